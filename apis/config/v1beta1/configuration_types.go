@@ -22,6 +22,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
 )
 
 // +k8s:defaulter-gen=true
@@ -111,6 +112,68 @@ type Configuration struct {
 	// of Kueue-managed objects. A nil value disables all automatic deletions.
 	// +optional
 	ObjectRetentionPolicies *ObjectRetentionPolicies `json:"objectRetentionPolicies,omitempty"`
+
+	// GracefulPreemption provides configuration options for signaling preempted
+	// jobs to checkpoint before they are stopped.
+	// It is only honored when the GracefulPreemption feature gate is enabled.
+	// +optional
+	GracefulPreemption *GracefulPreemption `json:"gracefulPreemption,omitempty"`
+
+	// SchedulingCycle provides configuration options for tuning the scheduling
+	// cycles, to trade admission latency for throughput.
+	// +optional
+	SchedulingCycle *SchedulingCycle `json:"schedulingCycle,omitempty"`
+
+	// ResourceFlavorDiscovery provides configuration options for creating and
+	// updating the ResourceFlavors from the node pools of the cluster.
+	// It is only honored when the ResourceFlavorDiscovery feature gate is enabled.
+	// +optional
+	ResourceFlavorDiscovery *ResourceFlavorDiscovery `json:"resourceFlavorDiscovery,omitempty"`
+
+	// FlavorCosts provides configuration options for the provider of the costs
+	// of the ResourceFlavors, used by the ClusterQueues with the LowestCost
+	// flavorOrder.
+	// It is only honored when the FlavorCostOrdering feature gate is enabled.
+	// +optional
+	FlavorCosts *FlavorCosts `json:"flavorCosts,omitempty"`
+
+	// FlavorFailover provides configuration options for the eviction of the
+	// admitted workloads whose pods stay unschedulable on the assigned
+	// ResourceFlavors.
+	// It is only honored when the FlavorFailover feature gate is enabled.
+	// +optional
+	FlavorFailover *FlavorFailover `json:"flavorFailover,omitempty"`
+
+	// ActualUsage provides configuration options for the sampling of the
+	// resource usage of the pods of the admitted workloads, recorded in the
+	// status of the workloads when they finish.
+	// It is only honored when the WorkloadActualUsage feature gate is enabled.
+	// +optional
+	ActualUsage *ActualUsage `json:"actualUsage,omitempty"`
+
+	// Tracing provides the configuration of the OpenTelemetry collector the
+	// spans of the admission lifecycle of the workloads are exported to.
+	// It is only honored when the AdmissionTracing feature gate is enabled.
+	// +optional
+	Tracing *tracingapi.TracingConfiguration `json:"tracing,omitempty"`
+
+	// PreemptionAudit provides configuration options for the sink of the audit
+	// records of the preemptions issued by the scheduler.
+	// It is only honored when the PreemptionAuditLog feature gate is enabled.
+	// +optional
+	PreemptionAudit *PreemptionAudit `json:"preemptionAudit,omitempty"`
+
+	// EventThrottling provides configuration options for the deduplication and
+	// the rate limiting of the events recorded by the controllers.
+	// It is only honored when the EventThrottling feature gate is enabled.
+	// +optional
+	EventThrottling *EventThrottling `json:"eventThrottling,omitempty"`
+
+	// SchedulingDecisionLog provides configuration options for the log of the
+	// decisions taken by the scheduler on each of the admission attempts.
+	// It is only honored when the SchedulingDecisionLog feature gate is enabled.
+	// +optional
+	SchedulingDecisionLog *SchedulingDecisionLog `json:"schedulingDecisionLog,omitempty"`
 }
 
 type ControllerManager struct {
@@ -177,6 +240,26 @@ type ControllerMetrics struct {
 	// metrics will be reported.
 	// +optional
 	EnableClusterQueueResources bool `json:"enableClusterQueueResources,omitempty"`
+
+	// WorkloadGroupingLabels is a list of label keys, for example team or
+	// cost-center, by which the resource usage of the ClusterQueues is reported.
+	// The labels are copied from the jobs to their workloads, in addition to the
+	// integrations labelKeysToCopy.
+	// Requires the WorkloadGroupingMetrics feature gate.
+	// +optional
+	WorkloadGroupingLabels []string `json:"workloadGroupingLabels,omitempty"`
+
+	// Cardinality limits the cardinality of the metrics of Kueue, by removing
+	// label dimensions from the metrics and capping the number of series per metric.
+	// It is only honored when the MetricsCardinalityLimits feature gate is enabled.
+	// +optional
+	Cardinality *MetricsCardinality `json:"cardinality,omitempty"`
+
+	// NamespaceAllowlist is the list of namespaces for which the admission wait
+	// time and the numbers of admitted and evicted workloads are reported per namespace.
+	// It is only honored when the NamespaceMetrics feature gate is enabled.
+	// +optional
+	NamespaceAllowlist []string `json:"namespaceAllowlist,omitempty"`
 }
 
 // ControllerHealth defines the health configs.
@@ -404,6 +487,13 @@ type Integrations struct {
 	//  - "kubeflow.org/jaxjob"
 	//  - "trainer.kubeflow.org/trainjob"
 	//  - "workload.codeflare.dev/appwrapper"
+	//  - "argoproj.io/workflow"
+	//  - "sparkoperator.k8s.io/sparkapplication"
+	//  - "batch/cronjob" (requires enabling batch/job integration)
+	//  - "flink.apache.org/flinkdeployment"
+	//  - "flink.apache.org/flinksessionjob"
+	//  - "kubevirt.io/virtualmachineinstance"
+	//  - "kubevirt.io/virtualmachineinstancereplicaset"
 	//  - "pod"
 	//  - "deployment" (requires enabling pod integration)
 	//  - "statefulset" (requires enabling pod integration)
@@ -418,6 +508,18 @@ type Integrations struct {
 	// instead.
 	PodOptions *PodIntegrationOptions `json:"podOptions,omitempty"`
 
+	// plugins is a list of job frameworks implemented by out-of-process plugins,
+	// which Kueue calls through the JobPlugin gRPC service.
+	// Requires the JobFrameworkPlugins feature gate.
+	// +optional
+	Plugins []IntegrationPlugin `json:"plugins,omitempty"`
+
+	// childJobsPolicies configure, per kind of owner, whether the jobs owned by
+	// the objects of the kind are queued separately.
+	// Requires the ChildJobsPolicies feature gate.
+	// +optional
+	ChildJobsPolicies []ChildJobsPolicy `json:"childJobsPolicies,omitempty"`
+
 	// labelKeysToCopy is a list of label keys that should be copied from the job into the
 	// workload object. It is not required for the job to have all the labels from this
 	// list. If a job does not have some label with the given key from this list, the
@@ -430,6 +532,54 @@ type Integrations struct {
 	LabelKeysToCopy []string `json:"labelKeysToCopy,omitempty"`
 }
 
+// IntegrationPlugin defines a job framework implemented by an out-of-process plugin.
+type IntegrationPlugin struct {
+	// name is the GVK of the jobs managed through the plugin,
+	// the expected format is `Kind.version.group`.
+	Name string `json:"name"`
+
+	// address is the gRPC target of the plugin, for example,
+	// `unix:///var/run/kueue/plugin.sock` or `dns:///localhost:9443`.
+	// The connection is not encrypted, so the plugin is expected to
+	// run next to the Kueue manager.
+	Address string `json:"address"`
+}
+
+type ChildJobsPolicyType string
+
+const (
+	// ChildJobsPolicyExempt exempts the jobs owned, directly or through other
+	// objects of kinds with a policy, by an object managed by Kueue from
+	// being queued separately, as the owner already holds the quota.
+	ChildJobsPolicyExempt ChildJobsPolicyType = "Exempt"
+
+	// ChildJobsPolicyQueue queues the jobs owned by the objects of the kind
+	// separately, even when the objects are managed by Kueue.
+	ChildJobsPolicyQueue ChildJobsPolicyType = "Queue"
+
+	// ChildJobsPolicyInheritQueue queues the jobs owned by the objects of the kind
+	// separately, in the queue of the owner when the jobs do not set a queue name.
+	ChildJobsPolicyInheritQueue ChildJobsPolicyType = "InheritQueue"
+)
+
+// ChildJobsPolicy defines how the jobs owned by the objects of a kind are queued.
+type ChildJobsPolicy struct {
+	// ownerKind is the GVK of the owners, the expected format is `Kind.version.group`.
+	OwnerKind string `json:"ownerKind"`
+
+	// policy is the policy of the jobs owned by the objects of the kind.
+	// The supported values are:
+	// - Exempt: the jobs are not queued separately when the owner, or any of its
+	//   ancestors, is managed by Kueue. Setting it for a kind which is not managed
+	//   by Kueue makes Kueue look for a managed ancestor through the objects of the kind.
+	// - Queue: the jobs are queued separately.
+	// - InheritQueue: the jobs are queued separately, and the jobs which do not set
+	//   a queue name are queued in the queue set by the queue-name label of the owner.
+	//   Kueue needs the privileges to get the objects of the kind.
+	// +kubebuilder:validation:Enum=Exempt;Queue;InheritQueue
+	Policy ChildJobsPolicyType `json:"policy"`
+}
+
 type PodIntegrationOptions struct {
 	// NamespaceSelector can be used to omit some namespaces from pod reconciliation
 	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
@@ -470,6 +620,14 @@ type Resources struct {
 	// for Dynamic Resource Allocation support.
 	// +optional
 	DeviceClassMappings []DeviceClassMapping `json:"deviceClassMappings,omitempty"`
+
+	// FractionalResources are the resources, other than cpu, of which the
+	// quotas and requests are accounted in milli-units, so that the fractions
+	// of the ResourceFlavor resourcePartitions, or the fractional outputs of
+	// the Transformations, aggregate without being rounded up to a unit.
+	// Requires the FlavorResourcePartitions feature gate.
+	// +optional
+	FractionalResources []corev1.ResourceName `json:"fractionalResources,omitempty"`
 }
 
 type ResourceTransformationStrategy string
@@ -541,6 +699,15 @@ type FairSharing struct {
 	//   newest start time first.
 	// The default strategy is ["LessThanOrEqualToFinalShare", "LessThanInitialShare"].
 	PreemptionStrategies []PreemptionStrategy `json:"preemptionStrategies,omitempty"`
+
+	// usageHalfLifeTime makes the usage of the ClusterQueues considered
+	// for Fair Sharing decay over time, rather than reflect only the
+	// currently admitted workloads. Once a ClusterQueue uses less
+	// resources, the difference decays by a half after this duration.
+	// If not set or 0, only the current usage is considered.
+	// This field requires the FairSharingUsageDecay feature gate.
+	// +optional
+	UsageHalfLifeTime *metav1.Duration `json:"usageHalfLifeTime,omitempty"`
 }
 
 type AdmissionFairSharing struct {
@@ -564,6 +731,27 @@ type ObjectRetentionPolicies struct {
 	// A nil value disables automatic deletion of Workloads.
 	// +optional
 	Workloads *WorkloadRetentionPolicy `json:"workloads,omitempty"`
+
+	// ProvisioningRequests configures retention for the ProvisioningRequests
+	// created by Kueue.
+	// A nil value disables automatic deletion of stale ProvisioningRequests.
+	// +optional
+	ProvisioningRequests *ProvisioningRequestRetentionPolicy `json:"provisioningRequests,omitempty"`
+}
+
+// ProvisioningRequestRetentionPolicy defines the policies for when the
+// ProvisioningRequests created by Kueue should be deleted.
+type ProvisioningRequestRetentionPolicy struct {
+	// AfterStale is the duration to wait after a ProvisioningRequest becomes
+	// stale before deleting it. A ProvisioningRequest is stale when the
+	// Workload owning it was deleted or finished, when its admission check was
+	// rejected, when the Workload got quota reserved on other flavors, or when
+	// the booking of the capacity of the admitted Workload expired.
+	// A duration of 0 will delete immediately.
+	// A nil value disables automatic deletion.
+	// Represented using metav1.Duration (e.g. "10m", "1h30m").
+	// +optional
+	AfterStale *metav1.Duration `json:"afterStale,omitempty"`
 }
 
 // WorkloadRetentionPolicy defines the policies for when Workloads should be deleted.
@@ -587,4 +775,253 @@ type WorkloadRetentionPolicy struct {
 	// Represented using metav1.Duration (e.g. "10m", "1h30m").
 	// +optional
 	AfterDeactivatedByKueue *metav1.Duration `json:"afterDeactivatedByKueue,omitempty"`
+
+	// AfterFinishedOverrides overrides AfterFinished for the finished Workloads
+	// of some namespaces or ClusterQueues. The first override matching a
+	// Workload applies to it, AfterFinished applies to the Workloads not
+	// matched by any override.
+	// +optional
+	// +listType=atomic
+	AfterFinishedOverrides []WorkloadRetentionOverride `json:"afterFinishedOverrides,omitempty"`
+
+	// Archive configures a sink receiving a record of each finished Workload
+	// before it is deleted due to elapsed retention. The Workload is not
+	// deleted until its record is accepted by the sink.
+	// A nil value disables archival.
+	// +optional
+	Archive *WorkloadArchive `json:"archive,omitempty"`
+}
+
+// WorkloadRetentionOverride defines the retention of the finished Workloads
+// matched by its selectors. An override without selectors matches all the
+// Workloads.
+type WorkloadRetentionOverride struct {
+	// NamespaceSelector selects the namespaces of the Workloads matched by
+	// the override.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// ClusterQueues are the names of the ClusterQueues which admitted the
+	// Workloads matched by the override.
+	// +optional
+	// +listType=set
+	ClusterQueues []string `json:"clusterQueues,omitempty"`
+
+	// AfterFinished is the duration to wait after a matched Workload finishes
+	// before deleting it.
+	// A duration of 0 will delete immediately.
+	// A nil value disables automatic deletion of the matched Workloads.
+	// Represented using metav1.Duration (e.g. "10m", "1h30m").
+	// +optional
+	AfterFinished *metav1.Duration `json:"afterFinished,omitempty"`
+}
+
+type WorkloadArchiveMethod string
+
+const (
+	// WorkloadArchiveMethodPost sends each record in a POST request to the URL,
+	// for example to a webhook.
+	WorkloadArchiveMethodPost WorkloadArchiveMethod = "POST"
+	// WorkloadArchiveMethodPut uploads each record in a PUT request to the
+	// object "<url>/<namespace>/<name>-<uid>.json", for example to an
+	// S3-compatible object storage.
+	WorkloadArchiveMethodPut WorkloadArchiveMethod = "PUT"
+)
+
+// WorkloadArchive defines the sink receiving the records of the deleted
+// finished Workloads, as compact JSON documents.
+type WorkloadArchive struct {
+	// URL is the URL of the sink. Only https URLs are supported.
+	URL string `json:"url"`
+
+	// Method is the HTTP method used to send the records, POST or PUT.
+	// Defaults to POST.
+	// +optional
+	Method WorkloadArchiveMethod `json:"method,omitempty"`
+
+	// BearerTokenFile is the path of a file holding the token sent in the
+	// Authorization header of the requests. The file is read for each request,
+	// which allows rotating the token.
+	// +optional
+	BearerTokenFile string `json:"bearerTokenFile,omitempty"`
+
+	// CABundleFile is the path of a file holding the PEM encoded CA bundle used
+	// to verify the certificate of the sink. If empty, the system trust roots
+	// are used.
+	// +optional
+	CABundleFile string `json:"caBundleFile,omitempty"`
+
+	// Timeout is the timeout of each request to the sink.
+	// Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// GracefulPreemption defines how long a preempted job may keep running after
+// it was asked to checkpoint.
+type GracefulPreemption struct {
+	// GracePeriod is the duration to wait after a Workload is evicted due to
+	// preemption before its job is stopped. During that window the job's object is
+	// annotated with "kueue.x-k8s.io/checkpoint-requested", holding the deadline,
+	// so that the workload (e.g. a trainer or a PipelineRun) can save its progress.
+	// A duration of 0 or a nil value stops the job immediately.
+	// Represented using metav1.Duration (e.g. "30s", "5m").
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+type SchedulingCycle struct {
+	// Period is the minimum time between the start of two consecutive scheduling
+	// cycles. Longer periods let more workloads and cluster events accumulate
+	// between cycles, at the cost of a higher admission latency.
+	// A duration of 0 or a nil value starts the next cycle as soon as the
+	// previous one finishes.
+	// Represented using metav1.Duration (e.g. "100ms", "1s").
+	// +optional
+	Period *metav1.Duration `json:"period,omitempty"`
+
+	// MaxHeads is the maximum number of ClusterQueue heads considered in a
+	// scheduling cycle. The heads with the highest priority, and then the
+	// oldest, are considered first. The rest are put back in their ClusterQueues
+	// for the next cycle.
+	// A value of 0 or a nil value considers all the heads.
+	// +optional
+	MaxHeads *int32 `json:"maxHeads,omitempty"`
+
+	// MaxAdmissionsPerCohort is the maximum number of workloads admitted in the
+	// ClusterQueues of a root Cohort in a scheduling cycle. ClusterQueues which
+	// don't belong to a Cohort are limited on their own. The workloads over the
+	// limit are put back in their ClusterQueues for the next cycle.
+	// A value of 0 or a nil value doesn't limit the admissions.
+	// +optional
+	MaxAdmissionsPerCohort *int32 `json:"maxAdmissionsPerCohort,omitempty"`
+}
+
+type ResourceFlavorDiscovery struct {
+	// NodeLabelKeys are the keys of the Node labels grouping the Nodes into
+	// node pools. A ResourceFlavor is created for each combination of values of
+	// these labels found on the Nodes, with the labels as its nodeLabels.
+	// The Nodes missing any of the labels are ignored.
+	NodeLabelKeys []string `json:"nodeLabelKeys"`
+
+	// TolerateNodeTaints makes the discovered ResourceFlavors tolerate the
+	// taints shared by all the Nodes of their node pool, so that Kueue adds the
+	// tolerations to the Pods of the admitted workloads.
+	// By default, the taints are set as the nodeTaints of the ResourceFlavors,
+	// and only the workloads tolerating them can be assigned the ResourceFlavors.
+	// +optional
+	TolerateNodeTaints *bool `json:"tolerateNodeTaints,omitempty"`
+}
+
+type FlavorCosts struct {
+	// ConfigMapName is the name of the ConfigMap, in the namespace of Kueue,
+	// holding the costs of the ResourceFlavors. The keys of the data are the
+	// names of the flavors and the values are their costs, as quantities,
+	// for example `spot-a2: "1.25"`. The ConfigMap can be kept up to date
+	// with the spot prices by an external tool.
+	ConfigMapName string `json:"configMapName"`
+
+	// UpdatePeriod is the period at which the costs are read.
+	// Defaults to 1m.
+	// +optional
+	UpdatePeriod *metav1.Duration `json:"updatePeriod,omitempty"`
+}
+
+type FlavorFailover struct {
+	// UnschedulableTimeout is how long a pod of an admitted workload can stay
+	// unschedulable before the workload is evicted, and the flavors assigned to
+	// the podSet of the pod are excluded for the workload.
+	// Defaults to 5m.
+	// +optional
+	UnschedulableTimeout *metav1.Duration `json:"unschedulableTimeout,omitempty"`
+
+	// ExclusionDuration is how long the flavors stay excluded for the evicted
+	// workload, after which the workload can be assigned them again.
+	// Defaults to 30m.
+	// +optional
+	ExclusionDuration *metav1.Duration `json:"exclusionDuration,omitempty"`
+}
+
+type ActualUsage struct {
+	// SamplingInterval is the period at which the resource usage of the pods
+	// is read from the metrics-server.
+	// Defaults to 30s.
+	// +optional
+	SamplingInterval *metav1.Duration `json:"samplingInterval,omitempty"`
+}
+
+type PreemptionAudit struct {
+	// Path is the absolute path of the file the audit records of the preemptions
+	// are appended to, one JSON object per line. When empty, the records are
+	// written to the log of the manager, by the preemption-audit logger.
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+type SchedulingDecisionLog struct {
+	// Path is the absolute path of the file the decision records are appended
+	// to, one JSON object per line. The file is rotated when it reaches
+	// maxSizeMegabytes. When empty, the records are written to the log of the
+	// manager, by the scheduling-decision logger.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// MaxSizeMegabytes is the size of the file, in megabytes, at which it is rotated.
+	// Defaults to 100.
+	// +optional
+	MaxSizeMegabytes *int32 `json:"maxSizeMegabytes,omitempty"`
+
+	// MaxBackups is the number of rotated files to retain. 0 retains all of them.
+	// Defaults to 5.
+	// +optional
+	MaxBackups *int32 `json:"maxBackups,omitempty"`
+}
+
+type EventThrottling struct {
+	// Interval is the period over which the identical events are deduplicated
+	// and the budgets of the reasons are accounted.
+	// Defaults to 1m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// DefaultBudget is the maximum number of events with the same reason
+	// recorded by a controller per interval, for the reasons not listed in
+	// reasonBudgets.
+	// Defaults to 100.
+	// +optional
+	DefaultBudget *int32 `json:"defaultBudget,omitempty"`
+
+	// ReasonBudgets is the maximum number of events recorded by a controller
+	// per interval, per reason of the events. A budget of 0 drops all the
+	// events with the reason.
+	// +optional
+	ReasonBudgets map[string]int32 `json:"reasonBudgets,omitempty"`
+}
+
+// MetricsLabel is a label dimension of the metrics of Kueue.
+type MetricsLabel string
+
+const (
+	// MetricsLabelNamespace is the namespace of the LocalQueues.
+	MetricsLabelNamespace MetricsLabel = "namespace"
+	// MetricsLabelPriorityClass is the priority class of the workloads.
+	MetricsLabelPriorityClass MetricsLabel = "priority_class"
+	// MetricsLabelFlavor is the ResourceFlavor of the quotas and usages.
+	MetricsLabelFlavor MetricsLabel = "flavor"
+)
+
+type MetricsCardinality struct {
+	// ExcludedLabels lists the label dimensions removed from the metrics of Kueue.
+	// The series which only differ by the excluded labels are aggregated: the
+	// counters, gauges and histograms are summed.
+	// Possible values are "namespace", "priority_class" and "flavor".
+	// +optional
+	ExcludedLabels []MetricsLabel `json:"excludedLabels,omitempty"`
+
+	// MaxSeriesPerMetric is the maximum number of series reported per metric of
+	// Kueue. The series beyond the limit are dropped, and their number is reported
+	// by the kueue_metrics_dropped_series metric.
+	// When not set, the number of series is not limited.
+	// +optional
+	MaxSeriesPerMetric *int32 `json:"maxSeriesPerMetric,omitempty"`
 }
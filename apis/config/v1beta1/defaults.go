@@ -51,6 +51,14 @@ const (
 	DefaultRequeuingBackoffBaseSeconds            = 60
 	DefaultRequeuingBackoffMaxSeconds             = 3600
 	DefaultResourceTransformationStrategy         = Retain
+	DefaultFlavorCostsUpdatePeriod                = time.Minute
+	DefaultFlavorFailoverTimeout                  = 5 * time.Minute
+	DefaultFlavorExclusionDuration                = 30 * time.Minute
+	DefaultActualUsageSamplingInterval            = 30 * time.Second
+	DefaultEventThrottlingInterval                = time.Minute
+	DefaultEventThrottlingBudget          int32   = 100
+	DefaultSchedulingDecisionLogMaxSize   int32   = 100
+	DefaultSchedulingDecisionLogBackups   int32   = 5
 )
 
 func getOperatorNamespace() string {
@@ -135,4 +143,22 @@ func SetDefaults_Configuration(cfg *Configuration) {
 			cfg.Resources.Transformations[idx].Strategy = ptr.To(cmp.Or(ptr.Deref(cfg.Resources.Transformations[idx].Strategy, ""), DefaultResourceTransformationStrategy))
 		}
 	}
+	if fc := cfg.FlavorCosts; fc != nil {
+		fc.UpdatePeriod = cmp.Or(fc.UpdatePeriod, &metav1.Duration{Duration: DefaultFlavorCostsUpdatePeriod})
+	}
+	if ff := cfg.FlavorFailover; ff != nil {
+		ff.UnschedulableTimeout = cmp.Or(ff.UnschedulableTimeout, &metav1.Duration{Duration: DefaultFlavorFailoverTimeout})
+		ff.ExclusionDuration = cmp.Or(ff.ExclusionDuration, &metav1.Duration{Duration: DefaultFlavorExclusionDuration})
+	}
+	if au := cfg.ActualUsage; au != nil {
+		au.SamplingInterval = cmp.Or(au.SamplingInterval, &metav1.Duration{Duration: DefaultActualUsageSamplingInterval})
+	}
+	if et := cfg.EventThrottling; et != nil {
+		et.Interval = cmp.Or(et.Interval, &metav1.Duration{Duration: DefaultEventThrottlingInterval})
+		et.DefaultBudget = cmp.Or(et.DefaultBudget, ptr.To(DefaultEventThrottlingBudget))
+	}
+	if dl := cfg.SchedulingDecisionLog; dl != nil {
+		dl.MaxSizeMegabytes = cmp.Or(dl.MaxSizeMegabytes, ptr.To(DefaultSchedulingDecisionLogMaxSize))
+		dl.MaxBackups = cmp.Or(dl.MaxBackups, ptr.To(DefaultSchedulingDecisionLogBackups))
+	}
 }
@@ -650,6 +650,136 @@ func TestSetDefaults_Configuration(t *testing.T) {
 				WaitForPodsReady: &WaitForPodsReady{},
 			},
 		},
+		"flavorCosts updatePeriod": {
+			original: &Configuration{
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				FlavorCosts: &FlavorCosts{
+					ConfigMapName: "flavor-costs",
+				},
+			},
+			want: &Configuration{
+				Namespace:         ptr.To(DefaultNamespace),
+				ControllerManager: defaultCtrlManagerConfigurationSpec,
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				ClientConnection:             defaultClientConnection,
+				Integrations:                 defaultIntegrations,
+				MultiKueue:                   defaultMultiKueue,
+				ManagedJobsNamespaceSelector: defaultManagedJobsNamespaceSelector,
+				FlavorCosts: &FlavorCosts{
+					ConfigMapName: "flavor-costs",
+					UpdatePeriod:  &metav1.Duration{Duration: DefaultFlavorCostsUpdatePeriod},
+				},
+				WaitForPodsReady: &WaitForPodsReady{},
+			},
+		},
+		"flavorFailover durations": {
+			original: &Configuration{
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				FlavorFailover: &FlavorFailover{
+					ExclusionDuration: &metav1.Duration{Duration: time.Hour},
+				},
+			},
+			want: &Configuration{
+				Namespace:         ptr.To(DefaultNamespace),
+				ControllerManager: defaultCtrlManagerConfigurationSpec,
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				ClientConnection:             defaultClientConnection,
+				Integrations:                 defaultIntegrations,
+				MultiKueue:                   defaultMultiKueue,
+				ManagedJobsNamespaceSelector: defaultManagedJobsNamespaceSelector,
+				FlavorFailover: &FlavorFailover{
+					UnschedulableTimeout: &metav1.Duration{Duration: DefaultFlavorFailoverTimeout},
+					ExclusionDuration:    &metav1.Duration{Duration: time.Hour},
+				},
+				WaitForPodsReady: &WaitForPodsReady{},
+			},
+		},
+		"actualUsage sampling interval": {
+			original: &Configuration{
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				ActualUsage: &ActualUsage{},
+			},
+			want: &Configuration{
+				Namespace:         ptr.To(DefaultNamespace),
+				ControllerManager: defaultCtrlManagerConfigurationSpec,
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				ClientConnection:             defaultClientConnection,
+				Integrations:                 defaultIntegrations,
+				MultiKueue:                   defaultMultiKueue,
+				ManagedJobsNamespaceSelector: defaultManagedJobsNamespaceSelector,
+				ActualUsage: &ActualUsage{
+					SamplingInterval: &metav1.Duration{Duration: DefaultActualUsageSamplingInterval},
+				},
+				WaitForPodsReady: &WaitForPodsReady{},
+			},
+		},
+		"eventThrottling defaults": {
+			original: &Configuration{
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				EventThrottling: &EventThrottling{
+					ReasonBudgets: map[string]int32{"Pending": 10},
+				},
+			},
+			want: &Configuration{
+				Namespace:         ptr.To(DefaultNamespace),
+				ControllerManager: defaultCtrlManagerConfigurationSpec,
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				ClientConnection:             defaultClientConnection,
+				Integrations:                 defaultIntegrations,
+				MultiKueue:                   defaultMultiKueue,
+				ManagedJobsNamespaceSelector: defaultManagedJobsNamespaceSelector,
+				EventThrottling: &EventThrottling{
+					Interval:      &metav1.Duration{Duration: DefaultEventThrottlingInterval},
+					DefaultBudget: ptr.To(DefaultEventThrottlingBudget),
+					ReasonBudgets: map[string]int32{"Pending": 10},
+				},
+				WaitForPodsReady: &WaitForPodsReady{},
+			},
+		},
+		"schedulingDecisionLog defaults": {
+			original: &Configuration{
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				SchedulingDecisionLog: &SchedulingDecisionLog{
+					Path:       "/var/log/kueue/decisions.log",
+					MaxBackups: ptr.To[int32](0),
+				},
+			},
+			want: &Configuration{
+				Namespace:         ptr.To(DefaultNamespace),
+				ControllerManager: defaultCtrlManagerConfigurationSpec,
+				InternalCertManagement: &InternalCertManagement{
+					Enable: ptr.To(false),
+				},
+				ClientConnection:             defaultClientConnection,
+				Integrations:                 defaultIntegrations,
+				MultiKueue:                   defaultMultiKueue,
+				ManagedJobsNamespaceSelector: defaultManagedJobsNamespaceSelector,
+				SchedulingDecisionLog: &SchedulingDecisionLog{
+					Path:             "/var/log/kueue/decisions.log",
+					MaxSizeMegabytes: ptr.To(DefaultSchedulingDecisionLogMaxSize),
+					MaxBackups:       ptr.To[int32](0),
+				},
+				WaitForPodsReady: &WaitForPodsReady{},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
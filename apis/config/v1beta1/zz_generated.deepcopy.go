@@ -25,9 +25,30 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/component-base/config/v1alpha1"
+	apiv1 "k8s.io/component-base/tracing/api/v1"
 	timex "time"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActualUsage) DeepCopyInto(out *ActualUsage) {
+	*out = *in
+	if in.SamplingInterval != nil {
+		in, out := &in.SamplingInterval, &out.SamplingInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActualUsage.
+func (in *ActualUsage) DeepCopy() *ActualUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ActualUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdmissionFairSharing) DeepCopyInto(out *AdmissionFairSharing) {
 	*out = *in
@@ -52,6 +73,21 @@ func (in *AdmissionFairSharing) DeepCopy() *AdmissionFairSharing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChildJobsPolicy) DeepCopyInto(out *ChildJobsPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildJobsPolicy.
+func (in *ChildJobsPolicy) DeepCopy() *ChildJobsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ChildJobsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientConnection) DeepCopyInto(out *ClientConnection) {
 	*out = *in
@@ -164,6 +200,56 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		*out = new(ObjectRetentionPolicies)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GracefulPreemption != nil {
+		in, out := &in.GracefulPreemption, &out.GracefulPreemption
+		*out = new(GracefulPreemption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchedulingCycle != nil {
+		in, out := &in.SchedulingCycle, &out.SchedulingCycle
+		*out = new(SchedulingCycle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceFlavorDiscovery != nil {
+		in, out := &in.ResourceFlavorDiscovery, &out.ResourceFlavorDiscovery
+		*out = new(ResourceFlavorDiscovery)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlavorCosts != nil {
+		in, out := &in.FlavorCosts, &out.FlavorCosts
+		*out = new(FlavorCosts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlavorFailover != nil {
+		in, out := &in.FlavorFailover, &out.FlavorFailover
+		*out = new(FlavorFailover)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ActualUsage != nil {
+		in, out := &in.ActualUsage, &out.ActualUsage
+		*out = new(ActualUsage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tracing != nil {
+		in, out := &in.Tracing, &out.Tracing
+		*out = new(apiv1.TracingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreemptionAudit != nil {
+		in, out := &in.PreemptionAudit, &out.PreemptionAudit
+		*out = new(PreemptionAudit)
+		**out = **in
+	}
+	if in.EventThrottling != nil {
+		in, out := &in.EventThrottling, &out.EventThrottling
+		*out = new(EventThrottling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchedulingDecisionLog != nil {
+		in, out := &in.SchedulingDecisionLog, &out.SchedulingDecisionLog
+		*out = new(SchedulingDecisionLog)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Configuration.
@@ -235,7 +321,7 @@ func (in *ControllerManager) DeepCopyInto(out *ControllerManager) {
 		*out = new(v1alpha1.LeaderElectionConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
-	out.Metrics = in.Metrics
+	in.Metrics.DeepCopyInto(&out.Metrics)
 	out.Health = in.Health
 	if in.Controller != nil {
 		in, out := &in.Controller, &out.Controller
@@ -257,6 +343,21 @@ func (in *ControllerManager) DeepCopy() *ControllerManager {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerMetrics) DeepCopyInto(out *ControllerMetrics) {
 	*out = *in
+	if in.WorkloadGroupingLabels != nil {
+		in, out := &in.WorkloadGroupingLabels, &out.WorkloadGroupingLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Cardinality != nil {
+		in, out := &in.Cardinality, &out.Cardinality
+		*out = new(MetricsCardinality)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceAllowlist != nil {
+		in, out := &in.NamespaceAllowlist, &out.NamespaceAllowlist
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerMetrics.
@@ -309,6 +410,38 @@ func (in *DeviceClassMapping) DeepCopy() *DeviceClassMapping {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventThrottling) DeepCopyInto(out *EventThrottling) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DefaultBudget != nil {
+		in, out := &in.DefaultBudget, &out.DefaultBudget
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReasonBudgets != nil {
+		in, out := &in.ReasonBudgets, &out.ReasonBudgets
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventThrottling.
+func (in *EventThrottling) DeepCopy() *EventThrottling {
+	if in == nil {
+		return nil
+	}
+	out := new(EventThrottling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FairSharing) DeepCopyInto(out *FairSharing) {
 	*out = *in
@@ -317,6 +450,11 @@ func (in *FairSharing) DeepCopyInto(out *FairSharing) {
 		*out = make([]PreemptionStrategy, len(*in))
 		copy(*out, *in)
 	}
+	if in.UsageHalfLifeTime != nil {
+		in, out := &in.UsageHalfLifeTime, &out.UsageHalfLifeTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FairSharing.
@@ -329,6 +467,86 @@ func (in *FairSharing) DeepCopy() *FairSharing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorCosts) DeepCopyInto(out *FlavorCosts) {
+	*out = *in
+	if in.UpdatePeriod != nil {
+		in, out := &in.UpdatePeriod, &out.UpdatePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorCosts.
+func (in *FlavorCosts) DeepCopy() *FlavorCosts {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorCosts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorFailover) DeepCopyInto(out *FlavorFailover) {
+	*out = *in
+	if in.UnschedulableTimeout != nil {
+		in, out := &in.UnschedulableTimeout, &out.UnschedulableTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ExclusionDuration != nil {
+		in, out := &in.ExclusionDuration, &out.ExclusionDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorFailover.
+func (in *FlavorFailover) DeepCopy() *FlavorFailover {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorFailover)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GracefulPreemption) DeepCopyInto(out *GracefulPreemption) {
+	*out = *in
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GracefulPreemption.
+func (in *GracefulPreemption) DeepCopy() *GracefulPreemption {
+	if in == nil {
+		return nil
+	}
+	out := new(GracefulPreemption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntegrationPlugin) DeepCopyInto(out *IntegrationPlugin) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationPlugin.
+func (in *IntegrationPlugin) DeepCopy() *IntegrationPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(IntegrationPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Integrations) DeepCopyInto(out *Integrations) {
 	*out = *in
@@ -347,6 +565,16 @@ func (in *Integrations) DeepCopyInto(out *Integrations) {
 		*out = new(PodIntegrationOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]IntegrationPlugin, len(*in))
+		copy(*out, *in)
+	}
+	if in.ChildJobsPolicies != nil {
+		in, out := &in.ChildJobsPolicies, &out.ChildJobsPolicies
+		*out = make([]ChildJobsPolicy, len(*in))
+		copy(*out, *in)
+	}
 	if in.LabelKeysToCopy != nil {
 		in, out := &in.LabelKeysToCopy, &out.LabelKeysToCopy
 		*out = make([]string, len(*in))
@@ -394,6 +622,31 @@ func (in *InternalCertManagement) DeepCopy() *InternalCertManagement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsCardinality) DeepCopyInto(out *MetricsCardinality) {
+	*out = *in
+	if in.ExcludedLabels != nil {
+		in, out := &in.ExcludedLabels, &out.ExcludedLabels
+		*out = make([]MetricsLabel, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxSeriesPerMetric != nil {
+		in, out := &in.MaxSeriesPerMetric, &out.MaxSeriesPerMetric
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsCardinality.
+func (in *MetricsCardinality) DeepCopy() *MetricsCardinality {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsCardinality)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MultiKueue) DeepCopyInto(out *MultiKueue) {
 	*out = *in
@@ -457,6 +710,11 @@ func (in *ObjectRetentionPolicies) DeepCopyInto(out *ObjectRetentionPolicies) {
 		*out = new(WorkloadRetentionPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProvisioningRequests != nil {
+		in, out := &in.ProvisioningRequests, &out.ProvisioningRequests
+		*out = new(ProvisioningRequestRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectRetentionPolicies.
@@ -494,6 +752,41 @@ func (in *PodIntegrationOptions) DeepCopy() *PodIntegrationOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreemptionAudit) DeepCopyInto(out *PreemptionAudit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreemptionAudit.
+func (in *PreemptionAudit) DeepCopy() *PreemptionAudit {
+	if in == nil {
+		return nil
+	}
+	out := new(PreemptionAudit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningRequestRetentionPolicy) DeepCopyInto(out *ProvisioningRequestRetentionPolicy) {
+	*out = *in
+	if in.AfterStale != nil {
+		in, out := &in.AfterStale, &out.AfterStale
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestRetentionPolicy.
+func (in *ProvisioningRequestRetentionPolicy) DeepCopy() *ProvisioningRequestRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningRequestRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QueueVisibility) DeepCopyInto(out *QueueVisibility) {
 	*out = *in
@@ -549,6 +842,31 @@ func (in *RequeuingStrategy) DeepCopy() *RequeuingStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceFlavorDiscovery) DeepCopyInto(out *ResourceFlavorDiscovery) {
+	*out = *in
+	if in.NodeLabelKeys != nil {
+		in, out := &in.NodeLabelKeys, &out.NodeLabelKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TolerateNodeTaints != nil {
+		in, out := &in.TolerateNodeTaints, &out.TolerateNodeTaints
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFlavorDiscovery.
+func (in *ResourceFlavorDiscovery) DeepCopy() *ResourceFlavorDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceFlavorDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceTransformation) DeepCopyInto(out *ResourceTransformation) {
 	*out = *in
@@ -598,6 +916,11 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FractionalResources != nil {
+		in, out := &in.FractionalResources, &out.FractionalResources
+		*out = make([]corev1.ResourceName, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resources.
@@ -610,6 +933,61 @@ func (in *Resources) DeepCopy() *Resources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingCycle) DeepCopyInto(out *SchedulingCycle) {
+	*out = *in
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxHeads != nil {
+		in, out := &in.MaxHeads, &out.MaxHeads
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxAdmissionsPerCohort != nil {
+		in, out := &in.MaxAdmissionsPerCohort, &out.MaxAdmissionsPerCohort
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingCycle.
+func (in *SchedulingCycle) DeepCopy() *SchedulingCycle {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingCycle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingDecisionLog) DeepCopyInto(out *SchedulingDecisionLog) {
+	*out = *in
+	if in.MaxSizeMegabytes != nil {
+		in, out := &in.MaxSizeMegabytes, &out.MaxSizeMegabytes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBackups != nil {
+		in, out := &in.MaxBackups, &out.MaxBackups
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingDecisionLog.
+func (in *SchedulingDecisionLog) DeepCopy() *SchedulingDecisionLog {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingDecisionLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WaitForPodsReady) DeepCopyInto(out *WaitForPodsReady) {
 	*out = *in
@@ -645,6 +1023,56 @@ func (in *WaitForPodsReady) DeepCopy() *WaitForPodsReady {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadArchive) DeepCopyInto(out *WorkloadArchive) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadArchive.
+func (in *WorkloadArchive) DeepCopy() *WorkloadArchive {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadArchive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadRetentionOverride) DeepCopyInto(out *WorkloadRetentionOverride) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterQueues != nil {
+		in, out := &in.ClusterQueues, &out.ClusterQueues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AfterFinished != nil {
+		in, out := &in.AfterFinished, &out.AfterFinished
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadRetentionOverride.
+func (in *WorkloadRetentionOverride) DeepCopy() *WorkloadRetentionOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadRetentionOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadRetentionPolicy) DeepCopyInto(out *WorkloadRetentionPolicy) {
 	*out = *in
@@ -658,6 +1086,18 @@ func (in *WorkloadRetentionPolicy) DeepCopyInto(out *WorkloadRetentionPolicy) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.AfterFinishedOverrides != nil {
+		in, out := &in.AfterFinishedOverrides, &out.AfterFinishedOverrides
+		*out = make([]WorkloadRetentionOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Archive != nil {
+		in, out := &in.Archive, &out.Archive
+		*out = new(WorkloadArchive)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadRetentionPolicy.
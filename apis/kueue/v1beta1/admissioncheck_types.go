@@ -64,6 +64,61 @@ type AdmissionCheckSpec struct {
 	// check.
 	// +optional
 	Parameters *AdmissionCheckParametersReference `json:"parameters,omitempty"`
+
+	// continuous configures the evaluation of the check after the admission
+	// of the Workloads. When set, the admitted Workloads whose check is set
+	// to Retry or Rejected keep running for the gracePeriod, and are then
+	// evicted according to the evictionPolicy.
+	//
+	// This field is in alpha stage. To enable this field, enable the
+	// ContinuousAdmissionChecks feature gate.
+	// +optional
+	Continuous *ContinuousAdmissionCheck `json:"continuous,omitempty"`
+}
+
+type ContinuousAdmissionCheckEvictionPolicy string
+
+const (
+	// ContinuousAdmissionCheckEvictionPolicyRequeue evicts the Workloads and
+	// requeues them.
+	ContinuousAdmissionCheckEvictionPolicyRequeue ContinuousAdmissionCheckEvictionPolicy = "Requeue"
+
+	// ContinuousAdmissionCheckEvictionPolicyDeactivate evicts the Workloads
+	// and deactivates them.
+	ContinuousAdmissionCheckEvictionPolicyDeactivate ContinuousAdmissionCheckEvictionPolicy = "Deactivate"
+)
+
+// ContinuousAdmissionCheck configures the evaluation of an admission check
+// after the admission of the Workloads.
+type ContinuousAdmissionCheck struct {
+	// interval is the time between the evaluations of the check for the
+	// admitted Workloads, by the built-in admission check controllers
+	// supporting the continuous evaluation.
+	//
+	// Defaults to 5m.
+	// +optional
+	// +kubebuilder:default="5m"
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// gracePeriod is the time an admitted Workload keeps running after the
+	// check is set to Retry or Rejected, before it's evicted.
+	//
+	// Defaults to 0.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+
+	// evictionPolicy is the outcome for the admitted Workloads whose check
+	// is set to Retry, once the gracePeriod elapsed. Possible values are:
+	//
+	// - `Requeue` (default): the Workload is evicted and requeued.
+	// - `Deactivate`: the Workload is evicted and deactivated.
+	//
+	// The Workloads whose check is set to Rejected are always deactivated.
+	//
+	// +optional
+	// +kubebuilder:default=Requeue
+	// +kubebuilder:validation:Enum=Requeue;Deactivate
+	EvictionPolicy *ContinuousAdmissionCheckEvictionPolicy `json:"evictionPolicy,omitempty"`
 }
 
 type AdmissionCheckParametersReference struct {
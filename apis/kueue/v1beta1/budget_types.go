@@ -0,0 +1,196 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// BudgetAdmissionCheckControllerName is the name used by the budget
+	// admission check controller.
+	BudgetAdmissionCheckControllerName = "kueue.x-k8s.io/budget"
+)
+
+type BudgetExceedPolicy string
+
+const (
+	// BudgetExceedPolicyDefer sets the admission check of the Workloads
+	// exceeding the budget to Retry, so they release their quota reservation
+	// and are requeued with a backoff.
+	BudgetExceedPolicyDefer BudgetExceedPolicy = "Defer"
+
+	// BudgetExceedPolicyReject sets the admission check of the Workloads
+	// exceeding the budget to Rejected, which deactivates them.
+	BudgetExceedPolicyReject BudgetExceedPolicy = "Reject"
+)
+
+// BudgetSpec defines the desired state of Budget
+type BudgetSpec struct {
+	// localQueueName is the name of the LocalQueue the budget applies to.
+	// If empty, the budget applies to all the Workloads of the namespace.
+	//
+	// +optional
+	LocalQueueName *LocalQueueName `json:"localQueueName,omitempty"`
+
+	// monthlyLimit is the maximum cost of the Workloads admitted in a
+	// calendar month, in the currency of the prices of the
+	// BudgetAdmissionCheckConfig.
+	//
+	// +required
+	MonthlyLimit resource.Quantity `json:"monthlyLimit"`
+
+	// exceedPolicy is the outcome for a Workload whose cost would exceed
+	// the budget. Possible values are:
+	//
+	// - `Defer` (default): the Workload releases its quota reservation and
+	//   is requeued with a backoff.
+	// - `Reject`: the Workload is deactivated.
+	//
+	// +optional
+	// +kubebuilder:default=Defer
+	// +kubebuilder:validation:Enum=Defer;Reject
+	ExceedPolicy *BudgetExceedPolicy `json:"exceedPolicy,omitempty"`
+}
+
+// BudgetStatus defines the observed state of Budget
+type BudgetStatus struct {
+	// periodStart is the start of the calendar month of the spending.
+	//
+	// +optional
+	PeriodStart *metav1.Time `json:"periodStart,omitempty"`
+
+	// spent is the cost of the Workloads admitted since the periodStart.
+	//
+	// +optional
+	Spent *resource.Quantity `json:"spent,omitempty"`
+
+	// admittedWorkloads is the number of Workloads admitted since the
+	// periodStart.
+	//
+	// +optional
+	AdmittedWorkloads int32 `json:"admittedWorkloads,omitempty"`
+
+	// previousPeriodSpent is the cost of the Workloads admitted in the
+	// calendar month before the periodStart.
+	//
+	// +optional
+	PreviousPeriodSpent *resource.Quantity `json:"previousPeriodSpent,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Limit",JSONPath=".spec.monthlyLimit",type=string,description="Monthly limit"
+// +kubebuilder:printcolumn:name="Spent",JSONPath=".status.spent",type=string,description="Cost spent in the current month"
+// +kubebuilder:printcolumn:name="Age",JSONPath=".metadata.creationTimestamp",type=date,description="Time this resource was created"
+
+// Budget is the Schema for the budgets API
+type Budget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BudgetSpec   `json:"spec,omitempty"`
+	Status BudgetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BudgetList contains a list of Budget
+type BudgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Budget `json:"items"`
+}
+
+// BudgetAdmissionCheckConfigSpec defines the desired state of BudgetAdmissionCheckConfig
+type BudgetAdmissionCheckConfigSpec struct {
+	// prices is the list of the prices of the resources of the ResourceFlavors.
+	// The resources without a price are free.
+	//
+	// +listType=map
+	// +listMapKey=flavor
+	// +kubebuilder:validation:MaxItems=64
+	Prices []FlavorPrices `json:"prices,omitempty"`
+
+	// defaultDuration is the duration used to estimate the cost of the
+	// Workloads without maximumExecutionTimeSeconds.
+	//
+	// Defaults to 1h.
+	// +optional
+	// +kubebuilder:default="1h"
+	DefaultDuration *metav1.Duration `json:"defaultDuration,omitempty"`
+}
+
+// FlavorPrices defines the prices of the resources of a ResourceFlavor.
+type FlavorPrices struct {
+	// flavor is the name of the ResourceFlavor.
+	Flavor ResourceFlavorReference `json:"flavor"`
+
+	// resources is the list of the prices of the resources.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Resources []ResourcePrice `json:"resources"`
+}
+
+// ResourcePrice defines the price of a resource.
+type ResourcePrice struct {
+	// name of the resource.
+	Name corev1.ResourceName `json:"name"`
+
+	// pricePerHour is the price of using the unit of the resource for an hour.
+	PricePerHour resource.Quantity `json:"pricePerHour"`
+
+	// unit is the quantity of the resource the price applies to, for
+	// example 1Gi for memory.
+	//
+	// Defaults to 1.
+	// +optional
+	Unit *resource.Quantity `json:"unit,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster
+
+// BudgetAdmissionCheckConfig is the Schema for the budgetadmissioncheckconfigs API
+type BudgetAdmissionCheckConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec BudgetAdmissionCheckConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BudgetAdmissionCheckConfigList contains a list of BudgetAdmissionCheckConfig
+type BudgetAdmissionCheckConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BudgetAdmissionCheckConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Budget{}, &BudgetList{}, &BudgetAdmissionCheckConfig{}, &BudgetAdmissionCheckConfigList{})
+}
@@ -0,0 +1,111 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// CapacityReservationAdmissionCheckControllerName is the name used by the
+	// capacity reservation admission check controller.
+	CapacityReservationAdmissionCheckControllerName = "kueue.x-k8s.io/capacity-reservation"
+
+	// StaticCapacityReservationProvider is the name of the built-in provider
+	// holding the capacity declared in the reservations of the configuration.
+	StaticCapacityReservationProvider = "Static"
+)
+
+// CapacityReservationAdmissionCheckConfigSpec defines the desired state of CapacityReservationAdmissionCheckConfig
+type CapacityReservationAdmissionCheckConfigSpec struct {
+	// provider is the name of the provider of the capacity reservations, for
+	// example the provider of On-Demand Capacity Reservations of a cloud.
+	// The built-in Static provider holds the capacity declared in the
+	// reservations.
+	//
+	// Defaults to Static.
+	// +optional
+	// +kubebuilder:default=Static
+	// +kubebuilder:validation:MaxLength=63
+	Provider string `json:"provider,omitempty"`
+
+	// reservations is the list of the capacity reservations the Workloads
+	// can be admitted on. The reservations are tried in order.
+	//
+	// +listType=map
+	// +listMapKey=id
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Reservations []CapacityReservation `json:"reservations"`
+}
+
+// CapacityReservation defines a capacity reservation of the provider.
+type CapacityReservation struct {
+	// id is the identifier of the reservation in the provider.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=253
+	ID string `json:"id"`
+
+	// flavor is the ResourceFlavor of the reservation. If set, only the
+	// Workloads with a PodSet assigned to the flavor use the reservation.
+	//
+	// +optional
+	Flavor *ResourceFlavorReference `json:"flavor,omitempty"`
+
+	// capacity is the capacity of the reservation. It is required by the
+	// Static provider, and ignored by the providers getting the capacity
+	// from the cloud.
+	//
+	// +optional
+	Capacity corev1.ResourceList `json:"capacity,omitempty"`
+
+	// nodeSelector is the set of labels of the Nodes of the reservation,
+	// injected in the PodSets of the Workloads admitted on the reservation.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxProperties=8
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster
+
+// CapacityReservationAdmissionCheckConfig is the Schema for the capacityreservationadmissioncheckconfigs API
+type CapacityReservationAdmissionCheckConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec CapacityReservationAdmissionCheckConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CapacityReservationAdmissionCheckConfigList contains a list of CapacityReservationAdmissionCheckConfig
+type CapacityReservationAdmissionCheckConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CapacityReservationAdmissionCheckConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CapacityReservationAdmissionCheckConfig{}, &CapacityReservationAdmissionCheckConfigList{})
+}
@@ -33,10 +33,16 @@ const (
 	ClusterQueueActiveReasonMultiKueueAdmissionCheckAppliedPerFlavor = "MultiKueueAdmissionCheckAppliedPerFlavor"
 	ClusterQueueActiveReasonNotSupportedWithTopologyAwareScheduling  = "NotSupportedWithTopologyAwareScheduling"
 	ClusterQueueActiveReasonTopologyNotFound                         = "TopologyNotFound"
+	ClusterQueueActiveReasonFlavorWithoutNodes                       = "FlavorWithoutNodes"
 	ClusterQueueActiveReasonUnknown                                  = "Unknown"
 	ClusterQueueActiveReasonReady                                    = "Ready"
 )
 
+// ClusterQueue problem reasons, in addition to the Active condition reasons.
+const (
+	ClusterQueueProblemReasonCohortHasCycle = "CohortHasCycle"
+)
+
 // ClusterQueueReference is the name of the ClusterQueue.
 // It must be a DNS (RFC 1123) and has the maximum length of 253 characters.
 //
@@ -93,6 +99,25 @@ type ClusterQueueSpec struct {
 	// +kubebuilder:validation:Enum=StrictFIFO;BestEffortFIFO
 	QueueingStrategy QueueingStrategy `json:"queueingStrategy,omitempty"`
 
+	// headOfLineTimeoutSeconds is the maximum duration, in seconds, for which
+	// the workload at the head of a StrictFIFO ClusterQueue can block the
+	// newer workloads. Once exceeded, the workload is parked: it no longer
+	// blocks the queue, and it is retried only when cluster events free up
+	// quota, like with BestEffortFIFO.
+	// This field can only be set when queueingStrategy is StrictFIFO.
+	// This field is in alpha stage, and requires the StrictFIFOHeadOfLineTimeout
+	// feature gate to be enabled.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	HeadOfLineTimeoutSeconds *int32 `json:"headOfLineTimeoutSeconds,omitempty"`
+
+	// headroomPriorityThreshold is the minimum priority of the workloads
+	// which can use the reservedHeadroom of the ClusterQueue quotas.
+	// This field is in alpha stage, and requires the ReservedHeadroom
+	// feature gate to be enabled.
+	// +optional
+	HeadroomPriorityThreshold *int32 `json:"headroomPriorityThreshold,omitempty"`
+
 	// namespaceSelector defines which namespaces are allowed to submit workloads to
 	// this clusterQueue. Beyond this basic support for policy, a policy agent like
 	// Gatekeeper should be used to enforce more advanced policies.
@@ -132,6 +157,20 @@ type ClusterQueueSpec struct {
 	// +kubebuilder:default="None"
 	StopPolicy *StopPolicy `json:"stopPolicy,omitempty"`
 
+	// successorClusterQueue is the name of the ClusterQueue that takes over the
+	// LocalQueues of this ClusterQueue while it is stopped.
+	//
+	// When stopPolicy is set to a value different from None, the LocalQueues pointing
+	// to this ClusterQueue are recreated, with the same name, pointing to the successor,
+	// so that their pending workloads are considered for admission in the successor
+	// ClusterQueue, once the successor ClusterQueue exists.
+	// Workloads holding a quota reservation in this ClusterQueue keep it, unless
+	// they are evicted because of the HoldAndDrain stopPolicy.
+	//
+	// This field is honored only when the ClusterQueueWorkloadMigration feature gate is enabled.
+	// +optional
+	SuccessorClusterQueue *ClusterQueueReference `json:"successorClusterQueue,omitempty"`
+
 	// fairSharing defines the properties of the ClusterQueue when
 	// participating in FairSharing.  The values are only relevant
 	// if FairSharing is enabled in the Kueue configuration.
@@ -141,8 +180,123 @@ type ClusterQueueSpec struct {
 	// admissionScope indicates whether ClusterQueue uses the Admission Fair Sharing
 	// +optional
 	AdmissionScope *AdmissionScope `json:"admissionScope,omitempty"`
+
+	// topologyPlacementPolicy overrides the placementPolicy of the Topologies
+	// of the ResourceFlavors for the Workloads admitted in the ClusterQueue.
+	//
+	// This field is honored only when the TASPlacementPolicy feature gate is enabled.
+	// +optional
+	TopologyPlacementPolicy *TopologyPlacementPolicy `json:"topologyPlacementPolicy,omitempty"`
+
+	// physicalCapacityPolicy defines whether the Workloads are admitted beyond
+	// the physical capacity of the ResourceFlavors, that is the allocatable
+	// capacity of the ready Nodes matching their nodeLabels. Possible values are:
+	//
+	// - `Ignore` (default): the Workloads are admitted as long as they fit in the quotas.
+	// - `LimitAdmission`: the Workloads are only admitted if they also fit in the
+	//   physical headroom of the ResourceFlavors with nodeLabels, that is their
+	//   physical capacity minus the usage of the Workloads in all the ClusterQueues.
+	//
+	// This field is honored only when the FlavorPhysicalCapacity feature gate is enabled.
+	// +optional
+	PhysicalCapacityPolicy *PhysicalCapacityPolicy `json:"physicalCapacityPolicy,omitempty"`
+
+	// evictionBackoff overrides, for the Workloads admitted in the ClusterQueue,
+	// the requeuing backoff of the waitForPodsReady.requeuingStrategy of the
+	// Kueue configuration, applied when the Workloads are evicted due to the
+	// PodsReady timeout. The unset fields fall back to the requeuingStrategy.
+	//
+	// This field is honored only when the ClusterQueueEvictionBackoff feature gate is enabled.
+	// +optional
+	EvictionBackoff *ClusterQueueEvictionBackoff `json:"evictionBackoff,omitempty"`
+
+	// reactivationPolicy defines the automatic reactivation of the Workloads of
+	// the ClusterQueue deactivated after exceeding their requeuing limit.
+	// When not set, these Workloads stay deactivated until their
+	// .spec.active field is set back to true.
+	//
+	// This field is honored only when the WorkloadReactivationPolicy feature gate is enabled.
+	// +optional
+	ReactivationPolicy *ReactivationPolicy `json:"reactivationPolicy,omitempty"`
 }
 
+// ReactivationPolicy defines the automatic reactivation of the Workloads
+// deactivated after exceeding their requeuing limit.
+type ReactivationPolicy struct {
+	// cooldownSeconds is the base of the exponential cool-down, in seconds.
+	// A Workload deactivated for the n-th time is reactivated
+	// cooldownSeconds*2^(n-1) seconds after its deactivation.
+	// Defaults to 600.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	CooldownSeconds *int32 `json:"cooldownSeconds,omitempty"`
+
+	// maxCooldownSeconds is the maximum cool-down, in seconds.
+	// Defaults to 86400.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxCooldownSeconds *int32 `json:"maxCooldownSeconds,omitempty"`
+
+	// limitCount is the number of reactivations after which a deactivated
+	// Workload stays deactivated.
+	// Defaults to 3.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	LimitCount *int32 `json:"limitCount,omitempty"`
+}
+
+// ClusterQueueEvictionBackoff defines the requeuing backoff of the evicted
+// Workloads of a ClusterQueue.
+type ClusterQueueEvictionBackoff struct {
+	// backoffBaseSeconds is the base of the exponential backoff, in seconds.
+	// A Workload evicted for the n-th consecutive time is requeued after
+	// backoffBaseSeconds*2^(n-1) seconds.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BackoffBaseSeconds *int32 `json:"backoffBaseSeconds,omitempty"`
+
+	// backoffMaxSeconds is the maximum backoff, in seconds.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BackoffMaxSeconds *int32 `json:"backoffMaxSeconds,omitempty"`
+
+	// backoffLimitCount is the number of requeues after which an evicted
+	// Workload is deactivated instead of requeued.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BackoffLimitCount *int32 `json:"backoffLimitCount,omitempty"`
+
+	// jitterPercent is the maximum random jitter added to the backoff, as a
+	// percentage of it, which spreads the requeues of the Workloads evicted
+	// at the same time.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	JitterPercent *int32 `json:"jitterPercent,omitempty"`
+}
+
+// PhysicalCapacityPolicy defines whether the Workloads are admitted beyond the
+// physical capacity of the ResourceFlavors.
+// +kubebuilder:validation:Enum=Ignore;LimitAdmission
+type PhysicalCapacityPolicy string
+
+const (
+	// PhysicalCapacityPolicyIgnore admits the Workloads regardless of the
+	// physical capacity of the ResourceFlavors.
+	PhysicalCapacityPolicyIgnore PhysicalCapacityPolicy = "Ignore"
+
+	// PhysicalCapacityPolicyLimitAdmission only admits the Workloads fitting
+	// in the physical headroom of the ResourceFlavors.
+	PhysicalCapacityPolicyLimitAdmission PhysicalCapacityPolicy = "LimitAdmission"
+)
+
 // AdmissionChecksStrategy defines a strategy for a AdmissionCheck.
 type AdmissionChecksStrategy struct {
 	// admissionChecks is a list of strategies for AdmissionChecks
@@ -158,8 +312,65 @@ type AdmissionCheckStrategyRule struct {
 	// If empty, the AdmissionCheck will run for all workloads submitted to the ClusterQueue.
 	// +optional
 	OnFlavors []ResourceFlavorReference `json:"onFlavors,omitempty"`
+
+	// onPodSets is a list of PodSets' names that this AdmissionCheck should run for.
+	// The AdmissionCheck is only added to the Workloads having at least one of
+	// these PodSets, and, when onFlavors is set, only if one of these PodSets
+	// is assigned one of the flavors. The controllers of the AdmissionCheck only
+	// consider these PodSets, for example, only these PodSets are included in
+	// the ProvisioningRequests.
+	// If empty, the AdmissionCheck runs for all the PodSets.
+	// This field is in alpha stage. To enable this field, enable the
+	// AdmissionCheckPodSets feature gate.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=8
+	OnPodSets []PodSetReference `json:"onPodSets,omitempty"`
+
+	// dependsOn is a list of names of other AdmissionChecks in this strategy
+	// that need to be Ready before this AdmissionCheck is added to the Workload.
+	// Dependencies that don't apply to the Workload, because of their onFlavors,
+	// are ignored.
+	// This field is in alpha stage. To enable this field, enable the
+	// AdmissionCheckDependencies feature gate.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=8
+	DependsOn []AdmissionCheckReference `json:"dependsOn,omitempty"`
+
+	// timeout is the maximum time the AdmissionCheck can stay Pending once
+	// the Workload has quota reserved. When exceeded, the timeoutPolicy is applied.
+	// This field is in alpha stage. To enable this field, enable the
+	// AdmissionCheckTimeouts feature gate.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// timeoutPolicy is the outcome of the AdmissionCheck once the timeout is exceeded.
+	// Possible values are:
+	//
+	// - `Retry` (default): the check is set to Retry, so the Workload releases its
+	//   quota reservation and is requeued, excluding its flavors for the timeout duration.
+	// - `Reject`: the check is set to Rejected, which deactivates the Workload.
+	// - `Skip`: the check is set to Ready, so the Workload is admitted without it.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=Retry;Reject;Skip
+	TimeoutPolicy *AdmissionCheckTimeoutPolicy `json:"timeoutPolicy,omitempty"`
 }
 
+type AdmissionCheckTimeoutPolicy string
+
+const (
+	// AdmissionCheckTimeoutRetry sets the timed out AdmissionCheck to Retry.
+	AdmissionCheckTimeoutRetry AdmissionCheckTimeoutPolicy = "Retry"
+
+	// AdmissionCheckTimeoutReject sets the timed out AdmissionCheck to Rejected.
+	AdmissionCheckTimeoutReject AdmissionCheckTimeoutPolicy = "Reject"
+
+	// AdmissionCheckTimeoutSkip sets the timed out AdmissionCheck to Ready.
+	AdmissionCheckTimeoutSkip AdmissionCheckTimeoutPolicy = "Skip"
+)
+
 type QueueingStrategy string
 
 const (
@@ -256,6 +467,84 @@ type ResourceQuota struct {
 	// This field is in beta stage and is enabled by default.
 	// +optional
 	LendingLimit *resource.Quantity `json:"lendingLimit,omitempty"`
+
+	// peerBorrowingLimits caps the amount of quota for the [flavor, resource]
+	// combination that this ClusterQueue is allowed to borrow from specific
+	// ClusterQueues in the same cohort. A peer lending more than its limit
+	// only contributes up to the limit to the capacity this ClusterQueue can
+	// borrow; a limit of 0 means that this ClusterQueue never borrows the
+	// quota of the peer. Peers which are not listed are not limited.
+	// peerBorrowingLimits must be empty if spec.cohort is empty, and it is not
+	// supported in Cohorts.
+	// This field is in alpha stage, and requires the PeerBorrowingLimits
+	// feature gate to be enabled.
+	// +optional
+	// +listType=map
+	// +listMapKey=clusterQueue
+	// +kubebuilder:validation:MaxItems=16
+	PeerBorrowingLimits []PeerBorrowingLimit `json:"peerBorrowingLimits,omitempty"`
+
+	// overcommitPercent is the factor, in percent, applied to the nominalQuota
+	// when admitting workloads, to account for workloads which request more
+	// resources than they use. For example, with a nominalQuota of 10 CPUs and
+	// an overcommitPercent of 150, workloads requesting up to 15 CPUs can be
+	// admitted. The borrowingLimit and lendingLimit are not affected.
+	// The value is static: it is not adjusted from the observed utilization.
+	// This field is in alpha stage, and requires the FlavorQuotaOvercommit
+	// feature gate to be enabled.
+	// +optional
+	// +kubebuilder:validation:Minimum=100
+	// +kubebuilder:validation:Maximum=1000
+	OvercommitPercent *int32 `json:"overcommitPercent,omitempty"`
+
+	// reservedHeadroom is the amount of the quota which is kept unused by
+	// the workloads with a priority lower than the headroomPriorityThreshold
+	// of the ClusterQueue, so that the workloads with a higher priority can
+	// be admitted without preempting other workloads.
+	// It must be lower or equal to the nominalQuota, and it requires the
+	// headroomPriorityThreshold of the ClusterQueue to be set.
+	// This field is in alpha stage, and requires the ReservedHeadroom
+	// feature gate to be enabled.
+	// +optional
+	ReservedHeadroom *resource.Quantity `json:"reservedHeadroom,omitempty"`
+
+	// maxRatio limits the usage of this resource in the flavor, by the
+	// workloads admitted by the ClusterQueue, to a quantity per unit of the
+	// usage of another resource of the flavor. For example, a maxRatio of 4
+	// CPUs per GPU keeps the workloads requesting many CPUs from using the
+	// CPUs needed by the workloads requesting GPUs in the same nodes.
+	// The other resource must be covered by the same resource group.
+	// maxRatio is not supported in Cohorts.
+	// This field is in alpha stage, and requires the FlavorResourceRatios
+	// feature gate to be enabled.
+	// +optional
+	MaxRatio *ResourceRatio `json:"maxRatio,omitempty"`
+}
+
+// ResourceRatio is the maximum quantity of a resource per unit of another
+// resource.
+type ResourceRatio struct {
+	// perResource is the name of the resource the ratio is relative to.
+	// +required
+	PerResource corev1.ResourceName `json:"perResource"`
+
+	// quantity is the maximum quantity of the resource per unit of the
+	// perResource. It must be non-negative.
+	// +required
+	Quantity resource.Quantity `json:"quantity"`
+}
+
+// PeerBorrowingLimit is the maximum amount of quota a ClusterQueue can borrow
+// from one of its peers in the cohort.
+type PeerBorrowingLimit struct {
+	// clusterQueue is the name of the peer ClusterQueue.
+	// +required
+	ClusterQueue ClusterQueueReference `json:"clusterQueue"`
+
+	// borrowingLimit is the maximum amount of the peer's quota which can be
+	// borrowed. It must be non-negative.
+	// +required
+	BorrowingLimit resource.Quantity `json:"borrowingLimit"`
 }
 
 // ResourceFlavorReference is the name of the ResourceFlavor.
@@ -319,6 +608,33 @@ type ClusterQueueStatus struct {
 	// This is recorded only when Fair Sharing is enabled in the Kueue configuration.
 	// +optional
 	FairSharing *FairSharingStatus `json:"fairSharing,omitempty"`
+
+	// flavorsPhysicalCapacity is the physical capacity, by flavor, of the
+	// ResourceFlavors with nodeLabels of this ClusterQueue.
+	// This is recorded only when the FlavorPhysicalCapacity feature gate is enabled.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	FlavorsPhysicalCapacity []FlavorPhysicalCapacity `json:"flavorsPhysicalCapacity,omitempty"`
+
+	// problems lists each of the problems making the ClusterQueue inactive or
+	// partially functional, while the Active condition only reports the first one.
+	// This is recorded only when the ClusterQueueProblems feature gate is enabled.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Problems []ClusterQueueProblem `json:"problems,omitempty"`
+}
+
+// ClusterQueueProblem is a problem of the configuration of a ClusterQueue.
+type ClusterQueueProblem struct {
+	// reason is the reason of the problem, in CamelCase, like FlavorNotFound
+	// or CohortHasCycle.
+	Reason string `json:"reason"`
+
+	// message is a human readable message of the problem.
+	Message string `json:"message"`
 }
 
 type ClusterQueuePendingWorkloadsStatus struct {
@@ -352,6 +668,31 @@ type FlavorUsage struct {
 	Resources []ResourceUsage `json:"resources"`
 }
 
+type FlavorPhysicalCapacity struct {
+	// name of the flavor.
+	Name ResourceFlavorReference `json:"name"`
+
+	// resources lists the physical capacity for the resources in this flavor.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Resources []ResourcePhysicalCapacity `json:"resources"`
+}
+
+type ResourcePhysicalCapacity struct {
+	// name of the resource
+	Name corev1.ResourceName `json:"name"`
+
+	// allocatable is the allocatable quantity of the resource on the ready
+	// and schedulable Nodes matching the nodeLabels of the flavor.
+	Allocatable resource.Quantity `json:"allocatable"`
+
+	// headroom is the allocatable quantity not used by the Workloads of the
+	// flavor in all the ClusterQueues. It is negative when the usage exceeds
+	// the allocatable quantity.
+	Headroom resource.Quantity `json:"headroom"`
+}
+
 type ResourceUsage struct {
 	// name of the resource
 	Name corev1.ResourceName `json:"name"`
@@ -388,6 +729,13 @@ const (
 	TryNextFlavor FlavorFungibilityPolicy = "TryNextFlavor"
 )
 
+type FlavorOrderPolicy string
+
+const (
+	FlavorOrderDeclared   FlavorOrderPolicy = "Declared"
+	FlavorOrderLowestCost FlavorOrderPolicy = "LowestCost"
+)
+
 // FlavorFungibility determines whether a workload should try the next flavor
 // before borrowing or preempting in current flavor.
 type FlavorFungibility struct {
@@ -412,6 +760,21 @@ type FlavorFungibility struct {
 	// +kubebuilder:validation:Enum={Preempt,TryNextFlavor}
 	// +kubebuilder:default="TryNextFlavor"
 	WhenCanPreempt FlavorFungibilityPolicy `json:"whenCanPreempt,omitempty"`
+
+	// flavorOrder determines the order in which the flavors of the resource
+	// groups are tried. The possible values are:
+	//
+	// - `Declared` (default): try the flavors in the order they are listed
+	//   in the resource groups.
+	// - `LowestCost`: try the flavors in increasing order of the costs
+	//   reported by the flavor cost provider configured in Kueue. The flavors
+	//   without a cost are tried last, in the order they are listed.
+	//
+	// It is only honored when the FlavorCostOrdering feature gate is enabled.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum={Declared,LowestCost}
+	FlavorOrder FlavorOrderPolicy `json:"flavorOrder,omitempty"`
 }
 
 // ClusterQueuePreemption contains policies to preempt Workloads from this
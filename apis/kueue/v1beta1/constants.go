@@ -25,6 +25,10 @@ const (
 	// This gate ensures that Pods do not begin scheduling prematurely, maintaining
 	// proper sequencing in workload processing.
 	ElasticJobSchedulingGate = "kueue.x-k8s.io/elastic-job"
+
+	// WorkloadGroupControllerName is the name used by the workload group
+	// admission check controller.
+	WorkloadGroupControllerName = "kueue.x-k8s.io/workload-group"
 )
 
 type StopPolicy string
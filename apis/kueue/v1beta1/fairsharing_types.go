@@ -55,6 +55,13 @@ type FairSharingStatus struct {
 	// 9223372036854775807, the maximum possible share value.
 	WeightedShare int64 `json:"weightedShare"`
 
+	// decayedUsage is the usage of the ClusterQueue considered for Fair
+	// Sharing, aggregated across flavors, when the usage is configured to
+	// decay over time with the fairSharing.usageHalfLifeTime.
+	// It is not set for Cohorts.
+	// +optional
+	DecayedUsage corev1.ResourceList `json:"decayedUsage,omitempty"`
+
 	// admissionFairSharingStatus represents information relevant to the Admission Fair Sharing
 	// +optional
 	AdmissionFairSharingStatus *AdmissionFairSharingStatus `json:"admissionFairSharingStatus,omitempty"`
@@ -0,0 +1,109 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// HTTPAdmissionCheckControllerName is the name used by the HTTP
+	// admission check controller.
+	HTTPAdmissionCheckControllerName = "kueue.x-k8s.io/http"
+)
+
+// HTTPAdmissionCheckConfigSpec defines the desired state of HTTPAdmissionCheckConfig
+type HTTPAdmissionCheckConfigSpec struct {
+	// url is the HTTPS endpoint called with the Workloads pending on the
+	// admission check. The endpoint responds with the state of the check
+	// for the Workload.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^https://`
+	URL string `json:"url"`
+
+	// caBundle is a PEM encoded CA bundle used to verify the certificate of
+	// the endpoint. If empty, the system trust roots are used.
+	//
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// clientCertificateSecretRef references a Secret of type kubernetes.io/tls
+	// holding the client certificate and key presented to the endpoint, for
+	// mutual TLS.
+	//
+	// +optional
+	ClientCertificateSecretRef *HTTPAdmissionCheckSecretReference `json:"clientCertificateSecretRef,omitempty"`
+
+	// timeout is the timeout of each request to the endpoint.
+	//
+	// Defaults to 10s.
+	// +optional
+	// +kubebuilder:default="10s"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// retryPeriod is the time between the requests for a Workload while the
+	// endpoint responds with Pending or the requests fail.
+	//
+	// Defaults to 1m.
+	// +optional
+	// +kubebuilder:default="1m"
+	RetryPeriod *metav1.Duration `json:"retryPeriod,omitempty"`
+}
+
+// HTTPAdmissionCheckSecretReference references a Secret.
+type HTTPAdmissionCheckSecretReference struct {
+	// name of the Secret.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// namespace of the Secret. It must be the namespace Kueue runs in.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster
+
+// HTTPAdmissionCheckConfig is the Schema for the httpadmissioncheckconfigs API
+type HTTPAdmissionCheckConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec HTTPAdmissionCheckConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HTTPAdmissionCheckConfigList contains a list of HTTPAdmissionCheckConfig
+type HTTPAdmissionCheckConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HTTPAdmissionCheckConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&HTTPAdmissionCheckConfig{}, &HTTPAdmissionCheckConfigList{})
+}
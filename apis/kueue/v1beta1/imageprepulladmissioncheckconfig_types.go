@@ -0,0 +1,75 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ImagePrePullAdmissionCheckControllerName is the name used by the image
+	// pre-pull admission check controller.
+	ImagePrePullAdmissionCheckControllerName = "kueue.x-k8s.io/image-prepull"
+)
+
+// ImagePrePullAdmissionCheckConfigSpec defines the desired state of ImagePrePullAdmissionCheckConfig
+type ImagePrePullAdmissionCheckConfigSpec struct {
+	// command is the command run by the init containers pulling the images
+	// of the Workloads. It must exist in the images, and exit successfully.
+	//
+	// Defaults to ["/bin/sh", "-c", "exit 0"].
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=8
+	Command []string `json:"command,omitempty"`
+
+	// pauseImage is the image of the container of the pre-pull Pods, which
+	// keeps them running once the images are pulled.
+	//
+	// Defaults to registry.k8s.io/pause:3.10.
+	// +optional
+	// +kubebuilder:default="registry.k8s.io/pause:3.10"
+	// +kubebuilder:validation:MaxLength=512
+	PauseImage *string `json:"pauseImage,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster
+
+// ImagePrePullAdmissionCheckConfig is the Schema for the imageprepulladmissioncheckconfigs API
+type ImagePrePullAdmissionCheckConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ImagePrePullAdmissionCheckConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImagePrePullAdmissionCheckConfigList contains a list of ImagePrePullAdmissionCheckConfig
+type ImagePrePullAdmissionCheckConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImagePrePullAdmissionCheckConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ImagePrePullAdmissionCheckConfig{}, &ImagePrePullAdmissionCheckConfigList{})
+}
@@ -0,0 +1,96 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ImageVerificationAdmissionCheckControllerName is the name used by the
+	// image verification admission check controller.
+	ImageVerificationAdmissionCheckControllerName = "kueue.x-k8s.io/image-verification"
+
+	// RegistryImageVerifier is the name of the built-in verifier accepting the
+	// images of the allowed registries.
+	RegistryImageVerifier = "Registry"
+)
+
+// ImageVerificationAdmissionCheckConfigSpec defines the desired state of ImageVerificationAdmissionCheckConfig
+type ImageVerificationAdmissionCheckConfigSpec struct {
+	// verifier is the name of the verifier of the images of the Workloads,
+	// for example a verifier checking the signatures and attestations of the
+	// images against a policy. The built-in Registry verifier accepts the
+	// images of the allowed registries.
+	//
+	// Defaults to Registry.
+	// +optional
+	// +kubebuilder:default=Registry
+	// +kubebuilder:validation:MaxLength=63
+	Verifier string `json:"verifier,omitempty"`
+
+	// allowedRegistries is the list of the prefixes of the images accepted
+	// by the Registry verifier, e.g. "registry.example.com/" or
+	// "quay.io/my-org/". The prefixes match on a path boundary of the
+	// images, normalized with the implicit docker.io registry, e.g.
+	// "ubuntu" is matched as "docker.io/library/ubuntu".
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=64
+	AllowedRegistries []string `json:"allowedRegistries,omitempty"`
+
+	// requireDigest makes the Registry verifier reject the images which are
+	// not referenced by digest.
+	//
+	// +optional
+	RequireDigest bool `json:"requireDigest,omitempty"`
+
+	// parameters are passed to the verifier, for example the name of the
+	// policy the images are verified against.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxProperties=32
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster
+
+// ImageVerificationAdmissionCheckConfig is the Schema for the imageverificationadmissioncheckconfigs API
+type ImageVerificationAdmissionCheckConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ImageVerificationAdmissionCheckConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageVerificationAdmissionCheckConfigList contains a list of ImageVerificationAdmissionCheckConfig
+type ImageVerificationAdmissionCheckConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageVerificationAdmissionCheckConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ImageVerificationAdmissionCheckConfig{}, &ImageVerificationAdmissionCheckConfigList{})
+}
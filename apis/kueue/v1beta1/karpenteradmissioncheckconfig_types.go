@@ -0,0 +1,102 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// KarpenterAdmissionCheckControllerName is the name used by the Karpenter
+	// admission check controller.
+	KarpenterAdmissionCheckControllerName = "kueue.x-k8s.io/karpenter"
+)
+
+// KarpenterAdmissionCheckConfigSpec defines the desired state of KarpenterAdmissionCheckConfig
+type KarpenterAdmissionCheckConfigSpec struct {
+	// nodePoolName is the name of the Karpenter NodePool the NodeClaims
+	// are created for.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=253
+	NodePoolName string `json:"nodePoolName"`
+
+	// nodeClassRef references the Karpenter NodeClass of the NodeClaims.
+	// If empty, the NodeClass of the NodePool is used.
+	//
+	// +optional
+	NodeClassRef *KarpenterNodeClassReference `json:"nodeClassRef,omitempty"`
+
+	// maxNodeClaims is the maximum number of NodeClaims created for a
+	// Workload. A NodeClaim is created for each Pod of the Workload, and
+	// the Workloads with more Pods are rejected.
+	//
+	// Defaults to 64.
+	// +optional
+	// +kubebuilder:default=64
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	MaxNodeClaims *int32 `json:"maxNodeClaims,omitempty"`
+}
+
+// KarpenterNodeClassReference references a Karpenter NodeClass.
+type KarpenterNodeClassReference struct {
+	// group of the NodeClass, for example karpenter.k8s.aws.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=253
+	Group string `json:"group"`
+
+	// kind of the NodeClass, for example EC2NodeClass.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=63
+	Kind string `json:"kind"`
+
+	// name of the NodeClass.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster
+
+// KarpenterAdmissionCheckConfig is the Schema for the karpenteradmissioncheckconfigs API
+type KarpenterAdmissionCheckConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec KarpenterAdmissionCheckConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KarpenterAdmissionCheckConfigList contains a list of KarpenterAdmissionCheckConfig
+type KarpenterAdmissionCheckConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KarpenterAdmissionCheckConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KarpenterAdmissionCheckConfig{}, &KarpenterAdmissionCheckConfigList{})
+}
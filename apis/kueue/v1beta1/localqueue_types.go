@@ -54,6 +54,15 @@ type LocalQueueSpec struct {
 	// if AdmissionFairSharing is enabled in the Kueue configuration.
 	// +optional
 	FairSharing *FairSharing `json:"fairSharing,omitempty"`
+
+	// admissionTarget is the default target time for the pending workloads of
+	// the LocalQueue to reserve quota, overridden by the
+	// "kueue.x-k8s.io/admission-target" annotation of the workloads. The
+	// workloads pending for longer than their target get the SLOBreached
+	// condition.
+	// This field requires enabling the WorkloadAdmissionSLO feature gate.
+	// +optional
+	AdmissionTarget *metav1.Duration `json:"admissionTarget,omitempty"`
 }
 
 type LocalQueueFlavorStatus struct {
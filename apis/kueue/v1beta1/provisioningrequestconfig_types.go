@@ -32,18 +32,39 @@ const (
 	IdenticalPodTemplates                   ProvisioningRequestConfigPodSetMergePolicy = "IdenticalPodTemplates"
 )
 
+type ProvisioningRequestFailurePolicy string
+
+const (
+	// ProvisioningRequestFailurePolicyReject sets the admission check to Rejected,
+	// which deactivates the Workload.
+	ProvisioningRequestFailurePolicyReject ProvisioningRequestFailurePolicy = "Reject"
+
+	// ProvisioningRequestFailurePolicyAdmit sets the admission check to Ready, so
+	// the Workload is admitted without the provisioned capacity.
+	ProvisioningRequestFailurePolicyAdmit ProvisioningRequestFailurePolicy = "Admit"
+)
+
 // ProvisioningRequestConfigSpec defines the desired state of ProvisioningRequestConfig
 type ProvisioningRequestConfigSpec struct {
 	// ProvisioningClassName describes the different modes of provisioning the resources.
 	// Check autoscaling.x-k8s.io ProvisioningRequestSpec.ProvisioningClassName for details.
 	//
+	// With the ProvisioningRequestConfigTemplates feature gate, it can reference
+	// the labels and annotations of the Workloads, as ${label:<key>} and
+	// ${annotation:<key>}, optionally with a default value used when the Workload
+	// doesn't have it, as ${label:<key>:<default>}.
+	//
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*|.*\$\{(label|annotation):[^}]+\}.*)$`
 	// +kubebuilder:validation:MaxLength=253
 	ProvisioningClassName string `json:"provisioningClassName"`
 
 	// Parameters contains all other parameters classes may require.
 	//
+	// With the ProvisioningRequestConfigTemplates feature gate, the values can
+	// reference the labels and annotations of the Workloads, like the
+	// provisioningClassName.
+	//
 	// +optional
 	// +kubebuilder:validation:MaxProperties=100
 	Parameters map[string]Parameter `json:"parameters,omitempty"`
@@ -88,6 +109,40 @@ type ProvisioningRequestConfigSpec struct {
 	// +optional
 	// +kubebuilder:validation:Enum=IdenticalPodTemplates;IdenticalWorkloadSchedulingRequirements
 	PodSetMergePolicy *ProvisioningRequestConfigPodSetMergePolicy `json:"podSetMergePolicy,omitempty"`
+
+	// consolidation configures the consolidation of the ProvisioningRequests of
+	// multiple workloads, from the same namespace and assigned the same flavors,
+	// into a single ProvisioningRequest with the PodSets of all of them.
+	// Only the first ProvisioningRequest of a workload is consolidated, its retries
+	// are not.
+	// This field is in alpha stage. To enable this field, enable the
+	// ProvisioningRequestConsolidation feature gate.
+	//
+	// +optional
+	Consolidation *ProvisioningRequestConsolidation `json:"consolidation,omitempty"`
+}
+
+// ProvisioningRequestConsolidation defines how the ProvisioningRequests of
+// multiple workloads are consolidated.
+type ProvisioningRequestConsolidation struct {
+	// window is the time a workload waits for other workloads to consolidate
+	// with, before the ProvisioningRequest is created.
+	//
+	// +required
+	Window metav1.Duration `json:"window"`
+
+	// maxWorkloads is the maximum number of workloads consolidated into a
+	// ProvisioningRequest. The ProvisioningRequest is created as soon as it is
+	// reached, without waiting for the end of the window. Since a
+	// ProvisioningRequest has at most 32 PodSets, fewer workloads are
+	// consolidated when they have multiple PodSets.
+	//
+	// Defaults to 16.
+	// +optional
+	// +kubebuilder:default=16
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=32
+	MaxWorkloads *int32 `json:"maxWorkloads,omitempty"`
 }
 
 type ProvisioningRequestPodSetUpdates struct {
@@ -148,6 +203,20 @@ type ProvisioningRequestRetryStrategy struct {
 	// +optional
 	// +kubebuilder:default=1800
 	BackoffMaxSeconds *int32 `json:"backoffMaxSeconds,omitempty"`
+
+	// failurePolicy defines the outcome for the workload once the ProvisioningRequest
+	// failed, or its booking expired, and the backoffLimitCount is reached.
+	// Possible values are:
+	//
+	// - `Reject` (default): the admission check is set to Rejected, which deactivates the workload.
+	// - `Admit`: the admission check is set to Ready, so the workload is admitted
+	//   without the provisioned capacity, relying on the existing nodes.
+	//
+	// This field is in alpha stage. To enable this field, enable the
+	// ProvisioningRequestFailurePolicy feature gate.
+	// +optional
+	// +kubebuilder:validation:Enum=Reject;Admit
+	FailurePolicy *ProvisioningRequestFailurePolicy `json:"failurePolicy,omitempty"`
 }
 
 // Parameter is limited to 255 characters.
@@ -0,0 +1,136 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReservationSpec defines the desired state of Reservation
+// +kubebuilder:validation:XValidation:rule="self.endTime > self.startTime", message="endTime must be after startTime"
+type ReservationSpec struct {
+	// clusterQueue is the name of the ClusterQueue in which the capacity
+	// is reserved.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="field is immutable"
+	ClusterQueue ClusterQueueReference `json:"clusterQueue"`
+
+	// holder identifies the workloads which are allowed to use the reserved
+	// capacity during the reservation window. During the window, the scheduler
+	// considers the holder's workloads before the workloads of the other
+	// ClusterQueues of the cohort. Within the ClusterQueue, the pending workloads
+	// keep their usual order, so with the StrictFIFO queueing strategy, a workload
+	// of another user at the head of the queue blocks the holder's workloads.
+	// +required
+	Holder ReservationHolder `json:"holder"`
+
+	// startTime is the beginning of the reservation window.
+	// +required
+	StartTime metav1.Time `json:"startTime"`
+
+	// endTime is the end of the reservation window.
+	// +required
+	EndTime metav1.Time `json:"endTime"`
+
+	// flavors is the list of resources reserved per ResourceFlavor.
+	// While the reservation is active, the scheduler doesn't admit workloads
+	// of other users if that would leave less free capacity in the
+	// ClusterQueue than reserved, and not yet used by the holder's workloads.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Flavors []ReservedFlavor `json:"flavors"`
+}
+
+// ReservationHolder identifies the owner of a Reservation.
+type ReservationHolder struct {
+	// namespace is the namespace of the holder's workloads.
+	// +required
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	Namespace string `json:"namespace"`
+
+	// localQueue restricts the holder to the workloads submitted to the
+	// LocalQueue with this name, in the holder's namespace. If not set, all
+	// the workloads in the namespace are considered as owned by the holder.
+	// +optional
+	LocalQueue *LocalQueueName `json:"localQueue,omitempty"`
+}
+
+// ReservedFlavor is the amount of resources reserved in a ResourceFlavor.
+type ReservedFlavor struct {
+	// name of the ResourceFlavor.
+	// +required
+	Name ResourceFlavorReference `json:"name"`
+
+	// resources is the amount of each resource reserved in the flavor.
+	// +required
+	Resources corev1.ResourceList `json:"resources"`
+}
+
+// ReservationStatus defines the observed state of Reservation
+type ReservationStatus struct {
+	// conditions hold the latest available observations of the Reservation
+	// current state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+const (
+	// ReservationActive indicates that the reservation window is open and
+	// the reserved capacity is kept for the holder's workloads.
+	ReservationActive string = "Active"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="ClusterQueue",JSONPath=".spec.clusterQueue",type=string,description="Name of the ClusterQueue in which the capacity is reserved"
+// +kubebuilder:printcolumn:name="Start",JSONPath=".spec.startTime",type=date,description="Beginning of the reservation window"
+// +kubebuilder:printcolumn:name="End",JSONPath=".spec.endTime",type=date,description="End of the reservation window"
+// +kubebuilder:printcolumn:name="Active",JSONPath=".status.conditions[?(@.type=='Active')].status",type=string,description="Whether the reservation window is open"
+
+// Reservation is the Schema for the reservations API
+type Reservation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReservationSpec   `json:"spec,omitempty"`
+	Status ReservationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReservationList contains a list of Reservation
+type ReservationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Reservation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Reservation{}, &ReservationList{})
+}
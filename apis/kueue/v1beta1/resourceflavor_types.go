@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -25,6 +26,7 @@ import (
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
+// +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,shortName={flavor,flavors,rf}
 
 // ResourceFlavor is the Schema for the resourceflavors API.
@@ -32,9 +34,38 @@ type ResourceFlavor struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec ResourceFlavorSpec `json:"spec,omitempty"`
+	Spec   ResourceFlavorSpec   `json:"spec,omitempty"`
+	Status ResourceFlavorStatus `json:"status,omitempty"`
 }
 
+// ResourceFlavorStatus defines the observed state of the ResourceFlavor
+type ResourceFlavorStatus struct {
+	// conditions hold the latest available observations of the ResourceFlavor
+	// current state.
+	//
+	// The conditions are only set when the FlavorNodeDriftDetection feature
+	// gate is enabled.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+const (
+	// ResourceFlavorNodesAvailable indicates whether there are schedulable
+	// Nodes matching the nodeLabels of the ResourceFlavor, with no taints
+	// other than its nodeTaints, or the taints tolerated by its tolerations.
+	ResourceFlavorNodesAvailable = "NodesAvailable"
+
+	// ResourceFlavorNodesAvailable condition reasons.
+	ResourceFlavorNodesAvailableReasonNodesFound      = "NodesFound"
+	ResourceFlavorNodesAvailableReasonNoMatchingNodes = "NoMatchingNodes"
+)
+
 // TopologyReference is the name of the Topology.
 // +kubebuilder:validation:MaxLength=253
 // +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$"
@@ -103,6 +134,53 @@ type ResourceFlavorSpec struct {
 	//
 	// +optional
 	TopologyName *TopologyReference `json:"topologyName,omitempty"`
+
+	// resourcePartitions are the resources that the nodes associated with this
+	// ResourceFlavor offer as partitions of larger devices, for example the
+	// MIG slices that the NVIDIA device plugin advertises as nvidia.com/gpu
+	// with the single MIG strategy.
+	// The requests of these resources are accounted in the quota of this
+	// ResourceFlavor as the fraction of the device that a partition represents,
+	// which lets a ClusterQueue define quotas in device-equivalents across the
+	// ResourceFlavors of full and partitioned devices.
+	//
+	// This field is used only when the FlavorResourcePartitions feature gate
+	// is enabled.
+	//
+	// resourcePartitions can be up to 16 elements.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	ResourcePartitions []ResourcePartition `json:"resourcePartitions,omitempty"`
+
+	// podOverhead is the quantity of resources added to the requests of each
+	// pod assigned to this ResourceFlavor in the quota computation, such as
+	// the overhead of the runtime class or the resources reserved for the
+	// DaemonSets running in each node. It makes the nominal quotas map to the
+	// capacity of the nodes available for the workloads.
+	// The overhead is only added for the resources requested by the pods.
+	//
+	// This field is used only when the FlavorPodOverhead feature gate is
+	// enabled.
+	//
+	// +optional
+	PodOverhead corev1.ResourceList `json:"podOverhead,omitempty"`
+}
+
+// ResourcePartition describes a resource offered as a partition of a device.
+type ResourcePartition struct {
+	// name of the resource.
+	// +required
+	Name corev1.ResourceName `json:"name"`
+
+	// fraction of the device that a unit of the resource represents, for
+	// example 142m for a 1g.5gb MIG slice of an NVIDIA A100 GPU, which can be
+	// partitioned into 7 slices.
+	// The fraction must be greater than 0 and not greater than 1.
+	// +required
+	Fraction resource.Quantity `json:"fraction"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,141 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// SlurmAdmissionCheckControllerName is the name used by the Slurm
+	// admission check controller.
+	SlurmAdmissionCheckControllerName = "kueue.x-k8s.io/slurm"
+
+	// SlurmJobIDAnnotation is the annotation of the Workloads holding the ID
+	// of the Slurm job running the Workload.
+	SlurmJobIDAnnotation = "kueue.x-k8s.io/slurm-job-id"
+)
+
+// SlurmAdmissionCheckConfigSpec defines the desired state of SlurmAdmissionCheckConfig
+type SlurmAdmissionCheckConfigSpec struct {
+	// url is the base URL of the slurmrestd REST API of the Slurm cluster
+	// the Workloads are dispatched to.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// apiVersion is the version of the Slurm REST API.
+	//
+	// Defaults to v0.0.40.
+	// +optional
+	// +kubebuilder:default="v0.0.40"
+	// +kubebuilder:validation:Pattern=`^v[0-9]+\.[0-9]+\.[0-9]+$`
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// tokenSecretRef references a Secret holding the user name, in the `user`
+	// key, and the JWT, in the `token` key, used to authenticate to slurmrestd.
+	//
+	// +required
+	TokenSecretRef SlurmAdmissionCheckSecretReference `json:"tokenSecretRef"`
+
+	// caBundle is a PEM encoded CA bundle used to verify the certificate of
+	// slurmrestd. If empty, the system trust roots are used.
+	//
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// partition is the Slurm partition the jobs are submitted to. If empty,
+	// the default partition of the Slurm cluster is used.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	Partition string `json:"partition,omitempty"`
+
+	// account is the Slurm account the jobs are charged to. If empty, the
+	// default account of the user is used.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	Account string `json:"account,omitempty"`
+
+	// workingDirectory is the working directory of the jobs on the Slurm nodes.
+	//
+	// Defaults to /tmp.
+	// +optional
+	// +kubebuilder:default="/tmp"
+	// +kubebuilder:validation:MaxLength=4096
+	WorkingDirectory string `json:"workingDirectory,omitempty"`
+
+	// timeout is the timeout of each request to slurmrestd.
+	//
+	// Defaults to 10s.
+	// +optional
+	// +kubebuilder:default="10s"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// pollInterval is the time between the requests for the state of the
+	// Slurm job of a Workload, and between the retries of the failed requests.
+	//
+	// Defaults to 30s.
+	// +optional
+	// +kubebuilder:default="30s"
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+}
+
+// SlurmAdmissionCheckSecretReference references a Secret.
+type SlurmAdmissionCheckSecretReference struct {
+	// name of the Secret.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// namespace of the Secret.
+	//
+	// +required
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster
+
+// SlurmAdmissionCheckConfig is the Schema for the slurmadmissioncheckconfigs API
+type SlurmAdmissionCheckConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SlurmAdmissionCheckConfigSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SlurmAdmissionCheckConfigList contains a list of SlurmAdmissionCheckConfig
+type SlurmAdmissionCheckConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SlurmAdmissionCheckConfig `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SlurmAdmissionCheckConfig{}, &SlurmAdmissionCheckConfigList{})
+}
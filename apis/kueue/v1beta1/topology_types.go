@@ -59,6 +59,39 @@ const (
 	// is defined.
 	PodSetSliceSizeAnnotation = "kueue.x-k8s.io/podset-slice-size"
 
+	// PodSetExclusiveTopologyAnnotation indicates that a PodSet requires
+	// exclusive ownership of the topology domains, at the topology level
+	// indicated by the annotation value (e.g. a rack or a host), in which its
+	// pods are placed. No other workload admitted by TAS is placed in these
+	// domains while the PodSet is running, and the PodSet is only placed in
+	// domains which are not used by other workloads admitted by TAS.
+	//
+	// The annotation can only be used along with one of the
+	// `kueue.x-k8s.io/podset-required-topology`,
+	// `kueue.x-k8s.io/podset-preferred-topology` or
+	// `kueue.x-k8s.io/podset-unconstrained-topology` annotations.
+	PodSetExclusiveTopologyAnnotation = "kueue.x-k8s.io/podset-exclusive-topology"
+
+	// PodSetBalancedTopologyAnnotation indicates the topology level (e.g. a
+	// rack) across which the pods of a PodSet are balanced. The pods are
+	// spread evenly over the number of domains of this level indicated by the
+	// `kueue.x-k8s.io/podset-balanced-domain-count` annotation, and packed
+	// within each of these domains.
+	//
+	// The annotation can only be used along with one of the
+	// `kueue.x-k8s.io/podset-required-topology`,
+	// `kueue.x-k8s.io/podset-preferred-topology` or
+	// `kueue.x-k8s.io/podset-unconstrained-topology` annotations, and not
+	// along with the podset slice annotations.
+	PodSetBalancedTopologyAnnotation = "kueue.x-k8s.io/podset-balanced-topology"
+
+	// PodSetBalancedDomainCountAnnotation indicates the number of topology
+	// domains, at the level indicated by the
+	// `kueue.x-k8s.io/podset-balanced-topology` annotation, across which the
+	// pods of a PodSet are balanced. The number of pods of the PodSet must be
+	// a multiple of the number of domains.
+	PodSetBalancedDomainCountAnnotation = "kueue.x-k8s.io/podset-balanced-domain-count"
+
 	// TopologySchedulingGate is used to delay scheduling of a Pod until the
 	// nodeSelectors corresponding to the assigned topology domain are injected
 	// into the Pod. For the Pod-based integrations the gate is added in webhook
@@ -70,6 +103,14 @@ const (
 	// annotation is set when starting the Job, and removed on stopping the Job.
 	WorkloadAnnotation = "kueue.x-k8s.io/workload"
 
+	// TopologyAssignmentAnnotation is an annotation set on the objects of the
+	// MultiKueue external frameworks, on the manager and worker clusters, with
+	// the topology assignments of the PodSets of the Workload, encoded in JSON
+	// as a map of the PodSet names to their TopologyAssignment. The controllers
+	// of the frameworks use it to place the Pods of the PodSets on the assigned
+	// topology domains.
+	TopologyAssignmentAnnotation = "kueue.x-k8s.io/topology-assignment"
+
 	// TASLabel is a label set on the Job's PodTemplate to indicate that the
 	// PodSet is admitted using TopologyAwareScheduling, and all Pods created
 	// from the Job's PodTemplate also have the label. For the Pod-based
@@ -92,7 +133,26 @@ const (
 	PodSetGroupName = "kueue.x-k8s.io/podset-group-name"
 )
 
+// TopologyPlacementPolicy is the policy used to select the topology domains
+// of the PodSets admitted with Topology Aware Scheduling.
+// +kubebuilder:validation:Enum=BestFit;LeastFragmentation
+type TopologyPlacementPolicy string
+
+const (
+	// TopologyPlacementPolicyBestFit selects the domains with the most free
+	// capacity, or the domains with the least free capacity when the
+	// TASProfileLeastFreeCapacity or TASProfileMixed feature gates are enabled,
+	// and the domain fitting best the rest of the PodSet.
+	TopologyPlacementPolicyBestFit TopologyPlacementPolicy = "BestFit"
+
+	// TopologyPlacementPolicyLeastFragmentation fills the domains already used
+	// by TAS Workloads, with the least free capacity first, before using the
+	// domains without TAS Workloads.
+	TopologyPlacementPolicyLeastFragmentation TopologyPlacementPolicy = "LeastFragmentation"
+)
+
 // TopologySpec defines the desired state of Topology
+// +kubebuilder:validation:XValidation:rule="!has(self.domainHealth) || !has(self.domainHealth.level) || self.levels.exists(l, l.nodeLabel == self.domainHealth.level)",message="domainHealth.level must be one of the levels"
 type TopologySpec struct {
 	// levels define the levels of topology.
 	//
@@ -104,6 +164,76 @@ type TopologySpec struct {
 	// +kubebuilder:validation:XValidation:rule="size(self.filter(i, size(self.filter(j, j == i)) > 1)) == 0",message="must be unique"
 	// +kubebuilder:validation:XValidation:rule="size(self.filter(i, i.nodeLabel == 'kubernetes.io/hostname')) == 0 || self[size(self) - 1].nodeLabel == 'kubernetes.io/hostname'",message="the kubernetes.io/hostname label can only be used at the lowest level of topology"
 	Levels []TopologyLevel `json:"levels,omitempty"`
+
+	// placementPolicy is the policy used to select the topology domains of the
+	// PodSets admitted on the ResourceFlavors of the topology. It can be
+	// overridden by the topologyPlacementPolicy of the ClusterQueues.
+	//
+	// The possible values are:
+	// - `BestFit` (default) selects the domains with the most free capacity, and
+	//   the domain fitting best the rest of the PodSet.
+	// - `LeastFragmentation` fills the domains already used by TAS Workloads,
+	//   with the least free capacity first, before using the other domains.
+	//
+	// This field is honored only when the TASPlacementPolicy feature gate is enabled.
+	//
+	// +optional
+	PlacementPolicy *TopologyPlacementPolicy `json:"placementPolicy,omitempty"`
+
+	// domainHealth configures the exclusion of the unhealthy topology domains
+	// from the assignments of the PodSets admitted on the ResourceFlavors of
+	// the topology.
+	//
+	// This field is honored only when the TASUnhealthyDomainExclusion feature
+	// gate is enabled.
+	//
+	// +optional
+	DomainHealth *TopologyDomainHealth `json:"domainHealth,omitempty"`
+}
+
+// TopologyDomainEvictionPolicy defines whether the Workloads placed in the
+// unhealthy topology domains are evicted.
+// +kubebuilder:validation:Enum=Never;Evict
+type TopologyDomainEvictionPolicy string
+
+const (
+	// TopologyDomainEvictionPolicyNever keeps the Workloads placed in the
+	// unhealthy domains running.
+	TopologyDomainEvictionPolicyNever TopologyDomainEvictionPolicy = "Never"
+	// TopologyDomainEvictionPolicyEvict evicts the Workloads placed in the
+	// unhealthy domains, so that they are placed again in healthy domains.
+	TopologyDomainEvictionPolicyEvict TopologyDomainEvictionPolicy = "Evict"
+)
+
+// TopologyDomainHealth defines when the topology domains are unhealthy.
+type TopologyDomainHealth struct {
+	// level is the nodeLabel of the topology level of the domains excluded
+	// from the new assignments when one of their nodes is unhealthy. For
+	// example, with the level of the racks, all the nodes of a rack are
+	// excluded when one of its nodes is unhealthy.
+	// Defaults to the lowest level of the topology, for which only the
+	// unhealthy nodes are excluded.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=316
+	Level *string `json:"level,omitempty"`
+
+	// unhealthyNodeTaintKeys are the keys of the taints marking the nodes as
+	// unhealthy, in addition to the nodes which are not ready.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=8
+	UnhealthyNodeTaintKeys []string `json:"unhealthyNodeTaintKeys,omitempty"`
+
+	// evictionPolicy defines whether the admitted Workloads with pods placed
+	// in the unhealthy domains are evicted. The possible values are:
+	// - `Never` (default) keeps the Workloads running.
+	// - `Evict` evicts the Workloads, so that they are placed again in
+	//   healthy domains.
+	//
+	// +optional
+	EvictionPolicy *TopologyDomainEvictionPolicy `json:"evictionPolicy,omitempty"`
 }
 
 // TopologyLevel defines the desired state of TopologyLevel
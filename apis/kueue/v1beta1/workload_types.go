@@ -21,6 +21,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // WorkloadSpec defines the desired state of Workload
@@ -84,6 +85,19 @@ type WorkloadSpec struct {
 	// +optional
 	// +kubebuilder:validation:Minimum=1
 	MaximumExecutionTimeSeconds *int32 `json:"maximumExecutionTimeSeconds,omitempty"`
+
+	// held parks a pending workload: while true, the workload keeps its
+	// position in its queue but is skipped by the scheduler, until held is
+	// set to false or removed. Holding a workload with reserved quota has no
+	// effect on it.
+	//
+	// Changing held requires the update permission on the workloads/hold
+	// subresource, in addition to the permission to update the workload.
+	//
+	// This field requires the WorkloadHold feature gate to be enabled.
+	//
+	// +optional
+	Held *bool `json:"held,omitempty"`
 }
 
 // PodSetTopologyRequest defines the topology request for a PodSet.
@@ -143,6 +157,27 @@ type PodSetTopologyRequest struct {
 	//
 	// +optional
 	PodSetSliceSize *int32 `json:"podSetSliceSize,omitempty"`
+
+	// PodSetExclusiveTopology indicates the topology level at which the PodSet
+	// requires exclusive ownership of the topology domains it is placed in, as
+	// indicated by the `kueue.x-k8s.io/podset-exclusive-topology` annotation.
+	//
+	// +optional
+	PodSetExclusiveTopology *string `json:"podSetExclusiveTopology,omitempty"`
+
+	// PodSetBalancedTopology indicates the topology level across which the
+	// pods of the PodSet are balanced, as indicated by the
+	// `kueue.x-k8s.io/podset-balanced-topology` annotation.
+	//
+	// +optional
+	PodSetBalancedTopology *string `json:"podSetBalancedTopology,omitempty"`
+
+	// PodSetBalancedDomainCount indicates the number of topology domains
+	// across which the pods of the PodSet are balanced, as indicated by the
+	// `kueue.x-k8s.io/podset-balanced-domain-count` annotation.
+	//
+	// +optional
+	PodSetBalancedDomainCount *int32 `json:"podSetBalancedDomainCount,omitempty"`
 }
 
 type Admission struct {
@@ -246,6 +281,16 @@ type PodSetAssignment struct {
 	//
 	// +optional
 	DelayedTopologyRequest *DelayedTopologyRequestState `json:"delayedTopologyRequest,omitempty"`
+
+	// achievedTopologyLevel is the lowest topology level at which a single
+	// topology domain contains all the Pods of the PodSet. It is only set for
+	// the PodSets requesting a preferred topology level, and is either the
+	// preferred level, or a higher level when the preferred level could not be
+	// satisfied. It is missing when the Pods are spread over multiple domains
+	// of the highest topology level.
+	//
+	// +optional
+	AchievedTopologyLevel *string `json:"achievedTopologyLevel,omitempty"`
 }
 
 // DelayedTopologyRequestState indicates the state of the delayed TopologyRequest.
@@ -436,6 +481,294 @@ type WorkloadStatus struct {
 	//
 	// +optional
 	UnhealthyNodes []UnhealthyNode `json:"unhealthyNodes,omitempty"`
+
+	// unschedulableReasons is a structured breakdown of why the workload
+	// couldn't be admitted in the last scheduling cycle, per podSet, flavor
+	// and resource. While the workload has quota reserved, it lists the
+	// AdmissionChecks which are not ready yet. The list is cleared once the
+	// workload is admitted.
+	// Requires enabling the WorkloadUnschedulableReasons feature gate.
+	//
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	UnschedulableReasons []UnschedulableReason `json:"unschedulableReasons,omitempty"`
+
+	// lastAdmittedFlavors records the flavors assigned to the podSets of the
+	// workload when its quota reservation was last released. When the
+	// workload is admitted again, the scheduler tries these flavors first,
+	// as long as they fit without preemption, and places the podSets in the
+	// topology domains of their last topologyAssignment, as long as they fit.
+	// Requires enabling the FlavorStickiness feature gate.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	LastAdmittedFlavors []PodSetFlavors `json:"lastAdmittedFlavors,omitempty"`
+
+	// admissionChecksSummary summarizes the progress of the admission checks
+	// of the workload, as the number of ready checks out of all the checks, and
+	// what the workload waits for, e.g. "1/2 Ready, blocked on prov (Pending)
+	// since 2025-01-01T00:00:00Z".
+	// Requires enabling the AdmissionChecksSummary feature gate.
+	//
+	// +kubebuilder:validation:MaxLength=512
+	// +optional
+	AdmissionChecksSummary string `json:"admissionChecksSummary,omitempty"`
+
+	// excludedFlavors are the flavors the workload can't be assigned until
+	// their expiration time, because its pods stayed unschedulable on them, or
+	// one of its admission checks timed out, when they were last assigned.
+	// This field should not be set by the users.
+	// Requires enabling the FlavorFailover or the AdmissionCheckTimeouts feature gate.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	ExcludedFlavors []ExcludedFlavor `json:"excludedFlavors,omitempty"`
+
+	// requeueHistory records the last evictions of the workload which
+	// released its quota and requeued it, from the oldest to the newest.
+	// Only the last 10 evictions are kept.
+	// Requires enabling the WorkloadRequeueHistory feature gate.
+	//
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	RequeueHistory []RequeueRecord `json:"requeueHistory,omitempty"`
+
+	// actualUsage is the resource usage of the pods of the workload, sampled
+	// while it was admitted, recorded when the workload finishes. It can be
+	// compared to the resourceUsage of the podSetAssignments of the admission,
+	// for example to right-size the requests.
+	// Requires enabling the WorkloadActualUsage feature gate.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	ActualUsage []PodSetActualUsage `json:"actualUsage,omitempty"`
+}
+
+// PodSetActualUsage is the resource usage of the pods of a podSet.
+type PodSetActualUsage struct {
+	// name is the name of the podSet.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Name PodSetReference `json:"name"`
+
+	// peak is the highest total resource usage of the pods of the podSet
+	// among the samples.
+	//
+	// +optional
+	Peak corev1.ResourceList `json:"peak,omitempty"`
+
+	// average is the average total resource usage of the pods of the podSet
+	// over the samples.
+	//
+	// +optional
+	Average corev1.ResourceList `json:"average,omitempty"`
+
+	// samples is the number of samples of the resource usage.
+	//
+	// +optional
+	Samples int32 `json:"samples,omitempty"`
+}
+
+// RequeueRecord is an eviction of a workload.
+type RequeueRecord struct {
+	// time is when the workload was evicted.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Time metav1.Time `json:"time"`
+
+	// reason is the reason of the eviction, the reason of the Evicted
+	// condition, e.g. Preempted or PodsReadyTimeout.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=316
+	Reason string `json:"reason"`
+
+	// underlyingCause is a finer-grained explanation that complements the
+	// reason.
+	//
+	// +optional
+	UnderlyingCause EvictionUnderlyingCause `json:"underlyingCause,omitempty"`
+
+	// message is the human-readable message of the eviction.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message,omitempty"`
+
+	// clusterQueue is the ClusterQueue in which the workload had quota
+	// reserved when it was evicted.
+	//
+	// +optional
+	ClusterQueue ClusterQueueReference `json:"clusterQueue,omitempty"`
+
+	// flavors are the flavors assigned to the podSets of the workload when it
+	// was evicted.
+	//
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	Flavors []PodSetFlavors `json:"flavors,omitempty"`
+
+	// preemptor is the workload which preempted the workload, when the
+	// reason is Preempted.
+	//
+	// +optional
+	Preemptor *RequeueRecordPreemptor `json:"preemptor,omitempty"`
+}
+
+// RequeueRecordPreemptor references the workload which preempted a workload.
+type RequeueRecordPreemptor struct {
+	// namespace of the preempting workload.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace"`
+
+	// name of the preempting workload.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// uid of the preempting workload.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=36
+	UID types.UID `json:"uid,omitempty"`
+
+	// clusterQueue is the ClusterQueue of the preempting workload.
+	//
+	// +optional
+	ClusterQueue ClusterQueueReference `json:"clusterQueue,omitempty"`
+}
+
+// ExcludedFlavor is a flavor excluded for a workload.
+type ExcludedFlavor struct {
+	// name is the name of the excluded flavor.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Name ResourceFlavorReference `json:"name"`
+
+	// expirationTime is the time after which the workload can be assigned
+	// the flavor again.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	ExpirationTime metav1.Time `json:"expirationTime"`
+}
+
+// PodSetFlavors are the flavors assigned to a podSet.
+type PodSetFlavors struct {
+	// name is the name of the podSet.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Name PodSetReference `json:"name"`
+
+	// flavors are the flavors assigned to the podSet for each resource.
+	//
+	// +optional
+	Flavors map[corev1.ResourceName]ResourceFlavorReference `json:"flavors,omitempty"`
+
+	// topologyAssignment is the topology assignment of the podSet, when its
+	// flavor uses Topology Aware Scheduling.
+	//
+	// +optional
+	TopologyAssignment *TopologyAssignment `json:"topologyAssignment,omitempty"`
+}
+
+// UnschedulableReasonType is the programmatic identifier of the reason why a
+// workload couldn't be admitted.
+// +kubebuilder:validation:Enum=InsufficientQuota;BorrowingBlocked;PreemptionRequired;PreemptionInsufficient;FlavorMismatch;TopologyUnavailable;AdmissionCheckPending;ResourceRatioExceeded;InsufficientPhysicalCapacity
+type UnschedulableReasonType string
+
+const (
+	// UnschedulableReasonInsufficientQuota means that the request is bigger
+	// than the nominal quota, plus what can be borrowed in the cohort.
+	UnschedulableReasonInsufficientQuota UnschedulableReasonType = "InsufficientQuota"
+
+	// UnschedulableReasonBorrowingBlocked means that the request only fits
+	// by borrowing quota which is in use in the cohort, and the ClusterQueue
+	// isn't allowed to preempt while borrowing.
+	UnschedulableReasonBorrowingBlocked UnschedulableReasonType = "BorrowingBlocked"
+
+	// UnschedulableReasonPreemptionRequired means that the request fits
+	// after preempting other workloads.
+	UnschedulableReasonPreemptionRequired UnschedulableReasonType = "PreemptionRequired"
+
+	// UnschedulableReasonPreemptionInsufficient means that preempting the
+	// candidate workloads wouldn't free enough quota for the request.
+	UnschedulableReasonPreemptionInsufficient UnschedulableReasonType = "PreemptionInsufficient"
+
+	// UnschedulableReasonFlavorMismatch means that the flavor can't be used
+	// by the podSet, because of taints, node affinity or topology constraints.
+	UnschedulableReasonFlavorMismatch UnschedulableReasonType = "FlavorMismatch"
+
+	// UnschedulableReasonTopologyUnavailable means that the topology domains
+	// of the flavor don't have enough free capacity for the podSet.
+	UnschedulableReasonTopologyUnavailable UnschedulableReasonType = "TopologyUnavailable"
+
+	// UnschedulableReasonAdmissionCheckPending means that the workload has
+	// quota reserved, but the admissionCheck is not ready yet.
+	UnschedulableReasonAdmissionCheckPending UnschedulableReasonType = "AdmissionCheckPending"
+
+	// UnschedulableReasonResourceRatioExceeded means that the usage of the
+	// resource in the flavor would exceed its maxRatio in the ClusterQueue.
+	UnschedulableReasonResourceRatioExceeded UnschedulableReasonType = "ResourceRatioExceeded"
+
+	// UnschedulableReasonInsufficientPhysicalCapacity means that the request
+	// is bigger than the physical headroom of the flavor.
+	UnschedulableReasonInsufficientPhysicalCapacity UnschedulableReasonType = "InsufficientPhysicalCapacity"
+)
+
+type UnschedulableReason struct {
+	// reason is the programmatic identifier of why the workload couldn't be
+	// admitted.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	Reason UnschedulableReasonType `json:"reason"`
+
+	// podSet is the name of the podSet the reason applies to.
+	//
+	// +optional
+	PodSet PodSetReference `json:"podSet,omitempty"`
+
+	// flavor is the name of the ResourceFlavor the reason applies to.
+	//
+	// +optional
+	Flavor ResourceFlavorReference `json:"flavor,omitempty"`
+
+	// resource is the name of the resource the reason applies to.
+	//
+	// +optional
+	Resource corev1.ResourceName `json:"resource,omitempty"`
+
+	// admissionCheck is the name of the AdmissionCheck which is not ready.
+	//
+	// +optional
+	AdmissionCheck AdmissionCheckReference `json:"admissionCheck,omitempty"`
+
+	// message is a human-readable explanation of the reason.
+	//
+	// +required
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message"`
 }
 
 type SchedulingStats struct {
@@ -631,6 +964,39 @@ const (
 	// WorkloadDeactivationTarget means that the Workload should be deactivated.
 	// This condition is temporary, so it should be removed after deactivation.
 	WorkloadDeactivationTarget = "DeactivationTarget"
+
+	// WorkloadPreemptionRequested means that the job of the preempted Workload
+	// was asked to checkpoint its progress before it is stopped. The condition
+	// is set to false once the job is stopped, either because it acknowledged
+	// the checkpoint or because the grace period elapsed.
+	WorkloadPreemptionRequested = "PreemptionRequested"
+
+	// WorkloadSLOBreached means that the Workload is pending for longer than
+	// its admission target. The condition is set to false once the Workload
+	// reserves quota.
+	WorkloadSLOBreached = "SLOBreached"
+)
+
+// Reasons for the WorkloadPreemptionRequested condition.
+const (
+	// WorkloadCheckpointRequested indicates that the job is given the grace
+	// period to checkpoint its progress.
+	WorkloadCheckpointRequested = "CheckpointRequested"
+
+	// WorkloadCheckpointReady indicates that the job was stopped once it
+	// acknowledged that its checkpoint was saved.
+	WorkloadCheckpointReady = "CheckpointReady"
+
+	// WorkloadCheckpointDeadlineExceeded indicates that the job was stopped at
+	// the end of the grace period without acknowledging its checkpoint.
+	WorkloadCheckpointDeadlineExceeded = "CheckpointDeadlineExceeded"
+)
+
+// Reasons for the WorkloadSLOBreached condition.
+const (
+	// WorkloadAdmissionTargetExceeded indicates that the Workload didn't
+	// reserve quota within its admission target.
+	WorkloadAdmissionTargetExceeded = "AdmissionTargetExceeded"
 )
 
 // Reasons for the WorkloadPreempted condition.
@@ -681,6 +1047,16 @@ const (
 	// due to non-recoverable node failures.
 	WorkloadEvictedDueToNodeFailures = "NodeFailures"
 
+	// WorkloadEvictedDueToUnhealthyTopologyDomain indicates that the workload
+	// was evicted because some of its pods are placed in unhealthy topology
+	// domains.
+	WorkloadEvictedDueToUnhealthyTopologyDomain = "UnhealthyTopologyDomain"
+
+	// WorkloadEvictedDueToPodsUnschedulable indicates that the workload was
+	// evicted because some of its pods stayed unschedulable on the assigned
+	// flavors.
+	WorkloadEvictedDueToPodsUnschedulable = "PodsUnschedulable"
+
 	// WorkloadSliceReplaced indicates that the workload instance was
 	// replaced with a new workload slice.
 	WorkloadSliceReplaced = "WorkloadSliceReplaced"
@@ -746,6 +1122,7 @@ const (
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Queue",JSONPath=".spec.queueName",type="string",description="Name of the queue this workload was submitted to"
 // +kubebuilder:printcolumn:name="Reserved in",JSONPath=".status.admission.clusterQueue",type="string",description="Name of the ClusterQueue where the workload is reserving quota"
+// +kubebuilder:printcolumn:name="Checks",JSONPath=".status.admissionChecksSummary",type="string",description="Progress of the admission checks"
 // +kubebuilder:printcolumn:name="Admitted",JSONPath=".status.conditions[?(@.type=='Admitted')].status",type="string",description="Admission status"
 // +kubebuilder:printcolumn:name="Finished",JSONPath=".status.conditions[?(@.type=='Finished')].status",type="string",description="Workload finished"
 // +kubebuilder:printcolumn:name="Age",JSONPath=".metadata.creationTimestamp",type="date",description="Time this workload was created"
@@ -135,6 +135,11 @@ func (in *AdmissionCheckSpec) DeepCopyInto(out *AdmissionCheckSpec) {
 		*out = new(AdmissionCheckParametersReference)
 		**out = **in
 	}
+	if in.Continuous != nil {
+		in, out := &in.Continuous, &out.Continuous
+		*out = new(ContinuousAdmissionCheck)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionCheckSpec.
@@ -200,6 +205,26 @@ func (in *AdmissionCheckStrategyRule) DeepCopyInto(out *AdmissionCheckStrategyRu
 		*out = make([]ResourceFlavorReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.OnPodSets != nil {
+		in, out := &in.OnPodSets, &out.OnPodSets
+		*out = make([]PodSetReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]AdmissionCheckReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TimeoutPolicy != nil {
+		in, out := &in.TimeoutPolicy, &out.TimeoutPolicy
+		*out = new(AdmissionCheckTimeoutPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionCheckStrategyRule.
@@ -293,7 +318,7 @@ func (in *BorrowWithinCohort) DeepCopy() *BorrowWithinCohort {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterQueue) DeepCopyInto(out *ClusterQueue) {
+func (in *Budget) DeepCopyInto(out *Budget) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -301,18 +326,18 @@ func (in *ClusterQueue) DeepCopyInto(out *ClusterQueue) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueue.
-func (in *ClusterQueue) DeepCopy() *ClusterQueue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Budget.
+func (in *Budget) DeepCopy() *Budget {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterQueue)
+	out := new(Budget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterQueue) DeepCopyObject() runtime.Object {
+func (in *Budget) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -320,31 +345,57 @@ func (in *ClusterQueue) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterQueueList) DeepCopyInto(out *ClusterQueueList) {
+func (in *BudgetAdmissionCheckConfig) DeepCopyInto(out *BudgetAdmissionCheckConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BudgetAdmissionCheckConfig.
+func (in *BudgetAdmissionCheckConfig) DeepCopy() *BudgetAdmissionCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BudgetAdmissionCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BudgetAdmissionCheckConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BudgetAdmissionCheckConfigList) DeepCopyInto(out *BudgetAdmissionCheckConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterQueue, len(*in))
+		*out = make([]BudgetAdmissionCheckConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueList.
-func (in *ClusterQueueList) DeepCopy() *ClusterQueueList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BudgetAdmissionCheckConfigList.
+func (in *BudgetAdmissionCheckConfigList) DeepCopy() *BudgetAdmissionCheckConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterQueueList)
+	out := new(BudgetAdmissionCheckConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterQueueList) DeepCopyObject() runtime.Object {
+func (in *BudgetAdmissionCheckConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -352,190 +403,173 @@ func (in *ClusterQueueList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterQueuePendingWorkload) DeepCopyInto(out *ClusterQueuePendingWorkload) {
+func (in *BudgetAdmissionCheckConfigSpec) DeepCopyInto(out *BudgetAdmissionCheckConfigSpec) {
 	*out = *in
+	if in.Prices != nil {
+		in, out := &in.Prices, &out.Prices
+		*out = make([]FlavorPrices, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultDuration != nil {
+		in, out := &in.DefaultDuration, &out.DefaultDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueuePendingWorkload.
-func (in *ClusterQueuePendingWorkload) DeepCopy() *ClusterQueuePendingWorkload {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BudgetAdmissionCheckConfigSpec.
+func (in *BudgetAdmissionCheckConfigSpec) DeepCopy() *BudgetAdmissionCheckConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterQueuePendingWorkload)
+	out := new(BudgetAdmissionCheckConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterQueuePendingWorkloadsStatus) DeepCopyInto(out *ClusterQueuePendingWorkloadsStatus) {
+func (in *BudgetList) DeepCopyInto(out *BudgetList) {
 	*out = *in
-	if in.Head != nil {
-		in, out := &in.Head, &out.Head
-		*out = make([]ClusterQueuePendingWorkload, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Budget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	in.LastChangeTime.DeepCopyInto(&out.LastChangeTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueuePendingWorkloadsStatus.
-func (in *ClusterQueuePendingWorkloadsStatus) DeepCopy() *ClusterQueuePendingWorkloadsStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BudgetList.
+func (in *BudgetList) DeepCopy() *BudgetList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterQueuePendingWorkloadsStatus)
+	out := new(BudgetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BudgetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterQueuePreemption) DeepCopyInto(out *ClusterQueuePreemption) {
+func (in *BudgetSpec) DeepCopyInto(out *BudgetSpec) {
 	*out = *in
-	if in.BorrowWithinCohort != nil {
-		in, out := &in.BorrowWithinCohort, &out.BorrowWithinCohort
-		*out = new(BorrowWithinCohort)
-		(*in).DeepCopyInto(*out)
+	if in.LocalQueueName != nil {
+		in, out := &in.LocalQueueName, &out.LocalQueueName
+		*out = new(LocalQueueName)
+		**out = **in
+	}
+	out.MonthlyLimit = in.MonthlyLimit.DeepCopy()
+	if in.ExceedPolicy != nil {
+		in, out := &in.ExceedPolicy, &out.ExceedPolicy
+		*out = new(BudgetExceedPolicy)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueuePreemption.
-func (in *ClusterQueuePreemption) DeepCopy() *ClusterQueuePreemption {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BudgetSpec.
+func (in *BudgetSpec) DeepCopy() *BudgetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterQueuePreemption)
+	out := new(BudgetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterQueueSpec) DeepCopyInto(out *ClusterQueueSpec) {
+func (in *BudgetStatus) DeepCopyInto(out *BudgetStatus) {
 	*out = *in
-	if in.ResourceGroups != nil {
-		in, out := &in.ResourceGroups, &out.ResourceGroups
-		*out = make([]ResourceGroup, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.NamespaceSelector != nil {
-		in, out := &in.NamespaceSelector, &out.NamespaceSelector
-		*out = new(v1.LabelSelector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.FlavorFungibility != nil {
-		in, out := &in.FlavorFungibility, &out.FlavorFungibility
-		*out = new(FlavorFungibility)
-		**out = **in
-	}
-	if in.Preemption != nil {
-		in, out := &in.Preemption, &out.Preemption
-		*out = new(ClusterQueuePreemption)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.AdmissionChecks != nil {
-		in, out := &in.AdmissionChecks, &out.AdmissionChecks
-		*out = make([]AdmissionCheckReference, len(*in))
-		copy(*out, *in)
-	}
-	if in.AdmissionChecksStrategy != nil {
-		in, out := &in.AdmissionChecksStrategy, &out.AdmissionChecksStrategy
-		*out = new(AdmissionChecksStrategy)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.StopPolicy != nil {
-		in, out := &in.StopPolicy, &out.StopPolicy
-		*out = new(StopPolicy)
-		**out = **in
+	if in.PeriodStart != nil {
+		in, out := &in.PeriodStart, &out.PeriodStart
+		*out = (*in).DeepCopy()
 	}
-	if in.FairSharing != nil {
-		in, out := &in.FairSharing, &out.FairSharing
-		*out = new(FairSharing)
-		(*in).DeepCopyInto(*out)
+	if in.Spent != nil {
+		in, out := &in.Spent, &out.Spent
+		x := (*in).DeepCopy()
+		*out = &x
 	}
-	if in.AdmissionScope != nil {
-		in, out := &in.AdmissionScope, &out.AdmissionScope
-		*out = new(AdmissionScope)
-		**out = **in
+	if in.PreviousPeriodSpent != nil {
+		in, out := &in.PreviousPeriodSpent, &out.PreviousPeriodSpent
+		x := (*in).DeepCopy()
+		*out = &x
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueSpec.
-func (in *ClusterQueueSpec) DeepCopy() *ClusterQueueSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BudgetStatus.
+func (in *BudgetStatus) DeepCopy() *BudgetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterQueueSpec)
+	out := new(BudgetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterQueueStatus) DeepCopyInto(out *ClusterQueueStatus) {
+func (in *CapacityReservation) DeepCopyInto(out *CapacityReservation) {
 	*out = *in
-	if in.FlavorsReservation != nil {
-		in, out := &in.FlavorsReservation, &out.FlavorsReservation
-		*out = make([]FlavorUsage, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Flavor != nil {
+		in, out := &in.Flavor, &out.Flavor
+		*out = new(ResourceFlavorReference)
+		**out = **in
 	}
-	if in.FlavorsUsage != nil {
-		in, out := &in.FlavorsUsage, &out.FlavorsUsage
-		*out = make([]FlavorUsage, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
 		}
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
-	if in.PendingWorkloadsStatus != nil {
-		in, out := &in.PendingWorkloadsStatus, &out.PendingWorkloadsStatus
-		*out = new(ClusterQueuePendingWorkloadsStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.FairSharing != nil {
-		in, out := &in.FairSharing, &out.FairSharing
-		*out = new(FairSharingStatus)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueStatus.
-func (in *ClusterQueueStatus) DeepCopy() *ClusterQueueStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservation.
+func (in *CapacityReservation) DeepCopy() *CapacityReservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterQueueStatus)
+	out := new(CapacityReservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Cohort) DeepCopyInto(out *Cohort) {
+func (in *CapacityReservationAdmissionCheckConfig) DeepCopyInto(out *CapacityReservationAdmissionCheckConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cohort.
-func (in *Cohort) DeepCopy() *Cohort {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationAdmissionCheckConfig.
+func (in *CapacityReservationAdmissionCheckConfig) DeepCopy() *CapacityReservationAdmissionCheckConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(Cohort)
+	out := new(CapacityReservationAdmissionCheckConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Cohort) DeepCopyObject() runtime.Object {
+func (in *CapacityReservationAdmissionCheckConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -543,31 +577,31 @@ func (in *Cohort) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CohortList) DeepCopyInto(out *CohortList) {
+func (in *CapacityReservationAdmissionCheckConfigList) DeepCopyInto(out *CapacityReservationAdmissionCheckConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Cohort, len(*in))
+		*out = make([]CapacityReservationAdmissionCheckConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CohortList.
-func (in *CohortList) DeepCopy() *CohortList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationAdmissionCheckConfigList.
+func (in *CapacityReservationAdmissionCheckConfigList) DeepCopy() *CapacityReservationAdmissionCheckConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(CohortList)
+	out := new(CapacityReservationAdmissionCheckConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CohortList) DeepCopyObject() runtime.Object {
+func (in *CapacityReservationAdmissionCheckConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -575,147 +609,1019 @@ func (in *CohortList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CohortSpec) DeepCopyInto(out *CohortSpec) {
+func (in *CapacityReservationAdmissionCheckConfigSpec) DeepCopyInto(out *CapacityReservationAdmissionCheckConfigSpec) {
 	*out = *in
-	if in.ResourceGroups != nil {
-		in, out := &in.ResourceGroups, &out.ResourceGroups
-		*out = make([]ResourceGroup, len(*in))
+	if in.Reservations != nil {
+		in, out := &in.Reservations, &out.Reservations
+		*out = make([]CapacityReservation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.FairSharing != nil {
-		in, out := &in.FairSharing, &out.FairSharing
-		*out = new(FairSharing)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CohortSpec.
-func (in *CohortSpec) DeepCopy() *CohortSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationAdmissionCheckConfigSpec.
+func (in *CapacityReservationAdmissionCheckConfigSpec) DeepCopy() *CapacityReservationAdmissionCheckConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CohortSpec)
+	out := new(CapacityReservationAdmissionCheckConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CohortStatus) DeepCopyInto(out *CohortStatus) {
+func (in *ClusterQueue) DeepCopyInto(out *ClusterQueue) {
 	*out = *in
-	if in.FairSharing != nil {
-		in, out := &in.FairSharing, &out.FairSharing
-		*out = new(FairSharingStatus)
-		(*in).DeepCopyInto(*out)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CohortStatus.
-func (in *CohortStatus) DeepCopy() *CohortStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueue.
+func (in *ClusterQueue) DeepCopy() *ClusterQueue {
 	if in == nil {
 		return nil
 	}
-	out := new(CohortStatus)
+	out := new(ClusterQueue)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterQueue) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FairSharing) DeepCopyInto(out *FairSharing) {
+func (in *ClusterQueueEvictionBackoff) DeepCopyInto(out *ClusterQueueEvictionBackoff) {
 	*out = *in
-	if in.Weight != nil {
-		in, out := &in.Weight, &out.Weight
-		x := (*in).DeepCopy()
-		*out = &x
+	if in.BackoffBaseSeconds != nil {
+		in, out := &in.BackoffBaseSeconds, &out.BackoffBaseSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackoffMaxSeconds != nil {
+		in, out := &in.BackoffMaxSeconds, &out.BackoffMaxSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackoffLimitCount != nil {
+		in, out := &in.BackoffLimitCount, &out.BackoffLimitCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.JitterPercent != nil {
+		in, out := &in.JitterPercent, &out.JitterPercent
+		*out = new(int32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FairSharing.
-func (in *FairSharing) DeepCopy() *FairSharing {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueEvictionBackoff.
+func (in *ClusterQueueEvictionBackoff) DeepCopy() *ClusterQueueEvictionBackoff {
 	if in == nil {
 		return nil
 	}
-	out := new(FairSharing)
+	out := new(ClusterQueueEvictionBackoff)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FairSharingStatus) DeepCopyInto(out *FairSharingStatus) {
+func (in *ClusterQueueList) DeepCopyInto(out *ClusterQueueList) {
 	*out = *in
-	if in.AdmissionFairSharingStatus != nil {
-		in, out := &in.AdmissionFairSharingStatus, &out.AdmissionFairSharingStatus
-		*out = new(AdmissionFairSharingStatus)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterQueue, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueList.
+func (in *ClusterQueueList) DeepCopy() *ClusterQueueList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterQueueList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterQueueList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterQueuePendingWorkload) DeepCopyInto(out *ClusterQueuePendingWorkload) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueuePendingWorkload.
+func (in *ClusterQueuePendingWorkload) DeepCopy() *ClusterQueuePendingWorkload {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterQueuePendingWorkload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterQueuePendingWorkloadsStatus) DeepCopyInto(out *ClusterQueuePendingWorkloadsStatus) {
+	*out = *in
+	if in.Head != nil {
+		in, out := &in.Head, &out.Head
+		*out = make([]ClusterQueuePendingWorkload, len(*in))
+		copy(*out, *in)
+	}
+	in.LastChangeTime.DeepCopyInto(&out.LastChangeTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueuePendingWorkloadsStatus.
+func (in *ClusterQueuePendingWorkloadsStatus) DeepCopy() *ClusterQueuePendingWorkloadsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterQueuePendingWorkloadsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterQueuePreemption) DeepCopyInto(out *ClusterQueuePreemption) {
+	*out = *in
+	if in.BorrowWithinCohort != nil {
+		in, out := &in.BorrowWithinCohort, &out.BorrowWithinCohort
+		*out = new(BorrowWithinCohort)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FairSharingStatus.
-func (in *FairSharingStatus) DeepCopy() *FairSharingStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueuePreemption.
+func (in *ClusterQueuePreemption) DeepCopy() *ClusterQueuePreemption {
 	if in == nil {
 		return nil
 	}
-	out := new(FairSharingStatus)
+	out := new(ClusterQueuePreemption)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FlavorFungibility) DeepCopyInto(out *FlavorFungibility) {
+func (in *ClusterQueueProblem) DeepCopyInto(out *ClusterQueueProblem) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorFungibility.
-func (in *FlavorFungibility) DeepCopy() *FlavorFungibility {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueProblem.
+func (in *ClusterQueueProblem) DeepCopy() *ClusterQueueProblem {
 	if in == nil {
 		return nil
 	}
-	out := new(FlavorFungibility)
+	out := new(ClusterQueueProblem)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FlavorQuotas) DeepCopyInto(out *FlavorQuotas) {
+func (in *ClusterQueueSpec) DeepCopyInto(out *ClusterQueueSpec) {
 	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = make([]ResourceQuota, len(*in))
+	if in.ResourceGroups != nil {
+		in, out := &in.ResourceGroups, &out.ResourceGroups
+		*out = make([]ResourceGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HeadOfLineTimeoutSeconds != nil {
+		in, out := &in.HeadOfLineTimeoutSeconds, &out.HeadOfLineTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HeadroomPriorityThreshold != nil {
+		in, out := &in.HeadroomPriorityThreshold, &out.HeadroomPriorityThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlavorFungibility != nil {
+		in, out := &in.FlavorFungibility, &out.FlavorFungibility
+		*out = new(FlavorFungibility)
+		**out = **in
+	}
+	if in.Preemption != nil {
+		in, out := &in.Preemption, &out.Preemption
+		*out = new(ClusterQueuePreemption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdmissionChecks != nil {
+		in, out := &in.AdmissionChecks, &out.AdmissionChecks
+		*out = make([]AdmissionCheckReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdmissionChecksStrategy != nil {
+		in, out := &in.AdmissionChecksStrategy, &out.AdmissionChecksStrategy
+		*out = new(AdmissionChecksStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StopPolicy != nil {
+		in, out := &in.StopPolicy, &out.StopPolicy
+		*out = new(StopPolicy)
+		**out = **in
+	}
+	if in.SuccessorClusterQueue != nil {
+		in, out := &in.SuccessorClusterQueue, &out.SuccessorClusterQueue
+		*out = new(ClusterQueueReference)
+		**out = **in
+	}
+	if in.FairSharing != nil {
+		in, out := &in.FairSharing, &out.FairSharing
+		*out = new(FairSharing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdmissionScope != nil {
+		in, out := &in.AdmissionScope, &out.AdmissionScope
+		*out = new(AdmissionScope)
+		**out = **in
+	}
+	if in.TopologyPlacementPolicy != nil {
+		in, out := &in.TopologyPlacementPolicy, &out.TopologyPlacementPolicy
+		*out = new(TopologyPlacementPolicy)
+		**out = **in
+	}
+	if in.PhysicalCapacityPolicy != nil {
+		in, out := &in.PhysicalCapacityPolicy, &out.PhysicalCapacityPolicy
+		*out = new(PhysicalCapacityPolicy)
+		**out = **in
+	}
+	if in.EvictionBackoff != nil {
+		in, out := &in.EvictionBackoff, &out.EvictionBackoff
+		*out = new(ClusterQueueEvictionBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReactivationPolicy != nil {
+		in, out := &in.ReactivationPolicy, &out.ReactivationPolicy
+		*out = new(ReactivationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueSpec.
+func (in *ClusterQueueSpec) DeepCopy() *ClusterQueueSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterQueueSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterQueueStatus) DeepCopyInto(out *ClusterQueueStatus) {
+	*out = *in
+	if in.FlavorsReservation != nil {
+		in, out := &in.FlavorsReservation, &out.FlavorsReservation
+		*out = make([]FlavorUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FlavorsUsage != nil {
+		in, out := &in.FlavorsUsage, &out.FlavorsUsage
+		*out = make([]FlavorUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PendingWorkloadsStatus != nil {
+		in, out := &in.PendingWorkloadsStatus, &out.PendingWorkloadsStatus
+		*out = new(ClusterQueuePendingWorkloadsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FairSharing != nil {
+		in, out := &in.FairSharing, &out.FairSharing
+		*out = new(FairSharingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlavorsPhysicalCapacity != nil {
+		in, out := &in.FlavorsPhysicalCapacity, &out.FlavorsPhysicalCapacity
+		*out = make([]FlavorPhysicalCapacity, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Problems != nil {
+		in, out := &in.Problems, &out.Problems
+		*out = make([]ClusterQueueProblem, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorQuotas.
-func (in *FlavorQuotas) DeepCopy() *FlavorQuotas {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterQueueStatus.
+func (in *ClusterQueueStatus) DeepCopy() *ClusterQueueStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(FlavorQuotas)
+	out := new(ClusterQueueStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FlavorUsage) DeepCopyInto(out *FlavorUsage) {
+func (in *Cohort) DeepCopyInto(out *Cohort) {
 	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = make([]ResourceUsage, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cohort.
+func (in *Cohort) DeepCopy() *Cohort {
+	if in == nil {
+		return nil
+	}
+	out := new(Cohort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Cohort) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CohortList) DeepCopyInto(out *CohortList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Cohort, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorUsage.
-func (in *FlavorUsage) DeepCopy() *FlavorUsage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CohortList.
+func (in *CohortList) DeepCopy() *CohortList {
+	if in == nil {
+		return nil
+	}
+	out := new(CohortList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CohortList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CohortSpec) DeepCopyInto(out *CohortSpec) {
+	*out = *in
+	if in.ResourceGroups != nil {
+		in, out := &in.ResourceGroups, &out.ResourceGroups
+		*out = make([]ResourceGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FairSharing != nil {
+		in, out := &in.FairSharing, &out.FairSharing
+		*out = new(FairSharing)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CohortSpec.
+func (in *CohortSpec) DeepCopy() *CohortSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CohortSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CohortStatus) DeepCopyInto(out *CohortStatus) {
+	*out = *in
+	if in.FairSharing != nil {
+		in, out := &in.FairSharing, &out.FairSharing
+		*out = new(FairSharingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CohortStatus.
+func (in *CohortStatus) DeepCopy() *CohortStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CohortStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContinuousAdmissionCheck) DeepCopyInto(out *ContinuousAdmissionCheck) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.EvictionPolicy != nil {
+		in, out := &in.EvictionPolicy, &out.EvictionPolicy
+		*out = new(ContinuousAdmissionCheckEvictionPolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContinuousAdmissionCheck.
+func (in *ContinuousAdmissionCheck) DeepCopy() *ContinuousAdmissionCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ContinuousAdmissionCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExcludedFlavor) DeepCopyInto(out *ExcludedFlavor) {
+	*out = *in
+	in.ExpirationTime.DeepCopyInto(&out.ExpirationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludedFlavor.
+func (in *ExcludedFlavor) DeepCopy() *ExcludedFlavor {
+	if in == nil {
+		return nil
+	}
+	out := new(ExcludedFlavor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FairSharing) DeepCopyInto(out *FairSharing) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FairSharing.
+func (in *FairSharing) DeepCopy() *FairSharing {
+	if in == nil {
+		return nil
+	}
+	out := new(FairSharing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FairSharingStatus) DeepCopyInto(out *FairSharingStatus) {
+	*out = *in
+	if in.DecayedUsage != nil {
+		in, out := &in.DecayedUsage, &out.DecayedUsage
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.AdmissionFairSharingStatus != nil {
+		in, out := &in.AdmissionFairSharingStatus, &out.AdmissionFairSharingStatus
+		*out = new(AdmissionFairSharingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FairSharingStatus.
+func (in *FairSharingStatus) DeepCopy() *FairSharingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FairSharingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorFungibility) DeepCopyInto(out *FlavorFungibility) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorFungibility.
+func (in *FlavorFungibility) DeepCopy() *FlavorFungibility {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorFungibility)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorPhysicalCapacity) DeepCopyInto(out *FlavorPhysicalCapacity) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourcePhysicalCapacity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorPhysicalCapacity.
+func (in *FlavorPhysicalCapacity) DeepCopy() *FlavorPhysicalCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorPhysicalCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorPrices) DeepCopyInto(out *FlavorPrices) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourcePrice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorPrices.
+func (in *FlavorPrices) DeepCopy() *FlavorPrices {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorPrices)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorQuotas) DeepCopyInto(out *FlavorQuotas) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorQuotas.
+func (in *FlavorQuotas) DeepCopy() *FlavorQuotas {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorQuotas)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorUsage) DeepCopyInto(out *FlavorUsage) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorUsage.
+func (in *FlavorUsage) DeepCopy() *FlavorUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPAdmissionCheckConfig) DeepCopyInto(out *HTTPAdmissionCheckConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPAdmissionCheckConfig.
+func (in *HTTPAdmissionCheckConfig) DeepCopy() *HTTPAdmissionCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPAdmissionCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTTPAdmissionCheckConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPAdmissionCheckConfigList) DeepCopyInto(out *HTTPAdmissionCheckConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HTTPAdmissionCheckConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPAdmissionCheckConfigList.
+func (in *HTTPAdmissionCheckConfigList) DeepCopy() *HTTPAdmissionCheckConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPAdmissionCheckConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTTPAdmissionCheckConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPAdmissionCheckConfigSpec) DeepCopyInto(out *HTTPAdmissionCheckConfigSpec) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientCertificateSecretRef != nil {
+		in, out := &in.ClientCertificateSecretRef, &out.ClientCertificateSecretRef
+		*out = new(HTTPAdmissionCheckSecretReference)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RetryPeriod != nil {
+		in, out := &in.RetryPeriod, &out.RetryPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPAdmissionCheckConfigSpec.
+func (in *HTTPAdmissionCheckConfigSpec) DeepCopy() *HTTPAdmissionCheckConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPAdmissionCheckConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPAdmissionCheckSecretReference) DeepCopyInto(out *HTTPAdmissionCheckSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPAdmissionCheckSecretReference.
+func (in *HTTPAdmissionCheckSecretReference) DeepCopy() *HTTPAdmissionCheckSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPAdmissionCheckSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrePullAdmissionCheckConfig) DeepCopyInto(out *ImagePrePullAdmissionCheckConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrePullAdmissionCheckConfig.
+func (in *ImagePrePullAdmissionCheckConfig) DeepCopy() *ImagePrePullAdmissionCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrePullAdmissionCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImagePrePullAdmissionCheckConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrePullAdmissionCheckConfigList) DeepCopyInto(out *ImagePrePullAdmissionCheckConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImagePrePullAdmissionCheckConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrePullAdmissionCheckConfigList.
+func (in *ImagePrePullAdmissionCheckConfigList) DeepCopy() *ImagePrePullAdmissionCheckConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrePullAdmissionCheckConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImagePrePullAdmissionCheckConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrePullAdmissionCheckConfigSpec) DeepCopyInto(out *ImagePrePullAdmissionCheckConfigSpec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PauseImage != nil {
+		in, out := &in.PauseImage, &out.PauseImage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrePullAdmissionCheckConfigSpec.
+func (in *ImagePrePullAdmissionCheckConfigSpec) DeepCopy() *ImagePrePullAdmissionCheckConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrePullAdmissionCheckConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerificationAdmissionCheckConfig) DeepCopyInto(out *ImageVerificationAdmissionCheckConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerificationAdmissionCheckConfig.
+func (in *ImageVerificationAdmissionCheckConfig) DeepCopy() *ImageVerificationAdmissionCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerificationAdmissionCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageVerificationAdmissionCheckConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerificationAdmissionCheckConfigList) DeepCopyInto(out *ImageVerificationAdmissionCheckConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageVerificationAdmissionCheckConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerificationAdmissionCheckConfigList.
+func (in *ImageVerificationAdmissionCheckConfigList) DeepCopy() *ImageVerificationAdmissionCheckConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerificationAdmissionCheckConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageVerificationAdmissionCheckConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerificationAdmissionCheckConfigSpec) DeepCopyInto(out *ImageVerificationAdmissionCheckConfigSpec) {
+	*out = *in
+	if in.AllowedRegistries != nil {
+		in, out := &in.AllowedRegistries, &out.AllowedRegistries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerificationAdmissionCheckConfigSpec.
+func (in *ImageVerificationAdmissionCheckConfigSpec) DeepCopy() *ImageVerificationAdmissionCheckConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerificationAdmissionCheckConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KarpenterAdmissionCheckConfig) DeepCopyInto(out *KarpenterAdmissionCheckConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KarpenterAdmissionCheckConfig.
+func (in *KarpenterAdmissionCheckConfig) DeepCopy() *KarpenterAdmissionCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KarpenterAdmissionCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KarpenterAdmissionCheckConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KarpenterAdmissionCheckConfigList) DeepCopyInto(out *KarpenterAdmissionCheckConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KarpenterAdmissionCheckConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KarpenterAdmissionCheckConfigList.
+func (in *KarpenterAdmissionCheckConfigList) DeepCopy() *KarpenterAdmissionCheckConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(KarpenterAdmissionCheckConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KarpenterAdmissionCheckConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KarpenterAdmissionCheckConfigSpec) DeepCopyInto(out *KarpenterAdmissionCheckConfigSpec) {
+	*out = *in
+	if in.NodeClassRef != nil {
+		in, out := &in.NodeClassRef, &out.NodeClassRef
+		*out = new(KarpenterNodeClassReference)
+		**out = **in
+	}
+	if in.MaxNodeClaims != nil {
+		in, out := &in.MaxNodeClaims, &out.MaxNodeClaims
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KarpenterAdmissionCheckConfigSpec.
+func (in *KarpenterAdmissionCheckConfigSpec) DeepCopy() *KarpenterAdmissionCheckConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KarpenterAdmissionCheckConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KarpenterNodeClassReference) DeepCopyInto(out *KarpenterNodeClassReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KarpenterNodeClassReference.
+func (in *KarpenterNodeClassReference) DeepCopy() *KarpenterNodeClassReference {
 	if in == nil {
 		return nil
 	}
-	out := new(FlavorUsage)
+	out := new(KarpenterNodeClassReference)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -884,6 +1790,11 @@ func (in *LocalQueueSpec) DeepCopyInto(out *LocalQueueSpec) {
 		*out = new(FairSharing)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdmissionTarget != nil {
+		in, out := &in.AdmissionTarget, &out.AdmissionTarget
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalQueueSpec.
@@ -1119,6 +2030,22 @@ func (in *MultiKueueConfigSpec) DeepCopy() *MultiKueueConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PeerBorrowingLimit) DeepCopyInto(out *PeerBorrowingLimit) {
+	*out = *in
+	out.BorrowingLimit = in.BorrowingLimit.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerBorrowingLimit.
+func (in *PeerBorrowingLimit) DeepCopy() *PeerBorrowingLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(PeerBorrowingLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSet) DeepCopyInto(out *PodSet) {
 	*out = *in
@@ -1145,6 +2072,35 @@ func (in *PodSet) DeepCopy() *PodSet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSetActualUsage) DeepCopyInto(out *PodSetActualUsage) {
+	*out = *in
+	if in.Peak != nil {
+		in, out := &in.Peak, &out.Peak
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Average != nil {
+		in, out := &in.Average, &out.Average
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSetActualUsage.
+func (in *PodSetActualUsage) DeepCopy() *PodSetActualUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSetActualUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSetAssignment) DeepCopyInto(out *PodSetAssignment) {
 	*out = *in
@@ -1177,6 +2133,11 @@ func (in *PodSetAssignment) DeepCopyInto(out *PodSetAssignment) {
 		*out = new(DelayedTopologyRequestState)
 		**out = **in
 	}
+	if in.AchievedTopologyLevel != nil {
+		in, out := &in.AchievedTopologyLevel, &out.AchievedTopologyLevel
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSetAssignment.
@@ -1189,6 +2150,33 @@ func (in *PodSetAssignment) DeepCopy() *PodSetAssignment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodSetFlavors) DeepCopyInto(out *PodSetFlavors) {
+	*out = *in
+	if in.Flavors != nil {
+		in, out := &in.Flavors, &out.Flavors
+		*out = make(map[corev1.ResourceName]ResourceFlavorReference, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TopologyAssignment != nil {
+		in, out := &in.TopologyAssignment, &out.TopologyAssignment
+		*out = new(TopologyAssignment)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSetFlavors.
+func (in *PodSetFlavors) DeepCopy() *PodSetFlavors {
+	if in == nil {
+		return nil
+	}
+	out := new(PodSetFlavors)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSetRequest) DeepCopyInto(out *PodSetRequest) {
 	*out = *in
@@ -1259,6 +2247,21 @@ func (in *PodSetTopologyRequest) DeepCopyInto(out *PodSetTopologyRequest) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.PodSetExclusiveTopology != nil {
+		in, out := &in.PodSetExclusiveTopology, &out.PodSetExclusiveTopology
+		*out = new(string)
+		**out = **in
+	}
+	if in.PodSetBalancedTopology != nil {
+		in, out := &in.PodSetBalancedTopology, &out.PodSetBalancedTopology
+		*out = new(string)
+		**out = **in
+	}
+	if in.PodSetBalancedDomainCount != nil {
+		in, out := &in.PodSetBalancedDomainCount, &out.PodSetBalancedDomainCount
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSetTopologyRequest.
@@ -1402,6 +2405,11 @@ func (in *ProvisioningRequestConfigSpec) DeepCopyInto(out *ProvisioningRequestCo
 		*out = new(ProvisioningRequestConfigPodSetMergePolicy)
 		**out = **in
 	}
+	if in.Consolidation != nil {
+		in, out := &in.Consolidation, &out.Consolidation
+		*out = new(ProvisioningRequestConsolidation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestConfigSpec.
@@ -1414,6 +2422,27 @@ func (in *ProvisioningRequestConfigSpec) DeepCopy() *ProvisioningRequestConfigSp
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningRequestConsolidation) DeepCopyInto(out *ProvisioningRequestConsolidation) {
+	*out = *in
+	out.Window = in.Window
+	if in.MaxWorkloads != nil {
+		in, out := &in.MaxWorkloads, &out.MaxWorkloads
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestConsolidation.
+func (in *ProvisioningRequestConsolidation) DeepCopy() *ProvisioningRequestConsolidation {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningRequestConsolidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProvisioningRequestPodSetUpdates) DeepCopyInto(out *ProvisioningRequestPodSetUpdates) {
 	*out = *in
@@ -1467,53 +2496,279 @@ func (in *ProvisioningRequestRetryStrategy) DeepCopyInto(out *ProvisioningReques
 		*out = new(int32)
 		**out = **in
 	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(ProvisioningRequestFailurePolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestRetryStrategy.
+func (in *ProvisioningRequestRetryStrategy) DeepCopy() *ProvisioningRequestRetryStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningRequestRetryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReactivationPolicy) DeepCopyInto(out *ReactivationPolicy) {
+	*out = *in
+	if in.CooldownSeconds != nil {
+		in, out := &in.CooldownSeconds, &out.CooldownSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxCooldownSeconds != nil {
+		in, out := &in.MaxCooldownSeconds, &out.MaxCooldownSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LimitCount != nil {
+		in, out := &in.LimitCount, &out.LimitCount
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReactivationPolicy.
+func (in *ReactivationPolicy) DeepCopy() *ReactivationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ReactivationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReclaimablePod) DeepCopyInto(out *ReclaimablePod) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReclaimablePod.
+func (in *ReclaimablePod) DeepCopy() *ReclaimablePod {
+	if in == nil {
+		return nil
+	}
+	out := new(ReclaimablePod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequeueRecord) DeepCopyInto(out *RequeueRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	if in.Flavors != nil {
+		in, out := &in.Flavors, &out.Flavors
+		*out = make([]PodSetFlavors, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Preemptor != nil {
+		in, out := &in.Preemptor, &out.Preemptor
+		*out = new(RequeueRecordPreemptor)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequeueRecord.
+func (in *RequeueRecord) DeepCopy() *RequeueRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(RequeueRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequeueRecordPreemptor) DeepCopyInto(out *RequeueRecordPreemptor) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequeueRecordPreemptor.
+func (in *RequeueRecordPreemptor) DeepCopy() *RequeueRecordPreemptor {
+	if in == nil {
+		return nil
+	}
+	out := new(RequeueRecordPreemptor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequeueState) DeepCopyInto(out *RequeueState) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RequeueAt != nil {
+		in, out := &in.RequeueAt, &out.RequeueAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequeueState.
+func (in *RequeueState) DeepCopy() *RequeueState {
+	if in == nil {
+		return nil
+	}
+	out := new(RequeueState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Reservation) DeepCopyInto(out *Reservation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Reservation.
+func (in *Reservation) DeepCopy() *Reservation {
+	if in == nil {
+		return nil
+	}
+	out := new(Reservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Reservation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservationHolder) DeepCopyInto(out *ReservationHolder) {
+	*out = *in
+	if in.LocalQueue != nil {
+		in, out := &in.LocalQueue, &out.LocalQueue
+		*out = new(LocalQueueName)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservationHolder.
+func (in *ReservationHolder) DeepCopy() *ReservationHolder {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservationHolder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservationList) DeepCopyInto(out *ReservationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Reservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservationList.
+func (in *ReservationList) DeepCopy() *ReservationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReservationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservationSpec) DeepCopyInto(out *ReservationSpec) {
+	*out = *in
+	in.Holder.DeepCopyInto(&out.Holder)
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	if in.Flavors != nil {
+		in, out := &in.Flavors, &out.Flavors
+		*out = make([]ReservedFlavor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningRequestRetryStrategy.
-func (in *ProvisioningRequestRetryStrategy) DeepCopy() *ProvisioningRequestRetryStrategy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservationSpec.
+func (in *ReservationSpec) DeepCopy() *ReservationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProvisioningRequestRetryStrategy)
+	out := new(ReservationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReclaimablePod) DeepCopyInto(out *ReclaimablePod) {
+func (in *ReservationStatus) DeepCopyInto(out *ReservationStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReclaimablePod.
-func (in *ReclaimablePod) DeepCopy() *ReclaimablePod {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservationStatus.
+func (in *ReservationStatus) DeepCopy() *ReservationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ReclaimablePod)
+	out := new(ReservationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RequeueState) DeepCopyInto(out *RequeueState) {
+func (in *ReservedFlavor) DeepCopyInto(out *ReservedFlavor) {
 	*out = *in
-	if in.Count != nil {
-		in, out := &in.Count, &out.Count
-		*out = new(int32)
-		**out = **in
-	}
-	if in.RequeueAt != nil {
-		in, out := &in.RequeueAt, &out.RequeueAt
-		*out = (*in).DeepCopy()
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequeueState.
-func (in *RequeueState) DeepCopy() *RequeueState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedFlavor.
+func (in *ReservedFlavor) DeepCopy() *ReservedFlavor {
 	if in == nil {
 		return nil
 	}
-	out := new(RequeueState)
+	out := new(ReservedFlavor)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1524,6 +2779,7 @@ func (in *ResourceFlavor) DeepCopyInto(out *ResourceFlavor) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFlavor.
@@ -1605,6 +2861,20 @@ func (in *ResourceFlavorSpec) DeepCopyInto(out *ResourceFlavorSpec) {
 		*out = new(TopologyReference)
 		**out = **in
 	}
+	if in.ResourcePartitions != nil {
+		in, out := &in.ResourcePartitions, &out.ResourcePartitions
+		*out = make([]ResourcePartition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodOverhead != nil {
+		in, out := &in.PodOverhead, &out.PodOverhead
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFlavorSpec.
@@ -1617,6 +2887,28 @@ func (in *ResourceFlavorSpec) DeepCopy() *ResourceFlavorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceFlavorStatus) DeepCopyInto(out *ResourceFlavorStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFlavorStatus.
+func (in *ResourceFlavorStatus) DeepCopy() *ResourceFlavorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceFlavorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceGroup) DeepCopyInto(out *ResourceGroup) {
 	*out = *in
@@ -1644,6 +2936,60 @@ func (in *ResourceGroup) DeepCopy() *ResourceGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePartition) DeepCopyInto(out *ResourcePartition) {
+	*out = *in
+	out.Fraction = in.Fraction.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePartition.
+func (in *ResourcePartition) DeepCopy() *ResourcePartition {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePartition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePhysicalCapacity) DeepCopyInto(out *ResourcePhysicalCapacity) {
+	*out = *in
+	out.Allocatable = in.Allocatable.DeepCopy()
+	out.Headroom = in.Headroom.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePhysicalCapacity.
+func (in *ResourcePhysicalCapacity) DeepCopy() *ResourcePhysicalCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePhysicalCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePrice) DeepCopyInto(out *ResourcePrice) {
+	*out = *in
+	out.PricePerHour = in.PricePerHour.DeepCopy()
+	if in.Unit != nil {
+		in, out := &in.Unit, &out.Unit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePrice.
+func (in *ResourcePrice) DeepCopy() *ResourcePrice {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePrice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceQuota) DeepCopyInto(out *ResourceQuota) {
 	*out = *in
@@ -1658,6 +3004,28 @@ func (in *ResourceQuota) DeepCopyInto(out *ResourceQuota) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.PeerBorrowingLimits != nil {
+		in, out := &in.PeerBorrowingLimits, &out.PeerBorrowingLimits
+		*out = make([]PeerBorrowingLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OvercommitPercent != nil {
+		in, out := &in.OvercommitPercent, &out.OvercommitPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReservedHeadroom != nil {
+		in, out := &in.ReservedHeadroom, &out.ReservedHeadroom
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxRatio != nil {
+		in, out := &in.MaxRatio, &out.MaxRatio
+		*out = new(ResourceRatio)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuota.
@@ -1670,6 +3038,22 @@ func (in *ResourceQuota) DeepCopy() *ResourceQuota {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRatio) DeepCopyInto(out *ResourceRatio) {
+	*out = *in
+	out.Quantity = in.Quantity.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRatio.
+func (in *ResourceRatio) DeepCopy() *ResourceRatio {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRatio)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceUsage) DeepCopyInto(out *ResourceUsage) {
 	*out = *in
@@ -1707,6 +3091,110 @@ func (in *SchedulingStats) DeepCopy() *SchedulingStats {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlurmAdmissionCheckConfig) DeepCopyInto(out *SlurmAdmissionCheckConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlurmAdmissionCheckConfig.
+func (in *SlurmAdmissionCheckConfig) DeepCopy() *SlurmAdmissionCheckConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SlurmAdmissionCheckConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SlurmAdmissionCheckConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlurmAdmissionCheckConfigList) DeepCopyInto(out *SlurmAdmissionCheckConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SlurmAdmissionCheckConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlurmAdmissionCheckConfigList.
+func (in *SlurmAdmissionCheckConfigList) DeepCopy() *SlurmAdmissionCheckConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(SlurmAdmissionCheckConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SlurmAdmissionCheckConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlurmAdmissionCheckConfigSpec) DeepCopyInto(out *SlurmAdmissionCheckConfigSpec) {
+	*out = *in
+	out.TokenSecretRef = in.TokenSecretRef
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlurmAdmissionCheckConfigSpec.
+func (in *SlurmAdmissionCheckConfigSpec) DeepCopy() *SlurmAdmissionCheckConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SlurmAdmissionCheckConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlurmAdmissionCheckSecretReference) DeepCopyInto(out *SlurmAdmissionCheckSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlurmAdmissionCheckSecretReference.
+func (in *SlurmAdmissionCheckSecretReference) DeepCopy() *SlurmAdmissionCheckSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SlurmAdmissionCheckSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Topology) DeepCopyInto(out *Topology) {
 	*out = *in
@@ -1780,6 +3268,36 @@ func (in *TopologyDomainAssignment) DeepCopy() *TopologyDomainAssignment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyDomainHealth) DeepCopyInto(out *TopologyDomainHealth) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(string)
+		**out = **in
+	}
+	if in.UnhealthyNodeTaintKeys != nil {
+		in, out := &in.UnhealthyNodeTaintKeys, &out.UnhealthyNodeTaintKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EvictionPolicy != nil {
+		in, out := &in.EvictionPolicy, &out.EvictionPolicy
+		*out = new(TopologyDomainEvictionPolicy)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyDomainHealth.
+func (in *TopologyDomainHealth) DeepCopy() *TopologyDomainHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyDomainHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologyInfo) DeepCopyInto(out *TopologyInfo) {
 	*out = *in
@@ -1855,6 +3373,16 @@ func (in *TopologySpec) DeepCopyInto(out *TopologySpec) {
 		*out = make([]TopologyLevel, len(*in))
 		copy(*out, *in)
 	}
+	if in.PlacementPolicy != nil {
+		in, out := &in.PlacementPolicy, &out.PlacementPolicy
+		*out = new(TopologyPlacementPolicy)
+		**out = **in
+	}
+	if in.DomainHealth != nil {
+		in, out := &in.DomainHealth, &out.DomainHealth
+		*out = new(TopologyDomainHealth)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySpec.
@@ -1882,6 +3410,21 @@ func (in *UnhealthyNode) DeepCopy() *UnhealthyNode {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnschedulableReason) DeepCopyInto(out *UnschedulableReason) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnschedulableReason.
+func (in *UnschedulableReason) DeepCopy() *UnschedulableReason {
+	if in == nil {
+		return nil
+	}
+	out := new(UnschedulableReason)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Workload) DeepCopyInto(out *Workload) {
 	*out = *in
@@ -2038,6 +3581,11 @@ func (in *WorkloadSpec) DeepCopyInto(out *WorkloadSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Held != nil {
+		in, out := &in.Held, &out.Held
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadSpec.
@@ -2114,6 +3662,39 @@ func (in *WorkloadStatus) DeepCopyInto(out *WorkloadStatus) {
 		*out = make([]UnhealthyNode, len(*in))
 		copy(*out, *in)
 	}
+	if in.UnschedulableReasons != nil {
+		in, out := &in.UnschedulableReasons, &out.UnschedulableReasons
+		*out = make([]UnschedulableReason, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastAdmittedFlavors != nil {
+		in, out := &in.LastAdmittedFlavors, &out.LastAdmittedFlavors
+		*out = make([]PodSetFlavors, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExcludedFlavors != nil {
+		in, out := &in.ExcludedFlavors, &out.ExcludedFlavors
+		*out = make([]ExcludedFlavor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RequeueHistory != nil {
+		in, out := &in.RequeueHistory, &out.RequeueHistory
+		*out = make([]RequeueRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ActualUsage != nil {
+		in, out := &in.ActualUsage, &out.ActualUsage
+		*out = make([]PodSetActualUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadStatus.
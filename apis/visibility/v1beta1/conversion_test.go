@@ -23,6 +23,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/diff"
+	"k8s.io/utils/ptr"
 )
 
 func TestPendingWorkloadsOptions(t *testing.T) {
@@ -52,6 +53,30 @@ func TestPendingWorkloadsOptions(t *testing.T) {
 				Offset: 2,
 			},
 		},
+		"filtering and continue parameters": {
+			inputQueryParams: url.Values{
+				"limit":         {"1"},
+				"continue":      {"token"},
+				"labelSelector": {"team=x"},
+				"namespace":     {"ns"},
+				"minPriority":   {"-1"},
+			},
+			wantQueryParams: url.Values{
+				"limit":         {"1"},
+				"offset":        {"0"},
+				"continue":      {"token"},
+				"labelSelector": {"team=x"},
+				"namespace":     {"ns"},
+				"minPriority":   {"-1"},
+			},
+			wantPendingWorkloadOptions: PendingWorkloadOptions{
+				Limit:         1,
+				Continue:      "token",
+				LabelSelector: "team=x",
+				Namespace:     "ns",
+				MinPriority:   ptr.To[int64](-1),
+			},
+		},
 		"default values": {
 			inputQueryParams: url.Values{
 				"limit":  {"0"},
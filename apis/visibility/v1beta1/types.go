@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
@@ -61,6 +63,45 @@ type LocalQueueList struct {
 	Items []LocalQueue `json:"items"`
 }
 
+// +genclient
+// +kubebuilder:object:root=true
+// +k8s:openapi-gen=true
+// +genclient:method=GetAdmissionExplanation,verb=get,subresource=explanation,result=sigs.k8s.io/kueue/apis/visibility/v1beta1.AdmissionExplanation
+type Workload struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Explanation AdmissionExplanation `json:"admissionExplanation"`
+}
+
+// +kubebuilder:object:root=true
+type WorkloadList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Workload `json:"items"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +k8s:openapi-gen=true
+// +genclient:nonNamespaced
+// +genclient:method=GetDispatchedWorkloadsSummary,verb=get,subresource=dispatchedworkloads,result=sigs.k8s.io/kueue/apis/visibility/v1beta1.DispatchedWorkloadsSummary
+type MultiKueueCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Summary DispatchedWorkloadsSummary `json:"dispatchedWorkloadsSummary"`
+}
+
+// +kubebuilder:object:root=true
+type MultiKueueClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []MultiKueueCluster `json:"items"`
+}
+
 // PendingWorkload is a user-facing representation of a pending workload that summarizes the relevant information for
 // position in the cluster queue.
 type PendingWorkload struct {
@@ -89,6 +130,11 @@ type PendingWorkloadsSummary struct {
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
 	Items []PendingWorkload `json:"items"`
+
+	// Continue is set when there are more pending workloads matching the query.
+	// Pass it as the continue query parameter to fetch the next page.
+	// +optional
+	Continue string `json:"continue,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -105,11 +151,154 @@ type PendingWorkloadOptions struct {
 
 	// Limit indicates max number of pending workloads that should be fetched. 1000 by default
 	Limit int64 `json:"limit,omitempty"`
+
+	// Continue is the token returned in the previous response to fetch the next page
+	// of pending workloads. It cannot be used together with offset.
+	Continue string `json:"continue,omitempty"`
+
+	// LabelSelector restricts the pending workloads to those whose labels match the selector.
+	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// Namespace restricts the pending workloads to those in the namespace.
+	// Only used when querying a ClusterQueue.
+	Namespace string `json:"namespace,omitempty"`
+
+	// MinPriority restricts the pending workloads to those with at least the priority.
+	MinPriority *int64 `json:"minPriority,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+
+// AdmissionExplanation describes why a workload is not admitted yet.
+type AdmissionExplanation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// LocalQueueName indicates the name of the LocalQueue the workload is submitted to
+	LocalQueueName v1beta1.LocalQueueName `json:"localQueueName"`
+
+	// ClusterQueue indicates the name of the ClusterQueue the workload is queued in.
+	// Empty when the LocalQueue doesn't exist or doesn't point to an existing ClusterQueue.
+	// +optional
+	ClusterQueue v1beta1.ClusterQueueReference `json:"clusterQueue,omitempty"`
+
+	// Cohort indicates the name of the cohort of the ClusterQueue.
+	// +optional
+	Cohort v1beta1.CohortReference `json:"cohort,omitempty"`
+
+	// Status indicates the admission status of the workload.
+	// One of Pending, QuotaReserved or Admitted.
+	Status AdmissionStatus `json:"status"`
+
+	// Message is the message of the QuotaReserved condition of the pending workload,
+	// which contains the reason of the last failed attempt to reserve quota.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// PositionInClusterQueue indicates the workload's position in the ClusterQueue, starting from 0.
+	// Not set when the workload is not pending in the ClusterQueue.
+	// +optional
+	PositionInClusterQueue *int32 `json:"positionInClusterQueue,omitempty"`
+
+	// WorkloadsAheadCount indicates the number of pending workloads ahead of the workload in the ClusterQueue.
+	WorkloadsAheadCount int32 `json:"workloadsAheadCount"`
+
+	// WorkloadsAhead lists the pending workloads right ahead of the workload in the ClusterQueue, up to 10.
+	// +optional
+	WorkloadsAhead []PendingWorkload `json:"workloadsAhead,omitempty"`
+
+	// FlavorShortfalls lists, for each resource requested by the workload, the flavors that
+	// the ClusterQueue can assign and how much of the requested quantity they lack.
+	// +optional
+	FlavorShortfalls []FlavorShortfall `json:"flavorShortfalls,omitempty"`
+
+	// PendingAdmissionChecks lists the admission checks that are not Ready yet.
+	// +optional
+	PendingAdmissionChecks []v1beta1.AdmissionCheckState `json:"pendingAdmissionChecks,omitempty"`
+}
+
+// DispatchedWorkload is a user-facing representation of a workload dispatched
+// by MultiKueue to a worker cluster.
+type DispatchedWorkload struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Priority indicates the workload's priority
+	Priority int32 `json:"priority"`
+
+	// LocalQueueName indicates the name of the LocalQueue the workload is submitted to
+	LocalQueueName v1beta1.LocalQueueName `json:"localQueueName"`
+
+	// ClusterQueue indicates the name of the ClusterQueue the workload has quota reserved in.
+	ClusterQueue v1beta1.ClusterQueueReference `json:"clusterQueue"`
+
+	// Status indicates the admission status of the workload, as synchronized
+	// from the worker cluster. One of QuotaReserved or Admitted.
+	Status AdmissionStatus `json:"status"`
+}
+
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+
+// DispatchedWorkloadsSummary contains the list of the workloads dispatched
+// by MultiKueue to a worker cluster.
+type DispatchedWorkloadsSummary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Items []DispatchedWorkload `json:"items"`
+}
+
+// AdmissionStatus is the admission status of a workload.
+type AdmissionStatus string
+
+const (
+	// AdmissionStatusPending means that the workload is waiting for quota reservation.
+	AdmissionStatusPending AdmissionStatus = "Pending"
+
+	// AdmissionStatusQuotaReserved means that the workload has quota reserved
+	// and is waiting for its admission checks.
+	AdmissionStatusQuotaReserved AdmissionStatus = "QuotaReserved"
+
+	// AdmissionStatusAdmitted means that the workload is admitted.
+	AdmissionStatusAdmitted AdmissionStatus = "Admitted"
+)
+
+// FlavorShortfall describes the quota of a flavor for a resource requested by a workload.
+type FlavorShortfall struct {
+	// Flavor is the name of the ResourceFlavor.
+	Flavor v1beta1.ResourceFlavorReference `json:"flavor"`
+
+	// Resource is the name of the resource.
+	Resource corev1.ResourceName `json:"resource"`
+
+	// Requested is the total quantity of the resource requested by the workload.
+	Requested resource.Quantity `json:"requested"`
+
+	// NominalQuota is the nominal quota of the ClusterQueue for the flavor and resource.
+	NominalQuota resource.Quantity `json:"nominalQuota"`
+
+	// Usage is the quantity used by the workloads with quota reserved in the ClusterQueue.
+	Usage resource.Quantity `json:"usage"`
+
+	// Available is the quantity available to the ClusterQueue, including what it can
+	// borrow from its cohort, without preempting other workloads.
+	Available resource.Quantity `json:"available"`
+
+	// Shortfall is the requested quantity exceeding the available quantity.
+	// Zero when the flavor fits the request.
+	Shortfall resource.Quantity `json:"shortfall"`
+
+	// Borrowing indicates whether the ClusterQueue is borrowing quota of the flavor
+	// and resource from its cohort.
+	Borrowing bool `json:"borrowing"`
 }
 
 func init() {
 	SchemeBuilder.Register(
 		&PendingWorkloadsSummary{},
 		&PendingWorkloadOptions{},
+		&AdmissionExplanation{},
+		&DispatchedWorkloadsSummary{},
 	)
 }
@@ -23,6 +23,7 @@ type AdmissionCheckSpecApplyConfiguration struct {
 	ControllerName    *string                                              `json:"controllerName,omitempty"`
 	RetryDelayMinutes *int64                                               `json:"retryDelayMinutes,omitempty"`
 	Parameters        *AdmissionCheckParametersReferenceApplyConfiguration `json:"parameters,omitempty"`
+	Continuous        *ContinuousAdmissionCheckApplyConfiguration          `json:"continuous,omitempty"`
 }
 
 // AdmissionCheckSpecApplyConfiguration constructs a declarative configuration of the AdmissionCheckSpec type for use with
@@ -54,3 +55,11 @@ func (b *AdmissionCheckSpecApplyConfiguration) WithParameters(value *AdmissionCh
 	b.Parameters = value
 	return b
 }
+
+// WithContinuous sets the Continuous field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Continuous field is set to the value of the last call.
+func (b *AdmissionCheckSpecApplyConfiguration) WithContinuous(value *ContinuousAdmissionCheckApplyConfiguration) *AdmissionCheckSpecApplyConfiguration {
+	b.Continuous = value
+	return b
+}
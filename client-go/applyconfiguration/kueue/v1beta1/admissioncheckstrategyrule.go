@@ -18,14 +18,19 @@ limitations under the License.
 package v1beta1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kueuev1beta1 "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 )
 
 // AdmissionCheckStrategyRuleApplyConfiguration represents a declarative configuration of the AdmissionCheckStrategyRule type for use
 // with apply.
 type AdmissionCheckStrategyRuleApplyConfiguration struct {
-	Name      *kueuev1beta1.AdmissionCheckReference  `json:"name,omitempty"`
-	OnFlavors []kueuev1beta1.ResourceFlavorReference `json:"onFlavors,omitempty"`
+	Name          *kueuev1beta1.AdmissionCheckReference     `json:"name,omitempty"`
+	OnFlavors     []kueuev1beta1.ResourceFlavorReference    `json:"onFlavors,omitempty"`
+	OnPodSets     []kueuev1beta1.PodSetReference            `json:"onPodSets,omitempty"`
+	DependsOn     []kueuev1beta1.AdmissionCheckReference    `json:"dependsOn,omitempty"`
+	Timeout       *v1.Duration                              `json:"timeout,omitempty"`
+	TimeoutPolicy *kueuev1beta1.AdmissionCheckTimeoutPolicy `json:"timeoutPolicy,omitempty"`
 }
 
 // AdmissionCheckStrategyRuleApplyConfiguration constructs a declarative configuration of the AdmissionCheckStrategyRule type for use with
@@ -51,3 +56,39 @@ func (b *AdmissionCheckStrategyRuleApplyConfiguration) WithOnFlavors(values ...k
 	}
 	return b
 }
+
+// WithOnPodSets adds the given value to the OnPodSets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OnPodSets field.
+func (b *AdmissionCheckStrategyRuleApplyConfiguration) WithOnPodSets(values ...kueuev1beta1.PodSetReference) *AdmissionCheckStrategyRuleApplyConfiguration {
+	for i := range values {
+		b.OnPodSets = append(b.OnPodSets, values[i])
+	}
+	return b
+}
+
+// WithDependsOn adds the given value to the DependsOn field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DependsOn field.
+func (b *AdmissionCheckStrategyRuleApplyConfiguration) WithDependsOn(values ...kueuev1beta1.AdmissionCheckReference) *AdmissionCheckStrategyRuleApplyConfiguration {
+	for i := range values {
+		b.DependsOn = append(b.DependsOn, values[i])
+	}
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *AdmissionCheckStrategyRuleApplyConfiguration) WithTimeout(value v1.Duration) *AdmissionCheckStrategyRuleApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithTimeoutPolicy sets the TimeoutPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeoutPolicy field is set to the value of the last call.
+func (b *AdmissionCheckStrategyRuleApplyConfiguration) WithTimeoutPolicy(value kueuev1beta1.AdmissionCheckTimeoutPolicy) *AdmissionCheckStrategyRuleApplyConfiguration {
+	b.TimeoutPolicy = &value
+	return b
+}
@@ -25,17 +25,24 @@ import (
 // ClusterQueueSpecApplyConfiguration represents a declarative configuration of the ClusterQueueSpec type for use
 // with apply.
 type ClusterQueueSpecApplyConfiguration struct {
-	ResourceGroups          []ResourceGroupApplyConfiguration          `json:"resourceGroups,omitempty"`
-	Cohort                  *kueuev1beta1.CohortReference              `json:"cohort,omitempty"`
-	QueueingStrategy        *kueuev1beta1.QueueingStrategy             `json:"queueingStrategy,omitempty"`
-	NamespaceSelector       *v1.LabelSelectorApplyConfiguration        `json:"namespaceSelector,omitempty"`
-	FlavorFungibility       *FlavorFungibilityApplyConfiguration       `json:"flavorFungibility,omitempty"`
-	Preemption              *ClusterQueuePreemptionApplyConfiguration  `json:"preemption,omitempty"`
-	AdmissionChecks         []kueuev1beta1.AdmissionCheckReference     `json:"admissionChecks,omitempty"`
-	AdmissionChecksStrategy *AdmissionChecksStrategyApplyConfiguration `json:"admissionChecksStrategy,omitempty"`
-	StopPolicy              *kueuev1beta1.StopPolicy                   `json:"stopPolicy,omitempty"`
-	FairSharing             *FairSharingApplyConfiguration             `json:"fairSharing,omitempty"`
-	AdmissionScope          *AdmissionScopeApplyConfiguration          `json:"admissionScope,omitempty"`
+	ResourceGroups            []ResourceGroupApplyConfiguration              `json:"resourceGroups,omitempty"`
+	Cohort                    *kueuev1beta1.CohortReference                  `json:"cohort,omitempty"`
+	QueueingStrategy          *kueuev1beta1.QueueingStrategy                 `json:"queueingStrategy,omitempty"`
+	HeadOfLineTimeoutSeconds  *int32                                         `json:"headOfLineTimeoutSeconds,omitempty"`
+	HeadroomPriorityThreshold *int32                                         `json:"headroomPriorityThreshold,omitempty"`
+	NamespaceSelector         *v1.LabelSelectorApplyConfiguration            `json:"namespaceSelector,omitempty"`
+	FlavorFungibility         *FlavorFungibilityApplyConfiguration           `json:"flavorFungibility,omitempty"`
+	Preemption                *ClusterQueuePreemptionApplyConfiguration      `json:"preemption,omitempty"`
+	AdmissionChecks           []kueuev1beta1.AdmissionCheckReference         `json:"admissionChecks,omitempty"`
+	AdmissionChecksStrategy   *AdmissionChecksStrategyApplyConfiguration     `json:"admissionChecksStrategy,omitempty"`
+	StopPolicy                *kueuev1beta1.StopPolicy                       `json:"stopPolicy,omitempty"`
+	SuccessorClusterQueue     *kueuev1beta1.ClusterQueueReference            `json:"successorClusterQueue,omitempty"`
+	FairSharing               *FairSharingApplyConfiguration                 `json:"fairSharing,omitempty"`
+	AdmissionScope            *AdmissionScopeApplyConfiguration              `json:"admissionScope,omitempty"`
+	TopologyPlacementPolicy   *kueuev1beta1.TopologyPlacementPolicy          `json:"topologyPlacementPolicy,omitempty"`
+	PhysicalCapacityPolicy    *kueuev1beta1.PhysicalCapacityPolicy           `json:"physicalCapacityPolicy,omitempty"`
+	EvictionBackoff           *ClusterQueueEvictionBackoffApplyConfiguration `json:"evictionBackoff,omitempty"`
+	ReactivationPolicy        *ReactivationPolicyApplyConfiguration          `json:"reactivationPolicy,omitempty"`
 }
 
 // ClusterQueueSpecApplyConfiguration constructs a declarative configuration of the ClusterQueueSpec type for use with
@@ -73,6 +80,22 @@ func (b *ClusterQueueSpecApplyConfiguration) WithQueueingStrategy(value kueuev1b
 	return b
 }
 
+// WithHeadOfLineTimeoutSeconds sets the HeadOfLineTimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HeadOfLineTimeoutSeconds field is set to the value of the last call.
+func (b *ClusterQueueSpecApplyConfiguration) WithHeadOfLineTimeoutSeconds(value int32) *ClusterQueueSpecApplyConfiguration {
+	b.HeadOfLineTimeoutSeconds = &value
+	return b
+}
+
+// WithHeadroomPriorityThreshold sets the HeadroomPriorityThreshold field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HeadroomPriorityThreshold field is set to the value of the last call.
+func (b *ClusterQueueSpecApplyConfiguration) WithHeadroomPriorityThreshold(value int32) *ClusterQueueSpecApplyConfiguration {
+	b.HeadroomPriorityThreshold = &value
+	return b
+}
+
 // WithNamespaceSelector sets the NamespaceSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the NamespaceSelector field is set to the value of the last call.
@@ -123,6 +146,14 @@ func (b *ClusterQueueSpecApplyConfiguration) WithStopPolicy(value kueuev1beta1.S
 	return b
 }
 
+// WithSuccessorClusterQueue sets the SuccessorClusterQueue field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuccessorClusterQueue field is set to the value of the last call.
+func (b *ClusterQueueSpecApplyConfiguration) WithSuccessorClusterQueue(value kueuev1beta1.ClusterQueueReference) *ClusterQueueSpecApplyConfiguration {
+	b.SuccessorClusterQueue = &value
+	return b
+}
+
 // WithFairSharing sets the FairSharing field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the FairSharing field is set to the value of the last call.
@@ -138,3 +169,35 @@ func (b *ClusterQueueSpecApplyConfiguration) WithAdmissionScope(value *Admission
 	b.AdmissionScope = value
 	return b
 }
+
+// WithTopologyPlacementPolicy sets the TopologyPlacementPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyPlacementPolicy field is set to the value of the last call.
+func (b *ClusterQueueSpecApplyConfiguration) WithTopologyPlacementPolicy(value kueuev1beta1.TopologyPlacementPolicy) *ClusterQueueSpecApplyConfiguration {
+	b.TopologyPlacementPolicy = &value
+	return b
+}
+
+// WithPhysicalCapacityPolicy sets the PhysicalCapacityPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PhysicalCapacityPolicy field is set to the value of the last call.
+func (b *ClusterQueueSpecApplyConfiguration) WithPhysicalCapacityPolicy(value kueuev1beta1.PhysicalCapacityPolicy) *ClusterQueueSpecApplyConfiguration {
+	b.PhysicalCapacityPolicy = &value
+	return b
+}
+
+// WithEvictionBackoff sets the EvictionBackoff field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EvictionBackoff field is set to the value of the last call.
+func (b *ClusterQueueSpecApplyConfiguration) WithEvictionBackoff(value *ClusterQueueEvictionBackoffApplyConfiguration) *ClusterQueueSpecApplyConfiguration {
+	b.EvictionBackoff = value
+	return b
+}
+
+// WithReactivationPolicy sets the ReactivationPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReactivationPolicy field is set to the value of the last call.
+func (b *ClusterQueueSpecApplyConfiguration) WithReactivationPolicy(value *ReactivationPolicyApplyConfiguration) *ClusterQueueSpecApplyConfiguration {
+	b.ReactivationPolicy = value
+	return b
+}
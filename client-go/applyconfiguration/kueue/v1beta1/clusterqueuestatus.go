@@ -24,14 +24,16 @@ import (
 // ClusterQueueStatusApplyConfiguration represents a declarative configuration of the ClusterQueueStatus type for use
 // with apply.
 type ClusterQueueStatusApplyConfiguration struct {
-	FlavorsReservation     []FlavorUsageApplyConfiguration                       `json:"flavorsReservation,omitempty"`
-	FlavorsUsage           []FlavorUsageApplyConfiguration                       `json:"flavorsUsage,omitempty"`
-	PendingWorkloads       *int32                                                `json:"pendingWorkloads,omitempty"`
-	ReservingWorkloads     *int32                                                `json:"reservingWorkloads,omitempty"`
-	AdmittedWorkloads      *int32                                                `json:"admittedWorkloads,omitempty"`
-	Conditions             []v1.ConditionApplyConfiguration                      `json:"conditions,omitempty"`
-	PendingWorkloadsStatus *ClusterQueuePendingWorkloadsStatusApplyConfiguration `json:"pendingWorkloadsStatus,omitempty"`
-	FairSharing            *FairSharingStatusApplyConfiguration                  `json:"fairSharing,omitempty"`
+	FlavorsReservation      []FlavorUsageApplyConfiguration                       `json:"flavorsReservation,omitempty"`
+	FlavorsUsage            []FlavorUsageApplyConfiguration                       `json:"flavorsUsage,omitempty"`
+	PendingWorkloads        *int32                                                `json:"pendingWorkloads,omitempty"`
+	ReservingWorkloads      *int32                                                `json:"reservingWorkloads,omitempty"`
+	AdmittedWorkloads       *int32                                                `json:"admittedWorkloads,omitempty"`
+	Conditions              []v1.ConditionApplyConfiguration                      `json:"conditions,omitempty"`
+	PendingWorkloadsStatus  *ClusterQueuePendingWorkloadsStatusApplyConfiguration `json:"pendingWorkloadsStatus,omitempty"`
+	FairSharing             *FairSharingStatusApplyConfiguration                  `json:"fairSharing,omitempty"`
+	FlavorsPhysicalCapacity []FlavorPhysicalCapacityApplyConfiguration            `json:"flavorsPhysicalCapacity,omitempty"`
+	Problems                []ClusterQueueProblemApplyConfiguration               `json:"problems,omitempty"`
 }
 
 // ClusterQueueStatusApplyConfiguration constructs a declarative configuration of the ClusterQueueStatus type for use with
@@ -118,3 +120,29 @@ func (b *ClusterQueueStatusApplyConfiguration) WithFairSharing(value *FairSharin
 	b.FairSharing = value
 	return b
 }
+
+// WithFlavorsPhysicalCapacity adds the given value to the FlavorsPhysicalCapacity field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the FlavorsPhysicalCapacity field.
+func (b *ClusterQueueStatusApplyConfiguration) WithFlavorsPhysicalCapacity(values ...*FlavorPhysicalCapacityApplyConfiguration) *ClusterQueueStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithFlavorsPhysicalCapacity")
+		}
+		b.FlavorsPhysicalCapacity = append(b.FlavorsPhysicalCapacity, *values[i])
+	}
+	return b
+}
+
+// WithProblems adds the given value to the Problems field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Problems field.
+func (b *ClusterQueueStatusApplyConfiguration) WithProblems(values ...*ClusterQueueProblemApplyConfiguration) *ClusterQueueStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithProblems")
+		}
+		b.Problems = append(b.Problems, *values[i])
+	}
+	return b
+}
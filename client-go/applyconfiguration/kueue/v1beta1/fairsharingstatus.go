@@ -17,10 +17,15 @@ limitations under the License.
 
 package v1beta1
 
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
 // FairSharingStatusApplyConfiguration represents a declarative configuration of the FairSharingStatus type for use
 // with apply.
 type FairSharingStatusApplyConfiguration struct {
 	WeightedShare              *int64                                        `json:"weightedShare,omitempty"`
+	DecayedUsage               *v1.ResourceList                              `json:"decayedUsage,omitempty"`
 	AdmissionFairSharingStatus *AdmissionFairSharingStatusApplyConfiguration `json:"admissionFairSharingStatus,omitempty"`
 }
 
@@ -38,6 +43,14 @@ func (b *FairSharingStatusApplyConfiguration) WithWeightedShare(value int64) *Fa
 	return b
 }
 
+// WithDecayedUsage sets the DecayedUsage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DecayedUsage field is set to the value of the last call.
+func (b *FairSharingStatusApplyConfiguration) WithDecayedUsage(value v1.ResourceList) *FairSharingStatusApplyConfiguration {
+	b.DecayedUsage = &value
+	return b
+}
+
 // WithAdmissionFairSharingStatus sets the AdmissionFairSharingStatus field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the AdmissionFairSharingStatus field is set to the value of the last call.
@@ -26,6 +26,7 @@ import (
 type FlavorFungibilityApplyConfiguration struct {
 	WhenCanBorrow  *kueuev1beta1.FlavorFungibilityPolicy `json:"whenCanBorrow,omitempty"`
 	WhenCanPreempt *kueuev1beta1.FlavorFungibilityPolicy `json:"whenCanPreempt,omitempty"`
+	FlavorOrder    *kueuev1beta1.FlavorOrderPolicy       `json:"flavorOrder,omitempty"`
 }
 
 // FlavorFungibilityApplyConfiguration constructs a declarative configuration of the FlavorFungibility type for use with
@@ -49,3 +50,11 @@ func (b *FlavorFungibilityApplyConfiguration) WithWhenCanPreempt(value kueuev1be
 	b.WhenCanPreempt = &value
 	return b
 }
+
+// WithFlavorOrder sets the FlavorOrder field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FlavorOrder field is set to the value of the last call.
+func (b *FlavorFungibilityApplyConfiguration) WithFlavorOrder(value kueuev1beta1.FlavorOrderPolicy) *FlavorFungibilityApplyConfiguration {
+	b.FlavorOrder = &value
+	return b
+}
@@ -18,15 +18,17 @@ limitations under the License.
 package v1beta1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kueuev1beta1 "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 )
 
 // LocalQueueSpecApplyConfiguration represents a declarative configuration of the LocalQueueSpec type for use
 // with apply.
 type LocalQueueSpecApplyConfiguration struct {
-	ClusterQueue *kueuev1beta1.ClusterQueueReference `json:"clusterQueue,omitempty"`
-	StopPolicy   *kueuev1beta1.StopPolicy            `json:"stopPolicy,omitempty"`
-	FairSharing  *FairSharingApplyConfiguration      `json:"fairSharing,omitempty"`
+	ClusterQueue    *kueuev1beta1.ClusterQueueReference `json:"clusterQueue,omitempty"`
+	StopPolicy      *kueuev1beta1.StopPolicy            `json:"stopPolicy,omitempty"`
+	FairSharing     *FairSharingApplyConfiguration      `json:"fairSharing,omitempty"`
+	AdmissionTarget *v1.Duration                        `json:"admissionTarget,omitempty"`
 }
 
 // LocalQueueSpecApplyConfiguration constructs a declarative configuration of the LocalQueueSpec type for use with
@@ -58,3 +60,11 @@ func (b *LocalQueueSpecApplyConfiguration) WithFairSharing(value *FairSharingApp
 	b.FairSharing = value
 	return b
 }
+
+// WithAdmissionTarget sets the AdmissionTarget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AdmissionTarget field is set to the value of the last call.
+func (b *LocalQueueSpecApplyConfiguration) WithAdmissionTarget(value v1.Duration) *LocalQueueSpecApplyConfiguration {
+	b.AdmissionTarget = &value
+	return b
+}
@@ -31,6 +31,7 @@ type PodSetAssignmentApplyConfiguration struct {
 	Count                  *int32                                                   `json:"count,omitempty"`
 	TopologyAssignment     *TopologyAssignmentApplyConfiguration                    `json:"topologyAssignment,omitempty"`
 	DelayedTopologyRequest *kueuev1beta1.DelayedTopologyRequestState                `json:"delayedTopologyRequest,omitempty"`
+	AchievedTopologyLevel  *string                                                  `json:"achievedTopologyLevel,omitempty"`
 }
 
 // PodSetAssignmentApplyConfiguration constructs a declarative configuration of the PodSetAssignment type for use with
@@ -92,3 +93,11 @@ func (b *PodSetAssignmentApplyConfiguration) WithDelayedTopologyRequest(value ku
 	b.DelayedTopologyRequest = &value
 	return b
 }
+
+// WithAchievedTopologyLevel sets the AchievedTopologyLevel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AchievedTopologyLevel field is set to the value of the last call.
+func (b *PodSetAssignmentApplyConfiguration) WithAchievedTopologyLevel(value string) *PodSetAssignmentApplyConfiguration {
+	b.AchievedTopologyLevel = &value
+	return b
+}
@@ -29,6 +29,9 @@ type PodSetTopologyRequestApplyConfiguration struct {
 	PodSetGroupName             *string `json:"podSetGroupName,omitempty"`
 	PodSetSliceRequiredTopology *string `json:"podSetSliceRequiredTopology,omitempty"`
 	PodSetSliceSize             *int32  `json:"podSetSliceSize,omitempty"`
+	PodSetExclusiveTopology     *string `json:"podSetExclusiveTopology,omitempty"`
+	PodSetBalancedTopology      *string `json:"podSetBalancedTopology,omitempty"`
+	PodSetBalancedDomainCount   *int32  `json:"podSetBalancedDomainCount,omitempty"`
 }
 
 // PodSetTopologyRequestApplyConfiguration constructs a declarative configuration of the PodSetTopologyRequest type for use with
@@ -108,3 +111,27 @@ func (b *PodSetTopologyRequestApplyConfiguration) WithPodSetSliceSize(value int3
 	b.PodSetSliceSize = &value
 	return b
 }
+
+// WithPodSetExclusiveTopology sets the PodSetExclusiveTopology field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodSetExclusiveTopology field is set to the value of the last call.
+func (b *PodSetTopologyRequestApplyConfiguration) WithPodSetExclusiveTopology(value string) *PodSetTopologyRequestApplyConfiguration {
+	b.PodSetExclusiveTopology = &value
+	return b
+}
+
+// WithPodSetBalancedTopology sets the PodSetBalancedTopology field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodSetBalancedTopology field is set to the value of the last call.
+func (b *PodSetTopologyRequestApplyConfiguration) WithPodSetBalancedTopology(value string) *PodSetTopologyRequestApplyConfiguration {
+	b.PodSetBalancedTopology = &value
+	return b
+}
+
+// WithPodSetBalancedDomainCount sets the PodSetBalancedDomainCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodSetBalancedDomainCount field is set to the value of the last call.
+func (b *PodSetTopologyRequestApplyConfiguration) WithPodSetBalancedDomainCount(value int32) *PodSetTopologyRequestApplyConfiguration {
+	b.PodSetBalancedDomainCount = &value
+	return b
+}
@@ -31,6 +31,7 @@ type ProvisioningRequestConfigSpecApplyConfiguration struct {
 	RetryStrategy         *ProvisioningRequestRetryStrategyApplyConfiguration      `json:"retryStrategy,omitempty"`
 	PodSetUpdates         *ProvisioningRequestPodSetUpdatesApplyConfiguration      `json:"podSetUpdates,omitempty"`
 	PodSetMergePolicy     *kueuev1beta1.ProvisioningRequestConfigPodSetMergePolicy `json:"podSetMergePolicy,omitempty"`
+	Consolidation         *ProvisioningRequestConsolidationApplyConfiguration      `json:"consolidation,omitempty"`
 }
 
 // ProvisioningRequestConfigSpecApplyConfiguration constructs a declarative configuration of the ProvisioningRequestConfigSpec type for use with
@@ -94,3 +95,11 @@ func (b *ProvisioningRequestConfigSpecApplyConfiguration) WithPodSetMergePolicy(
 	b.PodSetMergePolicy = &value
 	return b
 }
+
+// WithConsolidation sets the Consolidation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Consolidation field is set to the value of the last call.
+func (b *ProvisioningRequestConfigSpecApplyConfiguration) WithConsolidation(value *ProvisioningRequestConsolidationApplyConfiguration) *ProvisioningRequestConfigSpecApplyConfiguration {
+	b.Consolidation = value
+	return b
+}
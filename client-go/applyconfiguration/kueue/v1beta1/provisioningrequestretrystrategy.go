@@ -17,12 +17,17 @@ limitations under the License.
 
 package v1beta1
 
+import (
+	kueuev1beta1 "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
 // ProvisioningRequestRetryStrategyApplyConfiguration represents a declarative configuration of the ProvisioningRequestRetryStrategy type for use
 // with apply.
 type ProvisioningRequestRetryStrategyApplyConfiguration struct {
-	BackoffLimitCount  *int32 `json:"backoffLimitCount,omitempty"`
-	BackoffBaseSeconds *int32 `json:"backoffBaseSeconds,omitempty"`
-	BackoffMaxSeconds  *int32 `json:"backoffMaxSeconds,omitempty"`
+	BackoffLimitCount  *int32                                         `json:"backoffLimitCount,omitempty"`
+	BackoffBaseSeconds *int32                                         `json:"backoffBaseSeconds,omitempty"`
+	BackoffMaxSeconds  *int32                                         `json:"backoffMaxSeconds,omitempty"`
+	FailurePolicy      *kueuev1beta1.ProvisioningRequestFailurePolicy `json:"failurePolicy,omitempty"`
 }
 
 // ProvisioningRequestRetryStrategyApplyConfiguration constructs a declarative configuration of the ProvisioningRequestRetryStrategy type for use with
@@ -54,3 +59,11 @@ func (b *ProvisioningRequestRetryStrategyApplyConfiguration) WithBackoffMaxSecon
 	b.BackoffMaxSeconds = &value
 	return b
 }
+
+// WithFailurePolicy sets the FailurePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailurePolicy field is set to the value of the last call.
+func (b *ProvisioningRequestRetryStrategyApplyConfiguration) WithFailurePolicy(value kueuev1beta1.ProvisioningRequestFailurePolicy) *ProvisioningRequestRetryStrategyApplyConfiguration {
+	b.FailurePolicy = &value
+	return b
+}
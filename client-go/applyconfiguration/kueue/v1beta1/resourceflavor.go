@@ -28,7 +28,8 @@ import (
 type ResourceFlavorApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *ResourceFlavorSpecApplyConfiguration `json:"spec,omitempty"`
+	Spec                             *ResourceFlavorSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *ResourceFlavorStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // ResourceFlavor constructs a declarative configuration of the ResourceFlavor type for use with
@@ -208,6 +209,14 @@ func (b *ResourceFlavorApplyConfiguration) WithSpec(value *ResourceFlavorSpecApp
 	return b
 }
 
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *ResourceFlavorApplyConfiguration) WithStatus(value *ResourceFlavorStatusApplyConfiguration) *ResourceFlavorApplyConfiguration {
+	b.Status = value
+	return b
+}
+
 // GetKind retrieves the value of the Kind field in the declarative configuration.
 func (b *ResourceFlavorApplyConfiguration) GetKind() *string {
 	return b.TypeMetaApplyConfiguration.Kind
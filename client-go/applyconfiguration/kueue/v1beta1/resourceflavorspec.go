@@ -18,6 +18,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/client-go/applyconfigurations/core/v1"
 	kueuev1beta1 "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 )
@@ -25,10 +26,12 @@ import (
 // ResourceFlavorSpecApplyConfiguration represents a declarative configuration of the ResourceFlavorSpec type for use
 // with apply.
 type ResourceFlavorSpecApplyConfiguration struct {
-	NodeLabels   map[string]string                 `json:"nodeLabels,omitempty"`
-	NodeTaints   []v1.TaintApplyConfiguration      `json:"nodeTaints,omitempty"`
-	Tolerations  []v1.TolerationApplyConfiguration `json:"tolerations,omitempty"`
-	TopologyName *kueuev1beta1.TopologyReference   `json:"topologyName,omitempty"`
+	NodeLabels         map[string]string                     `json:"nodeLabels,omitempty"`
+	NodeTaints         []v1.TaintApplyConfiguration          `json:"nodeTaints,omitempty"`
+	Tolerations        []v1.TolerationApplyConfiguration     `json:"tolerations,omitempty"`
+	TopologyName       *kueuev1beta1.TopologyReference       `json:"topologyName,omitempty"`
+	ResourcePartitions []ResourcePartitionApplyConfiguration `json:"resourcePartitions,omitempty"`
+	PodOverhead        *corev1.ResourceList                  `json:"podOverhead,omitempty"`
 }
 
 // ResourceFlavorSpecApplyConfiguration constructs a declarative configuration of the ResourceFlavorSpec type for use with
@@ -84,3 +87,24 @@ func (b *ResourceFlavorSpecApplyConfiguration) WithTopologyName(value kueuev1bet
 	b.TopologyName = &value
 	return b
 }
+
+// WithResourcePartitions adds the given value to the ResourcePartitions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ResourcePartitions field.
+func (b *ResourceFlavorSpecApplyConfiguration) WithResourcePartitions(values ...*ResourcePartitionApplyConfiguration) *ResourceFlavorSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithResourcePartitions")
+		}
+		b.ResourcePartitions = append(b.ResourcePartitions, *values[i])
+	}
+	return b
+}
+
+// WithPodOverhead sets the PodOverhead field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodOverhead field is set to the value of the last call.
+func (b *ResourceFlavorSpecApplyConfiguration) WithPodOverhead(value corev1.ResourceList) *ResourceFlavorSpecApplyConfiguration {
+	b.PodOverhead = &value
+	return b
+}
@@ -25,10 +25,14 @@ import (
 // ResourceQuotaApplyConfiguration represents a declarative configuration of the ResourceQuota type for use
 // with apply.
 type ResourceQuotaApplyConfiguration struct {
-	Name           *v1.ResourceName   `json:"name,omitempty"`
-	NominalQuota   *resource.Quantity `json:"nominalQuota,omitempty"`
-	BorrowingLimit *resource.Quantity `json:"borrowingLimit,omitempty"`
-	LendingLimit   *resource.Quantity `json:"lendingLimit,omitempty"`
+	Name                *v1.ResourceName                       `json:"name,omitempty"`
+	NominalQuota        *resource.Quantity                     `json:"nominalQuota,omitempty"`
+	BorrowingLimit      *resource.Quantity                     `json:"borrowingLimit,omitempty"`
+	LendingLimit        *resource.Quantity                     `json:"lendingLimit,omitempty"`
+	PeerBorrowingLimits []PeerBorrowingLimitApplyConfiguration `json:"peerBorrowingLimits,omitempty"`
+	OvercommitPercent   *int32                                 `json:"overcommitPercent,omitempty"`
+	ReservedHeadroom    *resource.Quantity                     `json:"reservedHeadroom,omitempty"`
+	MaxRatio            *ResourceRatioApplyConfiguration       `json:"maxRatio,omitempty"`
 }
 
 // ResourceQuotaApplyConfiguration constructs a declarative configuration of the ResourceQuota type for use with
@@ -68,3 +72,40 @@ func (b *ResourceQuotaApplyConfiguration) WithLendingLimit(value resource.Quanti
 	b.LendingLimit = &value
 	return b
 }
+
+// WithPeerBorrowingLimits adds the given value to the PeerBorrowingLimits field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PeerBorrowingLimits field.
+func (b *ResourceQuotaApplyConfiguration) WithPeerBorrowingLimits(values ...*PeerBorrowingLimitApplyConfiguration) *ResourceQuotaApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPeerBorrowingLimits")
+		}
+		b.PeerBorrowingLimits = append(b.PeerBorrowingLimits, *values[i])
+	}
+	return b
+}
+
+// WithOvercommitPercent sets the OvercommitPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OvercommitPercent field is set to the value of the last call.
+func (b *ResourceQuotaApplyConfiguration) WithOvercommitPercent(value int32) *ResourceQuotaApplyConfiguration {
+	b.OvercommitPercent = &value
+	return b
+}
+
+// WithReservedHeadroom sets the ReservedHeadroom field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReservedHeadroom field is set to the value of the last call.
+func (b *ResourceQuotaApplyConfiguration) WithReservedHeadroom(value resource.Quantity) *ResourceQuotaApplyConfiguration {
+	b.ReservedHeadroom = &value
+	return b
+}
+
+// WithMaxRatio sets the MaxRatio field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRatio field is set to the value of the last call.
+func (b *ResourceQuotaApplyConfiguration) WithMaxRatio(value *ResourceRatioApplyConfiguration) *ResourceQuotaApplyConfiguration {
+	b.MaxRatio = value
+	return b
+}
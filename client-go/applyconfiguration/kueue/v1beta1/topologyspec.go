@@ -17,10 +17,16 @@ limitations under the License.
 
 package v1beta1
 
+import (
+	kueuev1beta1 "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
 // TopologySpecApplyConfiguration represents a declarative configuration of the TopologySpec type for use
 // with apply.
 type TopologySpecApplyConfiguration struct {
-	Levels []TopologyLevelApplyConfiguration `json:"levels,omitempty"`
+	Levels          []TopologyLevelApplyConfiguration       `json:"levels,omitempty"`
+	PlacementPolicy *kueuev1beta1.TopologyPlacementPolicy   `json:"placementPolicy,omitempty"`
+	DomainHealth    *TopologyDomainHealthApplyConfiguration `json:"domainHealth,omitempty"`
 }
 
 // TopologySpecApplyConfiguration constructs a declarative configuration of the TopologySpec type for use with
@@ -41,3 +47,19 @@ func (b *TopologySpecApplyConfiguration) WithLevels(values ...*TopologyLevelAppl
 	}
 	return b
 }
+
+// WithPlacementPolicy sets the PlacementPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PlacementPolicy field is set to the value of the last call.
+func (b *TopologySpecApplyConfiguration) WithPlacementPolicy(value kueuev1beta1.TopologyPlacementPolicy) *TopologySpecApplyConfiguration {
+	b.PlacementPolicy = &value
+	return b
+}
+
+// WithDomainHealth sets the DomainHealth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DomainHealth field is set to the value of the last call.
+func (b *TopologySpecApplyConfiguration) WithDomainHealth(value *TopologyDomainHealthApplyConfiguration) *TopologySpecApplyConfiguration {
+	b.DomainHealth = value
+	return b
+}
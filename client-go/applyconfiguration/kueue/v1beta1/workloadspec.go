@@ -31,6 +31,7 @@ type WorkloadSpecApplyConfiguration struct {
 	PriorityClassSource         *string                      `json:"priorityClassSource,omitempty"`
 	Active                      *bool                        `json:"active,omitempty"`
 	MaximumExecutionTimeSeconds *int32                       `json:"maximumExecutionTimeSeconds,omitempty"`
+	Held                        *bool                        `json:"held,omitempty"`
 }
 
 // WorkloadSpecApplyConfiguration constructs a declarative configuration of the WorkloadSpec type for use with
@@ -99,3 +100,11 @@ func (b *WorkloadSpecApplyConfiguration) WithMaximumExecutionTimeSeconds(value i
 	b.MaximumExecutionTimeSeconds = &value
 	return b
 }
+
+// WithHeld sets the Held field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Held field is set to the value of the last call.
+func (b *WorkloadSpecApplyConfiguration) WithHeld(value bool) *WorkloadSpecApplyConfiguration {
+	b.Held = &value
+	return b
+}
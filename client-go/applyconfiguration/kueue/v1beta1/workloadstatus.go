@@ -35,6 +35,12 @@ type WorkloadStatusApplyConfiguration struct {
 	NominatedClusterNames                []string                                `json:"nominatedClusterNames,omitempty"`
 	ClusterName                          *string                                 `json:"clusterName,omitempty"`
 	UnhealthyNodes                       []UnhealthyNodeApplyConfiguration       `json:"unhealthyNodes,omitempty"`
+	UnschedulableReasons                 []UnschedulableReasonApplyConfiguration `json:"unschedulableReasons,omitempty"`
+	LastAdmittedFlavors                  []PodSetFlavorsApplyConfiguration       `json:"lastAdmittedFlavors,omitempty"`
+	AdmissionChecksSummary               *string                                 `json:"admissionChecksSummary,omitempty"`
+	ExcludedFlavors                      []ExcludedFlavorApplyConfiguration      `json:"excludedFlavors,omitempty"`
+	RequeueHistory                       []RequeueRecordApplyConfiguration       `json:"requeueHistory,omitempty"`
+	ActualUsage                          []PodSetActualUsageApplyConfiguration   `json:"actualUsage,omitempty"`
 }
 
 // WorkloadStatusApplyConfiguration constructs a declarative configuration of the WorkloadStatus type for use with
@@ -157,3 +163,76 @@ func (b *WorkloadStatusApplyConfiguration) WithUnhealthyNodes(values ...*Unhealt
 	}
 	return b
 }
+
+// WithUnschedulableReasons adds the given value to the UnschedulableReasons field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the UnschedulableReasons field.
+func (b *WorkloadStatusApplyConfiguration) WithUnschedulableReasons(values ...*UnschedulableReasonApplyConfiguration) *WorkloadStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithUnschedulableReasons")
+		}
+		b.UnschedulableReasons = append(b.UnschedulableReasons, *values[i])
+	}
+	return b
+}
+
+// WithLastAdmittedFlavors adds the given value to the LastAdmittedFlavors field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the LastAdmittedFlavors field.
+func (b *WorkloadStatusApplyConfiguration) WithLastAdmittedFlavors(values ...*PodSetFlavorsApplyConfiguration) *WorkloadStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithLastAdmittedFlavors")
+		}
+		b.LastAdmittedFlavors = append(b.LastAdmittedFlavors, *values[i])
+	}
+	return b
+}
+
+// WithAdmissionChecksSummary sets the AdmissionChecksSummary field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AdmissionChecksSummary field is set to the value of the last call.
+func (b *WorkloadStatusApplyConfiguration) WithAdmissionChecksSummary(value string) *WorkloadStatusApplyConfiguration {
+	b.AdmissionChecksSummary = &value
+	return b
+}
+
+// WithExcludedFlavors adds the given value to the ExcludedFlavors field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExcludedFlavors field.
+func (b *WorkloadStatusApplyConfiguration) WithExcludedFlavors(values ...*ExcludedFlavorApplyConfiguration) *WorkloadStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithExcludedFlavors")
+		}
+		b.ExcludedFlavors = append(b.ExcludedFlavors, *values[i])
+	}
+	return b
+}
+
+// WithRequeueHistory adds the given value to the RequeueHistory field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RequeueHistory field.
+func (b *WorkloadStatusApplyConfiguration) WithRequeueHistory(values ...*RequeueRecordApplyConfiguration) *WorkloadStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRequeueHistory")
+		}
+		b.RequeueHistory = append(b.RequeueHistory, *values[i])
+	}
+	return b
+}
+
+// WithActualUsage adds the given value to the ActualUsage field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ActualUsage field.
+func (b *WorkloadStatusApplyConfiguration) WithActualUsage(values ...*PodSetActualUsageApplyConfiguration) *WorkloadStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithActualUsage")
+		}
+		b.ActualUsage = append(b.ActualUsage, *values[i])
+	}
+	return b
+}
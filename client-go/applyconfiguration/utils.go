@@ -55,14 +55,34 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta1.AdmissionScopeApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("BorrowWithinCohort"):
 		return &kueuev1beta1.BorrowWithinCohortApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Budget"):
+		return &kueuev1beta1.BudgetApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("BudgetAdmissionCheckConfig"):
+		return &kueuev1beta1.BudgetAdmissionCheckConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("BudgetAdmissionCheckConfigSpec"):
+		return &kueuev1beta1.BudgetAdmissionCheckConfigSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("BudgetSpec"):
+		return &kueuev1beta1.BudgetSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("BudgetStatus"):
+		return &kueuev1beta1.BudgetStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CapacityReservation"):
+		return &kueuev1beta1.CapacityReservationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CapacityReservationAdmissionCheckConfig"):
+		return &kueuev1beta1.CapacityReservationAdmissionCheckConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CapacityReservationAdmissionCheckConfigSpec"):
+		return &kueuev1beta1.CapacityReservationAdmissionCheckConfigSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ClusterQueue"):
 		return &kueuev1beta1.ClusterQueueApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClusterQueueEvictionBackoff"):
+		return &kueuev1beta1.ClusterQueueEvictionBackoffApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ClusterQueuePendingWorkload"):
 		return &kueuev1beta1.ClusterQueuePendingWorkloadApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ClusterQueuePendingWorkloadsStatus"):
 		return &kueuev1beta1.ClusterQueuePendingWorkloadsStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ClusterQueuePreemption"):
 		return &kueuev1beta1.ClusterQueuePreemptionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ClusterQueueProblem"):
+		return &kueuev1beta1.ClusterQueueProblemApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ClusterQueueSpec"):
 		return &kueuev1beta1.ClusterQueueSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ClusterQueueStatus"):
@@ -73,16 +93,44 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta1.CohortSpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("CohortStatus"):
 		return &kueuev1beta1.CohortStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ContinuousAdmissionCheck"):
+		return &kueuev1beta1.ContinuousAdmissionCheckApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ExcludedFlavor"):
+		return &kueuev1beta1.ExcludedFlavorApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("FairSharing"):
 		return &kueuev1beta1.FairSharingApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("FairSharingStatus"):
 		return &kueuev1beta1.FairSharingStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("FlavorFungibility"):
 		return &kueuev1beta1.FlavorFungibilityApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("FlavorPhysicalCapacity"):
+		return &kueuev1beta1.FlavorPhysicalCapacityApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("FlavorPrices"):
+		return &kueuev1beta1.FlavorPricesApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("FlavorQuotas"):
 		return &kueuev1beta1.FlavorQuotasApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("FlavorUsage"):
 		return &kueuev1beta1.FlavorUsageApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPAdmissionCheckConfig"):
+		return &kueuev1beta1.HTTPAdmissionCheckConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPAdmissionCheckConfigSpec"):
+		return &kueuev1beta1.HTTPAdmissionCheckConfigSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPAdmissionCheckSecretReference"):
+		return &kueuev1beta1.HTTPAdmissionCheckSecretReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ImagePrePullAdmissionCheckConfig"):
+		return &kueuev1beta1.ImagePrePullAdmissionCheckConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ImagePrePullAdmissionCheckConfigSpec"):
+		return &kueuev1beta1.ImagePrePullAdmissionCheckConfigSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ImageVerificationAdmissionCheckConfig"):
+		return &kueuev1beta1.ImageVerificationAdmissionCheckConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ImageVerificationAdmissionCheckConfigSpec"):
+		return &kueuev1beta1.ImageVerificationAdmissionCheckConfigSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("KarpenterAdmissionCheckConfig"):
+		return &kueuev1beta1.KarpenterAdmissionCheckConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("KarpenterAdmissionCheckConfigSpec"):
+		return &kueuev1beta1.KarpenterAdmissionCheckConfigSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("KarpenterNodeClassReference"):
+		return &kueuev1beta1.KarpenterNodeClassReferenceApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("KubeConfig"):
 		return &kueuev1beta1.KubeConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("LocalQueue"):
@@ -107,10 +155,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta1.MultiKueueConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MultiKueueConfigSpec"):
 		return &kueuev1beta1.MultiKueueConfigSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PeerBorrowingLimit"):
+		return &kueuev1beta1.PeerBorrowingLimitApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("PodSet"):
 		return &kueuev1beta1.PodSetApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PodSetActualUsage"):
+		return &kueuev1beta1.PodSetActualUsageApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("PodSetAssignment"):
 		return &kueuev1beta1.PodSetAssignmentApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("PodSetFlavors"):
+		return &kueuev1beta1.PodSetFlavorsApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("PodSetRequest"):
 		return &kueuev1beta1.PodSetRequestApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("PodSetTopologyRequest"):
@@ -121,34 +175,70 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta1.ProvisioningRequestConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ProvisioningRequestConfigSpec"):
 		return &kueuev1beta1.ProvisioningRequestConfigSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ProvisioningRequestConsolidation"):
+		return &kueuev1beta1.ProvisioningRequestConsolidationApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ProvisioningRequestPodSetUpdates"):
 		return &kueuev1beta1.ProvisioningRequestPodSetUpdatesApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ProvisioningRequestPodSetUpdatesNodeSelector"):
 		return &kueuev1beta1.ProvisioningRequestPodSetUpdatesNodeSelectorApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ProvisioningRequestRetryStrategy"):
 		return &kueuev1beta1.ProvisioningRequestRetryStrategyApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ReactivationPolicy"):
+		return &kueuev1beta1.ReactivationPolicyApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ReclaimablePod"):
 		return &kueuev1beta1.ReclaimablePodApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RequeueRecord"):
+		return &kueuev1beta1.RequeueRecordApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RequeueRecordPreemptor"):
+		return &kueuev1beta1.RequeueRecordPreemptorApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("RequeueState"):
 		return &kueuev1beta1.RequeueStateApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Reservation"):
+		return &kueuev1beta1.ReservationApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ReservationHolder"):
+		return &kueuev1beta1.ReservationHolderApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ReservationSpec"):
+		return &kueuev1beta1.ReservationSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ReservationStatus"):
+		return &kueuev1beta1.ReservationStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ReservedFlavor"):
+		return &kueuev1beta1.ReservedFlavorApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ResourceFlavor"):
 		return &kueuev1beta1.ResourceFlavorApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ResourceFlavorSpec"):
 		return &kueuev1beta1.ResourceFlavorSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ResourceFlavorStatus"):
+		return &kueuev1beta1.ResourceFlavorStatusApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ResourceGroup"):
 		return &kueuev1beta1.ResourceGroupApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ResourcePartition"):
+		return &kueuev1beta1.ResourcePartitionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ResourcePhysicalCapacity"):
+		return &kueuev1beta1.ResourcePhysicalCapacityApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ResourcePrice"):
+		return &kueuev1beta1.ResourcePriceApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ResourceQuota"):
 		return &kueuev1beta1.ResourceQuotaApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ResourceRatio"):
+		return &kueuev1beta1.ResourceRatioApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("ResourceUsage"):
 		return &kueuev1beta1.ResourceUsageApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("SchedulingStats"):
 		return &kueuev1beta1.SchedulingStatsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SlurmAdmissionCheckConfig"):
+		return &kueuev1beta1.SlurmAdmissionCheckConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SlurmAdmissionCheckConfigSpec"):
+		return &kueuev1beta1.SlurmAdmissionCheckConfigSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SlurmAdmissionCheckSecretReference"):
+		return &kueuev1beta1.SlurmAdmissionCheckSecretReferenceApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Topology"):
 		return &kueuev1beta1.TopologyApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("TopologyAssignment"):
 		return &kueuev1beta1.TopologyAssignmentApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("TopologyDomainAssignment"):
 		return &kueuev1beta1.TopologyDomainAssignmentApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("TopologyDomainHealth"):
+		return &kueuev1beta1.TopologyDomainHealthApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("TopologyInfo"):
 		return &kueuev1beta1.TopologyInfoApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("TopologyLevel"):
@@ -157,6 +247,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta1.TopologySpecApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("UnhealthyNode"):
 		return &kueuev1beta1.UnhealthyNodeApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("UnschedulableReason"):
+		return &kueuev1beta1.UnschedulableReasonApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Workload"):
 		return &kueuev1beta1.WorkloadApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("WorkloadPriorityClass"):
@@ -169,14 +261,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &kueuev1beta1.WorkloadStatusApplyConfiguration{}
 
 		// Group=visibility.kueue.x-k8s.io, Version=v1beta1
+	case visibilityv1beta1.SchemeGroupVersion.WithKind("AdmissionExplanation"):
+		return &applyconfigurationvisibilityv1beta1.AdmissionExplanationApplyConfiguration{}
 	case visibilityv1beta1.SchemeGroupVersion.WithKind("ClusterQueue"):
 		return &applyconfigurationvisibilityv1beta1.ClusterQueueApplyConfiguration{}
+	case visibilityv1beta1.SchemeGroupVersion.WithKind("DispatchedWorkload"):
+		return &applyconfigurationvisibilityv1beta1.DispatchedWorkloadApplyConfiguration{}
+	case visibilityv1beta1.SchemeGroupVersion.WithKind("DispatchedWorkloadsSummary"):
+		return &applyconfigurationvisibilityv1beta1.DispatchedWorkloadsSummaryApplyConfiguration{}
+	case visibilityv1beta1.SchemeGroupVersion.WithKind("FlavorShortfall"):
+		return &applyconfigurationvisibilityv1beta1.FlavorShortfallApplyConfiguration{}
 	case visibilityv1beta1.SchemeGroupVersion.WithKind("LocalQueue"):
 		return &applyconfigurationvisibilityv1beta1.LocalQueueApplyConfiguration{}
+	case visibilityv1beta1.SchemeGroupVersion.WithKind("MultiKueueCluster"):
+		return &applyconfigurationvisibilityv1beta1.MultiKueueClusterApplyConfiguration{}
 	case visibilityv1beta1.SchemeGroupVersion.WithKind("PendingWorkload"):
 		return &applyconfigurationvisibilityv1beta1.PendingWorkloadApplyConfiguration{}
 	case visibilityv1beta1.SchemeGroupVersion.WithKind("PendingWorkloadsSummary"):
 		return &applyconfigurationvisibilityv1beta1.PendingWorkloadsSummaryApplyConfiguration{}
+	case visibilityv1beta1.SchemeGroupVersion.WithKind("Workload"):
+		return &applyconfigurationvisibilityv1beta1.WorkloadApplyConfiguration{}
 
 	}
 	return nil
@@ -31,6 +31,18 @@ func (c *FakeKueueV1beta1) AdmissionChecks() v1beta1.AdmissionCheckInterface {
 	return newFakeAdmissionChecks(c)
 }
 
+func (c *FakeKueueV1beta1) Budgets(namespace string) v1beta1.BudgetInterface {
+	return newFakeBudgets(c, namespace)
+}
+
+func (c *FakeKueueV1beta1) BudgetAdmissionCheckConfigs() v1beta1.BudgetAdmissionCheckConfigInterface {
+	return newFakeBudgetAdmissionCheckConfigs(c)
+}
+
+func (c *FakeKueueV1beta1) CapacityReservationAdmissionCheckConfigs() v1beta1.CapacityReservationAdmissionCheckConfigInterface {
+	return newFakeCapacityReservationAdmissionCheckConfigs(c)
+}
+
 func (c *FakeKueueV1beta1) ClusterQueues() v1beta1.ClusterQueueInterface {
 	return newFakeClusterQueues(c)
 }
@@ -39,6 +51,22 @@ func (c *FakeKueueV1beta1) Cohorts(namespace string) v1beta1.CohortInterface {
 	return newFakeCohorts(c, namespace)
 }
 
+func (c *FakeKueueV1beta1) HTTPAdmissionCheckConfigs() v1beta1.HTTPAdmissionCheckConfigInterface {
+	return newFakeHTTPAdmissionCheckConfigs(c)
+}
+
+func (c *FakeKueueV1beta1) ImagePrePullAdmissionCheckConfigs() v1beta1.ImagePrePullAdmissionCheckConfigInterface {
+	return newFakeImagePrePullAdmissionCheckConfigs(c)
+}
+
+func (c *FakeKueueV1beta1) ImageVerificationAdmissionCheckConfigs() v1beta1.ImageVerificationAdmissionCheckConfigInterface {
+	return newFakeImageVerificationAdmissionCheckConfigs(c)
+}
+
+func (c *FakeKueueV1beta1) KarpenterAdmissionCheckConfigs() v1beta1.KarpenterAdmissionCheckConfigInterface {
+	return newFakeKarpenterAdmissionCheckConfigs(c)
+}
+
 func (c *FakeKueueV1beta1) LocalQueues(namespace string) v1beta1.LocalQueueInterface {
 	return newFakeLocalQueues(c, namespace)
 }
@@ -55,10 +83,18 @@ func (c *FakeKueueV1beta1) ProvisioningRequestConfigs() v1beta1.ProvisioningRequ
 	return newFakeProvisioningRequestConfigs(c)
 }
 
+func (c *FakeKueueV1beta1) Reservations() v1beta1.ReservationInterface {
+	return newFakeReservations(c)
+}
+
 func (c *FakeKueueV1beta1) ResourceFlavors() v1beta1.ResourceFlavorInterface {
 	return newFakeResourceFlavors(c)
 }
 
+func (c *FakeKueueV1beta1) SlurmAdmissionCheckConfigs() v1beta1.SlurmAdmissionCheckConfigInterface {
+	return newFakeSlurmAdmissionCheckConfigs(c)
+}
+
 func (c *FakeKueueV1beta1) Topologies() v1beta1.TopologyInterface {
 	return newFakeTopologies(c)
 }
@@ -19,10 +19,24 @@ package v1beta1
 
 type AdmissionCheckExpansion interface{}
 
+type BudgetExpansion interface{}
+
+type BudgetAdmissionCheckConfigExpansion interface{}
+
+type CapacityReservationAdmissionCheckConfigExpansion interface{}
+
 type ClusterQueueExpansion interface{}
 
 type CohortExpansion interface{}
 
+type HTTPAdmissionCheckConfigExpansion interface{}
+
+type ImagePrePullAdmissionCheckConfigExpansion interface{}
+
+type ImageVerificationAdmissionCheckConfigExpansion interface{}
+
+type KarpenterAdmissionCheckConfigExpansion interface{}
+
 type LocalQueueExpansion interface{}
 
 type MultiKueueClusterExpansion interface{}
@@ -31,8 +45,12 @@ type MultiKueueConfigExpansion interface{}
 
 type ProvisioningRequestConfigExpansion interface{}
 
+type ReservationExpansion interface{}
+
 type ResourceFlavorExpansion interface{}
 
+type SlurmAdmissionCheckConfigExpansion interface{}
+
 type TopologyExpansion interface{}
 
 type WorkloadExpansion interface{}
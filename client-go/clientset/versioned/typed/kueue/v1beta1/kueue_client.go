@@ -28,13 +28,22 @@ import (
 type KueueV1beta1Interface interface {
 	RESTClient() rest.Interface
 	AdmissionChecksGetter
+	BudgetsGetter
+	BudgetAdmissionCheckConfigsGetter
+	CapacityReservationAdmissionCheckConfigsGetter
 	ClusterQueuesGetter
 	CohortsGetter
+	HTTPAdmissionCheckConfigsGetter
+	ImagePrePullAdmissionCheckConfigsGetter
+	ImageVerificationAdmissionCheckConfigsGetter
+	KarpenterAdmissionCheckConfigsGetter
 	LocalQueuesGetter
 	MultiKueueClustersGetter
 	MultiKueueConfigsGetter
 	ProvisioningRequestConfigsGetter
+	ReservationsGetter
 	ResourceFlavorsGetter
+	SlurmAdmissionCheckConfigsGetter
 	TopologiesGetter
 	WorkloadsGetter
 	WorkloadPriorityClassesGetter
@@ -49,6 +58,18 @@ func (c *KueueV1beta1Client) AdmissionChecks() AdmissionCheckInterface {
 	return newAdmissionChecks(c)
 }
 
+func (c *KueueV1beta1Client) Budgets(namespace string) BudgetInterface {
+	return newBudgets(c, namespace)
+}
+
+func (c *KueueV1beta1Client) BudgetAdmissionCheckConfigs() BudgetAdmissionCheckConfigInterface {
+	return newBudgetAdmissionCheckConfigs(c)
+}
+
+func (c *KueueV1beta1Client) CapacityReservationAdmissionCheckConfigs() CapacityReservationAdmissionCheckConfigInterface {
+	return newCapacityReservationAdmissionCheckConfigs(c)
+}
+
 func (c *KueueV1beta1Client) ClusterQueues() ClusterQueueInterface {
 	return newClusterQueues(c)
 }
@@ -57,6 +78,22 @@ func (c *KueueV1beta1Client) Cohorts(namespace string) CohortInterface {
 	return newCohorts(c, namespace)
 }
 
+func (c *KueueV1beta1Client) HTTPAdmissionCheckConfigs() HTTPAdmissionCheckConfigInterface {
+	return newHTTPAdmissionCheckConfigs(c)
+}
+
+func (c *KueueV1beta1Client) ImagePrePullAdmissionCheckConfigs() ImagePrePullAdmissionCheckConfigInterface {
+	return newImagePrePullAdmissionCheckConfigs(c)
+}
+
+func (c *KueueV1beta1Client) ImageVerificationAdmissionCheckConfigs() ImageVerificationAdmissionCheckConfigInterface {
+	return newImageVerificationAdmissionCheckConfigs(c)
+}
+
+func (c *KueueV1beta1Client) KarpenterAdmissionCheckConfigs() KarpenterAdmissionCheckConfigInterface {
+	return newKarpenterAdmissionCheckConfigs(c)
+}
+
 func (c *KueueV1beta1Client) LocalQueues(namespace string) LocalQueueInterface {
 	return newLocalQueues(c, namespace)
 }
@@ -73,10 +110,18 @@ func (c *KueueV1beta1Client) ProvisioningRequestConfigs() ProvisioningRequestCon
 	return newProvisioningRequestConfigs(c)
 }
 
+func (c *KueueV1beta1Client) Reservations() ReservationInterface {
+	return newReservations(c)
+}
+
 func (c *KueueV1beta1Client) ResourceFlavors() ResourceFlavorInterface {
 	return newResourceFlavors(c)
 }
 
+func (c *KueueV1beta1Client) SlurmAdmissionCheckConfigs() SlurmAdmissionCheckConfigInterface {
+	return newSlurmAdmissionCheckConfigs(c)
+}
+
 func (c *KueueV1beta1Client) Topologies() TopologyInterface {
 	return newTopologies(c)
 }
@@ -35,6 +35,14 @@ func (c *FakeVisibilityV1beta1) LocalQueues(namespace string) v1beta1.LocalQueue
 	return newFakeLocalQueues(c, namespace)
 }
 
+func (c *FakeVisibilityV1beta1) MultiKueueClusters() v1beta1.MultiKueueClusterInterface {
+	return newFakeMultiKueueClusters(c)
+}
+
+func (c *FakeVisibilityV1beta1) Workloads(namespace string) v1beta1.WorkloadInterface {
+	return newFakeWorkloads(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeVisibilityV1beta1) RESTClient() rest.Interface {
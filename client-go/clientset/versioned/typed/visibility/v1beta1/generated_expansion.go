@@ -20,3 +20,7 @@ package v1beta1
 type ClusterQueueExpansion interface{}
 
 type LocalQueueExpansion interface{}
+
+type MultiKueueClusterExpansion interface{}
+
+type WorkloadExpansion interface{}
@@ -29,6 +29,8 @@ type VisibilityV1beta1Interface interface {
 	RESTClient() rest.Interface
 	ClusterQueuesGetter
 	LocalQueuesGetter
+	MultiKueueClustersGetter
+	WorkloadsGetter
 }
 
 // VisibilityV1beta1Client is used to interact with features provided by the visibility.kueue.x-k8s.io group.
@@ -44,6 +46,14 @@ func (c *VisibilityV1beta1Client) LocalQueues(namespace string) LocalQueueInterf
 	return newLocalQueues(c, namespace)
 }
 
+func (c *VisibilityV1beta1Client) MultiKueueClusters() MultiKueueClusterInterface {
+	return newMultiKueueClusters(c)
+}
+
+func (c *VisibilityV1beta1Client) Workloads(namespace string) WorkloadInterface {
+	return newWorkloads(c, namespace)
+}
+
 // NewForConfig creates a new VisibilityV1beta1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -55,10 +55,24 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=kueue.x-k8s.io, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithResource("admissionchecks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().AdmissionChecks().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("budgets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().Budgets().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("budgetadmissioncheckconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().BudgetAdmissionCheckConfigs().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("capacityreservationadmissioncheckconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().CapacityReservationAdmissionCheckConfigs().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("clusterqueues"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().ClusterQueues().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("cohorts"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().Cohorts().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("httpadmissioncheckconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().HTTPAdmissionCheckConfigs().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("imageprepulladmissioncheckconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().ImagePrePullAdmissionCheckConfigs().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("imageverificationadmissioncheckconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().ImageVerificationAdmissionCheckConfigs().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("karpenteradmissioncheckconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().KarpenterAdmissionCheckConfigs().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("localqueues"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().LocalQueues().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("multikueueclusters"):
@@ -67,8 +81,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().MultiKueueConfigs().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("provisioningrequestconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().ProvisioningRequestConfigs().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("reservations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().Reservations().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("resourceflavors"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().ResourceFlavors().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("slurmadmissioncheckconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().SlurmAdmissionCheckConfigs().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("topologies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kueue().V1beta1().Topologies().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("workloads"):
@@ -81,6 +99,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Visibility().V1beta1().ClusterQueues().Informer()}, nil
 	case visibilityv1beta1.SchemeGroupVersion.WithResource("localqueues"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Visibility().V1beta1().LocalQueues().Informer()}, nil
+	case visibilityv1beta1.SchemeGroupVersion.WithResource("multikueueclusters"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Visibility().V1beta1().MultiKueueClusters().Informer()}, nil
+	case visibilityv1beta1.SchemeGroupVersion.WithResource("workloads"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Visibility().V1beta1().Workloads().Informer()}, nil
 
 	}
 
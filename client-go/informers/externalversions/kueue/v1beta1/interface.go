@@ -25,10 +25,24 @@ import (
 type Interface interface {
 	// AdmissionChecks returns a AdmissionCheckInformer.
 	AdmissionChecks() AdmissionCheckInformer
+	// Budgets returns a BudgetInformer.
+	Budgets() BudgetInformer
+	// BudgetAdmissionCheckConfigs returns a BudgetAdmissionCheckConfigInformer.
+	BudgetAdmissionCheckConfigs() BudgetAdmissionCheckConfigInformer
+	// CapacityReservationAdmissionCheckConfigs returns a CapacityReservationAdmissionCheckConfigInformer.
+	CapacityReservationAdmissionCheckConfigs() CapacityReservationAdmissionCheckConfigInformer
 	// ClusterQueues returns a ClusterQueueInformer.
 	ClusterQueues() ClusterQueueInformer
 	// Cohorts returns a CohortInformer.
 	Cohorts() CohortInformer
+	// HTTPAdmissionCheckConfigs returns a HTTPAdmissionCheckConfigInformer.
+	HTTPAdmissionCheckConfigs() HTTPAdmissionCheckConfigInformer
+	// ImagePrePullAdmissionCheckConfigs returns a ImagePrePullAdmissionCheckConfigInformer.
+	ImagePrePullAdmissionCheckConfigs() ImagePrePullAdmissionCheckConfigInformer
+	// ImageVerificationAdmissionCheckConfigs returns a ImageVerificationAdmissionCheckConfigInformer.
+	ImageVerificationAdmissionCheckConfigs() ImageVerificationAdmissionCheckConfigInformer
+	// KarpenterAdmissionCheckConfigs returns a KarpenterAdmissionCheckConfigInformer.
+	KarpenterAdmissionCheckConfigs() KarpenterAdmissionCheckConfigInformer
 	// LocalQueues returns a LocalQueueInformer.
 	LocalQueues() LocalQueueInformer
 	// MultiKueueClusters returns a MultiKueueClusterInformer.
@@ -37,8 +51,12 @@ type Interface interface {
 	MultiKueueConfigs() MultiKueueConfigInformer
 	// ProvisioningRequestConfigs returns a ProvisioningRequestConfigInformer.
 	ProvisioningRequestConfigs() ProvisioningRequestConfigInformer
+	// Reservations returns a ReservationInformer.
+	Reservations() ReservationInformer
 	// ResourceFlavors returns a ResourceFlavorInformer.
 	ResourceFlavors() ResourceFlavorInformer
+	// SlurmAdmissionCheckConfigs returns a SlurmAdmissionCheckConfigInformer.
+	SlurmAdmissionCheckConfigs() SlurmAdmissionCheckConfigInformer
 	// Topologies returns a TopologyInformer.
 	Topologies() TopologyInformer
 	// Workloads returns a WorkloadInformer.
@@ -63,6 +81,21 @@ func (v *version) AdmissionChecks() AdmissionCheckInformer {
 	return &admissionCheckInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// Budgets returns a BudgetInformer.
+func (v *version) Budgets() BudgetInformer {
+	return &budgetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// BudgetAdmissionCheckConfigs returns a BudgetAdmissionCheckConfigInformer.
+func (v *version) BudgetAdmissionCheckConfigs() BudgetAdmissionCheckConfigInformer {
+	return &budgetAdmissionCheckConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// CapacityReservationAdmissionCheckConfigs returns a CapacityReservationAdmissionCheckConfigInformer.
+func (v *version) CapacityReservationAdmissionCheckConfigs() CapacityReservationAdmissionCheckConfigInformer {
+	return &capacityReservationAdmissionCheckConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ClusterQueues returns a ClusterQueueInformer.
 func (v *version) ClusterQueues() ClusterQueueInformer {
 	return &clusterQueueInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -73,6 +106,26 @@ func (v *version) Cohorts() CohortInformer {
 	return &cohortInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// HTTPAdmissionCheckConfigs returns a HTTPAdmissionCheckConfigInformer.
+func (v *version) HTTPAdmissionCheckConfigs() HTTPAdmissionCheckConfigInformer {
+	return &hTTPAdmissionCheckConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// ImagePrePullAdmissionCheckConfigs returns a ImagePrePullAdmissionCheckConfigInformer.
+func (v *version) ImagePrePullAdmissionCheckConfigs() ImagePrePullAdmissionCheckConfigInformer {
+	return &imagePrePullAdmissionCheckConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// ImageVerificationAdmissionCheckConfigs returns a ImageVerificationAdmissionCheckConfigInformer.
+func (v *version) ImageVerificationAdmissionCheckConfigs() ImageVerificationAdmissionCheckConfigInformer {
+	return &imageVerificationAdmissionCheckConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// KarpenterAdmissionCheckConfigs returns a KarpenterAdmissionCheckConfigInformer.
+func (v *version) KarpenterAdmissionCheckConfigs() KarpenterAdmissionCheckConfigInformer {
+	return &karpenterAdmissionCheckConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // LocalQueues returns a LocalQueueInformer.
 func (v *version) LocalQueues() LocalQueueInformer {
 	return &localQueueInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -93,11 +146,21 @@ func (v *version) ProvisioningRequestConfigs() ProvisioningRequestConfigInformer
 	return &provisioningRequestConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// Reservations returns a ReservationInformer.
+func (v *version) Reservations() ReservationInformer {
+	return &reservationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ResourceFlavors returns a ResourceFlavorInformer.
 func (v *version) ResourceFlavors() ResourceFlavorInformer {
 	return &resourceFlavorInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// SlurmAdmissionCheckConfigs returns a SlurmAdmissionCheckConfigInformer.
+func (v *version) SlurmAdmissionCheckConfigs() SlurmAdmissionCheckConfigInformer {
+	return &slurmAdmissionCheckConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Topologies returns a TopologyInformer.
 func (v *version) Topologies() TopologyInformer {
 	return &topologyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -27,6 +27,10 @@ type Interface interface {
 	ClusterQueues() ClusterQueueInformer
 	// LocalQueues returns a LocalQueueInformer.
 	LocalQueues() LocalQueueInformer
+	// MultiKueueClusters returns a MultiKueueClusterInformer.
+	MultiKueueClusters() MultiKueueClusterInformer
+	// Workloads returns a WorkloadInformer.
+	Workloads() WorkloadInformer
 }
 
 type version struct {
@@ -49,3 +53,13 @@ func (v *version) ClusterQueues() ClusterQueueInformer {
 func (v *version) LocalQueues() LocalQueueInformer {
 	return &localQueueInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// MultiKueueClusters returns a MultiKueueClusterInformer.
+func (v *version) MultiKueueClusters() MultiKueueClusterInformer {
+	return &multiKueueClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Workloads returns a WorkloadInformer.
+func (v *version) Workloads() WorkloadInformer {
+	return &workloadInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
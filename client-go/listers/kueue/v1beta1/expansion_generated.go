@@ -21,6 +21,22 @@ package v1beta1
 // AdmissionCheckLister.
 type AdmissionCheckListerExpansion interface{}
 
+// BudgetListerExpansion allows custom methods to be added to
+// BudgetLister.
+type BudgetListerExpansion interface{}
+
+// BudgetNamespaceListerExpansion allows custom methods to be added to
+// BudgetNamespaceLister.
+type BudgetNamespaceListerExpansion interface{}
+
+// BudgetAdmissionCheckConfigListerExpansion allows custom methods to be added to
+// BudgetAdmissionCheckConfigLister.
+type BudgetAdmissionCheckConfigListerExpansion interface{}
+
+// CapacityReservationAdmissionCheckConfigListerExpansion allows custom methods to be added to
+// CapacityReservationAdmissionCheckConfigLister.
+type CapacityReservationAdmissionCheckConfigListerExpansion interface{}
+
 // ClusterQueueListerExpansion allows custom methods to be added to
 // ClusterQueueLister.
 type ClusterQueueListerExpansion interface{}
@@ -33,6 +49,22 @@ type CohortListerExpansion interface{}
 // CohortNamespaceLister.
 type CohortNamespaceListerExpansion interface{}
 
+// HTTPAdmissionCheckConfigListerExpansion allows custom methods to be added to
+// HTTPAdmissionCheckConfigLister.
+type HTTPAdmissionCheckConfigListerExpansion interface{}
+
+// ImagePrePullAdmissionCheckConfigListerExpansion allows custom methods to be added to
+// ImagePrePullAdmissionCheckConfigLister.
+type ImagePrePullAdmissionCheckConfigListerExpansion interface{}
+
+// ImageVerificationAdmissionCheckConfigListerExpansion allows custom methods to be added to
+// ImageVerificationAdmissionCheckConfigLister.
+type ImageVerificationAdmissionCheckConfigListerExpansion interface{}
+
+// KarpenterAdmissionCheckConfigListerExpansion allows custom methods to be added to
+// KarpenterAdmissionCheckConfigLister.
+type KarpenterAdmissionCheckConfigListerExpansion interface{}
+
 // LocalQueueListerExpansion allows custom methods to be added to
 // LocalQueueLister.
 type LocalQueueListerExpansion interface{}
@@ -53,10 +85,18 @@ type MultiKueueConfigListerExpansion interface{}
 // ProvisioningRequestConfigLister.
 type ProvisioningRequestConfigListerExpansion interface{}
 
+// ReservationListerExpansion allows custom methods to be added to
+// ReservationLister.
+type ReservationListerExpansion interface{}
+
 // ResourceFlavorListerExpansion allows custom methods to be added to
 // ResourceFlavorLister.
 type ResourceFlavorListerExpansion interface{}
 
+// SlurmAdmissionCheckConfigListerExpansion allows custom methods to be added to
+// SlurmAdmissionCheckConfigLister.
+type SlurmAdmissionCheckConfigListerExpansion interface{}
+
 // TopologyListerExpansion allows custom methods to be added to
 // TopologyLister.
 type TopologyListerExpansion interface{}
@@ -25,6 +25,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 
 	zaplog "go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -40,11 +41,15 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/util/flowcontrol"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
@@ -53,24 +58,40 @@ import (
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/capacity"
 	"sigs.k8s.io/kueue/pkg/config"
 	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/budget"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/capacityreservation"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/httpcheck"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/imageprepull"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/imageverification"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/karpenter"
 	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue"
 	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/multikueue/externalframeworks"
 	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/provisioning"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/slurm"
+	"sigs.k8s.io/kueue/pkg/controller/admissionchecks/workloadgroup"
 	"sigs.k8s.io/kueue/pkg/controller/core"
 	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	jobplugin "sigs.k8s.io/kueue/pkg/controller/jobframework/plugin"
 	"sigs.k8s.io/kueue/pkg/controller/tas"
 	tasindexer "sigs.k8s.io/kueue/pkg/controller/tas/indexer"
 	dispatcher "sigs.k8s.io/kueue/pkg/controller/workloaddispatcher"
 	"sigs.k8s.io/kueue/pkg/debugger"
 	"sigs.k8s.io/kueue/pkg/dra"
 	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/health"
 	"sigs.k8s.io/kueue/pkg/metrics"
+	"sigs.k8s.io/kueue/pkg/metrics/objectstate"
+	"sigs.k8s.io/kueue/pkg/resources"
 	"sigs.k8s.io/kueue/pkg/scheduler"
+	"sigs.k8s.io/kueue/pkg/scheduler/preemption"
 	"sigs.k8s.io/kueue/pkg/util/cert"
+	"sigs.k8s.io/kueue/pkg/util/events"
 	"sigs.k8s.io/kueue/pkg/util/kubeversion"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 	"sigs.k8s.io/kueue/pkg/util/useragent"
 	"sigs.k8s.io/kueue/pkg/version"
 	"sigs.k8s.io/kueue/pkg/visibility"
@@ -96,6 +117,7 @@ func init() {
 	utilruntime.Must(kueuealpha.AddToScheme(scheme))
 	utilruntime.Must(configapi.AddToScheme(scheme))
 	utilruntime.Must(autoscaling.AddToScheme(scheme))
+	utilruntime.Must(metricsv1beta1.AddToScheme(scheme))
 	// Add any additional framework integration types.
 	utilruntime.Must(
 		jobframework.ForEachIntegration(func(_ string, cb jobframework.IntegrationCallbacks) error {
@@ -182,7 +204,10 @@ func main() {
 	}
 	options.Metrics = metricsServerOptions
 
-	metrics.Register()
+	if features.Enabled(features.NamespaceMetrics) {
+		metrics.SetNamespaceAllowlist(cfg.Metrics.NamespaceAllowlist)
+	}
+	metrics.Register(cfg.Metrics.Cardinality)
 
 	kubeConfig := ctrl.GetConfigOrDie()
 	if kubeConfig.UserAgent == "" {
@@ -203,6 +228,10 @@ func main() {
 		os.Exit(1)
 	}
 
+	if features.Enabled(features.EventThrottling) && cfg.EventThrottling != nil {
+		mgr = events.WithThrottledEvents(mgr, cfg.EventThrottling)
+	}
+
 	certsReady := make(chan struct{})
 
 	if cfg.InternalCertManagement != nil && *cfg.InternalCertManagement.Enable {
@@ -223,6 +252,9 @@ func main() {
 		cacheOptions = append(cacheOptions, schdcache.WithResourceTransformations(cfg.Resources.Transformations))
 		queueOptions = append(queueOptions, qcache.WithResourceTransformations(cfg.Resources.Transformations))
 	}
+	if features.Enabled(features.FlavorResourcePartitions) && cfg.Resources != nil && len(cfg.Resources.FractionalResources) > 0 {
+		resources.SetFractionalResources(cfg.Resources.FractionalResources)
+	}
 	if features.Enabled(features.DynamicResourceAllocation) && cfg.Resources != nil && len(cfg.Resources.DeviceClassMappings) > 0 {
 		if err := dra.CreateMapperFromConfiguration(cfg.Resources.DeviceClassMappings); err != nil {
 			setupLog.Error(err, "Failed to initialize DRA mapper from configuration")
@@ -232,11 +264,17 @@ func main() {
 	}
 	if cfg.FairSharing != nil {
 		cacheOptions = append(cacheOptions, schdcache.WithFairSharing(cfg.FairSharing.Enable))
+		if cfg.FairSharing.UsageHalfLifeTime != nil {
+			cacheOptions = append(cacheOptions, schdcache.WithFairSharingUsageHalfLife(cfg.FairSharing.UsageHalfLifeTime.Duration))
+		}
 	}
 	if cfg.AdmissionFairSharing != nil {
 		queueOptions = append(queueOptions, qcache.WithAdmissionFairSharing(cfg.AdmissionFairSharing))
 		cacheOptions = append(cacheOptions, schdcache.WithAdmissionFairSharing(cfg.AdmissionFairSharing))
 	}
+	if features.Enabled(features.TASHostScorer) {
+		cacheOptions = append(cacheOptions, schdcache.WithHostScorer(utiltas.NoopHostScorer{}))
+	}
 	cCache := schdcache.New(mgr.GetClient(), cacheOptions...)
 	queues := qcache.NewManager(mgr.GetClient(), cCache, queueOptions...)
 
@@ -253,11 +291,29 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := setupProbeEndpoints(mgr, certsReady); err != nil {
+	if features.Enabled(features.ObjectStateMetrics) {
+		objectstate.Register(mgr.GetCache())
+	}
+
+	var schedulerTracker *health.SchedulerTracker
+	var healthReporter *health.Reporter
+	if features.Enabled(features.ControllerHealthDetails) {
+		schedulerTracker = &health.SchedulerTracker{}
+		healthReporter = health.NewReporter(mgr.GetCache(), ctrlmetrics.Registry, schedulerTracker, clock.RealClock{})
+	}
+
+	if err := setupProbeEndpoints(mgr, certsReady, healthReporter); err != nil {
 		setupLog.Error(err, "Unable to setup probe endpoints")
 		os.Exit(1)
 	}
 
+	if features.Enabled(features.FleetCapacitySnapshot) {
+		if err := mgr.AddMetricsServerExtraHandler(capacity.Path, capacity.NewHandler(cCache, queues)); err != nil {
+			setupLog.Error(err, "Unable to setup the capacity snapshot endpoint")
+			os.Exit(1)
+		}
+	}
+
 	// Cert won't be ready until manager starts, so start a goroutine here which
 	// will block until the cert is ready before setting up the controllers.
 	// Controllers who register after manager starts will start directly.
@@ -273,14 +329,14 @@ func main() {
 
 	if features.Enabled(features.VisibilityOnDemand) {
 		go func() {
-			if err := visibility.CreateAndStartVisibilityServer(ctx, queues, *cfg.InternalCertManagement.Enable); err != nil {
+			if err := visibility.CreateAndStartVisibilityServer(ctx, queues, cCache, *cfg.InternalCertManagement.Enable); err != nil {
 				setupLog.Error(err, "Unable to create and start visibility server")
 				os.Exit(1)
 			}
 		}()
 	}
 
-	if err := setupScheduler(mgr, cCache, queues, &cfg); err != nil {
+	if err := setupScheduler(mgr, cCache, queues, &cfg, schedulerTracker); err != nil {
 		setupLog.Error(err, "Could not setup scheduler")
 		os.Exit(1)
 	}
@@ -311,12 +367,24 @@ func setupIndexes(ctx context.Context, mgr ctrl.Manager, cfg *configapi.Configur
 		}
 	}
 
+	if features.Enabled(features.WorkloadGroups) {
+		if err := workloadgroup.SetupIndexer(ctx, mgr.GetFieldIndexer()); err != nil {
+			return fmt.Errorf("could not setup workload group indexer: %w", err)
+		}
+	}
+
 	if features.Enabled(features.MultiKueue) {
 		if err := multikueue.SetupIndexer(ctx, mgr.GetFieldIndexer(), *cfg.Namespace); err != nil {
 			return fmt.Errorf("could not setup multikueue indexer: %w", err)
 		}
 	}
 
+	if features.Enabled(features.JobFrameworkPlugins) {
+		if err := jobplugin.SetupIndexes(ctx, mgr.GetFieldIndexer(), cfg.Integrations.Plugins); err != nil {
+			return fmt.Errorf("could not setup job framework plugins indexer: %w", err)
+		}
+	}
+
 	opts := []jobframework.Option{
 		jobframework.WithEnabledFrameworks(cfg.Integrations.Frameworks),
 	}
@@ -336,7 +404,7 @@ func setupControllers(ctx context.Context, mgr ctrl.Manager, cCache *schdcache.C
 	if err := provisioning.ServerSupportsProvisioningRequest(mgr); err != nil {
 		setupLog.Info("Skipping provisioning controller setup: Provisioning Requests not supported (Possible cause: missing or unsupported cluster-autoscaler)")
 	} else {
-		ctrl, err := provisioning.NewController(mgr.GetClient(), mgr.GetEventRecorderFor("kueue-provisioning-request-controller"))
+		ctrl, err := provisioning.NewController(mgr.GetClient(), mgr.GetEventRecorderFor("kueue-provisioning-request-controller"), provisioning.WithAPIReader(mgr.GetAPIReader()))
 		if err != nil {
 			return fmt.Errorf("could not create the provisioning controller: %w", err)
 		}
@@ -344,6 +412,92 @@ func setupControllers(ctx context.Context, mgr ctrl.Manager, cCache *schdcache.C
 		if err := ctrl.SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("could not setup provisioning controller: %w", err)
 		}
+
+		if rp := cfg.ObjectRetentionPolicies; features.Enabled(features.ObjectRetentionPolicies) && rp != nil && rp.ProvisioningRequests != nil && rp.ProvisioningRequests.AfterStale != nil {
+			if err := provisioning.NewCleanupController(mgr.GetClient(), rp.ProvisioningRequests.AfterStale.Duration).SetupWithManager(mgr); err != nil {
+				return fmt.Errorf("could not setup provisioning cleanup controller: %w", err)
+			}
+		}
+	}
+
+	if features.Enabled(features.WorkloadGroups) {
+		if err := workloadgroup.NewController(mgr.GetClient()).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("could not setup workload group controller: %w", err)
+		}
+	}
+
+	if features.Enabled(features.HTTPAdmissionCheck) {
+		ctrl, err := httpcheck.NewController(mgr.GetClient(), httpcheck.WithNamespace(*cfg.Namespace))
+		if err != nil {
+			return fmt.Errorf("could not create the HTTP admission check controller: %w", err)
+		}
+		if err := ctrl.SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("could not setup HTTP admission check controller: %w", err)
+		}
+	}
+
+	if features.Enabled(features.BudgetAdmissionCheck) {
+		ctrl, err := budget.NewController(mgr.GetClient())
+		if err != nil {
+			return fmt.Errorf("could not create the budget admission check controller: %w", err)
+		}
+		if err := ctrl.SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("could not setup budget admission check controller: %w", err)
+		}
+	}
+
+	if features.Enabled(features.CapacityReservationAdmissionCheck) {
+		ctrl, err := capacityreservation.NewController(mgr.GetClient())
+		if err != nil {
+			return fmt.Errorf("could not create the capacity reservation admission check controller: %w", err)
+		}
+		if err := ctrl.SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("could not setup capacity reservation admission check controller: %w", err)
+		}
+	}
+
+	if features.Enabled(features.ImagePrePullAdmissionCheck) {
+		ctrl, err := imageprepull.NewController(mgr.GetClient())
+		if err != nil {
+			return fmt.Errorf("could not create the image pre-pull admission check controller: %w", err)
+		}
+		if err := ctrl.SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("could not setup image pre-pull admission check controller: %w", err)
+		}
+	}
+
+	if features.Enabled(features.ImageVerificationAdmissionCheck) {
+		ctrl, err := imageverification.NewController(mgr.GetClient())
+		if err != nil {
+			return fmt.Errorf("could not create the image verification admission check controller: %w", err)
+		}
+		if err := ctrl.SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("could not setup image verification admission check controller: %w", err)
+		}
+	}
+
+	if features.Enabled(features.KarpenterAdmissionCheck) {
+		if err := karpenter.ServerSupportsNodeClaims(mgr); err != nil {
+			setupLog.Info("Skipping Karpenter admission check controller setup: NodeClaims not supported (Possible cause: missing or unsupported Karpenter)")
+		} else {
+			ctrl, err := karpenter.NewController(mgr.GetClient())
+			if err != nil {
+				return fmt.Errorf("could not create the Karpenter admission check controller: %w", err)
+			}
+			if err := ctrl.SetupWithManager(mgr); err != nil {
+				return fmt.Errorf("could not setup Karpenter admission check controller: %w", err)
+			}
+		}
+	}
+
+	if features.Enabled(features.SlurmAdmissionCheck) {
+		ctrl, err := slurm.NewController(mgr.GetClient())
+		if err != nil {
+			return fmt.Errorf("could not create the Slurm admission check controller: %w", err)
+		}
+		if err := ctrl.SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("could not setup Slurm admission check controller: %w", err)
+		}
 	}
 
 	if features.Enabled(features.MultiKueue) {
@@ -371,6 +525,7 @@ func setupControllers(ctx context.Context, mgr ctrl.Manager, cCache *schdcache.C
 			multikueue.WithWorkerLostTimeout(cfg.MultiKueue.WorkerLostTimeout.Duration),
 			multikueue.WithAdapters(adapters),
 			multikueue.WithDispatcherName(ptr.Deref(cfg.MultiKueue.DispatcherName, configapi.MultiKueueDispatcherModeAllAtOnce)),
+			multikueue.WithTracing(tracingConfig(cfg)),
 		); err != nil {
 			return fmt.Errorf("could not setup MultiKueue controller: %w", err)
 		}
@@ -397,10 +552,14 @@ func setupControllers(ctx context.Context, mgr ctrl.Manager, cCache *schdcache.C
 		jobframework.WithEnabledFrameworks(cfg.Integrations.Frameworks),
 		jobframework.WithEnabledExternalFrameworks(cfg.Integrations.ExternalFrameworks),
 		jobframework.WithManagerName(constants.KueueName),
-		jobframework.WithLabelKeysToCopy(cfg.Integrations.LabelKeysToCopy),
+		jobframework.WithLabelKeysToCopy(labelKeysToCopy(cfg)),
 		jobframework.WithCache(cCache),
 		jobframework.WithQueues(queues),
 		jobframework.WithObjectRetentionPolicies(cfg.ObjectRetentionPolicies),
+		jobframework.WithGracefulPreemption(cfg.GracefulPreemption),
+	}
+	if features.Enabled(features.ChildJobsPolicies) {
+		opts = append(opts, jobframework.WithChildJobsPolicies(cfg.Integrations.ChildJobsPolicies))
 	}
 	if cfg.Integrations.PodOptions != nil {
 		opts = append(opts, jobframework.WithIntegrationOptions(corev1.SchemeGroupVersion.WithKind("Pod").String(), cfg.Integrations.PodOptions))
@@ -415,11 +574,17 @@ func setupControllers(ctx context.Context, mgr ctrl.Manager, cCache *schdcache.C
 		return fmt.Errorf("unable to create controller or webhook for kubernetesVersion %v: %w", serverVersionFetcher.GetServerVersion(), err)
 	}
 
+	if features.Enabled(features.JobFrameworkPlugins) {
+		if err := jobplugin.SetupControllers(mgr, cfg.Integrations.Plugins, opts...); err != nil {
+			return fmt.Errorf("unable to create controller for job framework plugins: %w", err)
+		}
+	}
+
 	return nil
 }
 
 // setupProbeEndpoints registers the health endpoints
-func setupProbeEndpoints(mgr ctrl.Manager, certsReady <-chan struct{}) error {
+func setupProbeEndpoints(mgr ctrl.Manager, certsReady <-chan struct{}, healthReporter *health.Reporter) error {
 	defer setupLog.Info("Probe endpoints are configured on healthz and readyz")
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -444,18 +609,47 @@ func setupProbeEndpoints(mgr ctrl.Manager, certsReady <-chan struct{}) error {
 		return fmt.Errorf("unable to set up ready check: %w", err)
 	}
 
+	if healthReporter != nil {
+		if err := mgr.AddHealthzCheck("scheduler", healthReporter.SchedulerProgressing()); err != nil {
+			return fmt.Errorf("unable to set up scheduler health check: %w", err)
+		}
+		if err := mgr.AddReadyzCheck("informers", healthReporter.InformersSynced()); err != nil {
+			return fmt.Errorf("unable to set up informers ready check: %w", err)
+		}
+		if err := mgr.AddMetricsServerExtraHandler(health.DetailsPath, healthReporter); err != nil {
+			return fmt.Errorf("unable to set up the health details endpoint: %w", err)
+		}
+	}
+
 	return nil
 }
 
-func setupScheduler(mgr ctrl.Manager, cCache *schdcache.Cache, queues *qcache.Manager, cfg *configapi.Configuration) error {
+func setupScheduler(mgr ctrl.Manager, cCache *schdcache.Cache, queues *qcache.Manager, cfg *configapi.Configuration, schedulerTracker *health.SchedulerTracker) error {
+	opts := []scheduler.Option{
+		scheduler.WithPodsReadyRequeuingTimestamp(podsReadyRequeuingTimestamp(cfg)),
+		scheduler.WithFairSharing(cfg.FairSharing),
+		scheduler.WithAdmissionFairSharing(cfg.AdmissionFairSharing),
+		scheduler.WithSchedulingCycle(cfg.SchedulingCycle),
+	}
+	if schedulerTracker != nil {
+		opts = append(opts, scheduler.WithCycleTracker(schedulerTracker))
+	}
+	if features.Enabled(features.PreemptionAuditLog) {
+		sink, err := preemption.NewAuditSink(cfg.PreemptionAudit)
+		if err != nil {
+			return fmt.Errorf("unable to create the preemption audit sink: %w", err)
+		}
+		opts = append(opts, scheduler.WithPreemptionAuditSink(sink))
+	}
+	if features.Enabled(features.SchedulingDecisionLog) {
+		opts = append(opts, scheduler.WithDecisionSink(scheduler.NewDecisionSink(cfg.SchedulingDecisionLog)))
+	}
 	sched := scheduler.New(
 		queues,
 		cCache,
 		mgr.GetClient(),
 		mgr.GetEventRecorderFor(constants.AdmissionName),
-		scheduler.WithPodsReadyRequeuingTimestamp(podsReadyRequeuingTimestamp(cfg)),
-		scheduler.WithFairSharing(cfg.FairSharing),
-		scheduler.WithAdmissionFairSharing(cfg.AdmissionFairSharing),
+		opts...,
 	)
 	if err := mgr.Add(sched); err != nil {
 		return fmt.Errorf("unable to add scheduler to manager: %w", err)
@@ -494,6 +688,30 @@ func podsReadyRequeuingTimestamp(cfg *configapi.Configuration) configapi.Requeui
 	return configapi.EvictionTimestamp
 }
 
+// labelKeysToCopy returns the label keys copied from the jobs to their
+// workloads, including the labels grouping the resource usage metrics.
+func labelKeysToCopy(cfg *configapi.Configuration) []string {
+	if !features.Enabled(features.WorkloadGroupingMetrics) {
+		return cfg.Integrations.LabelKeysToCopy
+	}
+	keys := slices.Clone(cfg.Integrations.LabelKeysToCopy)
+	for _, key := range cfg.Metrics.WorkloadGroupingLabels {
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// tracingConfig returns the tracing configuration, when the tracing of the
+// admission lifecycle is enabled.
+func tracingConfig(cfg *configapi.Configuration) *tracingapi.TracingConfiguration {
+	if !features.Enabled(features.AdmissionTracing) {
+		return nil
+	}
+	return cfg.Tracing
+}
+
 func apply(configFile string) (ctrl.Options, configapi.Configuration, error) {
 	options, cfg, err := config.Load(scheme, configFile)
 	if err != nil {
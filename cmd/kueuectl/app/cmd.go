@@ -26,10 +26,14 @@ import (
 
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/create"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/drain"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/list"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/move"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/passthrough"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/reactivate"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/resume"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/stop"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/top"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/util"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/version"
 )
@@ -78,8 +82,12 @@ func NewKueuectlCmd(o KueuectlOptions) *cobra.Command {
 	cmd.AddCommand(create.NewCreateCmd(clientGetter, o.IOStreams))
 	cmd.AddCommand(resume.NewResumeCmd(clientGetter, o.IOStreams))
 	cmd.AddCommand(stop.NewStopCmd(clientGetter, o.IOStreams))
+	cmd.AddCommand(drain.NewDrainCmd(clientGetter, o.IOStreams))
+	cmd.AddCommand(move.NewMoveCmd(clientGetter, o.IOStreams))
+	cmd.AddCommand(reactivate.NewReactivateCmd(clientGetter, o.IOStreams))
 	cmd.AddCommand(list.NewListCmd(clientGetter, o.IOStreams, o.Clock))
-	cmd.AddCommand(passthrough.NewCommands(clientGetter, o.IOStreams)...)
+	cmd.AddCommand(top.NewTopCmd(clientGetter, o.IOStreams))
+	cmd.AddCommand(passthrough.NewCommands(clientGetter, o.IOStreams, o.Clock)...)
 	cmd.AddCommand(version.NewVersionCmd(clientGetter, o.IOStreams))
 
 	return cmd
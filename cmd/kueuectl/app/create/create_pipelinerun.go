@@ -0,0 +1,345 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	kueuev1beta1 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/kueue/v1beta1"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/util"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+const (
+	pipelineRunKind          = "PipelineRun"
+	pipelineRunPendingStatus = "PipelineRunPending"
+)
+
+var (
+	pipelineRunGVK = schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: pipelineRunKind}
+
+	prLong = templates.LongDesc(`
+		Create a Tekton PipelineRun of the given Pipeline, targeting the given
+		local queue.
+
+		With --for-file, the manifest in the file is submitted instead, after
+		setting the queue and the priority class labels on it. The manifest
+		can be of any kind managed by Kueue, while --pending only applies to
+		PipelineRuns.
+	`)
+	prExample = templates.Examples(`
+		# Create a pipelinerun
+		kueuectl create pipelinerun my-pipelinerun --pipeline my-pipeline -q my-local-queue
+
+		# Create a pending pipelinerun with parameters and a priority class
+		kueuectl create pipelinerun --pipeline my-pipeline -q my-local-queue --param username=Tekton --priority-class high --pending
+
+		# Create the pipelinerun of the manifest
+		kueuectl create pipelinerun --for-file pipelinerun.yaml -q my-local-queue
+	`)
+)
+
+type PipelineRunOptions struct {
+	PrintFlags *genericclioptions.PrintFlags
+
+	DryRunStrategy   util.DryRunStrategy
+	Name             string
+	Namespace        string
+	EnforceNamespace bool
+	Pipeline         string
+	Params           []string
+	ForFile          string
+	LocalQueue       string
+	PriorityClass    string
+	Pending          bool
+	IgnoreUnknownLq  bool
+
+	Client        kueuev1beta1.KueueV1beta1Interface
+	DynamicClient dynamic.Interface
+	RestMapper    meta.RESTMapper
+
+	PrintObj printers.ResourcePrinterFunc
+
+	genericiooptions.IOStreams
+}
+
+func NewPipelineRunOptions(streams genericiooptions.IOStreams) *PipelineRunOptions {
+	return &PipelineRunOptions{
+		PrintFlags: genericclioptions.NewPrintFlags("created"),
+		IOStreams:  streams,
+	}
+}
+
+func NewPipelineRunCmd(clientGetter util.ClientGetter, streams genericiooptions.IOStreams) *cobra.Command {
+	o := NewPipelineRunOptions(streams)
+
+	cmd := &cobra.Command{
+		Use: "pipelinerun [NAME] (--pipeline PIPELINE_NAME | --for-file FILE) -q LOCAL_QUEUE_NAME " +
+			"[--param key=value] [--priority-class PRIORITY_CLASS_NAME] [--pending] [--ignore-unknown-lq] [--dry-run STRATEGY]",
+		// To do not add "[flags]" suffix on the end of usage line
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{"pr"},
+		Short:                 "Creates a pipelinerun",
+		Long:                  prLong,
+		Example:               prExample,
+		Args:                  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			cmd.SilenceUsage = true
+			err := o.Complete(clientGetter, cmd, args)
+			if err != nil {
+				return err
+			}
+			err = o.Validate(ctx)
+			if err != nil {
+				return err
+			}
+			return o.Run(ctx)
+		},
+	}
+
+	o.PrintFlags.AddFlags(cmd)
+
+	cmd.Flags().StringVar(&o.Pipeline, "pipeline", "",
+		"The name of the pipeline to run.")
+	cmd.Flags().StringArrayVar(&o.Params, "param", nil,
+		"The parameters of the pipeline, in the key=value format. Can be specified multiple times.")
+	cmd.Flags().StringVar(&o.ForFile, "for-file", "",
+		"The file containing the manifest to submit, or - for the standard input.")
+	cmd.Flags().StringVarP(&o.LocalQueue, "localqueue", "q", "",
+		"The local queue name which will be set on the pipelinerun (required).")
+	cmd.Flags().StringVar(&o.PriorityClass, "priority-class", "",
+		"The workload priority class name which will be set on the pipelinerun.")
+	cmd.Flags().BoolVar(&o.Pending, "pending", false,
+		"Create the pipelinerun in the pending status, so that it is started later.")
+	cmd.Flags().BoolVarP(&o.IgnoreUnknownLq, "ignore-unknown-lq", "i", false,
+		"Ignore unknown local queue.")
+
+	cobra.CheckErr(cmd.RegisterFlagCompletionFunc("localqueue", completion.LocalQueueNameFunc(clientGetter, nil)))
+
+	cmd.MarkFlagsMutuallyExclusive("pipeline", "for-file")
+	cmd.MarkFlagsOneRequired("pipeline", "for-file")
+	cmd.MarkFlagsMutuallyExclusive("param", "for-file")
+	_ = cmd.MarkFlagRequired("localqueue")
+
+	return cmd
+}
+
+// Complete completes all the required options
+func (o *PipelineRunOptions) Complete(clientGetter util.ClientGetter, cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	var err error
+	o.Namespace, o.EnforceNamespace, err = clientGetter.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := clientGetter.KueueClientSet()
+	if err != nil {
+		return err
+	}
+
+	o.Client = clientset.KueueV1beta1()
+
+	o.DynamicClient, err = clientGetter.DynamicClient()
+	if err != nil {
+		return err
+	}
+
+	o.RestMapper, err = clientGetter.ToRESTMapper()
+	if err != nil {
+		return err
+	}
+
+	o.DryRunStrategy, err = util.GetDryRunStrategy(cmd)
+	if err != nil {
+		return err
+	}
+
+	err = util.PrintFlagsWithDryRunStrategy(o.PrintFlags, o.DryRunStrategy)
+	if err != nil {
+		return err
+	}
+
+	printer, err := o.PrintFlags.ToPrinter()
+	if err != nil {
+		return err
+	}
+
+	o.PrintObj = printer.PrintObj
+
+	return nil
+}
+
+// Validate validates required fields are set to support structured generation
+func (o *PipelineRunOptions) Validate(ctx context.Context) error {
+	if len(o.LocalQueue) == 0 {
+		return errors.New("localqueue must be specified")
+	}
+	if len(o.Namespace) == 0 {
+		return errors.New("namespace must be specified")
+	}
+	for _, param := range o.Params {
+		if !strings.Contains(param, "=") {
+			return fmt.Errorf("invalid param %q, must be in the key=value format", param)
+		}
+	}
+	if !o.IgnoreUnknownLq {
+		_, err := o.Client.LocalQueues(o.Namespace).Get(ctx, o.LocalQueue, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run create pipelinerun
+func (o *PipelineRunOptions) Run(ctx context.Context) error {
+	var (
+		obj *unstructured.Unstructured
+		err error
+	)
+	if o.ForFile != "" {
+		obj, err = o.readManifest()
+	} else {
+		obj = o.createPipelineRun()
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := o.injectKueueFields(obj); err != nil {
+		return err
+	}
+
+	if o.DryRunStrategy != util.DryRunClient {
+		mapping, err := o.RestMapper.RESTMapping(obj.GroupVersionKind().GroupKind(), obj.GroupVersionKind().Version)
+		if err != nil {
+			return err
+		}
+		var createOptions metav1.CreateOptions
+		if o.DryRunStrategy == util.DryRunServer {
+			createOptions.DryRun = []string{metav1.DryRunAll}
+		}
+		obj, err = o.DynamicClient.Resource(mapping.Resource).Namespace(obj.GetNamespace()).Create(ctx, obj, createOptions)
+		if err != nil {
+			return err
+		}
+	}
+	return o.PrintObj(obj, o.Out)
+}
+
+func (o *PipelineRunOptions) createPipelineRun() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(pipelineRunGVK)
+	obj.SetNamespace(o.Namespace)
+	if o.Name != "" {
+		obj.SetName(o.Name)
+	} else {
+		obj.SetGenerateName(o.Pipeline + "-")
+	}
+
+	spec := map[string]any{
+		"pipelineRef": map[string]any{"name": o.Pipeline},
+	}
+	if len(o.Params) > 0 {
+		params := make([]any, 0, len(o.Params))
+		for _, param := range o.Params {
+			key, value, _ := strings.Cut(param, "=")
+			params = append(params, map[string]any{"name": key, "value": value})
+		}
+		spec["params"] = params
+	}
+	obj.Object["spec"] = spec
+
+	return obj
+}
+
+func (o *PipelineRunOptions) readManifest() (*unstructured.Unstructured, error) {
+	var reader io.Reader = o.In
+	if o.ForFile != "-" {
+		f, err := os.Open(o.ForFile)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := yaml.NewYAMLOrJSONDecoder(reader, 4096).Decode(&obj.Object); err != nil {
+		return nil, err
+	}
+	if obj.GetKind() == "" {
+		return nil, fmt.Errorf("the manifest in %s has no kind", o.ForFile)
+	}
+
+	if o.Name != "" {
+		obj.SetName(o.Name)
+	}
+	switch {
+	case obj.GetNamespace() == "":
+		obj.SetNamespace(o.Namespace)
+	case o.EnforceNamespace && obj.GetNamespace() != o.Namespace:
+		return nil, fmt.Errorf("the namespace of the manifest (%s) does not match the namespace %s", obj.GetNamespace(), o.Namespace)
+	}
+
+	return obj, nil
+}
+
+// injectKueueFields sets the queue and the priority class labels, and the
+// pending status of the PipelineRuns.
+func (o *PipelineRunOptions) injectKueueFields(obj *unstructured.Unstructured) error {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[constants.QueueLabel] = o.LocalQueue
+	if o.PriorityClass != "" {
+		labels[constants.WorkloadPriorityClassLabel] = o.PriorityClass
+	}
+	obj.SetLabels(labels)
+
+	if !o.Pending {
+		return nil
+	}
+	if obj.GroupVersionKind().GroupKind() != pipelineRunGVK.GroupKind() {
+		return fmt.Errorf("--pending is only supported for %s, not %s", pipelineRunKind, obj.GetKind())
+	}
+	return unstructured.SetNestedField(obj.Object, pipelineRunPendingStatus, "spec", "status")
+}
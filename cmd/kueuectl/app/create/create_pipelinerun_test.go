@@ -0,0 +1,313 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package create
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
+	cmdtesting "sigs.k8s.io/kueue/cmd/kueuectl/app/testing"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestCreatePipelineRun(t *testing.T) {
+	testCases := map[string]struct {
+		options  *PipelineRunOptions
+		expected *unstructured.Unstructured
+	}{
+		"success_create": {
+			options: &PipelineRunOptions{
+				Name:      "pr1",
+				Namespace: "ns1",
+				Pipeline:  "pipeline1",
+			},
+			expected: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "tekton.dev/v1",
+				"kind":       "PipelineRun",
+				"metadata":   map[string]any{"name": "pr1", "namespace": "ns1"},
+				"spec": map[string]any{
+					"pipelineRef": map[string]any{"name": "pipeline1"},
+				},
+			}},
+		},
+		"success_create_with_generated_name_and_params": {
+			options: &PipelineRunOptions{
+				Namespace: "ns1",
+				Pipeline:  "pipeline1",
+				Params:    []string{"username=Tekton", "greeting=a=b"},
+			},
+			expected: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "tekton.dev/v1",
+				"kind":       "PipelineRun",
+				"metadata":   map[string]any{"generateName": "pipeline1-", "namespace": "ns1"},
+				"spec": map[string]any{
+					"pipelineRef": map[string]any{"name": "pipeline1"},
+					"params": []any{
+						map[string]any{"name": "username", "value": "Tekton"},
+						map[string]any{"name": "greeting", "value": "a=b"},
+					},
+				},
+			}},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			pr := tc.options.createPipelineRun()
+			if diff := cmp.Diff(tc.expected, pr); diff != "" {
+				t.Errorf("Unexpected result (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPipelineRunCmd(t *testing.T) {
+	pipelineRunGVR := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "pipelineruns"}
+	jobGVK := schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}
+	jobGVR := schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}
+
+	manifestDir := t.TempDir()
+	pipelineRunManifest := filepath.Join(manifestDir, "pipelinerun.yaml")
+	if err := os.WriteFile(pipelineRunManifest, []byte(`apiVersion: tekton.dev/v1
+kind: PipelineRun
+metadata:
+  name: pr1
+  labels:
+    app: build
+spec:
+  pipelineRef:
+    name: pipeline1
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	jobManifest := filepath.Join(manifestDir, "job.yaml")
+	if err := os.WriteFile(jobManifest, []byte(`apiVersion: batch/v1
+kind: Job
+metadata:
+  name: job1
+`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		args       []string
+		in         string
+		gvr        schema.GroupVersionResource
+		name       string
+		wantObj    *unstructured.Unstructured
+		wantOut    string
+		wantOutErr string
+		wantErr    string
+	}{
+		"should create pipelinerun": {
+			args: []string{"pr1", "--pipeline", "pipeline1", "-q", "lq1", "--priority-class", "high", "--pending"},
+			gvr:  pipelineRunGVR,
+			name: "pr1",
+			wantObj: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "tekton.dev/v1",
+				"kind":       "PipelineRun",
+				"metadata": map[string]any{
+					"name":      "pr1",
+					"namespace": metav1.NamespaceDefault,
+					"labels": map[string]any{
+						"kueue.x-k8s.io/queue-name":     "lq1",
+						"kueue.x-k8s.io/priority-class": "high",
+					},
+				},
+				"spec": map[string]any{
+					"pipelineRef": map[string]any{"name": "pipeline1"},
+					"status":      "PipelineRunPending",
+				},
+			}},
+			wantOut: "pipelinerun.tekton.dev/pr1 created\n",
+		},
+		"should create pipelinerun for file": {
+			args: []string{"--for-file", pipelineRunManifest, "-q", "lq1"},
+			gvr:  pipelineRunGVR,
+			name: "pr1",
+			wantObj: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "tekton.dev/v1",
+				"kind":       "PipelineRun",
+				"metadata": map[string]any{
+					"name":      "pr1",
+					"namespace": metav1.NamespaceDefault,
+					"labels": map[string]any{
+						"app":                       "build",
+						"kueue.x-k8s.io/queue-name": "lq1",
+					},
+				},
+				"spec": map[string]any{
+					"pipelineRef": map[string]any{"name": "pipeline1"},
+				},
+			}},
+			wantOut: "pipelinerun.tekton.dev/pr1 created\n",
+		},
+		"should create pipelinerun for the standard input": {
+			args: []string{"pr2", "--for-file", "-", "-q", "lq1"},
+			in:   `{"apiVersion": "tekton.dev/v1", "kind": "PipelineRun", "spec": {"pipelineRef": {"name": "pipeline1"}}}`,
+			gvr:  pipelineRunGVR,
+			name: "pr2",
+			wantObj: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "tekton.dev/v1",
+				"kind":       "PipelineRun",
+				"metadata": map[string]any{
+					"name":      "pr2",
+					"namespace": metav1.NamespaceDefault,
+					"labels":    map[string]any{"kueue.x-k8s.io/queue-name": "lq1"},
+				},
+				"spec": map[string]any{
+					"pipelineRef": map[string]any{"name": "pipeline1"},
+				},
+			}},
+			wantOut: "pipelinerun.tekton.dev/pr2 created\n",
+		},
+		"should create job for file": {
+			args: []string{"--for-file", jobManifest, "-q", "lq1"},
+			gvr:  jobGVR,
+			name: "job1",
+			wantObj: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"metadata": map[string]any{
+					"name":      "job1",
+					"namespace": metav1.NamespaceDefault,
+					"labels":    map[string]any{"kueue.x-k8s.io/queue-name": "lq1"},
+				},
+			}},
+			wantOut: "job.batch/job1 created\n",
+		},
+		"shouldn't create pipelinerun with dry-run client": {
+			args:    []string{"pr1", "--pipeline", "pipeline1", "-q", "lq1", "--dry-run", "client"},
+			gvr:     pipelineRunGVR,
+			name:    "pr1",
+			wantOut: "pipelinerun.tekton.dev/pr1 created (client dry run)\n",
+		},
+		"shouldn't create pending job": {
+			args:    []string{"--for-file", jobManifest, "-q", "lq1", "--pending"},
+			wantErr: "--pending is only supported for PipelineRun, not Job",
+		},
+		"shouldn't create pipelinerun with unknown localqueue": {
+			args:    []string{"pr1", "--pipeline", "pipeline1", "-q", "lq2"},
+			wantErr: `localqueues.kueue.x-k8s.io "lq2" not found`,
+		},
+		"should create pipelinerun with ignored unknown localqueue": {
+			args: []string{"pr1", "--pipeline", "pipeline1", "-q", "lq2", "-i"},
+			gvr:  pipelineRunGVR,
+			name: "pr1",
+			wantObj: &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "tekton.dev/v1",
+				"kind":       "PipelineRun",
+				"metadata": map[string]any{
+					"name":      "pr1",
+					"namespace": metav1.NamespaceDefault,
+					"labels":    map[string]any{"kueue.x-k8s.io/queue-name": "lq2"},
+				},
+				"spec": map[string]any{
+					"pipelineRef": map[string]any{"name": "pipeline1"},
+				},
+			}},
+			wantOut: "pipelinerun.tekton.dev/pr1 created\n",
+		},
+		"shouldn't create pipelinerun with invalid param": {
+			args:    []string{"pr1", "--pipeline", "pipeline1", "-q", "lq1", "--param", "username"},
+			wantErr: `invalid param "username", must be in the key=value format`,
+		},
+		"shouldn't create pipelinerun without pipeline or file": {
+			args:    []string{"pr1", "-q", "lq1"},
+			wantErr: "at least one of the flags in the group [pipeline for-file] is required",
+		},
+		"shouldn't create pipelinerun without localqueue": {
+			args:    []string{"pr1", "--pipeline", "pipeline1"},
+			wantErr: `required flag(s) "localqueue" not set`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			streams, in, out, outErr := genericiooptions.NewTestIOStreams()
+			in.WriteString(tc.in)
+
+			clientset := fake.NewSimpleClientset(utiltesting.MakeLocalQueue("lq1", metav1.NamespaceDefault).Obj())
+			dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+				pipelineRunGVR: "PipelineRunList",
+				jobGVR:         "JobList",
+			})
+			restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{})
+			restMapper.Add(pipelineRunGVK, meta.RESTScopeNamespace)
+			restMapper.Add(jobGVK, meta.RESTScopeNamespace)
+
+			tcg := cmdtesting.NewTestClientGetter().
+				WithKueueClientset(clientset).
+				WithDynamicClient(dynamicClient).
+				WithRESTMapper(restMapper)
+
+			cmd := NewPipelineRunCmd(tcg, streams)
+			cmd.SetIn(in)
+			cmd.SetOut(out)
+			cmd.SetErr(outErr)
+			cmd.SetArgs(tc.args)
+			cmd.Flags().String("dry-run", "none", "")
+
+			gotErr := cmd.Execute()
+
+			var gotErrStr string
+			if gotErr != nil {
+				gotErrStr = gotErr.Error()
+			}
+
+			if diff := cmp.Diff(tc.wantErr, gotErrStr); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+
+			if gotErr != nil {
+				return
+			}
+
+			gotObj, err := dynamicClient.Resource(tc.gvr).Namespace(metav1.NamespaceDefault).Get(context.Background(), tc.name, metav1.GetOptions{})
+			if tc.wantObj == nil {
+				if err == nil {
+					t.Errorf("Unexpected object created: %v", gotObj)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(tc.wantObj, gotObj); diff != "" {
+					t.Errorf("Unexpected object (-want/+got)\n%s", diff)
+				}
+			}
+
+			if diff := cmp.Diff(tc.wantOut, out.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.wantOutErr, outErr.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
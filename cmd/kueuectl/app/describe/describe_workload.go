@@ -0,0 +1,291 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package describe
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/kubectl/pkg/util/templates"
+	"k8s.io/utils/clock"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	kueuev1beta1 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/kueue/v1beta1"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/util"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const noneValue = "<none>"
+
+var (
+	wlLong = templates.LongDesc(`
+		Shows the details of the Workload along with the explanation of why
+		it is not admitted yet: the latest message of the scheduler, the
+		reasons why the flavors couldn't be assigned, the state of the
+		admission checks and, for MultiKueue, the nominated and assigned
+		worker clusters.
+	`)
+	wlExample = templates.Examples(`
+		# Describe the Workload
+		kueuectl describe workload my-workload
+	`)
+)
+
+type WorkloadOptions struct {
+	Clock        clock.Clock
+	WorkloadName string
+	Namespace    string
+
+	Client       kueuev1beta1.KueueV1beta1Interface
+	EventsClient corev1client.EventsGetter
+
+	genericiooptions.IOStreams
+}
+
+func NewWorkloadOptions(streams genericiooptions.IOStreams, clock clock.Clock) *WorkloadOptions {
+	return &WorkloadOptions{
+		IOStreams: streams,
+		Clock:     clock,
+	}
+}
+
+func NewWorkloadCmd(clientGetter util.ClientGetter, streams genericiooptions.IOStreams, clock clock.Clock) *cobra.Command {
+	o := NewWorkloadOptions(streams, clock)
+
+	cmd := &cobra.Command{
+		Use:                   "workload NAME",
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{"wl"},
+		Short:                 "Show the details of the Workload and why it is not admitted",
+		Long:                  wlLong,
+		Example:               wlExample,
+		Args:                  cobra.ExactArgs(1),
+		ValidArgsFunction:     completion.WorkloadNameFunc(clientGetter, nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			err := o.Complete(clientGetter, args)
+			if err != nil {
+				return err
+			}
+			return o.Run(cmd.Context())
+		},
+	}
+
+	return cmd
+}
+
+// Complete completes all the required options
+func (o *WorkloadOptions) Complete(clientGetter util.ClientGetter, args []string) error {
+	o.WorkloadName = args[0]
+
+	var err error
+	o.Namespace, _, err = clientGetter.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := clientGetter.KueueClientSet()
+	if err != nil {
+		return err
+	}
+	o.Client = clientset.KueueV1beta1()
+
+	k8sClientset, err := clientGetter.K8sClientSet()
+	if err != nil {
+		return err
+	}
+	o.EventsClient = k8sClientset.CoreV1()
+
+	return nil
+}
+
+// Run prints the details of the workload.
+func (o *WorkloadOptions) Run(ctx context.Context) error {
+	wl, err := o.Client.Workloads(o.Namespace).Get(ctx, o.WorkloadName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	events, err := o.EventsClient.Events(o.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.AndSelectors(
+			fields.OneTermEqualSelector("involvedObject.name", wl.Name),
+			fields.OneTermEqualSelector("involvedObject.uid", string(wl.UID)),
+		).String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	tabWriter := printers.GetNewTabWriter(o.Out)
+	o.printWorkload(tabWriter, wl, events.Items)
+	return tabWriter.Flush()
+}
+
+func (o *WorkloadOptions) printWorkload(w io.Writer, wl *v1beta1.Workload, events []corev1.Event) {
+	fmt.Fprintf(w, "Name:\t%s\n", wl.Name)
+	fmt.Fprintf(w, "Namespace:\t%s\n", wl.Namespace)
+	fmt.Fprintf(w, "Queue:\t%s\n", valueOrNone(string(wl.Spec.QueueName)))
+	var clusterQueue string
+	if wl.Status.Admission != nil {
+		clusterQueue = string(wl.Status.Admission.ClusterQueue)
+	}
+	fmt.Fprintf(w, "ClusterQueue:\t%s\n", valueOrNone(clusterQueue))
+	fmt.Fprintf(w, "Priority Class:\t%s\n", valueOrNone(wl.Spec.PriorityClassName))
+	fmt.Fprintf(w, "Priority:\t%d\n", priority.Priority(wl))
+	fmt.Fprintf(w, "Active:\t%t\n", workload.IsActive(wl))
+	fmt.Fprintf(w, "Status:\t%s\n", workload.Status(wl))
+	if len(wl.Status.NominatedClusterNames) > 0 || wl.Status.ClusterName != nil {
+		fmt.Fprintf(w, "Nominated Clusters:\t%s\n", valueOrNone(strings.Join(wl.Status.NominatedClusterNames, ", ")))
+		var clusterName string
+		if wl.Status.ClusterName != nil {
+			clusterName = *wl.Status.ClusterName
+		}
+		fmt.Fprintf(w, "Assigned Cluster:\t%s\n", valueOrNone(clusterName))
+	}
+
+	printPodSets(w, wl)
+	printAdmissionChecks(w, wl.Status.AdmissionChecks)
+	printExplanation(w, wl)
+	printConditions(w, wl.Status.Conditions)
+	o.printEvents(w, events)
+}
+
+// printPodSets prints the requests of the pod sets, and the flavors assigned
+// to them once the quota is reserved.
+func printPodSets(w io.Writer, wl *v1beta1.Workload) {
+	fmt.Fprintf(w, "Pod Sets:\n")
+	fmt.Fprintf(w, "  Name\tCount\tResource\tRequest\tFlavor\n")
+	fmt.Fprintf(w, "  ----\t-----\t--------\t-------\t------\n")
+	for _, ps := range workload.NewInfo(wl).TotalRequests {
+		if len(ps.Requests) == 0 {
+			fmt.Fprintf(w, "  %s\t%d\t%s\t%s\t%s\n", ps.Name, ps.Count, noneValue, noneValue, noneValue)
+			continue
+		}
+		for _, rName := range slices.Sorted(maps.Keys(ps.Requests)) {
+			fmt.Fprintf(w, "  %s\t%d\t%s\t%s\t%s\n", ps.Name, ps.Count, rName,
+				resources.ResourceQuantityString(rName, ps.Requests[rName]), valueOrNone(string(ps.Flavors[rName])))
+		}
+	}
+}
+
+func printAdmissionChecks(w io.Writer, checks []v1beta1.AdmissionCheckState) {
+	if len(checks) == 0 {
+		fmt.Fprintf(w, "Admission Checks:\t%s\n", noneValue)
+		return
+	}
+	fmt.Fprintf(w, "Admission Checks:\n")
+	fmt.Fprintf(w, "  Name\tState\tMessage\n")
+	fmt.Fprintf(w, "  ----\t-----\t-------\n")
+	for _, check := range checks {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", check.Name, check.State, check.Message)
+	}
+}
+
+// printExplanation prints why the workload is not admitted: the latest
+// message of the scheduler when the quota is not reserved, or of the
+// admission checks otherwise, followed by the unschedulable reasons.
+func printExplanation(w io.Writer, wl *v1beta1.Workload) {
+	var explanation string
+	if cond := apimeta.FindStatusCondition(wl.Status.Conditions, v1beta1.WorkloadQuotaReserved); cond != nil && cond.Status == metav1.ConditionFalse {
+		explanation = cond.Message
+	} else if cond := apimeta.FindStatusCondition(wl.Status.Conditions, v1beta1.WorkloadAdmitted); cond != nil && cond.Status == metav1.ConditionFalse {
+		explanation = cond.Message
+	}
+	fmt.Fprintf(w, "Explanation:\t%s\n", valueOrNone(explanation))
+
+	if len(wl.Status.UnschedulableReasons) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "Unschedulable Reasons:\n")
+	fmt.Fprintf(w, "  Reason\tPod Set\tFlavor\tResource\tAdmission Check\tMessage\n")
+	fmt.Fprintf(w, "  ------\t-------\t------\t--------\t---------------\t-------\n")
+	for _, reason := range wl.Status.UnschedulableReasons {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\t%s\n", reason.Reason, valueOrNone(string(reason.PodSet)),
+			valueOrNone(string(reason.Flavor)), valueOrNone(string(reason.Resource)),
+			valueOrNone(string(reason.AdmissionCheck)), reason.Message)
+	}
+}
+
+func printConditions(w io.Writer, conditions []metav1.Condition) {
+	if len(conditions) == 0 {
+		fmt.Fprintf(w, "Conditions:\t%s\n", noneValue)
+		return
+	}
+	fmt.Fprintf(w, "Conditions:\n")
+	fmt.Fprintf(w, "  Type\tStatus\tReason\tMessage\n")
+	fmt.Fprintf(w, "  ----\t------\t------\t-------\n")
+	for _, cond := range conditions {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", cond.Type, cond.Status, cond.Reason, cond.Message)
+	}
+}
+
+func (o *WorkloadOptions) printEvents(w io.Writer, events []corev1.Event) {
+	if len(events) == 0 {
+		fmt.Fprintf(w, "Events:\t%s\n", noneValue)
+		return
+	}
+	slices.SortStableFunc(events, func(a, b corev1.Event) int {
+		return eventTime(a).Compare(eventTime(b).Time)
+	})
+	fmt.Fprintf(w, "Events:\n")
+	fmt.Fprintf(w, "  Type\tReason\tAge\tFrom\tMessage\n")
+	fmt.Fprintf(w, "  ----\t------\t---\t----\t-------\n")
+	for _, event := range events {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", event.Type, event.Reason,
+			duration.HumanDuration(o.Clock.Since(eventTime(event).Time)), eventSource(event), strings.TrimSpace(event.Message))
+	}
+}
+
+func eventTime(event corev1.Event) metav1.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp
+	}
+	if !event.EventTime.IsZero() {
+		return metav1.NewTime(event.EventTime.Time)
+	}
+	return event.FirstTimestamp
+}
+
+func eventSource(event corev1.Event) string {
+	if event.Source.Component != "" {
+		return event.Source.Component
+	}
+	return event.ReportingController
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return noneValue
+	}
+	return value
+}
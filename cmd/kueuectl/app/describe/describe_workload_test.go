@@ -0,0 +1,198 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
+	cmdtesting "sigs.k8s.io/kueue/cmd/kueuectl/app/testing"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestWorkloadCmd(t *testing.T) {
+	testStartTime := time.Now().Truncate(time.Second)
+
+	pendingWl := utiltesting.MakeWorkload("pending", metav1.NamespaceDefault).
+		UID("pending-uid").
+		Queue("lq").
+		Request(corev1.ResourceCPU, "5").
+		Request(corev1.ResourceMemory, "1Gi").
+		Condition(metav1.Condition{
+			Type:    v1beta1.WorkloadQuotaReserved,
+			Status:  metav1.ConditionFalse,
+			Reason:  "Pending",
+			Message: "couldn't assign flavors to pod set main: insufficient quota for cpu in flavor default, request > maximum capacity (5 > 2)",
+		}).
+		Obj()
+	pendingWl.Status.UnschedulableReasons = []v1beta1.UnschedulableReason{{
+		Reason:   v1beta1.UnschedulableReasonInsufficientQuota,
+		PodSet:   v1beta1.DefaultPodSetName,
+		Flavor:   "default",
+		Resource: corev1.ResourceCPU,
+		Message:  "request > maximum capacity (5 > 2)",
+	}}
+
+	testCases := map[string]struct {
+		args       []string
+		objs       []runtime.Object
+		k8sObjs    []runtime.Object
+		wantOut    string
+		wantOutErr string
+		wantErr    string
+	}{
+		"should explain why the workload is pending": {
+			args: []string{"pending"},
+			objs: []runtime.Object{pendingWl},
+			k8sObjs: []runtime.Object{
+				&corev1.Event{
+					ObjectMeta:     metav1.ObjectMeta{Name: "pending.1", Namespace: metav1.NamespaceDefault},
+					InvolvedObject: corev1.ObjectReference{Kind: "Workload", Name: "pending", UID: "pending-uid"},
+					Type:           corev1.EventTypeNormal,
+					Reason:         "Pending",
+					Message:        "couldn't assign flavors to pod set main",
+					Source:         corev1.EventSource{Component: "kueue-admission"},
+					LastTimestamp:  metav1.NewTime(testStartTime.Add(-time.Minute)),
+				},
+			},
+			wantOut: `Name:             pending
+Namespace:        default
+Queue:            lq
+ClusterQueue:     <none>
+Priority Class:   <none>
+Priority:         0
+Active:           true
+Status:           pending
+Pod Sets:
+  Name              Count   Resource   Request   Flavor
+  ----              -----   --------   -------   ------
+  main              1       cpu        5         <none>
+  main              1       memory     1Gi       <none>
+Admission Checks:   <none>
+Explanation:        couldn't assign flavors to pod set main: insufficient quota for cpu in flavor default, request > maximum capacity (5 > 2)
+Unschedulable Reasons:
+  Reason              Pod Set   Flavor     Resource   Admission Check   Message
+  ------              -------   ------     --------   ---------------   -------
+  InsufficientQuota   main      default    cpu        <none>            request > maximum capacity (5 > 2)
+Conditions:
+  Type                Status    Reason     Message
+  ----                ------    ------     -------
+  QuotaReserved       False     Pending    couldn't assign flavors to pod set main: insufficient quota for cpu in flavor default, request > maximum capacity (5 > 2)
+Events:
+  Type                Reason    Age        From              Message
+  ----                ------    ---        ----              -------
+  Normal              Pending   60s        kueue-admission   couldn't assign flavors to pod set main
+`,
+		},
+		"should describe the admitted workload dispatched to a worker cluster": {
+			args: []string{"dispatched"},
+			objs: []runtime.Object{
+				utiltesting.MakeWorkload("dispatched", metav1.NamespaceDefault).
+					Queue("lq").
+					Priority(100).
+					Request(corev1.ResourceCPU, "1").
+					ReserveQuota(utiltesting.MakeAdmission("cq").
+						PodSets(utiltesting.MakePodSetAssignment(v1beta1.DefaultPodSetName).Assignment(corev1.ResourceCPU, "default", "1").Obj()).
+						Obj()).
+					AdmissionCheck(v1beta1.AdmissionCheckState{
+						Name:    "multikueue",
+						State:   v1beta1.CheckStateReady,
+						Message: `The workload got reservation on "worker1"`,
+					}).
+					Admitted(true).
+					NominatedClusterNames("worker1", "worker2").
+					ClusterName("worker1").
+					Obj(),
+			},
+			wantOut: `Name:                 dispatched
+Namespace:            default
+Queue:                lq
+ClusterQueue:         cq
+Priority Class:       <none>
+Priority:             100
+Active:               true
+Status:               admitted
+Nominated Clusters:   worker1, worker2
+Assigned Cluster:     worker1
+Pod Sets:
+  Name                Count   Resource   Request   Flavor
+  ----                -----   --------   -------   ------
+  main                1       cpu        1         default
+Admission Checks:
+  Name                State   Message
+  ----                -----   -------
+  multikueue          Ready   The workload got reservation on "worker1"
+Explanation:          <none>
+Conditions:
+  Type                Status   Reason           Message
+  ----                ------   ------           -------
+  QuotaReserved       True     AdmittedByTest   Admitted by ClusterQueue cq
+  Admitted            True     ByTest           Admitted by ClusterQueue cq
+Events:               <none>
+`,
+		},
+		"should return an error when the workload is not found": {
+			args:    []string{"missing"},
+			wantErr: `workloads.kueue.x-k8s.io "missing" not found`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			streams, _, out, outErr := genericiooptions.NewTestIOStreams()
+
+			tcg := cmdtesting.NewTestClientGetter().
+				WithKueueClientset(fake.NewSimpleClientset(tc.objs...)).
+				WithK8sClientset(k8sfake.NewSimpleClientset(tc.k8sObjs...))
+
+			cmd := NewWorkloadCmd(tcg, streams, testingclock.NewFakeClock(testStartTime))
+			cmd.SetOut(out)
+			cmd.SetErr(outErr)
+			cmd.SetArgs(tc.args)
+
+			gotErr := cmd.Execute()
+			var gotErrStr string
+			if gotErr != nil {
+				gotErrStr = gotErr.Error()
+			}
+			if diff := cmp.Diff(tc.wantErr, gotErrStr); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+
+			if gotErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.wantOut, out.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.wantOutErr, outErr.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
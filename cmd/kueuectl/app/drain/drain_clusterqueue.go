@@ -0,0 +1,276 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/kubectl/pkg/util/templates"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	kueuev1beta1 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/kueue/v1beta1"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/util"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const defaultPollInterval = 5 * time.Second
+
+var (
+	cqLong = templates.LongDesc(`
+		Stops the admission of new workloads to the given ClusterQueue and
+		evicts the admitted workloads.
+
+		When a grace period is set, the admitted workloads are left running
+		until they finish or the grace period expires, and only the workloads
+		still running afterwards are evicted.
+
+		When another ClusterQueue is set to requeue to, the pending workloads
+		are moved to the LocalQueue of the other ClusterQueue in the same
+		namespace, if any.
+	`)
+	cqExample = templates.Examples(`
+		# Drain the clusterqueue
+		kueuectl drain clusterqueue my-clusterqueue
+
+		# Drain the clusterqueue, letting the running workloads finish within 30 minutes
+		kueuectl drain clusterqueue my-clusterqueue --grace=30m
+
+		# Drain the clusterqueue, and move its pending workloads to another clusterqueue
+		kueuectl drain clusterqueue my-clusterqueue --requeue-to=other-clusterqueue
+	`)
+)
+
+type ClusterQueueOptions struct {
+	ClusterQueueName string
+	RequeueTo        string
+	Grace            time.Duration
+	PollInterval     time.Duration
+
+	Client        kueuev1beta1.KueueV1beta1Interface
+	DynamicClient dynamic.Interface
+	RestMapper    meta.RESTMapper
+
+	genericiooptions.IOStreams
+}
+
+func NewClusterQueueOptions(streams genericiooptions.IOStreams) *ClusterQueueOptions {
+	return &ClusterQueueOptions{
+		PollInterval: defaultPollInterval,
+		IOStreams:    streams,
+	}
+}
+
+func NewClusterQueueCmd(clientGetter util.ClientGetter, streams genericiooptions.IOStreams) *cobra.Command {
+	o := NewClusterQueueOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:                   "clusterqueue NAME [--requeue-to CLUSTERQUEUE] [--grace DURATION]",
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{"cq"},
+		Short:                 "Drain the ClusterQueue",
+		Long:                  cqLong,
+		Example:               cqExample,
+		Args:                  cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		ValidArgsFunction:     completion.ClusterQueueNameFunc(clientGetter, nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			err := o.Complete(clientGetter, args)
+			if err != nil {
+				return err
+			}
+			return o.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVar(&o.RequeueTo, "requeue-to", "",
+		"The ClusterQueue to move the pending workloads to.")
+	cmd.Flags().DurationVar(&o.Grace, "grace", 0,
+		"The period to wait for the admitted workloads to finish before evicting them.")
+
+	cobra.CheckErr(cmd.RegisterFlagCompletionFunc("requeue-to", completion.ClusterQueueNameFunc(clientGetter, nil)))
+
+	return cmd
+}
+
+// Complete completes all the required options
+func (o *ClusterQueueOptions) Complete(clientGetter util.ClientGetter, args []string) error {
+	o.ClusterQueueName = args[0]
+
+	if o.RequeueTo == o.ClusterQueueName {
+		return errors.New("cannot requeue the pending workloads to the drained clusterqueue")
+	}
+
+	if o.Grace < 0 {
+		return errors.New("the grace period must not be negative")
+	}
+
+	clientset, err := clientGetter.KueueClientSet()
+	if err != nil {
+		return err
+	}
+
+	o.Client = clientset.KueueV1beta1()
+
+	o.DynamicClient, err = clientGetter.DynamicClient()
+	if err != nil {
+		return err
+	}
+
+	o.RestMapper, err = clientGetter.ToRESTMapper()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run executes the command
+func (o *ClusterQueueOptions) Run(ctx context.Context) error {
+	if _, err := o.Client.ClusterQueues().Get(ctx, o.ClusterQueueName, metav1.GetOptions{}); err != nil {
+		return err
+	}
+	if o.RequeueTo != "" {
+		if _, err := o.Client.ClusterQueues().Get(ctx, o.RequeueTo, metav1.GetOptions{}); err != nil {
+			return err
+		}
+	}
+
+	if o.Grace > 0 {
+		if err := o.setStopPolicy(ctx, v1beta1.Hold); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.Out, "clusterqueue.kueue.x-k8s.io/%s stopped, waiting up to %s for the admitted workloads to finish\n", o.ClusterQueueName, o.Grace)
+		if err := o.waitForAdmittedWorkloads(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := o.setStopPolicy(ctx, v1beta1.HoldAndDrain); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "clusterqueue.kueue.x-k8s.io/%s drained\n", o.ClusterQueueName)
+
+	if o.RequeueTo != "" {
+		return o.requeuePendingWorkloads(ctx)
+	}
+
+	return nil
+}
+
+func (o *ClusterQueueOptions) setStopPolicy(ctx context.Context, policy v1beta1.StopPolicy) error {
+	cq, err := o.Client.ClusterQueues().Get(ctx, o.ClusterQueueName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	cqOriginal := cq.DeepCopy()
+	cq.Spec.StopPolicy = ptr.To(policy)
+
+	patch := client.MergeFrom(cqOriginal)
+	data, err := patch.Data(cq)
+	if err != nil {
+		return err
+	}
+	_, err = o.Client.ClusterQueues().Patch(ctx, o.ClusterQueueName, types.MergePatchType, data, metav1.PatchOptions{})
+	return err
+}
+
+// waitForAdmittedWorkloads waits for the workloads with quota reserved in the
+// ClusterQueue to finish, until the grace period expires.
+func (o *ClusterQueueOptions) waitForAdmittedWorkloads(ctx context.Context) error {
+	var running int
+	err := wait.PollUntilContextTimeout(ctx, o.PollInterval, o.Grace, true, func(ctx context.Context) (bool, error) {
+		list, err := o.Client.Workloads(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return false, err
+		}
+		running = 0
+		for i := range list.Items {
+			wl := &list.Items[i]
+			if workload.HasQuotaReservation(wl) && !workload.IsFinished(wl) &&
+				string(wl.Status.Admission.ClusterQueue) == o.ClusterQueueName {
+				running++
+			}
+		}
+		return running == 0, nil
+	})
+	if wait.Interrupted(err) {
+		fmt.Fprintf(o.Out, "%d workload(s) still running after the grace period\n", running)
+		return nil
+	}
+	return err
+}
+
+// requeuePendingWorkloads moves the workloads pending in the LocalQueues of the
+// drained ClusterQueue to the LocalQueues of the ClusterQueue to requeue to,
+// in the same namespace.
+func (o *ClusterQueueOptions) requeuePendingWorkloads(ctx context.Context) error {
+	lqList, err := o.Client.LocalQueues(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	sourceQueues := make(map[types.NamespacedName]bool)
+	targetQueues := make(map[string]v1beta1.LocalQueueName)
+	for _, lq := range lqList.Items {
+		switch string(lq.Spec.ClusterQueue) {
+		case o.ClusterQueueName:
+			sourceQueues[types.NamespacedName{Namespace: lq.Namespace, Name: lq.Name}] = true
+		case o.RequeueTo:
+			if _, found := targetQueues[lq.Namespace]; !found {
+				targetQueues[lq.Namespace] = v1beta1.LocalQueueName(lq.Name)
+			}
+		}
+	}
+
+	wlList, err := o.Client.Workloads(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for i := range wlList.Items {
+		wl := &wlList.Items[i]
+		if !sourceQueues[types.NamespacedName{Namespace: wl.Namespace, Name: string(wl.Spec.QueueName)}] ||
+			workload.HasQuotaReservation(wl) || workload.IsFinished(wl) {
+			continue
+		}
+		target, found := targetQueues[wl.Namespace]
+		if !found {
+			fmt.Fprintf(o.ErrOut, "workload.kueue.x-k8s.io/%s not moved: no localqueue of clusterqueue %s in namespace %s\n", wl.Name, o.RequeueTo, wl.Namespace)
+			continue
+		}
+		if err := util.SetWorkloadQueueName(ctx, o.Client, o.DynamicClient, o.RestMapper, wl, target); err != nil {
+			return err
+		}
+		fmt.Fprintf(o.Out, "workload.kueue.x-k8s.io/%s moved to localqueue %s/%s\n", wl.Name, wl.Namespace, target)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sscheme "k8s.io/client-go/kubernetes/scheme"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
+	cmdtesting "sigs.k8s.io/kueue/cmd/kueuectl/app/testing"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestClusterQueueCmd(t *testing.T) {
+	jobGVK := schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}
+	jobGVR := schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}
+
+	admission := utiltesting.MakeAdmission("cq1").
+		PodSets(utiltesting.MakePodSetAssignment(v1beta1.DefaultPodSetName).Assignment(corev1.ResourceCPU, "default", "1").Obj()).
+		Obj()
+
+	testCases := map[string]struct {
+		args           []string
+		objs           []runtime.Object
+		jobs           []runtime.Object
+		wantStopPolicy v1beta1.StopPolicy
+		wantQueueNames map[string]v1beta1.LocalQueueName
+		wantJobQueues  map[string]string
+		wantOut        string
+		wantOutErr     string
+		wantErr        string
+	}{
+		"should drain the clusterqueue": {
+			args:           []string{"cq1"},
+			objs:           []runtime.Object{utiltesting.MakeClusterQueue("cq1").Obj()},
+			wantStopPolicy: v1beta1.HoldAndDrain,
+			wantOut:        "clusterqueue.kueue.x-k8s.io/cq1 drained\n",
+		},
+		"should drain the clusterqueue once the admitted workloads finished": {
+			args: []string{"cq1", "--grace", "1m"},
+			objs: []runtime.Object{
+				utiltesting.MakeClusterQueue("cq1").Obj(),
+				utiltesting.MakeWorkload("wl1", "ns1").Queue("lq1").ReserveQuota(admission).Finished().Obj(),
+			},
+			wantStopPolicy: v1beta1.HoldAndDrain,
+			wantOut: `clusterqueue.kueue.x-k8s.io/cq1 stopped, waiting up to 1m0s for the admitted workloads to finish
+clusterqueue.kueue.x-k8s.io/cq1 drained
+`,
+		},
+		"should drain the clusterqueue after the grace period": {
+			args: []string{"cq1", "--grace", "10ms"},
+			objs: []runtime.Object{
+				utiltesting.MakeClusterQueue("cq1").Obj(),
+				utiltesting.MakeWorkload("wl1", "ns1").Queue("lq1").ReserveQuota(admission).Obj(),
+			},
+			wantStopPolicy: v1beta1.HoldAndDrain,
+			wantOut: `clusterqueue.kueue.x-k8s.io/cq1 stopped, waiting up to 10ms for the admitted workloads to finish
+1 workload(s) still running after the grace period
+clusterqueue.kueue.x-k8s.io/cq1 drained
+`,
+		},
+		"should move the pending workloads to the other clusterqueue": {
+			args: []string{"cq1", "--requeue-to", "cq2"},
+			objs: []runtime.Object{
+				utiltesting.MakeClusterQueue("cq1").Obj(),
+				utiltesting.MakeClusterQueue("cq2").Obj(),
+				utiltesting.MakeLocalQueue("lq1", "ns1").ClusterQueue("cq1").Obj(),
+				utiltesting.MakeLocalQueue("lq2", "ns1").ClusterQueue("cq2").Obj(),
+				utiltesting.MakeLocalQueue("lq1", "ns2").ClusterQueue("cq1").Obj(),
+				utiltesting.MakeWorkload("pending", "ns1").Queue("lq1").Obj(),
+				utiltesting.MakeWorkload("pending-job", "ns1").Queue("lq1").OwnerReference(jobGVK, "job", "").Obj(),
+				utiltesting.MakeWorkload("admitted", "ns1").Queue("lq1").ReserveQuota(admission).Obj(),
+				utiltesting.MakeWorkload("other", "ns1").Queue("lq3").Obj(),
+				utiltesting.MakeWorkload("pending", "ns2").Queue("lq1").Obj(),
+			},
+			jobs: []runtime.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "job",
+						Namespace: "ns1",
+						Labels:    map[string]string{constants.QueueLabel: "lq1"},
+					},
+				},
+			},
+			wantStopPolicy: v1beta1.HoldAndDrain,
+			wantQueueNames: map[string]v1beta1.LocalQueueName{
+				"ns1/pending":     "lq2",
+				"ns1/pending-job": "lq1",
+				"ns1/admitted":    "lq1",
+				"ns1/other":       "lq3",
+				"ns2/pending":     "lq1",
+			},
+			wantJobQueues: map[string]string{"job": "lq2"},
+			wantOut: `clusterqueue.kueue.x-k8s.io/cq1 drained
+workload.kueue.x-k8s.io/pending moved to localqueue ns1/lq2
+workload.kueue.x-k8s.io/pending-job moved to localqueue ns1/lq2
+`,
+			wantOutErr: "workload.kueue.x-k8s.io/pending not moved: no localqueue of clusterqueue cq2 in namespace ns2\n",
+		},
+		"should fail to requeue to the drained clusterqueue": {
+			args:    []string{"cq1", "--requeue-to", "cq1"},
+			objs:    []runtime.Object{utiltesting.MakeClusterQueue("cq1").Obj()},
+			wantErr: "cannot requeue the pending workloads to the drained clusterqueue",
+		},
+		"should fail when the clusterqueue to requeue to is not found": {
+			args:    []string{"cq1", "--requeue-to", "cq2"},
+			objs:    []runtime.Object{utiltesting.MakeClusterQueue("cq1").Obj()},
+			wantErr: `clusterqueues.kueue.x-k8s.io "cq2" not found`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			streams, _, out, outErr := genericiooptions.NewTestIOStreams()
+
+			clientset := fake.NewSimpleClientset(tc.objs...)
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(k8sscheme.Scheme, tc.jobs...)
+			restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{})
+			restMapper.Add(jobGVK, meta.RESTScopeNamespace)
+
+			tcg := cmdtesting.NewTestClientGetter().
+				WithKueueClientset(clientset).
+				WithDynamicClient(dynamicClient).
+				WithRESTMapper(restMapper)
+
+			cmd := NewClusterQueueCmd(tcg, streams)
+			cmd.SetOut(out)
+			cmd.SetErr(outErr)
+			cmd.SetArgs(tc.args)
+
+			gotErr := cmd.Execute()
+			var gotErrStr string
+			if gotErr != nil {
+				gotErrStr = gotErr.Error()
+			}
+			if diff := cmp.Diff(tc.wantErr, gotErrStr); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+
+			if gotErr != nil {
+				return
+			}
+
+			cq, err := clientset.KueueV1beta1().ClusterQueues().Get(context.Background(), "cq1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotStopPolicy v1beta1.StopPolicy
+			if cq.Spec.StopPolicy != nil {
+				gotStopPolicy = *cq.Spec.StopPolicy
+			}
+			if diff := cmp.Diff(tc.wantStopPolicy, gotStopPolicy); diff != "" {
+				t.Errorf("Unexpected stop policy (-want/+got)\n%s", diff)
+			}
+
+			if tc.wantQueueNames != nil {
+				wlList, err := clientset.KueueV1beta1().Workloads(metav1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				gotQueueNames := make(map[string]v1beta1.LocalQueueName, len(wlList.Items))
+				for _, wl := range wlList.Items {
+					gotQueueNames[wl.Namespace+"/"+wl.Name] = wl.Spec.QueueName
+				}
+				if diff := cmp.Diff(tc.wantQueueNames, gotQueueNames); diff != "" {
+					t.Errorf("Unexpected queue names of the workloads (-want/+got)\n%s", diff)
+				}
+			}
+
+			for jobName, wantQueue := range tc.wantJobQueues {
+				job, err := dynamicClient.Resource(jobGVR).Namespace("ns1").Get(context.Background(), jobName, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(wantQueue, job.GetLabels()[constants.QueueLabel]); diff != "" {
+					t.Errorf("Unexpected queue name of the job %s (-want/+got)\n%s", jobName, diff)
+				}
+			}
+
+			if diff := cmp.Diff(tc.wantOut, out.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.wantOutErr, outErr.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
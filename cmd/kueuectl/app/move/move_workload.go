@@ -0,0 +1,327 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package move
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/kubectl/pkg/util/templates"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	kueuev1beta1 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/kueue/v1beta1"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/util"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	defaultPollInterval = time.Second
+	defaultTimeout      = time.Minute
+)
+
+var (
+	wlLong = templates.LongDesc(`
+		Moves the given pending Workloads, or the pending Workloads matching
+		the selector, to another LocalQueue in the same namespace.
+
+		The queue-name label of the Jobs owning the Workloads is updated, so
+		that Kueue moves the Workloads along with them. The Workloads with
+		quota reserved are not moved.
+
+		With --recreate, the Workloads are deleted and created again in the
+		target LocalQueue, which drops their status, like the scheduling
+		history and the requeue state. The Workloads without owners are
+		recreated with the same spec, including the priority, while the
+		Workloads owned by Jobs are recreated by Kueue from the Jobs. A
+		Workload without owners is only created again once its deletion is
+		complete. If it can't be created again, its manifest is printed, so
+		that it can be created manually.
+	`)
+	wlExample = templates.Examples(`
+		# Move the workload to another localqueue
+		kueuectl move workload my-workload --to my-localqueue
+
+		# Move the workloads of a localqueue to another localqueue
+		kueuectl move workload --from my-localqueue --to other-localqueue
+
+		# Move the workloads matching the selector to another localqueue, recreating them
+		kueuectl move workload -l team=a --to other-localqueue --recreate
+	`)
+)
+
+type WorkloadOptions struct {
+	Names         []string
+	Namespace     string
+	LabelSelector string
+	From          string
+	To            string
+	Recreate      bool
+	Timeout       time.Duration
+	PollInterval  time.Duration
+
+	Client        kueuev1beta1.KueueV1beta1Interface
+	DynamicClient dynamic.Interface
+	RestMapper    meta.RESTMapper
+
+	genericiooptions.IOStreams
+}
+
+func NewWorkloadOptions(streams genericiooptions.IOStreams) *WorkloadOptions {
+	return &WorkloadOptions{
+		PollInterval: defaultPollInterval,
+		IOStreams:    streams,
+	}
+}
+
+func NewWorkloadCmd(clientGetter util.ClientGetter, streams genericiooptions.IOStreams) *cobra.Command {
+	o := NewWorkloadOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:                   "workload [NAME...] --to LOCALQUEUE [--from LOCALQUEUE] [--selector key1=value1] [--recreate]",
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{"wl"},
+		Short:                 "Move the pending Workloads to another LocalQueue",
+		Long:                  wlLong,
+		Example:               wlExample,
+		ValidArgsFunction:     completion.WorkloadNameFunc(clientGetter, nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			err := o.Complete(clientGetter, args)
+			if err != nil {
+				return err
+			}
+			return o.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVar(&o.To, "to", "",
+		"The LocalQueue to move the Workloads to.")
+	cmd.Flags().StringVar(&o.From, "from", "",
+		"Move only the Workloads of this LocalQueue.")
+	cmd.Flags().StringVarP(&o.LabelSelector, "selector", "l", "",
+		"Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints.")
+	cmd.Flags().BoolVar(&o.Recreate, "recreate", false,
+		"Delete the Workloads and create them again in the target LocalQueue.")
+	cmd.Flags().DurationVar(&o.Timeout, "timeout", defaultTimeout,
+		"The period to wait for the deletion of each recreated Workload.")
+
+	cobra.CheckErr(cmd.MarkFlagRequired("to"))
+	cobra.CheckErr(cmd.RegisterFlagCompletionFunc("to", completion.LocalQueueNameFunc(clientGetter, nil)))
+	cobra.CheckErr(cmd.RegisterFlagCompletionFunc("from", completion.LocalQueueNameFunc(clientGetter, nil)))
+
+	return cmd
+}
+
+// Complete completes all the required options
+func (o *WorkloadOptions) Complete(clientGetter util.ClientGetter, args []string) error {
+	o.Names = args
+
+	if len(o.Names) == 0 && o.LabelSelector == "" && o.From == "" {
+		return errors.New("requires the names of the workloads, a selector or the localqueue to move the workloads from")
+	}
+
+	if len(o.Names) > 0 && o.LabelSelector != "" {
+		return errors.New("name cannot be provided when a selector is specified")
+	}
+
+	if o.From != "" && o.From == o.To {
+		return errors.New("cannot move the workloads to the localqueue they are moved from")
+	}
+
+	if o.Timeout <= 0 {
+		return errors.New("the timeout must be positive")
+	}
+
+	var err error
+	o.Namespace, _, err = clientGetter.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := clientGetter.KueueClientSet()
+	if err != nil {
+		return err
+	}
+
+	o.Client = clientset.KueueV1beta1()
+
+	o.DynamicClient, err = clientGetter.DynamicClient()
+	if err != nil {
+		return err
+	}
+
+	o.RestMapper, err = clientGetter.ToRESTMapper()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run executes the command
+func (o *WorkloadOptions) Run(ctx context.Context) error {
+	if _, err := o.Client.LocalQueues(o.Namespace).Get(ctx, o.To, metav1.GetOptions{}); err != nil {
+		return err
+	}
+
+	workloads, err := o.getWorkloads(ctx)
+	if err != nil {
+		return err
+	}
+
+	target := v1beta1.LocalQueueName(o.To)
+	for _, wl := range workloads {
+		if o.From != "" && string(wl.Spec.QueueName) != o.From {
+			continue
+		}
+		if wl.Spec.QueueName == target {
+			fmt.Fprintf(o.ErrOut, "workload.kueue.x-k8s.io/%s is already in localqueue %s\n", wl.Name, o.To)
+			continue
+		}
+		if workload.HasQuotaReservation(wl) || workload.IsFinished(wl) {
+			fmt.Fprintf(o.ErrOut, "workload.kueue.x-k8s.io/%s not moved: the workload is not pending\n", wl.Name)
+			continue
+		}
+		if o.Recreate {
+			err = o.recreateWorkload(ctx, wl)
+		} else {
+			err = util.SetWorkloadQueueName(ctx, o.Client, o.DynamicClient, o.RestMapper, wl, target)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(o.Out, "workload.kueue.x-k8s.io/%s moved to localqueue %s\n", wl.Name, o.To)
+	}
+
+	return nil
+}
+
+func (o *WorkloadOptions) getWorkloads(ctx context.Context) ([]*v1beta1.Workload, error) {
+	if len(o.Names) == 0 {
+		list, err := o.Client.Workloads(o.Namespace).List(ctx, metav1.ListOptions{LabelSelector: o.LabelSelector})
+		if err != nil {
+			return nil, err
+		}
+		workloads := make([]*v1beta1.Workload, 0, len(list.Items))
+		for i := range list.Items {
+			workloads = append(workloads, &list.Items[i])
+		}
+		return workloads, nil
+	}
+
+	workloads := make([]*v1beta1.Workload, 0, len(o.Names))
+	for _, name := range o.Names {
+		wl, err := o.Client.Workloads(o.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		if err != nil {
+			fmt.Fprintln(o.ErrOut, err)
+			continue
+		}
+		workloads = append(workloads, wl)
+	}
+	return workloads, nil
+}
+
+// recreateWorkload deletes the Workload and creates it again in the target
+// LocalQueue. The Workloads owned by Jobs are recreated by Kueue once the
+// queue-name label of the Jobs is updated.
+func (o *WorkloadOptions) recreateWorkload(ctx context.Context, wl *v1beta1.Workload) error {
+	deleteOptions := metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: ptr.To(wl.UID)}}
+
+	if len(wl.OwnerReferences) > 0 {
+		if err := util.SetWorkloadQueueName(ctx, o.Client, o.DynamicClient, o.RestMapper, wl, v1beta1.LocalQueueName(o.To)); err != nil {
+			return err
+		}
+		return client.IgnoreNotFound(o.Client.Workloads(wl.Namespace).Delete(ctx, wl.Name, deleteOptions))
+	}
+
+	newWl := &v1beta1.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        wl.Name,
+			Namespace:   wl.Namespace,
+			Labels:      wl.Labels,
+			Annotations: wl.Annotations,
+		},
+		Spec: *wl.Spec.DeepCopy(),
+	}
+	newWl.Spec.QueueName = v1beta1.LocalQueueName(o.To)
+
+	if err := o.Client.Workloads(wl.Namespace).Delete(ctx, wl.Name, deleteOptions); err != nil {
+		return err
+	}
+	err := o.waitForDeletion(ctx, wl)
+	if err == nil {
+		_, err = o.Client.Workloads(wl.Namespace).Create(ctx, newWl, metav1.CreateOptions{})
+	}
+	if err != nil {
+		o.printManifest(newWl)
+		return fmt.Errorf("recreating the workload %s: %w", wl.Name, err)
+	}
+	return nil
+}
+
+// waitForDeletion waits for the deleted Workload to be removed, for example
+// once its finalizers are removed, so that it can be created again with the
+// same name.
+func (o *WorkloadOptions) waitForDeletion(ctx context.Context, wl *v1beta1.Workload) error {
+	err := wait.PollUntilContextTimeout(ctx, o.PollInterval, o.Timeout, true, func(ctx context.Context) (bool, error) {
+		current, err := o.Client.Workloads(wl.Namespace).Get(ctx, wl.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return current.UID != wl.UID, nil
+	})
+	if err == nil {
+		return nil
+	}
+	if wait.Interrupted(err) {
+		return fmt.Errorf("the workload is still being deleted after %s", o.Timeout)
+	}
+	return err
+}
+
+// printManifest prints the manifest of the Workload which couldn't be created
+// again, so that it isn't lost.
+func (o *WorkloadOptions) printManifest(wl *v1beta1.Workload) {
+	manifest := wl.DeepCopy()
+	manifest.APIVersion = v1beta1.GroupVersion.String()
+	manifest.Kind = "Workload"
+	data, err := yaml.Marshal(manifest)
+	if err != nil {
+		fmt.Fprintf(o.ErrOut, "workload.kueue.x-k8s.io/%s not recreated, encoding its manifest: %v\n", wl.Name, err)
+		return
+	}
+	fmt.Fprintf(o.ErrOut, "workload.kueue.x-k8s.io/%s not recreated, its manifest is:\n%s", wl.Name, data)
+}
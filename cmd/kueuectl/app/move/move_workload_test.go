@@ -0,0 +1,338 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package move
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sscheme "k8s.io/client-go/kubernetes/scheme"
+	kubetesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
+	cmdtesting "sigs.k8s.io/kueue/cmd/kueuectl/app/testing"
+	"sigs.k8s.io/kueue/pkg/constants"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestWorkloadCmd(t *testing.T) {
+	jobGVK := schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}
+	jobGVR := schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}
+
+	admission := utiltesting.MakeAdmission("cq").
+		PodSets(utiltesting.MakePodSetAssignment(v1beta1.DefaultPodSetName).Assignment(corev1.ResourceCPU, "default", "1").Obj()).
+		Obj()
+	pendingCondition := metav1.Condition{
+		Type:    v1beta1.WorkloadQuotaReserved,
+		Status:  metav1.ConditionFalse,
+		Reason:  "Pending",
+		Message: "couldn't assign flavors to pod set main",
+	}
+	localQueues := []runtime.Object{
+		utiltesting.MakeLocalQueue("lq1", metav1.NamespaceDefault).ClusterQueue("cq").Obj(),
+		utiltesting.MakeLocalQueue("lq2", metav1.NamespaceDefault).ClusterQueue("cq").Obj(),
+		utiltesting.MakeLocalQueue("lq3", metav1.NamespaceDefault).ClusterQueue("cq").Obj(),
+	}
+
+	testCases := map[string]struct {
+		args          []string
+		objs          []runtime.Object
+		jobs          []runtime.Object
+		wantWorkloads []v1beta1.Workload
+		wantJobQueues map[string]string
+		// workloadReactors are the reactions of the clientset to the verbs on the workloads.
+		workloadReactors map[string]kubetesting.ReactionFunc
+		wantOut          string
+		wantOutErr       string
+		wantErr          string
+	}{
+		"should move the workload": {
+			args: []string{"wl1", "--to", "lq2"},
+			objs: append([]runtime.Object{
+				utiltesting.MakeWorkload("wl1", metav1.NamespaceDefault).Queue("lq1").Obj(),
+				utiltesting.MakeWorkload("wl2", metav1.NamespaceDefault).Queue("lq1").Obj(),
+			}, localQueues...),
+			wantWorkloads: []v1beta1.Workload{
+				*utiltesting.MakeWorkload("wl1", metav1.NamespaceDefault).Queue("lq2").Obj(),
+				*utiltesting.MakeWorkload("wl2", metav1.NamespaceDefault).Queue("lq1").Obj(),
+			},
+			wantOut: "workload.kueue.x-k8s.io/wl1 moved to localqueue lq2\n",
+		},
+		"should move the pending workloads matching the selector through their jobs": {
+			args: []string{"-l", "team=a", "--to", "lq2"},
+			objs: append([]runtime.Object{
+				utiltesting.MakeWorkload("wl1", metav1.NamespaceDefault).Queue("lq1").Label("team", "a").
+					OwnerReference(jobGVK, "job1", "").Obj(),
+				utiltesting.MakeWorkload("wl2", metav1.NamespaceDefault).Queue("lq1").Label("team", "a").
+					ReserveQuota(admission).Obj(),
+				utiltesting.MakeWorkload("wl3", metav1.NamespaceDefault).Queue("lq2").Label("team", "a").Obj(),
+				utiltesting.MakeWorkload("wl4", metav1.NamespaceDefault).Queue("lq1").Label("team", "b").Obj(),
+			}, localQueues...),
+			jobs: []runtime.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "job1",
+						Namespace: metav1.NamespaceDefault,
+						Labels:    map[string]string{controllerconsts.QueueLabel: "lq1"},
+					},
+				},
+			},
+			wantWorkloads: []v1beta1.Workload{
+				*utiltesting.MakeWorkload("wl1", metav1.NamespaceDefault).Queue("lq1").Label("team", "a").
+					OwnerReference(jobGVK, "job1", "").Obj(),
+				*utiltesting.MakeWorkload("wl2", metav1.NamespaceDefault).Queue("lq1").Label("team", "a").
+					ReserveQuota(admission).Obj(),
+				*utiltesting.MakeWorkload("wl3", metav1.NamespaceDefault).Queue("lq2").Label("team", "a").Obj(),
+				*utiltesting.MakeWorkload("wl4", metav1.NamespaceDefault).Queue("lq1").Label("team", "b").Obj(),
+			},
+			wantJobQueues: map[string]string{"job1": "lq2"},
+			wantOut:       "workload.kueue.x-k8s.io/wl1 moved to localqueue lq2\n",
+			wantOutErr: `workload.kueue.x-k8s.io/wl2 not moved: the workload is not pending
+workload.kueue.x-k8s.io/wl3 is already in localqueue lq2
+`,
+		},
+		"should move the workloads of the localqueue": {
+			args: []string{"--from", "lq1", "--to", "lq2"},
+			objs: append([]runtime.Object{
+				utiltesting.MakeWorkload("wl1", metav1.NamespaceDefault).Queue("lq1").Obj(),
+				utiltesting.MakeWorkload("wl2", metav1.NamespaceDefault).Queue("lq3").Obj(),
+			}, localQueues...),
+			wantWorkloads: []v1beta1.Workload{
+				*utiltesting.MakeWorkload("wl1", metav1.NamespaceDefault).Queue("lq2").Obj(),
+				*utiltesting.MakeWorkload("wl2", metav1.NamespaceDefault).Queue("lq3").Obj(),
+			},
+			wantOut: "workload.kueue.x-k8s.io/wl1 moved to localqueue lq2\n",
+		},
+		"should recreate the workload preserving its priority": {
+			args: []string{"wl1", "--to", "lq2", "--recreate"},
+			objs: append([]runtime.Object{
+				utiltesting.MakeWorkload("wl1", metav1.NamespaceDefault).
+					Queue("lq1").
+					PriorityClass("high").
+					PriorityClassSource(constants.WorkloadPriorityClassSource).
+					Priority(100).
+					Condition(pendingCondition).
+					RequeueState(ptr.To[int32](1), nil).
+					Obj(),
+			}, localQueues...),
+			wantWorkloads: []v1beta1.Workload{
+				*utiltesting.MakeWorkload("wl1", metav1.NamespaceDefault).
+					Queue("lq2").
+					PriorityClass("high").
+					PriorityClassSource(constants.WorkloadPriorityClassSource).
+					Priority(100).
+					Obj(),
+			},
+			wantOut: "workload.kueue.x-k8s.io/wl1 moved to localqueue lq2\n",
+		},
+		"should recreate the workload owned by a job": {
+			args: []string{"wl1", "--to", "lq2", "--recreate"},
+			objs: append([]runtime.Object{
+				utiltesting.MakeWorkload("wl1", metav1.NamespaceDefault).Queue("lq1").
+					OwnerReference(jobGVK, "job1", "").Condition(pendingCondition).Obj(),
+			}, localQueues...),
+			jobs: []runtime.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "job1",
+						Namespace: metav1.NamespaceDefault,
+						Labels:    map[string]string{controllerconsts.QueueLabel: "lq1"},
+					},
+				},
+			},
+			wantJobQueues: map[string]string{"job1": "lq2"},
+			wantOut:       "workload.kueue.x-k8s.io/wl1 moved to localqueue lq2\n",
+		},
+		"should wait for the deletion of the workload to recreate it": {
+			args: []string{"wl1", "--to", "lq2", "--recreate", "--timeout", "10ms"},
+			objs: append([]runtime.Object{
+				utiltesting.MakeWorkload("wl1", metav1.NamespaceDefault).Queue("lq1").
+					Finalizers(v1beta1.ResourceInUseFinalizerName).Obj(),
+			}, localQueues...),
+			workloadReactors: map[string]kubetesting.ReactionFunc{
+				// The workload is kept by its finalizer.
+				"delete": func(kubetesting.Action) (bool, runtime.Object, error) {
+					return true, nil, nil
+				},
+			},
+			wantErr: "recreating the workload wl1: the workload is still being deleted after 10ms",
+			wantOutErr: `workload.kueue.x-k8s.io/wl1 not recreated, its manifest is:
+apiVersion: kueue.x-k8s.io/v1beta1
+kind: Workload
+metadata:
+  name: wl1
+  namespace: default
+spec:
+  podSets:
+  - count: 1
+    name: main
+    template:
+      metadata: {}
+      spec:
+        containers:
+        - name: c
+          resources: {}
+        restartPolicy: Never
+  queueName: lq2
+status: {}
+Error: recreating the workload wl1: the workload is still being deleted after 10ms
+`,
+		},
+		"should print the manifest of the workload which can't be created again": {
+			args: []string{"wl1", "--to", "lq2", "--recreate"},
+			objs: append([]runtime.Object{
+				utiltesting.MakeWorkload("wl1", metav1.NamespaceDefault).Queue("lq1").Obj(),
+			}, localQueues...),
+			workloadReactors: map[string]kubetesting.ReactionFunc{
+				"create": func(kubetesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New("quota exceeded")
+				},
+			},
+			wantErr: "recreating the workload wl1: quota exceeded",
+			wantOutErr: `workload.kueue.x-k8s.io/wl1 not recreated, its manifest is:
+apiVersion: kueue.x-k8s.io/v1beta1
+kind: Workload
+metadata:
+  name: wl1
+  namespace: default
+spec:
+  podSets:
+  - count: 1
+    name: main
+    template:
+      metadata: {}
+      spec:
+        containers:
+        - name: c
+          resources: {}
+        restartPolicy: Never
+  queueName: lq2
+status: {}
+Error: recreating the workload wl1: quota exceeded
+`,
+		},
+		"should print the workloads not found": {
+			args:       []string{"wl1", "--to", "lq2"},
+			objs:       localQueues,
+			wantOutErr: "workloads.kueue.x-k8s.io \"wl1\" not found\n",
+		},
+		"no workloads selected": {
+			args:    []string{"--to", "lq2"},
+			wantErr: "requires the names of the workloads, a selector or the localqueue to move the workloads from",
+		},
+		"no target localqueue": {
+			args:    []string{"wl1"},
+			wantErr: `required flag(s) "to" not set`,
+		},
+		"names and selector": {
+			args:    []string{"wl1", "-l", "team=a", "--to", "lq2"},
+			wantErr: "name cannot be provided when a selector is specified",
+		},
+		"same source and target localqueues": {
+			args:    []string{"--from", "lq1", "--to", "lq1"},
+			wantErr: "cannot move the workloads to the localqueue they are moved from",
+		},
+		"target localqueue not found": {
+			args:    []string{"wl1", "--to", "lq4"},
+			objs:    localQueues,
+			wantErr: `localqueues.kueue.x-k8s.io "lq4" not found`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			streams, _, out, outErr := genericiooptions.NewTestIOStreams()
+
+			clientset := fake.NewSimpleClientset(tc.objs...)
+			for verb, reactor := range tc.workloadReactors {
+				clientset.PrependReactor(verb, "workloads", reactor)
+			}
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(k8sscheme.Scheme, tc.jobs...)
+			restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{})
+			restMapper.Add(jobGVK, meta.RESTScopeNamespace)
+
+			tcg := cmdtesting.NewTestClientGetter().
+				WithKueueClientset(clientset).
+				WithDynamicClient(dynamicClient).
+				WithRESTMapper(restMapper)
+
+			cmd := NewWorkloadCmd(tcg, streams)
+			cmd.SetOut(out)
+			cmd.SetErr(outErr)
+			cmd.SetArgs(tc.args)
+
+			gotErr := cmd.Execute()
+			var gotErrStr string
+			if gotErr != nil {
+				gotErrStr = gotErr.Error()
+			}
+			if diff := cmp.Diff(tc.wantErr, gotErrStr); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+
+			if gotErr != nil {
+				if tc.wantOutErr != "" {
+					if diff := cmp.Diff(tc.wantOutErr, outErr.String()); diff != "" {
+						t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+					}
+				}
+				return
+			}
+
+			wlList, err := clientset.KueueV1beta1().Workloads(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.wantWorkloads, wlList.Items, cmpopts.EquateEmpty(),
+				cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion"),
+				cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected workloads (-want/+got)\n%s", diff)
+			}
+
+			for jobName, wantQueue := range tc.wantJobQueues {
+				job, err := dynamicClient.Resource(jobGVR).Namespace(metav1.NamespaceDefault).Get(context.Background(), jobName, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(wantQueue, job.GetLabels()[controllerconsts.QueueLabel]); diff != "" {
+					t.Errorf("Unexpected queue name of the job %s (-want/+got)\n%s", jobName, diff)
+				}
+			}
+
+			if diff := cmp.Diff(tc.wantOut, out.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.wantOutErr, outErr.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
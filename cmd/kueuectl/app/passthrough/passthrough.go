@@ -24,8 +24,10 @@ import (
 
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/utils/clock"
 
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/delete"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/describe"
 	"sigs.k8s.io/kueue/cmd/kueuectl/app/util"
 )
 
@@ -56,10 +58,10 @@ var (
 	}
 )
 
-func NewCommands(clientGetter util.ClientGetter, streams genericiooptions.IOStreams) []*cobra.Command {
+func NewCommands(clientGetter util.ClientGetter, streams genericiooptions.IOStreams, clock clock.Clock) []*cobra.Command {
 	commands := make([]*cobra.Command, len(passThroughCommands))
 	for i, ptCmd := range passThroughCommands {
-		commands[i] = newCommand(clientGetter, streams, ptCmd, passThroughTypes)
+		commands[i] = newCommand(clientGetter, streams, clock, ptCmd, passThroughTypes)
 	}
 	return commands
 }
@@ -67,6 +69,7 @@ func NewCommands(clientGetter util.ClientGetter, streams genericiooptions.IOStre
 func newCommand(
 	clientGetter util.ClientGetter,
 	streams genericiooptions.IOStreams,
+	clock clock.Clock,
 	command passThroughCommand,
 	ptTypes []passThroughType,
 ) *cobra.Command {
@@ -77,6 +80,8 @@ func newCommand(
 	for _, ptType := range ptTypes {
 		if command.name == "delete" && ptType.name == "workload" {
 			cmd.AddCommand(delete.NewWorkloadCmd(clientGetter, streams))
+		} else if command.name == "describe" && ptType.name == "workload" {
+			cmd.AddCommand(describe.NewWorkloadCmd(clientGetter, streams, clock))
 		} else {
 			cmd.AddCommand(newSubcommand(command, ptType))
 		}
@@ -0,0 +1,270 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reactivate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/kubectl/pkg/util/templates"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/scheme"
+	kueuev1beta1 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/kueue/v1beta1"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/util"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+var (
+	wlLong = templates.LongDesc(`
+		Reactivates the deactivated Workloads, allowing their admission according to
+		regular ClusterQueue rules, and resets the number of their reactivations by
+		the reactivationPolicy of their ClusterQueue.
+
+		With --all, reactivates all the Workloads deactivated by Kueue, for example
+		after exceeding their requeuing limit, leaving the Workloads stopped by the
+		users deactivated.
+	`)
+	wlExample = templates.Examples(`
+		# Reactivate the workload
+		kueuectl reactivate workload my-workload
+
+		# Reactivate all the workloads deactivated by Kueue in the local queue
+		kueuectl reactivate workload --all --localqueue my-local-queue
+
+		# Reactivate all the workloads deactivated by Kueue in all the namespaces
+		kueuectl reactivate workload --all --all-namespaces
+	`)
+)
+
+type WorkloadOptions struct {
+	PrintFlags *genericclioptions.PrintFlags
+
+	Names            []string
+	Namespace        string
+	AllNamespaces    bool
+	ReactivateAll    bool
+	LocalQueueFilter string
+
+	DryRunStrategy util.DryRunStrategy
+
+	Client kueuev1beta1.KueueV1beta1Interface
+
+	PrintObj printers.ResourcePrinterFunc
+
+	genericiooptions.IOStreams
+}
+
+func NewWorkloadOptions(streams genericiooptions.IOStreams) *WorkloadOptions {
+	return &WorkloadOptions{
+		PrintFlags: genericclioptions.NewPrintFlags("reactivated").WithTypeSetter(scheme.Scheme),
+		IOStreams:  streams,
+	}
+}
+
+func NewWorkloadCmd(clientGetter util.ClientGetter, streams genericiooptions.IOStreams) *cobra.Command {
+	o := NewWorkloadOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:                   "workload NAME [--all] [--localqueue LOCAL_QUEUE_NAME] [--all-namespaces] [--dry-run STRATEGY]",
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{"wl"},
+		Short:                 "Reactivate the deactivated Workloads",
+		Long:                  wlLong,
+		Example:               wlExample,
+		ValidArgsFunction:     completion.WorkloadNameFunc(clientGetter, ptr.To(false)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			err := o.Complete(clientGetter, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			return o.Run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().BoolVar(&o.ReactivateAll, "all", false, "Reactivate all the Workloads deactivated by Kueue, in the specified namespace.")
+	cmd.Flags().StringVarP(&o.LocalQueueFilter, "localqueue", "q", "", "Filter by local queue name which is associated with the resource.")
+
+	util.AddAllNamespacesFlagVar(cmd, &o.AllNamespaces)
+	o.PrintFlags.AddFlags(cmd)
+
+	cobra.CheckErr(cmd.RegisterFlagCompletionFunc("localqueue", completion.LocalQueueNameFunc(clientGetter, nil)))
+
+	return cmd
+}
+
+// Complete completes all the required options
+func (o *WorkloadOptions) Complete(clientGetter util.ClientGetter, cmd *cobra.Command, args []string) error {
+	o.Names = args
+
+	if !o.ReactivateAll && len(o.Names) == 0 {
+		return errors.New("requires at least 1 arg(s), only received 0")
+	}
+
+	if o.ReactivateAll && len(o.Names) > 0 {
+		return errors.New("name cannot be provided when --all is specified")
+	}
+
+	if !o.ReactivateAll && (o.AllNamespaces || len(o.LocalQueueFilter) > 0) {
+		return errors.New("--all-namespaces and --localqueue can only be used with --all")
+	}
+
+	var err error
+
+	o.Namespace, _, err = clientGetter.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+
+	o.DryRunStrategy, err = util.GetDryRunStrategy(cmd)
+	if err != nil {
+		return err
+	}
+
+	err = util.PrintFlagsWithDryRunStrategy(o.PrintFlags, o.DryRunStrategy)
+	if err != nil {
+		return err
+	}
+
+	printer, err := o.PrintFlags.ToPrinter()
+	if err != nil {
+		return err
+	}
+
+	o.PrintObj = printer.PrintObj
+
+	clientset, err := clientGetter.KueueClientSet()
+	if err != nil {
+		return err
+	}
+
+	o.Client = clientset.KueueV1beta1()
+
+	return nil
+}
+
+// Run reactivates the Workloads
+func (o *WorkloadOptions) Run(ctx context.Context) error {
+	var (
+		workloads []*v1beta1.Workload
+		err       error
+	)
+
+	if o.ReactivateAll {
+		workloads, err = o.getDeactivatedWorkloads(ctx)
+	} else {
+		workloads, err = o.getWorkloads(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, wl := range workloads {
+		if err := o.reactivateWorkload(ctx, wl); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *WorkloadOptions) getDeactivatedWorkloads(ctx context.Context) ([]*v1beta1.Workload, error) {
+	var namespace string
+	if !o.AllNamespaces {
+		namespace = o.Namespace
+	}
+
+	list, err := o.Client.Workloads(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	workloads := make([]*v1beta1.Workload, 0, len(list.Items))
+	for index := range list.Items {
+		wl := &list.Items[index]
+		if ptr.Deref(wl.Spec.Active, true) || !workload.IsEvictedDueToDeactivationByKueue(wl) {
+			continue
+		}
+		if len(o.LocalQueueFilter) > 0 && wl.Spec.QueueName != v1beta1.LocalQueueName(o.LocalQueueFilter) {
+			continue
+		}
+		workloads = append(workloads, wl)
+	}
+
+	return workloads, nil
+}
+
+func (o *WorkloadOptions) getWorkloads(ctx context.Context) ([]*v1beta1.Workload, error) {
+	workloads := make([]*v1beta1.Workload, 0, len(o.Names))
+
+	for _, name := range o.Names {
+		wl, err := o.Client.Workloads(o.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		if err != nil {
+			fmt.Fprintln(o.ErrOut, err)
+			continue
+		}
+		if ptr.Deref(wl.Spec.Active, true) {
+			fmt.Fprintf(o.ErrOut, "workload %q is already active\n", wl.Name)
+			continue
+		}
+
+		workloads = append(workloads, wl)
+	}
+
+	return workloads, nil
+}
+
+func (o *WorkloadOptions) reactivateWorkload(ctx context.Context, wl *v1beta1.Workload) error {
+	wlOriginal := wl.DeepCopy()
+	wl.Spec.Active = ptr.To(true)
+	delete(wl.Annotations, constants.ReactivationCountAnnotation)
+
+	if o.DryRunStrategy != util.DryRunClient {
+		opts := metav1.PatchOptions{}
+		if o.DryRunStrategy == util.DryRunServer {
+			opts.DryRun = []string{metav1.DryRunAll}
+		}
+		patch := client.MergeFrom(wlOriginal)
+		data, err := patch.Data(wl)
+		if err != nil {
+			return err
+		}
+		wl, err = o.Client.Workloads(wl.Namespace).Patch(ctx, wl.Name, types.MergePatchType, data, opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	return o.PrintObj(wl, o.Out)
+}
@@ -0,0 +1,179 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reactivate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
+	cmdtesting "sigs.k8s.io/kueue/cmd/kueuectl/app/testing"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestWorkloadCmd(t *testing.T) {
+	deactivationTime := metav1.NewTime(time.Now().Truncate(time.Second))
+	deactivatedByKueue := func(name, namespace string) *utiltesting.WorkloadWrapper {
+		return utiltesting.MakeWorkload(name, namespace).
+			Queue("lq").
+			Active(false).
+			Annotation(constants.ReactivationCountAnnotation, "3").
+			Condition(metav1.Condition{
+				Type:               v1beta1.WorkloadEvicted,
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: deactivationTime,
+				Reason:             "DeactivatedDueToRequeuingLimitExceeded",
+			})
+	}
+
+	testCases := map[string]struct {
+		ns            string
+		args          []string
+		workloads     []runtime.Object
+		wantWorkloads []v1beta1.Workload
+		wantOut       string
+		wantOutErr    string
+		wantErr       string
+	}{
+		"no arguments": {
+			args:    []string{},
+			wantErr: "requires at least 1 arg(s), only received 0",
+		},
+		"name with --all": {
+			args:    []string{"wl1", "--all"},
+			wantErr: "name cannot be provided when --all is specified",
+		},
+		"--localqueue without --all": {
+			args:    []string{"wl1", "--localqueue", "lq"},
+			wantErr: "--all-namespaces and --localqueue can only be used with --all",
+		},
+		"should reactivate the workload and reset its reactivation count": {
+			args: []string{"wl1"},
+			workloads: []runtime.Object{
+				deactivatedByKueue("wl1", metav1.NamespaceDefault).Obj(),
+			},
+			wantWorkloads: []v1beta1.Workload{
+				*deactivatedByKueue("wl1", metav1.NamespaceDefault).
+					Active(true).
+					Annotations(map[string]string{}).
+					Obj(),
+			},
+			wantOut: "workload.kueue.x-k8s.io/wl1 reactivated\n",
+		},
+		"shouldn't reactivate an active workload": {
+			args: []string{"wl1"},
+			workloads: []runtime.Object{
+				utiltesting.MakeWorkload("wl1", metav1.NamespaceDefault).Obj(),
+			},
+			wantWorkloads: []v1beta1.Workload{
+				*utiltesting.MakeWorkload("wl1", metav1.NamespaceDefault).Obj(),
+			},
+			wantOutErr: "workload \"wl1\" is already active\n",
+		},
+		"shouldn't reactivate a workload because it is not found": {
+			args:       []string{"wl1"},
+			wantOutErr: "workloads.kueue.x-k8s.io \"wl1\" not found\n",
+		},
+		"should reactivate all the workloads deactivated by kueue in the local queue": {
+			args: []string{"--all", "--localqueue", "lq"},
+			workloads: []runtime.Object{
+				deactivatedByKueue("wl1", metav1.NamespaceDefault).Obj(),
+				deactivatedByKueue("wl2", metav1.NamespaceDefault).Queue("other").Obj(),
+				utiltesting.MakeWorkload("wl3", metav1.NamespaceDefault).Queue("lq").Active(false).Obj(),
+				deactivatedByKueue("wl4", "other").Obj(),
+			},
+			wantWorkloads: []v1beta1.Workload{
+				*deactivatedByKueue("wl1", metav1.NamespaceDefault).
+					Active(true).
+					Annotations(map[string]string{}).
+					Obj(),
+				*deactivatedByKueue("wl2", metav1.NamespaceDefault).Queue("other").Obj(),
+				*utiltesting.MakeWorkload("wl3", metav1.NamespaceDefault).Queue("lq").Active(false).Obj(),
+			},
+			wantOut: "workload.kueue.x-k8s.io/wl1 reactivated\n",
+		},
+		"shouldn't reactivate the workload with client dry run": {
+			args: []string{"wl1", "--dry-run", "client"},
+			workloads: []runtime.Object{
+				deactivatedByKueue("wl1", metav1.NamespaceDefault).Obj(),
+			},
+			wantWorkloads: []v1beta1.Workload{
+				*deactivatedByKueue("wl1", metav1.NamespaceDefault).Obj(),
+			},
+			wantOut: "workload.kueue.x-k8s.io/wl1 reactivated (client dry run)\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ns := metav1.NamespaceDefault
+			if tc.ns != "" {
+				ns = tc.ns
+			}
+
+			streams, _, out, outErr := genericiooptions.NewTestIOStreams()
+			clientset := fake.NewSimpleClientset(tc.workloads...)
+
+			tcg := cmdtesting.NewTestClientGetter().
+				WithNamespace(ns).
+				WithKueueClientset(clientset)
+
+			cmd := NewReactivateCmd(tcg, streams)
+			cmd.SetOut(out)
+			cmd.SetErr(outErr)
+			cmd.SetArgs(append([]string{"workload"}, tc.args...))
+
+			gotErr := cmd.Execute()
+			var gotErrStr string
+			if gotErr != nil {
+				gotErrStr = gotErr.Error()
+			}
+
+			if diff := cmp.Diff(tc.wantErr, gotErrStr); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+
+			if gotErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.wantOut, out.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.wantOutErr, outErr.String()); diff != "" {
+				t.Errorf("Unexpected error output (-want/+got)\n%s", diff)
+			}
+
+			ctx, _ := utiltesting.ContextWithLog(t)
+			gotWorkloadList, err := clientset.KueueV1beta1().Workloads(ns).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.wantWorkloads, gotWorkloadList.Items, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected workloads (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package top
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+const noneValue = "<none>"
+
+func addLabelSelectorFlagVar(cmd *cobra.Command, p *string) {
+	cmd.Flags().StringVarP(p, "selector", "l", "",
+		"Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2). Matching objects must satisfy all of the specified label constraints.")
+}
+
+func addNoHeadersFlagVar(cmd *cobra.Command, p *bool) {
+	cmd.Flags().BoolVar(p, "no-headers", false,
+		"If present, print output without headers.")
+}
+
+// flavorsUsage indexes the usage of the ClusterQueue by flavor and resource.
+func flavorsUsage(flavors []v1beta1.FlavorUsage) map[v1beta1.ResourceFlavorReference]map[corev1.ResourceName]v1beta1.ResourceUsage {
+	usage := make(map[v1beta1.ResourceFlavorReference]map[corev1.ResourceName]v1beta1.ResourceUsage, len(flavors))
+	for _, flavor := range flavors {
+		usage[flavor.Name] = make(map[corev1.ResourceName]v1beta1.ResourceUsage, len(flavor.Resources))
+		for _, r := range flavor.Resources {
+			usage[flavor.Name][r.Name] = r
+		}
+	}
+	return usage
+}
+
+// usagePercentage returns the percentage of the quota used, or <none> when
+// the quota is zero.
+func usagePercentage(usage, quota resource.Quantity) string {
+	if quota.IsZero() {
+		return noneValue
+	}
+	return fmt.Sprintf("%d%%", int64(math.Round(usage.AsApproximateFloat64()*100/quota.AsApproximateFloat64())))
+}
@@ -0,0 +1,139 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package top
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	kueuev1beta1 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/kueue/v1beta1"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/util"
+)
+
+var (
+	cqLong = templates.LongDesc(`
+		Displays the quota reserved by the workloads in the ClusterQueues,
+		compared to the nominal quota, for each of the flavors and resources,
+		along with the quota borrowed from the cohort and the number of pending workloads.
+	`)
+	cqExample = templates.Examples(`
+		# Show the usage of the quota of all the ClusterQueues
+		kueuectl top clusterqueue
+
+		# Show the usage of the quota of the ClusterQueue
+		kueuectl top clusterqueue my-clusterqueue
+	`)
+)
+
+type ClusterQueueOptions struct {
+	ClusterQueueName string
+	LabelSelector    string
+	NoHeaders        bool
+
+	Client kueuev1beta1.KueueV1beta1Interface
+
+	genericiooptions.IOStreams
+}
+
+func NewClusterQueueOptions(streams genericiooptions.IOStreams) *ClusterQueueOptions {
+	return &ClusterQueueOptions{
+		IOStreams: streams,
+	}
+}
+
+func NewClusterQueueCmd(clientGetter util.ClientGetter, streams genericiooptions.IOStreams) *cobra.Command {
+	o := NewClusterQueueOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:                   "clusterqueue [NAME] [--selector key1=value1] [--no-headers]",
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{"cq"},
+		Short:                 "Display the usage of the quota of the ClusterQueues",
+		Long:                  cqLong,
+		Example:               cqExample,
+		Args:                  cobra.MaximumNArgs(1),
+		ValidArgsFunction:     completion.ClusterQueueNameFunc(clientGetter, nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			err := o.Complete(clientGetter, args)
+			if err != nil {
+				return err
+			}
+			return o.Run(cmd.Context())
+		},
+	}
+
+	addLabelSelectorFlagVar(cmd, &o.LabelSelector)
+	addNoHeadersFlagVar(cmd, &o.NoHeaders)
+
+	return cmd
+}
+
+// Complete completes all the required options
+func (o *ClusterQueueOptions) Complete(clientGetter util.ClientGetter, args []string) error {
+	if len(args) > 0 {
+		o.ClusterQueueName = args[0]
+	}
+
+	clientset, err := clientGetter.KueueClientSet()
+	if err != nil {
+		return err
+	}
+
+	o.Client = clientset.KueueV1beta1()
+
+	return nil
+}
+
+// Run prints the usage of the quota of the cluster queues.
+func (o *ClusterQueueOptions) Run(ctx context.Context) error {
+	list := &v1beta1.ClusterQueueList{}
+	if o.ClusterQueueName != "" {
+		cq, err := o.Client.ClusterQueues().Get(ctx, o.ClusterQueueName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		list.Items = append(list.Items, *cq)
+	} else {
+		var err error
+		list, err = o.Client.ClusterQueues().List(ctx, metav1.ListOptions{LabelSelector: o.LabelSelector})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(list.Items) == 0 {
+		fmt.Fprintln(o.ErrOut, "No resources found")
+		return nil
+	}
+
+	tabWriter := printers.GetNewTabWriter(o.Out)
+	printer := newClusterQueueTablePrinter().WithHeaders(!o.NoHeaders)
+	if err := printer.PrintObj(list, tabWriter); err != nil {
+		return err
+	}
+
+	return tabWriter.Flush()
+}
@@ -0,0 +1,110 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package top
+
+import (
+	"errors"
+	"io"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+type topClusterQueuePrinter struct {
+	printOptions printers.PrintOptions
+}
+
+var _ printers.ResourcePrinter = (*topClusterQueuePrinter)(nil)
+
+func (p *topClusterQueuePrinter) PrintObj(obj runtime.Object, out io.Writer) error {
+	printer := printers.NewTablePrinter(p.printOptions)
+
+	list, ok := obj.(*v1beta1.ClusterQueueList)
+	if !ok {
+		return errors.New("invalid object type")
+	}
+
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "Flavor", Type: "string"},
+			{Name: "Resource", Type: "string"},
+			{Name: "Usage", Type: "string"},
+			{Name: "Quota", Type: "string"},
+			{Name: "Usage(%)", Type: "string"},
+			{Name: "Borrowed", Type: "string"},
+			{Name: "Pending Workloads", Type: "integer"},
+		},
+		Rows: p.printClusterQueueList(list),
+	}
+
+	return printer.PrintObj(table, out)
+}
+
+func (p *topClusterQueuePrinter) WithHeaders(f bool) *topClusterQueuePrinter {
+	p.printOptions.NoHeaders = !f
+	return p
+}
+
+func newClusterQueueTablePrinter() *topClusterQueuePrinter {
+	return &topClusterQueuePrinter{}
+}
+
+func (p *topClusterQueuePrinter) printClusterQueueList(list *v1beta1.ClusterQueueList) []metav1.TableRow {
+	var rows []metav1.TableRow
+	for index := range list.Items {
+		rows = append(rows, p.printClusterQueue(&list.Items[index])...)
+	}
+	return rows
+}
+
+// printClusterQueue returns a row for each of the resources of the flavors
+// of the ClusterQueue, in the order of its resource groups.
+func (p *topClusterQueuePrinter) printClusterQueue(clusterQueue *v1beta1.ClusterQueue) []metav1.TableRow {
+	reservation := flavorsUsage(clusterQueue.Status.FlavorsReservation)
+	var rows []metav1.TableRow
+	for _, rg := range clusterQueue.Spec.ResourceGroups {
+		for _, fq := range rg.Flavors {
+			for _, r := range fq.Resources {
+				usage := reservation[fq.Name][r.Name]
+				rows = append(rows, metav1.TableRow{
+					Object: runtime.RawExtension{Object: clusterQueue},
+					Cells: []any{
+						clusterQueue.Name,
+						fq.Name,
+						r.Name,
+						usage.Total.String(),
+						r.NominalQuota.String(),
+						usagePercentage(usage.Total, r.NominalQuota),
+						usage.Borrowed.String(),
+						clusterQueue.Status.PendingWorkloads,
+					},
+				})
+			}
+		}
+	}
+	if len(rows) == 0 {
+		rows = append(rows, metav1.TableRow{
+			Object: runtime.RawExtension{Object: clusterQueue},
+			Cells:  []any{clusterQueue.Name, noneValue, noneValue, "", "", "", "", clusterQueue.Status.PendingWorkloads},
+		})
+	}
+	return rows
+}
@@ -0,0 +1,138 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package top
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
+	cmdtesting "sigs.k8s.io/kueue/cmd/kueuectl/app/testing"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestClusterQueueRun(t *testing.T) {
+	objs := []runtime.Object{
+		utiltesting.MakeClusterQueue("cq1").
+			Label("key", "value1").
+			ResourceGroup(
+				*utiltesting.MakeFlavorQuotas("default").
+					Resource(corev1.ResourceCPU, "4").
+					Resource(corev1.ResourceMemory, "8Gi").
+					Obj(),
+				*utiltesting.MakeFlavorQuotas("spot").
+					Resource(corev1.ResourceCPU, "0").
+					Resource(corev1.ResourceMemory, "0").
+					Obj(),
+			).
+			PendingWorkloads(2).
+			FlavorsReservation(
+				v1beta1.FlavorUsage{
+					Name: "default",
+					Resources: []v1beta1.ResourceUsage{
+						{Name: corev1.ResourceCPU, Total: resource.MustParse("5"), Borrowed: resource.MustParse("1")},
+						{Name: corev1.ResourceMemory, Total: resource.MustParse("2Gi")},
+					},
+				},
+			).
+			Obj(),
+		utiltesting.MakeClusterQueue("cq2").
+			Label("key", "value2").
+			PendingWorkloads(1).
+			Obj(),
+	}
+
+	testCases := map[string]struct {
+		objs       []runtime.Object
+		args       []string
+		wantOut    string
+		wantOutErr string
+		wantErr    string
+	}{
+		"should print the usage of all the cluster queues": {
+			objs: objs,
+			wantOut: `NAME   FLAVOR    RESOURCE   USAGE   QUOTA   USAGE(%)   BORROWED   PENDING WORKLOADS
+cq1    default   cpu        5       4       125%       1          2
+cq1    default   memory     2Gi     8Gi     25%        0          2
+cq1    spot      cpu        0       0       <none>     0          2
+cq1    spot      memory     0       0       <none>     0          2
+cq2    <none>    <none>                                           1
+`,
+		},
+		"should print the usage of the cluster queue": {
+			objs: objs,
+			args: []string{"cq2"},
+			wantOut: `NAME   FLAVOR   RESOURCE   USAGE   QUOTA   USAGE(%)   BORROWED   PENDING WORKLOADS
+cq2    <none>   <none>                                           1
+`,
+		},
+		"should print the usage of the cluster queues with label selector and without headers": {
+			objs: objs,
+			args: []string{"--selector", "key=value1", "--no-headers"},
+			wantOut: `cq1   default   cpu      5     4     125%     1     2
+cq1   default   memory   2Gi   8Gi   25%      0     2
+cq1   spot      cpu      0     0     <none>   0     2
+cq1   spot      memory   0     0     <none>   0     2
+`,
+		},
+		"should print not found error": {
+			wantOutErr: "No resources found\n",
+		},
+		"should return not found error for the cluster queue": {
+			args:    []string{"cq3"},
+			wantErr: `clusterqueues.kueue.x-k8s.io "cq3" not found`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			streams, _, out, outErr := genericiooptions.NewTestIOStreams()
+
+			tcg := cmdtesting.NewTestClientGetter().WithKueueClientset(fake.NewSimpleClientset(tc.objs...))
+
+			cmd := NewClusterQueueCmd(tcg, streams)
+			cmd.SetArgs(tc.args)
+			cmd.SetOut(out)
+			cmd.SetErr(outErr)
+
+			gotErr := cmd.Execute()
+			var gotErrMsg string
+			if gotErr != nil {
+				gotErrMsg = gotErr.Error()
+			}
+			if diff := cmp.Diff(tc.wantErr, gotErrMsg); diff != "" {
+				t.Errorf("Unexpected error (-want/+got)\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.wantOut, out.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+
+			if tc.wantErr == "" {
+				if diff := cmp.Diff(tc.wantOutErr, outErr.String(), cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package top
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	kueuev1beta1 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/kueue/v1beta1"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/completion"
+	"sigs.k8s.io/kueue/cmd/kueuectl/app/util"
+)
+
+var (
+	lqLong = templates.LongDesc(`
+		Displays the quota reserved by the workloads in the LocalQueues,
+		for each of the flavors and resources, along with the number of
+		pending workloads.
+	`)
+	lqExample = templates.Examples(`
+		# Show the usage of the quota of the LocalQueues in the current namespace
+		kueuectl top localqueue
+
+		# Show the usage of the quota of the LocalQueues in all the namespaces
+		kueuectl top localqueue --all-namespaces
+	`)
+)
+
+type LocalQueueOptions struct {
+	LocalQueueName string
+	Namespace      string
+	AllNamespaces  bool
+	LabelSelector  string
+	NoHeaders      bool
+
+	Client kueuev1beta1.KueueV1beta1Interface
+
+	genericiooptions.IOStreams
+}
+
+func NewLocalQueueOptions(streams genericiooptions.IOStreams) *LocalQueueOptions {
+	return &LocalQueueOptions{
+		IOStreams: streams,
+	}
+}
+
+func NewLocalQueueCmd(clientGetter util.ClientGetter, streams genericiooptions.IOStreams) *cobra.Command {
+	o := NewLocalQueueOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:                   "localqueue [NAME] [--selector key1=value1] [--all-namespaces] [--no-headers]",
+		DisableFlagsInUseLine: true,
+		Aliases:               []string{"lq"},
+		Short:                 "Display the usage of the quota of the LocalQueues",
+		Long:                  lqLong,
+		Example:               lqExample,
+		Args:                  cobra.MaximumNArgs(1),
+		ValidArgsFunction:     completion.LocalQueueNameFunc(clientGetter, nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			err := o.Complete(clientGetter, args)
+			if err != nil {
+				return err
+			}
+			return o.Run(cmd.Context())
+		},
+	}
+
+	util.AddAllNamespacesFlagVar(cmd, &o.AllNamespaces)
+	addLabelSelectorFlagVar(cmd, &o.LabelSelector)
+	addNoHeadersFlagVar(cmd, &o.NoHeaders)
+
+	return cmd
+}
+
+// Complete completes all the required options
+func (o *LocalQueueOptions) Complete(clientGetter util.ClientGetter, args []string) error {
+	if len(args) > 0 {
+		o.LocalQueueName = args[0]
+	}
+
+	var err error
+	o.Namespace, _, err = clientGetter.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := clientGetter.KueueClientSet()
+	if err != nil {
+		return err
+	}
+
+	o.Client = clientset.KueueV1beta1()
+
+	return nil
+}
+
+// Run prints the usage of the quota of the local queues.
+func (o *LocalQueueOptions) Run(ctx context.Context) error {
+	namespace := o.Namespace
+	if o.AllNamespaces {
+		namespace = ""
+	}
+
+	list := &v1beta1.LocalQueueList{}
+	if o.LocalQueueName != "" {
+		lq, err := o.Client.LocalQueues(o.Namespace).Get(ctx, o.LocalQueueName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		list.Items = append(list.Items, *lq)
+	} else {
+		var err error
+		list, err = o.Client.LocalQueues(namespace).List(ctx, metav1.ListOptions{LabelSelector: o.LabelSelector})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(list.Items) == 0 {
+		fmt.Fprintln(o.ErrOut, "No resources found")
+		return nil
+	}
+
+	tabWriter := printers.GetNewTabWriter(o.Out)
+	printer := newLocalQueueTablePrinter().
+		WithNamespace(o.AllNamespaces).
+		WithHeaders(!o.NoHeaders)
+	if err := printer.PrintObj(list, tabWriter); err != nil {
+		return err
+	}
+
+	return tabWriter.Flush()
+}
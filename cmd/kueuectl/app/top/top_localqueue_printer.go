@@ -0,0 +1,107 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package top
+
+import (
+	"errors"
+	"io"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+type topLocalQueuePrinter struct {
+	printOptions printers.PrintOptions
+}
+
+var _ printers.ResourcePrinter = (*topLocalQueuePrinter)(nil)
+
+func (p *topLocalQueuePrinter) PrintObj(obj runtime.Object, out io.Writer) error {
+	printer := printers.NewTablePrinter(p.printOptions)
+
+	list, ok := obj.(*v1beta1.LocalQueueList)
+	if !ok {
+		return errors.New("invalid object type")
+	}
+
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "ClusterQueue", Type: "string"},
+			{Name: "Flavor", Type: "string"},
+			{Name: "Resource", Type: "string"},
+			{Name: "Usage", Type: "string"},
+			{Name: "Pending Workloads", Type: "integer"},
+		},
+		Rows: p.printLocalQueueList(list),
+	}
+
+	return printer.PrintObj(table, out)
+}
+
+func (p *topLocalQueuePrinter) WithNamespace(f bool) *topLocalQueuePrinter {
+	p.printOptions.WithNamespace = f
+	return p
+}
+
+func (p *topLocalQueuePrinter) WithHeaders(f bool) *topLocalQueuePrinter {
+	p.printOptions.NoHeaders = !f
+	return p
+}
+
+func newLocalQueueTablePrinter() *topLocalQueuePrinter {
+	return &topLocalQueuePrinter{}
+}
+
+func (p *topLocalQueuePrinter) printLocalQueueList(list *v1beta1.LocalQueueList) []metav1.TableRow {
+	var rows []metav1.TableRow
+	for index := range list.Items {
+		rows = append(rows, p.printLocalQueue(&list.Items[index])...)
+	}
+	return rows
+}
+
+// printLocalQueue returns a row for each of the resources of the flavors
+// in which the workloads of the LocalQueue reserve quota.
+func (p *topLocalQueuePrinter) printLocalQueue(localQueue *v1beta1.LocalQueue) []metav1.TableRow {
+	var rows []metav1.TableRow
+	for _, flavor := range localQueue.Status.FlavorsReservation {
+		for _, r := range flavor.Resources {
+			rows = append(rows, metav1.TableRow{
+				Object: runtime.RawExtension{Object: localQueue},
+				Cells: []any{
+					localQueue.Name,
+					localQueue.Spec.ClusterQueue,
+					flavor.Name,
+					r.Name,
+					r.Total.String(),
+					localQueue.Status.PendingWorkloads,
+				},
+			})
+		}
+	}
+	if len(rows) == 0 {
+		rows = append(rows, metav1.TableRow{
+			Object: runtime.RawExtension{Object: localQueue},
+			Cells:  []any{localQueue.Name, localQueue.Spec.ClusterQueue, noneValue, noneValue, "", localQueue.Status.PendingWorkloads},
+		})
+	}
+	return rows
+}
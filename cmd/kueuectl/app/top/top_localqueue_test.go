@@ -0,0 +1,127 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package top
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/client-go/clientset/versioned/fake"
+	cmdtesting "sigs.k8s.io/kueue/cmd/kueuectl/app/testing"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestLocalQueueRun(t *testing.T) {
+	objs := []runtime.Object{
+		utiltesting.MakeLocalQueue("lq1", "ns1").
+			ClusterQueue("cq1").
+			PendingWorkloads(2).
+			FlavorsReservation(v1beta1.LocalQueueFlavorUsage{
+				Name: "default",
+				Resources: []v1beta1.LocalQueueResourceUsage{
+					{Name: corev1.ResourceCPU, Total: resource.MustParse("3")},
+					{Name: corev1.ResourceMemory, Total: resource.MustParse("1Gi")},
+				},
+			}).
+			Obj(),
+		utiltesting.MakeLocalQueue("lq2", "ns1").
+			ClusterQueue("cq1").
+			Obj(),
+		utiltesting.MakeLocalQueue("lq3", "ns2").
+			ClusterQueue("cq2").
+			PendingWorkloads(1).
+			FlavorsReservation(v1beta1.LocalQueueFlavorUsage{
+				Name: "spot",
+				Resources: []v1beta1.LocalQueueResourceUsage{
+					{Name: corev1.ResourceCPU, Total: resource.MustParse("500m")},
+				},
+			}).
+			Obj(),
+	}
+
+	testCases := map[string]struct {
+		ns         string
+		objs       []runtime.Object
+		args       []string
+		wantOut    string
+		wantOutErr string
+	}{
+		"should print the usage of the local queues in the namespace": {
+			ns:   "ns1",
+			objs: objs,
+			wantOut: `NAME   CLUSTERQUEUE   FLAVOR    RESOURCE   USAGE   PENDING WORKLOADS
+lq1    cq1            default   cpu        3       2
+lq1    cq1            default   memory     1Gi     2
+lq2    cq1            <none>    <none>             0
+`,
+		},
+		"should print the usage of the local queue": {
+			ns:   "ns1",
+			objs: objs,
+			args: []string{"lq2"},
+			wantOut: `NAME   CLUSTERQUEUE   FLAVOR   RESOURCE   USAGE   PENDING WORKLOADS
+lq2    cq1            <none>   <none>             0
+`,
+		},
+		"should print the usage of the local queues in all the namespaces": {
+			objs: objs,
+			args: []string{"--all-namespaces"},
+			wantOut: `NAMESPACE   NAME   CLUSTERQUEUE   FLAVOR    RESOURCE   USAGE   PENDING WORKLOADS
+ns1         lq1    cq1            default   cpu        3       2
+ns1         lq1    cq1            default   memory     1Gi     2
+ns1         lq2    cq1            <none>    <none>             0
+ns2         lq3    cq2            spot      cpu        500m    1
+`,
+		},
+		"should print not found error": {
+			ns:         "ns3",
+			objs:       objs,
+			wantOutErr: "No resources found\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			streams, _, out, outErr := genericiooptions.NewTestIOStreams()
+
+			tcg := cmdtesting.NewTestClientGetter().WithKueueClientset(fake.NewSimpleClientset(tc.objs...))
+			if len(tc.ns) > 0 {
+				tcg.WithNamespace(tc.ns)
+			}
+
+			cmd := NewLocalQueueCmd(tcg, streams)
+			cmd.SetArgs(tc.args)
+
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.wantOut, out.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tc.wantOutErr, outErr.String()); diff != "" {
+				t.Errorf("Unexpected output (-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
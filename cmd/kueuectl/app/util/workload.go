@@ -0,0 +1,74 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+
+	"sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	kueuev1beta1 "sigs.k8s.io/kueue/client-go/clientset/versioned/typed/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+// SetWorkloadQueueName moves the Workload to the LocalQueue. The queue-name
+// label of the jobs owning the Workload is updated, so that the job reconciler
+// moves the Workload along with them, while a Workload without owners is
+// updated directly.
+func SetWorkloadQueueName(ctx context.Context, client kueuev1beta1.KueueV1beta1Interface, dynamicClient dynamic.Interface,
+	restMapper meta.RESTMapper, wl *v1beta1.Workload, queueName v1beta1.LocalQueueName) error {
+	if len(wl.OwnerReferences) == 0 {
+		patch, err := json.Marshal(map[string]any{
+			"spec": map[string]any{"queueName": queueName},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = client.Workloads(wl.Namespace).Patch(ctx, wl.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"labels": map[string]any{constants.QueueLabel: queueName},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for _, owner := range wl.OwnerReferences {
+		gv, err := schema.ParseGroupVersion(owner.APIVersion)
+		if err != nil {
+			return err
+		}
+		mapping, err := restMapper.RESTMapping(gv.WithKind(owner.Kind).GroupKind(), gv.Version)
+		if err != nil {
+			return err
+		}
+		if _, err := dynamicClient.Resource(mapping.Resource).Namespace(wl.Namespace).
+			Patch(ctx, owner.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
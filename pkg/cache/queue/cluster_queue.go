@@ -20,6 +20,7 @@ import (
 	"context"
 	"slices"
 	"sync"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -28,12 +29,14 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	config "sigs.k8s.io/kueue/apis/config/v1beta1"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/cache/hierarchy"
+	"sigs.k8s.io/kueue/pkg/features"
 	afs "sigs.k8s.io/kueue/pkg/util/admissionfairsharing"
 	"sigs.k8s.io/kueue/pkg/util/heap"
 	utilmaps "sigs.k8s.io/kueue/pkg/util/maps"
@@ -50,6 +53,9 @@ const (
 	RequeueReasonNamespaceMismatch     RequeueReason = "NamespaceMismatch"
 	RequeueReasonGeneric               RequeueReason = ""
 	RequeueReasonPendingPreemption     RequeueReason = "PendingPreemption"
+	// RequeueReasonDeferred means that the workload wasn't evaluated in the
+	// scheduling cycle, because of the limits of the cycle.
+	RequeueReasonDeferred RequeueReason = "Deferred"
 )
 
 var (
@@ -82,6 +88,13 @@ type ClusterQueue struct {
 
 	queueingStrategy kueue.QueueingStrategy
 
+	// headOfLineTimeout is the maximum duration for which an inadmissible
+	// workload is requeued immediately in a StrictFIFO ClusterQueue.
+	headOfLineTimeout *time.Duration
+	// blockedSince holds, for the workloads which couldn't be admitted at
+	// the head of a StrictFIFO ClusterQueue, when they first failed.
+	blockedSince map[workload.Reference]time.Time
+
 	rwm sync.RWMutex
 
 	clock clock.Clock
@@ -115,6 +128,7 @@ func newClusterQueueImpl(ctx context.Context, client client.Client, wo workload.
 	return &ClusterQueue{
 		heap:                      *heap.New(workloadKey, lessFunc),
 		inadmissibleWorkloads:     make(map[workload.Reference]*workload.Info),
+		blockedSince:              make(map[workload.Reference]time.Time),
 		queueInadmissibleCycle:    -1,
 		lessFunc:                  lessFunc,
 		rwm:                       sync.RWMutex{},
@@ -130,6 +144,10 @@ func (c *ClusterQueue) Update(apiCQ *kueue.ClusterQueue) error {
 	defer c.rwm.Unlock()
 	c.name = kueue.ClusterQueueReference(apiCQ.Name)
 	c.queueingStrategy = apiCQ.Spec.QueueingStrategy
+	c.headOfLineTimeout = nil
+	if features.Enabled(features.StrictFIFOHeadOfLineTimeout) && apiCQ.Spec.HeadOfLineTimeoutSeconds != nil {
+		c.headOfLineTimeout = ptr.To(time.Duration(*apiCQ.Spec.HeadOfLineTimeoutSeconds) * time.Second)
+	}
 	nsSelector, err := metav1.LabelSelectorAsSelector(apiCQ.Spec.NamespaceSelector)
 	if err != nil {
 		return err
@@ -147,6 +165,10 @@ func (c *ClusterQueue) AddFromLocalQueue(q *LocalQueue) bool {
 	defer c.rwm.Unlock()
 	added := false
 	for _, info := range q.items {
+		if workload.IsHeld(info.Obj) {
+			c.inadmissibleWorkloads[workload.Key(info.Obj)] = info
+			continue
+		}
 		if c.heap.PushIfNotPresent(info) {
 			added = true
 		}
@@ -178,6 +200,13 @@ func (c *ClusterQueue) PushOrUpdate(wInfo *workload.Info) {
 		// otherwise move or update in place in the queue.
 		delete(c.inadmissibleWorkloads, key)
 	}
+	// The held workloads are kept with the inadmissible workloads, so that
+	// they keep their position in the queue while being skipped.
+	if workload.IsHeld(wInfo.Obj) {
+		c.heap.Delete(key)
+		c.inadmissibleWorkloads[key] = wInfo
+		return
+	}
 	if c.heap.GetByKey(key) == nil && !c.backoffWaitingTimeExpired(wInfo) {
 		c.inadmissibleWorkloads[key] = wInfo
 		return
@@ -221,6 +250,7 @@ func (c *ClusterQueue) Delete(w *kueue.Workload) {
 func (c *ClusterQueue) delete(w *kueue.Workload) {
 	key := workload.Key(w)
 	delete(c.inadmissibleWorkloads, key)
+	delete(c.blockedSince, key)
 	c.heap.Delete(key)
 	c.forgetInflightByKey(key)
 }
@@ -251,7 +281,7 @@ func (c *ClusterQueue) requeueIfNotPresent(wInfo *workload.Info, immediate bool)
 	defer c.rwm.Unlock()
 	key := workload.Key(wInfo.Obj)
 	c.forgetInflightByKey(key)
-	if c.backoffWaitingTimeExpired(wInfo) &&
+	if !workload.IsHeld(wInfo.Obj) && c.backoffWaitingTimeExpired(wInfo) &&
 		(immediate || c.queueInadmissibleCycle >= c.popCycle || wInfo.LastAssignment.PendingFlavors()) {
 		// If the workload was inadmissible, move it back into the queue.
 		inadmissibleWl := c.inadmissibleWorkloads[key]
@@ -295,8 +325,8 @@ func (c *ClusterQueue) QueueInadmissibleWorkloads(ctx context.Context, client cl
 	moved := false
 	for key, wInfo := range c.inadmissibleWorkloads {
 		ns := corev1.Namespace{}
-		err := client.Get(ctx, types.NamespacedName{Name: wInfo.Obj.Namespace}, &ns)
-		if err != nil || !c.namespaceSelector.Matches(labels.Set(ns.Labels)) || !c.backoffWaitingTimeExpired(wInfo) {
+		err := client.Get(ctx, types.NamespacedName{Name: utilqueue.NamespaceForWorkload(wInfo.Obj)}, &ns)
+		if err != nil || !c.namespaceSelector.Matches(labels.Set(ns.Labels)) || !c.backoffWaitingTimeExpired(wInfo) || workload.IsHeld(wInfo.Obj) {
 			inadmissibleWorkloads[key] = wInfo
 		} else {
 			moved = c.heap.PushIfNotPresent(wInfo) || moved
@@ -454,9 +484,52 @@ func (c *ClusterQueue) Active() bool {
 // Returns true if the workload was inserted.
 func (c *ClusterQueue) RequeueIfNotPresent(wInfo *workload.Info, reason RequeueReason) bool {
 	if c.queueingStrategy == kueue.StrictFIFO {
-		return c.requeueIfNotPresent(wInfo, reason != RequeueReasonNamespaceMismatch)
+		immediate := reason != RequeueReasonNamespaceMismatch
+		if immediate && c.headOfLineTimeoutExceeded(wInfo, reason) {
+			// Park the workload, so that it no longer blocks the queue.
+			immediate = false
+		}
+		return c.requeueIfNotPresent(wInfo, immediate)
+	}
+	return c.requeueIfNotPresent(wInfo, reason == RequeueReasonFailedAfterNomination || reason == RequeueReasonPendingPreemption || reason == RequeueReasonDeferred)
+}
+
+// headOfLineTimeoutExceeded returns true if the workload has been blocking
+// the head of the queue for longer than the headOfLineTimeout.
+func (c *ClusterQueue) headOfLineTimeoutExceeded(wInfo *workload.Info, reason RequeueReason) bool {
+	c.rwm.Lock()
+	defer c.rwm.Unlock()
+	if c.headOfLineTimeout == nil {
+		return false
+	}
+	key := workload.Key(wInfo.Obj)
+	if reason == RequeueReasonDeferred {
+		// The workload wasn't evaluated, so it keeps its blocking time.
+		return false
+	}
+	if reason != RequeueReasonGeneric {
+		// The workload was nominated, so it isn't blocked.
+		delete(c.blockedSince, key)
+		return false
+	}
+	since, found := c.blockedSince[key]
+	if !found {
+		c.blockedSince[key] = c.clock.Now()
+		return false
+	}
+	return c.clock.Since(since) >= *c.headOfLineTimeout
+}
+
+// IsParked returns true if the workload was parked after blocking the head
+// of the queue for longer than the headOfLineTimeout.
+func (c *ClusterQueue) IsParked(key workload.Reference) bool {
+	c.rwm.RLock()
+	defer c.rwm.RUnlock()
+	if c.headOfLineTimeout == nil || c.inadmissibleWorkloads[key] == nil {
+		return false
 	}
-	return c.requeueIfNotPresent(wInfo, reason == RequeueReasonFailedAfterNomination || reason == RequeueReasonPendingPreemption)
+	since, found := c.blockedSince[key]
+	return found && c.clock.Since(since) >= *c.headOfLineTimeout
 }
 
 // queueOrderingFunc returns a function used by the clusterQueue heap algorithm
@@ -32,6 +32,7 @@ import (
 
 	config "sigs.k8s.io/kueue/apis/config/v1beta1"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/features"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	"sigs.k8s.io/kueue/pkg/workload"
@@ -332,6 +333,19 @@ func TestClusterQueueImpl(t *testing.T) {
 	updatedWorkloads[1] = workloads[1].DeepCopy()
 	updatedWorkloads[1].Spec.QueueName = "q1"
 
+	heldWorkload := utiltesting.MakeWorkload("w5-held", "ns1").Queue("q1").Held(true).Obj()
+	releasedWorkload := heldWorkload.DeepCopy()
+	releasedWorkload.Spec.Held = ptr.To(false)
+
+	billedToMatchingNamespace := utiltesting.MakeWorkload("w6-billed", "ns3").
+		Queue("q1").
+		Annotation(constants.BillingNamespaceAnnotation, "ns1").
+		Obj()
+	billedToNotMatchingNamespace := utiltesting.MakeWorkload("w7-billed", "ns1").
+		Queue("q3").
+		Annotation(constants.BillingNamespaceAnnotation, "ns3").
+		Obj()
+
 	tests := map[string]struct {
 		workloadsToAdd                    []*kueue.Workload
 		inadmissibleWorkloadsToRequeue    []*workload.Info
@@ -379,6 +393,13 @@ func TestClusterQueueImpl(t *testing.T) {
 			wantActiveWorkloads:            []workload.Reference{workload.Key(workloads[0])},
 			wantPending:                    2,
 		},
+		"namespace selector matches the billing namespace of the inadmissible workloads": {
+			inadmissibleWorkloadsToRequeue:    []*workload.Info{workload.NewInfo(billedToMatchingNamespace), workload.NewInfo(billedToNotMatchingNamespace)},
+			queueInadmissibleWorkloads:        true,
+			wantActiveWorkloads:               []workload.Reference{workload.Key(billedToMatchingNamespace)},
+			wantPending:                       2,
+			wantInadmissibleWorkloadsRequeued: true,
+		},
 		"update inadmissible workload": {
 			workloadsToAdd:                 []*kueue.Workload{workloads[0]},
 			inadmissibleWorkloadsToRequeue: []*workload.Info{workload.NewInfo(workloads[1])},
@@ -415,10 +436,30 @@ func TestClusterQueueImpl(t *testing.T) {
 			wantActiveWorkloads: []workload.Reference{"/w"},
 			wantPending:         1,
 		},
+		"held workload is kept pending but not active": {
+			workloadsToAdd:      []*kueue.Workload{workloads[0], heldWorkload},
+			wantActiveWorkloads: []workload.Reference{workload.Key(workloads[0])},
+			wantPending:         2,
+		},
+		"held workload is not re-queued when flushing inadmissible workloads": {
+			workloadsToAdd:                 []*kueue.Workload{workloads[0]},
+			inadmissibleWorkloadsToRequeue: []*workload.Info{workload.NewInfo(heldWorkload)},
+			queueInadmissibleWorkloads:     true,
+			wantActiveWorkloads:            []workload.Reference{workload.Key(workloads[0])},
+			wantPending:                    2,
+		},
+		"released workload becomes active": {
+			workloadsToAdd:      []*kueue.Workload{heldWorkload},
+			workloadsToUpdate:   []*kueue.Workload{releasedWorkload},
+			wantActiveWorkloads: []workload.Reference{workload.Key(heldWorkload)},
+			wantPending:         1,
+		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkloadHold, true)
+			features.SetFeatureGateDuringTest(t, features.CrossNamespaceBilling, true)
 			ctx, _ := utiltesting.ContextWithLog(t)
 			cq := newClusterQueueImpl(ctx, nil, defaultOrdering, fakeClock, nil, false, nil)
 			err := cq.Update(utiltesting.MakeClusterQueue("cq").
@@ -581,6 +622,10 @@ func TestBestEffortFIFORequeueIfNotPresent(t *testing.T) {
 			reason:           RequeueReasonNamespaceMismatch,
 			wantInadmissible: true,
 		},
+		"deferred by the limits of the scheduling cycle": {
+			reason:           RequeueReasonDeferred,
+			wantInadmissible: false,
+		},
 		"didn't fit and no pending flavors": {
 			reason: RequeueReasonGeneric,
 			lastAssignment: &workload.AssignmentClusterQueueState{
@@ -920,6 +965,71 @@ func TestStrictFIFORequeueIfNotPresent(t *testing.T) {
 	}
 }
 
+func TestStrictFIFOHeadOfLineTimeout(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.StrictFIFOHeadOfLineTimeout, true)
+	ctx, _ := utiltesting.ContextWithLog(t)
+	now := time.Now()
+	fakeClock := testingclock.NewFakeClock(now)
+	cq := newClusterQueueImpl(ctx, nil, defaultOrdering, fakeClock, nil, false, nil)
+	if err := cq.Update(utiltesting.MakeClusterQueue("cq").
+		QueueingStrategy(kueue.StrictFIFO).
+		HeadOfLineTimeoutSeconds(600).
+		Obj()); err != nil {
+		t.Fatalf("Failed to update ClusterQueue: %v", err)
+	}
+	cq.namespaceSelector = labels.Everything()
+
+	blocking := utiltesting.MakeWorkload("blocking", defaultNamespace).Creation(now).Obj()
+	newer := utiltesting.MakeWorkload("newer", defaultNamespace).Creation(now.Add(time.Second)).Obj()
+	cl := utiltesting.NewFakeClient(blocking, newer, utiltesting.MakeNamespace(defaultNamespace))
+	cq.PushOrUpdate(workload.NewInfo(blocking))
+	cq.PushOrUpdate(workload.NewInfo(newer))
+
+	// The head is requeued immediately while it's within the timeout.
+	for _, elapsed := range []time.Duration{0, 5 * time.Minute} {
+		fakeClock.SetTime(now.Add(elapsed))
+		head := cq.Pop()
+		if diff := cmp.Diff(workload.Key(blocking), workload.Key(head.Obj)); diff != "" {
+			t.Fatalf("Unexpected head after %v (-want,+got):\n%s", elapsed, diff)
+		}
+		cq.RequeueIfNotPresent(head, RequeueReasonGeneric)
+		if cq.IsParked(workload.Key(blocking)) {
+			t.Errorf("Workload parked after %v", elapsed)
+		}
+	}
+
+	// Once the timeout is exceeded, the head is parked.
+	fakeClock.SetTime(now.Add(11 * time.Minute))
+	head := cq.Pop()
+	cq.RequeueIfNotPresent(head, RequeueReasonGeneric)
+	if !cq.IsParked(workload.Key(blocking)) {
+		t.Error("Workload not parked after exceeding the timeout")
+	}
+	if head := cq.Pop(); head == nil || workload.Key(head.Obj) != workload.Key(newer) {
+		t.Errorf("Expected the newer workload to become the head, got %v", head)
+	}
+
+	// The parked workload is retried after cluster events, and parked
+	// again if it's still blocked.
+	cq.QueueInadmissibleWorkloads(ctx, cl)
+	if cq.IsParked(workload.Key(blocking)) {
+		t.Error("Workload still parked after queueing inadmissible workloads")
+	}
+	head = cq.Pop()
+	if diff := cmp.Diff(workload.Key(blocking), workload.Key(head.Obj)); diff != "" {
+		t.Fatalf("Unexpected head after cluster events (-want,+got):\n%s", diff)
+	}
+	cq.RequeueIfNotPresent(head, RequeueReasonGeneric)
+	if !cq.IsParked(workload.Key(blocking)) {
+		t.Error("Workload not parked again")
+	}
+
+	cq.Delete(blocking)
+	if _, found := cq.blockedSince[workload.Key(blocking)]; found {
+		t.Error("Workload still tracked as blocked after deletion")
+	}
+}
+
 func TestFsAdmission(t *testing.T) {
 	wlCmpOpts := []cmp.Option{
 		cmpopts.EquateEmpty(),
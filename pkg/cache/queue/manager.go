@@ -320,8 +320,15 @@ func (m *Manager) AddLocalQueue(ctx context.Context, q *kueue.LocalQueue) error
 	if err := m.client.List(ctx, &workloads, client.MatchingFields{utilindexer.WorkloadQueueKey: q.Name}, client.InNamespace(q.Namespace)); err != nil {
 		return fmt.Errorf("listing workloads that match the queue: %w", err)
 	}
+	if features.Enabled(features.CrossNamespaceBilling) {
+		var billedWorkloads kueue.WorkloadList
+		if err := m.client.List(ctx, &billedWorkloads, client.MatchingFields{utilindexer.WorkloadBillingQueueKey: string(key)}); err != nil {
+			return fmt.Errorf("listing workloads billed to the queue: %w", err)
+		}
+		workloads.Items = append(workloads.Items, billedWorkloads.Items...)
+	}
 	for _, w := range workloads.Items {
-		if !workload.IsActive(&w) || workload.HasQuotaReservation(&w) {
+		if queue.KeyFromWorkload(&w) != key || !workload.IsActive(&w) || workload.HasQuotaReservation(&w) {
 			continue
 		}
 
@@ -503,6 +510,19 @@ func (m *Manager) RequeueWorkload(ctx context.Context, info *workload.Info, reas
 	return added
 }
 
+// IsParked returns true if the workload was parked after blocking the head
+// of its StrictFIFO ClusterQueue for longer than the headOfLineTimeoutSeconds.
+func (m *Manager) IsParked(w *kueue.Workload) bool {
+	m.RLock()
+	defer m.RUnlock()
+	q := m.localQueues[queue.KeyFromWorkload(w)]
+	if q == nil {
+		return false
+	}
+	cq := m.hm.ClusterQueue(q.ClusterQueue)
+	return cq != nil && cq.IsParked(workload.Key(w))
+}
+
 func (m *Manager) DeleteWorkload(w *kueue.Workload) {
 	m.Lock()
 	defer m.Unlock()
@@ -748,6 +768,19 @@ func (m *Manager) PendingWorkloadsInfo(cqName kueue.ClusterQueueReference) []*wo
 	return cq.Snapshot()
 }
 
+// PendingWorkload returns the pending workload with the given key and whether
+// it's found in any LocalQueue.
+func (m *Manager) PendingWorkload(key workload.Reference) (*workload.Info, bool) {
+	m.RLock()
+	defer m.RUnlock()
+	for _, lq := range m.localQueues {
+		if wlInfo, ok := lq.items[key]; ok {
+			return wlInfo, true
+		}
+	}
+	return nil, false
+}
+
 // ClusterQueueFromLocalQueue returns ClusterQueue name and whether it's found,
 // given a QueueKey(namespace/localQueueName) as the parameter
 func (m *Manager) ClusterQueueFromLocalQueue(localQueueKey queue.LocalQueueReference) (kueue.ClusterQueueReference, bool) {
@@ -33,6 +33,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/queue"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	"sigs.k8s.io/kueue/pkg/workload"
@@ -69,6 +71,30 @@ func TestAddLocalQueueOrphans(t *testing.T) {
 	}
 }
 
+func TestAddLocalQueueOrphansWithBillingNamespace(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.CrossNamespaceBilling, true)
+	kClient := utiltesting.NewFakeClient(
+		utiltesting.MakeWorkload("a", "earth").Queue("foo").Obj(),
+		utiltesting.MakeWorkload("b", "earth").Queue("foo").
+			Annotation(constants.BillingNamespaceAnnotation, "moon").Obj(),
+		utiltesting.MakeWorkload("c", "mars").Queue("foo").
+			Annotation(constants.BillingNamespaceAnnotation, "earth").Obj(),
+		utiltesting.MakeWorkload("d", "mars").Queue("bar").
+			Annotation(constants.BillingNamespaceAnnotation, "earth").Obj(),
+	)
+	manager := NewManager(kClient, nil)
+	q := utiltesting.MakeLocalQueue("foo", "earth").Obj()
+	ctx, _ := utiltesting.ContextWithLog(t)
+	if err := manager.AddLocalQueue(ctx, q); err != nil {
+		t.Fatalf("Failed adding queue: %v", err)
+	}
+	qImpl := manager.localQueues[queue.Key(q)]
+	workloadNames := workloadNamesFromLQ(qImpl)
+	if diff := cmp.Diff(sets.New[workload.Reference]("earth/a", "mars/c"), workloadNames); diff != "" {
+		t.Errorf("Unexpected items in queue foo (-want,+got):\n%s", diff)
+	}
+}
+
 // TestAddClusterQueueOrphans verifies that when a ClusterQueue is recreated,
 // it adopts the existing workloads.
 func TestAddClusterQueueOrphans(t *testing.T) {
@@ -21,16 +21,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -42,6 +47,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/metrics"
 	"sigs.k8s.io/kueue/pkg/resources"
 	"sigs.k8s.io/kueue/pkg/util/queue"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 	"sigs.k8s.io/kueue/pkg/workload"
 )
 
@@ -90,12 +96,28 @@ func WithFairSharing(enabled bool) Option {
 	}
 }
 
+// WithFairSharingUsageHalfLife sets the half-life of the usage considered
+// for Fair Sharing.
+func WithFairSharingUsageHalfLife(halfLife time.Duration) Option {
+	return func(c *Cache) {
+		c.fairSharingUsageHalfLife = halfLife
+	}
+}
+
 func WithAdmissionFairSharing(afs *config.AdmissionFairSharing) Option {
 	return func(c *Cache) {
 		c.admissionFairSharing = afs
 	}
 }
 
+// WithHostScorer sets the scorer of the host-local constraints of the pods
+// placed by TAS.
+func WithHostScorer(scorer utiltas.HostScorer) Option {
+	return func(c *Cache) {
+		c.tasCache.hostScorer = scorer
+	}
+}
+
 // Cache keeps track of the Workloads that got admitted through ClusterQueues.
 type Cache struct {
 	sync.RWMutex
@@ -106,13 +128,22 @@ type Cache struct {
 	resourceFlavors      map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor
 	podsReadyTracking    bool
 	admissionChecks      map[kueue.AdmissionCheckReference]AdmissionCheck
+	reservations         map[string]*kueue.Reservation
 	workloadInfoOptions  []workload.InfoOption
 	fairSharingEnabled   bool
 	admissionFairSharing *config.AdmissionFairSharing
 
+	fairSharingUsageHalfLife time.Duration
+	clock                    clock.Clock
+
 	hm hierarchy.Manager[*clusterQueue, *cohort]
 
 	tasCache tasCache
+
+	// flavorCosts holds the costs of the ResourceFlavors reported by the
+	// flavor cost provider. The map is replaced, never modified, so that
+	// it can be shared by the snapshots.
+	flavorCosts map[kueue.ResourceFlavorReference]resource.Quantity
 }
 
 func New(client client.Client, options ...Option) *Cache {
@@ -121,8 +152,10 @@ func New(client client.Client, options ...Option) *Cache {
 		assumedWorkloads: make(map[workload.Reference]kueue.ClusterQueueReference),
 		resourceFlavors:  make(map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor),
 		admissionChecks:  make(map[kueue.AdmissionCheckReference]AdmissionCheck),
+		reservations:     make(map[string]*kueue.Reservation),
 		hm:               hierarchy.NewManager(newCohort),
 		tasCache:         NewTASCache(client),
+		clock:            clock.RealClock{},
 	}
 	for _, option := range options {
 		option(cache)
@@ -146,6 +179,9 @@ func (c *Cache) newClusterQueue(log logr.Logger, cq *kueue.ClusterQueue) (*clust
 
 		workloadsNotAccountedForTAS: sets.New[workload.Reference](),
 	}
+	if features.Enabled(features.FairSharingUsageDecay) && c.fairSharingUsageHalfLife > 0 {
+		cqImpl.usageDecay = newUsageDecay(c.fairSharingUsageHalfLife, c.clock)
+	}
 	c.hm.AddClusterQueue(cqImpl)
 	c.hm.UpdateClusterQueueEdge(kueue.ClusterQueueReference(cq.Name), cq.Spec.Cohort)
 	if err := cqImpl.updateClusterQueue(log, cq, c.resourceFlavors, c.admissionChecks, nil); err != nil {
@@ -246,6 +282,12 @@ func (c *Cache) TASCache() *tasCache {
 	return &c.tasCache
 }
 
+// HostScorer returns the scorer of the host-local constraints of the pods
+// placed by TAS, or nil if there is none.
+func (c *Cache) HostScorer() utiltas.HostScorer {
+	return c.tasCache.hostScorer
+}
+
 func (c *Cache) AddOrUpdateResourceFlavor(log logr.Logger, rf *kueue.ResourceFlavor) sets.Set[kueue.ClusterQueueReference] {
 	c.Lock()
 	defer c.Unlock()
@@ -260,12 +302,27 @@ func (c *Cache) DeleteResourceFlavor(log logr.Logger, rf *kueue.ResourceFlavor)
 	c.Lock()
 	defer c.Unlock()
 	delete(c.resourceFlavors, kueue.ResourceFlavorReference(rf.Name))
+	if features.Enabled(features.FlavorPhysicalCapacity) {
+		metrics.ClearResourceFlavorPhysicalHeadroom(kueue.ResourceFlavorReference(rf.Name))
+	}
 	if handleTASFlavor(rf) {
 		c.tasCache.DeleteFlavor(kueue.ResourceFlavorReference(rf.Name))
 	}
 	return c.updateClusterQueues(log)
 }
 
+// SetFlavorCosts replaces the costs of the ResourceFlavors used to order the
+// flavors of the ClusterQueues. It returns whether the costs changed.
+func (c *Cache) SetFlavorCosts(costs map[kueue.ResourceFlavorReference]resource.Quantity) bool {
+	c.Lock()
+	defer c.Unlock()
+	if maps.EqualFunc(c.flavorCosts, costs, func(a, b resource.Quantity) bool { return a.Cmp(b) == 0 }) {
+		return false
+	}
+	c.flavorCosts = maps.Clone(costs)
+	return true
+}
+
 func (c *Cache) AddOrUpdateTopology(log logr.Logger, topology *kueue.Topology) sets.Set[kueue.ClusterQueueReference] {
 	c.Lock()
 	defer c.Unlock()
@@ -344,6 +401,18 @@ func (c *Cache) ClusterQueueReadiness(name kueue.ClusterQueueReference) (metav1.
 	return metav1.ConditionFalse, reason, msg
 }
 
+// ClusterQueueProblems returns all the problems making the ClusterQueue inactive
+// or partially functional.
+func (c *Cache) ClusterQueueProblems(name kueue.ClusterQueueReference) []kueue.ClusterQueueProblem {
+	c.RLock()
+	defer c.RUnlock()
+	cq := c.hm.ClusterQueue(name)
+	if cq == nil {
+		return nil
+	}
+	return cq.problems()
+}
+
 func (c *Cache) clusterQueueInStatus(name kueue.ClusterQueueReference, status metrics.ClusterQueueStatus) bool {
 	c.RLock()
 	defer c.RUnlock()
@@ -687,6 +756,10 @@ type ClusterQueueUsageStats struct {
 	AdmittedResources  []kueue.FlavorUsage
 	AdmittedWorkloads  int
 	WeightedShare      int64
+	DecayedUsage       corev1.ResourceList
+	// LentResources is the quota of the ClusterQueue used by the other
+	// ClusterQueues of its cohort tree.
+	LentResources resources.FlavorResourceQuantities
 }
 
 // Usage reports the reserved and admitted resources and number of workloads holding them in the ClusterQueue.
@@ -704,16 +777,138 @@ func (c *Cache) Usage(cqObj *kueue.ClusterQueue) (*ClusterQueueUsageStats, error
 		ReservingWorkloads: len(cq.Workloads),
 		AdmittedResources:  getUsage(cq.AdmittedUsage, cq),
 		AdmittedWorkloads:  cq.admittedWorkloadsCount,
+		LentResources:      lentResources(cq),
 	}
 
 	if c.fairSharingEnabled {
-		drs := dominantResourceShare(cq, nil)
+		var decayedUsage resources.FlavorResourceQuantities
+		if cq.usageDecay != nil {
+			decayedUsage = cq.usageDecay.at(c.clock.Now(), cq.resourceNode.Usage)
+			stats.DecayedUsage = decayedUsageList(decayedUsage)
+		}
+		drs := dominantResourceShareWithDecayedUsage(cq, nil, decayedUsage)
 		weightedShare, _ := drs.roundedWeightedShare()
 		stats.WeightedShare = weightedShare
 	}
 	return stats, nil
 }
 
+// UsageByLabel returns the usage of the workloads reserving quota in the
+// ClusterQueue, per value of their label with the given key. The workloads
+// without the label are accounted under the empty value.
+func (c *Cache) UsageByLabel(name kueue.ClusterQueueReference, key string) (map[string]resources.FlavorResourceQuantities, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	cq := c.hm.ClusterQueue(name)
+	if cq == nil {
+		return nil, ErrCqNotFound
+	}
+
+	usage := make(map[string]resources.FlavorResourceQuantities)
+	for _, wi := range cq.Workloads {
+		value := wi.Obj.Labels[key]
+		if usage[value] == nil {
+			usage[value] = make(resources.FlavorResourceQuantities)
+		}
+		for fr, q := range wi.FlavorResourceUsage() {
+			usage[value][fr] += q
+		}
+	}
+	return usage, nil
+}
+
+// WorkloadWithQuota returns the workload with the given key if it has quota
+// reserved in a ClusterQueue.
+func (c *Cache) WorkloadWithQuota(key workload.Reference) (*workload.Info, bool) {
+	c.RLock()
+	defer c.RUnlock()
+
+	for _, cq := range c.hm.ClusterQueues() {
+		if wi := cq.Workloads[key]; wi != nil {
+			return wi, true
+		}
+	}
+	return nil, false
+}
+
+// DispatchedWorkloads returns the workloads with quota reserved which are
+// dispatched by MultiKueue to the worker cluster, sorted by key.
+func (c *Cache) DispatchedWorkloads(clusterName string) []*workload.Info {
+	c.RLock()
+	defer c.RUnlock()
+
+	var result []*workload.Info
+	for _, cq := range c.hm.ClusterQueues() {
+		for _, wi := range cq.Workloads {
+			if ptr.Deref(wi.Obj.Status.ClusterName, "") == clusterName {
+				result = append(result, wi)
+			}
+		}
+	}
+	slices.SortFunc(result, func(a, b *workload.Info) int {
+		return cmp.Compare(workload.Key(a.Obj), workload.Key(b.Obj))
+	})
+	return result
+}
+
+// FlavorResourceQuota is the quota of a ClusterQueue for a flavor and resource.
+type FlavorResourceQuota struct {
+	resources.FlavorResource
+	Nominal int64
+	Usage   int64
+	// Available is the quota available before preempting any workload,
+	// including the quota that can be borrowed from the cohort.
+	Available int64
+	// Borrowing is true when the usage exceeds the nominal quota and the
+	// ClusterQueue belongs to a cohort.
+	Borrowing bool
+}
+
+// ClusterQueueQuotas returns the cohort of the ClusterQueue and its quota for
+// the flavors of the resource groups covering the given resources. The quotas
+// are ordered by resource, and then by the order of the flavors in the groups.
+func (c *Cache) ClusterQueueQuotas(name kueue.ClusterQueueReference, rNames []corev1.ResourceName) (kueue.CohortReference, []FlavorResourceQuota, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	cq := c.hm.ClusterQueue(name)
+	if cq == nil {
+		return "", nil, ErrCqNotFound
+	}
+	var cohort kueue.CohortReference
+	hasCycle := false
+	if cq.HasParent() {
+		cohort = cq.Parent().Name
+		hasCycle = hierarchy.HasCycle(cq.Parent())
+	}
+
+	var quotas []FlavorResourceQuota
+	for _, rName := range rNames {
+		for _, rg := range cq.ResourceGroups {
+			if !rg.CoveredResources.Has(rName) {
+				continue
+			}
+			for _, fName := range rg.Flavors {
+				fr := resources.FlavorResource{Flavor: fName, Resource: rName}
+				quota := FlavorResourceQuota{
+					FlavorResource: fr,
+					Nominal:        cq.resourceNode.Quotas[fr].Nominal,
+					Usage:          cq.resourceNode.Usage[fr],
+				}
+				if hasCycle {
+					quota.Available = LocalAvailable(cq, fr)
+				} else {
+					quota.Available = max(0, available(cq, fr))
+				}
+				quota.Borrowing = cq.HasParent() && quota.Usage > quota.Nominal
+				quotas = append(quotas, quota)
+			}
+		}
+	}
+	return cohort, quotas, nil
+}
+
 type CohortUsageStats struct {
 	WeightedShare int64
 }
@@ -800,6 +995,56 @@ func getUsage(frq resources.FlavorResourceQuantities, cq *clusterQueue) []kueue.
 	return usage
 }
 
+// lentResources returns the quota of the ClusterQueue used by the other
+// ClusterQueues of its cohort tree. As the borrowed quota can't be tracked
+// back to its lenders, the quota borrowed in the tree is attributed to the
+// lenders in proportion to their unused lendable quota. The quota of the
+// cohorts is lent before the quota of the ClusterQueues, in the same way.
+func lentResources(cq *clusterQueue) resources.FlavorResourceQuantities {
+	if !cq.HasParent() || hierarchy.HasCycle(cq.Parent()) {
+		return nil
+	}
+	borrowed := make(resources.FlavorResourceQuantities)
+	cohortQuota := make(resources.FlavorResourceQuantities)
+	unused := make(resources.FlavorResourceQuantities)
+	var visit func(*cohort)
+	visit = func(c *cohort) {
+		for fr, quota := range c.resourceNode.Quotas {
+			cohortQuota[fr] += quota.Nominal
+		}
+		for _, child := range c.ChildCQs() {
+			for fr, quota := range child.resourceNode.Quotas {
+				borrowed[fr] += max(0, child.resourceNode.Usage[fr]-quota.Nominal)
+				unused[fr] += unusedLendableQuota(child, fr)
+			}
+		}
+		for _, child := range c.ChildCohorts() {
+			visit(child)
+		}
+	}
+	visit(cq.Parent().getRootUnsafe())
+
+	lent := make(resources.FlavorResourceQuantities, len(cq.resourceNode.Quotas))
+	for fr := range cq.resourceNode.Quotas {
+		own := unusedLendableQuota(cq, fr)
+		lentByCQs := max(0, borrowed[fr]-cohortQuota[fr])
+		if own == 0 || lentByCQs == 0 {
+			lent[fr] = 0
+			continue
+		}
+		lent[fr] = min(own, int64(float64(lentByCQs)*float64(own)/float64(unused[fr])))
+	}
+	return lent
+}
+
+// unusedLendableQuota returns the quota of the ClusterQueue that it can lend
+// and that is not used by its own workloads.
+func unusedLendableQuota(cq *clusterQueue, fr resources.FlavorResource) int64 {
+	guaranteed := cq.resourceNode.localQuota(fr)
+	lendable := cq.resourceNode.Quotas[fr].Nominal - guaranteed
+	return max(0, lendable-max(0, cq.resourceNode.Usage[fr]-guaranteed))
+}
+
 type LocalQueueUsageStats struct {
 	ReservedResources  []kueue.LocalQueueFlavorUsage
 	ReservingWorkloads int
@@ -39,6 +39,7 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/cache/hierarchy"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/resources"
 	"sigs.k8s.io/kueue/pkg/util/queue"
@@ -2096,6 +2097,141 @@ func TestClusterQueueUsage(t *testing.T) {
 	}
 }
 
+func TestClusterQueueUsageByLabel(t *testing.T) {
+	cq := utiltesting.MakeClusterQueue("foo").
+		ResourceGroup(
+			*utiltesting.MakeFlavorQuotas("default").
+				Resource(corev1.ResourceCPU, "10").
+				Obj(),
+		).Obj()
+	workloads := []*kueue.Workload{
+		utiltesting.MakeWorkload("one", "").
+			Label("team", "a").
+			Request(corev1.ResourceCPU, "2").
+			ReserveQuota(utiltesting.MakeAdmission("foo").
+				PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "default", "2").
+					Obj()).
+				Obj()).
+			Obj(),
+		utiltesting.MakeWorkload("two", "").
+			Label("team", "a").
+			Request(corev1.ResourceCPU, "3").
+			ReserveQuota(utiltesting.MakeAdmission("foo").
+				PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "default", "3").
+					Obj()).
+				Obj()).
+			Obj(),
+		utiltesting.MakeWorkload("three", "").
+			Label("team", "b").
+			Request(corev1.ResourceCPU, "1").
+			ReserveQuota(utiltesting.MakeAdmission("foo").
+				PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "default", "1").
+					Obj()).
+				Obj()).
+			Obj(),
+		utiltesting.MakeWorkload("four", "").
+			Request(corev1.ResourceCPU, "4").
+			ReserveQuota(utiltesting.MakeAdmission("foo").
+				PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "default", "4").
+					Obj()).
+				Obj()).
+			Obj(),
+	}
+	cache := New(utiltesting.NewFakeClient())
+	ctx, log := utiltesting.ContextWithLog(t)
+	if err := cache.AddClusterQueue(ctx, cq); err != nil {
+		t.Fatalf("Adding ClusterQueue: %v", err)
+	}
+	for _, w := range workloads {
+		if added := cache.AddOrUpdateWorkload(log, w); !added {
+			t.Fatalf("Workload %s was not added", workload.Key(w))
+		}
+	}
+	got, err := cache.UsageByLabel("foo", "team")
+	if err != nil {
+		t.Fatalf("Couldn't get usage by label: %v", err)
+	}
+	cpu := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+	want := map[string]resources.FlavorResourceQuantities{
+		"a": {cpu: 5_000},
+		"b": {cpu: 1_000},
+		"":  {cpu: 4_000},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected usage by label (-want,+got):\n%s", diff)
+	}
+	if _, err := cache.UsageByLabel("bar", "team"); !errors.Is(err, ErrCqNotFound) {
+		t.Errorf("Got error %v for a missing ClusterQueue, want %v", err, ErrCqNotFound)
+	}
+}
+
+func TestClusterQueueLentResources(t *testing.T) {
+	clusterQueues := []*kueue.ClusterQueue{
+		utiltesting.MakeClusterQueue("a").
+			Cohort("team").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+			Obj(),
+		utiltesting.MakeClusterQueue("b").
+			Cohort("team").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "6", "", "2").Obj()).
+			Obj(),
+		utiltesting.MakeClusterQueue("c").
+			Cohort("team").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj()).
+			Obj(),
+		utiltesting.MakeClusterQueue("standalone").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj()).
+			Obj(),
+	}
+	usage := map[string]string{
+		"a": "2",
+		"b": "3",
+		"c": "9",
+	}
+	cache := New(utiltesting.NewFakeClient())
+	ctx, log := utiltesting.ContextWithLog(t)
+	for _, cq := range clusterQueues {
+		if err := cache.AddClusterQueue(ctx, cq); err != nil {
+			t.Fatalf("Adding ClusterQueue: %v", err)
+		}
+	}
+	for cqName, cpu := range usage {
+		w := utiltesting.MakeWorkload("wl-"+cqName, "").
+			Request(corev1.ResourceCPU, cpu).
+			ReserveQuota(utiltesting.MakeAdmission(cqName).
+				PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "default", cpu).
+					Obj()).
+				Obj()).
+			Obj()
+		if added := cache.AddOrUpdateWorkload(log, w); !added {
+			t.Fatalf("Workload %s was not added", workload.Key(w))
+		}
+	}
+
+	cpu := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+	// c borrows 5 CPUs, lent by a and b in proportion to their unused lendable quota, 8 and 2.
+	want := map[string]resources.FlavorResourceQuantities{
+		"a":          {cpu: 4_000},
+		"b":          {cpu: 1_000},
+		"c":          {cpu: 0},
+		"standalone": nil,
+	}
+	for _, cq := range clusterQueues {
+		stats, err := cache.Usage(cq)
+		if err != nil {
+			t.Fatalf("Couldn't get usage: %v", err)
+		}
+		if diff := cmp.Diff(want[cq.Name], stats.LentResources); diff != "" {
+			t.Errorf("Unexpected lent resources of ClusterQueue %s (-want,+got):\n%s", cq.Name, diff)
+		}
+	}
+}
+
 func TestLocalQueueUsage(t *testing.T) {
 	cq := *utiltesting.MakeClusterQueue("foo").
 		ResourceGroup(
@@ -2123,6 +2259,9 @@ func TestLocalQueueUsage(t *testing.T) {
 		wls            []kueue.Workload
 		wantUsage      []kueue.LocalQueueFlavorUsage
 		inAdmissibleWl sets.Set[string]
+		// addLocalQueueLast adds the LocalQueue after the workloads.
+		addLocalQueueLast           bool
+		enableCrossNamespaceBilling bool
 	}{
 		"clusterQueue is missing": {
 			wls: []kueue.Workload{
@@ -2293,9 +2432,71 @@ func TestLocalQueueUsage(t *testing.T) {
 				},
 			},
 		},
+		"workloads billed to the namespace of the queue are added before it": {
+			cq:                          &cq,
+			addLocalQueueLast:           true,
+			enableCrossNamespaceBilling: true,
+			wls: []kueue.Workload{
+				*utiltesting.MakeWorkload("one", "ci").
+					Queue("test").
+					Annotation(constants.BillingNamespaceAnnotation, "ns1").
+					Request(corev1.ResourceCPU, "5").
+					ReserveQuota(
+						utiltesting.MakeAdmission("foo").
+							PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+								Assignment(corev1.ResourceCPU, "default", "5000m").Obj()).Obj(),
+					).Obj(),
+				*utiltesting.MakeWorkload("two", "ci").
+					Queue("test").
+					Request(corev1.ResourceCPU, "3").
+					ReserveQuota(
+						utiltesting.MakeAdmission("foo").
+							PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+								Assignment(corev1.ResourceCPU, "default", "3000m").Obj()).Obj(),
+					).Obj(),
+			},
+			wantUsage: []kueue.LocalQueueFlavorUsage{
+				{
+					Name: "default",
+					Resources: []kueue.LocalQueueResourceUsage{
+						{
+							Name:  corev1.ResourceCPU,
+							Total: resource.MustParse("5"),
+						},
+					},
+				},
+				{
+					Name: "model-a",
+					Resources: []kueue.LocalQueueResourceUsage{
+						{
+							Name:  "example.com/gpu",
+							Total: resource.MustParse("0"),
+						},
+					},
+				},
+				{
+					Name: "model-b",
+					Resources: []kueue.LocalQueueResourceUsage{
+						{
+							Name:  "example.com/gpu",
+							Total: resource.MustParse("0"),
+						},
+					},
+				},
+				{
+					Name: "interconnect-a",
+					Resources: []kueue.LocalQueueResourceUsage{
+						{Name: "example.com/vf-0"},
+						{Name: "example.com/vf-1"},
+						{Name: "example.com/vf-2"},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.CrossNamespaceBilling, tc.enableCrossNamespaceBilling)
 			cache := New(utiltesting.NewFakeClient())
 			ctx, log := utiltesting.ContextWithLog(t)
 			if tc.cq != nil {
@@ -2303,14 +2504,21 @@ func TestLocalQueueUsage(t *testing.T) {
 					t.Fatalf("Adding ClusterQueue: %v", err)
 				}
 			}
-			if err := cache.AddLocalQueue(&localQueue); err != nil {
-				t.Fatalf("Adding LocalQueue: %v", err)
+			if !tc.addLocalQueueLast {
+				if err := cache.AddLocalQueue(&localQueue); err != nil {
+					t.Fatalf("Adding LocalQueue: %v", err)
+				}
 			}
 			for _, w := range tc.wls {
 				if added := cache.AddOrUpdateWorkload(log, &w); !added && !tc.inAdmissibleWl.Has(w.Name) {
 					t.Fatalf("Workload %s was not added", workload.Key(&w))
 				}
 			}
+			if tc.addLocalQueueLast {
+				if err := cache.AddLocalQueue(&localQueue); err != nil {
+					t.Fatalf("Adding LocalQueue: %v", err)
+				}
+			}
 			gotUsage, err := cache.LocalQueueUsage(&localQueue)
 			if err != nil {
 				t.Fatalf("Couldn't get usage for the queue: %v", err)
@@ -3568,6 +3776,98 @@ func TestClusterQueueReadiness(t *testing.T) {
 	}
 }
 
+func TestClusterQueueProblems(t *testing.T) {
+	baseFlavor := utiltesting.MakeResourceFlavor("flavor1").Obj()
+	baseQueue := utiltesting.MakeClusterQueue("queue1").
+		ResourceGroup(
+			*utiltesting.MakeFlavorQuotas(baseFlavor.Name).
+				Resource(corev1.ResourceCPU, "10", "10").Obj()).
+		AdmissionChecks("check1").
+		Obj()
+
+	cases := map[string]struct {
+		clusterQueues   []*kueue.ClusterQueue
+		resourceFlavors []*kueue.ResourceFlavor
+		admissionChecks []*kueue.AdmissionCheck
+		cohorts         []*kueue.Cohort
+		wantProblems    []kueue.ClusterQueueProblem
+	}{
+		"queue not found": {},
+		"no problem": {
+			clusterQueues:   []*kueue.ClusterQueue{baseQueue},
+			resourceFlavors: []*kueue.ResourceFlavor{baseFlavor},
+			admissionChecks: []*kueue.AdmissionCheck{utiltesting.MakeAdmissionCheck("check1").Active(metav1.ConditionTrue).Obj()},
+		},
+		"flavor and check not found": {
+			clusterQueues: []*kueue.ClusterQueue{baseQueue},
+			wantProblems: []kueue.ClusterQueueProblem{
+				{Reason: "FlavorNotFound", Message: "references missing ResourceFlavor(s): flavor1"},
+				{Reason: "AdmissionCheckNotFound", Message: "references missing AdmissionCheck(s): check1"},
+			},
+		},
+		"stopped with an inactive check": {
+			clusterQueues: []*kueue.ClusterQueue{
+				utiltesting.MakeClusterQueue("queue1").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas(baseFlavor.Name).
+							Resource(corev1.ResourceCPU, "10", "10").Obj()).
+					AdmissionChecks("check1").
+					StopPolicy(kueue.Hold).
+					Obj(),
+			},
+			resourceFlavors: []*kueue.ResourceFlavor{baseFlavor},
+			admissionChecks: []*kueue.AdmissionCheck{utiltesting.MakeAdmissionCheck("check1").Obj()},
+			wantProblems: []kueue.ClusterQueueProblem{
+				{Reason: "Stopped", Message: "is stopped"},
+				{Reason: "AdmissionCheckInactive", Message: "references inactive AdmissionCheck(s): check1"},
+			},
+		},
+		"cohort with a cycle": {
+			clusterQueues: []*kueue.ClusterQueue{
+				utiltesting.MakeClusterQueue("queue1").
+					Cohort("cohort-a").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas(baseFlavor.Name).
+							Resource(corev1.ResourceCPU, "10", "10").Obj()).
+					Obj(),
+			},
+			resourceFlavors: []*kueue.ResourceFlavor{baseFlavor},
+			cohorts: []*kueue.Cohort{
+				utiltesting.MakeCohort("cohort-a").Parent("cohort-b").Obj(),
+				utiltesting.MakeCohort("cohort-b").Parent("cohort-a").Obj(),
+			},
+			wantProblems: []kueue.ClusterQueueProblem{
+				{Reason: "CohortHasCycle", Message: `Cohort "cohort-a" has a cycle; can't borrow nor lend quota`},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+			cache := New(utiltesting.NewFakeClient())
+			for _, rf := range tc.resourceFlavors {
+				cache.AddOrUpdateResourceFlavor(log, rf)
+			}
+			for _, ac := range tc.admissionChecks {
+				cache.AddOrUpdateAdmissionCheck(log, ac)
+			}
+			for _, cohort := range tc.cohorts {
+				_ = cache.AddOrUpdateCohort(cohort)
+			}
+			for _, cq := range tc.clusterQueues {
+				if err := cache.AddClusterQueue(ctx, cq); err != nil && !errors.Is(err, ErrCohortHasCycle) {
+					t.Errorf("failed to add clusterQueue %q: %v", cq.Name, err)
+				}
+			}
+
+			if diff := cmp.Diff(tc.wantProblems, cache.ClusterQueueProblems("queue1")); len(diff) != 0 {
+				t.Errorf("Unexpected problems (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestCohortCycles(t *testing.T) {
 	t.Run("self cycle", func(t *testing.T) {
 		cache := New(utiltesting.NewFakeClient())
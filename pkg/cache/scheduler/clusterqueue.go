@@ -57,7 +57,15 @@ type clusterQueue struct {
 	NamespaceSelector labels.Selector
 	Preemption        kueue.ClusterQueuePreemption
 	FairWeight        float64
-	FlavorFungibility kueue.FlavorFungibility
+	// HeadroomPriorityThreshold is the minimum priority of the workloads
+	// which can use the ReservedHeadroom of the quotas.
+	HeadroomPriorityThreshold *int32
+	// TopologyPlacementPolicy overrides the placement policy of the topologies.
+	TopologyPlacementPolicy *kueue.TopologyPlacementPolicy
+	// PhysicalCapacityPolicy defines whether the workloads are admitted
+	// beyond the physical capacity of the flavors.
+	PhysicalCapacityPolicy *kueue.PhysicalCapacityPolicy
+	FlavorFungibility      kueue.FlavorFungibility
 	// Aggregates AdmissionChecks from both .spec.AdmissionChecks and .spec.AdmissionCheckStrategy
 	// Sets hold ResourceFlavors to which an AdmissionCheck should apply.
 	// In case its empty, it means an AdmissionCheck should apply to all ResourceFlavor
@@ -72,6 +80,7 @@ type clusterQueue struct {
 	localQueues                        map[queue.LocalQueueReference]*LocalQueue
 	podsReadyTracking                  bool
 	missingFlavors                     []kueue.ResourceFlavorReference
+	flavorsWithoutNodes                []kueue.ResourceFlavorReference
 	missingAdmissionChecks             []kueue.AdmissionCheckReference
 	inactiveAdmissionChecks            []kueue.AdmissionCheckReference
 	multiKueueAdmissionChecks          []kueue.AdmissionCheckReference
@@ -87,6 +96,10 @@ type clusterQueue struct {
 
 	tasCache *tasCache
 
+	// usageDecay is set when the usage considered for Fair Sharing decays
+	// over time.
+	usageDecay *usageDecay
+
 	workloadsNotAccountedForTAS sets.Set[workload.Reference]
 	AdmissionScope              *kueue.AdmissionScope
 }
@@ -163,9 +176,24 @@ func (c *clusterQueue) updateClusterQueue(log logr.Logger, in *kueue.ClusterQueu
 	} else {
 		c.FlavorFungibility = defaultFlavorFungibility
 	}
+	if !features.Enabled(features.FlavorCostOrdering) {
+		c.FlavorFungibility.FlavorOrder = ""
+	}
 
 	c.FairWeight = parseFairWeight(in.Spec.FairSharing)
+	c.HeadroomPriorityThreshold = nil
+	if features.Enabled(features.ReservedHeadroom) {
+		c.HeadroomPriorityThreshold = in.Spec.HeadroomPriorityThreshold
+	}
 	c.AdmissionScope = in.Spec.AdmissionScope
+	c.TopologyPlacementPolicy = nil
+	if features.Enabled(features.TASPlacementPolicy) {
+		c.TopologyPlacementPolicy = in.Spec.TopologyPlacementPolicy
+	}
+	c.PhysicalCapacityPolicy = nil
+	if features.Enabled(features.FlavorPhysicalCapacity) {
+		c.PhysicalCapacityPolicy = in.Spec.PhysicalCapacityPolicy
+	}
 	return nil
 }
 
@@ -215,6 +243,7 @@ func (c *clusterQueue) updateQueueStatus(log logr.Logger) {
 	status := active
 	if c.isStopped ||
 		len(c.missingFlavors) > 0 ||
+		len(c.flavorsWithoutNodes) > 0 ||
 		len(c.missingAdmissionChecks) > 0 ||
 		len(c.inactiveAdmissionChecks) > 0 ||
 		c.isTASViolated() ||
@@ -247,55 +276,75 @@ func (c *clusterQueue) inactiveReason() (string, string) {
 	case terminating:
 		return kueue.ClusterQueueActiveReasonTerminating, "Can't admit new workloads; clusterQueue is terminating"
 	case pending:
-		reasons := make([]string, 0, 3)
-		messages := make([]string, 0, 3)
-		if c.isStopped {
-			reasons = append(reasons, kueue.ClusterQueueActiveReasonStopped)
-			messages = append(messages, "is stopped")
-		}
-		if len(c.missingFlavors) > 0 {
-			reasons = append(reasons, kueue.ClusterQueueActiveReasonFlavorNotFound)
-			messages = append(messages, fmt.Sprintf("references missing ResourceFlavor(s): %v", stringsutils.Join(c.missingFlavors, ",")))
-		}
-		if len(c.missingAdmissionChecks) > 0 {
-			reasons = append(reasons, kueue.ClusterQueueActiveReasonAdmissionCheckNotFound)
-			messages = append(messages, fmt.Sprintf("references missing AdmissionCheck(s): %v", stringsutils.Join(c.missingAdmissionChecks, ",")))
+		problems := c.inactiveProblems()
+		if len(problems) == 0 {
+			return kueue.ClusterQueueActiveReasonUnknown, "Can't admit new workloads."
 		}
-		if len(c.inactiveAdmissionChecks) > 0 {
-			reasons = append(reasons, kueue.ClusterQueueActiveReasonAdmissionCheckInactive)
-			messages = append(messages, fmt.Sprintf("references inactive AdmissionCheck(s): %v", stringsutils.Join(c.inactiveAdmissionChecks, ",")))
+		messages := make([]string, 0, len(problems))
+		for _, problem := range problems {
+			messages = append(messages, problem.Message)
 		}
+		return problems[0].Reason, api.TruncateConditionMessage(fmt.Sprintf("Can't admit new workloads: %v.", strings.Join(messages, ", ")))
+	}
+	return kueue.ClusterQueueActiveReasonReady, "Can admit new workloads"
+}
 
-		if len(c.multiKueueAdmissionChecks) > 1 {
-			reasons = append(reasons, kueue.ClusterQueueActiveReasonMultipleMultiKueueAdmissionChecks)
-			messages = append(messages, fmt.Sprintf("Cannot use multiple MultiKueue AdmissionChecks on the same ClusterQueue, found: %v", stringsutils.Join(c.multiKueueAdmissionChecks, ",")))
-		}
+// inactiveProblems returns the problems preventing the ClusterQueue from admitting
+// new workloads.
+func (c *clusterQueue) inactiveProblems() []kueue.ClusterQueueProblem {
+	var problems []kueue.ClusterQueueProblem
+	add := func(reason, message string) {
+		problems = append(problems, kueue.ClusterQueueProblem{Reason: reason, Message: message})
+	}
+	if c.isStopped {
+		add(kueue.ClusterQueueActiveReasonStopped, "is stopped")
+	}
+	if len(c.missingFlavors) > 0 {
+		add(kueue.ClusterQueueActiveReasonFlavorNotFound, fmt.Sprintf("references missing ResourceFlavor(s): %v", stringsutils.Join(c.missingFlavors, ",")))
+	}
+	if len(c.flavorsWithoutNodes) > 0 {
+		add(kueue.ClusterQueueActiveReasonFlavorWithoutNodes, fmt.Sprintf("references ResourceFlavor(s) without schedulable Nodes: %v", stringsutils.Join(c.flavorsWithoutNodes, ",")))
+	}
+	if len(c.missingAdmissionChecks) > 0 {
+		add(kueue.ClusterQueueActiveReasonAdmissionCheckNotFound, fmt.Sprintf("references missing AdmissionCheck(s): %v", stringsutils.Join(c.missingAdmissionChecks, ",")))
+	}
+	if len(c.inactiveAdmissionChecks) > 0 {
+		add(kueue.ClusterQueueActiveReasonAdmissionCheckInactive, fmt.Sprintf("references inactive AdmissionCheck(s): %v", stringsutils.Join(c.inactiveAdmissionChecks, ",")))
+	}
 
-		if len(c.perFlavorMultiKueueAdmissionChecks) > 0 {
-			reasons = append(reasons, kueue.ClusterQueueActiveReasonMultiKueueAdmissionCheckAppliedPerFlavor)
-			messages = append(messages, fmt.Sprintf("Cannot specify MultiKueue AdmissionCheck per flavor, found: %s", stringsutils.Join(c.perFlavorMultiKueueAdmissionChecks, ",")))
-		}
+	if len(c.multiKueueAdmissionChecks) > 1 {
+		add(kueue.ClusterQueueActiveReasonMultipleMultiKueueAdmissionChecks, fmt.Sprintf("Cannot use multiple MultiKueue AdmissionChecks on the same ClusterQueue, found: %v", stringsutils.Join(c.multiKueueAdmissionChecks, ",")))
+	}
 
-		if features.Enabled(features.TopologyAwareScheduling) && len(c.tasFlavors) > 0 {
-			if len(c.multiKueueAdmissionChecks) > 0 {
-				reasons = append(reasons, kueue.ClusterQueueActiveReasonNotSupportedWithTopologyAwareScheduling)
-				messages = append(messages, "TAS is not supported with MultiKueue admission check")
-			}
-			for tasFlavor, topology := range c.tasFlavors {
-				if c.tasCache.Get(tasFlavor) == nil {
-					reasons = append(reasons, kueue.ClusterQueueActiveReasonTopologyNotFound)
-					messages = append(messages, fmt.Sprintf("there is no Topology %q for TAS flavor %q", topology, tasFlavor))
-				}
-			}
-		}
+	if len(c.perFlavorMultiKueueAdmissionChecks) > 0 {
+		add(kueue.ClusterQueueActiveReasonMultiKueueAdmissionCheckAppliedPerFlavor, fmt.Sprintf("Cannot specify MultiKueue AdmissionCheck per flavor, found: %s", stringsutils.Join(c.perFlavorMultiKueueAdmissionChecks, ",")))
+	}
 
-		if len(reasons) == 0 {
-			return kueue.ClusterQueueActiveReasonUnknown, "Can't admit new workloads."
+	if features.Enabled(features.TopologyAwareScheduling) && len(c.tasFlavors) > 0 {
+		if len(c.multiKueueAdmissionChecks) > 0 {
+			add(kueue.ClusterQueueActiveReasonNotSupportedWithTopologyAwareScheduling, "TAS is not supported with MultiKueue admission check")
 		}
+		for _, tasFlavor := range slices.Sorted(maps.Keys(c.tasFlavors)) {
+			if c.tasCache.Get(tasFlavor) == nil {
+				add(kueue.ClusterQueueActiveReasonTopologyNotFound, fmt.Sprintf("there is no Topology %q for TAS flavor %q", c.tasFlavors[tasFlavor], tasFlavor))
+			}
+		}
+	}
+	return problems
+}
 
-		return reasons[0], api.TruncateConditionMessage(fmt.Sprintf("Can't admit new workloads: %v.", strings.Join(messages, ", ")))
+// problems returns all the problems of the ClusterQueue, including the ones which
+// don't prevent it from admitting new workloads, like a cycle in its Cohort
+// making it unable to borrow.
+func (c *clusterQueue) problems() []kueue.ClusterQueueProblem {
+	problems := c.inactiveProblems()
+	if c.HasParent() && hierarchy.HasCycle(c.Parent()) {
+		problems = append(problems, kueue.ClusterQueueProblem{
+			Reason:  kueue.ClusterQueueProblemReasonCohortHasCycle,
+			Message: fmt.Sprintf("Cohort %q has a cycle; can't borrow nor lend quota", c.Parent().Name),
+		})
 	}
-	return kueue.ClusterQueueActiveReasonReady, "Can admit new workloads"
+	return problems
 }
 
 func (c *clusterQueue) isTASViolated() bool {
@@ -317,6 +366,7 @@ func (c *clusterQueue) UpdateWithFlavors(log logr.Logger, flavors map[kueue.Reso
 
 func (c *clusterQueue) updateLabelKeys(flavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor) {
 	c.missingFlavors = nil
+	c.flavorsWithoutNodes = nil
 	c.tasFlavors = nil
 	for i := range c.ResourceGroups {
 		rg := &c.ResourceGroups[i]
@@ -330,6 +380,9 @@ func (c *clusterQueue) updateLabelKeys(flavors map[kueue.ResourceFlavorReference
 				for k := range flv.Spec.NodeLabels {
 					keys.Insert(k)
 				}
+				if features.Enabled(features.FlavorNodeDriftDetection) && apimeta.IsStatusConditionFalse(flv.Status.Conditions, kueue.ResourceFlavorNodesAvailable) {
+					c.flavorsWithoutNodes = append(c.flavorsWithoutNodes, fName)
+				}
 				if flv.Spec.TopologyName != nil {
 					if c.tasFlavors == nil {
 						c.tasFlavors = make(map[kueue.ResourceFlavorReference]kueue.TopologyReference, 1)
@@ -480,6 +533,9 @@ func (q *LocalQueue) reportActiveWorkloads() {
 func (c *clusterQueue) updateWorkloadUsage(log logr.Logger, wi *workload.Info, op usageOp) {
 	admitted := workload.IsAdmitted(wi.Obj)
 	frUsage := wi.FlavorResourceUsage()
+	if c.usageDecay != nil {
+		c.usageDecay.update(c.resourceNode.Usage)
+	}
 	for fr, q := range frUsage {
 		if op == add {
 			addUsage(c, fr, q)
@@ -615,7 +671,7 @@ func resetUsage(lqUsage resources.FlavorResourceQuantities, cqUsage resources.Fl
 }
 
 func workloadBelongsToLocalQueue(wl *kueue.Workload, q *kueue.LocalQueue) bool {
-	return wl.Namespace == q.Namespace && string(wl.Spec.QueueName) == q.Name
+	return queue.NamespaceForWorkload(wl) == q.Namespace && string(wl.Spec.QueueName) == q.Name
 }
 
 // Implements dominantResourceShareNode interface.
@@ -21,6 +21,7 @@ import (
 	"maps"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -59,6 +60,28 @@ type ClusterQueueSnapshot struct {
 	tasOnly    bool
 
 	flavorsForProvReqACs sets.Set[kueue.ResourceFlavorReference]
+
+	// Reservations holds the Reservations booking capacity in the ClusterQueue.
+	Reservations []*kueue.Reservation
+
+	// HeadroomPriorityThreshold is the minimum priority of the workloads
+	// which can use the ReservedHeadroom of the quotas.
+	HeadroomPriorityThreshold *int32
+
+	// TopologyPlacementPolicy overrides the placement policy of the topologies.
+	TopologyPlacementPolicy *kueue.TopologyPlacementPolicy
+
+	// PhysicalCapacityPolicy defines whether the workloads are admitted
+	// beyond the physical capacity of the flavors.
+	PhysicalCapacityPolicy *kueue.PhysicalCapacityPolicy
+
+	// PhysicalCapacity is the physical capacity of the flavors, shared by
+	// all the ClusterQueues of the snapshot.
+	PhysicalCapacity *PhysicalCapacity
+
+	// FlavorCosts are the costs of the flavors reported by the flavor cost
+	// provider, shared by all the ClusterQueues of the snapshot.
+	FlavorCosts map[kueue.ResourceFlavorReference]resource.Quantity
 }
 
 // RGByResource returns the ResourceGroup which contains capacity
@@ -113,6 +136,9 @@ func (c *ClusterQueueSnapshot) AddUsage(usage workload.Usage) {
 		addUsage(c, fr, q)
 	}
 	c.updateTASUsage(usage.TAS, add)
+	if c.PhysicalCapacity != nil {
+		c.PhysicalCapacity.updateUsage(usage.Quota, add)
+	}
 }
 
 func (c *ClusterQueueSnapshot) RemoveUsage(usage workload.Usage) {
@@ -120,6 +146,9 @@ func (c *ClusterQueueSnapshot) RemoveUsage(usage workload.Usage) {
 		removeUsage(c, fr, q)
 	}
 	c.updateTASUsage(usage.TAS, subtract)
+	if c.PhysicalCapacity != nil {
+		c.PhysicalCapacity.updateUsage(usage.Quota, subtract)
+	}
 }
 
 func (c *ClusterQueueSnapshot) updateTASUsage(usage workload.TASUsage, op usageOp) {
@@ -129,6 +158,9 @@ func (c *ClusterQueueSnapshot) updateTASUsage(usage workload.TASUsage, op usageO
 				for _, tr := range tasUsage {
 					domainID := utiltas.DomainID(tr.Values)
 					tasFlvCache.updateTASUsage(domainID, tr.TotalRequests(), op, tr.Count)
+					if tr.ExclusiveLevel != "" {
+						tasFlvCache.updateExclusiveUsage(domainID, tr.ExclusiveLevel, tr.Count, op)
+					}
 				}
 			}
 		}
@@ -179,6 +211,47 @@ func (c *ClusterQueueSnapshot) PotentialAvailable(fr resources.FlavorResource) i
 	return potentialAvailable(c, fr)
 }
 
+// applyPeerBorrowingLimits lowers the BorrowingLimits of the ClusterQueue,
+// so that the quota lent by each of the peers listed in PeerBorrowingLimits
+// only counts up to the limit set for the peer.
+func (c *ClusterQueueSnapshot) applyPeerBorrowingLimits() {
+	if !c.HasParent() {
+		return
+	}
+	var quotas map[resources.FlavorResource]ResourceQuota
+	for fr, quota := range c.ResourceNode.Quotas {
+		if len(quota.PeerBorrowingLimits) == 0 {
+			continue
+		}
+		var excess int64
+		for _, peer := range c.Parent().ChildCQs() {
+			peerLimit, found := quota.PeerBorrowingLimits[peer.Name]
+			if !found || peer == c {
+				continue
+			}
+			lent := peer.ResourceNode.SubtreeQuota[fr] - peer.ResourceNode.localQuota(fr)
+			excess += max(0, lent-peerLimit)
+		}
+		if excess == 0 {
+			continue
+		}
+		storedInParent := c.ResourceNode.SubtreeQuota[fr] - c.ResourceNode.localQuota(fr)
+		limit := max(0, potentialAvailable(c.Parent(), fr)-storedInParent-excess)
+		if quota.BorrowingLimit != nil {
+			limit = min(limit, *quota.BorrowingLimit)
+		}
+		if quotas == nil {
+			// Quotas are shared with the cache, so we modify a copy.
+			quotas = maps.Clone(c.ResourceNode.Quotas)
+		}
+		quota.BorrowingLimit = &limit
+		quotas[fr] = quota
+	}
+	if quotas != nil {
+		c.ResourceNode.Quotas = quotas
+	}
+}
+
 func (c *ClusterQueueSnapshot) GetName() kueue.ClusterQueueReference {
 	return c.Name
 }
@@ -209,9 +282,8 @@ func (c *ClusterQueueSnapshot) FindTopologyAssignmentsForWorkload(
 	tasRequestsByFlavor WorkloadTASRequests,
 	options ...FindTopologyAssignmentsOption,
 ) TASAssignmentsResult {
-	opts := &findTopologyAssignmentsOption{}
-	for _, option := range options {
-		option(opts)
+	if c.TopologyPlacementPolicy != nil {
+		options = append([]FindTopologyAssignmentsOption{WithPlacementPolicy(*c.TopologyPlacementPolicy)}, options...)
 	}
 
 	result := make(TASAssignmentsResult)
@@ -32,15 +32,23 @@ import (
 
 func TestClusterQueueUpdateWithFlavors(t *testing.T) {
 	rf := utiltesting.MakeResourceFlavor("x86").Obj()
+	rfWithoutNodes := utiltesting.MakeResourceFlavor("x86").Obj()
+	rfWithoutNodes.Status.Conditions = []metav1.Condition{{
+		Type:   kueue.ResourceFlavorNodesAvailable,
+		Status: metav1.ConditionFalse,
+		Reason: kueue.ResourceFlavorNodesAvailableReasonNoMatchingNodes,
+	}}
 	cq := utiltesting.MakeClusterQueue("cq").
 		ResourceGroup(*utiltesting.MakeFlavorQuotas("x86").Resource("cpu", "5").Obj()).
 		Obj()
 
 	testcases := []struct {
-		name       string
-		curStatus  metrics.ClusterQueueStatus
-		flavors    map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor
-		wantStatus metrics.ClusterQueueStatus
+		name                 string
+		enableNodeDriftCheck bool
+		curStatus            metrics.ClusterQueueStatus
+		flavors              map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor
+		wantStatus           metrics.ClusterQueueStatus
+		wantInactiveReason   string
 	}{
 		{
 			name:      "Pending clusterQueue updated existent flavors",
@@ -69,10 +77,29 @@ func TestClusterQueueUpdateWithFlavors(t *testing.T) {
 			curStatus:  terminating,
 			wantStatus: terminating,
 		},
+		{
+			name:                 "Active clusterQueue updated with a flavor without nodes",
+			enableNodeDriftCheck: true,
+			curStatus:            active,
+			flavors: map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor{
+				kueue.ResourceFlavorReference(rfWithoutNodes.Name): rfWithoutNodes,
+			},
+			wantStatus:         pending,
+			wantInactiveReason: kueue.ClusterQueueActiveReasonFlavorWithoutNodes,
+		},
+		{
+			name:      "Flavor without nodes is ignored when the feature gate is disabled",
+			curStatus: pending,
+			flavors: map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor{
+				kueue.ResourceFlavorReference(rfWithoutNodes.Name): rfWithoutNodes,
+			},
+			wantStatus: active,
+		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.FlavorNodeDriftDetection, tc.enableNodeDriftCheck)
 			_, log := utiltesting.ContextWithLog(t)
 			cache := New(utiltesting.NewFakeClient())
 			cq, err := cache.newClusterQueue(log, cq)
@@ -86,6 +113,11 @@ func TestClusterQueueUpdateWithFlavors(t *testing.T) {
 			if cq.Status != tc.wantStatus {
 				t.Fatalf("got different status, want: %v, got: %v", tc.wantStatus, cq.Status)
 			}
+			if tc.wantInactiveReason != "" {
+				if gotReason, _ := cq.inactiveReason(); gotReason != tc.wantInactiveReason {
+					t.Errorf("got different inactive reason, want: %v, got: %v", tc.wantInactiveReason, gotReason)
+				}
+			}
 		})
 	}
 }
@@ -0,0 +1,99 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"slices"
+	"strings"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/cache/hierarchy"
+	"sigs.k8s.io/kueue/pkg/resources"
+)
+
+// CohortCapacity is the capacity of a cohort, accumulated over the
+// ClusterQueues and the Cohorts of its subtree.
+type CohortCapacity struct {
+	Name   kueue.CohortReference
+	Parent kueue.CohortReference
+	// ClusterQueues are the ClusterQueues of the subtree of the cohort.
+	ClusterQueues []kueue.ClusterQueueReference
+	// Nominal is the nominal quota of the ClusterQueues and the Cohorts of the subtree.
+	Nominal resources.FlavorResourceQuantities
+	// Reserved is the quota reserved by the workloads in the ClusterQueues of the subtree.
+	Reserved resources.FlavorResourceQuantities
+	// Used is the quota used by the admitted workloads in the ClusterQueues of the subtree.
+	Used resources.FlavorResourceQuantities
+	// Borrowable is the quota of the cohort which is not reserved, and can be
+	// borrowed by the ClusterQueues of the subtree, without preempting any workload.
+	// It is not set when the cohort has a cycle.
+	Borrowable resources.FlavorResourceQuantities
+}
+
+// CohortCapacities returns the capacity of each of the cohorts, sorted by name.
+func (c *Cache) CohortCapacities() []CohortCapacity {
+	c.RLock()
+	defer c.RUnlock()
+
+	result := make([]CohortCapacity, 0, len(c.hm.Cohorts()))
+	for name, cohort := range c.hm.Cohorts() {
+		capacity := CohortCapacity{
+			Name:     name,
+			Nominal:  make(resources.FlavorResourceQuantities),
+			Reserved: make(resources.FlavorResourceQuantities),
+			Used:     make(resources.FlavorResourceQuantities),
+		}
+		if cohort.HasParent() {
+			capacity.Parent = cohort.Parent().Name
+		}
+		hasCycle := hierarchy.HasCycle(cohort)
+		if !hasCycle {
+			accumulateCohortCapacity(&capacity, cohort)
+			capacity.Borrowable = make(resources.FlavorResourceQuantities, len(capacity.Nominal))
+			for fr := range capacity.Nominal {
+				capacity.Borrowable[fr] = max(0, available(cohort, fr))
+			}
+		}
+		slices.Sort(capacity.ClusterQueues)
+		result = append(result, capacity)
+	}
+	slices.SortFunc(result, func(a, b CohortCapacity) int { return strings.Compare(string(a.Name), string(b.Name)) })
+	return result
+}
+
+// accumulateCohortCapacity accumulates the capacity of the subtree of the cohort.
+// It expects that the cohort has no cycle.
+func accumulateCohortCapacity(capacity *CohortCapacity, cohort *cohort) {
+	for fr, quota := range cohort.resourceNode.Quotas {
+		capacity.Nominal[fr] += quota.Nominal
+	}
+	for _, child := range cohort.ChildCohorts() {
+		accumulateCohortCapacity(capacity, child)
+	}
+	for _, cq := range cohort.ChildCQs() {
+		capacity.ClusterQueues = append(capacity.ClusterQueues, cq.Name)
+		for fr, quota := range cq.resourceNode.Quotas {
+			capacity.Nominal[fr] += quota.Nominal
+		}
+		for fr, usage := range cq.resourceNode.Usage {
+			capacity.Reserved[fr] += usage
+		}
+		for fr, usage := range cq.AdmittedUsage {
+			capacity.Used[fr] += usage
+		}
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestCohortCapacities(t *testing.T) {
+	ctx, log := utiltesting.ContextWithLog(t)
+	cache := New(utiltesting.NewFakeClient())
+	cache.AddOrUpdateResourceFlavor(log, utiltesting.MakeResourceFlavor("default").Obj())
+	for _, cohort := range []*kueue.Cohort{
+		utiltesting.MakeCohort("root").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "2").Obj()).
+			Obj(),
+		utiltesting.MakeCohort("team-a").Parent("root").Obj(),
+	} {
+		if err := cache.AddOrUpdateCohort(cohort); err != nil {
+			t.Fatalf("Failed to add the Cohort %s: %v", cohort.Name, err)
+		}
+	}
+	for _, cq := range []*kueue.ClusterQueue{
+		utiltesting.MakeClusterQueue("cq-a").
+			Cohort("team-a").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+			Obj(),
+		utiltesting.MakeClusterQueue("cq-b").
+			Cohort("root").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "5").Obj()).
+			Obj(),
+	} {
+		if err := cache.AddClusterQueue(ctx, cq); err != nil {
+			t.Fatalf("Failed to add the ClusterQueue %s: %v", cq.Name, err)
+		}
+	}
+	cache.AddOrUpdateWorkload(log, utiltesting.MakeWorkload("wl-a", "ns").
+		ReserveQuota(utiltesting.MakeAdmission("cq-a").PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "default", "3").Obj()).Obj()).
+		Admitted(true).
+		Obj())
+	cache.AddOrUpdateWorkload(log, utiltesting.MakeWorkload("wl-b", "ns").
+		ReserveQuota(utiltesting.MakeAdmission("cq-b").PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "default", "7").Obj()).Obj()).
+		Obj())
+
+	defaultCPU := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+	want := []CohortCapacity{
+		{
+			Name:          "root",
+			ClusterQueues: []kueue.ClusterQueueReference{"cq-a", "cq-b"},
+			Nominal:       resources.FlavorResourceQuantities{defaultCPU: 17_000},
+			Reserved:      resources.FlavorResourceQuantities{defaultCPU: 10_000},
+			Used:          resources.FlavorResourceQuantities{defaultCPU: 3_000},
+			Borrowable:    resources.FlavorResourceQuantities{defaultCPU: 7_000},
+		},
+		{
+			Name:          "team-a",
+			Parent:        "root",
+			ClusterQueues: []kueue.ClusterQueueReference{"cq-a"},
+			Nominal:       resources.FlavorResourceQuantities{defaultCPU: 10_000},
+			Reserved:      resources.FlavorResourceQuantities{defaultCPU: 3_000},
+			Used:          resources.FlavorResourceQuantities{defaultCPU: 3_000},
+			Borrowable:    resources.FlavorResourceQuantities{defaultCPU: 7_000},
+		},
+	}
+	if diff := cmp.Diff(want, cache.CohortCapacities()); diff != "" {
+		t.Errorf("Unexpected cohort capacities (-want,+got):\n%s", diff)
+	}
+}
@@ -116,6 +116,12 @@ func (d DRS) zeroWeightBorrows() bool {
 }
 
 func dominantResourceShare(node dominantResourceShareNode, wlReq resources.FlavorResourceQuantities) DRS {
+	return dominantResourceShareWithDecayedUsage(node, wlReq, node.getResourceNode().DecayedUsage)
+}
+
+// dominantResourceShareWithDecayedUsage computes the DRS of the node, using
+// the decayed usage for the resources in which it is higher than the usage.
+func dominantResourceShareWithDecayedUsage(node dominantResourceShareNode, wlReq, decayedUsage resources.FlavorResourceQuantities) DRS {
 	drs := DRS{fairWeight: node.fairWeight(), unweightedRatio: 0, dominantResource: ""}
 	if !node.HasParent() {
 		return drs
@@ -123,7 +129,7 @@ func dominantResourceShare(node dominantResourceShareNode, wlReq resources.Flavo
 
 	borrowing := make(map[corev1.ResourceName]int64, len(node.getResourceNode().SubtreeQuota))
 	for fr, quota := range node.getResourceNode().SubtreeQuota {
-		amountBorrowed := wlReq[fr] + node.getResourceNode().Usage[fr] - quota
+		amountBorrowed := wlReq[fr] + max(node.getResourceNode().Usage[fr], decayedUsage[fr]) - quota
 		if amountBorrowed > 0 {
 			borrowing[fr.Resource] += amountBorrowed
 		}
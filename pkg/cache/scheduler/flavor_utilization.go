@@ -0,0 +1,113 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"context"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/cache/hierarchy"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+)
+
+// FlavorUtilization is the percentage of the capacity of the flavor resources
+// used by the admitted workloads.
+type FlavorUtilization struct {
+	// Nominal is the percentage of the nominal quota used by the admitted
+	// workloads, for the flavor resources with a positive nominal quota.
+	Nominal map[resources.FlavorResource]float64
+	// Physical is the percentage of the allocatable capacity of the Nodes of
+	// the flavors used by the admitted workloads. It is only set when the
+	// FlavorPhysicalCapacity feature gate is enabled, and only for the flavor
+	// resources of which the physical capacity is tracked.
+	Physical map[resources.FlavorResource]float64
+}
+
+// FlavorUtilizationStats is the flavor utilization of a ClusterQueue, and of
+// each of the Cohorts of its cohort tree.
+type FlavorUtilizationStats struct {
+	ClusterQueue FlavorUtilization
+	Cohorts      map[kueue.CohortReference]FlavorUtilization
+}
+
+// FlavorUtilization returns the flavor utilization of the ClusterQueue and of
+// the Cohorts from its parent to the root. The Cohorts are omitted when the
+// cohort tree has a cycle.
+func (c *Cache) FlavorUtilization(ctx context.Context, cqObj *kueue.ClusterQueue) (*FlavorUtilizationStats, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	cq := c.hm.ClusterQueue(kueue.ClusterQueueReference(cqObj.Name))
+	if cq == nil {
+		return nil, ErrCqNotFound
+	}
+	var capacity *PhysicalCapacity
+	if features.Enabled(features.FlavorPhysicalCapacity) {
+		var err error
+		if capacity, err = c.physicalCapacity(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	nominal := make(resources.FlavorResourceQuantities, len(cq.resourceNode.Quotas))
+	for fr, quota := range cq.resourceNode.Quotas {
+		nominal[fr] = quota.Nominal
+	}
+	stats := &FlavorUtilizationStats{
+		ClusterQueue: flavorUtilization(nominal, cq.AdmittedUsage, capacity),
+		Cohorts:      make(map[kueue.CohortReference]FlavorUtilization),
+	}
+	if !cq.HasParent() || hierarchy.HasCycle(cq.Parent()) {
+		return stats, nil
+	}
+	for cohort := range cq.Parent().PathSelfToRoot() {
+		cohortCapacity := CohortCapacity{
+			Nominal:  make(resources.FlavorResourceQuantities),
+			Reserved: make(resources.FlavorResourceQuantities),
+			Used:     make(resources.FlavorResourceQuantities),
+		}
+		accumulateCohortCapacity(&cohortCapacity, cohort)
+		stats.Cohorts[cohort.Name] = flavorUtilization(cohortCapacity.Nominal, cohortCapacity.Used, capacity)
+	}
+	return stats, nil
+}
+
+func flavorUtilization(nominal, used resources.FlavorResourceQuantities, capacity *PhysicalCapacity) FlavorUtilization {
+	utilization := FlavorUtilization{
+		Nominal: make(map[resources.FlavorResource]float64, len(nominal)),
+	}
+	for fr, quota := range nominal {
+		if quota > 0 {
+			utilization.Nominal[fr] = percentage(used[fr], quota)
+		}
+	}
+	if capacity == nil {
+		return utilization
+	}
+	utilization.Physical = make(map[resources.FlavorResource]float64)
+	for fr := range nominal {
+		if allocatable, found := capacity.Allocatable[fr]; found && allocatable > 0 {
+			utilization.Physical[fr] = percentage(used[fr], allocatable)
+		}
+	}
+	return utilization
+}
+
+func percentage(used, total int64) float64 {
+	return float64(used) * 100 / float64(total)
+}
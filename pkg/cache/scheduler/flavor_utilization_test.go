@@ -0,0 +1,137 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
+)
+
+func TestFlavorUtilization(t *testing.T) {
+	gpuCPU := resources.FlavorResource{Flavor: "gpu", Resource: corev1.ResourceCPU}
+	defaultCPU := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+
+	cases := map[string]struct {
+		physicalCapacity bool
+		cq               kueue.ClusterQueueReference
+		want             FlavorUtilizationStats
+	}{
+		"ClusterQueue in a cohort tree": {
+			cq: "cq-a",
+			want: FlavorUtilizationStats{
+				ClusterQueue: FlavorUtilization{
+					Nominal: map[resources.FlavorResource]float64{gpuCPU: 75, defaultCPU: 0},
+				},
+				Cohorts: map[kueue.CohortReference]FlavorUtilization{
+					"team-a": {Nominal: map[resources.FlavorResource]float64{gpuCPU: 75, defaultCPU: 0}},
+					"root":   {Nominal: map[resources.FlavorResource]float64{gpuCPU: 50, defaultCPU: 0}},
+				},
+			},
+		},
+		"ClusterQueue in a cohort tree with the physical capacity": {
+			physicalCapacity: true,
+			cq:               "cq-a",
+			want: FlavorUtilizationStats{
+				ClusterQueue: FlavorUtilization{
+					Nominal:  map[resources.FlavorResource]float64{gpuCPU: 75, defaultCPU: 0},
+					Physical: map[resources.FlavorResource]float64{gpuCPU: 37.5},
+				},
+				Cohorts: map[kueue.CohortReference]FlavorUtilization{
+					"team-a": {
+						Nominal:  map[resources.FlavorResource]float64{gpuCPU: 75, defaultCPU: 0},
+						Physical: map[resources.FlavorResource]float64{gpuCPU: 37.5},
+					},
+					"root": {
+						Nominal:  map[resources.FlavorResource]float64{gpuCPU: 50, defaultCPU: 0},
+						Physical: map[resources.FlavorResource]float64{gpuCPU: 50},
+					},
+				},
+			},
+		},
+		"ClusterQueue without a cohort, with a zero nominal quota": {
+			cq: "cq-c",
+			want: FlavorUtilizationStats{
+				ClusterQueue: FlavorUtilization{Nominal: map[resources.FlavorResource]float64{}},
+				Cohorts:      map[kueue.CohortReference]FlavorUtilization{},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.FlavorPhysicalCapacity, tc.physicalCapacity)
+			ctx, log := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewFakeClient(
+				testingnode.MakeNode("gpu-1").Label("pool", "gpu").StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")}).Ready().Obj(),
+			)
+			cache := New(cl)
+			cache.AddOrUpdateResourceFlavor(log, utiltesting.MakeResourceFlavor("gpu").NodeLabel("pool", "gpu").Obj())
+			cache.AddOrUpdateResourceFlavor(log, utiltesting.MakeResourceFlavor("default").Obj())
+			if err := cache.AddOrUpdateCohort(utiltesting.MakeCohort("team-a").Parent("root").Obj()); err != nil {
+				t.Fatalf("Failed to add the Cohort: %v", err)
+			}
+			for _, cq := range []*kueue.ClusterQueue{
+				utiltesting.MakeClusterQueue("cq-a").
+					Cohort("team-a").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas("gpu").Resource(corev1.ResourceCPU, "4").Obj(),
+						*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj(),
+					).Obj(),
+				utiltesting.MakeClusterQueue("cq-b").
+					Cohort("root").
+					ResourceGroup(*utiltesting.MakeFlavorQuotas("gpu").Resource(corev1.ResourceCPU, "4").Obj()).
+					Obj(),
+				utiltesting.MakeClusterQueue("cq-c").
+					ResourceGroup(*utiltesting.MakeFlavorQuotas("gpu").Resource(corev1.ResourceCPU, "0").Obj()).
+					Obj(),
+			} {
+				if err := cache.AddClusterQueue(ctx, cq); err != nil {
+					t.Fatalf("Failed to add the ClusterQueue %s: %v", cq.Name, err)
+				}
+			}
+			cache.AddOrUpdateWorkload(log, utiltesting.MakeWorkload("admitted-a", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("cq-a").PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "gpu", "3").Obj()).Obj()).
+				Admitted(true).
+				Obj())
+			cache.AddOrUpdateWorkload(log, utiltesting.MakeWorkload("admitted-b", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("cq-b").PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "gpu", "1").Obj()).Obj()).
+				Admitted(true).
+				Obj())
+			// The quota reserved by the workloads which are not admitted is not utilized.
+			cache.AddOrUpdateWorkload(log, utiltesting.MakeWorkload("reserved-a", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("cq-a").PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "default", "2").Obj()).Obj()).
+				Obj())
+
+			got, err := cache.FlavorUtilization(ctx, utiltesting.MakeClusterQueue(string(tc.cq)).Obj())
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, *got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected flavor utilization (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
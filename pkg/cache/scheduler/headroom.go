@@ -0,0 +1,53 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"cmp"
+	"slices"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+)
+
+// CanUseHeadroom returns true if the workload can use the reserved headroom
+// of the ClusterQueue quotas.
+func (c *ClusterQueueSnapshot) CanUseHeadroom(wl *kueue.Workload) bool {
+	return c.HeadroomPriorityThreshold != nil && priority.Priority(wl) >= *c.HeadroomPriorityThreshold
+}
+
+// HeadroomConflicts returns the flavor resources of which the workload
+// would consume the reserved headroom, if admitted with the given usage.
+// The freed usage is the usage released by the preemptions required to
+// admit the workload.
+func (c *ClusterQueueSnapshot) HeadroomConflicts(wl *kueue.Workload, usage, freed resources.FlavorResourceQuantities) []resources.FlavorResource {
+	if c.CanUseHeadroom(wl) {
+		return nil
+	}
+	var conflicts []resources.FlavorResource
+	for fr, q := range usage {
+		headroom := c.QuotaFor(fr).ReservedHeadroom
+		if headroom > 0 && c.Available(fr)+freed[fr]-q < headroom {
+			conflicts = append(conflicts, fr)
+		}
+	}
+	slices.SortFunc(conflicts, func(a, b resources.FlavorResource) int {
+		return cmp.Or(cmp.Compare(a.Flavor, b.Flavor), cmp.Compare(a.Resource, b.Resource))
+	})
+	return conflicts
+}
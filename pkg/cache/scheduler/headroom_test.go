@@ -0,0 +1,97 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestHeadroomConflicts(t *testing.T) {
+	cpu := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+	admitted := []kueue.Workload{
+		*utiltesting.MakeWorkload("admitted", "ns").
+			ReserveQuota(utiltesting.MakeAdmission("cq").
+				PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "default", "5").
+					Obj()).
+				Obj()).
+			Obj(),
+	}
+
+	cases := map[string]struct {
+		workload      *kueue.Workload
+		usage         resources.FlavorResourceQuantities
+		freed         resources.FlavorResourceQuantities
+		wantConflicts []resources.FlavorResource
+	}{
+		"workload fits next to the headroom": {
+			workload: utiltesting.MakeWorkload("wl", "ns").Obj(),
+			usage:    resources.FlavorResourceQuantities{cpu: 3_000},
+		},
+		"workload would consume the headroom": {
+			workload:      utiltesting.MakeWorkload("wl", "ns").Obj(),
+			usage:         resources.FlavorResourceQuantities{cpu: 4_000},
+			wantConflicts: []resources.FlavorResource{cpu},
+		},
+		"high priority workload can consume the headroom": {
+			workload: utiltesting.MakeWorkload("wl", "ns").Priority(1000).Obj(),
+			usage:    resources.FlavorResourceQuantities{cpu: 5_000},
+		},
+		"workload fits next to the headroom after preemptions": {
+			workload: utiltesting.MakeWorkload("wl", "ns").Obj(),
+			usage:    resources.FlavorResourceQuantities{cpu: 6_000},
+			freed:    resources.FlavorResourceQuantities{cpu: 5_000},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.ReservedHeadroom, true)
+			ctx, log := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().WithLists(&kueue.WorkloadList{Items: admitted}).Build()
+
+			cache := New(cl)
+			cache.AddOrUpdateResourceFlavor(log, utiltesting.MakeResourceFlavor("default").Obj())
+			cq := utiltesting.MakeClusterQueue("cq").
+				HeadroomPriorityThreshold(1000).
+				ResourceGroup(*utiltesting.MakeFlavorQuotas("default").
+					ResourceQuotaWrapper(corev1.ResourceCPU).NominalQuota("10").ReservedHeadroom("2").Append().
+					Obj()).
+				Obj()
+			if err := cache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Couldn't add ClusterQueue to cache: %v", err)
+			}
+
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error while building snapshot: %v", err)
+			}
+			got := snapshot.ClusterQueue("cq").HeadroomConflicts(tc.workload, tc.usage, tc.freed)
+			if diff := cmp.Diff(tc.wantConflicts, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected conflicts (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
+)
+
+// PhysicalCapacity holds the allocatable capacity of the ready and
+// schedulable Nodes matching the nodeLabels of the ResourceFlavors, and the
+// usage of the flavors in all the ClusterQueues.
+//
+// Only the resources reported in the allocatable of the Nodes are tracked,
+// and the flavors without nodeLabels are not tracked.
+type PhysicalCapacity struct {
+	Allocatable resources.FlavorResourceQuantities
+	Usage       resources.FlavorResourceQuantities
+}
+
+// Headroom returns the allocatable quantity of the flavor resource not used
+// by the workloads, and whether the flavor resource is tracked.
+func (p *PhysicalCapacity) Headroom(fr resources.FlavorResource) (int64, bool) {
+	allocatable, found := p.Allocatable[fr]
+	if !found {
+		return 0, false
+	}
+	return allocatable - p.Usage[fr], true
+}
+
+// Violations returns the flavor resources of which the usage would exceed
+// the physical capacity, if the given usage was added.
+func (p *PhysicalCapacity) Violations(usage resources.FlavorResourceQuantities) []resources.FlavorResource {
+	var violations []resources.FlavorResource
+	for fr, q := range usage {
+		if q <= 0 {
+			continue
+		}
+		if headroom, found := p.Headroom(fr); found && q > headroom {
+			violations = append(violations, fr)
+		}
+	}
+	slices.SortFunc(violations, func(a, b resources.FlavorResource) int {
+		return cmp.Or(cmp.Compare(a.Flavor, b.Flavor), cmp.Compare(a.Resource, b.Resource))
+	})
+	return violations
+}
+
+func (p *PhysicalCapacity) updateUsage(usage resources.FlavorResourceQuantities, op usageOp) {
+	for fr, q := range usage {
+		if _, found := p.Allocatable[fr]; !found {
+			continue
+		}
+		if op == add {
+			p.Usage[fr] += q
+		} else {
+			p.Usage[fr] -= q
+		}
+	}
+}
+
+// physicalCapacity computes the physical capacity of the ResourceFlavors
+// with nodeLabels. It must be called with the cache locked.
+func (c *Cache) physicalCapacity(ctx context.Context) (*PhysicalCapacity, error) {
+	capacity := &PhysicalCapacity{
+		Allocatable: make(resources.FlavorResourceQuantities),
+		Usage:       make(resources.FlavorResourceQuantities),
+	}
+	selectors := make(map[kueue.ResourceFlavorReference]labels.Selector)
+	for name, rf := range c.resourceFlavors {
+		if len(rf.Spec.NodeLabels) > 0 {
+			selectors[name] = labels.SelectorFromSet(rf.Spec.NodeLabels)
+		}
+	}
+	if len(selectors) == 0 {
+		return capacity, nil
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := c.client.List(ctx, nodes); err != nil {
+		return nil, fmt.Errorf("failed to list nodes for the physical capacity: %w", err)
+	}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if node.Spec.Unschedulable || !utiltas.IsNodeStatusConditionTrue(node.Status.Conditions, corev1.NodeReady) {
+			continue
+		}
+		nodeLabels := labels.Set(node.Labels)
+		for name, selector := range selectors {
+			if !selector.Matches(nodeLabels) {
+				continue
+			}
+			for resourceName, q := range node.Status.Allocatable {
+				capacity.Allocatable[resources.FlavorResource{Flavor: name, Resource: resourceName}] += resources.ResourceValue(resourceName, q)
+			}
+		}
+	}
+	for _, cq := range c.hm.ClusterQueues() {
+		capacity.updateUsage(cq.resourceNode.Usage, add)
+	}
+	return capacity, nil
+}
+
+// PhysicalCapacityStatus returns the physical capacity of the flavors with
+// nodeLabels of the ClusterQueue, in the order of its resource groups.
+func (c *Cache) PhysicalCapacityStatus(ctx context.Context, cqObj *kueue.ClusterQueue) ([]kueue.FlavorPhysicalCapacity, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	cq := c.hm.ClusterQueue(kueue.ClusterQueueReference(cqObj.Name))
+	if cq == nil {
+		return nil, ErrCqNotFound
+	}
+	capacity, err := c.physicalCapacity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var status []kueue.FlavorPhysicalCapacity
+	for _, rg := range cq.ResourceGroups {
+		for _, fName := range rg.Flavors {
+			var flvCapacity []kueue.ResourcePhysicalCapacity
+			for _, rName := range sets.List(rg.CoveredResources) {
+				fr := resources.FlavorResource{Flavor: fName, Resource: rName}
+				headroom, found := capacity.Headroom(fr)
+				if !found {
+					continue
+				}
+				flvCapacity = append(flvCapacity, kueue.ResourcePhysicalCapacity{
+					Name:        rName,
+					Allocatable: resources.ResourceQuantity(rName, capacity.Allocatable[fr]),
+					Headroom:    resources.ResourceQuantity(rName, headroom),
+				})
+			}
+			if len(flvCapacity) > 0 {
+				status = append(status, kueue.FlavorPhysicalCapacity{Name: fName, Resources: flvCapacity})
+			}
+		}
+	}
+	return status, nil
+}
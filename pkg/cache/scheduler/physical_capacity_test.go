@@ -0,0 +1,109 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestPhysicalCapacity(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.FlavorPhysicalCapacity, true)
+	ctx, log := utiltesting.ContextWithLog(t)
+
+	allocatable := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("4"),
+		corev1.ResourceMemory: resource.MustParse("8Gi"),
+	}
+	cl := utiltesting.NewFakeClient(
+		testingnode.MakeNode("gpu-1").Label("pool", "gpu").StatusAllocatable(allocatable).Ready().Obj(),
+		testingnode.MakeNode("gpu-2").Label("pool", "gpu").StatusAllocatable(allocatable).Ready().Obj(),
+		testingnode.MakeNode("gpu-3").Label("pool", "gpu").StatusAllocatable(allocatable).Ready().Unschedulable().Obj(),
+		testingnode.MakeNode("gpu-4").Label("pool", "gpu").StatusAllocatable(allocatable).NotReady().Obj(),
+		testingnode.MakeNode("cpu-1").Label("pool", "cpu").StatusAllocatable(allocatable).Ready().Obj(),
+	)
+	cache := New(cl)
+	cache.AddOrUpdateResourceFlavor(log, utiltesting.MakeResourceFlavor("gpu").NodeLabel("pool", "gpu").Obj())
+	cache.AddOrUpdateResourceFlavor(log, utiltesting.MakeResourceFlavor("default").Obj())
+	for _, cq := range []*kueue.ClusterQueue{
+		utiltesting.MakeClusterQueue("cq-a").
+			ResourceGroup(
+				*utiltesting.MakeFlavorQuotas("gpu").Resource(corev1.ResourceCPU, "10").Obj(),
+				*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj(),
+			).Obj(),
+		utiltesting.MakeClusterQueue("cq-b").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("gpu").Resource(corev1.ResourceCPU, "10").Obj()).
+			Obj(),
+	} {
+		if err := cache.AddClusterQueue(ctx, cq); err != nil {
+			t.Fatalf("Failed to add the ClusterQueue %s: %v", cq.Name, err)
+		}
+	}
+	cache.AddOrUpdateWorkload(log, utiltesting.MakeWorkload("wl-a", "ns").
+		ReserveQuota(utiltesting.MakeAdmission("cq-a").PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "gpu", "3").Obj()).Obj()).
+		Obj())
+	cache.AddOrUpdateWorkload(log, utiltesting.MakeWorkload("wl-b", "ns").
+		ReserveQuota(utiltesting.MakeAdmission("cq-b").PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "gpu", "2").Obj()).Obj()).
+		Obj())
+
+	gotStatus, err := cache.PhysicalCapacityStatus(ctx, utiltesting.MakeClusterQueue("cq-a").Obj())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantStatus := []kueue.FlavorPhysicalCapacity{{
+		Name: "gpu",
+		Resources: []kueue.ResourcePhysicalCapacity{{
+			Name:        corev1.ResourceCPU,
+			Allocatable: resource.MustParse("8"),
+			Headroom:    resource.MustParse("3"),
+		}},
+	}}
+	if diff := cmp.Diff(wantStatus, gotStatus); diff != "" {
+		t.Errorf("Unexpected physical capacity status (-want,+got):\n%s", diff)
+	}
+
+	snapshot, err := cache.Snapshot(ctx)
+	if err != nil {
+		t.Fatalf("Failed to build the snapshot: %v", err)
+	}
+	cqA := snapshot.ClusterQueue("cq-a")
+	gpuCPU := resources.FlavorResource{Flavor: "gpu", Resource: corev1.ResourceCPU}
+	gpuMemory := resources.FlavorResource{Flavor: "gpu", Resource: corev1.ResourceMemory}
+	if got := cqA.PhysicalCapacity.Violations(resources.FlavorResourceQuantities{gpuCPU: 3_000, gpuMemory: 1}); len(got) != 0 {
+		t.Errorf("Unexpected violations within the headroom: %v", got)
+	}
+	if got := cqA.PhysicalCapacity.Violations(resources.FlavorResourceQuantities{{Flavor: "default", Resource: corev1.ResourceCPU}: 100_000}); len(got) != 0 {
+		t.Errorf("Unexpected violations for a flavor without nodeLabels: %v", got)
+	}
+
+	// The usage added to a ClusterQueue of the snapshot is deducted from the
+	// headroom of all the ClusterQueues.
+	snapshot.ClusterQueue("cq-b").AddUsage(workload.Usage{Quota: resources.FlavorResourceQuantities{gpuCPU: 1_000}})
+	if diff := cmp.Diff([]resources.FlavorResource{gpuCPU}, cqA.PhysicalCapacity.Violations(resources.FlavorResourceQuantities{gpuCPU: 3_000})); diff != "" {
+		t.Errorf("Unexpected violations (-want,+got):\n%s", diff)
+	}
+}
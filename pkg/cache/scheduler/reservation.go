@@ -0,0 +1,127 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/resources"
+)
+
+func (c *Cache) AddOrUpdateReservation(r *kueue.Reservation) {
+	c.Lock()
+	defer c.Unlock()
+	c.reservations[r.Name] = r
+}
+
+// DeleteReservation removes the reservation from the cache and returns it,
+// or nil if it wasn't present.
+func (c *Cache) DeleteReservation(name string) *kueue.Reservation {
+	c.Lock()
+	defer c.Unlock()
+	r := c.reservations[name]
+	delete(c.reservations, name)
+	return r
+}
+
+// reservationsForClusterQueue returns the Reservations booking capacity in
+// the ClusterQueue, sorted by name. The caller must hold the cache lock.
+func (c *Cache) reservationsForClusterQueue(cqName kueue.ClusterQueueReference) []*kueue.Reservation {
+	var result []*kueue.Reservation
+	for _, r := range c.reservations {
+		if r.Spec.ClusterQueue == cqName {
+			result = append(result, r)
+		}
+	}
+	slices.SortFunc(result, func(a, b *kueue.Reservation) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return result
+}
+
+// IsReservationActive returns true if the reservation window is open at the
+// given time.
+func IsReservationActive(r *kueue.Reservation, now time.Time) bool {
+	return !now.Before(r.Spec.StartTime.Time) && now.Before(r.Spec.EndTime.Time)
+}
+
+// IsReservationHolder returns true if the workload is owned by the holder of
+// the reservation.
+func IsReservationHolder(r *kueue.Reservation, wl *kueue.Workload) bool {
+	if wl.Namespace != r.Spec.Holder.Namespace {
+		return false
+	}
+	return r.Spec.Holder.LocalQueue == nil || *r.Spec.Holder.LocalQueue == wl.Spec.QueueName
+}
+
+// HoldsActiveReservation returns true if the workload is owned by the holder
+// of a reservation of the ClusterQueue which is active at the given time.
+func (c *ClusterQueueSnapshot) HoldsActiveReservation(wl *kueue.Workload, now time.Time) bool {
+	return slices.ContainsFunc(c.Reservations, func(r *kueue.Reservation) bool {
+		return IsReservationActive(r, now) && IsReservationHolder(r, wl)
+	})
+}
+
+// ReservationConflicts returns the names of the active reservations which
+// would no longer have enough free capacity left for their holders if the
+// workload was admitted in the ClusterQueue with the given usage.
+func (c *ClusterQueueSnapshot) ReservationConflicts(wl *kueue.Workload, usage resources.FlavorResourceQuantities, now time.Time) []string {
+	outstanding := make(resources.FlavorResourceQuantities)
+	reservationsByFR := make(map[resources.FlavorResource][]string)
+	for _, r := range c.Reservations {
+		if !IsReservationActive(r, now) || IsReservationHolder(r, wl) {
+			continue
+		}
+		holderUsage := c.reservationHolderUsage(r)
+		for _, f := range r.Spec.Flavors {
+			for name, q := range f.Resources {
+				fr := resources.FlavorResource{Flavor: f.Name, Resource: name}
+				if remaining := resources.ResourceValue(name, q) - holderUsage[fr]; remaining > 0 {
+					outstanding[fr] += remaining
+					reservationsByFR[fr] = append(reservationsByFR[fr], r.Name)
+				}
+			}
+		}
+	}
+	conflicts := sets.New[string]()
+	for fr, q := range usage {
+		if reserved := outstanding[fr]; reserved > 0 && c.Available(fr)-q < reserved {
+			conflicts.Insert(reservationsByFR[fr]...)
+		}
+	}
+	return sets.List(conflicts)
+}
+
+// reservationHolderUsage returns the usage of the workloads owned by the
+// holder of the reservation, which are admitted in the ClusterQueue.
+func (c *ClusterQueueSnapshot) reservationHolderUsage(r *kueue.Reservation) resources.FlavorResourceQuantities {
+	usage := make(resources.FlavorResourceQuantities)
+	for _, wi := range c.Workloads {
+		if !IsReservationHolder(r, wi.Obj) {
+			continue
+		}
+		for fr, q := range wi.FlavorResourceUsage() {
+			usage[fr] += q
+		}
+	}
+	return usage
+}
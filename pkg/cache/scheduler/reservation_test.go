@@ -0,0 +1,193 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestReservationConflicts(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	cpu := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+
+	admitted := []kueue.Workload{
+		*utiltesting.MakeWorkload("holder", "team-a").
+			Queue("lq-a").
+			Request(corev1.ResourceCPU, "2").
+			ReserveQuota(utiltesting.MakeAdmission("cq").
+				PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "default", "2").
+					Obj()).
+				Obj()).
+			Obj(),
+		*utiltesting.MakeWorkload("other", "team-b").
+			Queue("lq-b").
+			Request(corev1.ResourceCPU, "4").
+			ReserveQuota(utiltesting.MakeAdmission("cq").
+				PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "default", "4").
+					Obj()).
+				Obj()).
+			Obj(),
+	}
+
+	cases := map[string]struct {
+		reservations  []*kueue.Reservation
+		workload      *kueue.Workload
+		usage         resources.FlavorResourceQuantities
+		wantConflicts []string
+	}{
+		"no reservations": {
+			workload: utiltesting.MakeWorkload("wl", "team-b").Queue("lq-b").Obj(),
+			usage:    resources.FlavorResourceQuantities{cpu: 4_000},
+		},
+		"workload of another user would use the reserved capacity": {
+			reservations: []*kueue.Reservation{
+				utiltesting.MakeReservation("r1", "cq").
+					Holder("team-a").
+					Window(now.Add(-time.Hour), now.Add(time.Hour)).
+					Resource("default", corev1.ResourceCPU, "5").
+					Obj(),
+			},
+			workload:      utiltesting.MakeWorkload("wl", "team-b").Queue("lq-b").Obj(),
+			usage:         resources.FlavorResourceQuantities{cpu: 2_000},
+			wantConflicts: []string{"r1"},
+		},
+		"workload of another user fits next to the capacity not yet used by the holder": {
+			reservations: []*kueue.Reservation{
+				utiltesting.MakeReservation("r1", "cq").
+					Holder("team-a").
+					Window(now.Add(-time.Hour), now.Add(time.Hour)).
+					Resource("default", corev1.ResourceCPU, "5").
+					Obj(),
+			},
+			workload: utiltesting.MakeWorkload("wl", "team-b").Queue("lq-b").Obj(),
+			usage:    resources.FlavorResourceQuantities{cpu: 1_000},
+		},
+		"workload of the holder can use the reserved capacity": {
+			reservations: []*kueue.Reservation{
+				utiltesting.MakeReservation("r1", "cq").
+					Holder("team-a").
+					Window(now.Add(-time.Hour), now.Add(time.Hour)).
+					Resource("default", corev1.ResourceCPU, "5").
+					Obj(),
+			},
+			workload: utiltesting.MakeWorkload("wl", "team-a").Queue("lq-c").Obj(),
+			usage:    resources.FlavorResourceQuantities{cpu: 4_000},
+		},
+		"workload in the holder's namespace, but another LocalQueue": {
+			reservations: []*kueue.Reservation{
+				utiltesting.MakeReservation("r1", "cq").
+					Holder("team-a", "lq-a").
+					Window(now.Add(-time.Hour), now.Add(time.Hour)).
+					Resource("default", corev1.ResourceCPU, "5").
+					Obj(),
+			},
+			workload:      utiltesting.MakeWorkload("wl", "team-a").Queue("lq-c").Obj(),
+			usage:         resources.FlavorResourceQuantities{cpu: 2_000},
+			wantConflicts: []string{"r1"},
+		},
+		"reservation not started yet": {
+			reservations: []*kueue.Reservation{
+				utiltesting.MakeReservation("r1", "cq").
+					Holder("team-a").
+					Window(now.Add(time.Hour), now.Add(2*time.Hour)).
+					Resource("default", corev1.ResourceCPU, "5").
+					Obj(),
+			},
+			workload: utiltesting.MakeWorkload("wl", "team-b").Queue("lq-b").Obj(),
+			usage:    resources.FlavorResourceQuantities{cpu: 4_000},
+		},
+		"reservation expired": {
+			reservations: []*kueue.Reservation{
+				utiltesting.MakeReservation("r1", "cq").
+					Holder("team-a").
+					Window(now.Add(-2*time.Hour), now).
+					Resource("default", corev1.ResourceCPU, "5").
+					Obj(),
+			},
+			workload: utiltesting.MakeWorkload("wl", "team-b").Queue("lq-b").Obj(),
+			usage:    resources.FlavorResourceQuantities{cpu: 4_000},
+		},
+		"reservation in another ClusterQueue": {
+			reservations: []*kueue.Reservation{
+				utiltesting.MakeReservation("r1", "other-cq").
+					Holder("team-a").
+					Window(now.Add(-time.Hour), now.Add(time.Hour)).
+					Resource("default", corev1.ResourceCPU, "5").
+					Obj(),
+			},
+			workload: utiltesting.MakeWorkload("wl", "team-b").Queue("lq-b").Obj(),
+			usage:    resources.FlavorResourceQuantities{cpu: 4_000},
+		},
+		"multiple reservations add up": {
+			reservations: []*kueue.Reservation{
+				utiltesting.MakeReservation("r1", "cq").
+					Holder("team-a").
+					Window(now.Add(-time.Hour), now.Add(time.Hour)).
+					Resource("default", corev1.ResourceCPU, "3").
+					Obj(),
+				utiltesting.MakeReservation("r2", "cq").
+					Holder("team-c").
+					Window(now.Add(-time.Hour), now.Add(time.Hour)).
+					Resource("default", corev1.ResourceCPU, "2").
+					Obj(),
+			},
+			workload:      utiltesting.MakeWorkload("wl", "team-b").Queue("lq-b").Obj(),
+			usage:         resources.FlavorResourceQuantities{cpu: 2_000},
+			wantConflicts: []string{"r1", "r2"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.AdvanceReservations, true)
+			ctx, log := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().WithLists(&kueue.WorkloadList{Items: admitted}).Build()
+
+			cache := New(cl)
+			cache.AddOrUpdateResourceFlavor(log, utiltesting.MakeResourceFlavor("default").Obj())
+			cq := utiltesting.MakeClusterQueue("cq").
+				ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+				Obj()
+			if err := cache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Couldn't add ClusterQueue to cache: %v", err)
+			}
+			for _, r := range tc.reservations {
+				cache.AddOrUpdateReservation(r)
+			}
+
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error while building snapshot: %v", err)
+			}
+			got := snapshot.ClusterQueue("cq").ReservationConflicts(tc.workload, tc.usage, now)
+			if diff := cmp.Diff(tc.wantConflicts, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected conflicts (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package scheduler
 
 import (
+	"math"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
@@ -47,6 +49,34 @@ type ResourceQuota struct {
 	Nominal        int64
 	BorrowingLimit *int64
 	LendingLimit   *int64
+	// PeerBorrowingLimits caps the quota which can be borrowed from
+	// specific ClusterQueues in the cohort.
+	PeerBorrowingLimits map[kueue.ClusterQueueReference]int64
+	// ReservedHeadroom is the quota kept unused by the workloads below
+	// the headroom priority threshold of the ClusterQueue.
+	ReservedHeadroom int64
+	// MaxRatio limits the usage of the resource per unit of the usage of
+	// another resource of the flavor.
+	MaxRatio *ResourceRatio
+}
+
+// ResourceRatio is the maximum usage of a resource per unit of the usage of
+// the PerResource.
+type ResourceRatio struct {
+	PerResource corev1.ResourceName
+	Quantity    int64
+}
+
+// overcommitQuota scales the quota by the percent. The quota is divided before
+// the multiplication, and the result is capped, so that large quantities, like
+// the memory in bytes, don't overflow.
+func overcommitQuota(quota int64, percent int32) int64 {
+	p := int64(percent)
+	q, r := quota/100, quota%100
+	if p > 0 && q > (math.MaxInt64-r*p/100)/p {
+		return math.MaxInt64
+	}
+	return q*p + r*p/100
 }
 
 func createResourceQuotas(kueueRgs []kueue.ResourceGroup) map[resources.FlavorResource]ResourceQuota {
@@ -61,12 +91,30 @@ func createResourceQuotas(kueueRgs []kueue.ResourceGroup) map[resources.FlavorRe
 				quota := ResourceQuota{
 					Nominal: resources.ResourceValue(kueueQuota.Name, kueueQuota.NominalQuota),
 				}
+				if features.Enabled(features.FlavorQuotaOvercommit) && kueueQuota.OvercommitPercent != nil {
+					quota.Nominal = overcommitQuota(quota.Nominal, *kueueQuota.OvercommitPercent)
+				}
 				if kueueQuota.BorrowingLimit != nil {
 					quota.BorrowingLimit = ptr.To(resources.ResourceValue(kueueQuota.Name, *kueueQuota.BorrowingLimit))
 				}
 				if features.Enabled(features.LendingLimit) && kueueQuota.LendingLimit != nil {
 					quota.LendingLimit = ptr.To(resources.ResourceValue(kueueQuota.Name, *kueueQuota.LendingLimit))
 				}
+				if features.Enabled(features.ReservedHeadroom) && kueueQuota.ReservedHeadroom != nil {
+					quota.ReservedHeadroom = resources.ResourceValue(kueueQuota.Name, *kueueQuota.ReservedHeadroom)
+				}
+				if features.Enabled(features.FlavorResourceRatios) && kueueQuota.MaxRatio != nil {
+					quota.MaxRatio = &ResourceRatio{
+						PerResource: kueueQuota.MaxRatio.PerResource,
+						Quantity:    resources.ResourceValue(kueueQuota.Name, kueueQuota.MaxRatio.Quantity),
+					}
+				}
+				if features.Enabled(features.PeerBorrowingLimits) && len(kueueQuota.PeerBorrowingLimits) > 0 {
+					quota.PeerBorrowingLimits = make(map[kueue.ClusterQueueReference]int64, len(kueueQuota.PeerBorrowingLimits))
+					for _, l := range kueueQuota.PeerBorrowingLimits {
+						quota.PeerBorrowingLimits[l.ClusterQueue] = resources.ResourceValue(kueueQuota.Name, l.BorrowingLimit)
+					}
+				}
 				quotas[resources.FlavorResource{Flavor: kueueFlavor.Name, Resource: kueueQuota.Name}] = quota
 			}
 		}
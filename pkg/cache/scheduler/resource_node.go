@@ -40,6 +40,11 @@ type resourceNode struct {
 	// usage. For Cohorts, this is the sum of childrens'
 	// usages past childrens' localQuota.
 	Usage resources.FlavorResourceQuantities
+	// DecayedUsage is the usage of a ClusterQueue decayed over time,
+	// which is considered for Fair Sharing instead of the Usage when
+	// higher. It is only set in the snapshots of ClusterQueues, when
+	// the fairSharing.usageHalfLifeTime is configured.
+	DecayedUsage resources.FlavorResourceQuantities
 }
 
 func NewResourceNode() resourceNode {
@@ -57,6 +62,7 @@ func (r resourceNode) Clone() resourceNode {
 		Quotas:       r.Quotas,
 		SubtreeQuota: r.SubtreeQuota,
 		Usage:        maps.Clone(r.Usage),
+		DecayedUsage: r.DecayedUsage,
 	}
 }
 
@@ -0,0 +1,58 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"cmp"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"sigs.k8s.io/kueue/pkg/resources"
+)
+
+// RatioLimit returns the maximum usage of the flavor resource allowed by its
+// maxRatio, if the given usage was added to the ClusterQueue, and whether the
+// flavor resource has a maxRatio.
+func (c *ClusterQueueSnapshot) RatioLimit(fr resources.FlavorResource, usage resources.FlavorResourceQuantities) (int64, bool) {
+	ratio := c.QuotaFor(fr).MaxRatio
+	if ratio == nil {
+		return 0, false
+	}
+	perFr := resources.FlavorResource{Flavor: fr.Flavor, Resource: ratio.PerResource}
+	perUsage := c.ResourceNode.Usage[perFr] + usage[perFr]
+	perUnit := resources.ResourceValue(ratio.PerResource, resource.MustParse("1"))
+	return ratio.Quantity * perUsage / perUnit, true
+}
+
+// RatioViolations returns the flavor resources of which the usage would
+// exceed the maxRatio, if the given usage was added to the ClusterQueue.
+func (c *ClusterQueueSnapshot) RatioViolations(usage resources.FlavorResourceQuantities) []resources.FlavorResource {
+	var violations []resources.FlavorResource
+	for fr, q := range usage {
+		if q <= 0 {
+			continue
+		}
+		if limit, found := c.RatioLimit(fr, usage); found && c.ResourceNode.Usage[fr]+q > limit {
+			violations = append(violations, fr)
+		}
+	}
+	slices.SortFunc(violations, func(a, b resources.FlavorResource) int {
+		return cmp.Or(cmp.Compare(a.Flavor, b.Flavor), cmp.Compare(a.Resource, b.Resource))
+	})
+	return violations
+}
@@ -17,11 +17,13 @@ limitations under the License.
 package scheduler
 
 import (
+	"math"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/resources"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	"sigs.k8s.io/kueue/pkg/workload"
@@ -34,6 +36,8 @@ func TestAvailable(t *testing.T) {
 		usage                    map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities
 		wantAvailable            map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities
 		wantPotentiallyAvailable map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities
+		enablePeerBorrowing      bool
+		enableOvercommit         bool
 	}{
 		"base cqs": {
 			clusterQueues: []kueue.ClusterQueue{
@@ -95,6 +99,151 @@ func TestAvailable(t *testing.T) {
 				"cq2": {{Flavor: "red", Resource: "cpu"}: 30_000},
 			},
 		},
+		"cq with peer borrowing limits": {
+			enablePeerBorrowing: true,
+			clusterQueues: []kueue.ClusterQueue{
+				*utiltesting.MakeClusterQueue("batch").
+					Cohort("cohort").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas("red").Resource("cpu", "10").Obj(),
+					).Obj(),
+				*utiltesting.MakeClusterQueue("interactive").
+					Cohort("cohort").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas("red").Resource("cpu", "10").Obj(),
+					).Obj(),
+				*utiltesting.MakeClusterQueue("scavenger").
+					Cohort("cohort").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas("red").
+							ResourceQuotaWrapper("cpu").NominalQuota("0").PeerBorrowingLimit("interactive", "3").Append().
+							Obj(),
+					).Obj(),
+			},
+			usage: map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities{
+				"batch": {{Flavor: "red", Resource: "cpu"}: 4_000},
+			},
+			wantAvailable: map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities{
+				"batch":       {{Flavor: "red", Resource: "cpu"}: 16_000},
+				"interactive": {{Flavor: "red", Resource: "cpu"}: 16_000},
+				// only 3k of the interactive quota can be borrowed.
+				"scavenger": {{Flavor: "red", Resource: "cpu"}: 13_000},
+			},
+			wantPotentiallyAvailable: map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities{
+				"batch":       {{Flavor: "red", Resource: "cpu"}: 20_000},
+				"interactive": {{Flavor: "red", Resource: "cpu"}: 20_000},
+				"scavenger":   {{Flavor: "red", Resource: "cpu"}: 13_000},
+			},
+		},
+		"cq with peer borrowing limits, but feature disabled": {
+			clusterQueues: []kueue.ClusterQueue{
+				*utiltesting.MakeClusterQueue("batch").
+					Cohort("cohort").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas("red").Resource("cpu", "10").Obj(),
+					).Obj(),
+				*utiltesting.MakeClusterQueue("interactive").
+					Cohort("cohort").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas("red").Resource("cpu", "10").Obj(),
+					).Obj(),
+				*utiltesting.MakeClusterQueue("scavenger").
+					Cohort("cohort").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas("red").
+							ResourceQuotaWrapper("cpu").NominalQuota("0").PeerBorrowingLimit("interactive", "0").Append().
+							Obj(),
+					).Obj(),
+			},
+			wantAvailable: map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities{
+				"batch":       {{Flavor: "red", Resource: "cpu"}: 20_000},
+				"interactive": {{Flavor: "red", Resource: "cpu"}: 20_000},
+				"scavenger":   {{Flavor: "red", Resource: "cpu"}: 20_000},
+			},
+			wantPotentiallyAvailable: map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities{
+				"batch":       {{Flavor: "red", Resource: "cpu"}: 20_000},
+				"interactive": {{Flavor: "red", Resource: "cpu"}: 20_000},
+				"scavenger":   {{Flavor: "red", Resource: "cpu"}: 20_000},
+			},
+		},
+		"cq with peer borrowing limits and borrowingLimit": {
+			enablePeerBorrowing: true,
+			clusterQueues: []kueue.ClusterQueue{
+				*utiltesting.MakeClusterQueue("batch").
+					Cohort("cohort").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas("red").Resource("cpu", "10").Obj(),
+					).Obj(),
+				*utiltesting.MakeClusterQueue("interactive").
+					Cohort("cohort").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas("red").Resource("cpu", "10").Obj(),
+					).Obj(),
+				*utiltesting.MakeClusterQueue("scavenger").
+					Cohort("cohort").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas("red").
+							ResourceQuotaWrapper("cpu").NominalQuota("0").BorrowingLimit("6").PeerBorrowingLimit("interactive", "0").Append().
+							Obj(),
+					).Obj(),
+			},
+			usage: map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities{
+				"batch": {{Flavor: "red", Resource: "cpu"}: 8_000},
+			},
+			wantAvailable: map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities{
+				"batch":       {{Flavor: "red", Resource: "cpu"}: 12_000},
+				"interactive": {{Flavor: "red", Resource: "cpu"}: 12_000},
+				"scavenger":   {{Flavor: "red", Resource: "cpu"}: 6_000},
+			},
+			wantPotentiallyAvailable: map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities{
+				"batch":       {{Flavor: "red", Resource: "cpu"}: 20_000},
+				"interactive": {{Flavor: "red", Resource: "cpu"}: 20_000},
+				"scavenger":   {{Flavor: "red", Resource: "cpu"}: 6_000},
+			},
+		},
+		"overcommitted nominal quota": {
+			enableOvercommit: true,
+			clusterQueues: []kueue.ClusterQueue{
+				*utiltesting.MakeClusterQueue("cq1").
+					Cohort("cohort").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas("red").
+							ResourceQuotaWrapper("cpu").NominalQuota("10").OvercommitPercent(150).Append().
+							Obj(),
+					).Obj(),
+				*utiltesting.MakeClusterQueue("cq2").
+					Cohort("cohort").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas("red").Resource("cpu", "10").Obj(),
+					).Obj(),
+			},
+			usage: map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities{
+				"cq1": {{Flavor: "red", Resource: "cpu"}: 12_000},
+			},
+			wantAvailable: map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities{
+				"cq1": {{Flavor: "red", Resource: "cpu"}: 13_000},
+				"cq2": {{Flavor: "red", Resource: "cpu"}: 13_000},
+			},
+			wantPotentiallyAvailable: map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities{
+				"cq1": {{Flavor: "red", Resource: "cpu"}: 25_000},
+				"cq2": {{Flavor: "red", Resource: "cpu"}: 25_000},
+			},
+		},
+		"overcommit ignored when the feature is disabled": {
+			clusterQueues: []kueue.ClusterQueue{
+				*utiltesting.MakeClusterQueue("cq1").
+					ResourceGroup(
+						*utiltesting.MakeFlavorQuotas("red").
+							ResourceQuotaWrapper("cpu").NominalQuota("10").OvercommitPercent(150).Append().
+							Obj(),
+					).Obj(),
+			},
+			usage: map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities{
+				"cq1": {{Flavor: "red", Resource: "cpu"}: 4_000},
+			},
+			wantAvailable:            map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities{"cq1": {{Flavor: "red", Resource: "cpu"}: 6_000}},
+			wantPotentiallyAvailable: map[kueue.ClusterQueueReference]resources.FlavorResourceQuantities{"cq1": {{Flavor: "red", Resource: "cpu"}: 10_000}},
+		},
 		"cq borrows from cohort": {
 			clusterQueues: []kueue.ClusterQueue{
 				*utiltesting.MakeClusterQueue("cq1").
@@ -360,6 +509,8 @@ func TestAvailable(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.PeerBorrowingLimits, tc.enablePeerBorrowing)
+			features.SetFeatureGateDuringTest(t, features.FlavorQuotaOvercommit, tc.enableOvercommit)
 			ctx, log := utiltesting.ContextWithLog(t)
 			cache := New(utiltesting.NewFakeClient())
 			cache.AddOrUpdateResourceFlavor(log, utiltesting.MakeResourceFlavor("red").Obj())
@@ -451,3 +602,39 @@ func TestAvailable(t *testing.T) {
 		})
 	}
 }
+
+func TestOvercommitQuota(t *testing.T) {
+	cases := map[string]struct {
+		quota   int64
+		percent int32
+		want    int64
+	}{
+		"cpu": {
+			quota:   10_000,
+			percent: 150,
+			want:    15_000,
+		},
+		"quota not divisible by 100": {
+			quota:   1_005,
+			percent: 150,
+			want:    1_507,
+		},
+		"large quota overflowing before the division": {
+			quota:   4_000_000_000_000_000_000,
+			percent: 200,
+			want:    8_000_000_000_000_000_000,
+		},
+		"overflowing quota": {
+			quota:   math.MaxInt64 / 2,
+			percent: 1000,
+			want:    math.MaxInt64,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := overcommitQuota(tc.quota, tc.percent); got != tc.want {
+				t.Errorf("Unexpected quota, want %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
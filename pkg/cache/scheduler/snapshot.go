@@ -154,6 +154,13 @@ func (c *Cache) Snapshot(ctx context.Context, options ...SnapshotOption) (*Snaps
 			}
 		}
 	}
+	var physicalCapacity *PhysicalCapacity
+	if features.Enabled(features.FlavorPhysicalCapacity) {
+		var err error
+		if physicalCapacity, err = c.physicalCapacity(ctx); err != nil {
+			return nil, err
+		}
+	}
 	for _, cq := range c.hm.ClusterQueues() {
 		if !cq.Active() || (cq.HasParent() && hierarchy.HasCycle(cq.Parent())) {
 			snap.InactiveClusterQueueSets.Insert(cq.Name)
@@ -167,6 +174,13 @@ func (c *Cache) Snapshot(ctx context.Context, options ...SnapshotOption) (*Snaps
 		if cq.HasParent() {
 			snap.UpdateClusterQueueEdge(cq.Name, cq.Parent().Name)
 		}
+		cqSnapshot.PhysicalCapacity = physicalCapacity
+		if features.Enabled(features.FlavorCostOrdering) {
+			cqSnapshot.FlavorCosts = c.flavorCosts
+		}
+		if features.Enabled(features.AdvanceReservations) {
+			cqSnapshot.Reservations = c.reservationsForClusterQueue(cq.Name)
+		}
 		if features.Enabled(features.TopologyAwareScheduling) {
 			for tasFlv, s := range tasSnapshots {
 				if cq.flavorInUse(tasFlv) {
@@ -175,6 +189,13 @@ func (c *Cache) Snapshot(ctx context.Context, options ...SnapshotOption) (*Snaps
 			}
 		}
 	}
+	if features.Enabled(features.PeerBorrowingLimits) {
+		// The limits depend on the quotas of the peers, so they can only be
+		// applied once the whole hierarchy is in the snapshot.
+		for _, cq := range snap.ClusterQueues() {
+			cq.applyPeerBorrowingLimits()
+		}
+	}
 	// Shallow copy is enough
 	maps.Copy(snap.ResourceFlavors, c.resourceFlavors)
 	return &snap, nil
@@ -189,6 +210,9 @@ func (c *Cache) snapshotClusterQueue(ctx context.Context, cq *clusterQueue, afsE
 		ResourceGroups:                make([]ResourceGroup, len(cq.ResourceGroups)),
 		FlavorFungibility:             cq.FlavorFungibility,
 		FairWeight:                    cq.FairWeight,
+		HeadroomPriorityThreshold:     cq.HeadroomPriorityThreshold,
+		TopologyPlacementPolicy:       cq.TopologyPlacementPolicy,
+		PhysicalCapacityPolicy:        cq.PhysicalCapacityPolicy,
 		AllocatableResourceGeneration: cq.AllocatableResourceGeneration,
 		Workloads:                     maps.Clone(cq.Workloads),
 		Preemption:                    cq.Preemption,
@@ -203,6 +227,9 @@ func (c *Cache) snapshotClusterQueue(ctx context.Context, cq *clusterQueue, afsE
 	for i, rg := range cq.ResourceGroups {
 		cc.ResourceGroups[i] = rg.Clone()
 	}
+	if c.fairSharingEnabled && cq.usageDecay != nil {
+		cc.ResourceNode.DecayedUsage = cq.usageDecay.at(c.clock.Now(), cq.resourceNode.Usage)
+	}
 	if afs.Enabled(c.admissionFairSharing) {
 		if cq.AdmissionScope != nil {
 			cc.AdmissionScope = *cq.AdmissionScope.DeepCopy()
@@ -21,6 +21,8 @@ import (
 	"slices"
 	"sync"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
@@ -33,6 +35,9 @@ type tasCache struct {
 	flavors     map[kueue.ResourceFlavorReference]flavorInformation
 	topologies  map[kueue.TopologyReference]topologyInformation
 	flavorCache map[kueue.ResourceFlavorReference]*TASFlavorCache
+
+	// hostScorer evaluates the host-local constraints of the pods, if set.
+	hostScorer utiltas.HostScorer
 }
 
 func NewTASCache(client client.Client) tasCache {
@@ -78,16 +83,30 @@ func (t *tasCache) AddTopology(topology *kueue.Topology) {
 	t.Lock()
 	defer t.Unlock()
 	name := kueue.TopologyReference(topology.Name)
-	if _, ok := t.topologies[name]; !ok {
-		tInfo := topologyInformation{
-			Levels: utiltas.Levels(topology),
-		}
-		t.topologies[name] = tInfo
-		for fName, flavorInfo := range t.flavors {
-			if flavorInfo.TopologyName == name {
-				t.flavorCache[fName] = t.NewTASFlavorCache(tInfo, flavorInfo)
+	tInfo := topologyInformation{
+		Levels:          utiltas.Levels(topology),
+		PlacementPolicy: topology.Spec.PlacementPolicy,
+		DomainHealth:    topology.Spec.DomainHealth,
+	}
+	if existing, ok := t.topologies[name]; ok {
+		// The levels are immutable, only the placement policy and the domain
+		// health can be updated.
+		if !ptr.Equal(existing.PlacementPolicy, tInfo.PlacementPolicy) || !equality.Semantic.DeepEqual(existing.DomainHealth, tInfo.DomainHealth) {
+			t.topologies[name] = tInfo
+			for fName, flavorInfo := range t.flavors {
+				if flavorInfo.TopologyName == name && t.flavorCache[fName] != nil {
+					t.flavorCache[fName].setPlacementPolicy(tInfo.PlacementPolicy)
+					t.flavorCache[fName].setDomainHealth(tInfo.DomainHealth)
+				}
 			}
 		}
+		return
+	}
+	t.topologies[name] = tInfo
+	for fName, flavorInfo := range t.flavors {
+		if flavorInfo.TopologyName == name {
+			t.flavorCache[fName] = t.NewTASFlavorCache(tInfo, flavorInfo)
+		}
 	}
 }
 
@@ -32,6 +32,7 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/controller/tas/indexer"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/resources"
 	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
 	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
@@ -71,6 +72,13 @@ type topologyInformation struct {
 	// levels is a list of levels defined in the Topology object referenced
 	// by the flavor corresponding to the cache.
 	Levels []string
+
+	// PlacementPolicy is the placement policy of the Topology object.
+	PlacementPolicy *kueue.TopologyPlacementPolicy
+
+	// DomainHealth is the configuration of the exclusion of the unhealthy
+	// domains of the Topology object.
+	DomainHealth *kueue.TopologyDomainHealth
 }
 
 type TASFlavorCache struct {
@@ -88,6 +96,13 @@ type TASFlavorCache struct {
 
 	// usage maintains the usage per topology domain
 	usage map[utiltas.TopologyDomainID]resources.Requests
+
+	// exclusiveUsage maintains, per topology domain, the number of pods which
+	// require exclusive placement, keyed by the exclusive topology level.
+	exclusiveUsage map[utiltas.TopologyDomainID]map[string]int32
+
+	// hostScorer evaluates the host-local constraints of the pods, if set.
+	hostScorer utiltas.HostScorer
 }
 
 func (t *tasCache) NewTASFlavorCache(topologyInfo topologyInformation,
@@ -97,6 +112,9 @@ func (t *tasCache) NewTASFlavorCache(topologyInfo topologyInformation,
 		topology: topologyInfo,
 		flavor:   flavorInfo,
 		usage:    make(map[utiltas.TopologyDomainID]resources.Requests),
+
+		exclusiveUsage: make(map[utiltas.TopologyDomainID]map[string]int32),
+		hostScorer:     t.hostScorer,
 	}
 }
 
@@ -107,23 +125,61 @@ func (c *TASFlavorCache) snapshot(ctx context.Context) (*TASFlavorSnapshot, erro
 	var requiredLabels client.MatchingLabels = maps.Clone(c.flavor.NodeLabels)
 	var requiredLabelKeys client.HasLabels = slices.Clone(c.topology.Levels)
 
-	err := c.client.List(ctx, nodes, requiredLabels, requiredLabelKeys, client.MatchingFields{
-		indexer.ReadyNode:       "true",
-		indexer.SchedulableNode: "true",
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list nodes for TAS: %w", err)
+	if domainHealth := c.domainHealth(); domainHealth != nil {
+		// The nodes which are not ready are needed to find the unhealthy
+		// domains.
+		if err := c.client.List(ctx, nodes, requiredLabels, requiredLabelKeys); err != nil {
+			return nil, fmt.Errorf("failed to list nodes for TAS: %w", err)
+		}
+		nodes.Items = excludeUnhealthyDomains(domainHealth, nodes.Items)
+	} else {
+		err := c.client.List(ctx, nodes, requiredLabels, requiredLabelKeys, client.MatchingFields{
+			indexer.ReadyNode:       "true",
+			indexer.SchedulableNode: "true",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list nodes for TAS: %w", err)
+		}
 	}
 	podListOpts := &client.ListOptions{}
 	podListOpts.FieldSelector = fields.OneTermEqualSelector(indexer.TASKey, "false")
 	pods := corev1.PodList{}
-	err = c.client.List(ctx, &pods, podListOpts)
-	if err != nil {
+	if err := c.client.List(ctx, &pods, podListOpts); err != nil {
 		return nil, fmt.Errorf("failed to list non-TAS pods which are bound to nodes: %w", err)
 	}
 	return c.snapshotForNodes(log, nodes.Items, pods.Items), nil
 }
 
+func (c *TASFlavorCache) setPlacementPolicy(policy *kueue.TopologyPlacementPolicy) {
+	c.Lock()
+	defer c.Unlock()
+	c.topology.PlacementPolicy = policy
+}
+
+func (c *TASFlavorCache) setDomainHealth(health *kueue.TopologyDomainHealth) {
+	c.Lock()
+	defer c.Unlock()
+	c.topology.DomainHealth = health
+}
+
+func (c *TASFlavorCache) domainHealth() *utiltas.DomainHealth {
+	c.RLock()
+	defer c.RUnlock()
+	if !features.Enabled(features.TASUnhealthyDomainExclusion) {
+		return nil
+	}
+	return utiltas.NewDomainHealth(c.topology.Levels, c.topology.DomainHealth)
+}
+
+// excludeUnhealthyDomains returns the ready and schedulable nodes which are
+// not in the unhealthy domains.
+func excludeUnhealthyDomains(domainHealth *utiltas.DomainHealth, nodes []corev1.Node) []corev1.Node {
+	unhealthyDomains := domainHealth.UnhealthyDomains(nodes)
+	return slices.DeleteFunc(nodes, func(node corev1.Node) bool {
+		return node.Spec.Unschedulable || !domainHealth.IsNodeHealthy(&node) || unhealthyDomains.Has(domainHealth.NodeDomain(&node))
+	})
+}
+
 func (c *TASFlavorCache) NodeLabels() map[string]string {
 	return c.flavor.NodeLabels
 }
@@ -143,6 +199,12 @@ func (c *TASFlavorCache) snapshotForNodes(log logr.Logger, nodes []corev1.Node,
 	log.V(3).Info("Constructing TAS snapshot", "nodeLabels", c.flavor.NodeLabels,
 		"levels", c.topology.Levels, "nodeCount", len(nodes), "podCount", len(pods))
 	snapshot := newTASFlavorSnapshot(log, c.flavor.TopologyName, c.topology.Levels, c.flavor.Tolerations)
+	if features.Enabled(features.TASPlacementPolicy) && c.topology.PlacementPolicy != nil {
+		snapshot.placementPolicy = *c.topology.PlacementPolicy
+	}
+	if features.Enabled(features.TASHostScorer) {
+		snapshot.hostScorer = c.hostScorer
+	}
 	nodeToDomain := make(map[string]utiltas.TopologyDomainID)
 	for _, node := range nodes {
 		nodeToDomain[node.Name] = snapshot.addNode(node)
@@ -151,6 +213,11 @@ func (c *TASFlavorCache) snapshotForNodes(log logr.Logger, nodes []corev1.Node,
 	for domainID, usage := range c.usage {
 		snapshot.addTASUsage(domainID, usage)
 	}
+	for domainID, levels := range c.exclusiveUsage {
+		for level, count := range levels {
+			snapshot.updateExclusiveUsage(domainID, level, count, add)
+		}
+	}
 	for _, pod := range pods {
 		// skip unscheduled or terminal pods as they don't use any capacity
 		if len(pod.Spec.NodeName) == 0 || utilpod.IsTerminated(&pod) {
@@ -189,5 +256,21 @@ func (c *TASFlavorCache) updateUsage(topologyRequests []workload.TopologyDomainR
 			c.usage[domainID].Add(tr.TotalRequests())
 			c.usage[domainID].Add(resources.Requests{corev1.ResourcePods: int64(tr.Count)})
 		}
+		if tr.ExclusiveLevel != "" {
+			c.updateExclusiveUsage(domainID, tr.ExclusiveLevel, tr.Count, op)
+		}
+	}
+}
+
+func (c *TASFlavorCache) updateExclusiveUsage(domainID utiltas.TopologyDomainID, level string, count int32, op usageOp) {
+	if c.exclusiveUsage[domainID] == nil {
+		c.exclusiveUsage[domainID] = make(map[string]int32)
+	}
+	c.exclusiveUsage[domainID][level] += int32(op.asSignedOne()) * count
+	if c.exclusiveUsage[domainID][level] <= 0 {
+		delete(c.exclusiveUsage[domainID], level)
+	}
+	if len(c.exclusiveUsage[domainID]) == 0 {
+		delete(c.exclusiveUsage, domainID)
 	}
 }
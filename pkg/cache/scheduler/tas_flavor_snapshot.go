@@ -30,6 +30,7 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
 	"k8s.io/utils/ptr"
 
@@ -85,6 +86,10 @@ type domain struct {
 	// levelValues stores the mapping from domain ID back to the
 	// ordered list of values
 	levelValues []string
+
+	// used is a temporary state of the topology domains during the assignment
+	// algorithm that denotes whether TAS workloads use the domain.
+	used bool
 }
 
 // leafDomain extends the domain with information for the lowest-level domain.
@@ -98,6 +103,10 @@ type leafDomain struct {
 	// tasUsage represents the usage associated with TAS workloads.
 	tasUsage resources.Requests
 
+	// exclusiveUsage represents the number of pods of TAS workloads which
+	// require exclusive placement, keyed by the exclusive topology level.
+	exclusiveUsage map[string]int32
+
 	// nodeTaints contains the list of taints for the node, only applies for
 	// lowest level of topology, if the lowest level is node
 	nodeTaints []corev1.Taint
@@ -135,6 +144,12 @@ type TASFlavorSnapshot struct {
 
 	// tolerations represents the list of tolerations defined for the resource flavor
 	tolerations []corev1.Toleration
+
+	// placementPolicy is the policy used to select the topology domains.
+	placementPolicy kueue.TopologyPlacementPolicy
+
+	// hostScorer evaluates the host-local constraints of the pods, if set.
+	hostScorer utiltas.HostScorer
 }
 
 func newTASFlavorSnapshot(log logr.Logger, topologyName kueue.TopologyReference,
@@ -284,6 +299,56 @@ func (s *TASFlavorSnapshot) removeTASUsage(domainID utiltas.TopologyDomainID, us
 	s.leaves[domainID].tasUsage.Sub(usage)
 }
 
+func (s *TASFlavorSnapshot) updateExclusiveUsage(domainID utiltas.TopologyDomainID, level string, count int32, op usageOp) {
+	leaf := s.leaves[domainID]
+	if leaf == nil {
+		s.log.V(3).Info("skip accounting for exclusive TAS usage in domain", "domain", domainID, "level", level)
+		return
+	}
+	if leaf.exclusiveUsage == nil {
+		leaf.exclusiveUsage = make(map[string]int32)
+	}
+	leaf.exclusiveUsage[level] += int32(op.asSignedOne()) * count
+	if leaf.exclusiveUsage[level] <= 0 {
+		delete(leaf.exclusiveUsage, level)
+	}
+}
+
+// exclusivelyBlockedDomains returns the IDs of the domains which cannot be
+// used by a PodSet because of exclusive placement. If exclusiveLevelIdx is
+// set, the PodSet requests exclusive placement at that level, so every domain
+// at that level which already runs TAS pods is blocked. Otherwise, every
+// domain held exclusively by another workload is blocked.
+func (s *TASFlavorSnapshot) exclusivelyBlockedDomains(exclusiveLevelIdx *int) sets.Set[utiltas.TopologyDomainID] {
+	blocked := sets.New[utiltas.TopologyDomainID]()
+	for _, leaf := range s.leaves {
+		if exclusiveLevelIdx != nil {
+			if leaf.tasUsage[corev1.ResourcePods] > 0 {
+				blocked.Insert(utiltas.DomainID(leaf.levelValues[:*exclusiveLevelIdx+1]))
+			}
+			continue
+		}
+		for level := range leaf.exclusiveUsage {
+			if levelIdx, found := s.resolveLevelIdx(level); found {
+				blocked.Insert(utiltas.DomainID(leaf.levelValues[:levelIdx+1]))
+			}
+		}
+	}
+	return blocked
+}
+
+func isExclusivelyBlocked(leaf *leafDomain, blocked sets.Set[utiltas.TopologyDomainID]) bool {
+	if len(blocked) == 0 {
+		return false
+	}
+	for idx := range leaf.levelValues {
+		if blocked.Has(utiltas.DomainID(leaf.levelValues[:idx+1])) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *TASFlavorSnapshot) freeCapacityPerDomain() map[utiltas.TopologyDomainID]resources.Requests {
 	freeCapacityPerDomain := make(map[utiltas.TopologyDomainID]resources.Requests, len(s.leaves))
 
@@ -353,6 +418,9 @@ type TASPodSetRequests struct {
 	Flavor            kueue.ResourceFlavorReference
 	Implied           bool
 	PodSetGroupName   *string
+	// PreferredAssignment is the topology assignment which is preferred for
+	// the PodSet, as long as it still fits.
+	PreferredAssignment *kueue.TopologyAssignment
 }
 
 func (t *TASPodSetRequests) TotalRequests() resources.Requests {
@@ -382,6 +450,11 @@ func (r TASAssignmentsResult) Failure() *FailureInfo {
 type tasPodSetAssignmentResult struct {
 	TopologyAssignment *kueue.TopologyAssignment
 	FailureReason      string
+
+	// AchievedTopologyLevel is the lowest topology level with a single domain
+	// containing the TopologyAssignment, for the PodSets requesting a preferred
+	// topology level.
+	AchievedTopologyLevel *string
 }
 
 type FlavorTASRequests []TASPodSetRequests
@@ -406,6 +479,8 @@ func (s *TASFlavorSnapshot) Fits(flavorUsage workload.TASFlavorUsage) bool {
 type findTopologyAssignmentsOption struct {
 	simulateEmpty bool
 	workload      *kueue.Workload
+
+	placementPolicy *kueue.TopologyPlacementPolicy
 }
 
 type FindTopologyAssignmentsOption func(*findTopologyAssignmentsOption)
@@ -424,6 +499,13 @@ func WithWorkload(wl *kueue.Workload) FindTopologyAssignmentsOption {
 	}
 }
 
+// WithPlacementPolicy overrides the placement policy of the topology.
+func WithPlacementPolicy(policy kueue.TopologyPlacementPolicy) FindTopologyAssignmentsOption {
+	return func(o *findTopologyAssignmentsOption) {
+		o.placementPolicy = &policy
+	}
+}
+
 // FindTopologyAssignmentsForFlavor returns TAS assignment, if possible, for all
 // the TAS requests in the flavor handled by the snapshot.
 func (s *TASFlavorSnapshot) FindTopologyAssignmentsForFlavor(flavorTASRequests FlavorTASRequests, options ...FindTopologyAssignmentsOption) TASAssignmentsResult {
@@ -431,6 +513,10 @@ func (s *TASFlavorSnapshot) FindTopologyAssignmentsForFlavor(flavorTASRequests F
 	for _, option := range options {
 		option(opts)
 	}
+	if opts.placementPolicy != nil {
+		defer func(policy kueue.TopologyPlacementPolicy) { s.placementPolicy = policy }(s.placementPolicy)
+		s.placementPolicy = *opts.placementPolicy
+	}
 
 	result := make(map[kueue.PodSetReference]tasPodSetAssignmentResult)
 	assumedUsage := make(map[utiltas.TopologyDomainID]resources.Requests)
@@ -487,9 +573,92 @@ func (s *TASFlavorSnapshot) FindTopologyAssignmentsForFlavor(flavorTASRequests F
 		}
 	}
 
+	if features.Enabled(features.TASAchievedTopologyLevel) {
+		for _, tr := range flavorTASRequests {
+			if tr.PodSet.TopologyRequest == nil || tr.PodSet.TopologyRequest.Preferred == nil {
+				continue
+			}
+			psResult := result[tr.PodSet.Name]
+			psResult.AchievedTopologyLevel = s.achievedTopologyLevel(psResult.TopologyAssignment)
+			result[tr.PodSet.Name] = psResult
+		}
+	}
 	return result
 }
 
+// achievedTopologyLevel returns the lowest topology level at which a single
+// domain contains all the domains of the assignment, or nil if the assignment
+// spans multiple domains of the highest level.
+func (s *TASFlavorSnapshot) achievedTopologyLevel(assignment *kueue.TopologyAssignment) *string {
+	if assignment == nil || len(assignment.Domains) == 0 {
+		return nil
+	}
+	var common []string
+	for i, domainAssignment := range assignment.Domains {
+		leaf, found := s.leaves[utiltas.DomainID(domainAssignment.Values)]
+		if !found {
+			return nil
+		}
+		if i == 0 {
+			common = leaf.levelValues
+			continue
+		}
+		prefix := 0
+		for prefix < len(common) && prefix < len(leaf.levelValues) && common[prefix] == leaf.levelValues[prefix] {
+			prefix++
+		}
+		common = common[:prefix]
+	}
+	if len(common) == 0 {
+		return nil
+	}
+	return ptr.To(s.levelKeys[len(common)-1])
+}
+
+// fitsPreferredAssignment returns true if the pods of the preferred assignment
+// still fit in its domains, as computed by fillInCounts, and the assignment
+// satisfies the required topology level.
+func (s *TASFlavorSnapshot) fitsPreferredAssignment(assignment *kueue.TopologyAssignment, count int32, required bool, levelKey string) bool {
+	if assignment == nil || len(assignment.Domains) == 0 {
+		return false
+	}
+	levelIdx := 0
+	if s.isLowestLevelNode() {
+		levelIdx = len(s.levelKeys) - 1
+	}
+	if !slices.Equal(assignment.Levels, s.levelKeys[levelIdx:]) {
+		return false
+	}
+	var total int32
+	for _, domainAssignment := range assignment.Domains {
+		leaf, found := s.leaves[utiltas.DomainID(domainAssignment.Values)]
+		if !found || leaf.state < domainAssignment.Count {
+			return false
+		}
+		total += domainAssignment.Count
+	}
+	if total != count {
+		return false
+	}
+	return !required || s.AssignmentDomains(assignment, levelKey).Len() == 1
+}
+
+// AssignmentDomains returns the IDs of the domains of the level which
+// contain the domains of the topology assignment.
+func (s *TASFlavorSnapshot) AssignmentDomains(assignment *kueue.TopologyAssignment, level string) sets.Set[utiltas.TopologyDomainID] {
+	domains := sets.New[utiltas.TopologyDomainID]()
+	levelIdx, found := s.resolveLevelIdx(level)
+	if assignment == nil || !found {
+		return domains
+	}
+	for _, domainAssignment := range assignment.Domains {
+		if leaf, found := s.leaves[utiltas.DomainID(domainAssignment.Values)]; found {
+			domains.Insert(utiltas.DomainID(leaf.levelValues[:levelIdx+1]))
+		}
+	}
+	return domains
+}
+
 func findLeaderAndWorkers(trs FlavorTASRequests) (*TASPodSetRequests, TASPodSetRequests) {
 	var leader *TASPodSetRequests = nil
 
@@ -716,6 +885,38 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 		return nil, fmt.Sprintf("podset slice topology %s is above the podset topology %s", sliceTopologyKey, *topologyKey)
 	}
 
+	// For the balanced placement, each domain at the balanced level gets a
+	// single slice of count/domainCount pods.
+	var maxSlicesPerDomain int32
+	if balancedKey, domainCount := workload.BalancedTopology(workersTasPodSetRequests.PodSet); balancedKey != "" {
+		if count%domainCount != 0 {
+			return nil, fmt.Sprintf("podset count %d is not a multiple of the balanced domain count %d", count, domainCount)
+		}
+		balancedLevelIdx, found := s.resolveLevelIdx(balancedKey)
+		if !found {
+			return nil, fmt.Sprintf("no requested topology level for balanced placement: %s", balancedKey)
+		}
+		if levelIdx > balancedLevelIdx {
+			return nil, fmt.Sprintf("podset balanced topology %s is above the podset topology %s", balancedKey, *topologyKey)
+		}
+		sliceSize = count / domainCount
+		sliceLevelIdx = balancedLevelIdx
+		maxSlicesPerDomain = 1
+	}
+
+	var exclusiveLevelIdx *int
+	if exclusiveKey := workload.ExclusiveTopologyLevel(workersTasPodSetRequests.PodSet); exclusiveKey != "" {
+		idx, found := s.resolveLevelIdx(exclusiveKey)
+		if !found {
+			return nil, fmt.Sprintf("no requested topology level for exclusive placement: %s", exclusiveKey)
+		}
+		exclusiveLevelIdx = &idx
+	}
+	var exclusivelyBlocked sets.Set[utiltas.TopologyDomainID]
+	if features.Enabled(features.TASExclusivePlacement) && !simulateEmpty {
+		exclusivelyBlocked = s.exclusivelyBlockedDomains(exclusiveLevelIdx)
+	}
+
 	var selector labels.Selector
 	if s.isLowestLevelNode() {
 		sel, err := labels.ValidatedSelectorFromSet(podSetNodeSelectors)
@@ -728,17 +929,27 @@ func (s *TASFlavorSnapshot) findTopologyAssignment(
 	}
 	// phase 1 - determine the number of pods and slices which can fit in each topology domain
 	s.fillInCounts(
+		workersTasPodSetRequests.PodSet,
 		requests,
 		leaderRequests,
 		assumedUsage,
 		sliceSize,
 		sliceLevelIdx,
+		maxSlicesPerDomain,
 		simulateEmpty,
 		append(podSetTolerations, s.tolerations...),
 		selector,
 		requiredReplacementDomain,
+		exclusivelyBlocked,
 	)
 
+	if leaderTasPodSetRequests == nil && requiredReplacementDomain == "" && sliceSize == 1 && maxSlicesPerDomain == 0 &&
+		s.fitsPreferredAssignment(workersTasPodSetRequests.PreferredAssignment, count, required, *topologyKey) {
+		return map[kueue.PodSetReference]*kueue.TopologyAssignment{
+			workersTasPodSetRequests.PodSet.Name: workersTasPodSetRequests.PreferredAssignment.DeepCopy(),
+		}, ""
+	}
+
 	// phase 2a: determine the level at which the assignment is done along with
 	// the domains which can accommodate all pods/slices
 	fitLevelIdx, currFitDomain, reason := s.findLevelWithFitDomains(levelIdx, required, count, leaderCount, sliceSize, unconstrained)
@@ -974,11 +1185,11 @@ func (s *TASFlavorSnapshot) findLevelWithFitDomains(levelIdx int, required bool,
 	topDomain := sortedDomain[0]
 
 	sliceCount := podSetSize / sliceSize
-	if useBestFitAlgorithm(unconstrained) && topDomain.sliceStateWithLeader >= sliceCount && topDomain.leaderState >= leaderPodSetSize {
+	if s.useBestFitAlgorithm(unconstrained) && topDomain.sliceStateWithLeader >= sliceCount && topDomain.leaderState >= leaderPodSetSize {
 		// optimize the potentially last domain
 		topDomain = findBestFitDomainForSlices(sortedDomain, sliceCount, leaderPodSetSize)
 	}
-	if useLeastFreeCapacityAlgorithm(unconstrained) {
+	if s.useLeastFreeCapacityAlgorithm(unconstrained) {
 		for _, candidateDomain := range sortedDomain {
 			if candidateDomain.sliceState >= sliceCount {
 				return levelIdx, []*domain{candidateDomain}, ""
@@ -1007,7 +1218,7 @@ func (s *TASFlavorSnapshot) findLevelWithFitDomains(levelIdx int, required bool,
 		idx := 0
 		for ; remainingLeaderCount > 0 && idx < len(sortedDomain) && sortedDomain[idx].leaderState > 0; idx++ {
 			domain := sortedDomain[idx]
-			if useBestFitAlgorithm(unconstrained) && sortedDomain[idx].sliceStateWithLeader >= remainingSliceCount {
+			if s.useBestFitAlgorithm(unconstrained) && sortedDomain[idx].sliceStateWithLeader >= remainingSliceCount {
 				// optimize the last domain
 				domain = findBestFitDomainForSlices(sortedDomain[idx:], remainingSliceCount, remainingLeaderCount)
 			}
@@ -1025,7 +1236,7 @@ func (s *TASFlavorSnapshot) findLevelWithFitDomains(levelIdx int, required bool,
 		sortedDomain = s.sortedDomains(sortedDomain[idx:], unconstrained)
 		for idx := 0; remainingSliceCount > 0 && idx < len(sortedDomain) && sortedDomain[idx].sliceState > 0; idx++ {
 			domain := sortedDomain[idx]
-			if useBestFitAlgorithm(unconstrained) && sortedDomain[idx].sliceState >= remainingSliceCount {
+			if s.useBestFitAlgorithm(unconstrained) && sortedDomain[idx].sliceState >= remainingSliceCount {
 				// optimize the last domain
 				domain = findBestFitDomainForSlices(sortedDomain[idx:], remainingSliceCount, 0)
 			}
@@ -1041,14 +1252,18 @@ func (s *TASFlavorSnapshot) findLevelWithFitDomains(levelIdx int, required bool,
 	return levelIdx, []*domain{topDomain}, ""
 }
 
-func useBestFitAlgorithm(unconstrained bool) bool {
+func (s *TASFlavorSnapshot) useBestFitAlgorithm(unconstrained bool) bool {
 	// following the matrix from KEP#2724
-	return !useLeastFreeCapacityAlgorithm(unconstrained)
+	return !s.useLeastFreeCapacityAlgorithm(unconstrained)
 }
 
-func useLeastFreeCapacityAlgorithm(unconstrained bool) bool {
+func (s *TASFlavorSnapshot) useLeastFragmentationAlgorithm() bool {
+	return s.placementPolicy == kueue.TopologyPlacementPolicyLeastFragmentation
+}
+
+func (s *TASFlavorSnapshot) useLeastFreeCapacityAlgorithm(unconstrained bool) bool {
 	// following the matrix from KEP#2724
-	return features.Enabled(features.TASProfileLeastFreeCapacity) ||
+	return s.useLeastFragmentationAlgorithm() || features.Enabled(features.TASProfileLeastFreeCapacity) ||
 		(unconstrained && features.Enabled(features.TASProfileMixed))
 }
 
@@ -1068,7 +1283,7 @@ func useLeastFreeCapacityAlgorithm(unconstrained bool) bool {
 //     (use 1 for pods, the actual sliceSize for slices)
 //   - slices: whether we're distributing slices (true) or pods (false)
 func (s *TASFlavorSnapshot) consumeWithLeadersGeneric(domain *domain, remainingDomains []*domain, remainingPrimary *int32, remainingLeaderCount *int32, unconstrained bool, withLeader *int32, primary *int32, sliceSize int32, slices bool) (*domain, bool) {
-	if useBestFitAlgorithm(unconstrained) && *withLeader >= *remainingPrimary && domain.leaderState >= *remainingLeaderCount {
+	if s.useBestFitAlgorithm(unconstrained) && *withLeader >= *remainingPrimary && domain.leaderState >= *remainingLeaderCount {
 		// optimize the last domain
 		if slices {
 			domain = findBestFitDomainForSlices(remainingDomains, *remainingPrimary, *remainingLeaderCount)
@@ -1140,7 +1355,7 @@ func (s *TASFlavorSnapshot) updateCountsToMinimumGeneric(domains []*domain, coun
 
 		// No leaders remaining: handle tail without leaders
 		if slices {
-			if useBestFitAlgorithm(unconstrained) && dom.sliceState >= remainingPrimary {
+			if s.useBestFitAlgorithm(unconstrained) && dom.sliceState >= remainingPrimary {
 				// optimize the last domain
 				dom = findBestFitDomainForSlices(domains[i:], remainingPrimary, 0)
 			}
@@ -1158,7 +1373,7 @@ func (s *TASFlavorSnapshot) updateCountsToMinimumGeneric(domains []*domain, coun
 		}
 
 		// pods (slices=false)
-		if useBestFitAlgorithm(unconstrained) && dom.state >= remainingPrimary {
+		if s.useBestFitAlgorithm(unconstrained) && dom.state >= remainingPrimary {
 			// optimize the last domain
 			dom = findBestFitDomain(domains[i:], remainingPrimary, 0)
 		}
@@ -1221,13 +1436,19 @@ func (s *TASFlavorSnapshot) lowerLevelDomains(domains []*domain) []*domain {
 }
 
 func (s *TASFlavorSnapshot) sortedDomainsWithLeader(domains []*domain, unconstrained bool) []*domain {
-	isLeastFreeCapacity := useLeastFreeCapacityAlgorithm(unconstrained)
+	isLeastFreeCapacity := s.useLeastFreeCapacityAlgorithm(unconstrained)
+	isLeastFragmentation := s.useLeastFragmentationAlgorithm()
 	result := slices.Clone(domains)
 	slices.SortFunc(result, func(a, b *domain) int {
 		if a.leaderState != b.leaderState {
 			return cmp.Compare(b.leaderState, a.leaderState)
 		}
 
+		if isLeastFragmentation && a.used != b.used {
+			// Fill the domains already used before the others.
+			return compareUsed(a, b)
+		}
+
 		if a.sliceStateWithLeader != b.sliceStateWithLeader {
 			if isLeastFreeCapacity {
 				// Start from the domain with the least amount of free resources.
@@ -1251,12 +1472,19 @@ func (s *TASFlavorSnapshot) sortedDomainsWithLeader(domains []*domain, unconstra
 // The sorting criteria are:
 // - **BestFit**: `sliceState` (descending), `state` (ascending), `levelValues` (ascending)
 // - **LeastFreeCapacity**: `sliceState` (ascending), `state` (ascending), `levelValues` (ascending)
+// - **LeastFragmentation**: `used` (first), then as LeastFreeCapacity
 //
 // `state` is always sorted ascending. This prioritizes domains that can accommodate slices with minimal leftover pod capacity.
 func (s *TASFlavorSnapshot) sortedDomains(domains []*domain, unconstrained bool) []*domain {
-	isLeastFreeCapacity := useLeastFreeCapacityAlgorithm(unconstrained)
+	isLeastFreeCapacity := s.useLeastFreeCapacityAlgorithm(unconstrained)
+	isLeastFragmentation := s.useLeastFragmentationAlgorithm()
 	result := slices.Clone(domains)
 	slices.SortFunc(result, func(a, b *domain) int {
+		if isLeastFragmentation && a.used != b.used {
+			// Fill the domains already used before the others.
+			return compareUsed(a, b)
+		}
+
 		if a.sliceState != b.sliceState {
 			if isLeastFreeCapacity {
 				// Start from the domain with the least amount of free resources.
@@ -1275,16 +1503,27 @@ func (s *TASFlavorSnapshot) sortedDomains(domains []*domain, unconstrained bool)
 	return result
 }
 
+// compareUsed orders the domains used by TAS workloads first.
+func compareUsed(a, b *domain) int {
+	if a.used {
+		return -1
+	}
+	return 1
+}
+
 func (s *TASFlavorSnapshot) fillInCounts(
+	podSet *kueue.PodSet,
 	requests resources.Requests,
 	leaderRequests *resources.Requests,
 	assumedUsage map[utiltas.TopologyDomainID]resources.Requests,
 	sliceSize int32,
 	sliceLevelIdx int,
+	maxSlicesPerDomain int32,
 	simulateEmpty bool,
 	tolerations []corev1.Toleration,
 	selector labels.Selector,
-	requiredReplacementDomain utiltas.TopologyDomainID) {
+	requiredReplacementDomain utiltas.TopologyDomainID,
+	exclusivelyBlocked sets.Set[utiltas.TopologyDomainID]) {
 	for _, domain := range s.domains {
 		// cleanup the state in case some remaining values are present from computing
 		// assignments for previous PodSets.
@@ -1293,6 +1532,7 @@ func (s *TASFlavorSnapshot) fillInCounts(
 		domain.sliceState = 0
 		domain.sliceStateWithLeader = 0
 		domain.leaderState = 0
+		domain.used = false
 	}
 	for _, leaf := range s.leaves {
 		// 1. Check Tolerations against Node Taints
@@ -1315,6 +1555,12 @@ func (s *TASFlavorSnapshot) fillInCounts(
 			continue
 		}
 
+		// 4. Check if the leaf is not excluded because of exclusive placement
+		if isExclusivelyBlocked(leaf, exclusivelyBlocked) {
+			s.log.V(3).Info("excluding domain because of exclusive placement", "domainID", leaf.id)
+			continue
+		}
+
 		// isLowestLevelNode() is necessary because we gather node level information only when
 		// node is the lowest level of the topology
 		if s.isLowestLevelNode() && !selector.Matches(nodeLabelSet) {
@@ -1324,9 +1570,11 @@ func (s *TASFlavorSnapshot) fillInCounts(
 		remainingCapacity := leaf.freeCapacity.Clone()
 		if !simulateEmpty {
 			remainingCapacity.Sub(leaf.tasUsage)
+			leaf.used = hasUsage(leaf.tasUsage)
 		}
 		if leafAssumedUsage, found := assumedUsage[leaf.id]; found {
 			remainingCapacity.Sub(leafAssumedUsage)
+			leaf.used = leaf.used || hasUsage(leafAssumedUsage)
 		}
 		leaf.state = requests.CountIn(remainingCapacity)
 
@@ -1337,10 +1585,26 @@ func (s *TASFlavorSnapshot) fillInCounts(
 		}
 
 		leaf.stateWithLeader = requests.CountIn(remainingCapacity)
+
+		// 5. Limit the pods to the ones satisfying the host-local constraints
+		if s.hostScorer != nil && s.isLowestLevelNode() {
+			host := utiltas.HostInfo{Name: leaf.levelValues[len(leaf.levelValues)-1], Labels: leaf.nodeLabels}
+			leaf.state = s.hostScorer.FitPods(host, podSet, leaf.state)
+			leaf.stateWithLeader = s.hostScorer.FitPods(host, podSet, leaf.stateWithLeader)
+		}
 	}
 	for _, root := range s.roots {
-		root.state, root.sliceState, root.stateWithLeader, root.sliceStateWithLeader, root.leaderState = s.fillInCountsHelper(root, sliceSize, sliceLevelIdx, 0)
+		root.state, root.sliceState, root.stateWithLeader, root.sliceStateWithLeader, root.leaderState = s.fillInCountsHelper(root, sliceSize, sliceLevelIdx, maxSlicesPerDomain, 0)
+	}
+}
+
+func hasUsage(usage resources.Requests) bool {
+	for _, v := range usage {
+		if v > 0 {
+			return true
+		}
 	}
+	return false
 }
 
 func belongsToRequiredDomain(leaf *leafDomain, requiredReplacementDomain utiltas.TopologyDomainID) bool {
@@ -1352,13 +1616,13 @@ func belongsToRequiredDomain(leaf *leafDomain, requiredReplacementDomain utiltas
 	return strings.HasPrefix(string(utiltas.DomainID(leaf.levelValues)), string(requiredReplacementDomain))
 }
 
-func (s *TASFlavorSnapshot) fillInCountsHelper(domain *domain, sliceSize int32, sliceLevelIdx int, level int) (int32, int32, int32, int32, int32) {
+func (s *TASFlavorSnapshot) fillInCountsHelper(domain *domain, sliceSize int32, sliceLevelIdx int, maxSlicesPerDomain int32, level int) (int32, int32, int32, int32, int32) {
 	// logic for a leaf
 	if len(domain.children) == 0 {
 		if level == sliceLevelIdx {
 			// initialize the sliceState if leaf is the request slice level
-			domain.sliceState = domain.state / sliceSize
-			domain.sliceStateWithLeader = domain.stateWithLeader / sliceSize
+			domain.sliceState = capSlices(domain.state/sliceSize, maxSlicesPerDomain)
+			domain.sliceStateWithLeader = capSlices(domain.stateWithLeader/sliceSize, maxSlicesPerDomain)
 		}
 		return domain.state, domain.sliceState, domain.stateWithLeader, domain.sliceStateWithLeader, domain.leaderState
 	}
@@ -1371,7 +1635,7 @@ func (s *TASFlavorSnapshot) fillInCountsHelper(domain *domain, sliceSize int32,
 	leaderState := int32(0)
 
 	for _, child := range domain.children {
-		addChildrenCapacity, addChildrenSliceCapacity, addChildrenCapacityWithLeader, addChildrenSliceCapacityWithLeader, childLeaderState := s.fillInCountsHelper(child, sliceSize, sliceLevelIdx, level+1)
+		addChildrenCapacity, addChildrenSliceCapacity, addChildrenCapacityWithLeader, addChildrenSliceCapacityWithLeader, childLeaderState := s.fillInCountsHelper(child, sliceSize, sliceLevelIdx, maxSlicesPerDomain, level+1)
 		childrenCapacity += addChildrenCapacity
 		sliceCapacity += addChildrenSliceCapacity
 		if addChildrenCapacity-addChildrenCapacityWithLeader < minStateWithLeaderDifference {
@@ -1383,6 +1647,7 @@ func (s *TASFlavorSnapshot) fillInCountsHelper(domain *domain, sliceSize int32,
 		if childLeaderState > leaderState {
 			leaderState = childLeaderState
 		}
+		domain.used = domain.used || child.used
 	}
 	domain.state = childrenCapacity
 	domain.stateWithLeader = childrenCapacity - minStateWithLeaderDifference
@@ -1391,8 +1656,8 @@ func (s *TASFlavorSnapshot) fillInCountsHelper(domain *domain, sliceSize int32,
 
 	if level == sliceLevelIdx {
 		// initialize the sliceState for the requested slice level.
-		sliceCapacity = domain.state / sliceSize
-		sliceStateWithLeader = domain.stateWithLeader / sliceSize
+		sliceCapacity = capSlices(domain.state/sliceSize, maxSlicesPerDomain)
+		sliceStateWithLeader = capSlices(domain.stateWithLeader/sliceSize, maxSlicesPerDomain)
 	}
 	domain.sliceState = sliceCapacity
 	domain.sliceStateWithLeader = sliceStateWithLeader
@@ -1400,6 +1665,15 @@ func (s *TASFlavorSnapshot) fillInCountsHelper(domain *domain, sliceSize int32,
 	return domain.state, domain.sliceState, domain.stateWithLeader, domain.sliceStateWithLeader, domain.leaderState
 }
 
+// capSlices limits the number of slices fitting in a domain at the slice
+// level to maxSlicesPerDomain, unless it is 0.
+func capSlices(slices, maxSlicesPerDomain int32) int32 {
+	if maxSlicesPerDomain > 0 {
+		return min(slices, maxSlicesPerDomain)
+	}
+	return slices
+}
+
 func (s *TASFlavorSnapshot) notFitMessage(slicesFitCount, totalRequestsSlicesCount, sliceSize int32) string {
 	if sliceSize == 1 {
 		// each slice is a single pod, so let's refer to them as pods
@@ -17,15 +17,20 @@ limitations under the License.
 package scheduler
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/ptr"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/resources"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	"sigs.k8s.io/kueue/pkg/util/testingjobs/node"
 )
@@ -428,3 +433,662 @@ func TestHasLevel(t *testing.T) {
 		})
 	}
 }
+
+func TestFindTopologyAssignmentsExclusivePlacement(t *testing.T) {
+	const rackLabel = "cloud.com/topology-rack"
+	levels := []string{rackLabel, corev1.LabelHostname}
+
+	//      r1        r2
+	//    /    \    /    \
+	//   x1    x2  x3    x4
+	nodes := []corev1.Node{
+		*node.MakeNode("x1").Label(rackLabel, "r1").Label(corev1.LabelHostname, "x1").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+		*node.MakeNode("x2").Label(rackLabel, "r1").Label(corev1.LabelHostname, "x2").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+		*node.MakeNode("x3").Label(rackLabel, "r2").Label(corev1.LabelHostname, "x3").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+		*node.MakeNode("x4").Label(rackLabel, "r2").Label(corev1.LabelHostname, "x4").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+	}
+
+	type exclusiveUsage struct {
+		domain utiltas.TopologyDomainID
+		level  string
+		count  int32
+	}
+
+	testCases := map[string]struct {
+		disableExclusivePlacement bool
+		tasUsage                  map[utiltas.TopologyDomainID]resources.Requests
+		exclusiveUsage            []exclusiveUsage
+		topologyRequest           *kueue.PodSetTopologyRequest
+		simulateEmpty             bool
+		wantAssignment            *kueue.TopologyAssignment
+		wantReason                string
+	}{
+		"exclusive podset avoids a rack with running TAS pods": {
+			tasUsage: map[utiltas.TopologyDomainID]resources.Requests{
+				"x1": {corev1.ResourceCPU: 1000, corev1.ResourcePods: 1},
+			},
+			topologyRequest: &kueue.PodSetTopologyRequest{
+				Required:                ptr.To(rackLabel),
+				PodSetExclusiveTopology: ptr.To(rackLabel),
+			},
+			wantAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 2, Values: []string{"x3"}}},
+			},
+		},
+		"exclusive podset is ignored when the feature gate is disabled": {
+			disableExclusivePlacement: true,
+			tasUsage: map[utiltas.TopologyDomainID]resources.Requests{
+				"x1": {corev1.ResourceCPU: 1000, corev1.ResourcePods: 1},
+			},
+			topologyRequest: &kueue.PodSetTopologyRequest{
+				Required:                ptr.To(rackLabel),
+				PodSetExclusiveTopology: ptr.To(rackLabel),
+			},
+			wantAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 2, Values: []string{"x2"}}},
+			},
+		},
+		"exclusive podset doesn't fit when all racks run TAS pods": {
+			tasUsage: map[utiltas.TopologyDomainID]resources.Requests{
+				"x1": {corev1.ResourceCPU: 1000, corev1.ResourcePods: 1},
+				"x4": {corev1.ResourceCPU: 1000, corev1.ResourcePods: 1},
+			},
+			topologyRequest: &kueue.PodSetTopologyRequest{
+				Required:                ptr.To(rackLabel),
+				PodSetExclusiveTopology: ptr.To(rackLabel),
+			},
+			wantReason: `topology "default" doesn't allow to fit any of 2 pod(s)`,
+		},
+		"exclusive podset fits into an occupied rack when simulating empty topology": {
+			tasUsage: map[utiltas.TopologyDomainID]resources.Requests{
+				"x1": {corev1.ResourceCPU: 1000, corev1.ResourcePods: 1},
+				"x4": {corev1.ResourceCPU: 1000, corev1.ResourcePods: 1},
+			},
+			topologyRequest: &kueue.PodSetTopologyRequest{
+				Required:                ptr.To(rackLabel),
+				PodSetExclusiveTopology: ptr.To(rackLabel),
+			},
+			simulateEmpty: true,
+			wantAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 2, Values: []string{"x1"}}},
+			},
+		},
+		"non-exclusive podset avoids a rack held exclusively": {
+			tasUsage: map[utiltas.TopologyDomainID]resources.Requests{
+				"x1": {corev1.ResourceCPU: 1000, corev1.ResourcePods: 1},
+			},
+			exclusiveUsage: []exclusiveUsage{{domain: "x1", level: rackLabel, count: 1}},
+			topologyRequest: &kueue.PodSetTopologyRequest{
+				Required: ptr.To(rackLabel),
+			},
+			wantAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 2, Values: []string{"x3"}}},
+			},
+		},
+		"exclusive level not present in the topology": {
+			topologyRequest: &kueue.PodSetTopologyRequest{
+				Required:                ptr.To(rackLabel),
+				PodSetExclusiveTopology: ptr.To("cloud.com/topology-block"),
+			},
+			wantReason: "no requested topology level for exclusive placement: cloud.com/topology-block",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TASExclusivePlacement, !tc.disableExclusivePlacement)
+			_, log := utiltesting.ContextWithLog(t)
+			snapshot := newTASFlavorSnapshot(log, "default", levels, nil)
+			for _, n := range nodes {
+				snapshot.addNode(n)
+			}
+			snapshot.initialize()
+			for domainID, usage := range tc.tasUsage {
+				snapshot.addTASUsage(domainID, usage)
+			}
+			for _, u := range tc.exclusiveUsage {
+				snapshot.updateExclusiveUsage(u.domain, u.level, u.count, add)
+			}
+
+			wantResult := TASAssignmentsResult{
+				"main": tasPodSetAssignmentResult{
+					TopologyAssignment: tc.wantAssignment,
+					FailureReason:      tc.wantReason,
+				},
+			}
+			gotResult := snapshot.FindTopologyAssignmentsForFlavor(FlavorTASRequests{{
+				PodSet: &kueue.PodSet{
+					Name:            "main",
+					TopologyRequest: tc.topologyRequest,
+				},
+				SinglePodRequests: resources.Requests{corev1.ResourceCPU: 1000},
+				Count:             2,
+			}}, WithSimulateEmpty(tc.simulateEmpty))
+			if diff := cmp.Diff(wantResult, gotResult); diff != "" {
+				t.Errorf("unexpected topology assignment (-want,+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFindTopologyAssignmentsPlacementPolicy(t *testing.T) {
+	const rackLabel = "cloud.com/topology-rack"
+	levels := []string{rackLabel, corev1.LabelHostname}
+
+	//      r1        r2
+	//    /    \    /    \
+	//   x1    x2  x3    x4
+	nodes := []corev1.Node{
+		*node.MakeNode("x1").Label(rackLabel, "r1").Label(corev1.LabelHostname, "x1").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+		*node.MakeNode("x2").Label(rackLabel, "r1").Label(corev1.LabelHostname, "x2").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+		*node.MakeNode("x3").Label(rackLabel, "r2").Label(corev1.LabelHostname, "x3").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+		*node.MakeNode("x4").Label(rackLabel, "r2").Label(corev1.LabelHostname, "x4").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+	}
+
+	testCases := map[string]struct {
+		placementPolicy kueue.TopologyPlacementPolicy
+		simulateEmpty   bool
+		wantAssignment  *kueue.TopologyAssignment
+	}{
+		"best fit selects the empty rack with the least free capacity": {
+			placementPolicy: kueue.TopologyPlacementPolicyBestFit,
+			wantAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 2, Values: []string{"x3"}}, {Count: 2, Values: []string{"x4"}}},
+			},
+		},
+		"least fragmentation fills the partially used rack first": {
+			placementPolicy: kueue.TopologyPlacementPolicyLeastFragmentation,
+			wantAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 3, Values: []string{"x1"}}, {Count: 1, Values: []string{"x2"}}},
+			},
+		},
+		"least fragmentation ignores the usage when simulating empty topology": {
+			placementPolicy: kueue.TopologyPlacementPolicyLeastFragmentation,
+			simulateEmpty:   true,
+			wantAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 2, Values: []string{"x3"}}, {Count: 2, Values: []string{"x4"}}},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TASPlacementPolicy, true)
+			_, log := utiltesting.ContextWithLog(t)
+			snapshot := newTASFlavorSnapshot(log, "default", levels, nil)
+			for _, n := range nodes {
+				snapshot.addNode(n)
+			}
+			snapshot.initialize()
+			snapshot.addTASUsage("x1", resources.Requests{corev1.ResourceCPU: 1000, corev1.ResourcePods: 1})
+
+			wantResult := TASAssignmentsResult{
+				"main": tasPodSetAssignmentResult{TopologyAssignment: tc.wantAssignment},
+			}
+			gotResult := snapshot.FindTopologyAssignmentsForFlavor(FlavorTASRequests{{
+				PodSet: &kueue.PodSet{
+					Name:            "main",
+					TopologyRequest: &kueue.PodSetTopologyRequest{Required: ptr.To(rackLabel)},
+				},
+				SinglePodRequests: resources.Requests{corev1.ResourceCPU: 1000},
+				Count:             4,
+			}}, WithSimulateEmpty(tc.simulateEmpty), WithPlacementPolicy(tc.placementPolicy))
+			if diff := cmp.Diff(wantResult, gotResult); diff != "" {
+				t.Errorf("unexpected topology assignment (-want,+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFindTopologyAssignmentsPreferredAssignment(t *testing.T) {
+	const rackLabel = "cloud.com/topology-rack"
+	levels := []string{rackLabel, corev1.LabelHostname}
+
+	//      r1        r2
+	//    /    \    /    \
+	//   x1    x2  x3    x4
+	nodes := []corev1.Node{
+		*node.MakeNode("x1").Label(rackLabel, "r1").Label(corev1.LabelHostname, "x1").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+		*node.MakeNode("x2").Label(rackLabel, "r1").Label(corev1.LabelHostname, "x2").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+		*node.MakeNode("x3").Label(rackLabel, "r2").Label(corev1.LabelHostname, "x3").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+		*node.MakeNode("x4").Label(rackLabel, "r2").Label(corev1.LabelHostname, "x4").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+	}
+	bestFitAssignment := &kueue.TopologyAssignment{
+		Levels:  []string{corev1.LabelHostname},
+		Domains: []kueue.TopologyDomainAssignment{{Count: 2, Values: []string{"x3"}}, {Count: 2, Values: []string{"x4"}}},
+	}
+
+	testCases := map[string]struct {
+		preferredAssignment *kueue.TopologyAssignment
+		wantAssignment      *kueue.TopologyAssignment
+	}{
+		"without preferred assignment": {
+			wantAssignment: bestFitAssignment,
+		},
+		"preferred assignment which fits is kept": {
+			preferredAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 1, Values: []string{"x1"}}, {Count: 3, Values: []string{"x2"}}},
+			},
+			wantAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 1, Values: []string{"x1"}}, {Count: 3, Values: []string{"x2"}}},
+			},
+		},
+		"preferred assignment exceeding the free capacity of a node is ignored": {
+			preferredAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 4, Values: []string{"x1"}}},
+			},
+			wantAssignment: bestFitAssignment,
+		},
+		"preferred assignment spanning the required level is ignored": {
+			preferredAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 2, Values: []string{"x2"}}, {Count: 2, Values: []string{"x3"}}},
+			},
+			wantAssignment: bestFitAssignment,
+		},
+		"preferred assignment with another pod count is ignored": {
+			preferredAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 2, Values: []string{"x2"}}},
+			},
+			wantAssignment: bestFitAssignment,
+		},
+		"preferred assignment on a removed node is ignored": {
+			preferredAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 4, Values: []string{"x5"}}},
+			},
+			wantAssignment: bestFitAssignment,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, log := utiltesting.ContextWithLog(t)
+			snapshot := newTASFlavorSnapshot(log, "default", levels, nil)
+			for _, n := range nodes {
+				snapshot.addNode(n)
+			}
+			snapshot.initialize()
+			snapshot.addTASUsage("x1", resources.Requests{corev1.ResourceCPU: 1000, corev1.ResourcePods: 1})
+
+			wantResult := TASAssignmentsResult{
+				"main": tasPodSetAssignmentResult{TopologyAssignment: tc.wantAssignment},
+			}
+			gotResult := snapshot.FindTopologyAssignmentsForFlavor(FlavorTASRequests{{
+				PodSet: &kueue.PodSet{
+					Name:            "main",
+					TopologyRequest: &kueue.PodSetTopologyRequest{Required: ptr.To(rackLabel)},
+				},
+				SinglePodRequests:   resources.Requests{corev1.ResourceCPU: 1000},
+				Count:               4,
+				PreferredAssignment: tc.preferredAssignment,
+			}})
+			if diff := cmp.Diff(wantResult, gotResult); diff != "" {
+				t.Errorf("unexpected topology assignment (-want,+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFindTopologyAssignmentsAchievedTopologyLevel(t *testing.T) {
+	const rackLabel = "cloud.com/topology-rack"
+	levels := []string{rackLabel, corev1.LabelHostname}
+
+	//      r1        r2
+	//    /    \    /    \
+	//   x1    x2  x3    x4
+	nodes := []corev1.Node{
+		*node.MakeNode("x1").Label(rackLabel, "r1").Label(corev1.LabelHostname, "x1").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+		*node.MakeNode("x2").Label(rackLabel, "r1").Label(corev1.LabelHostname, "x2").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+		*node.MakeNode("x3").Label(rackLabel, "r2").Label(corev1.LabelHostname, "x3").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+		*node.MakeNode("x4").Label(rackLabel, "r2").Label(corev1.LabelHostname, "x4").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+	}
+
+	testCases := map[string]struct {
+		disableAchievedLevel bool
+		topologyRequest      *kueue.PodSetTopologyRequest
+		count                int32
+		wantLevel            *string
+	}{
+		"the preferred level is achieved": {
+			topologyRequest: &kueue.PodSetTopologyRequest{Preferred: ptr.To(corev1.LabelHostname)},
+			count:           2,
+			wantLevel:       ptr.To(corev1.LabelHostname),
+		},
+		"the placement degrades to a higher level": {
+			topologyRequest: &kueue.PodSetTopologyRequest{Preferred: ptr.To(corev1.LabelHostname)},
+			count:           3,
+			wantLevel:       ptr.To(rackLabel),
+		},
+		"the pods are spread over multiple domains of the highest level": {
+			topologyRequest: &kueue.PodSetTopologyRequest{Preferred: ptr.To(rackLabel)},
+			count:           5,
+		},
+		"the level isn't recorded for required topology requests": {
+			topologyRequest: &kueue.PodSetTopologyRequest{Required: ptr.To(rackLabel)},
+			count:           3,
+		},
+		"the level isn't recorded when the feature gate is disabled": {
+			disableAchievedLevel: true,
+			topologyRequest:      &kueue.PodSetTopologyRequest{Preferred: ptr.To(corev1.LabelHostname)},
+			count:                3,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TASAchievedTopologyLevel, !tc.disableAchievedLevel)
+			_, log := utiltesting.ContextWithLog(t)
+			snapshot := newTASFlavorSnapshot(log, "default", levels, nil)
+			for _, n := range nodes {
+				snapshot.addNode(n)
+			}
+			snapshot.initialize()
+
+			gotResult := snapshot.FindTopologyAssignmentsForFlavor(FlavorTASRequests{{
+				PodSet: &kueue.PodSet{
+					Name:            "main",
+					TopologyRequest: tc.topologyRequest,
+				},
+				SinglePodRequests: resources.Requests{corev1.ResourceCPU: 1000},
+				Count:             tc.count,
+			}})
+			psResult := gotResult["main"]
+			if psResult.FailureReason != "" {
+				t.Fatalf("unexpected failure: %s", psResult.FailureReason)
+			}
+			if diff := cmp.Diff(tc.wantLevel, psResult.AchievedTopologyLevel); diff != "" {
+				t.Errorf("unexpected achieved topology level (-want,+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExcludeUnhealthyDomains(t *testing.T) {
+	const (
+		blockLabel = "cloud.com/topology-block"
+		rackLabel  = "cloud.com/topology-rack"
+		taintKey   = "example.com/gpu-degraded"
+	)
+	levels := []string{blockLabel, rackLabel, corev1.LabelHostname}
+
+	//            b1                   b2
+	//       /          \               |
+	//      r1           r2            r3
+	//    /    \       /    \        /    \
+	//   x1    x2     x3    x4      x5    x6
+	nodes := []corev1.Node{
+		*node.MakeNode("x1").Label(blockLabel, "b1").Label(rackLabel, "r1").Label(corev1.LabelHostname, "x1").Ready().Obj(),
+		*node.MakeNode("x2").Label(blockLabel, "b1").Label(rackLabel, "r1").Label(corev1.LabelHostname, "x2").NotReady().Obj(),
+		*node.MakeNode("x3").Label(blockLabel, "b1").Label(rackLabel, "r2").Label(corev1.LabelHostname, "x3").Ready().Obj(),
+		*node.MakeNode("x4").Label(blockLabel, "b1").Label(rackLabel, "r2").Label(corev1.LabelHostname, "x4").Ready().Unschedulable().Obj(),
+		*node.MakeNode("x5").Label(blockLabel, "b2").Label(rackLabel, "r3").Label(corev1.LabelHostname, "x5").Ready().Obj(),
+		*node.MakeNode("x6").Label(blockLabel, "b2").Label(rackLabel, "r3").Label(corev1.LabelHostname, "x6").Ready().
+			Taints(corev1.Taint{Key: taintKey, Effect: corev1.TaintEffectNoSchedule}).Obj(),
+	}
+
+	testCases := map[string]struct {
+		health    kueue.TopologyDomainHealth
+		wantNodes []string
+	}{
+		"the nodes are excluded up to the lowest level by default": {
+			health:    kueue.TopologyDomainHealth{},
+			wantNodes: []string{"x1", "x3", "x5", "x6"},
+		},
+		"the racks with a not ready node are excluded": {
+			health:    kueue.TopologyDomainHealth{Level: ptr.To(rackLabel)},
+			wantNodes: []string{"x3", "x5", "x6"},
+		},
+		"the racks with a node with an unhealthy taint are excluded": {
+			health: kueue.TopologyDomainHealth{
+				Level:                  ptr.To(rackLabel),
+				UnhealthyNodeTaintKeys: []string{taintKey},
+			},
+			wantNodes: []string{"x3"},
+		},
+		"the blocks with an unhealthy node are excluded": {
+			health: kueue.TopologyDomainHealth{
+				Level:                  ptr.To(blockLabel),
+				UnhealthyNodeTaintKeys: []string{taintKey},
+			},
+			wantNodes: nil,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			domainHealth := utiltas.NewDomainHealth(levels, &tc.health)
+			var gotNodes []string
+			for _, n := range excludeUnhealthyDomains(domainHealth, slices.Clone(nodes)) {
+				gotNodes = append(gotNodes, n.Name)
+			}
+			if diff := cmp.Diff(tc.wantNodes, gotNodes); diff != "" {
+				t.Errorf("unexpected nodes (-want,+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFindTopologyAssignmentsBalancedPlacement(t *testing.T) {
+	const (
+		blockLabel = "cloud.com/topology-block"
+		rackLabel  = "cloud.com/topology-rack"
+	)
+	levels := []string{blockLabel, rackLabel, corev1.LabelHostname}
+
+	//                     b1
+	//       /        /         \        \
+	//      r1       r2         r3       r4
+	//    /    \   /    \     /    \   /    \
+	//   x1    x2 x3    x4   x5    x6 x7    x8
+	var nodes []corev1.Node
+	for i := 1; i <= 8; i++ {
+		name := fmt.Sprintf("x%d", i)
+		nodes = append(nodes, *node.MakeNode(name).
+			Label(blockLabel, "b1").
+			Label(rackLabel, fmt.Sprintf("r%d", (i+1)/2)).
+			Label(corev1.LabelHostname, name).
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj())
+	}
+
+	testCases := map[string]struct {
+		disableBalancedPlacement bool
+		domainCount              int32
+		wantAssignment           *kueue.TopologyAssignment
+		wantReason               string
+	}{
+		"the pods are balanced across 4 racks": {
+			domainCount: 4,
+			wantAssignment: &kueue.TopologyAssignment{
+				Levels: []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{
+					{Count: 2, Values: []string{"x1"}},
+					{Count: 2, Values: []string{"x3"}},
+					{Count: 2, Values: []string{"x5"}},
+					{Count: 2, Values: []string{"x7"}},
+				},
+			},
+		},
+		"the pods are balanced across 2 racks and packed within each rack": {
+			domainCount: 2,
+			wantAssignment: &kueue.TopologyAssignment{
+				Levels: []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{
+					{Count: 4, Values: []string{"x1"}},
+					{Count: 4, Values: []string{"x3"}},
+				},
+			},
+		},
+		"the pods are packed when the feature gate is disabled": {
+			disableBalancedPlacement: true,
+			domainCount:              4,
+			wantAssignment: &kueue.TopologyAssignment{
+				Levels: []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{
+					{Count: 4, Values: []string{"x1"}},
+					{Count: 4, Values: []string{"x2"}},
+				},
+			},
+		},
+		"the pod count is not a multiple of the domain count": {
+			domainCount: 3,
+			wantReason:  "podset count 8 is not a multiple of the balanced domain count 3",
+		},
+		"not enough racks": {
+			domainCount: 8,
+			wantReason:  `topology "default" allows to fit only 4 out of 8 pod(s)`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TASBalancedPlacement, !tc.disableBalancedPlacement)
+			_, log := utiltesting.ContextWithLog(t)
+			snapshot := newTASFlavorSnapshot(log, "default", levels, nil)
+			for _, n := range nodes {
+				snapshot.addNode(n)
+			}
+			snapshot.initialize()
+
+			wantResult := TASAssignmentsResult{
+				"main": tasPodSetAssignmentResult{
+					TopologyAssignment: tc.wantAssignment,
+					FailureReason:      tc.wantReason,
+				},
+			}
+			gotResult := snapshot.FindTopologyAssignmentsForFlavor(FlavorTASRequests{{
+				PodSet: &kueue.PodSet{
+					Name: "main",
+					TopologyRequest: &kueue.PodSetTopologyRequest{
+						Required:                  ptr.To(blockLabel),
+						PodSetBalancedTopology:    ptr.To(rackLabel),
+						PodSetBalancedDomainCount: ptr.To(tc.domainCount),
+					},
+				},
+				SinglePodRequests: resources.Requests{corev1.ResourceCPU: 1000},
+				Count:             8,
+			}})
+			if diff := cmp.Diff(wantResult, gotResult); diff != "" {
+				t.Errorf("unexpected topology assignment (-want,+got): %s", diff)
+			}
+		})
+	}
+}
+
+// switchHostScorer is a HostScorer fitting at most podsPerSwitch pods of a
+// PodSet on a host, as the devices behind a single PCIe switch.
+type switchHostScorer struct {
+	utiltas.NoopHostScorer
+	podsPerSwitch int32
+}
+
+func (s *switchHostScorer) FitPods(_ utiltas.HostInfo, _ *kueue.PodSet, count int32) int32 {
+	return min(count, s.podsPerSwitch)
+}
+
+func TestFindTopologyAssignmentsHostScorer(t *testing.T) {
+	const rackLabel = "cloud.com/topology-rack"
+	levels := []string{rackLabel, corev1.LabelHostname}
+
+	//      r1
+	//    /    \
+	//   x1    x2
+	nodes := []corev1.Node{
+		*node.MakeNode("x1").Label(rackLabel, "r1").Label(corev1.LabelHostname, "x1").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+		*node.MakeNode("x2").Label(rackLabel, "r1").Label(corev1.LabelHostname, "x2").
+			StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4"), corev1.ResourcePods: resource.MustParse("10")}).
+			Ready().Obj(),
+	}
+
+	testCases := map[string]struct {
+		hostScorer     utiltas.HostScorer
+		wantAssignment *kueue.TopologyAssignment
+	}{
+		"the pods are packed on a host without a host scorer": {
+			wantAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 4, Values: []string{"x1"}}},
+			},
+		},
+		"the no-op host scorer doesn't change the assignment": {
+			hostScorer: utiltas.NoopHostScorer{},
+			wantAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 4, Values: []string{"x1"}}},
+			},
+		},
+		"the host scorer limits the pods placed on each host": {
+			hostScorer: &switchHostScorer{podsPerSwitch: 2},
+			wantAssignment: &kueue.TopologyAssignment{
+				Levels:  []string{corev1.LabelHostname},
+				Domains: []kueue.TopologyDomainAssignment{{Count: 2, Values: []string{"x1"}}, {Count: 2, Values: []string{"x2"}}},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, log := utiltesting.ContextWithLog(t)
+			snapshot := newTASFlavorSnapshot(log, "default", levels, nil)
+			snapshot.hostScorer = tc.hostScorer
+			for _, n := range nodes {
+				snapshot.addNode(n)
+			}
+			snapshot.initialize()
+
+			wantResult := TASAssignmentsResult{
+				"main": tasPodSetAssignmentResult{TopologyAssignment: tc.wantAssignment},
+			}
+			gotResult := snapshot.FindTopologyAssignmentsForFlavor(FlavorTASRequests{{
+				PodSet: &kueue.PodSet{
+					Name:            "main",
+					TopologyRequest: &kueue.PodSetTopologyRequest{Required: ptr.To(rackLabel)},
+				},
+				SinglePodRequests: resources.Requests{corev1.ResourceCPU: 1000},
+				Count:             4,
+			}})
+			if diff := cmp.Diff(wantResult, gotResult); diff != "" {
+				t.Errorf("unexpected topology assignment (-want,+got): %s", diff)
+			}
+		})
+	}
+}
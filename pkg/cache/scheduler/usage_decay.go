@@ -0,0 +1,82 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"maps"
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+
+	"sigs.k8s.io/kueue/pkg/resources"
+)
+
+// usageDecay tracks the usage of a ClusterQueue considered for Fair Sharing,
+// when the usage is configured to decay over time. The decayed usage is never
+// lower than the current usage and, once the usage goes down, it halves every
+// halfLife until it reaches the current usage.
+type usageDecay struct {
+	halfLife   time.Duration
+	clock      clock.Clock
+	usage      resources.FlavorResourceQuantities
+	lastUpdate time.Time
+}
+
+func newUsageDecay(halfLife time.Duration, clock clock.Clock) *usageDecay {
+	return &usageDecay{halfLife: halfLife, clock: clock, lastUpdate: clock.Now()}
+}
+
+// at returns the decayed usage at the given time, for the current usage.
+func (d *usageDecay) at(now time.Time, current resources.FlavorResourceQuantities) resources.FlavorResourceQuantities {
+	result := maps.Clone(current)
+	if result == nil {
+		result = make(resources.FlavorResourceQuantities)
+	}
+	factor := math.Pow(0.5, now.Sub(d.lastUpdate).Seconds()/d.halfLife.Seconds())
+	for fr, v := range d.usage {
+		if decayed := int64(float64(v) * factor); decayed > result[fr] {
+			result[fr] = decayed
+		}
+	}
+	return result
+}
+
+// update records the decayed usage at the current time. It must be called
+// before the current usage changes.
+func (d *usageDecay) update(current resources.FlavorResourceQuantities) {
+	now := d.clock.Now()
+	d.usage = d.at(now, current)
+	maps.DeleteFunc(d.usage, func(_ resources.FlavorResource, v int64) bool { return v <= 0 })
+	d.lastUpdate = now
+}
+
+// decayedUsageList aggregates the decayed usage per resource, across flavors.
+func decayedUsageList(frq resources.FlavorResourceQuantities) corev1.ResourceList {
+	perResource := make(map[corev1.ResourceName]int64)
+	for fr, v := range frq {
+		if v > 0 {
+			perResource[fr.Resource] += v
+		}
+	}
+	result := make(corev1.ResourceList, len(perResource))
+	for name, v := range perResource {
+		result[name] = resources.ResourceQuantity(name, v)
+	}
+	return result
+}
@@ -0,0 +1,139 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	testingclock "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestUsageDecay(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	cpu := resources.FlavorResource{Flavor: "default", Resource: corev1.ResourceCPU}
+	cases := map[string]struct {
+		recorded resources.FlavorResourceQuantities
+		current  resources.FlavorResourceQuantities
+		elapsed  time.Duration
+		want     resources.FlavorResourceQuantities
+	}{
+		"no recorded usage": {
+			current: resources.FlavorResourceQuantities{cpu: 2_000},
+			elapsed: time.Hour,
+			want:    resources.FlavorResourceQuantities{cpu: 2_000},
+		},
+		"recorded usage decays by a half after the half-life": {
+			recorded: resources.FlavorResourceQuantities{cpu: 8_000},
+			elapsed:  time.Hour,
+			want:     resources.FlavorResourceQuantities{cpu: 4_000},
+		},
+		"recorded usage decays by a quarter after two half-lives": {
+			recorded: resources.FlavorResourceQuantities{cpu: 8_000},
+			elapsed:  2 * time.Hour,
+			want:     resources.FlavorResourceQuantities{cpu: 2_000},
+		},
+		"decayed usage doesn't go below the current usage": {
+			recorded: resources.FlavorResourceQuantities{cpu: 8_000},
+			current:  resources.FlavorResourceQuantities{cpu: 3_000},
+			elapsed:  2 * time.Hour,
+			want:     resources.FlavorResourceQuantities{cpu: 3_000},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			fakeClock := testingclock.NewFakeClock(now)
+			d := newUsageDecay(time.Hour, fakeClock)
+			d.update(tc.recorded)
+			got := d.at(now.Add(tc.elapsed), tc.current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected decayed usage (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUsageWithDecayedUsage(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.FairSharingUsageDecay, true)
+	now := time.Now().Truncate(time.Second)
+	fakeClock := testingclock.NewFakeClock(now)
+	ctx, log := utiltesting.ContextWithLog(t)
+
+	cache := New(utiltesting.NewFakeClient(), WithFairSharing(true), WithFairSharingUsageHalfLife(time.Hour))
+	cache.clock = fakeClock
+	cache.AddOrUpdateResourceFlavor(log, utiltesting.MakeResourceFlavor("default").Obj())
+	cqs := []*kueue.ClusterQueue{
+		utiltesting.MakeClusterQueue("cq").
+			Cohort("cohort").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "0").Obj()).
+			Obj(),
+		utiltesting.MakeClusterQueue("lender").
+			Cohort("cohort").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+			Obj(),
+	}
+	for _, cq := range cqs {
+		if err := cache.AddClusterQueue(ctx, cq); err != nil {
+			t.Fatalf("Couldn't add ClusterQueue to cache: %v", err)
+		}
+	}
+	wl := utiltesting.MakeWorkload("wl", "ns").
+		ReserveQuota(utiltesting.MakeAdmission("cq").
+			PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+				Assignment(corev1.ResourceCPU, "default", "4").
+				Obj()).
+			Obj()).
+		Obj()
+	if !cache.AddOrUpdateWorkload(log, wl) {
+		t.Fatal("Failed to add the workload to the cache")
+	}
+	fakeClock.Step(time.Minute)
+	if err := cache.DeleteWorkload(log, wl); err != nil {
+		t.Fatalf("Failed to delete the workload: %v", err)
+	}
+	fakeClock.Step(time.Hour)
+
+	stats, err := cache.Usage(cqs[0])
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantDecayedUsage := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}
+	if diff := cmp.Diff(wantDecayedUsage, stats.DecayedUsage); diff != "" {
+		t.Errorf("Unexpected decayed usage (-want,+got):\n%s", diff)
+	}
+	// 2 CPUs borrowed out of the 10 lendable CPUs.
+	if stats.WeightedShare != 200 {
+		t.Errorf("Unexpected weighted share, want 200, got %d", stats.WeightedShare)
+	}
+
+	snapshot, err := cache.Snapshot(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error while building snapshot: %v", err)
+	}
+	share, _ := snapshot.ClusterQueue("cq").DominantResourceShare().roundedWeightedShare()
+	if share != 200 {
+		t.Errorf("Unexpected weighted share in snapshot, want 200, got %d", share)
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package capacity summarizes the capacity of the cohorts of the fleet, such
+// that capacity dashboards can poll a single endpoint instead of reconstructing
+// the state from the ClusterQueues, the Cohorts and the Workloads.
+package capacity
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/resources"
+)
+
+// Path is the path of the endpoint serving the snapshot, on the metrics server.
+const Path = "/capacity"
+
+// Snapshot is the capacity snapshot of the cohorts.
+type Snapshot struct {
+	Cohorts []CohortSnapshot `json:"cohorts"`
+}
+
+// CohortSnapshot is the capacity of a cohort, accumulated over the ClusterQueues
+// and the Cohorts of its subtree.
+type CohortSnapshot struct {
+	Name kueue.CohortReference `json:"name"`
+	// Parent is the parent of the cohort, empty for the root cohorts.
+	Parent kueue.CohortReference `json:"parent,omitempty"`
+	// ClusterQueues are the ClusterQueues of the subtree of the cohort.
+	ClusterQueues []kueue.ClusterQueueReference `json:"clusterQueues"`
+	// Flavors is the capacity of the cohort by flavor.
+	Flavors []FlavorCapacity `json:"flavors"`
+	// PendingWorkloads is the number of pending workloads in the ClusterQueues of the subtree.
+	PendingWorkloads int `json:"pendingWorkloads"`
+	// PendingDemand is the quantity of the resources requested by the pending workloads.
+	PendingDemand corev1.ResourceList `json:"pendingDemand,omitempty"`
+}
+
+// FlavorCapacity is the capacity of a cohort for a flavor.
+type FlavorCapacity struct {
+	Name      kueue.ResourceFlavorReference `json:"name"`
+	Resources []ResourceCapacity            `json:"resources"`
+}
+
+// ResourceCapacity is the capacity of a cohort for a flavor and resource.
+type ResourceCapacity struct {
+	Name corev1.ResourceName `json:"name"`
+	// Nominal is the nominal quota of the ClusterQueues and the Cohorts of the subtree.
+	Nominal resource.Quantity `json:"nominal"`
+	// Reserved is the quota reserved by the workloads.
+	Reserved resource.Quantity `json:"reserved"`
+	// Used is the quota used by the admitted workloads.
+	Used resource.Quantity `json:"used"`
+	// Borrowable is the quota which is not reserved, and can be borrowed by the
+	// ClusterQueues of the subtree without preempting any workload.
+	// It is not set when the cohort has a cycle.
+	Borrowable *resource.Quantity `json:"borrowable,omitempty"`
+}
+
+// Handler serves the capacity snapshot of the cohorts as JSON.
+type Handler struct {
+	cache  *schdcache.Cache
+	queues *qcache.Manager
+}
+
+var _ http.Handler = (*Handler)(nil)
+
+func NewHandler(cache *schdcache.Cache, queues *qcache.Manager) *Handler {
+	return &Handler{
+		cache:  cache,
+		queues: queues,
+	}
+}
+
+// Snapshot returns the capacity snapshot of the cohorts.
+func (h *Handler) Snapshot() *Snapshot {
+	capacities := h.cache.CohortCapacities()
+	snapshot := &Snapshot{Cohorts: make([]CohortSnapshot, 0, len(capacities))}
+	for _, capacity := range capacities {
+		cohort := CohortSnapshot{
+			Name:          capacity.Name,
+			Parent:        capacity.Parent,
+			ClusterQueues: capacity.ClusterQueues,
+			Flavors:       flavorCapacities(&capacity),
+		}
+		demand := make(resources.Requests)
+		for _, cqName := range capacity.ClusterQueues {
+			for _, wlInfo := range h.queues.PendingWorkloadsInfo(cqName) {
+				cohort.PendingWorkloads++
+				for _, ps := range wlInfo.TotalRequests {
+					demand.Add(ps.Requests)
+				}
+			}
+		}
+		if len(demand) > 0 {
+			cohort.PendingDemand = demand.ToResourceList()
+		}
+		snapshot.Cohorts = append(snapshot.Cohorts, cohort)
+	}
+	return snapshot
+}
+
+func flavorCapacities(capacity *schdcache.CohortCapacity) []FlavorCapacity {
+	byFlavor := make(map[kueue.ResourceFlavorReference][]corev1.ResourceName)
+	for fr := range capacity.Nominal {
+		byFlavor[fr.Flavor] = append(byFlavor[fr.Flavor], fr.Resource)
+	}
+	flavors := make([]FlavorCapacity, 0, len(byFlavor))
+	for _, flavor := range slices.Sorted(maps.Keys(byFlavor)) {
+		rNames := byFlavor[flavor]
+		slices.Sort(rNames)
+		flvCapacity := FlavorCapacity{Name: flavor, Resources: make([]ResourceCapacity, 0, len(rNames))}
+		for _, rName := range rNames {
+			fr := resources.FlavorResource{Flavor: flavor, Resource: rName}
+			rCapacity := ResourceCapacity{
+				Name:     rName,
+				Nominal:  resources.ResourceQuantity(rName, capacity.Nominal[fr]),
+				Reserved: resources.ResourceQuantity(rName, capacity.Reserved[fr]),
+				Used:     resources.ResourceQuantity(rName, capacity.Used[fr]),
+			}
+			if borrowable, found := capacity.Borrowable[fr]; found {
+				rCapacity.Borrowable = ptr.To(resources.ResourceQuantity(rName, borrowable))
+			}
+			flvCapacity.Resources = append(flvCapacity.Resources, rCapacity)
+		}
+		flavors = append(flavors, flvCapacity)
+	}
+	return flavors
+}
+
+// ServeHTTP serves the capacity snapshot of the cohorts as JSON. The "cohort"
+// query parameter restricts the snapshot to the given cohorts.
+func (h *Handler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	snapshot := h.Snapshot()
+	if names := req.URL.Query()["cohort"]; len(names) > 0 {
+		snapshot.Cohorts = slices.DeleteFunc(snapshot.Cohorts, func(c CohortSnapshot) bool {
+			return !slices.Contains(names, string(c.Name))
+		})
+	}
+	resp.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(resp).Encode(snapshot); err != nil {
+		http.Error(resp, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacity
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestHandler(t *testing.T) {
+	const nsName = "ns"
+
+	ctx, log := utiltesting.ContextWithLog(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	cache := schdcache.New(utiltesting.NewFakeClient())
+	manager := qcache.NewManager(utiltesting.NewFakeClient(), nil)
+	go manager.CleanUpOnContext(ctx)
+
+	cache.AddOrUpdateResourceFlavor(log, utiltesting.MakeResourceFlavor("default").Obj())
+	cache.AddOrUpdateResourceFlavor(log, utiltesting.MakeResourceFlavor("spot").Obj())
+	for _, cohort := range []*kueue.Cohort{
+		utiltesting.MakeCohort("root").Obj(),
+		utiltesting.MakeCohort("team-a").Parent("root").Obj(),
+	} {
+		if err := cache.AddOrUpdateCohort(cohort); err != nil {
+			t.Fatalf("Failed to add the Cohort %s to the cache: %v", cohort.Name, err)
+		}
+		manager.AddOrUpdateCohort(ctx, cohort)
+	}
+	for _, cq := range []*kueue.ClusterQueue{
+		utiltesting.MakeClusterQueue("cq-a").
+			Cohort("team-a").
+			ResourceGroup(
+				*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj(),
+				*utiltesting.MakeFlavorQuotas("spot").Resource(corev1.ResourceCPU, "2").Obj(),
+			).
+			Obj(),
+		utiltesting.MakeClusterQueue("cq-b").
+			Cohort("root").
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "2").Obj()).
+			Obj(),
+	} {
+		if err := cache.AddClusterQueue(ctx, cq); err != nil {
+			t.Fatalf("Failed to add the ClusterQueue %s to the cache: %v", cq.Name, err)
+		}
+		if err := manager.AddClusterQueue(ctx, cq); err != nil {
+			t.Fatalf("Failed to add the ClusterQueue %s to the queue manager: %v", cq.Name, err)
+		}
+	}
+	if err := manager.AddLocalQueue(ctx, utiltesting.MakeLocalQueue("lq-a", nsName).ClusterQueue("cq-a").Obj()); err != nil {
+		t.Fatalf("Failed to add the LocalQueue: %v", err)
+	}
+	cache.AddOrUpdateWorkload(log, utiltesting.MakeWorkload("running", nsName).
+		Queue("lq-a").
+		Request(corev1.ResourceCPU, "5").
+		ReserveQuota(utiltesting.MakeAdmission("cq-a").
+			PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "default", "5").Obj()).
+			Obj()).
+		Admitted(true).
+		Obj())
+	for _, wl := range []*kueue.Workload{
+		utiltesting.MakeWorkload("pending-1", nsName).Queue("lq-a").Request(corev1.ResourceCPU, "3").Obj(),
+		utiltesting.MakeWorkload("pending-2", nsName).Queue("lq-a").Request(corev1.ResourceCPU, "2").Obj(),
+	} {
+		if err := manager.AddOrUpdateWorkload(wl); err != nil {
+			t.Fatalf("Failed to add the Workload %s to the queue manager: %v", wl.Name, err)
+		}
+	}
+
+	rootCohort := CohortSnapshot{
+		Name:          "root",
+		ClusterQueues: []kueue.ClusterQueueReference{"cq-a", "cq-b"},
+		Flavors: []FlavorCapacity{
+			{
+				Name: "default",
+				Resources: []ResourceCapacity{{
+					Name:       corev1.ResourceCPU,
+					Nominal:    resource.MustParse("6"),
+					Reserved:   resource.MustParse("5"),
+					Used:       resource.MustParse("5"),
+					Borrowable: ptr.To(resource.MustParse("1")),
+				}},
+			},
+			{
+				Name: "spot",
+				Resources: []ResourceCapacity{{
+					Name:       corev1.ResourceCPU,
+					Nominal:    resource.MustParse("2"),
+					Reserved:   resource.MustParse("0"),
+					Used:       resource.MustParse("0"),
+					Borrowable: ptr.To(resource.MustParse("2")),
+				}},
+			},
+		},
+		PendingWorkloads: 2,
+		PendingDemand:    corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("5")},
+	}
+	teamACohort := CohortSnapshot{
+		Name:          "team-a",
+		Parent:        "root",
+		ClusterQueues: []kueue.ClusterQueueReference{"cq-a"},
+		Flavors: []FlavorCapacity{
+			{
+				Name: "default",
+				Resources: []ResourceCapacity{{
+					Name:       corev1.ResourceCPU,
+					Nominal:    resource.MustParse("4"),
+					Reserved:   resource.MustParse("5"),
+					Used:       resource.MustParse("5"),
+					Borrowable: ptr.To(resource.MustParse("1")),
+				}},
+			},
+			{
+				Name: "spot",
+				Resources: []ResourceCapacity{{
+					Name:       corev1.ResourceCPU,
+					Nominal:    resource.MustParse("2"),
+					Reserved:   resource.MustParse("0"),
+					Used:       resource.MustParse("0"),
+					Borrowable: ptr.To(resource.MustParse("2")),
+				}},
+			},
+		},
+		PendingWorkloads: 2,
+		PendingDemand:    corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("5")},
+	}
+
+	cases := map[string]struct {
+		query string
+		want  Snapshot
+	}{
+		"all the cohorts": {
+			want: Snapshot{Cohorts: []CohortSnapshot{rootCohort, teamACohort}},
+		},
+		"filtered by cohort": {
+			query: "?cohort=team-a",
+			want:  Snapshot{Cohorts: []CohortSnapshot{teamACohort}},
+		},
+		"unknown cohort": {
+			query: "?cohort=team-b",
+			want:  Snapshot{Cohorts: []CohortSnapshot{}},
+		},
+	}
+	handler := NewHandler(cache, manager)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, Path+tc.query, nil))
+			if resp.Code != http.StatusOK {
+				t.Fatalf("Unexpected status code %d: %s", resp.Code, resp.Body.String())
+			}
+			var got Snapshot
+			if err := json.Unmarshal(resp.Body.Bytes(), &got); err != nil {
+				t.Fatalf("Failed to decode the snapshot: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected snapshot (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
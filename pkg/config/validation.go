@@ -19,6 +19,8 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"path/filepath"
 	"slices"
 	"strings"
 
@@ -32,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	apimachineryutilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
@@ -47,20 +50,38 @@ var (
 	integrationsPath                     = field.NewPath("integrations")
 	integrationsFrameworksPath           = integrationsPath.Child("frameworks")
 	integrationsExternalFrameworkPath    = integrationsPath.Child("externalFrameworks")
+	integrationsPluginsPath              = integrationsPath.Child("plugins")
+	integrationsChildJobsPoliciesPath    = integrationsPath.Child("childJobsPolicies")
 	podOptionsPath                       = integrationsPath.Child("podOptions")
 	podOptionsNamespaceSelectorPath      = podOptionsPath.Child("namespaceSelector")
 	managedJobsNamespaceSelectorPath     = field.NewPath("managedJobsNamespaceSelector")
 	waitForPodsReadyPath                 = field.NewPath("waitForPodsReady")
 	requeuingStrategyPath                = waitForPodsReadyPath.Child("requeuingStrategy")
 	multiKueuePath                       = field.NewPath("multiKueue")
-	fsPreemptionStrategiesPath           = field.NewPath("fairSharing", "preemptionStrategies")
+	fsPath                               = field.NewPath("fairSharing")
+	fsPreemptionStrategiesPath           = fsPath.Child("preemptionStrategies")
 	afsResourceWeightsPath               = field.NewPath("admissionFairSharing", "resourceWeights")
 	afsPath                              = field.NewPath("admissionFairSharing")
 	internalCertManagementPath           = field.NewPath("internalCertManagement")
 	resourceTransformationPath           = field.NewPath("resources", "transformations")
 	dynamicResourceAllocationPath        = field.NewPath("resources", "deviceClassMappings")
+	fractionalResourcesPath              = field.NewPath("resources", "fractionalResources")
 	objectRetentionPoliciesPath          = field.NewPath("objectRetentionPolicies")
 	objectRetentionPoliciesWorkloadsPath = objectRetentionPoliciesPath.Child("workloads")
+	objectRetentionPoliciesProvReqsPath  = objectRetentionPoliciesPath.Child("provisioningRequests")
+	gracefulPreemptionPath               = field.NewPath("gracefulPreemption")
+	schedulingCyclePath                  = field.NewPath("schedulingCycle")
+	resourceFlavorDiscoveryPath          = field.NewPath("resourceFlavorDiscovery")
+	flavorCostsPath                      = field.NewPath("flavorCosts")
+	flavorFailoverPath                   = field.NewPath("flavorFailover")
+	actualUsagePath                      = field.NewPath("actualUsage")
+	tracingPath                          = field.NewPath("tracing")
+	preemptionAuditPath                  = field.NewPath("preemptionAudit")
+	eventThrottlingPath                  = field.NewPath("eventThrottling")
+	schedulingDecisionLogPath            = field.NewPath("schedulingDecisionLog")
+	workloadGroupingLabelsPath           = field.NewPath("metrics", "workloadGroupingLabels")
+	metricsCardinalityPath               = field.NewPath("metrics", "cardinality")
+	namespaceAllowlistPath               = field.NewPath("metrics", "namespaceAllowlist")
 	log                                  = ctrl.Log.WithName("config")
 )
 
@@ -74,8 +95,22 @@ func validate(c *configapi.Configuration, scheme *runtime.Scheme) field.ErrorLis
 	allErrs = append(allErrs, validateInternalCertManagement(c)...)
 	allErrs = append(allErrs, validateResourceTransformations(c)...)
 	allErrs = append(allErrs, validateDeviceClassMappings(c)...)
+	allErrs = append(allErrs, validateFractionalResources(c)...)
 	allErrs = append(allErrs, validateManagedJobsNamespaceSelector(c)...)
 	allErrs = append(allErrs, validateObjectRetentionPolicies(c)...)
+	allErrs = append(allErrs, validateGracefulPreemption(c)...)
+	allErrs = append(allErrs, validateSchedulingCycle(c)...)
+	allErrs = append(allErrs, validateResourceFlavorDiscovery(c)...)
+	allErrs = append(allErrs, validateFlavorCosts(c)...)
+	allErrs = append(allErrs, validateFlavorFailover(c)...)
+	allErrs = append(allErrs, validateActualUsage(c)...)
+	allErrs = append(allErrs, validateTracing(c)...)
+	allErrs = append(allErrs, validatePreemptionAudit(c)...)
+	allErrs = append(allErrs, validateEventThrottling(c)...)
+	allErrs = append(allErrs, validateSchedulingDecisionLog(c)...)
+	allErrs = append(allErrs, validateWorkloadGroupingLabels(c)...)
+	allErrs = append(allErrs, validateMetricsCardinality(c)...)
+	allErrs = append(allErrs, validateNamespaceAllowlist(c)...)
 	return allErrs
 }
 
@@ -229,6 +264,41 @@ func validateIntegrations(c *configapi.Configuration, scheme *runtime.Scheme) fi
 		}
 	}
 
+	for idx, plugin := range c.Integrations.Plugins {
+		gvk, _ := schema.ParseKindArg(plugin.Name)
+		switch {
+		case gvk == nil:
+			allErrs = append(allErrs, field.Invalid(integrationsPluginsPath.Index(idx).Child("name"), plugin.Name, "must be format, 'Kind.version.group.com'"))
+		case managedFrameworks.Has(gvk.String()):
+			allErrs = append(allErrs, field.Duplicate(integrationsPluginsPath.Index(idx).Child("name"), plugin.Name))
+		default:
+			managedFrameworks = managedFrameworks.Insert(gvk.String())
+		}
+		if plugin.Address == "" {
+			allErrs = append(allErrs, field.Required(integrationsPluginsPath.Index(idx).Child("address"), "cannot be empty"))
+		}
+	}
+
+	ownerKinds := sets.New[string]()
+	for idx, policy := range c.Integrations.ChildJobsPolicies {
+		policyPath := integrationsChildJobsPoliciesPath.Index(idx)
+		gvk, _ := schema.ParseKindArg(policy.OwnerKind)
+		switch {
+		case gvk == nil:
+			allErrs = append(allErrs, field.Invalid(policyPath.Child("ownerKind"), policy.OwnerKind, "must be format, 'Kind.version.group.com'"))
+		case ownerKinds.Has(gvk.String()):
+			allErrs = append(allErrs, field.Duplicate(policyPath.Child("ownerKind"), policy.OwnerKind))
+		default:
+			ownerKinds.Insert(gvk.String())
+		}
+		switch policy.Policy {
+		case configapi.ChildJobsPolicyExempt, configapi.ChildJobsPolicyQueue, configapi.ChildJobsPolicyInheritQueue:
+		default:
+			allErrs = append(allErrs, field.NotSupported(policyPath.Child("policy"), policy.Policy,
+				[]configapi.ChildJobsPolicyType{configapi.ChildJobsPolicyExempt, configapi.ChildJobsPolicyQueue, configapi.ChildJobsPolicyInheritQueue}))
+		}
+	}
+
 	allErrs = append(allErrs, validatePodIntegrationOptions(c)...)
 	return allErrs
 }
@@ -319,6 +389,10 @@ func validateFairSharing(c *configapi.Configuration) field.ErrorList {
 			allErrs = append(allErrs, field.NotSupported(fsPreemptionStrategiesPath, fs.PreemptionStrategies, validStrategySetsStr))
 		}
 	}
+	if features.Enabled(features.FairSharingUsageDecay) && fs.UsageHalfLifeTime != nil && fs.UsageHalfLifeTime.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fsPath.Child("usageHalfLifeTime"),
+			fs.UsageHalfLifeTime, apimachineryvalidation.IsNegativeErrorMsg))
+	}
 	return allErrs
 }
 
@@ -368,6 +442,31 @@ func validateResourceTransformations(c *configapi.Configuration) field.ErrorList
 	return allErrs
 }
 
+func validateFractionalResources(c *configapi.Configuration) field.ErrorList {
+	if c.Resources == nil || len(c.Resources.FractionalResources) == 0 {
+		return nil
+	}
+	if !features.Enabled(features.FlavorResourcePartitions) {
+		return field.ErrorList{field.Forbidden(fractionalResourcesPath, "can be set only when FlavorResourcePartitions feature gate is enabled")}
+	}
+	var allErrs field.ErrorList
+	seen := make(sets.Set[corev1.ResourceName])
+	for idx, name := range c.Resources.FractionalResources {
+		path := fractionalResourcesPath.Index(idx)
+		if errs := apimachineryutilvalidation.IsQualifiedName(string(name)); len(errs) > 0 {
+			allErrs = append(allErrs, field.Invalid(path, name, strings.Join(errs, "; ")))
+		}
+		if name == corev1.ResourceCPU {
+			allErrs = append(allErrs, field.Invalid(path, name, "cpu is always accounted in milli-units"))
+		}
+		if seen.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(path, name))
+		}
+		seen.Insert(name)
+	}
+	return allErrs
+}
+
 func validateDeviceClassMappings(c *configapi.Configuration) field.ErrorList {
 	if c.Resources == nil || len(c.Resources.DeviceClassMappings) == 0 {
 		return nil
@@ -493,7 +592,14 @@ func ValidateFeatureGates(featureGateCLI string, featureGateMap map[string]bool)
 func validateObjectRetentionPolicies(c *configapi.Configuration) field.ErrorList {
 	var allErrs field.ErrorList
 	rr := c.ObjectRetentionPolicies
-	if rr == nil || rr.Workloads == nil {
+	if rr == nil {
+		return allErrs
+	}
+	if rr.ProvisioningRequests != nil && rr.ProvisioningRequests.AfterStale != nil && rr.ProvisioningRequests.AfterStale.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(objectRetentionPoliciesProvReqsPath.Child("afterStale"),
+			rr.ProvisioningRequests.AfterStale.Duration.String(), apimachineryvalidation.IsNegativeErrorMsg))
+	}
+	if rr.Workloads == nil {
 		return allErrs
 	}
 	if rr.Workloads.AfterFinished != nil && rr.Workloads.AfterFinished.Duration < 0 {
@@ -504,5 +610,302 @@ func validateObjectRetentionPolicies(c *configapi.Configuration) field.ErrorList
 		allErrs = append(allErrs, field.Invalid(objectRetentionPoliciesWorkloadsPath.Child("afterDeactivatedByKueue"),
 			c.ObjectRetentionPolicies.Workloads.AfterDeactivatedByKueue.Duration.String(), apimachineryvalidation.IsNegativeErrorMsg))
 	}
+	for idx, override := range rr.Workloads.AfterFinishedOverrides {
+		overridePath := objectRetentionPoliciesWorkloadsPath.Child("afterFinishedOverrides").Index(idx)
+		allErrs = append(allErrs, validation.ValidateLabelSelector(override.NamespaceSelector, validation.LabelSelectorValidationOptions{}, overridePath.Child("namespaceSelector"))...)
+		for cqIdx, cq := range override.ClusterQueues {
+			for _, msg := range apimachineryutilvalidation.IsDNS1123Subdomain(cq) {
+				allErrs = append(allErrs, field.Invalid(overridePath.Child("clusterQueues").Index(cqIdx), cq, msg))
+			}
+		}
+		if override.AfterFinished != nil && override.AfterFinished.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(overridePath.Child("afterFinished"),
+				override.AfterFinished.Duration.String(), apimachineryvalidation.IsNegativeErrorMsg))
+		}
+	}
+	if archive := rr.Workloads.Archive; archive != nil {
+		archivePath := objectRetentionPoliciesWorkloadsPath.Child("archive")
+		if u, err := url.Parse(archive.URL); err != nil {
+			allErrs = append(allErrs, field.Invalid(archivePath.Child("url"), archive.URL, err.Error()))
+		} else if u.Scheme != "https" || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(archivePath.Child("url"), archive.URL, "must be an https URL"))
+		}
+		switch archive.Method {
+		case "", configapi.WorkloadArchiveMethodPost, configapi.WorkloadArchiveMethodPut:
+		default:
+			allErrs = append(allErrs, field.NotSupported(archivePath.Child("method"), archive.Method,
+				[]configapi.WorkloadArchiveMethod{configapi.WorkloadArchiveMethodPost, configapi.WorkloadArchiveMethodPut}))
+		}
+		if archive.Timeout != nil && archive.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(archivePath.Child("timeout"), archive.Timeout.Duration.String(), "must be positive"))
+		}
+	}
+	return allErrs
+}
+
+func validateGracefulPreemption(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	gp := c.GracefulPreemption
+	if gp == nil {
+		return allErrs
+	}
+	if !features.Enabled(features.GracefulPreemption) {
+		allErrs = append(allErrs, field.Forbidden(gracefulPreemptionPath, "can be set only when GracefulPreemption feature gate is enabled"))
+		return allErrs
+	}
+	if gp.GracePeriod != nil && gp.GracePeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(gracefulPreemptionPath.Child("gracePeriod"),
+			gp.GracePeriod.Duration.String(), apimachineryvalidation.IsNegativeErrorMsg))
+	}
+	return allErrs
+}
+
+func validateSchedulingCycle(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	sc := c.SchedulingCycle
+	if sc == nil {
+		return allErrs
+	}
+	if sc.Period != nil && sc.Period.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(schedulingCyclePath.Child("period"),
+			sc.Period.Duration.String(), apimachineryvalidation.IsNegativeErrorMsg))
+	}
+	if sc.MaxHeads != nil && *sc.MaxHeads < 0 {
+		allErrs = append(allErrs, field.Invalid(schedulingCyclePath.Child("maxHeads"),
+			*sc.MaxHeads, apimachineryvalidation.IsNegativeErrorMsg))
+	}
+	if sc.MaxAdmissionsPerCohort != nil && *sc.MaxAdmissionsPerCohort < 0 {
+		allErrs = append(allErrs, field.Invalid(schedulingCyclePath.Child("maxAdmissionsPerCohort"),
+			*sc.MaxAdmissionsPerCohort, apimachineryvalidation.IsNegativeErrorMsg))
+	}
+	return allErrs
+}
+
+// maxResourceFlavorNodeLabels is the maximum number of nodeLabels of a ResourceFlavor.
+const maxResourceFlavorNodeLabels = 8
+
+func validateResourceFlavorDiscovery(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	rfd := c.ResourceFlavorDiscovery
+	if rfd == nil {
+		return allErrs
+	}
+	if !features.Enabled(features.ResourceFlavorDiscovery) {
+		allErrs = append(allErrs, field.Forbidden(resourceFlavorDiscoveryPath, "can be set only when ResourceFlavorDiscovery feature gate is enabled"))
+		return allErrs
+	}
+	keysPath := resourceFlavorDiscoveryPath.Child("nodeLabelKeys")
+	if len(rfd.NodeLabelKeys) == 0 {
+		allErrs = append(allErrs, field.Required(keysPath, "at least one node label key is required"))
+	}
+	if len(rfd.NodeLabelKeys) > maxResourceFlavorNodeLabels {
+		allErrs = append(allErrs, field.TooMany(keysPath, len(rfd.NodeLabelKeys), maxResourceFlavorNodeLabels))
+	}
+	seen := sets.New[string]()
+	for i, key := range rfd.NodeLabelKeys {
+		allErrs = append(allErrs, validation.ValidateLabelName(key, keysPath.Index(i))...)
+		if seen.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(keysPath.Index(i), key))
+		}
+		seen.Insert(key)
+	}
+	return allErrs
+}
+
+func validateFlavorCosts(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	fc := c.FlavorCosts
+	if fc == nil {
+		return allErrs
+	}
+	if !features.Enabled(features.FlavorCostOrdering) {
+		allErrs = append(allErrs, field.Forbidden(flavorCostsPath, "can be set only when FlavorCostOrdering feature gate is enabled"))
+		return allErrs
+	}
+	namePath := flavorCostsPath.Child("configMapName")
+	if len(fc.ConfigMapName) == 0 {
+		allErrs = append(allErrs, field.Required(namePath, "the name of the ConfigMap is required"))
+	} else if errs := apimachineryutilvalidation.IsDNS1123Subdomain(fc.ConfigMapName); len(errs) != 0 {
+		allErrs = append(allErrs, field.Invalid(namePath, fc.ConfigMapName, strings.Join(errs, ",")))
+	}
+	if fc.UpdatePeriod != nil && fc.UpdatePeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(flavorCostsPath.Child("updatePeriod"), fc.UpdatePeriod.Duration, "must be greater than 0"))
+	}
+	return allErrs
+}
+
+func validateFlavorFailover(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	ff := c.FlavorFailover
+	if ff == nil {
+		return allErrs
+	}
+	if !features.Enabled(features.FlavorFailover) {
+		allErrs = append(allErrs, field.Forbidden(flavorFailoverPath, "can be set only when FlavorFailover feature gate is enabled"))
+		return allErrs
+	}
+	if ff.UnschedulableTimeout != nil && ff.UnschedulableTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(flavorFailoverPath.Child("unschedulableTimeout"), ff.UnschedulableTimeout.Duration, "must be greater than 0"))
+	}
+	if ff.ExclusionDuration != nil && ff.ExclusionDuration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(flavorFailoverPath.Child("exclusionDuration"), ff.ExclusionDuration.Duration, "must be greater than 0"))
+	}
+	return allErrs
+}
+
+func validateActualUsage(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	au := c.ActualUsage
+	if au == nil {
+		return allErrs
+	}
+	if !features.Enabled(features.WorkloadActualUsage) {
+		allErrs = append(allErrs, field.Forbidden(actualUsagePath, "can be set only when WorkloadActualUsage feature gate is enabled"))
+		return allErrs
+	}
+	if au.SamplingInterval != nil && au.SamplingInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(actualUsagePath.Child("samplingInterval"), au.SamplingInterval.Duration, "must be greater than 0"))
+	}
+	return allErrs
+}
+
+func validateTracing(c *configapi.Configuration) field.ErrorList {
+	if c.Tracing == nil {
+		return nil
+	}
+	if !features.Enabled(features.AdmissionTracing) {
+		return field.ErrorList{field.Forbidden(tracingPath, "can be set only when AdmissionTracing feature gate is enabled")}
+	}
+	return tracingapi.ValidateTracingConfiguration(c.Tracing, nil, tracingPath)
+}
+
+func validatePreemptionAudit(c *configapi.Configuration) field.ErrorList {
+	if c.PreemptionAudit == nil {
+		return nil
+	}
+	if !features.Enabled(features.PreemptionAuditLog) {
+		return field.ErrorList{field.Forbidden(preemptionAuditPath, "can be set only when PreemptionAuditLog feature gate is enabled")}
+	}
+	if path := c.PreemptionAudit.Path; path != "" && !filepath.IsAbs(path) {
+		return field.ErrorList{field.Invalid(preemptionAuditPath.Child("path"), path, "must be an absolute path")}
+	}
+	return nil
+}
+
+func validateEventThrottling(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	et := c.EventThrottling
+	if et == nil {
+		return allErrs
+	}
+	if !features.Enabled(features.EventThrottling) {
+		allErrs = append(allErrs, field.Forbidden(eventThrottlingPath, "can be set only when EventThrottling feature gate is enabled"))
+		return allErrs
+	}
+	if et.Interval != nil && et.Interval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(eventThrottlingPath.Child("interval"), et.Interval.Duration, "must be greater than 0"))
+	}
+	if et.DefaultBudget != nil && *et.DefaultBudget <= 0 {
+		allErrs = append(allErrs, field.Invalid(eventThrottlingPath.Child("defaultBudget"), *et.DefaultBudget, "must be greater than 0"))
+	}
+	for reason, budget := range et.ReasonBudgets {
+		if budget < 0 {
+			allErrs = append(allErrs, field.Invalid(eventThrottlingPath.Child("reasonBudgets").Key(reason), budget, "must be greater than or equal to 0"))
+		}
+	}
+	return allErrs
+}
+
+func validateSchedulingDecisionLog(c *configapi.Configuration) field.ErrorList {
+	var allErrs field.ErrorList
+	dl := c.SchedulingDecisionLog
+	if dl == nil {
+		return allErrs
+	}
+	if !features.Enabled(features.SchedulingDecisionLog) {
+		allErrs = append(allErrs, field.Forbidden(schedulingDecisionLogPath, "can be set only when SchedulingDecisionLog feature gate is enabled"))
+		return allErrs
+	}
+	if dl.Path != "" && !filepath.IsAbs(dl.Path) {
+		allErrs = append(allErrs, field.Invalid(schedulingDecisionLogPath.Child("path"), dl.Path, "must be an absolute path"))
+	}
+	if dl.MaxSizeMegabytes != nil && *dl.MaxSizeMegabytes <= 0 {
+		allErrs = append(allErrs, field.Invalid(schedulingDecisionLogPath.Child("maxSizeMegabytes"), *dl.MaxSizeMegabytes, "must be greater than 0"))
+	}
+	if dl.MaxBackups != nil && *dl.MaxBackups < 0 {
+		allErrs = append(allErrs, field.Invalid(schedulingDecisionLogPath.Child("maxBackups"), *dl.MaxBackups, "must be greater than or equal to 0"))
+	}
+	return allErrs
+}
+
+func validateWorkloadGroupingLabels(c *configapi.Configuration) field.ErrorList {
+	if len(c.Metrics.WorkloadGroupingLabels) == 0 {
+		return nil
+	}
+	if !features.Enabled(features.WorkloadGroupingMetrics) {
+		return field.ErrorList{field.Forbidden(workloadGroupingLabelsPath, "can be set only when WorkloadGroupingMetrics feature gate is enabled")}
+	}
+	var allErrs field.ErrorList
+	seen := sets.New[string]()
+	for idx, key := range c.Metrics.WorkloadGroupingLabels {
+		path := workloadGroupingLabelsPath.Index(idx)
+		if errs := apimachineryutilvalidation.IsQualifiedName(key); len(errs) > 0 {
+			allErrs = append(allErrs, field.Invalid(path, key, strings.Join(errs, "; ")))
+		}
+		if seen.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(path, key))
+		}
+		seen.Insert(key)
+	}
+	return allErrs
+}
+
+func validateNamespaceAllowlist(c *configapi.Configuration) field.ErrorList {
+	if len(c.Metrics.NamespaceAllowlist) == 0 {
+		return nil
+	}
+	if !features.Enabled(features.NamespaceMetrics) {
+		return field.ErrorList{field.Forbidden(namespaceAllowlistPath, "can be set only when NamespaceMetrics feature gate is enabled")}
+	}
+	var allErrs field.ErrorList
+	seen := sets.New[string]()
+	for idx, ns := range c.Metrics.NamespaceAllowlist {
+		path := namespaceAllowlistPath.Index(idx)
+		if errs := apimachineryutilvalidation.IsDNS1123Label(ns); len(errs) > 0 {
+			allErrs = append(allErrs, field.Invalid(path, ns, strings.Join(errs, "; ")))
+		}
+		if seen.Has(ns) {
+			allErrs = append(allErrs, field.Duplicate(path, ns))
+		}
+		seen.Insert(ns)
+	}
+	return allErrs
+}
+
+var metricsLabels = sets.New(configapi.MetricsLabelNamespace, configapi.MetricsLabelPriorityClass, configapi.MetricsLabelFlavor)
+
+func validateMetricsCardinality(c *configapi.Configuration) field.ErrorList {
+	mc := c.Metrics.Cardinality
+	if mc == nil {
+		return nil
+	}
+	if !features.Enabled(features.MetricsCardinalityLimits) {
+		return field.ErrorList{field.Forbidden(metricsCardinalityPath, "can be set only when MetricsCardinalityLimits feature gate is enabled")}
+	}
+	var allErrs field.ErrorList
+	seen := sets.New[configapi.MetricsLabel]()
+	for idx, label := range mc.ExcludedLabels {
+		path := metricsCardinalityPath.Child("excludedLabels").Index(idx)
+		if !metricsLabels.Has(label) {
+			allErrs = append(allErrs, field.NotSupported(path, label, sets.List(metricsLabels)))
+		}
+		if seen.Has(label) {
+			allErrs = append(allErrs, field.Duplicate(path, label))
+		}
+		seen.Insert(label)
+	}
+	if mc.MaxSeriesPerMetric != nil && *mc.MaxSeriesPerMetric <= 0 {
+		allErrs = append(allErrs, field.Invalid(metricsCardinalityPath.Child("maxSeriesPerMetric"), *mc.MaxSeriesPerMetric, "must be greater than 0"))
+	}
 	return allErrs
 }
@@ -29,9 +29,12 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/component-base/featuregate"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
 	"k8s.io/utils/ptr"
 
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
+	"sigs.k8s.io/kueue/pkg/features"
 )
 
 func TestValidate(t *testing.T) {
@@ -62,8 +65,9 @@ func TestValidate(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		cfg     *configapi.Configuration
-		wantErr field.ErrorList
+		cfg          *configapi.Configuration
+		featureGates map[featuregate.Feature]bool
+		wantErr      field.ErrorList
 	}{
 		"empty": {
 			cfg: &configapi.Configuration{},
@@ -159,6 +163,70 @@ func TestValidate(t *testing.T) {
 				},
 			},
 		},
+		"duplicate frameworks between integrations.externalFrameworks and integrations.plugins": {
+			cfg: &configapi.Configuration{
+				Integrations: &configapi.Integrations{
+					Frameworks:         []string{"batch/job"},
+					ExternalFrameworks: []string{"Foo.v1.example.com"},
+					Plugins: []configapi.IntegrationPlugin{
+						{Name: "Foo.v1.example.com", Address: "unix:///var/run/kueue/foo.sock"},
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "integrations.plugins[0].name",
+				},
+			},
+		},
+		"invalid integrations.plugins": {
+			cfg: &configapi.Configuration{
+				Integrations: &configapi.Integrations{
+					Frameworks: []string{"batch/job"},
+					Plugins: []configapi.IntegrationPlugin{
+						{Name: "invalid", Address: "unix:///var/run/kueue/foo.sock"},
+						{Name: "Bar.v1.example.com"},
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "integrations.plugins[0].name",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "integrations.plugins[1].address",
+				},
+			},
+		},
+		"invalid integrations.childJobsPolicies": {
+			cfg: &configapi.Configuration{
+				Integrations: &configapi.Integrations{
+					Frameworks: []string{"batch/job"},
+					ChildJobsPolicies: []configapi.ChildJobsPolicy{
+						{OwnerKind: "invalid", Policy: configapi.ChildJobsPolicyExempt},
+						{OwnerKind: "Foo.v1.example.com", Policy: configapi.ChildJobsPolicyQueue},
+						{OwnerKind: "Foo.v1.example.com", Policy: "Ignore"},
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "integrations.childJobsPolicies[0].ownerKind",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "integrations.childJobsPolicies[2].ownerKind",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "integrations.childJobsPolicies[2].policy",
+				},
+			},
+		},
 		"nil PodIntegrationOptions and nil managedJobsNamespaceSelector": {
 			cfg: &configapi.Configuration{
 				Integrations: &configapi.Integrations{
@@ -514,6 +582,31 @@ func TestValidate(t *testing.T) {
 				},
 			},
 		},
+		"negative fairSharing.usageHalfLifeTime": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				FairSharing: &configapi.FairSharing{
+					Enable:            true,
+					UsageHalfLifeTime: &metav1.Duration{Duration: -time.Second},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.FairSharingUsageDecay: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "fairSharing.usageHalfLifeTime",
+				},
+			},
+		},
+		"negative fairSharing.usageHalfLifeTime is ignored when the FairSharingUsageDecay feature gate is disabled": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				FairSharing: &configapi.FairSharing{
+					Enable:            true,
+					UsageHalfLifeTime: &metav1.Duration{Duration: -time.Second},
+				},
+			},
+		},
 		"valid preemption strategy": {
 			cfg: &configapi.Configuration{
 				Integrations: defaultIntegrations,
@@ -768,10 +861,745 @@ func TestValidate(t *testing.T) {
 				},
 			},
 		},
-	}
-
-	for name, tc := range testCases {
-		t.Run(name, func(t *testing.T) {
+		"invalid afterFinishedOverrides in .objectRetentionPolicies.workloads": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ObjectRetentionPolicies: &configapi.ObjectRetentionPolicies{
+					Workloads: &configapi.WorkloadRetentionPolicy{
+						AfterFinishedOverrides: []configapi.WorkloadRetentionOverride{
+							{
+								NamespaceSelector: &metav1.LabelSelector{
+									MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Unknown"}},
+								},
+								ClusterQueues: []string{"Invalid_Name"},
+								AfterFinished: ptr.To(metav1.Duration{Duration: -1}),
+							},
+						},
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "objectRetentionPolicies.workloads.afterFinishedOverrides[0].namespaceSelector.matchExpressions[0].operator",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "objectRetentionPolicies.workloads.afterFinishedOverrides[0].clusterQueues[0]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "objectRetentionPolicies.workloads.afterFinishedOverrides[0].afterFinished",
+				},
+			},
+		},
+		"valid afterFinishedOverrides and archive in .objectRetentionPolicies.workloads": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ObjectRetentionPolicies: &configapi.ObjectRetentionPolicies{
+					Workloads: &configapi.WorkloadRetentionPolicy{
+						AfterFinished: ptr.To(metav1.Duration{Duration: time.Hour}),
+						AfterFinishedOverrides: []configapi.WorkloadRetentionOverride{
+							{
+								NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+								ClusterQueues:     []string{"cq"},
+								AfterFinished:     ptr.To(metav1.Duration{Duration: time.Minute}),
+							},
+						},
+						Archive: &configapi.WorkloadArchive{
+							URL:    "https://archive.example.com/workloads",
+							Method: configapi.WorkloadArchiveMethodPut,
+						},
+					},
+				},
+			},
+		},
+		"invalid archive in .objectRetentionPolicies.workloads": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ObjectRetentionPolicies: &configapi.ObjectRetentionPolicies{
+					Workloads: &configapi.WorkloadRetentionPolicy{
+						Archive: &configapi.WorkloadArchive{
+							URL:     "http://archive.example.com",
+							Method:  "PATCH",
+							Timeout: ptr.To(metav1.Duration{}),
+						},
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "objectRetentionPolicies.workloads.archive.url",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "objectRetentionPolicies.workloads.archive.method",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "objectRetentionPolicies.workloads.archive.timeout",
+				},
+			},
+		},
+		"negative afterStale in .objectRetentionPolicies.provisioningRequests": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ObjectRetentionPolicies: &configapi.ObjectRetentionPolicies{
+					ProvisioningRequests: &configapi.ProvisioningRequestRetentionPolicy{
+						AfterStale: ptr.To(metav1.Duration{Duration: -1}),
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "objectRetentionPolicies.provisioningRequests.afterStale",
+				},
+			},
+		},
+		"zero afterStale in .objectRetentionPolicies.provisioningRequests": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ObjectRetentionPolicies: &configapi.ObjectRetentionPolicies{
+					ProvisioningRequests: &configapi.ProvisioningRequestRetentionPolicy{
+						AfterStale: ptr.To(metav1.Duration{Duration: 0}),
+					},
+				},
+			},
+		},
+		"gracefulPreemption with GracefulPreemption feature gate disabled": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				GracefulPreemption: &configapi.GracefulPreemption{
+					GracePeriod: &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "gracefulPreemption",
+				},
+			},
+		},
+		"negative gracePeriod in .gracefulPreemption": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				GracefulPreemption: &configapi.GracefulPreemption{
+					GracePeriod: &metav1.Duration{Duration: -1},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.GracefulPreemption: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "gracefulPreemption.gracePeriod",
+				},
+			},
+		},
+		"positive gracePeriod in .gracefulPreemption": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				GracefulPreemption: &configapi.GracefulPreemption{
+					GracePeriod: &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.GracefulPreemption: true},
+		},
+		"negative values in .schedulingCycle": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				SchedulingCycle: &configapi.SchedulingCycle{
+					Period:                 &metav1.Duration{Duration: -1},
+					MaxHeads:               ptr.To[int32](-1),
+					MaxAdmissionsPerCohort: ptr.To[int32](-1),
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "schedulingCycle.period",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "schedulingCycle.maxHeads",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "schedulingCycle.maxAdmissionsPerCohort",
+				},
+			},
+		},
+		"valid .schedulingCycle": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				SchedulingCycle: &configapi.SchedulingCycle{
+					Period:                 &metav1.Duration{Duration: time.Second},
+					MaxHeads:               ptr.To[int32](100),
+					MaxAdmissionsPerCohort: ptr.To[int32](5),
+				},
+			},
+		},
+		".resourceFlavorDiscovery with ResourceFlavorDiscovery feature gate disabled": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ResourceFlavorDiscovery: &configapi.ResourceFlavorDiscovery{
+					NodeLabelKeys: []string{"node.kubernetes.io/instance-type"},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "resourceFlavorDiscovery",
+				},
+			},
+		},
+		"invalid .resourceFlavorDiscovery.nodeLabelKeys": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ResourceFlavorDiscovery: &configapi.ResourceFlavorDiscovery{
+					NodeLabelKeys: []string{"node.kubernetes.io/instance-type", "invalid key", "node.kubernetes.io/instance-type"},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.ResourceFlavorDiscovery: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:   field.ErrorTypeInvalid,
+					Field:  "resourceFlavorDiscovery.nodeLabelKeys[1]",
+					Origin: "labelKey",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "resourceFlavorDiscovery.nodeLabelKeys[2]",
+				},
+			},
+		},
+		"empty .resourceFlavorDiscovery.nodeLabelKeys": {
+			cfg: &configapi.Configuration{
+				Integrations:            defaultIntegrations,
+				ResourceFlavorDiscovery: &configapi.ResourceFlavorDiscovery{},
+			},
+			featureGates: map[featuregate.Feature]bool{features.ResourceFlavorDiscovery: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeRequired,
+					Field: "resourceFlavorDiscovery.nodeLabelKeys",
+				},
+			},
+		},
+		"valid .resourceFlavorDiscovery": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ResourceFlavorDiscovery: &configapi.ResourceFlavorDiscovery{
+					NodeLabelKeys:      []string{"cloud.google.com/gke-nodepool", "node.kubernetes.io/instance-type"},
+					TolerateNodeTaints: ptr.To(true),
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.ResourceFlavorDiscovery: true},
+		},
+		".resources.fractionalResources with FlavorResourcePartitions feature gate disabled": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				Resources: &configapi.Resources{
+					FractionalResources: []corev1.ResourceName{"nvidia.com/gpu"},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "resources.fractionalResources",
+				},
+			},
+		},
+		"invalid .resources.fractionalResources": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				Resources: &configapi.Resources{
+					FractionalResources: []corev1.ResourceName{"nvidia.com/gpu", "cpu", "@gpu", "nvidia.com/gpu"},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.FlavorResourcePartitions: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "resources.fractionalResources[1]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "resources.fractionalResources[2]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "resources.fractionalResources[3]",
+				},
+			},
+		},
+		"valid .resources.fractionalResources": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				Resources: &configapi.Resources{
+					FractionalResources: []corev1.ResourceName{"nvidia.com/gpu", "example.com/gpu-equivalent"},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.FlavorResourcePartitions: true},
+		},
+		".flavorCosts with FlavorCostOrdering feature gate disabled": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				FlavorCosts: &configapi.FlavorCosts{
+					ConfigMapName: "flavor-costs",
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "flavorCosts",
+				},
+			},
+		},
+		"invalid .flavorCosts": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				FlavorCosts: &configapi.FlavorCosts{
+					ConfigMapName: "Flavor_Costs",
+					UpdatePeriod:  &metav1.Duration{},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.FlavorCostOrdering: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "flavorCosts.configMapName",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "flavorCosts.updatePeriod",
+				},
+			},
+		},
+		"valid .flavorCosts": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				FlavorCosts: &configapi.FlavorCosts{
+					ConfigMapName: "flavor-costs",
+					UpdatePeriod:  &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.FlavorCostOrdering: true},
+		},
+		".flavorFailover with FlavorFailover feature gate disabled": {
+			cfg: &configapi.Configuration{
+				Integrations:   defaultIntegrations,
+				FlavorFailover: &configapi.FlavorFailover{},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "flavorFailover",
+				},
+			},
+		},
+		"invalid .flavorFailover": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				FlavorFailover: &configapi.FlavorFailover{
+					UnschedulableTimeout: &metav1.Duration{},
+					ExclusionDuration:    &metav1.Duration{Duration: -time.Minute},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.FlavorFailover: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "flavorFailover.unschedulableTimeout",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "flavorFailover.exclusionDuration",
+				},
+			},
+		},
+		"valid .flavorFailover": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				FlavorFailover: &configapi.FlavorFailover{
+					UnschedulableTimeout: &metav1.Duration{Duration: 10 * time.Minute},
+					ExclusionDuration:    &metav1.Duration{Duration: time.Hour},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.FlavorFailover: true},
+		},
+		".actualUsage with WorkloadActualUsage feature gate disabled": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ActualUsage:  &configapi.ActualUsage{},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "actualUsage",
+				},
+			},
+		},
+		"invalid .actualUsage": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ActualUsage: &configapi.ActualUsage{
+					SamplingInterval: &metav1.Duration{},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.WorkloadActualUsage: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "actualUsage.samplingInterval",
+				},
+			},
+		},
+		"valid .actualUsage": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ActualUsage: &configapi.ActualUsage{
+					SamplingInterval: &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.WorkloadActualUsage: true},
+		},
+		".metrics.workloadGroupingLabels with WorkloadGroupingMetrics feature gate disabled": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ControllerManager: configapi.ControllerManager{
+					Metrics: configapi.ControllerMetrics{
+						WorkloadGroupingLabels: []string{"team"},
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "metrics.workloadGroupingLabels",
+				},
+			},
+		},
+		"invalid .metrics.workloadGroupingLabels": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ControllerManager: configapi.ControllerManager{
+					Metrics: configapi.ControllerMetrics{
+						WorkloadGroupingLabels: []string{"team", "cost center", "team"},
+					},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.WorkloadGroupingMetrics: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metrics.workloadGroupingLabels[1]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "metrics.workloadGroupingLabels[2]",
+				},
+			},
+		},
+		"valid .metrics.workloadGroupingLabels": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ControllerManager: configapi.ControllerManager{
+					Metrics: configapi.ControllerMetrics{
+						WorkloadGroupingLabels: []string{"team", "example.com/cost-center"},
+					},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.WorkloadGroupingMetrics: true},
+		},
+		".metrics.namespaceAllowlist with NamespaceMetrics feature gate disabled": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ControllerManager: configapi.ControllerManager{
+					Metrics: configapi.ControllerMetrics{
+						NamespaceAllowlist: []string{"team-a"},
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "metrics.namespaceAllowlist",
+				},
+			},
+		},
+		"invalid .metrics.namespaceAllowlist": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ControllerManager: configapi.ControllerManager{
+					Metrics: configapi.ControllerMetrics{
+						NamespaceAllowlist: []string{"team-a", "Team_B", "team-a"},
+					},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.NamespaceMetrics: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metrics.namespaceAllowlist[1]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "metrics.namespaceAllowlist[2]",
+				},
+			},
+		},
+		"valid .metrics.namespaceAllowlist": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ControllerManager: configapi.ControllerManager{
+					Metrics: configapi.ControllerMetrics{
+						NamespaceAllowlist: []string{"team-a", "team-b"},
+					},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.NamespaceMetrics: true},
+		},
+		".tracing with AdmissionTracing feature gate disabled": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				Tracing:      &tracingapi.TracingConfiguration{},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "tracing",
+				},
+			},
+		},
+		"invalid .tracing": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				Tracing: &tracingapi.TracingConfiguration{
+					Endpoint:               ptr.To("http://collector:4317"),
+					SamplingRatePerMillion: ptr.To[int32](2000000),
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.AdmissionTracing: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "tracing.samplingRatePerMillion",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "tracing.endpoint",
+				},
+			},
+		},
+		"valid .tracing": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				Tracing: &tracingapi.TracingConfiguration{
+					Endpoint:               ptr.To("otel-collector.monitoring:4317"),
+					SamplingRatePerMillion: ptr.To[int32](1000),
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.AdmissionTracing: true},
+		},
+		".preemptionAudit with PreemptionAuditLog feature gate disabled": {
+			cfg: &configapi.Configuration{
+				Integrations:    defaultIntegrations,
+				PreemptionAudit: &configapi.PreemptionAudit{},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "preemptionAudit",
+				},
+			},
+		},
+		"relative .preemptionAudit.path": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				PreemptionAudit: &configapi.PreemptionAudit{
+					Path: "audit/preemptions.log",
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.PreemptionAuditLog: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "preemptionAudit.path",
+				},
+			},
+		},
+		"valid .preemptionAudit": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				PreemptionAudit: &configapi.PreemptionAudit{
+					Path: "/var/log/kueue/preemptions.log",
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.PreemptionAuditLog: true},
+		},
+		".eventThrottling with EventThrottling feature gate disabled": {
+			cfg: &configapi.Configuration{
+				Integrations:    defaultIntegrations,
+				EventThrottling: &configapi.EventThrottling{},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "eventThrottling",
+				},
+			},
+		},
+		"invalid .eventThrottling": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				EventThrottling: &configapi.EventThrottling{
+					Interval:      &metav1.Duration{},
+					DefaultBudget: ptr.To[int32](0),
+					ReasonBudgets: map[string]int32{"Pending": -1},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.EventThrottling: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "eventThrottling.interval",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "eventThrottling.defaultBudget",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "eventThrottling.reasonBudgets[Pending]",
+				},
+			},
+		},
+		"valid .eventThrottling": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				EventThrottling: &configapi.EventThrottling{
+					Interval:      &metav1.Duration{Duration: time.Minute},
+					DefaultBudget: ptr.To[int32](100),
+					ReasonBudgets: map[string]int32{"Pending": 10, "Preempted": 0},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.EventThrottling: true},
+		},
+		".metrics.cardinality with MetricsCardinalityLimits feature gate disabled": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ControllerManager: configapi.ControllerManager{
+					Metrics: configapi.ControllerMetrics{
+						Cardinality: &configapi.MetricsCardinality{},
+					},
+				},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "metrics.cardinality",
+				},
+			},
+		},
+		"invalid .metrics.cardinality": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ControllerManager: configapi.ControllerManager{
+					Metrics: configapi.ControllerMetrics{
+						Cardinality: &configapi.MetricsCardinality{
+							ExcludedLabels:     []configapi.MetricsLabel{"namespace", "cluster_queue", "namespace"},
+							MaxSeriesPerMetric: ptr.To[int32](0),
+						},
+					},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.MetricsCardinalityLimits: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "metrics.cardinality.excludedLabels[1]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeDuplicate,
+					Field: "metrics.cardinality.excludedLabels[2]",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metrics.cardinality.maxSeriesPerMetric",
+				},
+			},
+		},
+		"valid .metrics.cardinality": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				ControllerManager: configapi.ControllerManager{
+					Metrics: configapi.ControllerMetrics{
+						Cardinality: &configapi.MetricsCardinality{
+							ExcludedLabels:     []configapi.MetricsLabel{configapi.MetricsLabelNamespace, configapi.MetricsLabelPriorityClass, configapi.MetricsLabelFlavor},
+							MaxSeriesPerMetric: ptr.To[int32](1000),
+						},
+					},
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.MetricsCardinalityLimits: true},
+		},
+		".schedulingDecisionLog with SchedulingDecisionLog feature gate disabled": {
+			cfg: &configapi.Configuration{
+				Integrations:          defaultIntegrations,
+				SchedulingDecisionLog: &configapi.SchedulingDecisionLog{},
+			},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "schedulingDecisionLog",
+				},
+			},
+		},
+		"invalid .schedulingDecisionLog": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				SchedulingDecisionLog: &configapi.SchedulingDecisionLog{
+					Path:             "decisions.log",
+					MaxSizeMegabytes: ptr.To[int32](0),
+					MaxBackups:       ptr.To[int32](-1),
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.SchedulingDecisionLog: true},
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "schedulingDecisionLog.path",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "schedulingDecisionLog.maxSizeMegabytes",
+				},
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "schedulingDecisionLog.maxBackups",
+				},
+			},
+		},
+		"valid .schedulingDecisionLog": {
+			cfg: &configapi.Configuration{
+				Integrations: defaultIntegrations,
+				SchedulingDecisionLog: &configapi.SchedulingDecisionLog{
+					Path:             "/var/log/kueue/decisions.log",
+					MaxSizeMegabytes: ptr.To[int32](50),
+					MaxBackups:       ptr.To[int32](0),
+				},
+			},
+			featureGates: map[featuregate.Feature]bool{features.SchedulingDecisionLog: true},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for fg, enabled := range tc.featureGates {
+				features.SetFeatureGateDuringTest(t, fg, enabled)
+			}
 			if diff := cmp.Diff(tc.wantErr, validate(tc.cfg, testScheme), cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
 				t.Errorf("Unexpected returned error (-want,+got):\n%s", diff)
 			}
@@ -0,0 +1,240 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+var (
+	realClock = clock.RealClock{}
+)
+
+type configHelper = admissioncheck.ConfigHelper[*kueue.BudgetAdmissionCheckConfig, kueue.BudgetAdmissionCheckConfig]
+
+type Option func(*Controller)
+
+// WithClock sets the clock used by the controller.
+func WithClock(c clock.Clock) Option {
+	return func(ctrl *Controller) {
+		ctrl.clock = c
+	}
+}
+
+// Controller sets the budget admission checks of the Workloads with quota
+// reserved to Ready when their estimated cost fits in the Budgets of their
+// namespace and LocalQueue, charging the cost to the Budgets. Otherwise,
+// the checks are set to Retry or Rejected, depending on the exceedPolicy
+// of the exceeded Budgets.
+type Controller struct {
+	client client.Client
+	helper *configHelper
+	clock  clock.Clock
+}
+
+var _ reconcile.Reconciler = (*Controller)(nil)
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=budgetadmissioncheckconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=budgets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=budgets/status,verbs=get;update;patch
+
+func NewController(client client.Client, opts ...Option) (*Controller, error) {
+	helper, err := admissioncheck.NewConfigHelper[*kueue.BudgetAdmissionCheckConfig](client)
+	if err != nil {
+		return nil, err
+	}
+	c := &Controller{
+		client: client,
+		helper: helper,
+		clock:  realClock,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	wl := &kueue.Workload{}
+	if err := c.client.Get(ctx, req.NamespacedName, wl); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if !workload.HasQuotaReservation(wl) || workload.IsFinished(wl) || workload.IsEvicted(wl) {
+		return reconcile.Result{}, nil
+	}
+
+	checks, err := admissioncheck.FilterForController(ctx, c.client, wl.Status.AdmissionChecks, kueue.BudgetAdmissionCheckControllerName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(checks) == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile budget admission checks")
+
+	wlPatch := workload.BaseSSAWorkload(wl, true)
+	updated := false
+	for _, checkName := range checks {
+		current := admissioncheck.FindAdmissionCheck(wl.Status.AdmissionChecks, checkName)
+		if current.State != kueue.CheckStatePending {
+			continue
+		}
+		newState := kueue.AdmissionCheckState{
+			Name:               current.Name,
+			State:              current.State,
+			LastTransitionTime: current.LastTransitionTime,
+			PodSetUpdates:      current.PodSetUpdates,
+		}
+		cfg, err := c.helper.ConfigForAdmissionCheck(ctx, checkName)
+		if err != nil {
+			newState.Message = fmt.Sprintf("Failed to get the configuration of the admission check: %v", err)
+		} else if err := c.chargeBudgets(ctx, wl, workloadCost(wl, cfg), &newState); err != nil {
+			return reconcile.Result{}, err
+		}
+		if newState.State == current.State && newState.Message == current.Message {
+			continue
+		}
+		workload.SetAdmissionCheckState(&wlPatch.Status.AdmissionChecks, newState, c.clock)
+		updated = true
+	}
+	if updated {
+		if err := c.client.Status().Patch(ctx, wlPatch, client.Apply, client.FieldOwner(kueue.BudgetAdmissionCheckControllerName), client.ForceOwnership); err != nil {
+			return reconcile.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+// chargeBudgets charges the cost to the Budgets applying to the workload and
+// sets the state of the check to Ready, if the cost fits in all of them.
+func (c *Controller) chargeBudgets(ctx context.Context, wl *kueue.Workload, cost resource.Quantity, state *kueue.AdmissionCheckState) error {
+	budgets := &kueue.BudgetList{}
+	if err := c.client.List(ctx, budgets, client.InNamespace(wl.Namespace)); err != nil {
+		return err
+	}
+	now := c.clock.Now()
+	var applying []*kueue.Budget
+	var exceeded []string
+	policy := kueue.BudgetExceedPolicyDefer
+	for i := range budgets.Items {
+		b := &budgets.Items[i]
+		if b.Spec.LocalQueueName != nil && *b.Spec.LocalQueueName != wl.Spec.QueueName {
+			continue
+		}
+		startPeriod(b, now)
+		applying = append(applying, b)
+		spent := b.Status.Spent.DeepCopy()
+		spent.Add(cost)
+		if spent.Cmp(b.Spec.MonthlyLimit) > 0 {
+			exceeded = append(exceeded, b.Name)
+			if ptr.Deref(b.Spec.ExceedPolicy, kueue.BudgetExceedPolicyDefer) == kueue.BudgetExceedPolicyReject {
+				policy = kueue.BudgetExceedPolicyReject
+			}
+		}
+	}
+
+	switch {
+	case len(applying) == 0:
+		state.State = kueue.CheckStateReady
+		state.Message = "No budget applies to the workload"
+	case len(exceeded) > 0:
+		state.State = kueue.CheckStateRetry
+		if policy == kueue.BudgetExceedPolicyReject {
+			state.State = kueue.CheckStateRejected
+		}
+		state.Message = fmt.Sprintf("The estimated cost %s exceeds the budgets %s", cost.String(), strings.Join(exceeded, ", "))
+	default:
+		names := make([]string, 0, len(applying))
+		for _, b := range applying {
+			b.Status.Spent.Add(cost)
+			b.Status.AdmittedWorkloads++
+			if err := c.client.Status().Update(ctx, b); err != nil {
+				return err
+			}
+			names = append(names, b.Name)
+		}
+		state.State = kueue.CheckStateReady
+		state.Message = fmt.Sprintf("The estimated cost %s was charged to the budgets %s", cost.String(), strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// startPeriod resets the spending of the budget when the calendar month
+// changed since the start of its period. Returns true if the status changed.
+func startPeriod(b *kueue.Budget, now time.Time) bool {
+	monthStart := periodStart(now)
+	if b.Status.PeriodStart != nil && !b.Status.PeriodStart.Time.Before(monthStart) {
+		if b.Status.Spent == nil {
+			b.Status.Spent = resource.NewQuantity(0, resource.DecimalSI)
+		}
+		return false
+	}
+	if b.Status.PeriodStart != nil {
+		b.Status.PreviousPeriodSpent = b.Status.Spent
+	}
+	b.Status.PeriodStart = ptr.To(metav1.NewTime(monthStart))
+	b.Status.Spent = resource.NewQuantity(0, resource.DecimalSI)
+	b.Status.AdmittedWorkloads = 0
+	return true
+}
+
+// periodStart returns the start of the calendar month of t, in UTC.
+func periodStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named("budget_workload").
+		For(&kueue.Workload{}).
+		Complete(c)
+	if err != nil {
+		return err
+	}
+	err = ctrl.NewControllerManagedBy(mgr).
+		Named("budget_budget").
+		For(&kueue.Budget{}).
+		Complete(&budgetReconciler{client: c.client, clock: c.clock})
+	if err != nil {
+		return err
+	}
+	return admissioncheck.NewConfigReconciler(c.client, kueue.BudgetAdmissionCheckControllerName, c.helper).
+		SetupWithManager(mgr, "budget_admissioncheck")
+}
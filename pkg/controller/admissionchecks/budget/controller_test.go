@@ -0,0 +1,222 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestReconcile(t *testing.T) {
+	now := time.Date(2026, time.October, 14, 10, 0, 0, 0, time.UTC)
+	monthStart := metav1.NewTime(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC))
+	admission := utiltesting.MakeAdmission("cq").
+		PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+			Assignment(corev1.ResourceCPU, "default", "2").
+			Obj()).
+		Obj()
+	config := &kueue.BudgetAdmissionCheckConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Spec: kueue.BudgetAdmissionCheckConfigSpec{
+			Prices: []kueue.FlavorPrices{{
+				Flavor: "default",
+				Resources: []kueue.ResourcePrice{{
+					Name:         corev1.ResourceCPU,
+					PricePerHour: resource.MustParse("0.5"),
+				}},
+			}},
+		},
+	}
+	budget := func(name string, limit, spent string) *kueue.Budget {
+		return &kueue.Budget{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			Spec: kueue.BudgetSpec{
+				MonthlyLimit: resource.MustParse(limit),
+			},
+			Status: kueue.BudgetStatus{
+				PeriodStart:       &monthStart,
+				Spent:             ptr.To(resource.MustParse(spent)),
+				AdmittedWorkloads: 1,
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		budgets     []*kueue.Budget
+		wantState   kueue.AdmissionCheckState
+		wantBudgets []kueue.Budget
+	}{
+		"no budget applies": {
+			wantState: kueue.AdmissionCheckState{
+				Name:    "budget-check",
+				State:   kueue.CheckStateReady,
+				Message: "No budget applies to the workload",
+			},
+		},
+		"cost fits in the budget": {
+			budgets: []*kueue.Budget{budget("ns-budget", "10", "7")},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "budget-check",
+				State:   kueue.CheckStateReady,
+				Message: "The estimated cost 2 was charged to the budgets ns-budget",
+			},
+			wantBudgets: []kueue.Budget{{
+				ObjectMeta: metav1.ObjectMeta{Name: "ns-budget", Namespace: "ns"},
+				Spec:       kueue.BudgetSpec{MonthlyLimit: resource.MustParse("10")},
+				Status: kueue.BudgetStatus{
+					PeriodStart:       &monthStart,
+					Spent:             ptr.To(resource.MustParse("9")),
+					AdmittedWorkloads: 2,
+				},
+			}},
+		},
+		"cost exceeds the budget with the Defer policy": {
+			budgets: []*kueue.Budget{budget("ns-budget", "10", "9")},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "budget-check",
+				State:   kueue.CheckStateRetry,
+				Message: "The estimated cost 2 exceeds the budgets ns-budget",
+			},
+			wantBudgets: []kueue.Budget{*budget("ns-budget", "10", "9")},
+		},
+		"cost exceeds the budget with the Reject policy": {
+			budgets: []*kueue.Budget{func() *kueue.Budget {
+				b := budget("ns-budget", "10", "9")
+				b.Spec.ExceedPolicy = ptr.To(kueue.BudgetExceedPolicyReject)
+				return b
+			}()},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "budget-check",
+				State:   kueue.CheckStateRejected,
+				Message: "The estimated cost 2 exceeds the budgets ns-budget",
+			},
+			wantBudgets: []kueue.Budget{func() kueue.Budget {
+				b := budget("ns-budget", "10", "9")
+				b.Spec.ExceedPolicy = ptr.To(kueue.BudgetExceedPolicyReject)
+				return *b
+			}()},
+		},
+		"budget of another LocalQueue doesn't apply": {
+			budgets: []*kueue.Budget{func() *kueue.Budget {
+				b := budget("other-budget", "1", "1")
+				b.Spec.LocalQueueName = ptr.To[kueue.LocalQueueName]("other")
+				return b
+			}()},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "budget-check",
+				State:   kueue.CheckStateReady,
+				Message: "No budget applies to the workload",
+			},
+			wantBudgets: []kueue.Budget{func() kueue.Budget {
+				b := budget("other-budget", "1", "1")
+				b.Spec.LocalQueueName = ptr.To[kueue.LocalQueueName]("other")
+				return *b
+			}()},
+		},
+		"budget of the previous month starts a new period": {
+			budgets: []*kueue.Budget{func() *kueue.Budget {
+				b := budget("ns-budget", "10", "10")
+				b.Status.PeriodStart = ptr.To(metav1.NewTime(time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)))
+				return b
+			}()},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "budget-check",
+				State:   kueue.CheckStateReady,
+				Message: "The estimated cost 2 was charged to the budgets ns-budget",
+			},
+			wantBudgets: []kueue.Budget{{
+				ObjectMeta: metav1.ObjectMeta{Name: "ns-budget", Namespace: "ns"},
+				Spec:       kueue.BudgetSpec{MonthlyLimit: resource.MustParse("10")},
+				Status: kueue.BudgetStatus{
+					PeriodStart:         &monthStart,
+					Spent:               ptr.To(resource.MustParse("2")),
+					AdmittedWorkloads:   1,
+					PreviousPeriodSpent: ptr.To(resource.MustParse("10")),
+				},
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wl := utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				MaximumExecutionTimeSeconds(7200).
+				ReserveQuota(admission).
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:               "budget-check",
+					State:              kueue.CheckStatePending,
+					LastTransitionTime: metav1.NewTime(now),
+				}).
+				Obj()
+			objs := []client.Object{
+				utiltesting.MakeAdmissionCheck("budget-check").
+					ControllerName(kueue.BudgetAdmissionCheckControllerName).
+					Parameters(kueue.GroupVersion.Group, "BudgetAdmissionCheckConfig", "config").
+					Obj(),
+				config.DeepCopy(),
+				wl,
+			}
+			for _, b := range tc.budgets {
+				objs = append(objs, b)
+			}
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(objs...).
+				WithStatusSubresource(wl, &kueue.Budget{}).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+			ctx, _ := utiltesting.ContextWithLog(t)
+
+			controller, err := NewController(cl, WithClock(testingclock.NewFakeClock(now)))
+			if err != nil {
+				t.Fatalf("Failed to create the controller: %v", err)
+			}
+			if _, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(wl)}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var updated kueue.Workload
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(wl), &updated); err != nil {
+				t.Fatalf("Failed to get the workload: %v", err)
+			}
+			if diff := cmp.Diff([]kueue.AdmissionCheckState{tc.wantState}, updated.Status.AdmissionChecks, cmpopts.IgnoreFields(kueue.AdmissionCheckState{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected admission check states (-want,+got):\n%s", diff)
+			}
+
+			var budgets kueue.BudgetList
+			if err := cl.List(ctx, &budgets); err != nil {
+				t.Fatalf("Failed to list the budgets: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantBudgets, budgets.Items, cmpopts.EquateEmpty(), cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion")); diff != "" {
+				t.Errorf("Unexpected budgets (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
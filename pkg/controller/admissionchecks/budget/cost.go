@@ -0,0 +1,72 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package budget
+
+import (
+	"math"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+const defaultDuration = time.Hour
+
+// workloadCost estimates the cost of the workload from the resources assigned
+// in its admission, the prices of the configuration and its expected duration.
+func workloadCost(wl *kueue.Workload, cfg *kueue.BudgetAdmissionCheckConfig) resource.Quantity {
+	prices := make(map[kueue.ResourceFlavorReference]map[corev1.ResourceName]kueue.ResourcePrice, len(cfg.Spec.Prices))
+	for _, fp := range cfg.Spec.Prices {
+		resources := make(map[corev1.ResourceName]kueue.ResourcePrice, len(fp.Resources))
+		for _, rp := range fp.Resources {
+			resources[rp.Name] = rp
+		}
+		prices[fp.Flavor] = resources
+	}
+
+	duration := ptr.Deref(cfg.Spec.DefaultDuration, metav1.Duration{Duration: defaultDuration}).Duration
+	if wl.Spec.MaximumExecutionTimeSeconds != nil {
+		duration = time.Duration(*wl.Spec.MaximumExecutionTimeSeconds) * time.Second
+	}
+
+	var perHour float64
+	if wl.Status.Admission != nil {
+		for _, psa := range wl.Status.Admission.PodSetAssignments {
+			for name, flavor := range psa.Flavors {
+				price, found := prices[flavor][name]
+				if !found {
+					continue
+				}
+				usage, found := psa.ResourceUsage[name]
+				if !found {
+					continue
+				}
+				unit := float64(1)
+				if price.Unit != nil && price.Unit.Sign() > 0 {
+					unit = price.Unit.AsApproximateFloat64()
+				}
+				perHour += usage.AsApproximateFloat64() / unit * price.PricePerHour.AsApproximateFloat64()
+			}
+		}
+	}
+	cost := perHour * duration.Hours()
+	return *resource.NewMilliQuantity(int64(math.Ceil(cost*1000)), resource.DecimalSI)
+}
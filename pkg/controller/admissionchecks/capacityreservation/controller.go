@@ -0,0 +1,253 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityreservation
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	// ReservationAnnotation is injected in the PodSets of the Workloads with
+	// the id of the capacity reservation they were admitted on.
+	ReservationAnnotation = "kueue.x-k8s.io/capacity-reservation"
+
+	retryPeriod = time.Minute
+)
+
+var (
+	realClock = clock.RealClock{}
+)
+
+type configHelper = admissioncheck.ConfigHelper[*kueue.CapacityReservationAdmissionCheckConfig, kueue.CapacityReservationAdmissionCheckConfig]
+
+type Option func(*Controller)
+
+// WithClock sets the clock used by the controller.
+func WithClock(c clock.Clock) Option {
+	return func(ctrl *Controller) {
+		ctrl.clock = c
+	}
+}
+
+// WithProvider adds a provider of capacity reservations, referenced by name
+// in the configurations of the admission checks.
+func WithProvider(name string, p Provider) Option {
+	return func(ctrl *Controller) {
+		ctrl.providers[name] = p
+	}
+}
+
+// Controller holds the capacity of the Workloads with quota reserved in the
+// reservations of the capacity reservation admission checks, using the
+// provider of their configuration. The checks are set to Ready once the
+// capacity is held, injecting the id of the reservation as an annotation and
+// the node selector of the reservation in the PodSets.
+type Controller struct {
+	client    client.Client
+	helper    *configHelper
+	clock     clock.Clock
+	providers map[string]Provider
+}
+
+var _ reconcile.Reconciler = (*Controller)(nil)
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=capacityreservationadmissioncheckconfigs,verbs=get;list;watch
+
+func NewController(client client.Client, opts ...Option) (*Controller, error) {
+	helper, err := admissioncheck.NewConfigHelper[*kueue.CapacityReservationAdmissionCheckConfig](client)
+	if err != nil {
+		return nil, err
+	}
+	c := &Controller{
+		client: client,
+		helper: helper,
+		clock:  realClock,
+		providers: map[string]Provider{
+			kueue.StaticCapacityReservationProvider: &staticProvider{client: client},
+		},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	wl := &kueue.Workload{}
+	if err := c.client.Get(ctx, req.NamespacedName, wl); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, c.release(ctx, req.NamespacedName)
+		}
+		return reconcile.Result{}, err
+	}
+
+	checks, err := admissioncheck.FilterForController(ctx, c.client, wl.Status.AdmissionChecks, kueue.CapacityReservationAdmissionCheckControllerName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(checks) == 0 {
+		return reconcile.Result{}, nil
+	}
+	if !workload.HasQuotaReservation(wl) || workload.IsFinished(wl) || workload.IsEvicted(wl) {
+		return reconcile.Result{}, c.release(ctx, req.NamespacedName)
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile capacity reservation admission checks")
+
+	wlPatch := workload.BaseSSAWorkload(wl, true)
+	updated := false
+	requeue := false
+	for _, checkName := range checks {
+		current := admissioncheck.FindAdmissionCheck(wl.Status.AdmissionChecks, checkName)
+		if current.State != kueue.CheckStatePending {
+			continue
+		}
+		newState := kueue.AdmissionCheckState{
+			Name:               current.Name,
+			State:              current.State,
+			LastTransitionTime: current.LastTransitionTime,
+			PodSetUpdates:      current.PodSetUpdates,
+		}
+		if err := c.hold(ctx, wl, checkName, &newState); err != nil {
+			return reconcile.Result{}, err
+		}
+		if newState.State == kueue.CheckStatePending {
+			requeue = true
+		}
+		if newState.State == current.State && newState.Message == current.Message {
+			continue
+		}
+		workload.SetAdmissionCheckState(&wlPatch.Status.AdmissionChecks, newState, c.clock)
+		updated = true
+	}
+	if updated {
+		if err := c.client.Status().Patch(ctx, wlPatch, client.Apply, client.FieldOwner(kueue.CapacityReservationAdmissionCheckControllerName), client.ForceOwnership); err != nil {
+			return reconcile.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+	if requeue {
+		return reconcile.Result{RequeueAfter: retryPeriod}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+// hold holds the capacity of the workload in the first reservation of the
+// configuration with the capacity available, and sets the state of the check
+// to Ready with the PodSet updates of the reservation.
+func (c *Controller) hold(ctx context.Context, wl *kueue.Workload, checkName kueue.AdmissionCheckReference, state *kueue.AdmissionCheckState) error {
+	cfg, err := c.helper.ConfigForAdmissionCheck(ctx, checkName)
+	if err != nil {
+		state.Message = fmt.Sprintf("Failed to get the configuration of the admission check: %v", err)
+		return nil
+	}
+	provider, found := c.providers[cfg.Spec.Provider]
+	if !found {
+		state.Message = fmt.Sprintf("Unknown capacity reservation provider %q", cfg.Spec.Provider)
+		return nil
+	}
+
+	flavors := assignedFlavors(wl)
+	requests := totalRequests(wl)
+	var reasons []string
+	for i := range cfg.Spec.Reservations {
+		reservation := &cfg.Spec.Reservations[i]
+		if reservation.Flavor != nil && !flavors[*reservation.Flavor] {
+			continue
+		}
+		held, reason, err := provider.Hold(ctx, reservation, wl, requests)
+		if err != nil {
+			return err
+		}
+		if !held {
+			reasons = append(reasons, reason)
+			continue
+		}
+		state.State = kueue.CheckStateReady
+		state.Message = fmt.Sprintf("The capacity is held in the reservation %s", reservation.ID)
+		state.PodSetUpdates = make([]kueue.PodSetUpdate, 0, len(wl.Spec.PodSets))
+		for _, ps := range wl.Spec.PodSets {
+			state.PodSetUpdates = append(state.PodSetUpdates, kueue.PodSetUpdate{
+				Name:         ps.Name,
+				Annotations:  map[string]string{ReservationAnnotation: reservation.ID},
+				NodeSelector: maps.Clone(reservation.NodeSelector),
+			})
+		}
+		return nil
+	}
+	if len(reasons) == 0 {
+		state.Message = "No reservation matches the flavors of the workload"
+	} else {
+		state.Message = fmt.Sprintf("Waiting for capacity: %s", strings.Join(reasons, "; "))
+	}
+	return nil
+}
+
+// release releases the capacity held by the workload with all the providers.
+func (c *Controller) release(ctx context.Context, wlKey types.NamespacedName) error {
+	for _, provider := range c.providers {
+		if err := provider.Release(ctx, wlKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func assignedFlavors(wl *kueue.Workload) map[kueue.ResourceFlavorReference]bool {
+	flavors := make(map[kueue.ResourceFlavorReference]bool)
+	if wl.Status.Admission == nil {
+		return flavors
+	}
+	for _, psa := range wl.Status.Admission.PodSetAssignments {
+		for _, flavor := range psa.Flavors {
+			flavors[flavor] = true
+		}
+	}
+	return flavors
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named("capacityreservation_workload").
+		For(&kueue.Workload{}).
+		Complete(c)
+	if err != nil {
+		return err
+	}
+	return admissioncheck.NewConfigReconciler(c.client, kueue.CapacityReservationAdmissionCheckControllerName, c.helper).
+		SetupWithManager(mgr, "capacityreservation_admissioncheck")
+}
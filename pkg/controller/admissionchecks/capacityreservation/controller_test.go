@@ -0,0 +1,236 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityreservation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+type fakeProvider struct {
+	held     bool
+	released []types.NamespacedName
+}
+
+func (p *fakeProvider) Hold(context.Context, *kueue.CapacityReservation, *kueue.Workload, resources.Requests) (bool, string, error) {
+	if !p.held {
+		return false, "the reservation is not active", nil
+	}
+	return true, "", nil
+}
+
+func (p *fakeProvider) Release(_ context.Context, wl types.NamespacedName) error {
+	p.released = append(p.released, wl)
+	return nil
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	makeWorkload := func(name string, state kueue.AdmissionCheckState) *kueue.Workload {
+		return utiltesting.MakeWorkload(name, "ns").
+			UID(types.UID(name)).
+			Request("nvidia.com/gpu", "4").
+			ReserveQuota(utiltesting.MakeAdmission("cq").
+				PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment("nvidia.com/gpu", "a100", "4").
+					Obj()).
+				Obj()).
+			AdmissionCheck(state).
+			Obj()
+	}
+	pendingState := kueue.AdmissionCheckState{
+		Name:               "reservation",
+		State:              kueue.CheckStatePending,
+		LastTransitionTime: metav1.NewTime(now),
+	}
+	reservations := []kueue.CapacityReservation{
+		{
+			ID:           "cr-1",
+			Flavor:       ptr.To[kueue.ResourceFlavorReference]("a100"),
+			Capacity:     corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")},
+			NodeSelector: map[string]string{"reservation-id": "cr-1"},
+		},
+		{
+			ID:           "cr-2",
+			Flavor:       ptr.To[kueue.ResourceFlavorReference]("a100"),
+			Capacity:     corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("4")},
+			NodeSelector: map[string]string{"reservation-id": "cr-2"},
+		},
+	}
+	heldState := func(id string) kueue.AdmissionCheckState {
+		return kueue.AdmissionCheckState{
+			Name:    "reservation",
+			State:   kueue.CheckStateReady,
+			Message: "The capacity is held in the reservation " + id,
+			PodSetUpdates: []kueue.PodSetUpdate{{
+				Name:         kueue.DefaultPodSetName,
+				Annotations:  map[string]string{ReservationAnnotation: id},
+				NodeSelector: map[string]string{"reservation-id": id},
+			}},
+		}
+	}
+
+	cases := map[string]struct {
+		provider     string
+		reservations []kueue.CapacityReservation
+		workloads    []*kueue.Workload
+		wantState    kueue.AdmissionCheckState
+		wantResult   reconcile.Result
+	}{
+		"the first reservation has the capacity": {
+			reservations: reservations,
+			wantState:    heldState("cr-1"),
+		},
+		"the first reservation is full": {
+			reservations: reservations,
+			workloads: []*kueue.Workload{
+				makeWorkload("other-1", heldState("cr-1")),
+				makeWorkload("other-2", heldState("cr-1")),
+			},
+			wantState: heldState("cr-2"),
+		},
+		"all the reservations are full": {
+			reservations: reservations,
+			workloads: []*kueue.Workload{
+				makeWorkload("other-1", heldState("cr-1")),
+				makeWorkload("other-2", heldState("cr-1")),
+				makeWorkload("other-3", heldState("cr-2")),
+			},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "reservation",
+				State:   kueue.CheckStatePending,
+				Message: "Waiting for capacity: the reservation cr-1 doesn't have the nvidia.com/gpu available; the reservation cr-2 doesn't have the nvidia.com/gpu available",
+			},
+			wantResult: reconcile.Result{RequeueAfter: retryPeriod},
+		},
+		"no reservation for the flavor": {
+			reservations: []kueue.CapacityReservation{{
+				ID:       "cr-3",
+				Flavor:   ptr.To[kueue.ResourceFlavorReference]("h100"),
+				Capacity: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")},
+			}},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "reservation",
+				State:   kueue.CheckStatePending,
+				Message: "No reservation matches the flavors of the workload",
+			},
+			wantResult: reconcile.Result{RequeueAfter: retryPeriod},
+		},
+		"unknown provider": {
+			provider:     "Unknown",
+			reservations: reservations,
+			wantState: kueue.AdmissionCheckState{
+				Name:    "reservation",
+				State:   kueue.CheckStatePending,
+				Message: `Unknown capacity reservation provider "Unknown"`,
+			},
+			wantResult: reconcile.Result{RequeueAfter: retryPeriod},
+		},
+		"custom provider": {
+			provider:     "Fake",
+			reservations: []kueue.CapacityReservation{{ID: "odcr-1", NodeSelector: map[string]string{"reservation-id": "odcr-1"}}},
+			wantState:    heldState("odcr-1"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wl := makeWorkload("wl", pendingState)
+			provider := tc.provider
+			if provider == "" {
+				provider = kueue.StaticCapacityReservationProvider
+			}
+			objs := []client.Object{
+				utiltesting.MakeAdmissionCheck("reservation").
+					ControllerName(kueue.CapacityReservationAdmissionCheckControllerName).
+					Parameters(kueue.GroupVersion.Group, "CapacityReservationAdmissionCheckConfig", "config").
+					Obj(),
+				&kueue.CapacityReservationAdmissionCheckConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "config"},
+					Spec: kueue.CapacityReservationAdmissionCheckConfigSpec{
+						Provider:     provider,
+						Reservations: tc.reservations,
+					},
+				},
+				wl,
+			}
+			for _, other := range tc.workloads {
+				objs = append(objs, other)
+			}
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(objs...).
+				WithStatusSubresource(wl).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+			ctx, _ := utiltesting.ContextWithLog(t)
+
+			controller, err := NewController(cl, WithClock(testingclock.NewFakeClock(now)), WithProvider("Fake", &fakeProvider{held: true}))
+			if err != nil {
+				t.Fatalf("Failed to create the controller: %v", err)
+			}
+			gotResult, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(wl)})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, gotResult); diff != "" {
+				t.Errorf("Unexpected result (-want,+got):\n%s", diff)
+			}
+
+			var updated kueue.Workload
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(wl), &updated); err != nil {
+				t.Fatalf("Failed to get the workload: %v", err)
+			}
+			if diff := cmp.Diff([]kueue.AdmissionCheckState{tc.wantState}, updated.Status.AdmissionChecks, cmpopts.IgnoreFields(kueue.AdmissionCheckState{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected admission check states (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRelease(t *testing.T) {
+	wlKey := types.NamespacedName{Namespace: "ns", Name: "wl"}
+	cl := utiltesting.NewClientBuilder().Build()
+	ctx, _ := utiltesting.ContextWithLog(t)
+	provider := &fakeProvider{}
+	controller, err := NewController(cl, WithProvider("Fake", provider))
+	if err != nil {
+		t.Fatalf("Failed to create the controller: %v", err)
+	}
+	// The workload doesn't exist, so its capacity is released.
+	if _, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: wlKey}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]types.NamespacedName{wlKey}, provider.released); diff != "" {
+		t.Errorf("Unexpected released workloads (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityreservation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// Provider verifies and holds the capacity of the reservations of a cloud,
+// for example On-Demand Capacity Reservations or committed use reservations.
+type Provider interface {
+	// Hold holds the capacity requested by the workload in the reservation.
+	// Returns false and the reason when the reservation doesn't have the
+	// capacity available. Hold is called again for the workloads already
+	// holding capacity in the reservation, and must be idempotent.
+	Hold(ctx context.Context, reservation *kueue.CapacityReservation, wl *kueue.Workload, requests resources.Requests) (bool, string, error)
+
+	// Release releases the capacity held by the workload in all the
+	// reservations.
+	Release(ctx context.Context, wl types.NamespacedName) error
+}
+
+// staticProvider holds the capacity declared in the reservations, known from
+// the workloads admitted on them.
+type staticProvider struct {
+	client client.Client
+}
+
+var _ Provider = (*staticProvider)(nil)
+
+func (p *staticProvider) Hold(ctx context.Context, reservation *kueue.CapacityReservation, wl *kueue.Workload, requests resources.Requests) (bool, string, error) {
+	if len(reservation.Capacity) == 0 {
+		return false, fmt.Sprintf("the reservation %s has no capacity", reservation.ID), nil
+	}
+	workloads := &kueue.WorkloadList{}
+	if err := p.client.List(ctx, workloads); err != nil {
+		return false, "", err
+	}
+	used := resources.Requests{}
+	for i := range workloads.Items {
+		other := &workloads.Items[i]
+		if other.UID == wl.UID || workload.IsFinished(other) || !workload.HasQuotaReservation(other) || !holdsReservation(other, reservation.ID) {
+			continue
+		}
+		used.Add(totalRequests(other))
+	}
+	used.Add(requests)
+	capacity := resources.NewRequests(reservation.Capacity)
+	var exceeded []string
+	for name, quantity := range capacity {
+		if used[name] > quantity {
+			exceeded = append(exceeded, string(name))
+		}
+	}
+	if len(exceeded) > 0 {
+		slices.Sort(exceeded)
+		return false, fmt.Sprintf("the reservation %s doesn't have the %s available", reservation.ID, strings.Join(exceeded, ", ")), nil
+	}
+	return true, "", nil
+}
+
+// Release is a no-op, as the capacity held is computed from the workloads.
+func (p *staticProvider) Release(context.Context, types.NamespacedName) error {
+	return nil
+}
+
+// holdsReservation returns true if a Ready admission check of the workload
+// admitted it on the reservation.
+func holdsReservation(wl *kueue.Workload, id string) bool {
+	for _, check := range wl.Status.AdmissionChecks {
+		if check.State != kueue.CheckStateReady {
+			continue
+		}
+		for _, update := range check.PodSetUpdates {
+			if update.Annotations[ReservationAnnotation] == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func totalRequests(wl *kueue.Workload) resources.Requests {
+	total := resources.Requests{}
+	for _, psr := range workload.NewInfo(wl).TotalRequests {
+		total.Add(psr.Requests)
+	}
+	return total
+}
@@ -0,0 +1,170 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpcheck
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+const (
+	// maxResponseSize is the maximum size of the response body read from the endpoint.
+	maxResponseSize = 1 << 20
+
+	// idleConnTimeout is the time the idle connections to the endpoint are kept open.
+	idleConnTimeout = 90 * time.Second
+)
+
+var errInvalidCABundle = errors.New("the caBundle doesn't contain any valid PEM encoded certificate")
+
+// Request is the body of the requests sent to the endpoints.
+type Request struct {
+	// AdmissionCheck is the name of the admission check.
+	AdmissionCheck kueue.AdmissionCheckReference `json:"admissionCheck"`
+
+	// Workload is the Workload pending on the admission check.
+	Workload *kueue.Workload `json:"workload"`
+}
+
+// Response is the body of the responses expected from the endpoints.
+type Response struct {
+	// State is the state of the admission check for the Workload.
+	State kueue.CheckState `json:"state"`
+
+	// Message is a human readable message explaining the state.
+	Message string `json:"message,omitempty"`
+}
+
+// call sends the workload to the endpoint of the configuration and returns its response.
+func (c *Controller) call(ctx context.Context, cfg *kueue.HTTPAdmissionCheckConfig, checkName kueue.AdmissionCheckReference, wl *kueue.Workload) (*Response, error) {
+	httpClient, err := c.httpClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	wl = wl.DeepCopy()
+	wl.ManagedFields = nil
+	body, err := json.Marshal(Request{AdmissionCheck: checkName, Workload: wl})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.Spec.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	response := &Response{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(response); err != nil {
+		return nil, fmt.Errorf("decoding the response: %w", err)
+	}
+	switch response.State {
+	case kueue.CheckStatePending, kueue.CheckStateReady, kueue.CheckStateRetry, kueue.CheckStateRejected:
+	default:
+		return nil, fmt.Errorf("invalid state %q in the response", response.State)
+	}
+	return response, nil
+}
+
+// cachedClient is the HTTP client of a configuration, built for its resourceVersion.
+type cachedClient struct {
+	resourceVersion string
+	client          *http.Client
+}
+
+// httpClient returns an HTTP client trusting the caBundle and presenting the
+// client certificate of the configuration. The client is reused until the
+// configuration changes, keeping its connections to the endpoint open.
+func (c *Controller) httpClient(cfg *kueue.HTTPAdmissionCheckConfig) (*http.Client, error) {
+	c.clientsLock.Lock()
+	defer c.clientsLock.Unlock()
+	if cached, found := c.clients[cfg.Name]; found {
+		if cached.resourceVersion == cfg.ResourceVersion {
+			return cached.client, nil
+		}
+		cached.client.CloseIdleConnections()
+		delete(c.clients, cfg.Name)
+	}
+	httpClient, err := c.newHTTPClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c.clients[cfg.Name] = cachedClient{resourceVersion: cfg.ResourceVersion, client: httpClient}
+	return httpClient, nil
+}
+
+func (c *Controller) newHTTPClient(cfg *kueue.HTTPAdmissionCheckConfig) (*http.Client, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(cfg.Spec.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cfg.Spec.CABundle) {
+			return nil, errInvalidCABundle
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if ref := cfg.Spec.ClientCertificateSecretRef; ref != nil {
+		if c.namespace != "" && ref.Namespace != c.namespace {
+			return nil, fmt.Errorf("the client certificate secret must be in the namespace %q", c.namespace)
+		}
+		key := types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+		// The Secret is read on the TLS handshakes only, so that the rotated
+		// certificates are presented on the new connections.
+		tlsConfig.GetClientCertificate = func(info *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return c.clientCertificate(info.Context(), key)
+		}
+	}
+	return &http.Client{
+		Timeout:   ptr.Deref(cfg.Spec.Timeout, metav1.Duration{Duration: defaultTimeout}).Duration,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig, IdleConnTimeout: idleConnTimeout},
+	}, nil
+}
+
+// clientCertificate loads the client certificate and key of the Secret.
+func (c *Controller) clientCertificate(ctx context.Context, key types.NamespacedName) (*tls.Certificate, error) {
+	secret := &corev1.Secret{}
+	if err := c.client.Get(ctx, key, secret); err != nil {
+		return nil, fmt.Errorf("getting the client certificate secret: %w", err)
+	}
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("loading the client certificate: %w", err)
+	}
+	return &cert, nil
+}
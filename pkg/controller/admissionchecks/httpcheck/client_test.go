@@ -0,0 +1,77 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpcheck
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestHTTPClient(t *testing.T) {
+	controller, err := NewController(utiltesting.NewFakeClient(), WithNamespace("kueue-system"))
+	if err != nil {
+		t.Fatalf("Failed to create the controller: %v", err)
+	}
+	config := &kueue.HTTPAdmissionCheckConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", ResourceVersion: "1"},
+		Spec: kueue.HTTPAdmissionCheckConfigSpec{
+			URL: "https://example.com",
+			ClientCertificateSecretRef: &kueue.HTTPAdmissionCheckSecretReference{
+				Namespace: "kueue-system",
+				Name:      "client-cert",
+			},
+		},
+	}
+
+	first, err := controller.httpClient(config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	second, err := controller.httpClient(config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if first != second {
+		t.Errorf("The client isn't reused for the same version of the configuration")
+	}
+
+	config.ResourceVersion = "2"
+	third, err := controller.httpClient(config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if third == first {
+		t.Errorf("The client is reused for a new version of the configuration")
+	}
+
+	config.ResourceVersion = "3"
+	config.Spec.ClientCertificateSecretRef.Namespace = "ns"
+	if _, err := controller.httpClient(config); err == nil {
+		t.Errorf("Expected an error for the client certificate secret outside the namespace")
+	}
+
+	config.ResourceVersion = "4"
+	config.Spec.ClientCertificateSecretRef = nil
+	config.Spec.CABundle = []byte("invalid")
+	if _, err := controller.httpClient(config); err == nil {
+		t.Errorf("Expected an error for the invalid caBundle")
+	}
+}
@@ -0,0 +1,307 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpcheck
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	defaultTimeout     = 10 * time.Second
+	defaultRetryPeriod = time.Minute
+
+	defaultContinuousInterval = 5 * time.Minute
+)
+
+var (
+	realClock = clock.RealClock{}
+)
+
+type configHelper = admissioncheck.ConfigHelper[*kueue.HTTPAdmissionCheckConfig, kueue.HTTPAdmissionCheckConfig]
+
+type Option func(*Controller)
+
+// WithClock sets the clock used by the controller.
+func WithClock(c clock.Clock) Option {
+	return func(ctrl *Controller) {
+		ctrl.clock = c
+	}
+}
+
+// WithNamespace restricts the Secrets of the client certificates to the namespace.
+func WithNamespace(namespace string) Option {
+	return func(ctrl *Controller) {
+		ctrl.namespace = namespace
+	}
+}
+
+// Controller calls the HTTPS endpoints configured for the HTTP admission
+// checks with the Workloads that have quota reserved, and sets the states
+// of the admission checks from the responses.
+type Controller struct {
+	client client.Client
+	helper *configHelper
+	clock  clock.Clock
+
+	// namespace is the namespace of the Secrets of the client certificates,
+	// any namespace if empty.
+	namespace string
+
+	// clients holds the HTTP client of each configuration.
+	clientsLock sync.Mutex
+	clients     map[string]cachedClient
+
+	// nextRequest holds, per Workload and admission check, the earliest
+	// time at which the endpoint is called again.
+	nextRequestLock sync.Mutex
+	nextRequest     map[requestKey]time.Time
+}
+
+type requestKey struct {
+	workload types.NamespacedName
+	check    kueue.AdmissionCheckReference
+}
+
+var _ reconcile.Reconciler = (*Controller)(nil)
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=httpadmissioncheckconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+func NewController(client client.Client, opts ...Option) (*Controller, error) {
+	helper, err := admissioncheck.NewConfigHelper[*kueue.HTTPAdmissionCheckConfig](client)
+	if err != nil {
+		return nil, err
+	}
+	c := &Controller{
+		client:      client,
+		helper:      helper,
+		clock:       realClock,
+		clients:     make(map[string]cachedClient),
+		nextRequest: make(map[requestKey]time.Time),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	wl := &kueue.Workload{}
+	if err := c.client.Get(ctx, req.NamespacedName, wl); err != nil {
+		c.forgetRequests(req.NamespacedName)
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if !workload.HasQuotaReservation(wl) || workload.IsFinished(wl) || workload.IsEvicted(wl) {
+		c.forgetRequests(req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+
+	checks, err := admissioncheck.FilterForController(ctx, c.client, wl.Status.AdmissionChecks, kueue.HTTPAdmissionCheckControllerName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(checks) == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile HTTP admission checks")
+
+	wlPatch := workload.BaseSSAWorkload(wl, true)
+	updated := false
+	var requeueAfter time.Duration
+	for _, checkName := range checks {
+		current := admissioncheck.FindAdmissionCheck(wl.Status.AdmissionChecks, checkName)
+		key := requestKey{workload: req.NamespacedName, check: checkName}
+		continuous, err := c.continuousInterval(ctx, wl, current)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if current.State != kueue.CheckStatePending && continuous == 0 {
+			c.forgetRequest(key)
+			continue
+		}
+		after, scheduled := c.untilNextRequest(key)
+		if continuous > 0 && !scheduled {
+			// The check was just set to Ready, it's evaluated again after the interval.
+			c.setNextRequest(key, c.clock.Now().Add(continuous))
+			requeueAfter = minPositive(requeueAfter, continuous)
+			continue
+		}
+		if after > 0 {
+			requeueAfter = minPositive(requeueAfter, after)
+			continue
+		}
+		if continuous > 0 {
+			updated = c.reevaluate(ctx, wl, wlPatch, current, continuous) || updated
+			requeueAfter = minPositive(requeueAfter, continuous)
+			continue
+		}
+
+		newState := kueue.AdmissionCheckState{
+			Name:               current.Name,
+			State:              current.State,
+			LastTransitionTime: current.LastTransitionTime,
+			PodSetUpdates:      current.PodSetUpdates,
+		}
+		retryPeriod := defaultRetryPeriod
+		cfg, err := c.helper.ConfigForAdmissionCheck(ctx, checkName)
+		if err != nil {
+			newState.Message = fmt.Sprintf("Failed to get the configuration of the admission check: %v", err)
+		} else {
+			retryPeriod = ptr.Deref(cfg.Spec.RetryPeriod, metav1.Duration{Duration: defaultRetryPeriod}).Duration
+			response, err := c.call(ctx, cfg, checkName, wl)
+			if err != nil {
+				log.V(2).Info("Failed to call the endpoint of the admission check", "admissionCheck", checkName, "err", err)
+				newState.Message = fmt.Sprintf("Failed to call the endpoint: %v", err)
+			} else {
+				newState.State = response.State
+				newState.Message = response.Message
+			}
+		}
+		if newState.State == kueue.CheckStatePending {
+			c.setNextRequest(key, c.clock.Now().Add(retryPeriod))
+			requeueAfter = minPositive(requeueAfter, retryPeriod)
+		} else {
+			c.forgetRequest(key)
+		}
+		if newState.State == current.State && newState.Message == current.Message {
+			continue
+		}
+		workload.SetAdmissionCheckState(&wlPatch.Status.AdmissionChecks, newState, c.clock)
+		updated = true
+	}
+	if updated {
+		if err := c.client.Status().Patch(ctx, wlPatch, client.Apply, client.FieldOwner(kueue.HTTPAdmissionCheckControllerName), client.ForceOwnership); err != nil {
+			return reconcile.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (c *Controller) untilNextRequest(key requestKey) (time.Duration, bool) {
+	c.nextRequestLock.Lock()
+	defer c.nextRequestLock.Unlock()
+	next, found := c.nextRequest[key]
+	if !found {
+		return 0, false
+	}
+	return next.Sub(c.clock.Now()), true
+}
+
+// continuousInterval returns the interval between the evaluations of the Ready
+// check of the admitted workload, or 0 if the check isn't evaluated continuously.
+func (c *Controller) continuousInterval(ctx context.Context, wl *kueue.Workload, state *kueue.AdmissionCheckState) (time.Duration, error) {
+	if state.State != kueue.CheckStateReady || !workload.IsAdmitted(wl) {
+		return 0, nil
+	}
+	continuous, err := admissioncheck.ContinuousEvaluation(ctx, c.client, state.Name)
+	if err != nil || continuous == nil {
+		return 0, err
+	}
+	return ptr.Deref(continuous.Interval, metav1.Duration{Duration: defaultContinuousInterval}).Duration, nil
+}
+
+// reevaluate calls the endpoint for the Ready check of the admitted workload,
+// and sets the check to the Retry or Rejected state of the response. The other
+// responses and the failures keep the check Ready. Returns true if the state
+// of the check changed.
+func (c *Controller) reevaluate(ctx context.Context, wl *kueue.Workload, wlPatch *kueue.Workload, current *kueue.AdmissionCheckState, interval time.Duration) bool {
+	log := ctrl.LoggerFrom(ctx)
+	c.setNextRequest(requestKey{workload: client.ObjectKeyFromObject(wl), check: current.Name}, c.clock.Now().Add(interval))
+	cfg, err := c.helper.ConfigForAdmissionCheck(ctx, current.Name)
+	if err != nil {
+		log.V(2).Info("Failed to get the configuration of the admission check", "admissionCheck", current.Name, "err", err)
+		return false
+	}
+	response, err := c.call(ctx, cfg, current.Name, wl)
+	if err != nil {
+		log.V(2).Info("Failed to call the endpoint of the admission check", "admissionCheck", current.Name, "err", err)
+		return false
+	}
+	if response.State != kueue.CheckStateRetry && response.State != kueue.CheckStateRejected {
+		return false
+	}
+	workload.SetAdmissionCheckState(&wlPatch.Status.AdmissionChecks, kueue.AdmissionCheckState{
+		Name:          current.Name,
+		State:         response.State,
+		Message:       response.Message,
+		PodSetUpdates: current.PodSetUpdates,
+	}, c.clock)
+	return true
+}
+
+func (c *Controller) setNextRequest(key requestKey, next time.Time) {
+	c.nextRequestLock.Lock()
+	defer c.nextRequestLock.Unlock()
+	c.nextRequest[key] = next
+}
+
+func (c *Controller) forgetRequest(key requestKey) {
+	c.nextRequestLock.Lock()
+	defer c.nextRequestLock.Unlock()
+	delete(c.nextRequest, key)
+}
+
+func (c *Controller) forgetRequests(wlKey types.NamespacedName) {
+	c.nextRequestLock.Lock()
+	defer c.nextRequestLock.Unlock()
+	for key := range c.nextRequest {
+		if key.workload == wlKey {
+			delete(c.nextRequest, key)
+		}
+	}
+}
+
+func minPositive(current, d time.Duration) time.Duration {
+	if current == 0 || d < current {
+		return d
+	}
+	return current
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named("httpcheck_workload").
+		For(&kueue.Workload{}).
+		Complete(c)
+	if err != nil {
+		return err
+	}
+	return admissioncheck.NewConfigReconciler(c.client, kueue.HTTPAdmissionCheckControllerName, c.helper).
+		SetupWithManager(mgr, "httpcheck_admissioncheck")
+}
@@ -0,0 +1,276 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpcheck
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestReconcile(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	admission := utiltesting.MakeAdmission("cq").
+		PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+			Assignment(corev1.ResourceCPU, "default", "1").
+			Obj()).
+		Obj()
+
+	cases := map[string]struct {
+		status         int
+		response       Response
+		parametersName string
+		wantState      kueue.AdmissionCheckState
+		wantResult     reconcile.Result
+	}{
+		"endpoint responds Ready": {
+			status:   http.StatusOK,
+			response: Response{State: kueue.CheckStateReady, Message: "no change freeze"},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "http-check",
+				State:   kueue.CheckStateReady,
+				Message: "no change freeze",
+			},
+		},
+		"endpoint responds Rejected": {
+			status:   http.StatusOK,
+			response: Response{State: kueue.CheckStateRejected, Message: "no license available"},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "http-check",
+				State:   kueue.CheckStateRejected,
+				Message: "no license available",
+			},
+		},
+		"endpoint responds Pending": {
+			status:   http.StatusOK,
+			response: Response{State: kueue.CheckStatePending, Message: "change freeze until monday"},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "http-check",
+				State:   kueue.CheckStatePending,
+				Message: "change freeze until monday",
+			},
+			wantResult: reconcile.Result{RequeueAfter: 30 * time.Second},
+		},
+		"endpoint responds with an invalid state": {
+			status:   http.StatusOK,
+			response: Response{State: "Approved"},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "http-check",
+				State:   kueue.CheckStatePending,
+				Message: `Failed to call the endpoint: invalid state "Approved" in the response`,
+			},
+			wantResult: reconcile.Result{RequeueAfter: 30 * time.Second},
+		},
+		"endpoint fails": {
+			status: http.StatusServiceUnavailable,
+			wantState: kueue.AdmissionCheckState{
+				Name:    "http-check",
+				State:   kueue.CheckStatePending,
+				Message: "Failed to call the endpoint: unexpected status code 503",
+			},
+			wantResult: reconcile.Result{RequeueAfter: 30 * time.Second},
+		},
+		"missing configuration": {
+			parametersName: "missing",
+			wantState: kueue.AdmissionCheckState{
+				Name:    "http-check",
+				State:   kueue.CheckStatePending,
+				Message: `Failed to get the configuration of the admission check: httpadmissioncheckconfigs.kueue.x-k8s.io "missing" not found`,
+			},
+			wantResult: reconcile.Result{RequeueAfter: time.Minute},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var gotRequest Request
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&gotRequest); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(tc.response)
+			}))
+			defer server.Close()
+
+			parametersName := tc.parametersName
+			if parametersName == "" {
+				parametersName = "config"
+			}
+			config := &kueue.HTTPAdmissionCheckConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "config"},
+				Spec: kueue.HTTPAdmissionCheckConfigSpec{
+					URL:         server.URL,
+					CABundle:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+					RetryPeriod: &metav1.Duration{Duration: 30 * time.Second},
+				},
+			}
+			wl := utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(admission).
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:               "http-check",
+					State:              kueue.CheckStatePending,
+					LastTransitionTime: metav1.NewTime(now),
+				}).
+				Obj()
+			objs := []client.Object{
+				utiltesting.MakeAdmissionCheck("http-check").
+					ControllerName(kueue.HTTPAdmissionCheckControllerName).
+					Parameters(kueue.GroupVersion.Group, "HTTPAdmissionCheckConfig", parametersName).
+					Obj(),
+				config,
+				wl,
+			}
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(objs...).
+				WithStatusSubresource(wl).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+			ctx, _ := utiltesting.ContextWithLog(t)
+
+			controller, err := NewController(cl, WithClock(testingclock.NewFakeClock(now)))
+			if err != nil {
+				t.Fatalf("Failed to create the controller: %v", err)
+			}
+			gotResult, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(wl)})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, gotResult); diff != "" {
+				t.Errorf("Unexpected result (-want,+got):\n%s", diff)
+			}
+
+			var updated kueue.Workload
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(wl), &updated); err != nil {
+				t.Fatalf("Failed to get the workload: %v", err)
+			}
+			if diff := cmp.Diff([]kueue.AdmissionCheckState{tc.wantState}, updated.Status.AdmissionChecks, cmpopts.IgnoreFields(kueue.AdmissionCheckState{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected admission check states (-want,+got):\n%s", diff)
+			}
+			if tc.parametersName == "" {
+				if gotRequest.AdmissionCheck != "http-check" || gotRequest.Workload == nil || gotRequest.Workload.Name != "wl" {
+					t.Errorf("Unexpected request sent to the endpoint: %+v", gotRequest)
+				}
+			}
+
+			// The endpoint isn't called again before the retry period elapses.
+			gotRequest = Request{}
+			if _, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(wl)}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if gotRequest.Workload != nil {
+				t.Errorf("Unexpected request sent to the endpoint before the retry period: %+v", gotRequest)
+			}
+		})
+	}
+}
+
+func TestReconcileContinuous(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.ContinuousAdmissionChecks, true)
+	now := time.Now().Truncate(time.Second)
+	fakeClock := testingclock.NewFakeClock(now)
+
+	calls := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(Response{State: kueue.CheckStateRetry, Message: "change freeze started"})
+	}))
+	defer server.Close()
+
+	wl := utiltesting.MakeWorkload("wl", "ns").
+		ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+		Admitted(true).
+		AdmissionCheck(kueue.AdmissionCheckState{
+			Name:               "http-check",
+			State:              kueue.CheckStateReady,
+			LastTransitionTime: metav1.NewTime(now),
+		}).
+		Obj()
+	cl := utiltesting.NewClientBuilder().
+		WithObjects(
+			utiltesting.MakeAdmissionCheck("http-check").
+				ControllerName(kueue.HTTPAdmissionCheckControllerName).
+				Parameters(kueue.GroupVersion.Group, "HTTPAdmissionCheckConfig", "config").
+				Continuous(kueue.ContinuousAdmissionCheck{Interval: &metav1.Duration{Duration: time.Minute}}).
+				Obj(),
+			&kueue.HTTPAdmissionCheckConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "config"},
+				Spec: kueue.HTTPAdmissionCheckConfigSpec{
+					URL:      server.URL,
+					CABundle: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+				},
+			},
+			wl,
+		).
+		WithStatusSubresource(wl).
+		WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+		Build()
+	ctx, _ := utiltesting.ContextWithLog(t)
+
+	controller, err := NewController(cl, WithClock(fakeClock))
+	if err != nil {
+		t.Fatalf("Failed to create the controller: %v", err)
+	}
+	// The Ready check is evaluated again after the interval.
+	gotResult, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(wl)})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(reconcile.Result{RequeueAfter: time.Minute}, gotResult); diff != "" {
+		t.Errorf("Unexpected result (-want,+got):\n%s", diff)
+	}
+	if calls != 0 {
+		t.Errorf("Unexpected calls to the endpoint before the interval: %d", calls)
+	}
+
+	fakeClock.Step(time.Minute)
+	if _, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(wl)}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Unexpected calls to the endpoint after the interval: %d", calls)
+	}
+	var updated kueue.Workload
+	if err := cl.Get(ctx, client.ObjectKeyFromObject(wl), &updated); err != nil {
+		t.Fatalf("Failed to get the workload: %v", err)
+	}
+	wantStates := []kueue.AdmissionCheckState{{
+		Name:    "http-check",
+		State:   kueue.CheckStateRetry,
+		Message: "change freeze started",
+	}}
+	if diff := cmp.Diff(wantStates, updated.Status.AdmissionChecks, cmpopts.IgnoreFields(kueue.AdmissionCheckState{}, "LastTransitionTime")); diff != "" {
+		t.Errorf("Unexpected admission check states (-want,+got):\n%s", diff)
+	}
+}
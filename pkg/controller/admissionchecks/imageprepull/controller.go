@@ -0,0 +1,258 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageprepull
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+var (
+	realClock = clock.RealClock{}
+)
+
+type configHelper = admissioncheck.ConfigHelper[*kueue.ImagePrePullAdmissionCheckConfig, kueue.ImagePrePullAdmissionCheckConfig]
+
+type Option func(*Controller)
+
+// WithClock sets the clock used by the controller.
+func WithClock(c clock.Clock) Option {
+	return func(ctrl *Controller) {
+		ctrl.clock = c
+	}
+}
+
+// Controller creates a DaemonSet pulling the images of each PodSet of the
+// Workloads with quota reserved and an image pre-pull admission check, on the
+// Nodes of the flavors assigned to the PodSet. The checks are set to Ready
+// once the images are pulled on all the Nodes, and the DaemonSets are deleted.
+type Controller struct {
+	client client.Client
+	helper *configHelper
+	clock  clock.Clock
+}
+
+var _ reconcile.Reconciler = (*Controller)(nil)
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=imageprepulladmissioncheckconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;delete
+
+func NewController(client client.Client, opts ...Option) (*Controller, error) {
+	helper, err := admissioncheck.NewConfigHelper[*kueue.ImagePrePullAdmissionCheckConfig](client)
+	if err != nil {
+		return nil, err
+	}
+	c := &Controller{
+		client: client,
+		helper: helper,
+		clock:  realClock,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	wl := &kueue.Workload{}
+	if err := c.client.Get(ctx, req.NamespacedName, wl); err != nil {
+		// The DaemonSets are garbage collected with the workload.
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	checks, err := admissioncheck.FilterForController(ctx, c.client, wl.Status.AdmissionChecks, kueue.ImagePrePullAdmissionCheckControllerName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(checks) == 0 {
+		return reconcile.Result{}, nil
+	}
+	if !workload.HasQuotaReservation(wl) || workload.IsFinished(wl) || workload.IsEvicted(wl) {
+		return reconcile.Result{}, c.deleteDaemonSets(ctx, wl, "")
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile image pre-pull admission checks")
+
+	wlPatch := workload.BaseSSAWorkload(wl, true)
+	updated := false
+	for _, checkName := range checks {
+		current := admissioncheck.FindAdmissionCheck(wl.Status.AdmissionChecks, checkName)
+		if current.State != kueue.CheckStatePending {
+			if err := c.deleteDaemonSets(ctx, wl, checkName); err != nil {
+				return reconcile.Result{}, err
+			}
+			continue
+		}
+		newState := kueue.AdmissionCheckState{
+			Name:               current.Name,
+			State:              current.State,
+			LastTransitionTime: current.LastTransitionTime,
+			PodSetUpdates:      current.PodSetUpdates,
+		}
+		if err := c.syncDaemonSets(ctx, wl, checkName, &newState); err != nil {
+			return reconcile.Result{}, err
+		}
+		if newState.State == current.State && newState.Message == current.Message {
+			continue
+		}
+		workload.SetAdmissionCheckState(&wlPatch.Status.AdmissionChecks, newState, c.clock)
+		updated = true
+	}
+	if updated {
+		if err := c.client.Status().Patch(ctx, wlPatch, client.Apply, client.FieldOwner(kueue.ImagePrePullAdmissionCheckControllerName), client.ForceOwnership); err != nil {
+			return reconcile.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+// syncDaemonSets creates the missing pre-pull DaemonSets of the workload for
+// the check, and sets the check to Ready once they pulled the images.
+func (c *Controller) syncDaemonSets(ctx context.Context, wl *kueue.Workload, checkName kueue.AdmissionCheckReference, state *kueue.AdmissionCheckState) error {
+	cfg, err := c.helper.ConfigForAdmissionCheck(ctx, checkName)
+	if err != nil {
+		state.Message = fmt.Sprintf("Failed to get the configuration of the admission check: %v", err)
+		return nil
+	}
+
+	var pulling []string
+	for i := range wl.Spec.PodSets {
+		ps := &wl.Spec.PodSets[i]
+		if len(images(ps)) == 0 {
+			continue
+		}
+		ds := &appsv1.DaemonSet{}
+		err := c.client.Get(ctx, types.NamespacedName{Namespace: wl.Namespace, Name: daemonSetName(wl, checkName, ps.Name)}, ds)
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		if apierrors.IsNotFound(err) {
+			flavors, err := c.assignedFlavors(ctx, wl, ps.Name)
+			if err != nil {
+				return err
+			}
+			ds = buildDaemonSet(wl, checkName, ps, flavors, cfg)
+			if err := controllerutil.SetControllerReference(wl, ds, c.client.Scheme()); err != nil {
+				return err
+			}
+			if err := c.client.Create(ctx, ds); client.IgnoreAlreadyExists(err) != nil {
+				return err
+			}
+			pulling = append(pulling, fmt.Sprintf("%s (starting)", ps.Name))
+			continue
+		}
+		if ds.Status.ObservedGeneration < ds.Generation {
+			pulling = append(pulling, fmt.Sprintf("%s (starting)", ps.Name))
+			continue
+		}
+		// Without Nodes, the images are pulled when the Nodes are created.
+		if ds.Status.DesiredNumberScheduled > 0 && !pulled(ds) {
+			pulling = append(pulling, fmt.Sprintf("%s (%d of %d Nodes)", ps.Name, ds.Status.NumberReady, ds.Status.DesiredNumberScheduled))
+		}
+	}
+
+	if len(pulling) > 0 {
+		state.Message = fmt.Sprintf("Pulling the images of the PodSets %s", strings.Join(pulling, ", "))
+		return nil
+	}
+	state.State = kueue.CheckStateReady
+	state.Message = "The images are pulled"
+	return nil
+}
+
+// assignedFlavors returns the ResourceFlavors assigned to the PodSet.
+func (c *Controller) assignedFlavors(ctx context.Context, wl *kueue.Workload, podSetName kueue.PodSetReference) ([]*kueue.ResourceFlavor, error) {
+	var flavors []*kueue.ResourceFlavor
+	seen := make(map[kueue.ResourceFlavorReference]bool)
+	for _, psa := range wl.Status.Admission.PodSetAssignments {
+		if psa.Name != podSetName {
+			continue
+		}
+		for _, name := range psa.Flavors {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			flavor := &kueue.ResourceFlavor{}
+			if err := c.client.Get(ctx, types.NamespacedName{Name: string(name)}, flavor); err != nil {
+				return nil, err
+			}
+			flavors = append(flavors, flavor)
+		}
+	}
+	return flavors, nil
+}
+
+// deleteDaemonSets deletes the pre-pull DaemonSets of the workload for the
+// check, or for all its checks if the check name is empty.
+func (c *Controller) deleteDaemonSets(ctx context.Context, wl *kueue.Workload, checkName kueue.AdmissionCheckReference) error {
+	daemonSets := &appsv1.DaemonSetList{}
+	opts := []client.ListOption{client.InNamespace(wl.Namespace), client.HasLabels{CheckLabel}}
+	if checkName != "" {
+		opts = append(opts, client.MatchingLabels{CheckLabel: string(checkName)})
+	}
+	if err := c.client.List(ctx, daemonSets, opts...); err != nil {
+		return err
+	}
+	log := ctrl.LoggerFrom(ctx)
+	for i := range daemonSets.Items {
+		ds := &daemonSets.Items[i]
+		if !metav1.IsControlledBy(ds, wl) || !ds.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := c.client.Delete(ctx, ds, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		log.V(3).Info("Deleted the image pre-pull DaemonSet", "daemonSet", ds.Name)
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named("imageprepull_workload").
+		For(&kueue.Workload{}).
+		Owns(&appsv1.DaemonSet{}).
+		Complete(c)
+	if err != nil {
+		return err
+	}
+	return admissioncheck.NewConfigReconciler(c.client, kueue.ImagePrePullAdmissionCheckControllerName, c.helper).
+		SetupWithManager(mgr, "imageprepull_admissioncheck")
+}
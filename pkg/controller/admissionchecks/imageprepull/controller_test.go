@@ -0,0 +1,189 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageprepull
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestReconcile(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	makeWorkload := func(state kueue.CheckState) *kueue.Workload {
+		return utiltesting.MakeWorkload("wl", "ns").
+			UID("wl-uid").
+			PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 2).
+				Image("registry.example.com/training:v1").
+				Toleration(corev1.Toleration{Key: "team", Operator: corev1.TolerationOpExists}).
+				Obj()).
+			ReserveQuota(utiltesting.MakeAdmission("cq").
+				PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "gpu-nodes", "1").
+					Obj()).
+				Obj()).
+			AdmissionCheck(kueue.AdmissionCheckState{
+				Name:               "prepull",
+				State:              state,
+				LastTransitionTime: metav1.NewTime(now),
+			}).
+			Obj()
+	}
+	flavor := utiltesting.MakeResourceFlavor("gpu-nodes").
+		NodeLabel("node-pool", "gpu").
+		Toleration(corev1.Toleration{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}).
+		Obj()
+	cfg := &kueue.ImagePrePullAdmissionCheckConfig{ObjectMeta: metav1.ObjectMeta{Name: "config"}}
+	existingDaemonSet := func(desired, ready int32) *appsv1.DaemonSet {
+		wl := makeWorkload(kueue.CheckStatePending)
+		ds := buildDaemonSet(wl, "prepull", &wl.Spec.PodSets[0], []*kueue.ResourceFlavor{flavor}, cfg)
+		ds.Generation = 1
+		ds.Status = appsv1.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: desired, NumberReady: ready}
+		_ = controllerutil.SetControllerReference(wl, ds, utiltesting.NewClientBuilder().Build().Scheme())
+		return ds
+	}
+
+	cases := map[string]struct {
+		state          kueue.CheckState
+		daemonSets     []*appsv1.DaemonSet
+		wantState      kueue.AdmissionCheckState
+		wantDaemonSets int
+	}{
+		"creates the DaemonSet": {
+			state: kueue.CheckStatePending,
+			wantState: kueue.AdmissionCheckState{
+				Name:    "prepull",
+				State:   kueue.CheckStatePending,
+				Message: "Pulling the images of the PodSets main (starting)",
+			},
+			wantDaemonSets: 1,
+		},
+		"the images are pulled on some Nodes": {
+			state:      kueue.CheckStatePending,
+			daemonSets: []*appsv1.DaemonSet{existingDaemonSet(3, 1)},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "prepull",
+				State:   kueue.CheckStatePending,
+				Message: "Pulling the images of the PodSets main (1 of 3 Nodes)",
+			},
+			wantDaemonSets: 1,
+		},
+		"the images are pulled on all the Nodes": {
+			state:      kueue.CheckStatePending,
+			daemonSets: []*appsv1.DaemonSet{existingDaemonSet(3, 3)},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "prepull",
+				State:   kueue.CheckStateReady,
+				Message: "The images are pulled",
+			},
+			wantDaemonSets: 1,
+		},
+		"no Node matches the flavor": {
+			state:      kueue.CheckStatePending,
+			daemonSets: []*appsv1.DaemonSet{existingDaemonSet(0, 0)},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "prepull",
+				State:   kueue.CheckStateReady,
+				Message: "The images are pulled",
+			},
+			wantDaemonSets: 1,
+		},
+		"deletes the DaemonSet once the check is ready": {
+			state:      kueue.CheckStateReady,
+			daemonSets: []*appsv1.DaemonSet{existingDaemonSet(3, 3)},
+			wantState: kueue.AdmissionCheckState{
+				Name:  "prepull",
+				State: kueue.CheckStateReady,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wl := makeWorkload(tc.state)
+			objs := []client.Object{
+				utiltesting.MakeAdmissionCheck("prepull").
+					ControllerName(kueue.ImagePrePullAdmissionCheckControllerName).
+					Parameters(kueue.GroupVersion.Group, "ImagePrePullAdmissionCheckConfig", "config").
+					Obj(),
+				cfg.DeepCopy(),
+				flavor.DeepCopy(),
+				wl,
+			}
+			for _, ds := range tc.daemonSets {
+				objs = append(objs, ds)
+			}
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(objs...).
+				WithStatusSubresource(wl).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+			ctx, _ := utiltesting.ContextWithLog(t)
+
+			controller, err := NewController(cl, WithClock(testingclock.NewFakeClock(now)))
+			if err != nil {
+				t.Fatalf("Failed to create the controller: %v", err)
+			}
+			if _, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(wl)}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var updated kueue.Workload
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(wl), &updated); err != nil {
+				t.Fatalf("Failed to get the workload: %v", err)
+			}
+			if diff := cmp.Diff([]kueue.AdmissionCheckState{tc.wantState}, updated.Status.AdmissionChecks, cmpopts.IgnoreFields(kueue.AdmissionCheckState{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected admission check states (-want,+got):\n%s", diff)
+			}
+
+			daemonSets := &appsv1.DaemonSetList{}
+			if err := cl.List(ctx, daemonSets); err != nil {
+				t.Fatalf("Failed to list the DaemonSets: %v", err)
+			}
+			if len(daemonSets.Items) != tc.wantDaemonSets {
+				t.Fatalf("Unexpected number of DaemonSets, want %d, got %d", tc.wantDaemonSets, len(daemonSets.Items))
+			}
+			for _, ds := range daemonSets.Items {
+				spec := ds.Spec.Template.Spec
+				if diff := cmp.Diff(map[string]string{"node-pool": "gpu"}, spec.NodeSelector); diff != "" {
+					t.Errorf("Unexpected node selector (-want,+got):\n%s", diff)
+				}
+				if len(spec.Tolerations) != 2 {
+					t.Errorf("Unexpected tolerations: %v", spec.Tolerations)
+				}
+				if len(spec.InitContainers) != 1 || spec.InitContainers[0].Image != "registry.example.com/training:v1" {
+					t.Errorf("Unexpected init containers: %v", spec.InitContainers)
+				}
+				if !metav1.IsControlledBy(&ds, wl) {
+					t.Errorf("The DaemonSet %s is not controlled by the workload", ds.Name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageprepull
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
+)
+
+const (
+	// CheckLabel is set on the pre-pull DaemonSets with the name of the
+	// admission check they were created for.
+	CheckLabel = "kueue.x-k8s.io/image-prepull-check"
+
+	// PodSetLabel is set on the pre-pull DaemonSets with the name of the
+	// PodSet whose images they pull.
+	PodSetLabel = "kueue.x-k8s.io/image-prepull-podset"
+
+	defaultPauseImage = "registry.k8s.io/pause:3.10"
+)
+
+var (
+	defaultCommand = []string{"/bin/sh", "-c", "exit 0"}
+)
+
+func daemonSetName(wl *kueue.Workload, checkName kueue.AdmissionCheckReference, podSetName kueue.PodSetReference) string {
+	h := sha1.New()
+	h.Write([]byte(fmt.Sprintf("%s/%s/%s/%s/%s", wl.Namespace, wl.Name, wl.UID, checkName, podSetName)))
+	return "prepull-" + hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// images returns the images of the containers of the PodSet, without duplicates.
+func images(ps *kueue.PodSet) []string {
+	var result []string
+	for _, containers := range [][]corev1.Container{ps.Template.Spec.InitContainers, ps.Template.Spec.Containers} {
+		for _, c := range containers {
+			if c.Image != "" && !slices.Contains(result, c.Image) {
+				result = append(result, c.Image)
+			}
+		}
+	}
+	return result
+}
+
+// buildDaemonSet returns a DaemonSet pulling the images of the PodSet on the
+// Nodes of the flavors, with an init container per image.
+func buildDaemonSet(wl *kueue.Workload, checkName kueue.AdmissionCheckReference, ps *kueue.PodSet, flavors []*kueue.ResourceFlavor, cfg *kueue.ImagePrePullAdmissionCheckConfig) *appsv1.DaemonSet {
+	name := daemonSetName(wl, checkName, ps.Name)
+	labels := map[string]string{
+		constants.ManagedByKueueLabelKey: constants.ManagedByKueueLabelValue,
+		CheckLabel:                       string(checkName),
+		PodSetLabel:                      string(ps.Name),
+		"app":                            name,
+	}
+	nodeSelector := maps.Clone(ps.Template.Spec.NodeSelector)
+	tolerations := slices.Clone(ps.Template.Spec.Tolerations)
+	for _, flavor := range flavors {
+		if nodeSelector == nil && len(flavor.Spec.NodeLabels) > 0 {
+			nodeSelector = make(map[string]string, len(flavor.Spec.NodeLabels))
+		}
+		maps.Copy(nodeSelector, flavor.Spec.NodeLabels)
+		tolerations = append(tolerations, flavor.Spec.Tolerations...)
+	}
+	command := cfg.Spec.Command
+	if len(command) == 0 {
+		command = defaultCommand
+	}
+
+	var initContainers []corev1.Container
+	for i, image := range images(ps) {
+		initContainers = append(initContainers, corev1.Container{
+			Name:    fmt.Sprintf("pull-%d", i),
+			Image:   image,
+			Command: command,
+		})
+	}
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: wl.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					InitContainers:   initContainers,
+					Containers:       []corev1.Container{{Name: "pause", Image: ptr.Deref(cfg.Spec.PauseImage, defaultPauseImage)}},
+					NodeSelector:     nodeSelector,
+					Tolerations:      tolerations,
+					ImagePullSecrets: ps.Template.Spec.ImagePullSecrets,
+				},
+			},
+		},
+	}
+}
+
+// pulled returns true if the pre-pull Pods are ready on all the Nodes.
+func pulled(ds *appsv1.DaemonSet) bool {
+	return ds.Status.ObservedGeneration >= ds.Generation && ds.Status.DesiredNumberScheduled > 0 && ds.Status.NumberReady == ds.Status.DesiredNumberScheduled
+}
@@ -0,0 +1,219 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageverification
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	retryPeriod = time.Minute
+)
+
+var (
+	realClock = clock.RealClock{}
+)
+
+type configHelper = admissioncheck.ConfigHelper[*kueue.ImageVerificationAdmissionCheckConfig, kueue.ImageVerificationAdmissionCheckConfig]
+
+type Option func(*Controller)
+
+// WithClock sets the clock used by the controller.
+func WithClock(c clock.Clock) Option {
+	return func(ctrl *Controller) {
+		ctrl.clock = c
+	}
+}
+
+// WithVerifier adds a verifier of images, referenced by name in the
+// configurations of the admission checks.
+func WithVerifier(name string, v Verifier) Option {
+	return func(ctrl *Controller) {
+		ctrl.verifiers[name] = v
+	}
+}
+
+// Controller verifies the images of the Workloads with quota reserved and an
+// image verification admission check, using the verifier of their
+// configuration. The checks are set to Ready when all the images are
+// compliant, and Rejected otherwise, before the Workloads are admitted or
+// dispatched to the worker clusters.
+type Controller struct {
+	client    client.Client
+	helper    *configHelper
+	clock     clock.Clock
+	verifiers map[string]Verifier
+}
+
+var _ reconcile.Reconciler = (*Controller)(nil)
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=imageverificationadmissioncheckconfigs,verbs=get;list;watch
+
+func NewController(client client.Client, opts ...Option) (*Controller, error) {
+	helper, err := admissioncheck.NewConfigHelper[*kueue.ImageVerificationAdmissionCheckConfig](client)
+	if err != nil {
+		return nil, err
+	}
+	c := &Controller{
+		client: client,
+		helper: helper,
+		clock:  realClock,
+		verifiers: map[string]Verifier{
+			kueue.RegistryImageVerifier: registryVerifier{},
+		},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	wl := &kueue.Workload{}
+	if err := c.client.Get(ctx, req.NamespacedName, wl); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	checks, err := admissioncheck.FilterForController(ctx, c.client, wl.Status.AdmissionChecks, kueue.ImageVerificationAdmissionCheckControllerName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(checks) == 0 || !workload.HasQuotaReservation(wl) || workload.IsFinished(wl) || workload.IsEvicted(wl) {
+		return reconcile.Result{}, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile image verification admission checks")
+
+	wlPatch := workload.BaseSSAWorkload(wl, true)
+	updated := false
+	requeue := false
+	for _, checkName := range checks {
+		current := admissioncheck.FindAdmissionCheck(wl.Status.AdmissionChecks, checkName)
+		if current.State != kueue.CheckStatePending {
+			continue
+		}
+		newState := kueue.AdmissionCheckState{
+			Name:               current.Name,
+			State:              current.State,
+			LastTransitionTime: current.LastTransitionTime,
+			PodSetUpdates:      current.PodSetUpdates,
+		}
+		c.verify(ctx, wl, checkName, &newState)
+		if newState.State == kueue.CheckStatePending {
+			requeue = true
+		}
+		if newState.State == current.State && newState.Message == current.Message {
+			continue
+		}
+		workload.SetAdmissionCheckState(&wlPatch.Status.AdmissionChecks, newState, c.clock)
+		updated = true
+	}
+	if updated {
+		if err := c.client.Status().Patch(ctx, wlPatch, client.Apply, client.FieldOwner(kueue.ImageVerificationAdmissionCheckControllerName), client.ForceOwnership); err != nil {
+			return reconcile.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+	if requeue {
+		return reconcile.Result{RequeueAfter: retryPeriod}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+// verify verifies the images of the workload with the verifier of the
+// configuration, and sets the state of the check to Ready when they are all
+// compliant or to Rejected with the reasons otherwise. The check stays
+// Pending when the verification fails, to be retried.
+func (c *Controller) verify(ctx context.Context, wl *kueue.Workload, checkName kueue.AdmissionCheckReference, state *kueue.AdmissionCheckState) {
+	cfg, err := c.helper.ConfigForAdmissionCheck(ctx, checkName)
+	if err != nil {
+		state.Message = fmt.Sprintf("Failed to get the configuration of the admission check: %v", err)
+		return
+	}
+	verifier, found := c.verifiers[cfg.Spec.Verifier]
+	if !found {
+		state.Message = fmt.Sprintf("Unknown image verifier %q", cfg.Spec.Verifier)
+		return
+	}
+
+	var reasons []string
+	for _, image := range images(wl) {
+		compliant, reason, err := verifier.Verify(ctx, cfg, image)
+		if err != nil {
+			ctrl.LoggerFrom(ctx).V(2).Info("Failed to verify the image", "image", image, "error", err)
+			state.Message = fmt.Sprintf("Failed to verify the image %s: %v", image, err)
+			return
+		}
+		if !compliant {
+			reasons = append(reasons, reason)
+		}
+	}
+	if len(reasons) > 0 {
+		state.State = kueue.CheckStateRejected
+		state.Message = fmt.Sprintf("The images are not compliant: %s", strings.Join(reasons, "; "))
+		return
+	}
+	state.State = kueue.CheckStateReady
+	state.Message = "The images are compliant"
+}
+
+// images returns the images of the containers of all the PodSets of the
+// workload, without duplicates.
+func images(wl *kueue.Workload) []string {
+	var result []string
+	for _, ps := range wl.Spec.PodSets {
+		for _, containers := range [][]corev1.Container{ps.Template.Spec.InitContainers, ps.Template.Spec.Containers} {
+			for _, c := range containers {
+				if c.Image != "" && !slices.Contains(result, c.Image) {
+					result = append(result, c.Image)
+				}
+			}
+		}
+	}
+	return result
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named("imageverification_workload").
+		For(&kueue.Workload{}).
+		Complete(c)
+	if err != nil {
+		return err
+	}
+	return admissioncheck.NewConfigReconciler(c.client, kueue.ImageVerificationAdmissionCheckControllerName, c.helper).
+		SetupWithManager(mgr, "imageverification_admissioncheck")
+}
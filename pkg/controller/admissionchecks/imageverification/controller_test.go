@@ -0,0 +1,189 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageverification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+type fakeVerifier struct {
+	err error
+}
+
+func (v *fakeVerifier) Verify(_ context.Context, cfg *kueue.ImageVerificationAdmissionCheckConfig, image string) (bool, string, error) {
+	if v.err != nil {
+		return false, "", v.err
+	}
+	if image != cfg.Spec.Parameters["signed"] {
+		return false, "the image " + image + " is not signed", nil
+	}
+	return true, "", nil
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	cases := map[string]struct {
+		image      string
+		spec       kueue.ImageVerificationAdmissionCheckConfigSpec
+		verifier   Verifier
+		wantState  kueue.AdmissionCheckState
+		wantResult reconcile.Result
+	}{
+		"the image is from an allowed registry": {
+			image: "registry.example.com/training:v1",
+			spec: kueue.ImageVerificationAdmissionCheckConfigSpec{
+				AllowedRegistries: []string{"quay.io/my-org/", "registry.example.com/"},
+			},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "verify",
+				State:   kueue.CheckStateReady,
+				Message: "The images are compliant",
+			},
+		},
+		"the image is not from an allowed registry": {
+			image: "docker.io/training:v1",
+			spec: kueue.ImageVerificationAdmissionCheckConfigSpec{
+				AllowedRegistries: []string{"registry.example.com/"},
+			},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "verify",
+				State:   kueue.CheckStateRejected,
+				Message: "The images are not compliant: the image docker.io/training:v1 is not from an allowed registry",
+			},
+		},
+		"the image is not referenced by digest": {
+			image: "registry.example.com/training:v1",
+			spec: kueue.ImageVerificationAdmissionCheckConfigSpec{
+				RequireDigest: true,
+			},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "verify",
+				State:   kueue.CheckStateRejected,
+				Message: "The images are not compliant: the image registry.example.com/training:v1 is not referenced by digest",
+			},
+		},
+		"the image is signed": {
+			image: "registry.example.com/training:v1",
+			spec: kueue.ImageVerificationAdmissionCheckConfigSpec{
+				Verifier:   "Fake",
+				Parameters: map[string]string{"signed": "registry.example.com/training:v1"},
+			},
+			verifier: &fakeVerifier{},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "verify",
+				State:   kueue.CheckStateReady,
+				Message: "The images are compliant",
+			},
+		},
+		"the verification fails": {
+			image: "registry.example.com/training:v1",
+			spec: kueue.ImageVerificationAdmissionCheckConfigSpec{
+				Verifier: "Fake",
+			},
+			verifier: &fakeVerifier{err: errors.New("service unavailable")},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "verify",
+				State:   kueue.CheckStatePending,
+				Message: "Failed to verify the image registry.example.com/training:v1: service unavailable",
+			},
+			wantResult: reconcile.Result{RequeueAfter: retryPeriod},
+		},
+		"unknown verifier": {
+			image: "registry.example.com/training:v1",
+			spec: kueue.ImageVerificationAdmissionCheckConfigSpec{
+				Verifier: "Cosign",
+			},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "verify",
+				State:   kueue.CheckStatePending,
+				Message: `Unknown image verifier "Cosign"`,
+			},
+			wantResult: reconcile.Result{RequeueAfter: retryPeriod},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wl := utiltesting.MakeWorkload("wl", "ns").
+				PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Image(tc.image).Obj()).
+				ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:               "verify",
+					State:              kueue.CheckStatePending,
+					LastTransitionTime: metav1.NewTime(now),
+				}).
+				Obj()
+			spec := tc.spec
+			if spec.Verifier == "" {
+				spec.Verifier = kueue.RegistryImageVerifier
+			}
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(
+					utiltesting.MakeAdmissionCheck("verify").
+						ControllerName(kueue.ImageVerificationAdmissionCheckControllerName).
+						Parameters(kueue.GroupVersion.Group, "ImageVerificationAdmissionCheckConfig", "config").
+						Obj(),
+					&kueue.ImageVerificationAdmissionCheckConfig{
+						ObjectMeta: metav1.ObjectMeta{Name: "config"},
+						Spec:       spec,
+					},
+					wl,
+				).
+				WithStatusSubresource(wl).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+			ctx, _ := utiltesting.ContextWithLog(t)
+
+			opts := []Option{WithClock(testingclock.NewFakeClock(now))}
+			if tc.verifier != nil {
+				opts = append(opts, WithVerifier("Fake", tc.verifier))
+			}
+			controller, err := NewController(cl, opts...)
+			if err != nil {
+				t.Fatalf("Failed to create the controller: %v", err)
+			}
+			result, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(wl)})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, result); diff != "" {
+				t.Errorf("Unexpected result (-want,+got):\n%s", diff)
+			}
+
+			var updated kueue.Workload
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(wl), &updated); err != nil {
+				t.Fatalf("Failed to get the workload: %v", err)
+			}
+			if diff := cmp.Diff([]kueue.AdmissionCheckState{tc.wantState}, updated.Status.AdmissionChecks, cmpopts.IgnoreFields(kueue.AdmissionCheckState{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected admission check states (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageverification
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+// Verifier verifies the images of the Workloads against a scanner or an
+// attestation service, for example checking the signatures of the images
+// against a cosign policy.
+type Verifier interface {
+	// Verify returns false and the reason when the image is not compliant
+	// with the configuration of the admission check. The errors, for example
+	// when the service is unreachable, are retried.
+	Verify(ctx context.Context, cfg *kueue.ImageVerificationAdmissionCheckConfig, image string) (bool, string, error)
+}
+
+// registryVerifier accepts the images of the allowed registries, referenced
+// by digest when required.
+type registryVerifier struct{}
+
+var _ Verifier = (*registryVerifier)(nil)
+
+func (registryVerifier) Verify(_ context.Context, cfg *kueue.ImageVerificationAdmissionCheckConfig, image string) (bool, string, error) {
+	if cfg.Spec.RequireDigest && !strings.Contains(image, "@sha256:") {
+		return false, fmt.Sprintf("the image %s is not referenced by digest", image), nil
+	}
+	if len(cfg.Spec.AllowedRegistries) == 0 {
+		return true, "", nil
+	}
+	name := normalizeImage(image)
+	for _, prefix := range cfg.Spec.AllowedRegistries {
+		if matchesRegistry(name, normalizePrefix(prefix)) {
+			return true, "", nil
+		}
+	}
+	return false, fmt.Sprintf("the image %s is not from an allowed registry", image), nil
+}
+
+// normalizeImage returns the fully qualified reference of the image, with
+// the implicit docker.io registry and library repository of the images like
+// "ubuntu" or "my-org/app".
+func normalizeImage(image string) string {
+	domain, remainder, found := strings.Cut(image, "/")
+	if !found {
+		return "docker.io/library/" + image
+	}
+	if !isDomain(domain) {
+		return "docker.io/" + image
+	}
+	if domain == "index.docker.io" {
+		return "docker.io/" + remainder
+	}
+	return image
+}
+
+// normalizePrefix returns the allowed registry without the trailing "/",
+// with the implicit docker.io registry of the prefixes like "my-org/".
+func normalizePrefix(prefix string) string {
+	prefix = strings.TrimSuffix(prefix, "/")
+	domain, remainder, _ := strings.Cut(prefix, "/")
+	if !isDomain(domain) {
+		return "docker.io/" + prefix
+	}
+	if domain == "index.docker.io" {
+		return strings.TrimSuffix("docker.io/"+remainder, "/")
+	}
+	return prefix
+}
+
+// isDomain returns true when the first component of the image is a
+// registry, like the distribution reference parsing does.
+func isDomain(component string) bool {
+	return strings.ContainsAny(component, ".:") || component == "localhost" || strings.ToLower(component) != component
+}
+
+// matchesRegistry returns true when the image is the prefix or is under it,
+// matching on a path, tag or digest boundary, so that the prefix
+// "registry.example.com" doesn't match "registry.example.com.evil.io/app".
+func matchesRegistry(image, prefix string) bool {
+	if !strings.HasPrefix(image, prefix) {
+		return false
+	}
+	if len(image) == len(prefix) {
+		return true
+	}
+	switch image[len(prefix)] {
+	case '/', ':', '@':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageverification
+
+import (
+	"context"
+	"testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+func TestRegistryVerifier(t *testing.T) {
+	cases := map[string]struct {
+		image             string
+		allowedRegistries []string
+		requireDigest     bool
+		want              bool
+	}{
+		"no allowed registries": {
+			image: "ubuntu",
+			want:  true,
+		},
+		"the image is under the prefix": {
+			image:             "registry.example.com/training:v1",
+			allowedRegistries: []string{"registry.example.com/"},
+			want:              true,
+		},
+		"the prefix without the trailing slash": {
+			image:             "registry.example.com/training:v1",
+			allowedRegistries: []string{"registry.example.com"},
+			want:              true,
+		},
+		"the image shares the prefix outside a path boundary": {
+			image:             "registry.example.com.evil.io/training:v1",
+			allowedRegistries: []string{"registry.example.com"},
+		},
+		"the repository shares the prefix outside a path boundary": {
+			image:             "quay.io/my-org-evil/training:v1",
+			allowedRegistries: []string{"quay.io/my-org"},
+		},
+		"the image is the allowed repository": {
+			image:             "quay.io/my-org/training@sha256:0123",
+			allowedRegistries: []string{"quay.io/my-org/training"},
+			want:              true,
+		},
+		"the short name of an official image": {
+			image:             "ubuntu:24.04",
+			allowedRegistries: []string{"docker.io/library/"},
+			want:              true,
+		},
+		"the short name of an image of an organization": {
+			image:             "my-org/training",
+			allowedRegistries: []string{"docker.io/my-org/"},
+			want:              true,
+		},
+		"a registry without a domain is a docker.io organization": {
+			image:             "registry/training",
+			allowedRegistries: []string{"docker.io/registry/"},
+			want:              true,
+		},
+		"the short name of an image of docker.io is rejected": {
+			image:             "ubuntu",
+			allowedRegistries: []string{"registry.example.com/"},
+		},
+		"the image of a registry with a port": {
+			image:             "localhost:5000/training",
+			allowedRegistries: []string{"localhost:5000/"},
+			want:              true,
+		},
+		"the image isn't referenced by digest": {
+			image:             "registry.example.com/training:v1",
+			allowedRegistries: []string{"registry.example.com/"},
+			requireDigest:     true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg := &kueue.ImageVerificationAdmissionCheckConfig{
+				Spec: kueue.ImageVerificationAdmissionCheckConfigSpec{
+					AllowedRegistries: tc.allowedRegistries,
+					RequireDigest:     tc.requireDigest,
+				},
+			}
+			got, _, err := registryVerifier{}.Verify(context.Background(), cfg, tc.image)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("Unexpected result, want=%v, got=%v", tc.want, got)
+			}
+		})
+	}
+}
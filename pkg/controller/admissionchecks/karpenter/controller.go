@@ -0,0 +1,302 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package karpenter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	defaultMaxNodeClaims = 64
+)
+
+var (
+	realClock = clock.RealClock{}
+)
+
+type configHelper = admissioncheck.ConfigHelper[*kueue.KarpenterAdmissionCheckConfig, kueue.KarpenterAdmissionCheckConfig]
+
+type Option func(*Controller)
+
+// WithClock sets the clock used by the controller.
+func WithClock(c clock.Clock) Option {
+	return func(ctrl *Controller) {
+		ctrl.clock = c
+	}
+}
+
+// Controller creates a Karpenter NodeClaim for each Pod of the Workloads with
+// quota reserved and a Karpenter admission check, and sets the checks to Ready
+// once all the NodeClaims are ready, injecting a node selector targeting their
+// Nodes in the PodSets. The NodeClaims are deleted when the Workloads release
+// their quota reservation, finish or are deleted.
+type Controller struct {
+	client client.Client
+	helper *configHelper
+	clock  clock.Clock
+}
+
+var _ reconcile.Reconciler = (*Controller)(nil)
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=karpenteradmissioncheckconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=karpenter.sh,resources=nodeclaims,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=karpenter.sh,resources=nodepools,verbs=get;list;watch
+
+func NewController(client client.Client, opts ...Option) (*Controller, error) {
+	helper, err := admissioncheck.NewConfigHelper[*kueue.KarpenterAdmissionCheckConfig](client)
+	if err != nil {
+		return nil, err
+	}
+	c := &Controller{
+		client: client,
+		helper: helper,
+		clock:  realClock,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	wl := &kueue.Workload{}
+	if err := c.client.Get(ctx, req.NamespacedName, wl); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, c.deleteNodeClaims(ctx, req.NamespacedName, "")
+		}
+		return reconcile.Result{}, err
+	}
+
+	checks, err := admissioncheck.FilterForController(ctx, c.client, wl.Status.AdmissionChecks, kueue.KarpenterAdmissionCheckControllerName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(checks) == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	if !workload.HasQuotaReservation(wl) || workload.IsFinished(wl) || workload.IsEvicted(wl) {
+		return reconcile.Result{}, c.deleteNodeClaims(ctx, req.NamespacedName, "")
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile Karpenter admission checks")
+
+	wlPatch := workload.BaseSSAWorkload(wl, true)
+	updated := false
+	for _, checkName := range checks {
+		current := admissioncheck.FindAdmissionCheck(wl.Status.AdmissionChecks, checkName)
+		switch current.State {
+		case kueue.CheckStatePending:
+		case kueue.CheckStateReady:
+			continue
+		default:
+			if err := c.deleteNodeClaims(ctx, req.NamespacedName, checkName); err != nil {
+				return reconcile.Result{}, err
+			}
+			continue
+		}
+		newState := kueue.AdmissionCheckState{
+			Name:               current.Name,
+			State:              current.State,
+			LastTransitionTime: current.LastTransitionTime,
+			PodSetUpdates:      current.PodSetUpdates,
+		}
+		if err := c.syncNodeClaims(ctx, wl, checkName, &newState); err != nil {
+			return reconcile.Result{}, err
+		}
+		if newState.State == current.State && newState.Message == current.Message {
+			continue
+		}
+		workload.SetAdmissionCheckState(&wlPatch.Status.AdmissionChecks, newState, c.clock)
+		updated = true
+	}
+	if updated {
+		if err := c.client.Status().Patch(ctx, wlPatch, client.Apply, client.FieldOwner(kueue.KarpenterAdmissionCheckControllerName), client.ForceOwnership); err != nil {
+			return reconcile.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+// syncNodeClaims creates the missing NodeClaims of the workload for the check,
+// and sets the state of the check from the conditions of the NodeClaims.
+func (c *Controller) syncNodeClaims(ctx context.Context, wl *kueue.Workload, checkName kueue.AdmissionCheckReference, state *kueue.AdmissionCheckState) error {
+	cfg, err := c.helper.ConfigForAdmissionCheck(ctx, checkName)
+	if err != nil {
+		state.Message = fmt.Sprintf("Failed to get the configuration of the admission check: %v", err)
+		return nil
+	}
+	nodeClassRef := cfg.Spec.NodeClassRef
+	if nodeClassRef == nil {
+		nodePool := &unstructured.Unstructured{}
+		nodePool.SetGroupVersionKind(NodePoolGVK)
+		if err := c.client.Get(ctx, types.NamespacedName{Name: cfg.Spec.NodePoolName}, nodePool); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+			state.Message = fmt.Sprintf("Failed to get the NodePool: %v", err)
+			return nil
+		}
+		if nodeClassRef, err = nodeClassRefOfNodePool(nodePool); err != nil {
+			state.Message = err.Error()
+			return nil
+		}
+	}
+
+	info := workload.NewInfo(wl)
+	total := 0
+	for _, psr := range info.TotalRequests {
+		total += int(psr.Count)
+	}
+	if maxNodeClaims := int(ptr.Deref(cfg.Spec.MaxNodeClaims, defaultMaxNodeClaims)); total > maxNodeClaims {
+		state.State = kueue.CheckStateRejected
+		state.Message = fmt.Sprintf("The workload needs %d NodeClaims, exceeding the maximum of %d", total, maxNodeClaims)
+		return nil
+	}
+
+	capacity := capacityID(wl, checkName)
+	nodeClaims := newNodeClaimList()
+	if err := c.client.List(ctx, nodeClaims, client.MatchingLabels{CapacityLabel: capacity}); err != nil {
+		return err
+	}
+	existing := make(map[string]*unstructured.Unstructured, len(nodeClaims.Items))
+	for i := range nodeClaims.Items {
+		existing[nodeClaims.Items[i].GetName()] = &nodeClaims.Items[i]
+	}
+
+	podSets := make(map[kueue.PodSetReference]*kueue.PodSet, len(wl.Spec.PodSets))
+	for i := range wl.Spec.PodSets {
+		podSets[wl.Spec.PodSets[i].Name] = &wl.Spec.PodSets[i]
+	}
+	wlKey := client.ObjectKeyFromObject(wl)
+	ready := 0
+	var failed []string
+	for i, psr := range info.TotalRequests {
+		requests := psr.SinglePodRequests().ToResourceList()
+		for j := range int(psr.Count) {
+			name := nodeClaimName(capacity, i, j)
+			nc, found := existing[name]
+			if !found {
+				nc = buildNodeClaim(name, capacity, wlKey, checkName, podSets[psr.Name], requests, cfg.Spec.NodePoolName, nodeClassRef)
+				if err := c.client.Create(ctx, nc); client.IgnoreAlreadyExists(err) != nil {
+					return err
+				}
+				continue
+			}
+			if nc.GetDeletionTimestamp() != nil {
+				continue
+			}
+			if status, message := conditionStatus(nc, conditionLaunched); status == "False" {
+				failed = append(failed, fmt.Sprintf("%s: %s", name, message))
+			}
+			if status, _ := conditionStatus(nc, conditionReady); status == "True" {
+				ready++
+			}
+		}
+	}
+
+	switch {
+	case len(failed) > 0:
+		state.State = kueue.CheckStateRetry
+		state.Message = fmt.Sprintf("Failed to launch the NodeClaims: %s", strings.Join(failed, "; "))
+	case ready == total:
+		state.State = kueue.CheckStateReady
+		state.Message = "The NodeClaims are ready"
+		state.PodSetUpdates = make([]kueue.PodSetUpdate, 0, len(wl.Spec.PodSets))
+		for _, ps := range wl.Spec.PodSets {
+			state.PodSetUpdates = append(state.PodSetUpdates, kueue.PodSetUpdate{
+				Name:         ps.Name,
+				NodeSelector: map[string]string{CapacityLabel: capacity},
+			})
+		}
+	default:
+		state.Message = fmt.Sprintf("Waiting for %d of %d NodeClaims to be ready", total-ready, total)
+	}
+	return nil
+}
+
+// deleteNodeClaims deletes the NodeClaims created for the workload and the
+// check, or for all its checks if the check name is empty.
+func (c *Controller) deleteNodeClaims(ctx context.Context, wlKey types.NamespacedName, checkName kueue.AdmissionCheckReference) error {
+	nodeClaims := newNodeClaimList()
+	if err := c.client.List(ctx, nodeClaims, client.MatchingLabels{constants.ManagedByKueueLabelKey: constants.ManagedByKueueLabelValue}); err != nil {
+		return err
+	}
+	log := ctrl.LoggerFrom(ctx)
+	for i := range nodeClaims.Items {
+		nc := &nodeClaims.Items[i]
+		annotations := nc.GetAnnotations()
+		if annotations[WorkloadAnnotation] != wlKey.String() || (checkName != "" && annotations[CheckAnnotation] != string(checkName)) || nc.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if err := c.client.Delete(ctx, nc); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		log.V(3).Info("Deleted NodeClaim", "nodeClaim", nc.GetName())
+	}
+	return nil
+}
+
+// workloadForNodeClaim returns the workload the NodeClaim was created for.
+func workloadForNodeClaim(_ context.Context, obj client.Object) []reconcile.Request {
+	key, found := obj.GetAnnotations()[WorkloadAnnotation]
+	if !found {
+		return nil
+	}
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named("karpenter_workload").
+		For(&kueue.Workload{}).
+		Watches(newNodeClaim(), handler.EnqueueRequestsFromMapFunc(workloadForNodeClaim)).
+		Complete(c)
+	if err != nil {
+		return err
+	}
+	return admissioncheck.NewConfigReconciler(c.client, kueue.KarpenterAdmissionCheckControllerName, c.helper).
+		SetupWithManager(mgr, "karpenter_admissioncheck")
+}
@@ -0,0 +1,247 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package karpenter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func addKarpenterToScheme(s *runtime.Scheme) error {
+	for _, gvk := range []runtime.Object{newNodeClaim(), newNodeClaimList()} {
+		s.AddKnownTypeWithName(gvk.GetObjectKind().GroupVersionKind(), gvk)
+	}
+	s.AddKnownTypeWithName(NodePoolGVK, &unstructured.Unstructured{})
+	return nil
+}
+
+func withConditions(nc *unstructured.Unstructured, conditions ...metav1.Condition) *unstructured.Unstructured {
+	var list []any
+	for _, c := range conditions {
+		list = append(list, map[string]any{"type": c.Type, "status": string(c.Status), "message": c.Message})
+	}
+	_ = unstructured.SetNestedSlice(nc.Object, list, "status", "conditions")
+	return nc
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	admission := utiltesting.MakeAdmission("cq").
+		PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+			Assignment(corev1.ResourceCPU, "default", "4").
+			Count(2).
+			Obj()).
+		Obj()
+	baseWorkload := utiltesting.MakeWorkload("wl", "ns").
+		UID("wl-uid").
+		PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 2).
+			Request(corev1.ResourceCPU, "2").
+			Obj()).
+		ReserveQuota(admission).
+		AdmissionCheck(kueue.AdmissionCheckState{
+			Name:               "karpenter-check",
+			State:              kueue.CheckStatePending,
+			LastTransitionTime: metav1.NewTime(now),
+		}).
+		Obj()
+	capacity := capacityID(baseWorkload, "karpenter-check")
+	wlKey := client.ObjectKeyFromObject(baseWorkload)
+	nodeClassRef := &kueue.KarpenterNodeClassReference{Group: "karpenter.k8s.aws", Kind: "EC2NodeClass", Name: "default"}
+	nodeClaim := func(podIndex int) *unstructured.Unstructured {
+		return buildNodeClaim(nodeClaimName(capacity, 0, podIndex), capacity, wlKey, "karpenter-check", &baseWorkload.Spec.PodSets[0],
+			corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}, "gpu-pool", nodeClassRef)
+	}
+
+	cases := map[string]struct {
+		nodeClaims     []*unstructured.Unstructured
+		maxNodeClaims  *int32
+		wantState      kueue.AdmissionCheckState
+		wantNodeClaims []string
+	}{
+		"creates the NodeClaims": {
+			wantState: kueue.AdmissionCheckState{
+				Name:    "karpenter-check",
+				State:   kueue.CheckStatePending,
+				Message: "Waiting for 2 of 2 NodeClaims to be ready",
+			},
+			wantNodeClaims: []string{nodeClaimName(capacity, 0, 0), nodeClaimName(capacity, 0, 1)},
+		},
+		"the NodeClaims are ready": {
+			nodeClaims: []*unstructured.Unstructured{
+				withConditions(nodeClaim(0), metav1.Condition{Type: conditionLaunched, Status: metav1.ConditionTrue}, metav1.Condition{Type: conditionReady, Status: metav1.ConditionTrue}),
+				withConditions(nodeClaim(1), metav1.Condition{Type: conditionLaunched, Status: metav1.ConditionTrue}, metav1.Condition{Type: conditionReady, Status: metav1.ConditionTrue}),
+			},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "karpenter-check",
+				State:   kueue.CheckStateReady,
+				Message: "The NodeClaims are ready",
+				PodSetUpdates: []kueue.PodSetUpdate{{
+					Name:         kueue.DefaultPodSetName,
+					NodeSelector: map[string]string{CapacityLabel: capacity},
+				}},
+			},
+			wantNodeClaims: []string{nodeClaimName(capacity, 0, 0), nodeClaimName(capacity, 0, 1)},
+		},
+		"a NodeClaim is not ready": {
+			nodeClaims: []*unstructured.Unstructured{
+				withConditions(nodeClaim(0), metav1.Condition{Type: conditionLaunched, Status: metav1.ConditionTrue}, metav1.Condition{Type: conditionReady, Status: metav1.ConditionTrue}),
+				withConditions(nodeClaim(1), metav1.Condition{Type: conditionLaunched, Status: metav1.ConditionTrue}, metav1.Condition{Type: conditionReady, Status: metav1.ConditionUnknown}),
+			},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "karpenter-check",
+				State:   kueue.CheckStatePending,
+				Message: "Waiting for 1 of 2 NodeClaims to be ready",
+			},
+			wantNodeClaims: []string{nodeClaimName(capacity, 0, 0), nodeClaimName(capacity, 0, 1)},
+		},
+		"a NodeClaim failed to launch": {
+			nodeClaims: []*unstructured.Unstructured{
+				withConditions(nodeClaim(0), metav1.Condition{Type: conditionLaunched, Status: metav1.ConditionFalse, Message: "insufficient capacity"}),
+				nodeClaim(1),
+			},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "karpenter-check",
+				State:   kueue.CheckStateRetry,
+				Message: "Failed to launch the NodeClaims: " + nodeClaimName(capacity, 0, 0) + ": insufficient capacity",
+			},
+			wantNodeClaims: []string{nodeClaimName(capacity, 0, 0), nodeClaimName(capacity, 0, 1)},
+		},
+		"too many NodeClaims": {
+			maxNodeClaims: ptr.To[int32](1),
+			wantState: kueue.AdmissionCheckState{
+				Name:    "karpenter-check",
+				State:   kueue.CheckStateRejected,
+				Message: "The workload needs 2 NodeClaims, exceeding the maximum of 1",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wl := baseWorkload.DeepCopy()
+			nodePool := &unstructured.Unstructured{}
+			nodePool.SetGroupVersionKind(NodePoolGVK)
+			nodePool.SetName("gpu-pool")
+			_ = unstructured.SetNestedStringMap(nodePool.Object, map[string]string{"group": "karpenter.k8s.aws", "kind": "EC2NodeClass", "name": "default"}, "spec", "template", "spec", "nodeClassRef")
+			objs := []client.Object{
+				utiltesting.MakeAdmissionCheck("karpenter-check").
+					ControllerName(kueue.KarpenterAdmissionCheckControllerName).
+					Parameters(kueue.GroupVersion.Group, "KarpenterAdmissionCheckConfig", "config").
+					Obj(),
+				&kueue.KarpenterAdmissionCheckConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "config"},
+					Spec: kueue.KarpenterAdmissionCheckConfigSpec{
+						NodePoolName:  "gpu-pool",
+						MaxNodeClaims: tc.maxNodeClaims,
+					},
+				},
+				nodePool,
+				wl,
+			}
+			for _, nc := range tc.nodeClaims {
+				objs = append(objs, nc)
+			}
+			cl := utiltesting.NewClientBuilder(addKarpenterToScheme).
+				WithObjects(objs...).
+				WithStatusSubresource(wl).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+			ctx, _ := utiltesting.ContextWithLog(t)
+
+			controller, err := NewController(cl, WithClock(testingclock.NewFakeClock(now)))
+			if err != nil {
+				t.Fatalf("Failed to create the controller: %v", err)
+			}
+			if _, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: wlKey}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var updated kueue.Workload
+			if err := cl.Get(ctx, wlKey, &updated); err != nil {
+				t.Fatalf("Failed to get the workload: %v", err)
+			}
+			if diff := cmp.Diff([]kueue.AdmissionCheckState{tc.wantState}, updated.Status.AdmissionChecks, cmpopts.IgnoreFields(kueue.AdmissionCheckState{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected admission check states (-want,+got):\n%s", diff)
+			}
+
+			nodeClaims := newNodeClaimList()
+			if err := cl.List(ctx, nodeClaims); err != nil {
+				t.Fatalf("Failed to list the NodeClaims: %v", err)
+			}
+			var gotNodeClaims []string
+			for _, nc := range nodeClaims.Items {
+				gotNodeClaims = append(gotNodeClaims, nc.GetName())
+				if ref, _, _ := unstructured.NestedString(nc.Object, "spec", "nodeClassRef", "name"); ref != "default" {
+					t.Errorf("Unexpected nodeClassRef of the NodeClaim %s: %q", nc.GetName(), ref)
+				}
+			}
+			if diff := cmp.Diff(tc.wantNodeClaims, gotNodeClaims, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("Unexpected NodeClaims (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteNodeClaims(t *testing.T) {
+	wlKey := types.NamespacedName{Namespace: "ns", Name: "wl"}
+	otherKey := types.NamespacedName{Namespace: "ns", Name: "other"}
+	ps := utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Obj()
+	nodeClassRef := &kueue.KarpenterNodeClassReference{Group: "karpenter.k8s.aws", Kind: "EC2NodeClass", Name: "default"}
+	cl := utiltesting.NewClientBuilder(addKarpenterToScheme).
+		WithObjects(
+			buildNodeClaim("wl-claim", "wl", wlKey, "karpenter-check", ps, nil, "pool", nodeClassRef),
+			buildNodeClaim("other-claim", "other", otherKey, "karpenter-check", ps, nil, "pool", nodeClassRef),
+		).
+		Build()
+	ctx, _ := utiltesting.ContextWithLog(t)
+
+	controller, err := NewController(cl)
+	if err != nil {
+		t.Fatalf("Failed to create the controller: %v", err)
+	}
+	// The workload doesn't exist, so its NodeClaims are deleted.
+	if _, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: wlKey}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	nodeClaims := newNodeClaimList()
+	if err := cl.List(ctx, nodeClaims); err != nil {
+		t.Fatalf("Failed to list the NodeClaims: %v", err)
+	}
+	var gotNodeClaims []string
+	for _, nc := range nodeClaims.Items {
+		gotNodeClaims = append(gotNodeClaims, nc.GetName())
+	}
+	if diff := cmp.Diff([]string{"other-claim"}, gotNodeClaims); diff != "" {
+		t.Errorf("Unexpected NodeClaims (-want,+got):\n%s", diff)
+	}
+}
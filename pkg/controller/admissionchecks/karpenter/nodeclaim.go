@@ -0,0 +1,160 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package karpenter
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
+)
+
+const (
+	// CapacityLabel is set on the NodeClaims created for an admission check of
+	// a Workload, and injected as a node selector in the PodSets of the Workload.
+	// Karpenter propagates the labels of the NodeClaims to their Nodes.
+	CapacityLabel = "kueue.x-k8s.io/karpenter-capacity"
+
+	// WorkloadAnnotation is set on the NodeClaims with the namespaced name of
+	// the Workload they were created for.
+	WorkloadAnnotation = "kueue.x-k8s.io/workload"
+
+	// CheckAnnotation is set on the NodeClaims with the name of the admission
+	// check they were created for.
+	CheckAnnotation = "kueue.x-k8s.io/admission-check"
+
+	// PodSetAnnotation is set on the NodeClaims with the name of the PodSet
+	// they were created for.
+	PodSetAnnotation = "kueue.x-k8s.io/podset"
+
+	nodePoolLabel = "karpenter.sh/nodepool"
+
+	conditionLaunched = "Launched"
+	conditionReady    = "Ready"
+)
+
+var (
+	NodeClaimGVK = schema.GroupVersionKind{Group: "karpenter.sh", Version: "v1", Kind: "NodeClaim"}
+	NodePoolGVK  = schema.GroupVersionKind{Group: "karpenter.sh", Version: "v1", Kind: "NodePool"}
+)
+
+func newNodeClaim() *unstructured.Unstructured {
+	nc := &unstructured.Unstructured{}
+	nc.SetGroupVersionKind(NodeClaimGVK)
+	return nc
+}
+
+func newNodeClaimList() *unstructured.UnstructuredList {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(NodeClaimGVK.GroupVersion().WithKind(NodeClaimGVK.Kind + "List"))
+	return list
+}
+
+// capacityID identifies the capacity provisioned for the admission check of
+// a Workload. It is used as the value of the CapacityLabel.
+func capacityID(wl *kueue.Workload, checkName kueue.AdmissionCheckReference) string {
+	h := sha1.New()
+	h.Write([]byte(fmt.Sprintf("%s/%s/%s/%s", wl.Namespace, wl.Name, wl.UID, checkName)))
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+func nodeClaimName(capacity string, podSetIndex, podIndex int) string {
+	return fmt.Sprintf("kueue-%s-%d-%d", capacity, podSetIndex, podIndex)
+}
+
+// buildNodeClaim returns a NodeClaim for a Pod of the PodSet, in the NodePool
+// and with the NodeClass of the configuration.
+func buildNodeClaim(name, capacity string, wlKey types.NamespacedName, checkName kueue.AdmissionCheckReference, ps *kueue.PodSet, requests corev1.ResourceList, nodePool string, nodeClassRef *kueue.KarpenterNodeClassReference) *unstructured.Unstructured {
+	nc := newNodeClaim()
+	nc.SetName(name)
+	nc.SetLabels(map[string]string{
+		constants.ManagedByKueueLabelKey: constants.ManagedByKueueLabelValue,
+		CapacityLabel:                    capacity,
+		nodePoolLabel:                    nodePool,
+	})
+	nc.SetAnnotations(map[string]string{
+		WorkloadAnnotation: wlKey.String(),
+		CheckAnnotation:    string(checkName),
+		PodSetAnnotation:   string(ps.Name),
+	})
+
+	requirements := []any{
+		map[string]any{"key": nodePoolLabel, "operator": string(corev1.NodeSelectorOpIn), "values": []any{nodePool}},
+	}
+	for key, value := range ps.Template.Spec.NodeSelector {
+		requirements = append(requirements, map[string]any{"key": key, "operator": string(corev1.NodeSelectorOpIn), "values": []any{value}})
+	}
+	resourceRequests := make(map[string]any, len(requests))
+	for name, quantity := range requests {
+		resourceRequests[string(name)] = quantity.String()
+	}
+	nc.Object["spec"] = map[string]any{
+		"nodeClassRef": map[string]any{
+			"group": nodeClassRef.Group,
+			"kind":  nodeClassRef.Kind,
+			"name":  nodeClassRef.Name,
+		},
+		"requirements": requirements,
+		"resources": map[string]any{
+			"requests": resourceRequests,
+		},
+	}
+	return nc
+}
+
+// conditionStatus returns the status and message of the condition of the
+// NodeClaim, or an empty status if it isn't set.
+func conditionStatus(nc *unstructured.Unstructured, conditionType string) (string, string) {
+	conditions, _, _ := unstructured.NestedSlice(nc.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok || condition["type"] != conditionType {
+			continue
+		}
+		status, _ := condition["status"].(string)
+		message, _ := condition["message"].(string)
+		return status, message
+	}
+	return "", ""
+}
+
+// nodeClassRefOfNodePool returns the NodeClass of the template of the NodePool.
+func nodeClassRefOfNodePool(nodePool *unstructured.Unstructured) (*kueue.KarpenterNodeClassReference, error) {
+	ref, found, err := unstructured.NestedStringMap(nodePool.Object, "spec", "template", "spec", "nodeClassRef")
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("the NodePool %q has no nodeClassRef", nodePool.GetName())
+	}
+	return &kueue.KarpenterNodeClassReference{Group: ref["group"], Kind: ref["kind"], Name: ref["name"]}, nil
+}
+
+// ServerSupportsNodeClaims returns an error if the Karpenter NodeClaims
+// aren't served by the API server.
+func ServerSupportsNodeClaims(mgr manager.Manager) error {
+	_, err := mgr.GetRESTMapper().RESTMapping(NodeClaimGVK.GroupKind(), NodeClaimGVK.Version)
+	return err
+}
@@ -19,6 +19,7 @@ package multikueue
 import (
 	"time"
 
+	tracingapi "k8s.io/component-base/tracing/api/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
@@ -40,6 +41,7 @@ type SetupOptions struct {
 	eventsBatchPeriod time.Duration
 	adapters          map[string]jobframework.MultiKueueAdapter
 	dispatcherName    string
+	tracing           *tracingapi.TracingConfiguration
 }
 
 type SetupOption func(o *SetupOptions)
@@ -90,6 +92,14 @@ func WithDispatcherName(dispatcherName string) SetupOption {
 	}
 }
 
+// WithTracing sets the tracing configuration used to propagate the trace
+// context of the workloads to their copies in the worker clusters.
+func WithTracing(cfg *tracingapi.TracingConfiguration) SetupOption {
+	return func(o *SetupOptions) {
+		o.tracing = cfg
+	}
+}
+
 func SetupControllers(mgr ctrl.Manager, namespace string, opts ...SetupOption) error {
 	options := &SetupOptions{
 		gcInterval:        defaultGCInterval,
@@ -128,6 +138,6 @@ func SetupControllers(mgr ctrl.Manager, namespace string, opts ...SetupOption) e
 	}
 
 	wlRec := newWlReconciler(mgr.GetClient(), helper, cRec, options.origin, mgr.GetEventRecorderFor(constants.WorkloadControllerName),
-		options.workerLostTimeout, options.eventsBatchPeriod, options.adapters, options.dispatcherName)
+		options.workerLostTimeout, options.eventsBatchPeriod, options.adapters, options.dispatcherName, withTracing(options.tracing))
 	return wlRec.setupWithManager(mgr)
 }
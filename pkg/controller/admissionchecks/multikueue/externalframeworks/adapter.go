@@ -18,6 +18,7 @@ package externalframeworks
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -35,6 +36,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/workload"
 )
 
 // Adapter implements the MultiKueueAdapter interface for external frameworks
@@ -72,16 +74,30 @@ func (a *Adapter) SyncJob(ctx context.Context, localClient client.Client, remote
 		return err
 	}
 
-	if apierrors.IsNotFound(err) {
+	remoteNotFound := apierrors.IsNotFound(err)
+
+	topologyAssignment, err := a.topologyAssignment(ctx, remoteClient, types.NamespacedName{Namespace: key.Namespace, Name: workloadName})
+	if err != nil {
+		return err
+	}
+
+	if remoteNotFound {
 		// Create new remote object
-		return a.createRemoteObject(ctx, remoteClient, localObj, workloadName, origin)
+		return a.createRemoteObject(ctx, remoteClient, localObj, workloadName, origin, topologyAssignment)
+	}
+
+	if err := a.syncTopologyAssignment(ctx, remoteClient, remoteObj, topologyAssignment); err != nil {
+		return err
+	}
+	if err := a.syncTopologyAssignment(ctx, localClient, localObj, topologyAssignment); err != nil {
+		return err
 	}
 
 	// Update existing remote object status
 	return a.syncStatus(ctx, localClient, remoteClient, localObj, remoteObj)
 }
 
-func (a *Adapter) createRemoteObject(ctx context.Context, remoteClient client.Client, localObj *unstructured.Unstructured, workloadName, origin string) error {
+func (a *Adapter) createRemoteObject(ctx context.Context, remoteClient client.Client, localObj *unstructured.Unstructured, workloadName, origin, topologyAssignment string) error {
 	log := ctrl.LoggerFrom(ctx)
 
 	// Create a copy of the local object for the remote cluster
@@ -100,6 +116,15 @@ func (a *Adapter) createRemoteObject(ctx context.Context, remoteClient client.Cl
 	labels[kueue.MultiKueueOriginLabel] = origin
 	remoteObj.SetLabels(labels)
 
+	if topologyAssignment != "" {
+		annotations := remoteObj.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[kueue.TopologyAssignmentAnnotation] = topologyAssignment
+		remoteObj.SetAnnotations(annotations)
+	}
+
 	// Create the object in the remote cluster
 	log.V(2).Info("Creating remote object", "gvk", a.gvk, "name", remoteObj.GetName(), "namespace", remoteObj.GetNamespace())
 	return remoteClient.Create(ctx, remoteObj)
@@ -125,6 +150,52 @@ func (a *Adapter) syncStatus(ctx context.Context, localClient client.Client, rem
 	return localClient.Status().Patch(ctx, localObj, patch)
 }
 
+// topologyAssignment returns the topology assignments of the PodSets of the
+// workload in the worker cluster, encoded for the TopologyAssignmentAnnotation,
+// or an empty string if it doesn't have any.
+func (a *Adapter) topologyAssignment(ctx context.Context, remoteClient client.Client, wlKey types.NamespacedName) (string, error) {
+	if !features.Enabled(features.TASExternalFrameworks) {
+		return "", nil
+	}
+	wl := &kueue.Workload{}
+	if err := remoteClient.Get(ctx, wlKey, wl); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	if !workload.HasQuotaReservation(wl) {
+		return "", nil
+	}
+	assignments := make(map[kueue.PodSetReference]*kueue.TopologyAssignment)
+	for _, psa := range wl.Status.Admission.PodSetAssignments {
+		if psa.TopologyAssignment != nil {
+			assignments[psa.Name] = psa.TopologyAssignment
+		}
+	}
+	if len(assignments) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(assignments)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// syncTopologyAssignment sets the TopologyAssignmentAnnotation on the object.
+func (a *Adapter) syncTopologyAssignment(ctx context.Context, c client.Client, obj *unstructured.Unstructured, topologyAssignment string) error {
+	if topologyAssignment == "" || obj.GetAnnotations()[kueue.TopologyAssignmentAnnotation] == topologyAssignment {
+		return nil
+	}
+	original := obj.DeepCopy()
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[kueue.TopologyAssignmentAnnotation] = topologyAssignment
+	obj.SetAnnotations(annotations)
+	ctrl.LoggerFrom(ctx).V(2).Info("Setting the topology assignment", "gvk", a.gvk, "name", obj.GetName())
+	return c.Patch(ctx, obj, client.MergeFrom(original))
+}
+
 // removeManagedByField removes the .spec.managedBy field from the object
 func (a *Adapter) removeManagedByField(obj *unstructured.Unstructured) {
 	spec, exists, err := unstructured.NestedMap(obj.Object, "spec")
@@ -31,6 +31,7 @@ import (
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 )
 
 func TestAdapter_IsJobManagedByKueue(t *testing.T) {
@@ -365,3 +366,55 @@ func TestAdapter_WorkloadKeyFor(t *testing.T) {
 		})
 	}
 }
+
+func TestAdapter_SyncJobTopologyAssignment(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.TASExternalFrameworks, true)
+	gvk := schema.GroupVersionKind{Group: "tekton.dev", Version: "v1", Kind: "PipelineRun"}
+	adapter := &Adapter{gvk: gvk}
+	key := types.NamespacedName{Namespace: "ns", Name: "run"}
+
+	localObj := &unstructured.Unstructured{}
+	localObj.SetGroupVersionKind(gvk)
+	localObj.SetNamespace(key.Namespace)
+	localObj.SetName(key.Name)
+	localObj.Object["spec"] = map[string]any{"managedBy": kueue.MultiKueueControllerName}
+
+	remoteWl := utiltesting.MakeWorkload("wl", key.Namespace).
+		ReserveQuota(utiltesting.MakeAdmission("cq").
+			PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+				TopologyAssignment(utiltesting.MakeTopologyAssignment([]string{"rack"}).
+					Domain(utiltesting.MakeTopologyDomainAssignment([]string{"rack-1"}, 2).Obj()).
+					Obj()).
+				Obj()).
+			Obj()).
+		Obj()
+	wantAnnotation := `{"main":{"levels":["rack"],"domains":[{"values":["rack-1"],"count":2}]}}`
+
+	ctx, _ := utiltesting.ContextWithLog(t)
+	localClient := utiltesting.NewClientBuilder().WithObjects(localObj).Build()
+	remoteClient := utiltesting.NewClientBuilder().WithObjects(remoteWl).Build()
+
+	// The remote object is created with the topology assignment.
+	if err := adapter.SyncJob(ctx, localClient, remoteClient, key, "wl", "origin"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	remoteObj := &unstructured.Unstructured{}
+	remoteObj.SetGroupVersionKind(gvk)
+	if err := remoteClient.Get(ctx, key, remoteObj); err != nil {
+		t.Fatalf("Failed to get the remote object: %v", err)
+	}
+	if diff := cmp.Diff(wantAnnotation, remoteObj.GetAnnotations()[kueue.TopologyAssignmentAnnotation]); diff != "" {
+		t.Errorf("Unexpected topology assignment of the remote object (-want,+got):\n%s", diff)
+	}
+
+	// The local object gets the topology assignment on the next sync.
+	if err := adapter.SyncJob(ctx, localClient, remoteClient, key, "wl", "origin"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := localClient.Get(ctx, key, localObj); err != nil {
+		t.Fatalf("Failed to get the local object: %v", err)
+	}
+	if diff := cmp.Diff(wantAnnotation, localObj.GetAnnotations()[kueue.TopologyAssignmentAnnotation]); diff != "" {
+		t.Errorf("Unexpected topology assignment of the local object (-want,+got):\n%s", diff)
+	}
+}
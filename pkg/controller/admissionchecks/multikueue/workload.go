@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -46,6 +47,7 @@ import (
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/tracing"
 	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
 	"sigs.k8s.io/kueue/pkg/util/api"
 	clientutil "sigs.k8s.io/kueue/pkg/util/client"
@@ -69,6 +71,7 @@ type wlReconciler struct {
 	recorder          record.EventRecorder
 	clock             clock.Clock
 	dispatcherName    string
+	tracing           *tracingapi.TracingConfiguration
 }
 
 var _ reconcile.Reconciler = (*wlReconciler)(nil)
@@ -90,6 +93,12 @@ func WithClock(_ testing.TB, c clock.Clock) Option {
 	}
 }
 
+func withTracing(cfg *tracingapi.TracingConfiguration) Option {
+	return func(r *wlReconciler) {
+		r.tracing = cfg
+	}
+}
+
 // IsFinished returns true if the local workload is finished.
 func (g *wlGroup) IsFinished() bool {
 	return apimeta.IsStatusConditionTrue(g.local.Status.Conditions, kueue.WorkloadFinished)
@@ -466,6 +475,9 @@ func (w *wlReconciler) nominateAndSynchronizeWorkers(ctx context.Context, group
 		if slices.Contains(nominatedWorkers, rem) {
 			if remoteWl == nil {
 				clone := cloneForCreate(group.local, group.remoteClients[rem].origin)
+				if w.tracing != nil {
+					tracing.InjectTraceParent(w.tracing, group.local, clone)
+				}
 				if err := group.remoteClients[rem].client.Create(ctx, clone); err != nil {
 					log.V(2).Error(err, "creating remote object", "remote", rem)
 					errs = append(errs, err)
@@ -0,0 +1,210 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioning
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	autoscaling "k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/metrics"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	// FlavorsAnnotation is set on the ProvisioningRequests with the flavors
+	// assigned to the PodSets they were created for.
+	FlavorsAnnotation = "kueue.x-k8s.io/provisioning-flavors"
+)
+
+// The reasons for a ProvisioningRequest to be stale, reported in the
+// kueue_stale_provisioning_requests_deleted_total metric.
+const (
+	StaleWorkloadDeleted  = "WorkloadDeleted"
+	StaleWorkloadFinished = "WorkloadFinished"
+	StaleCheckRejected    = "CheckRejected"
+	StaleFlavorReassigned = "FlavorReassigned"
+	StaleBookingExpired   = "BookingExpired"
+)
+
+var workloadGVK = kueue.GroupVersion.WithKind("Workload")
+
+// CleanupController deletes the ProvisioningRequests created by Kueue which
+// are not needed anymore by the workloads owning them, once they have been
+// stale for the retention period.
+type CleanupController struct {
+	client     client.Client
+	afterStale time.Duration
+	clock      clock.Clock
+}
+
+var _ reconcile.Reconciler = (*CleanupController)(nil)
+
+func NewCleanupController(client client.Client, afterStale time.Duration) *CleanupController {
+	return &CleanupController{
+		client:     client,
+		afterStale: afterStale,
+		clock:      realClock,
+	}
+}
+
+func (c *CleanupController) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	pr := &autoscaling.ProvisioningRequest{}
+	if err := c.client.Get(ctx, req.NamespacedName, pr); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if !pr.DeletionTimestamp.IsZero() || pr.Labels[constants.ManagedByKueueLabelKey] != constants.ManagedByKueueLabelValue {
+		return reconcile.Result{}, nil
+	}
+
+	reason, since, err := c.staleness(ctx, pr)
+	if err != nil || reason == "" {
+		return reconcile.Result{}, err
+	}
+
+	log := ctrl.LoggerFrom(ctx).WithValues("reason", reason)
+	if remaining := since.Add(c.afterStale).Sub(c.clock.Now()); remaining > 0 {
+		log.V(3).Info("Requeueing stale ProvisioningRequest for deletion after retention period", "remainingTime", remaining)
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	log.V(2).Info("Deleting stale ProvisioningRequest", "retention", c.afterStale)
+	if err := c.client.Delete(ctx, pr); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	metrics.ReportStaleProvisioningRequestDeleted(reason)
+	return reconcile.Result{}, nil
+}
+
+// staleness returns the reason the ProvisioningRequest is stale for, and since
+// when, or an empty reason if it is still needed by one of the workloads
+// owning it.
+func (c *CleanupController) staleness(ctx context.Context, pr *autoscaling.ProvisioningRequest) (string, time.Time, error) {
+	var reason string
+	var since time.Time
+	for _, ref := range pr.OwnerReferences {
+		if ref.APIVersion != workloadGVK.GroupVersion().String() || ref.Kind != workloadGVK.Kind {
+			continue
+		}
+		wl := &kueue.Workload{}
+		err := c.client.Get(ctx, types.NamespacedName{Namespace: pr.Namespace, Name: ref.Name}, wl)
+		if client.IgnoreNotFound(err) != nil {
+			return "", time.Time{}, err
+		}
+		wlReason, wlSince := StaleWorkloadDeleted, pr.CreationTimestamp.Time
+		if err == nil && wl.UID == ref.UID {
+			wlReason, wlSince = workloadStaleness(pr, wl)
+		}
+		if wlReason == "" {
+			return "", time.Time{}, nil
+		}
+		if reason == "" || wlSince.After(since) {
+			reason, since = wlReason, wlSince
+		}
+	}
+	return reason, since, nil
+}
+
+// workloadStaleness returns the reason the ProvisioningRequest is not needed
+// anymore by the workload, and since when, or an empty reason if it is.
+func workloadStaleness(pr *autoscaling.ProvisioningRequest, wl *kueue.Workload) (string, time.Time) {
+	if cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadFinished); cond != nil && cond.Status == metav1.ConditionTrue {
+		return StaleWorkloadFinished, cond.LastTransitionTime.Time
+	}
+	var check *kueue.AdmissionCheckState
+	for i := range wl.Status.AdmissionChecks {
+		if matchesWorkloadAndCheck(pr, wl.Name, wl.Status.AdmissionChecks[i].Name) {
+			check = &wl.Status.AdmissionChecks[i]
+			break
+		}
+	}
+	if check == nil {
+		// The requests of the checks removed from the workload are deleted by the
+		// provisioning controller.
+		return "", time.Time{}
+	}
+	if check.State == kueue.CheckStateRejected {
+		return StaleCheckRejected, check.LastTransitionTime.Time
+	}
+	if flavors, found := pr.Annotations[FlavorsAnnotation]; found && flavors != "" && workload.HasQuotaReservation(wl) {
+		assigned := sets.New[string]()
+		for _, psa := range wl.Status.Admission.PodSetAssignments {
+			for _, flavor := range psa.Flavors {
+				assigned.Insert(string(flavor))
+			}
+		}
+		if !assigned.HasAll(strings.Split(flavors, ",")...) {
+			cond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadQuotaReserved)
+			return StaleFlavorReassigned, cond.LastTransitionTime.Time
+		}
+	}
+	// The expired requests of the workloads not admitted yet are retried, and
+	// count the attempts.
+	if isBookingExpired(pr) && workload.IsAdmitted(wl) && check.State == kueue.CheckStateReady {
+		return StaleBookingExpired, apimeta.FindStatusCondition(pr.Status.Conditions, autoscaling.BookingExpired).LastTransitionTime.Time
+	}
+	return "", time.Time{}
+}
+
+// mergedPodSetsFlavors returns the sorted flavors assigned to the PodSets,
+// separated by commas.
+func mergedPodSetsFlavors(podSets []MergedPodSet) string {
+	flavors := sets.New[string]()
+	for _, ps := range podSets {
+		for _, flavor := range ps.PodSetAssignment.Flavors {
+			flavors.Insert(string(flavor))
+		}
+	}
+	return strings.Join(sets.List(flavors), ",")
+}
+
+// requestsForWorkload returns the ProvisioningRequests owned by the workload.
+func (c *CleanupController) requestsForWorkload(ctx context.Context, obj client.Object) []reconcile.Request {
+	provReqs := &autoscaling.ProvisioningRequestList{}
+	if err := c.client.List(ctx, provReqs, client.InNamespace(obj.GetNamespace()), client.MatchingFields{RequestsOwnedByWorkloadKey: obj.GetName()}); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list the ProvisioningRequests of the workload", "workload", klog.KObj(obj))
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(provReqs.Items))
+	for i := range provReqs.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&provReqs.Items[i])})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (c *CleanupController) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("provisioning_cleanup").
+		For(&autoscaling.ProvisioningRequest{}).
+		Watches(&kueue.Workload{}, handler.EnqueueRequestsFromMapFunc(c.requestsForWorkload)).
+		Complete(c)
+}
@@ -0,0 +1,206 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioning
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	autoscaling "k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestCleanupReconcile(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	afterStale := 10 * time.Minute
+	longAgo := metav1.NewTime(now.Add(-time.Hour))
+	recently := metav1.NewTime(now.Add(-time.Minute))
+
+	baseWorkload := func(checkState kueue.CheckState, checkTransition metav1.Time) *utiltesting.WorkloadWrapper {
+		return utiltesting.MakeWorkload("wl", TestNamespace).
+			UID("wl-uid").
+			ReserveQuotaAt(utiltesting.MakeAdmission("q1").
+				PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "flv1", "1").
+					Obj()).
+				Obj(), longAgo.Time).
+			AdmissionCheck(kueue.AdmissionCheckState{
+				Name:               "check1",
+				State:              checkState,
+				LastTransitionTime: checkTransition,
+			})
+	}
+	baseRequest := func() *autoscaling.ProvisioningRequest {
+		return &autoscaling.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         TestNamespace,
+				Name:              "wl-check1-1",
+				CreationTimestamp: longAgo,
+				Labels: map[string]string{
+					constants.ManagedByKueueLabelKey: constants.ManagedByKueueLabelValue,
+				},
+				Annotations: map[string]string{
+					FlavorsAnnotation: "flv1",
+				},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: kueue.GroupVersion.String(),
+					Kind:       "Workload",
+					Name:       "wl",
+					UID:        "wl-uid",
+				}},
+			},
+		}
+	}
+	withCondition := func(pr *autoscaling.ProvisioningRequest, condType string, transition metav1.Time) *autoscaling.ProvisioningRequest {
+		pr.Status.Conditions = append(pr.Status.Conditions, metav1.Condition{
+			Type:               condType,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: transition,
+		})
+		return pr
+	}
+
+	cases := map[string]struct {
+		workload     *kueue.Workload
+		request      *autoscaling.ProvisioningRequest
+		wantDeleted  bool
+		wantRequeued time.Duration
+	}{
+		"the request is needed by the pending workload": {
+			workload: baseWorkload(kueue.CheckStatePending, longAgo).Obj(),
+			request:  baseRequest(),
+		},
+		"the workload was deleted": {
+			request:     baseRequest(),
+			wantDeleted: true,
+		},
+		"the workload was recreated": {
+			workload:    baseWorkload(kueue.CheckStatePending, longAgo).UID("other-uid").Obj(),
+			request:     baseRequest(),
+			wantDeleted: true,
+		},
+		"the workload finished": {
+			workload: baseWorkload(kueue.CheckStateReady, longAgo).
+				Condition(metav1.Condition{Type: kueue.WorkloadFinished, Status: metav1.ConditionTrue, LastTransitionTime: longAgo}).
+				Obj(),
+			request:     baseRequest(),
+			wantDeleted: true,
+		},
+		"the check was rejected": {
+			workload:    baseWorkload(kueue.CheckStateRejected, longAgo).Obj(),
+			request:     baseRequest(),
+			wantDeleted: true,
+		},
+		"the check was rejected recently": {
+			workload:     baseWorkload(kueue.CheckStateRejected, recently).Obj(),
+			request:      baseRequest(),
+			wantRequeued: afterStale - time.Minute,
+		},
+		"the workload got quota reserved on another flavor": {
+			workload: utiltesting.MakeWorkload("wl", TestNamespace).
+				UID("wl-uid").
+				ReserveQuotaAt(utiltesting.MakeAdmission("q1").
+					PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment(corev1.ResourceCPU, "flv2", "1").
+						Obj()).
+					Obj(), longAgo.Time).
+				AdmissionCheck(kueue.AdmissionCheckState{Name: "check1", State: kueue.CheckStatePending}).
+				Obj(),
+			request:     baseRequest(),
+			wantDeleted: true,
+		},
+		"the booking of the admitted workload expired": {
+			workload:    baseWorkload(kueue.CheckStateReady, longAgo).AdmittedAt(true, longAgo.Time).Obj(),
+			request:     withCondition(baseRequest(), autoscaling.BookingExpired, longAgo),
+			wantDeleted: true,
+		},
+		"the booking of the workload not admitted expired": {
+			workload: baseWorkload(kueue.CheckStatePending, longAgo).Obj(),
+			request:  withCondition(baseRequest(), autoscaling.BookingExpired, longAgo),
+		},
+		"the request is not managed by Kueue": {
+			request: func() *autoscaling.ProvisioningRequest {
+				pr := baseRequest()
+				pr.Labels = nil
+				return pr
+			}(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			builder, ctx := getClientBuilder(ctx)
+			builder = builder.WithObjects(tc.request)
+			if tc.workload != nil {
+				builder = builder.WithObjects(tc.workload)
+			}
+			cl := builder.Build()
+
+			controller := NewCleanupController(cl, afterStale)
+			controller.clock = testingclock.NewFakeClock(now)
+			result, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tc.request)})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantRequeued, result.RequeueAfter); diff != "" {
+				t.Errorf("Unexpected requeue (-want,+got):\n%s", diff)
+			}
+
+			err = cl.Get(ctx, client.ObjectKeyFromObject(tc.request), &autoscaling.ProvisioningRequest{})
+			if gotDeleted := apierrors.IsNotFound(err); gotDeleted != tc.wantDeleted {
+				t.Errorf("Unexpected deletion of the request, want %v, got %v (err: %v)", tc.wantDeleted, gotDeleted, err)
+			}
+		})
+	}
+}
+
+func TestCleanupRequestsForWorkload(t *testing.T) {
+	ctx, _ := utiltesting.ContextWithLog(t)
+	builder, ctx := getClientBuilder(ctx)
+	request := &autoscaling.ProvisioningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       TestNamespace,
+			Name:            "wl-check1-1",
+			OwnerReferences: []metav1.OwnerReference{{Name: "wl"}},
+		},
+	}
+	other := &autoscaling.ProvisioningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       TestNamespace,
+			Name:            "other-check1-1",
+			OwnerReferences: []metav1.OwnerReference{{Name: "other"}},
+		},
+	}
+	cl := builder.WithObjects(request, other).Build()
+	controller := NewCleanupController(cl, 0)
+
+	got := controller.requestsForWorkload(ctx, utiltesting.MakeWorkload("wl", TestNamespace).Obj())
+	want := []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(request)}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected requests (-want,+got):\n%s", diff)
+	}
+}
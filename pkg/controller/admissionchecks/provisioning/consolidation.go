@@ -0,0 +1,260 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provisioning
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	autoscaling "k8s.io/autoscaler/cluster-autoscaler/apis/provisioningrequest/autoscaling.x-k8s.io/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	// ConsolidatedCheckAnnotation is set on the ProvisioningRequests consolidating
+	// multiple workloads, with the name of the admission check they were created for.
+	ConsolidatedCheckAnnotation = "kueue.x-k8s.io/consolidated-admission-check"
+
+	// maxProvisioningRequestPodSets is the maximum number of PodSets of a ProvisioningRequest.
+	maxProvisioningRequestPodSets = 32
+
+	defaultConsolidationMaxWorkloads = 16
+)
+
+// consolidationKey identifies the workloads whose ProvisioningRequests can be consolidated.
+type consolidationKey struct {
+	namespace  string
+	check      kueue.AdmissionCheckReference
+	flavors    string
+	parameters string
+	// config is the provisioningClassName and parameters of the rendered
+	// config, which can differ between the workloads of the same check.
+	config string
+}
+
+type consolidationBatch struct {
+	start     time.Time
+	workloads []types.NamespacedName
+}
+
+// consolidator keeps the batches of workloads waiting for their
+// ProvisioningRequests to be consolidated.
+type consolidator struct {
+	sync.Mutex
+	batches map[consolidationKey]*consolidationBatch
+	// consolidated are the workloads included in a consolidated ProvisioningRequest
+	// not observed yet, so they don't start a new batch in the meantime.
+	consolidated map[types.NamespacedName]string
+}
+
+func newConsolidator() *consolidator {
+	return &consolidator{
+		batches:      make(map[consolidationKey]*consolidationBatch),
+		consolidated: make(map[types.NamespacedName]string),
+	}
+}
+
+// forget removes the workload from the consolidated workloads, once its
+// ProvisioningRequest is observed.
+func (c *consolidator) forget(wl types.NamespacedName) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.consolidated, wl)
+}
+
+func consolidationEnabled(prc *kueue.ProvisioningRequestConfig) bool {
+	return features.Enabled(features.ProvisioningRequestConsolidation) && prc.Spec.Consolidation != nil
+}
+
+func isConsolidated(pr *autoscaling.ProvisioningRequest) bool {
+	_, found := pr.Annotations[ConsolidatedCheckAnnotation]
+	return found
+}
+
+func consolidatedPodSetName(workloadName string, podSetName kueue.PodSetReference) kueue.PodSetReference {
+	return kueue.PodSetReference(fmt.Sprintf("%s-%s", workloadName, podSetName))
+}
+
+func newConsolidationKey(wl *kueue.Workload, checkName kueue.AdmissionCheckReference, prc *kueue.ProvisioningRequestConfig, podSets []MergedPodSet) consolidationKey {
+	params := admissioncheck.FilterProvReqAnnotations(wl.Annotations)
+	paramKeys := make([]string, 0, len(params))
+	for k, v := range params {
+		paramKeys = append(paramKeys, k+"="+v)
+	}
+	slices.Sort(paramKeys)
+	configKeys := make([]string, 0, len(prc.Spec.Parameters)+1)
+	configKeys = append(configKeys, prc.Spec.ProvisioningClassName)
+	for k, v := range prc.Spec.Parameters {
+		configKeys = append(configKeys, k+"="+string(v))
+	}
+	slices.Sort(configKeys[1:])
+	return consolidationKey{
+		namespace:  wl.Namespace,
+		check:      checkName,
+		flavors:    mergedPodSetsFlavors(podSets),
+		parameters: strings.Join(paramKeys, ","),
+		config:     strings.Join(configKeys, ","),
+	}
+}
+
+// consolidate adds the workload to the batch of the workloads with the same
+// flavors assigned, and creates the consolidated ProvisioningRequest once the
+// window is over or the batch is full. Returns the time until the end of the window.
+func (c *Controller) consolidate(ctx context.Context, wl *kueue.Workload, checkName kueue.AdmissionCheckReference, prc *kueue.ProvisioningRequestConfig, onPodSets sets.Set[kueue.PodSetReference]) (time.Duration, error) {
+	mergedPodSets, err := mergePodSets(wl, &prc.Spec, onPodSets)
+	if err != nil {
+		return 0, err
+	}
+	key := newConsolidationKey(wl, checkName, prc, mergedPodSets)
+	wlKey := client.ObjectKeyFromObject(wl)
+	window := prc.Spec.Consolidation.Window.Duration
+	maxWorkloads := int(ptr.Deref(prc.Spec.Consolidation.MaxWorkloads, defaultConsolidationMaxWorkloads))
+	now := c.clock.Now()
+
+	c.consolidator.Lock()
+	if _, found := c.consolidator.consolidated[wlKey]; found {
+		c.consolidator.Unlock()
+		return 0, nil
+	}
+	batch, found := c.consolidator.batches[key]
+	if !found {
+		batch = &consolidationBatch{start: now}
+		c.consolidator.batches[key] = batch
+	}
+	if !slices.Contains(batch.workloads, wlKey) {
+		batch.workloads = append(batch.workloads, wlKey)
+	}
+	if remaining := batch.start.Add(window).Sub(now); remaining > 0 && len(batch.workloads) < maxWorkloads {
+		c.consolidator.Unlock()
+		return remaining, nil
+	}
+	delete(c.consolidator.batches, key)
+	c.consolidator.Unlock()
+
+	return 0, c.createConsolidatedRequest(ctx, checkName, prc, onPodSets, batch.workloads)
+}
+
+// createConsolidatedRequest creates a ProvisioningRequest with the PodSets of
+// all the workloads of the batch still waiting for it.
+func (c *Controller) createConsolidatedRequest(ctx context.Context, checkName kueue.AdmissionCheckReference, prc *kueue.ProvisioningRequestConfig, onPodSets sets.Set[kueue.PodSetReference], workloads []types.NamespacedName) error {
+	log := ctrl.LoggerFrom(ctx)
+	var included []*kueue.Workload
+	includedPodSets := make(map[*kueue.Workload][]MergedPodSet, len(workloads))
+	podSetsCount := 0
+	for _, key := range workloads {
+		wl := &kueue.Workload{}
+		if err := c.client.Get(ctx, key, wl); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return err
+			}
+			continue
+		}
+		state := admissioncheck.FindAdmissionCheck(wl.Status.AdmissionChecks, checkName)
+		if !workload.HasQuotaReservation(wl) || state == nil || state.State != kueue.CheckStatePending {
+			continue
+		}
+		mergedPodSets, err := mergePodSets(wl, &prc.Spec, onPodSets)
+		if err != nil {
+			return err
+		}
+		if podSetsCount+len(mergedPodSets) > maxProvisioningRequestPodSets {
+			// The remaining workloads wait for the next batch.
+			break
+		}
+		podSetsCount += len(mergedPodSets)
+		included = append(included, wl)
+		includedPodSets[wl] = mergedPodSets
+	}
+	if len(included) == 0 {
+		return nil
+	}
+
+	requestName := ProvisioningRequestName(included[0].Name, checkName, 1)
+	req := &autoscaling.ProvisioningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      requestName,
+			Namespace: included[0].Namespace,
+			Labels: map[string]string{
+				constants.ManagedByKueueLabelKey: constants.ManagedByKueueLabelValue,
+			},
+			Annotations: map[string]string{
+				ConsolidatedCheckAnnotation: string(checkName),
+				FlavorsAnnotation:           mergedPodSetsFlavors(includedPodSets[included[0]]),
+			},
+		},
+		Spec: autoscaling.ProvisioningRequestSpec{
+			ProvisioningClassName: prc.Spec.ProvisioningClassName,
+			Parameters:            parametersKueueToProvisioning(prc.Spec.Parameters),
+		},
+	}
+	passProvReqParams(included[0], req)
+
+	for _, wl := range included {
+		for _, mergedPodSet := range includedPodSets[wl] {
+			ptName := getProvisioningRequestPodTemplateName(requestName, consolidatedPodSetName(wl.Name, mergedPodSet.Name))
+			pt := &corev1.PodTemplate{}
+			err := c.client.Get(ctx, types.NamespacedName{Namespace: wl.Namespace, Name: ptName}, pt)
+			if client.IgnoreNotFound(err) != nil {
+				return err
+			}
+			if err != nil {
+				if _, err := c.createPodTemplate(ctx, wl, ptName, mergedPodSet.PodSet, mergedPodSet.PodSetAssignment); err != nil {
+					return err
+				}
+			}
+			req.Spec.PodSets = append(req.Spec.PodSets, autoscaling.PodSet{
+				PodTemplateRef: autoscaling.Reference{
+					Name: ptName,
+				},
+				Count: mergedPodSet.Count,
+			})
+		}
+		if err := controllerutil.SetOwnerReference(wl, req, c.client.Scheme()); err != nil {
+			return err
+		}
+	}
+
+	if err := c.client.Create(ctx, req); err != nil {
+		return err
+	}
+	log.V(3).Info("Created consolidated ProvisioningRequest", "requestName", requestName, "workloads", len(included))
+
+	c.consolidator.Lock()
+	defer c.consolidator.Unlock()
+	for _, wl := range included {
+		c.consolidator.consolidated[client.ObjectKeyFromObject(wl)] = requestName
+		c.record.Eventf(wl, corev1.EventTypeNormal, "ProvisioningRequestCreated", "Created ProvisioningRequest: %q", req.Name)
+	}
+	return nil
+}
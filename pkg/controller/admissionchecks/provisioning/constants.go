@@ -17,7 +17,8 @@ limitations under the License.
 package provisioning
 
 const (
-	ConfigKind           = "ProvisioningRequestConfig"
-	CheckInactiveMessage = "the check is not active"
-	NoRequestNeeded      = "the provisioning request is not needed"
+	ConfigKind                     = "ProvisioningRequestConfig"
+	CheckInactiveMessage           = "the check is not active"
+	NoRequestNeeded                = "the provisioning request is not needed"
+	AdmittedWithoutCapacityMessage = "admitted without the provisioned capacity after exhausting the retries"
 )
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -46,6 +47,7 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/podset"
 	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
 	"sigs.k8s.io/kueue/pkg/util/api"
@@ -75,10 +77,12 @@ func newProvisioningConfigHelper(c client.Client) (*provisioningConfigHelper, er
 }
 
 type Controller struct {
-	client client.Client
-	record record.EventRecorder
-	helper *provisioningConfigHelper
-	clock  clock.Clock
+	client       client.Client
+	apiReader    client.Reader
+	record       record.EventRecorder
+	helper       *provisioningConfigHelper
+	clock        clock.Clock
+	consolidator *consolidator
 }
 
 type workloadInfo struct {
@@ -90,24 +94,43 @@ var _ reconcile.Reconciler = (*Controller)(nil)
 
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update
 // +kubebuilder:rbac:groups="",resources=podtemplates,verbs=get;list;watch;create;delete;update
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get
 // +kubebuilder:rbac:groups=autoscaling.x-k8s.io,resources=provisioningrequests,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=autoscaling.x-k8s.io,resources=provisioningrequests/status,verbs=get
 // +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks,verbs=get;list;watch
 // +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=provisioningrequestconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=clusterqueues,verbs=get;list;watch
+
+// Option configures the Controller.
+type Option func(*Controller)
 
-func NewController(client client.Client, record record.EventRecorder) (*Controller, error) {
+// WithAPIReader sets the reader of the objects which aren't cached, such as the
+// ConfigMaps storing the full pod templates of the compacted podSets.
+func WithAPIReader(r client.Reader) Option {
+	return func(c *Controller) {
+		c.apiReader = r
+	}
+}
+
+func NewController(client client.Client, record record.EventRecorder, opts ...Option) (*Controller, error) {
 	helper, err := newProvisioningConfigHelper(client)
 	if err != nil {
 		return nil, err
 	}
-	return &Controller{
-		client: client,
-		record: record,
-		helper: helper,
-		clock:  realClock,
-	}, nil
+	c := &Controller{
+		client:       client,
+		apiReader:    client,
+		record:       record,
+		helper:       helper,
+		clock:        realClock,
+		consolidator: newConsolidator(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
 }
 
 // Reconcile performs a full reconciliation for the object referred to by the Request.
@@ -145,39 +168,50 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		if client.IgnoreNotFound(err) != nil {
 			return reconcile.Result{}, err
 		}
-		checkConfig[checkName] = prc
+		checkConfig[checkName] = renderConfig(prc, wl)
 	}
 
-	activeOrLastPRForChecks := c.activeOrLastPRForChecks(ctx, wl, checkConfig, provReqs.Items)
+	checkPodSets, err := c.checkPodSets(ctx, wl)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	activeOrLastPRForChecks := c.activeOrLastPRForChecks(ctx, wl, checkConfig, checkPodSets, provReqs.Items)
+	for _, pr := range activeOrLastPRForChecks {
+		if isConsolidated(pr) {
+			c.consolidator.forget(req.NamespacedName)
+		}
+	}
 
 	wlInfo := workloadInfo{
 		checkStates: make([]kueue.AdmissionCheckState, 0),
 	}
-	err = c.syncCheckStates(ctx, wl, &wlInfo, checkConfig, activeOrLastPRForChecks)
+	err = c.syncCheckStates(ctx, wl, &wlInfo, checkConfig, checkPodSets, activeOrLastPRForChecks)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
-	err = c.deleteUnusedProvisioningRequests(ctx, provReqs.Items, activeOrLastPRForChecks)
+	err = c.deleteUnusedProvisioningRequests(ctx, wl, provReqs.Items, activeOrLastPRForChecks)
 	if err != nil {
 		log.V(2).Error(err, "syncOwnedProvisionRequest failed to delete unused provisioning requests")
 		return reconcile.Result{}, err
 	}
 
-	err = c.syncOwnedProvisionRequest(ctx, wl, &wlInfo, checkConfig, activeOrLastPRForChecks)
+	requeueAfter, err := c.syncOwnedProvisionRequest(ctx, wl, &wlInfo, checkConfig, checkPodSets, activeOrLastPRForChecks)
 	if err != nil {
 		// this can also delete unneeded checks
 		log.V(2).Error(err, "syncOwnedProvisionRequest failed")
 		return reconcile.Result{}, err
 	}
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (c *Controller) activeOrLastPRForChecks(
 	ctx context.Context,
 	wl *kueue.Workload,
 	checkConfig map[kueue.AdmissionCheckReference]*kueue.ProvisioningRequestConfig,
+	checkPodSets map[kueue.AdmissionCheckReference]sets.Set[kueue.PodSetReference],
 	ownedPRs []autoscaling.ProvisioningRequest,
 ) map[kueue.AdmissionCheckReference]*autoscaling.ProvisioningRequest {
 	activeOrLastPRForChecks := make(map[kueue.AdmissionCheckReference]*autoscaling.ProvisioningRequest)
@@ -190,7 +224,7 @@ func (c *Controller) activeOrLastPRForChecks(
 			req := &ownedPRs[i]
 			// PRs relevant for the admission check
 			if matchesWorkloadAndCheck(req, wl.Name, checkName) {
-				if c.reqIsNeeded(wl, prc) && provReqSyncedWithConfig(req, prc) {
+				if c.reqIsNeeded(wl, prc, checkPodSets[checkName]) && provReqSyncedWithConfig(req, prc) {
 					currPr, exists := activeOrLastPRForChecks[checkName]
 					if !exists || getAttempt(log, currPr, wl.Name, checkName) < getAttempt(log, req, wl.Name, checkName) {
 						activeOrLastPRForChecks[checkName] = req
@@ -202,7 +236,7 @@ func (c *Controller) activeOrLastPRForChecks(
 	return activeOrLastPRForChecks
 }
 
-func (c *Controller) deleteUnusedProvisioningRequests(ctx context.Context, ownedPRs []autoscaling.ProvisioningRequest, activeOrLastPRForChecks map[kueue.AdmissionCheckReference]*autoscaling.ProvisioningRequest) error {
+func (c *Controller) deleteUnusedProvisioningRequests(ctx context.Context, wl *kueue.Workload, ownedPRs []autoscaling.ProvisioningRequest, activeOrLastPRForChecks map[kueue.AdmissionCheckReference]*autoscaling.ProvisioningRequest) error {
 	log := ctrl.LoggerFrom(ctx)
 	prNames := sets.New[string]()
 	for _, pr := range activeOrLastPRForChecks {
@@ -211,6 +245,16 @@ func (c *Controller) deleteUnusedProvisioningRequests(ctx context.Context, owned
 	for _, pr := range ownedPRs {
 		req := &pr
 		if !prNames.Has(req.Name) {
+			if isConsolidated(req) && len(req.OwnerReferences) > 1 {
+				// the request is still used by the other workloads consolidated in it
+				if err := controllerutil.RemoveOwnerReference(wl, req, c.client.Scheme()); err != nil {
+					return err
+				}
+				if err := c.client.Update(ctx, req); client.IgnoreNotFound(err) != nil {
+					return err
+				}
+				continue
+			}
 			if err := c.client.Delete(ctx, req); client.IgnoreNotFound(err) != nil {
 				log.V(5).Error(err, "deleting the request", "req", klog.KObj(req))
 				return err
@@ -225,15 +269,17 @@ func (c *Controller) syncOwnedProvisionRequest(
 	wl *kueue.Workload,
 	wlInfo *workloadInfo,
 	checkConfig map[kueue.AdmissionCheckReference]*kueue.ProvisioningRequestConfig,
+	checkPodSets map[kueue.AdmissionCheckReference]sets.Set[kueue.PodSetReference],
 	activeOrLastPRForChecks map[kueue.AdmissionCheckReference]*autoscaling.ProvisioningRequest,
-) error {
+) (time.Duration, error) {
 	log := ctrl.LoggerFrom(ctx)
+	var requeueAfter time.Duration
 	for checkName, prc := range checkConfig {
 		if prc == nil {
 			// the check is not active
 			continue
 		}
-		if !c.reqIsNeeded(wl, prc) {
+		if !c.reqIsNeeded(wl, prc, checkPodSets[checkName]) {
 			continue
 		}
 		ac := admissioncheck.FindAdmissionCheck(wlInfo.checkStates, checkName)
@@ -257,6 +303,16 @@ func (c *Controller) syncOwnedProvisionRequest(
 		} else {
 			shouldCreatePr = true
 		}
+		if shouldCreatePr && attempt == 1 && consolidationEnabled(prc) {
+			remaining, err := c.consolidate(ctx, wl, checkName, prc, checkPodSets[checkName])
+			if err != nil {
+				return 0, err
+			}
+			if remaining > 0 && (requeueAfter == 0 || remaining < requeueAfter) {
+				requeueAfter = remaining
+			}
+			continue
+		}
 		requestName := ProvisioningRequestName(wl.Name, checkName, attempt)
 		if shouldCreatePr {
 			log.V(3).Info("Creating ProvisioningRequest", "requestName", requestName, "attempt", attempt)
@@ -275,9 +331,12 @@ func (c *Controller) syncOwnedProvisionRequest(
 			}
 			passProvReqParams(wl, req)
 
-			mergedPodSets, err := mergePodSets(wl, &prc.Spec)
+			mergedPodSets, err := mergePodSets(wl, &prc.Spec, checkPodSets[checkName])
 			if err != nil {
-				return err
+				return 0, err
+			}
+			if flavors := mergedPodSetsFlavors(mergedPodSets); flavors != "" {
+				req.Annotations = map[string]string{FlavorsAnnotation: flavors}
 			}
 
 			for _, mergedPodSet := range mergedPodSets {
@@ -286,14 +345,14 @@ func (c *Controller) syncOwnedProvisionRequest(
 				pt := &corev1.PodTemplate{}
 				err := c.client.Get(ctx, types.NamespacedName{Namespace: wl.Namespace, Name: ptName}, pt)
 				if client.IgnoreNotFound(err) != nil {
-					return err
+					return 0, err
 				}
 				if err != nil {
 					// it's a not found, so create it
 					_, err := c.createPodTemplate(ctx, wl, ptName, mergedPodSet.PodSet, mergedPodSet.PodSetAssignment)
 					if err != nil {
 						msg := fmt.Sprintf("Error creating PodTemplate %q: %v", ptName, err)
-						return c.handleError(ctx, wl, ac, msg, err)
+						return 0, c.handleError(ctx, wl, ac, msg, err)
 					}
 				}
 
@@ -306,21 +365,21 @@ func (c *Controller) syncOwnedProvisionRequest(
 			}
 
 			if err := ctrl.SetControllerReference(wl, req, c.client.Scheme()); err != nil {
-				return err
+				return 0, err
 			}
 
 			if err := c.client.Create(ctx, req); err != nil {
 				msg := fmt.Sprintf("Error creating ProvisioningRequest %q: %v", requestName, err)
-				return c.handleError(ctx, wl, ac, msg, err)
+				return 0, c.handleError(ctx, wl, ac, msg, err)
 			}
 			c.record.Eventf(wl, corev1.EventTypeNormal, "ProvisioningRequestCreated", "Created ProvisioningRequest: %q", req.Name)
 			activeOrLastPRForChecks[checkName] = req
 		}
 		if err := c.syncProvisionRequestsPodTemplates(ctx, wl, req); err != nil {
-			return err
+			return 0, err
 		}
 	}
-	return nil
+	return requeueAfter, nil
 }
 
 func (c *Controller) handleError(ctx context.Context, wl *kueue.Workload, ac *kueue.AdmissionCheckState, msg string, err error) error {
@@ -340,6 +399,11 @@ func (c *Controller) handleError(ctx context.Context, wl *kueue.Workload, ac *ku
 }
 
 func (c *Controller) createPodTemplate(ctx context.Context, wl *kueue.Workload, name string, ps *kueue.PodSet, psa *kueue.PodSetAssignment) (*corev1.PodTemplate, error) {
+	// The cluster autoscaler simulates the pods of the full pod template.
+	template, err := workload.FullPodTemplate(ctx, c.apiReader, wl, ps)
+	if err != nil {
+		return nil, err
+	}
 	newPt := &corev1.PodTemplate{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
@@ -348,7 +412,7 @@ func (c *Controller) createPodTemplate(ctx context.Context, wl *kueue.Workload,
 				constants.ManagedByKueueLabelKey: constants.ManagedByKueueLabelValue,
 			},
 		},
-		Template: ps.Template,
+		Template: *template.DeepCopy(),
 	}
 
 	// set the controller reference to workload so that the template is not left orphaned
@@ -397,6 +461,11 @@ func (c *Controller) syncProvisionRequestsPodTemplates(ctx context.Context, wl *
 		if err == nil {
 			var shouldUpdate bool
 
+			if owner := metav1.GetControllerOf(pt); isConsolidated(request) && owner != nil && owner.UID != wl.UID && owner.UID != request.UID {
+				// the template of another workload consolidated in the request
+				continue
+			}
+
 			// transfer the ownership of the template to the ProvisioningRequest
 			if metav1.IsControlledBy(pt, wl) {
 				if err := controllerutil.RemoveControllerReference(wl, pt, c.client.Scheme()); err != nil {
@@ -422,15 +491,31 @@ func (c *Controller) syncProvisionRequestsPodTemplates(ctx context.Context, wl *
 	return nil
 }
 
-func (c *Controller) reqIsNeeded(wl *kueue.Workload, prc *kueue.ProvisioningRequestConfig) bool {
-	return len(requiredPodSets(wl.Spec.PodSets, prc.Spec.ManagedResources)) > 0
+// checkPodSets returns the PodSets the admission checks of the ClusterQueue
+// admitting the workload run for, as set with onPodSets.
+func (c *Controller) checkPodSets(ctx context.Context, wl *kueue.Workload) (map[kueue.AdmissionCheckReference]sets.Set[kueue.PodSetReference], error) {
+	if !features.Enabled(features.AdmissionCheckPodSets) {
+		return nil, nil
+	}
+	cq := &kueue.ClusterQueue{}
+	if err := c.client.Get(ctx, types.NamespacedName{Name: string(wl.Status.Admission.ClusterQueue)}, cq); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return admissioncheck.NewAdmissionCheckPodSets(cq), nil
+}
+
+func (c *Controller) reqIsNeeded(wl *kueue.Workload, prc *kueue.ProvisioningRequestConfig, onPodSets sets.Set[kueue.PodSetReference]) bool {
+	return len(requiredPodSets(wl.Spec.PodSets, prc.Spec.ManagedResources, onPodSets)) > 0
 }
 
-func requiredPodSets(podSets []kueue.PodSet, resources []corev1.ResourceName) []kueue.PodSetReference {
+func requiredPodSets(podSets []kueue.PodSet, resources []corev1.ResourceName, onPodSets sets.Set[kueue.PodSetReference]) []kueue.PodSetReference {
 	resourcesSet := sets.New(resources...)
 	users := make([]kueue.PodSetReference, 0, len(podSets))
 	for i := range podSets {
 		ps := &podSets[i]
+		if len(onPodSets) > 0 && !onPodSets.Has(ps.Name) {
+			continue
+		}
 		if len(resources) == 0 || podUses(&ps.Template.Spec, resourcesSet) {
 			users = append(users, ps.Name)
 		}
@@ -489,10 +574,28 @@ func (wlInfo *workloadInfo) update(wl *kueue.Workload, c clock.Clock) {
 	wlInfo.requeueState = wl.Status.RequeueState
 }
 
+// setRetriesExhausted sets the state of the check once the retries of the
+// ProvisioningRequest are exhausted, according to the failurePolicy.
+func setRetriesExhausted(checkState *kueue.AdmissionCheckState, prc *kueue.ProvisioningRequestConfig, message string) {
+	if features.Enabled(features.ProvisioningRequestFailurePolicy) &&
+		ptr.Deref(prc.Spec.RetryStrategy.FailurePolicy, kueue.ProvisioningRequestFailurePolicyReject) == kueue.ProvisioningRequestFailurePolicyAdmit {
+		checkState.State = kueue.CheckStateReady
+		checkState.Message = AdmittedWithoutCapacityMessage
+		if message != "" {
+			checkState.Message = fmt.Sprintf("%s: %s", AdmittedWithoutCapacityMessage, message)
+		}
+		checkState.PodSetUpdates = nil
+		return
+	}
+	checkState.State = kueue.CheckStateRejected
+	checkState.Message = message
+}
+
 func (c *Controller) syncCheckStates(
 	ctx context.Context, wl *kueue.Workload,
 	wlInfo *workloadInfo,
 	checkConfig map[kueue.AdmissionCheckReference]*kueue.ProvisioningRequestConfig,
+	checkPodSets map[kueue.AdmissionCheckReference]sets.Set[kueue.PodSetReference],
 	activeOrLastPRForChecks map[kueue.AdmissionCheckReference]*autoscaling.ProvisioningRequest,
 ) error {
 	log := ctrl.LoggerFrom(ctx)
@@ -509,7 +612,7 @@ func (c *Controller) syncCheckStates(
 			// the check is not active
 			updated = updateCheckState(&checkState, kueue.CheckStatePending) || updated
 			updated = updateCheckMessage(&checkState, CheckInactiveMessage) || updated
-		} else if !c.reqIsNeeded(wl, prc) {
+		} else if !c.reqIsNeeded(wl, prc, checkPodSets[check]) {
 			if updateCheckState(&checkState, kueue.CheckStateReady) {
 				updated = true
 				checkState.Message = NoRequestNeeded
@@ -545,8 +648,7 @@ func (c *Controller) syncCheckStates(
 					}
 				} else {
 					updated = true
-					checkState.State = kueue.CheckStateRejected
-					checkState.Message = apimeta.FindStatusCondition(pr.Status.Conditions, autoscaling.Failed).Message
+					setRetriesExhausted(&checkState, prc, apimeta.FindStatusCondition(pr.Status.Conditions, autoscaling.Failed).Message)
 				}
 			case isCapacityRevoked(pr):
 				if workload.IsActive(wl) && !workload.IsFinished(wl) {
@@ -569,8 +671,7 @@ func (c *Controller) syncCheckStates(
 						}
 					} else {
 						updated = true
-						checkState.State = kueue.CheckStateRejected
-						checkState.Message = apimeta.FindStatusCondition(pr.Status.Conditions, autoscaling.BookingExpired).Message
+						setRetriesExhausted(&checkState, prc, apimeta.FindStatusCondition(pr.Status.Conditions, autoscaling.BookingExpired).Message)
 					}
 				}
 			case isProvisioned(pr):
@@ -618,9 +719,22 @@ func (c *Controller) syncCheckStates(
 func podSetUpdates(log logr.Logger, wl *kueue.Workload, pr *autoscaling.ProvisioningRequest, prc *kueue.ProvisioningRequestConfig) []kueue.PodSetUpdate {
 	podSets := wl.Spec.PodSets
 	refMap := slices.ToMap(podSets, func(i int) (string, kueue.PodSetReference) {
+		if isConsolidated(pr) {
+			return getProvisioningRequestPodTemplateName(pr.Name, consolidatedPodSetName(wl.Name, podSets[i].Name)), podSets[i].Name
+		}
 		return getProvisioningRequestPodTemplateName(pr.Name, podSets[i].Name), podSets[i].Name
 	})
-	return slices.Map(pr.Spec.PodSets, func(ps *autoscaling.PodSet) kueue.PodSetUpdate {
+	prPodSets := pr.Spec.PodSets
+	if isConsolidated(pr) {
+		// only the PodSets of the workload are updated
+		prPodSets = nil
+		for _, ps := range pr.Spec.PodSets {
+			if _, found := refMap[ps.PodTemplateRef.Name]; found {
+				prPodSets = append(prPodSets, ps)
+			}
+		}
+	}
+	return slices.Map(prPodSets, func(ps *autoscaling.PodSet) kueue.PodSetUpdate {
 		podSetUpdate := kueue.PodSetUpdate{
 			Name: refMap[ps.PodTemplateRef.Name],
 			Annotations: map[string]string{
@@ -798,7 +912,8 @@ func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
 	err := ctrl.NewControllerManagedBy(mgr).
 		Named("provisioning_workload").
 		For(&kueue.Workload{}).
-		Owns(&autoscaling.ProvisioningRequest{}).
+		// The consolidated ProvisioningRequests are owned by multiple workloads.
+		Watches(&autoscaling.ProvisioningRequest{}, handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &kueue.Workload{})).
 		Watches(&kueue.AdmissionCheck{}, ach).
 		Watches(&kueue.ProvisioningRequestConfig{}, prch).
 		Complete(c)
@@ -841,8 +956,9 @@ type MergedPodSet struct {
 func mergePodSets(
 	wl *kueue.Workload,
 	prcSpec *kueue.ProvisioningRequestConfigSpec,
+	onPodSets sets.Set[kueue.PodSetReference],
 ) ([]MergedPodSet, error) {
-	expectedPodSets := requiredPodSets(wl.Spec.PodSets, prcSpec.ManagedResources)
+	expectedPodSets := requiredPodSets(wl.Spec.PodSets, prcSpec.ManagedResources, onPodSets)
 	psaMap := slices.ToRefMap(wl.Status.Admission.PodSetAssignments, func(p *kueue.PodSetAssignment) kueue.PodSetReference { return p.Name })
 	podSetMap := slices.ToRefMap(wl.Spec.PodSets, func(ps *kueue.PodSet) kueue.PodSetReference { return ps.Name })
 
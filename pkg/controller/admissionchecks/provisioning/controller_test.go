@@ -42,6 +42,7 @@ import (
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/constants"
 	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	"sigs.k8s.io/kueue/pkg/workload"
 )
@@ -305,6 +306,7 @@ func TestReconcile(t *testing.T) {
 		templates            []corev1.PodTemplate
 		checks               []kueue.AdmissionCheck
 		configs              []kueue.ProvisioningRequestConfig
+		clusterQueues        []kueue.ClusterQueue
 		enableGates          []featuregate.Feature
 		flavors              []kueue.ResourceFlavor
 		workload             *kueue.Workload
@@ -598,6 +600,33 @@ func TestReconcile(t *testing.T) {
 					Obj(),
 			},
 		},
+		"when request fails, and there is no retry, with the Admit failure policy": {
+			workload:    baseWorkload.DeepCopy(),
+			checks:      []kueue.AdmissionCheck{*baseCheck.DeepCopy()},
+			flavors:     []kueue.ResourceFlavor{*baseFlavor1.DeepCopy(), *baseFlavor2.DeepCopy()},
+			configs:     []kueue.ProvisioningRequestConfig{*baseConfigWithRetryStrategy.Clone().RetryLimit(0).FailurePolicy(kueue.ProvisioningRequestFailurePolicyAdmit).Obj()},
+			enableGates: []featuregate.Feature{features.ProvisioningRequestFailurePolicy},
+			requests: []autoscaling.ProvisioningRequest{
+				*requestWithConditions(baseRequest, []metav1.Condition{{
+					Type:    autoscaling.Failed,
+					Status:  metav1.ConditionTrue,
+					Message: "out of capacity",
+				}}),
+			},
+			templates: []corev1.PodTemplate{*baseTemplate1.DeepCopy(), *baseTemplate2.DeepCopy()},
+			wantWorkloads: map[string]*kueue.Workload{
+				baseWorkload.GetName(): (&utiltesting.WorkloadWrapper{Workload: *baseWorkload.DeepCopy()}).
+					AdmissionChecks(kueue.AdmissionCheckState{
+						Name:    "check1",
+						State:   kueue.CheckStateReady,
+						Message: AdmittedWithoutCapacityMessage + ": out of capacity",
+					}, kueue.AdmissionCheckState{
+						Name:  "not-provisioning",
+						State: kueue.CheckStatePending,
+					}).
+					Obj(),
+			},
+		},
 		"when request is provisioned": {
 			workload: baseWorkload.DeepCopy(),
 			checks:   []kueue.AdmissionCheck{*baseCheck.DeepCopy()},
@@ -698,6 +727,57 @@ func TestReconcile(t *testing.T) {
 				},
 			},
 		},
+		"when the check runs for one PodSet": {
+			workload: baseWorkload.DeepCopy(),
+			checks:   []kueue.AdmissionCheck{*baseCheck.DeepCopy()},
+			flavors:  []kueue.ResourceFlavor{*baseFlavor1.DeepCopy(), *baseFlavor2.DeepCopy()},
+			configs:  []kueue.ProvisioningRequestConfig{*baseConfig.Clone().Obj()},
+			clusterQueues: []kueue.ClusterQueue{
+				*utiltesting.MakeClusterQueue("q1").
+					AdmissionCheckStrategy(*utiltesting.MakeAdmissionCheckStrategyRule("check1").OnPodSets("ps2").Obj()).
+					Obj(),
+			},
+			enableGates: []featuregate.Feature{features.AdmissionCheckPodSets},
+			wantWorkloads: map[string]*kueue.Workload{
+				baseWorkload.GetName(): baseWorkload.DeepCopy(),
+			},
+			wantRequests: map[string]*autoscaling.ProvisioningRequest{
+				"wl-check1-1": {
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{
+							constants.ManagedByKueueLabelKey: constants.ManagedByKueueLabelValue,
+						},
+					},
+					Spec: autoscaling.ProvisioningRequestSpec{
+						PodSets: []autoscaling.PodSet{
+							{
+								PodTemplateRef: autoscaling.Reference{
+									Name: "ppt-wl-check1-1-ps2",
+								},
+								Count: 3,
+							},
+						},
+						ProvisioningClassName: "class1",
+						Parameters: map[string]autoscaling.Parameter{
+							"p1": "v1",
+						},
+					},
+				},
+			},
+			wantTemplates: map[string]*corev1.PodTemplate{
+				baseTemplate2.Name: baseTemplate2.Clone().
+					ControllerReference(autoscaling.SchemeGroupVersion.WithKind("ProvisioningRequest"), "wl-check1-1", "").
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       client.ObjectKeyFromObject(baseWorkload),
+					EventType: corev1.EventTypeNormal,
+					Reason:    "ProvisioningRequestCreated",
+					Message:   `Created ProvisioningRequest: "wl-check1-1"`,
+				},
+			},
+		},
 		"when request is needed for one PodSet (resource limit)": {
 			workload: (&utiltesting.WorkloadWrapper{Workload: *baseWorkload.DeepCopy()}).Limit("example.com/gpu", "1").Obj(),
 			checks:   []kueue.AdmissionCheck{*baseCheck.DeepCopy()},
@@ -1596,6 +1676,7 @@ func TestReconcile(t *testing.T) {
 				&kueue.ProvisioningRequestConfigList{Items: tc.configs},
 				&kueue.AdmissionCheckList{Items: tc.checks},
 				&kueue.ResourceFlavorList{Items: tc.flavors},
+				&kueue.ClusterQueueList{Items: tc.clusterQueues},
 			)
 
 			k8sclient := builder.Build()
@@ -1789,10 +1870,200 @@ func TestActiveOrLastPRForChecks(t *testing.T) {
 				t.Fatalf("Setting up the provisioning request controller: %v", err)
 			}
 
-			gotResult := controller.activeOrLastPRForChecks(ctx, workload, checkConfig, tc.requests)
+			gotResult := controller.activeOrLastPRForChecks(ctx, workload, checkConfig, nil, tc.requests)
 			if diff := cmp.Diff(tc.wantResult, gotResult, reqCmpOptions...); diff != "" {
 				t.Errorf("unexpected request %q (-want/+got):\n%s", name, diff)
 			}
 		})
 	}
 }
+
+func TestConsolidation(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.ProvisioningRequestConsolidation, true)
+	fakeClock := testingclock.NewFakeClock(time.Now())
+
+	makeWorkload := func(name string) *kueue.Workload {
+		return utiltesting.MakeWorkload(name, TestNamespace).
+			PodSets(*utiltesting.MakePodSet("main", 4).Request(corev1.ResourceCPU, "1").Obj()).
+			ReserveQuota(utiltesting.MakeAdmission("q1").
+				PodSets(utiltesting.MakePodSetAssignment("main").Assignment(corev1.ResourceCPU, "flv1", "4").Count(4).Obj()).
+				Obj()).
+			AdmissionCheck(kueue.AdmissionCheckState{
+				Name:  "check1",
+				State: kueue.CheckStatePending,
+			}).
+			Obj()
+	}
+	wl1 := makeWorkload("wl1")
+	wl1.UID = "wl1-uid"
+	wl2 := makeWorkload("wl2")
+	wl2.UID = "wl2-uid"
+	config := utiltesting.MakeProvisioningRequestConfig("config1").
+		ProvisioningClass("class1").
+		RetryLimit(3).
+		BaseBackoff(60).
+		MaxBackoff(1800).
+		Obj()
+	config.Spec.Consolidation = &kueue.ProvisioningRequestConsolidation{
+		Window: metav1.Duration{Duration: time.Minute},
+	}
+
+	ctx, _ := utiltesting.ContextWithLog(t)
+	builder, ctx := getClientBuilder(ctx)
+	k8sclient := builder.
+		WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+		WithObjects(wl1, wl2, config,
+			utiltesting.MakeAdmissionCheck("check1").
+				ControllerName(kueue.ProvisioningRequestControllerName).
+				Parameters(kueue.GroupVersion.Group, ConfigKind, "config1").
+				Obj(),
+			utiltesting.MakeResourceFlavor("flv1").Obj(),
+		).
+		WithStatusSubresource(wl1, wl2).
+		Build()
+	controller, err := NewController(k8sclient, &utiltesting.EventRecorder{})
+	if err != nil {
+		t.Fatalf("Setting up the provisioning request controller: %v", err)
+	}
+	controller.clock = fakeClock
+
+	reconcileWorkload := func(wl *kueue.Workload) reconcile.Result {
+		t.Helper()
+		result, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(wl)})
+		if err != nil {
+			t.Fatalf("Unexpected reconcile error: %v", err)
+		}
+		return result
+	}
+	listRequests := func() []autoscaling.ProvisioningRequest {
+		t.Helper()
+		requests := &autoscaling.ProvisioningRequestList{}
+		if err := k8sclient.List(ctx, requests); err != nil {
+			t.Fatalf("Listing the provisioning requests: %v", err)
+		}
+		return requests.Items
+	}
+
+	// The workloads wait for the end of the window.
+	if result := reconcileWorkload(wl1); result.RequeueAfter != time.Minute {
+		t.Errorf("Unexpected requeue after %v for the first workload, want %v", result.RequeueAfter, time.Minute)
+	}
+	fakeClock.Step(10 * time.Second)
+	if result := reconcileWorkload(wl2); result.RequeueAfter != 50*time.Second {
+		t.Errorf("Unexpected requeue after %v for the second workload, want %v", result.RequeueAfter, 50*time.Second)
+	}
+	if requests := listRequests(); len(requests) != 0 {
+		t.Fatalf("Unexpected provisioning requests created before the end of the window: %v", requests)
+	}
+
+	// A single request is created for both workloads at the end of the window.
+	fakeClock.Step(50 * time.Second)
+	reconcileWorkload(wl1)
+	reconcileWorkload(wl2)
+	requests := listRequests()
+	if len(requests) != 1 {
+		t.Fatalf("Unexpected number of provisioning requests %d, want 1", len(requests))
+	}
+	gotRequest := requests[0]
+	wantPodSets := []autoscaling.PodSet{
+		{PodTemplateRef: autoscaling.Reference{Name: "ppt-wl1-check1-1-wl1-main"}, Count: 4},
+		{PodTemplateRef: autoscaling.Reference{Name: "ppt-wl1-check1-1-wl2-main"}, Count: 4},
+	}
+	if diff := cmp.Diff(wantPodSets, gotRequest.Spec.PodSets); diff != "" {
+		t.Errorf("Unexpected podSets of the consolidated request (-want,+got):\n%s", diff)
+	}
+	if gotRequest.Annotations[ConsolidatedCheckAnnotation] != "check1" || len(gotRequest.OwnerReferences) != 2 {
+		t.Errorf("Unexpected consolidated request metadata: %+v", gotRequest.ObjectMeta)
+	}
+
+	// Both workloads pass the check once the request is provisioned.
+	apimeta.SetStatusCondition(&gotRequest.Status.Conditions, metav1.Condition{
+		Type:   autoscaling.Provisioned,
+		Status: metav1.ConditionTrue,
+		Reason: autoscaling.Provisioned,
+	})
+	if err := k8sclient.Update(ctx, &gotRequest); err != nil {
+		t.Fatalf("Updating the provisioning request: %v", err)
+	}
+	for _, wl := range []*kueue.Workload{wl1, wl2} {
+		reconcileWorkload(wl)
+		gotWl := &kueue.Workload{}
+		if err := k8sclient.Get(ctx, client.ObjectKeyFromObject(wl), gotWl); err != nil {
+			t.Fatalf("Getting the workload: %v", err)
+		}
+		state := admissioncheck.FindAdmissionCheck(gotWl.Status.AdmissionChecks, "check1")
+		if state == nil || state.State != kueue.CheckStateReady {
+			t.Errorf("Unexpected check state of workload %q: %+v", wl.Name, state)
+			continue
+		}
+		wantUpdates := []kueue.PodSetUpdate{{
+			Name: "main",
+			Annotations: map[string]string{
+				autoscaling.ProvisioningRequestPodAnnotationKey: "wl1-check1-1",
+				autoscaling.ProvisioningClassPodAnnotationKey:   "class1",
+			},
+		}}
+		if diff := cmp.Diff(wantUpdates, state.PodSetUpdates); diff != "" {
+			t.Errorf("Unexpected podSetUpdates of workload %q (-want,+got):\n%s", wl.Name, diff)
+		}
+	}
+}
+
+func TestRenderConfig(t *testing.T) {
+	prc := utiltesting.MakeProvisioningRequestConfig("prov-config").
+		ProvisioningClass("${label:team}.example.com").
+		WithParameter("machineFamily", "${annotation:example.com/machine-family:n2}").
+		WithParameter("static", "value").
+		Obj()
+	cases := map[string]struct {
+		enableTemplates bool
+		workload        *kueue.Workload
+		wantClass       string
+		wantParameters  map[string]kueue.Parameter
+	}{
+		"the feature gate is disabled": {
+			workload:  utiltesting.MakeWorkload("wl", TestNamespace).Label("team", "ml").Obj(),
+			wantClass: "${label:team}.example.com",
+			wantParameters: map[string]kueue.Parameter{
+				"machineFamily": "${annotation:example.com/machine-family:n2}",
+				"static":        "value",
+			},
+		},
+		"references the labels and annotations of the workload": {
+			enableTemplates: true,
+			workload: utiltesting.MakeWorkload("wl", TestNamespace).
+				Label("team", "ml").
+				Annotation("example.com/machine-family", "a3").
+				Obj(),
+			wantClass: "ml.example.com",
+			wantParameters: map[string]kueue.Parameter{
+				"machineFamily": "a3",
+				"static":        "value",
+			},
+		},
+		"uses the default values": {
+			enableTemplates: true,
+			workload:        utiltesting.MakeWorkload("wl", TestNamespace).Obj(),
+			wantClass:       ".example.com",
+			wantParameters: map[string]kueue.Parameter{
+				"machineFamily": "n2",
+				"static":        "value",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.ProvisioningRequestConfigTemplates, tc.enableTemplates)
+			got := renderConfig(prc, tc.workload)
+			if diff := cmp.Diff(tc.wantClass, got.Spec.ProvisioningClassName); diff != "" {
+				t.Errorf("Unexpected provisioningClassName (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantParameters, got.Spec.Parameters); diff != "" {
+				t.Errorf("Unexpected parameters (-want,+got):\n%s", diff)
+			}
+			if prc.Spec.ProvisioningClassName != "${label:team}.example.com" {
+				t.Errorf("The config was modified: %v", prc.Spec)
+			}
+		})
+	}
+}
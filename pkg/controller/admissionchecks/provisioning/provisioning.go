@@ -29,6 +29,7 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
 )
 
@@ -68,12 +69,19 @@ func getProvisioningRequestPodTemplateName(prName string, podsetName kueue.PodSe
 }
 
 func matchesWorkloadAndCheck(pr *autoscaling.ProvisioningRequest, workloadName string, checkName kueue.AdmissionCheckReference) bool {
+	if consolidatedCheck, found := pr.Annotations[ConsolidatedCheckAnnotation]; found {
+		return consolidatedCheck == string(checkName)
+	}
 	attemptRegex := getAttemptRegex(workloadName, checkName)
 	matches := attemptRegex.FindStringSubmatch(pr.Name)
 	return len(matches) > 0
 }
 
 func getAttempt(log logr.Logger, pr *autoscaling.ProvisioningRequest, workloadName string, checkName kueue.AdmissionCheckReference) int32 {
+	if isConsolidated(pr) {
+		// only the first requests are consolidated
+		return 1
+	}
 	attemptRegex := getAttemptRegex(workloadName, checkName)
 	matches := attemptRegex.FindStringSubmatch(pr.Name)
 	if len(matches) > 0 {
@@ -106,6 +114,37 @@ func parametersKueueToProvisioning(in map[string]kueue.Parameter) map[string]aut
 	return out
 }
 
+var configTemplateRegex = regexp.MustCompile(`\$\{(label|annotation):([^:}]+)(?::([^}]*))?\}`)
+
+// renderConfig returns the config with the references to the labels and
+// annotations of the workload in the provisioningClassName and parameters
+// replaced by their values, or by the default values of the references when
+// the workload doesn't have them.
+func renderConfig(prc *kueue.ProvisioningRequestConfig, wl *kueue.Workload) *kueue.ProvisioningRequestConfig {
+	if prc == nil || !features.Enabled(features.ProvisioningRequestConfigTemplates) {
+		return prc
+	}
+	render := func(in string) string {
+		return configTemplateRegex.ReplaceAllStringFunc(in, func(ref string) string {
+			match := configTemplateRegex.FindStringSubmatch(ref)
+			values := wl.Labels
+			if match[1] == "annotation" {
+				values = wl.Annotations
+			}
+			if val, found := values[match[2]]; found {
+				return val
+			}
+			return match[3]
+		})
+	}
+	rendered := prc.DeepCopy()
+	rendered.Spec.ProvisioningClassName = render(prc.Spec.ProvisioningClassName)
+	for k, v := range prc.Spec.Parameters {
+		rendered.Spec.Parameters[k] = kueue.Parameter(render(string(v)))
+	}
+	return rendered
+}
+
 // provReqSyncedWithConfig checks if the provisioning request has the same provisioningClassName as the provisioning request config
 // and contains all the parameters from the config
 func provReqSyncedWithConfig(req *autoscaling.ProvisioningRequest, prc *kueue.ProvisioningRequestConfig) bool {
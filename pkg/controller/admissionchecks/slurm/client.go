@@ -0,0 +1,252 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slurm
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+const (
+	// maxResponseSize is the maximum size of the response body read from slurmrestd.
+	maxResponseSize = 1 << 20
+
+	defaultAPIVersion = "v0.0.40"
+
+	userNameHeader  = "X-SLURM-USER-NAME"
+	userTokenHeader = "X-SLURM-USER-TOKEN"
+
+	tokenSecretUserKey  = "user"
+	tokenSecretTokenKey = "token"
+)
+
+var (
+	errInvalidCABundle = errors.New("the caBundle doesn't contain any valid PEM encoded certificate")
+	errJobNotFound     = errors.New("the job is not found")
+)
+
+// uint64NoVal is the optional number type of the Slurm REST API.
+type uint64NoVal struct {
+	Set    bool   `json:"set"`
+	Number uint64 `json:"number"`
+}
+
+func setNumber(n uint64) *uint64NoVal {
+	return &uint64NoVal{Set: true, Number: n}
+}
+
+// jobDescription is the description of the submitted jobs.
+type jobDescription struct {
+	Name                    string       `json:"name"`
+	Comment                 string       `json:"comment,omitempty"`
+	Partition               string       `json:"partition,omitempty"`
+	Account                 string       `json:"account,omitempty"`
+	CurrentWorkingDirectory string       `json:"current_working_directory"`
+	Environment             []string     `json:"environment"`
+	Tasks                   int32        `json:"tasks"`
+	CPUsPerTask             int32        `json:"cpus_per_task"`
+	MemoryPerCPU            *uint64NoVal `json:"memory_per_cpu,omitempty"`
+	TRESPerTask             string       `json:"tres_per_task,omitempty"`
+	TimeLimit               *uint64NoVal `json:"time_limit,omitempty"`
+}
+
+type submitRequest struct {
+	Script string          `json:"script"`
+	Job    *jobDescription `json:"job"`
+}
+
+type apiError struct {
+	Error       string `json:"error"`
+	Description string `json:"description"`
+}
+
+type submitResponse struct {
+	JobID  int32      `json:"job_id"`
+	Errors []apiError `json:"errors"`
+}
+
+// jobState is the state of a job, a list of states since v0.0.40 and a
+// single state before.
+type jobState []string
+
+func (s *jobState) UnmarshalJSON(data []byte) error {
+	var states []string
+	if err := json.Unmarshal(data, &states); err == nil {
+		*s = states
+		return nil
+	}
+	var state string
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+	*s = []string{state}
+	return nil
+}
+
+type jobInfo struct {
+	JobID    int32    `json:"job_id"`
+	JobState jobState `json:"job_state"`
+}
+
+type jobsResponse struct {
+	Jobs   []jobInfo  `json:"jobs"`
+	Errors []apiError `json:"errors"`
+}
+
+func errorsMessage(errs []apiError) string {
+	msgs := make([]string, 0, len(errs))
+	for _, e := range errs {
+		msg := e.Description
+		if msg == "" {
+			msg = e.Error
+		}
+		msgs = append(msgs, msg)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// restClient calls the slurmrestd API of a configuration.
+type restClient struct {
+	httpClient *http.Client
+	baseURL    string
+	user       string
+	token      string
+}
+
+// newRESTClient returns a client of the slurmrestd API of the configuration,
+// authenticating with the token of the Secret of the configuration.
+func (c *Controller) newRESTClient(ctx context.Context, cfg *kueue.SlurmAdmissionCheckConfig) (*restClient, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(cfg.Spec.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cfg.Spec.CABundle) {
+			return nil, errInvalidCABundle
+		}
+		tlsConfig.RootCAs = pool
+	}
+	ref := cfg.Spec.TokenSecretRef
+	secret := &corev1.Secret{}
+	if err := c.client.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return nil, fmt.Errorf("getting the token secret: %w", err)
+	}
+	apiVersion := cfg.Spec.APIVersion
+	if apiVersion == "" {
+		apiVersion = defaultAPIVersion
+	}
+	return &restClient{
+		httpClient: &http.Client{
+			Timeout:   ptr.Deref(cfg.Spec.Timeout, metav1.Duration{Duration: defaultTimeout}).Duration,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		baseURL: fmt.Sprintf("%s/slurm/%s", strings.TrimSuffix(cfg.Spec.URL, "/"), apiVersion),
+		user:    string(secret.Data[tokenSecretUserKey]),
+		token:   string(secret.Data[tokenSecretTokenKey]),
+	}, nil
+}
+
+func (r *restClient) close() {
+	r.httpClient.CloseIdleConnections()
+}
+
+// do sends the request and decodes the response into out. Returns
+// errJobNotFound when slurmrestd responds with 404.
+func (r *restClient) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, r.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(userNameHeader, r.user)
+	req.Header.Set(userTokenHeader, r.token)
+
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return errJobNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(out); err != nil {
+		return fmt.Errorf("decoding the response: %w", err)
+	}
+	return nil
+}
+
+// submit submits the job and returns its ID.
+func (r *restClient) submit(ctx context.Context, script string, job *jobDescription) (string, error) {
+	resp := &submitResponse{}
+	if err := r.do(ctx, http.MethodPost, "/job/submit", &submitRequest{Script: script, Job: job}, resp); err != nil {
+		return "", err
+	}
+	if len(resp.Errors) > 0 {
+		return "", errors.New(errorsMessage(resp.Errors))
+	}
+	return fmt.Sprint(resp.JobID), nil
+}
+
+// state returns the state of the job.
+func (r *restClient) state(ctx context.Context, jobID string) (string, error) {
+	resp := &jobsResponse{}
+	if err := r.do(ctx, http.MethodGet, "/job/"+jobID, nil, resp); err != nil {
+		return "", err
+	}
+	if len(resp.Jobs) == 0 || len(resp.Jobs[0].JobState) == 0 {
+		if len(resp.Errors) > 0 {
+			return "", errors.New(errorsMessage(resp.Errors))
+		}
+		return "", errJobNotFound
+	}
+	return resp.Jobs[0].JobState[0], nil
+}
+
+// cancel cancels the job. Cancelling a job which is not found succeeds.
+func (r *restClient) cancel(ctx context.Context, jobID string) error {
+	if err := r.do(ctx, http.MethodDelete, "/job/"+jobID, nil, nil); err != nil && !errors.Is(err, errJobNotFound) {
+		return err
+	}
+	return nil
+}
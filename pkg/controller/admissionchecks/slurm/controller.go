@@ -0,0 +1,296 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slurm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	defaultTimeout      = 10 * time.Second
+	defaultPollInterval = 30 * time.Second
+)
+
+var (
+	realClock = clock.RealClock{}
+)
+
+type configHelper = admissioncheck.ConfigHelper[*kueue.SlurmAdmissionCheckConfig, kueue.SlurmAdmissionCheckConfig]
+
+type Option func(*Controller)
+
+// WithClock sets the clock used by the controller.
+func WithClock(c clock.Clock) Option {
+	return func(ctrl *Controller) {
+		ctrl.clock = c
+	}
+}
+
+// Controller dispatches the Workloads that have quota reserved for a Slurm
+// admission check to the Slurm cluster of the check, through slurmrestd.
+//
+// Like MultiKueue, the check is kept Pending while the Slurm job runs, so that
+// the job of the Workload stays suspended in the cluster of the manager, and
+// the Workload is declared finished when the Slurm job finishes.
+type Controller struct {
+	client client.Client
+	helper *configHelper
+	clock  clock.Clock
+
+	// jobs holds the Slurm jobs submitted for the Workloads, so that the
+	// jobs of the deleted Workloads are cancelled.
+	jobsLock sync.Mutex
+	jobs     map[types.NamespacedName]submittedJob
+}
+
+type submittedJob struct {
+	check kueue.AdmissionCheckReference
+	id    string
+}
+
+var _ reconcile.Reconciler = (*Controller)(nil)
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=slurmadmissioncheckconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+func NewController(client client.Client, opts ...Option) (*Controller, error) {
+	helper, err := admissioncheck.NewConfigHelper[*kueue.SlurmAdmissionCheckConfig](client)
+	if err != nil {
+		return nil, err
+	}
+	c := &Controller{
+		client: client,
+		helper: helper,
+		clock:  realClock,
+		jobs:   make(map[types.NamespacedName]submittedJob),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	wl := &kueue.Workload{}
+	if err := c.client.Get(ctx, req.NamespacedName, wl); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, c.cancelDeleted(ctx, req.NamespacedName)
+		}
+		return reconcile.Result{}, err
+	}
+
+	checks, err := admissioncheck.FilterForController(ctx, c.client, wl.Status.AdmissionChecks, kueue.SlurmAdmissionCheckControllerName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(checks) == 0 {
+		return reconcile.Result{}, nil
+	}
+	checkName := checks[0]
+	jobID := wl.Annotations[kueue.SlurmJobIDAnnotation]
+
+	if workload.IsFinished(wl) {
+		c.forgetJob(req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+	running := workload.HasQuotaReservation(wl) && !workload.IsEvicted(wl) && workload.IsActive(wl)
+	if !running && jobID == "" {
+		return reconcile.Result{}, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx).WithValues("admissionCheck", checkName, "slurmJobID", jobID)
+	ctx = ctrl.LoggerInto(ctx, log)
+	log.V(2).Info("Reconcile Slurm admission check")
+
+	current := admissioncheck.FindAdmissionCheck(wl.Status.AdmissionChecks, checkName)
+	cfg, err := c.helper.ConfigForAdmissionCheck(ctx, checkName)
+	if err != nil {
+		return c.retry(ctx, wl, current, defaultPollInterval, "Failed to get the configuration of the admission check: %v", err)
+	}
+	pollInterval := ptr.Deref(cfg.Spec.PollInterval, metav1.Duration{Duration: defaultPollInterval}).Duration
+	rc, err := c.newRESTClient(ctx, cfg)
+	if err != nil {
+		return c.retry(ctx, wl, current, pollInterval, "Failed to create the slurmrestd client: %v", err)
+	}
+	defer rc.close()
+
+	if !running {
+		// The Workload lost its quota, cancel its Slurm job so that it's
+		// submitted again when the Workload is admitted again.
+		if err := rc.cancel(ctx, jobID); err != nil {
+			log.V(2).Info("Failed to cancel the Slurm job", "err", err)
+			return reconcile.Result{RequeueAfter: pollInterval}, nil
+		}
+		log.V(2).Info("Cancelled the Slurm job of the Workload")
+		c.forgetJob(req.NamespacedName)
+		return reconcile.Result{}, c.setJobID(ctx, wl, "")
+	}
+	if current.State != kueue.CheckStatePending {
+		return reconcile.Result{}, nil
+	}
+
+	if jobID == "" {
+		script, job, err := jobForWorkload(cfg, wl)
+		if err != nil {
+			return reconcile.Result{}, c.setCheckState(ctx, wl, current, kueue.CheckStateRejected, err.Error())
+		}
+		jobID, err = rc.submit(ctx, script, job)
+		if err != nil {
+			return c.retry(ctx, wl, current, pollInterval, "Failed to submit the Slurm job: %v", err)
+		}
+		if err := c.setJobID(ctx, wl, jobID); err != nil {
+			// Don't leave a job that isn't recorded in the Workload running.
+			if cancelErr := rc.cancel(ctx, jobID); cancelErr != nil {
+				log.Error(cancelErr, "Failed to cancel the unrecorded Slurm job", "slurmJobID", jobID)
+			}
+			return reconcile.Result{}, err
+		}
+		log.V(2).Info("Submitted the Slurm job of the Workload", "slurmJobID", jobID)
+		c.trackJob(req.NamespacedName, checkName, jobID)
+		return reconcile.Result{RequeueAfter: pollInterval}, c.setCheckState(ctx, wl, current, kueue.CheckStatePending, fmt.Sprintf("Submitted as the Slurm job %s", jobID))
+	}
+
+	c.trackJob(req.NamespacedName, checkName, jobID)
+	state, err := rc.state(ctx, jobID)
+	switch {
+	case errors.Is(err, errJobNotFound):
+		return reconcile.Result{}, c.finish(ctx, wl, kueue.WorkloadFinishedReasonFailed, fmt.Sprintf("The Slurm job %s is not found", jobID))
+	case err != nil:
+		return c.retry(ctx, wl, current, pollInterval, "Failed to get the state of the Slurm job %s: %v", jobID, err)
+	case state == stateCompleted:
+		return reconcile.Result{}, c.finish(ctx, wl, kueue.WorkloadFinishedReasonSucceeded, fmt.Sprintf("The Slurm job %s is completed", jobID))
+	case failedStates[state]:
+		return reconcile.Result{}, c.finish(ctx, wl, kueue.WorkloadFinishedReasonFailed, fmt.Sprintf("The Slurm job %s finished in the %s state", jobID, state))
+	}
+	return reconcile.Result{RequeueAfter: pollInterval}, c.setCheckState(ctx, wl, current, kueue.CheckStatePending, fmt.Sprintf("The Slurm job %s is %s", jobID, state))
+}
+
+// retry keeps the check Pending with the message, and requeues the Workload after the interval.
+func (c *Controller) retry(ctx context.Context, wl *kueue.Workload, current *kueue.AdmissionCheckState, after time.Duration, format string, args ...any) (reconcile.Result, error) {
+	message := fmt.Sprintf(format, args...)
+	ctrl.LoggerFrom(ctx).V(2).Info("Retrying the Slurm admission check", "message", message)
+	if current.State != kueue.CheckStatePending {
+		return reconcile.Result{RequeueAfter: after}, nil
+	}
+	return reconcile.Result{RequeueAfter: after}, c.setCheckState(ctx, wl, current, kueue.CheckStatePending, message)
+}
+
+func (c *Controller) setCheckState(ctx context.Context, wl *kueue.Workload, current *kueue.AdmissionCheckState, state kueue.CheckState, message string) error {
+	if current.State == state && current.Message == message {
+		return nil
+	}
+	wlPatch := workload.BaseSSAWorkload(wl, true)
+	workload.SetAdmissionCheckState(&wlPatch.Status.AdmissionChecks, kueue.AdmissionCheckState{
+		Name:               current.Name,
+		State:              state,
+		LastTransitionTime: current.LastTransitionTime,
+		Message:            message,
+		PodSetUpdates:      current.PodSetUpdates,
+	}, c.clock)
+	err := c.client.Status().Patch(ctx, wlPatch, client.Apply, client.FieldOwner(kueue.SlurmAdmissionCheckControllerName), client.ForceOwnership)
+	return client.IgnoreNotFound(err)
+}
+
+// setJobID records the ID of the Slurm job in the Workload, or removes it when empty.
+func (c *Controller) setJobID(ctx context.Context, wl *kueue.Workload, jobID string) error {
+	patch := client.MergeFrom(wl.DeepCopy())
+	if jobID == "" {
+		delete(wl.Annotations, kueue.SlurmJobIDAnnotation)
+	} else {
+		metav1.SetMetaDataAnnotation(&wl.ObjectMeta, kueue.SlurmJobIDAnnotation, jobID)
+	}
+	return c.client.Patch(ctx, wl, patch)
+}
+
+func (c *Controller) finish(ctx context.Context, wl *kueue.Workload, reason, message string) error {
+	ctrl.LoggerFrom(ctx).V(2).Info("The Slurm job of the Workload finished", "reason", reason)
+	c.forgetJob(client.ObjectKeyFromObject(wl))
+	err := workload.UpdateStatus(ctx, c.client, wl, kueue.WorkloadFinished, metav1.ConditionTrue, reason, message, kueue.SlurmAdmissionCheckControllerName, c.clock)
+	return client.IgnoreNotFound(err)
+}
+
+// cancelDeleted cancels the Slurm job submitted for the deleted Workload, if any.
+func (c *Controller) cancelDeleted(ctx context.Context, wlKey types.NamespacedName) error {
+	c.jobsLock.Lock()
+	job, found := c.jobs[wlKey]
+	c.jobsLock.Unlock()
+	if !found {
+		return nil
+	}
+	log := ctrl.LoggerFrom(ctx).WithValues("slurmJobID", job.id)
+	cfg, err := c.helper.ConfigForAdmissionCheck(ctx, job.check)
+	if err != nil {
+		return err
+	}
+	rc, err := c.newRESTClient(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer rc.close()
+	if err := rc.cancel(ctx, job.id); err != nil {
+		return err
+	}
+	log.V(2).Info("Cancelled the Slurm job of the deleted Workload")
+	c.forgetJob(wlKey)
+	return nil
+}
+
+func (c *Controller) trackJob(wlKey types.NamespacedName, check kueue.AdmissionCheckReference, id string) {
+	c.jobsLock.Lock()
+	defer c.jobsLock.Unlock()
+	c.jobs[wlKey] = submittedJob{check: check, id: id}
+}
+
+func (c *Controller) forgetJob(wlKey types.NamespacedName) {
+	c.jobsLock.Lock()
+	defer c.jobsLock.Unlock()
+	delete(c.jobs, wlKey)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named("slurm_workload").
+		For(&kueue.Workload{}).
+		Complete(c)
+	if err != nil {
+		return err
+	}
+	return admissioncheck.NewConfigReconciler(c.client, kueue.SlurmAdmissionCheckControllerName, c.helper).
+		SetupWithManager(mgr, "slurm_admissioncheck")
+}
@@ -0,0 +1,353 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slurm
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+type slurmRequest struct {
+	method string
+	path   string
+	user   string
+	token  string
+	submit *submitRequest
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	admission := utiltesting.MakeAdmission("cq").
+		PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+			Assignment(corev1.ResourceCPU, "default", "4").
+			Assignment(corev1.ResourceMemory, "default", "2Gi").
+			Count(2).
+			Obj()).
+		Obj()
+	baseWorkload := utiltesting.MakeWorkload("wl", "ns").
+		PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 2).
+			Request(corev1.ResourceCPU, "2").
+			Request(corev1.ResourceMemory, "1Gi").
+			Obj()).
+		ReserveQuota(admission).
+		AdmissionCheck(kueue.AdmissionCheckState{
+			Name:               "slurm-check",
+			State:              kueue.CheckStatePending,
+			LastTransitionTime: metav1.NewTime(now),
+		})
+	baseWorkload.Spec.PodSets[0].Template.Spec.Containers[0].Command = []string{"train.sh", "--epochs=3"}
+	baseWorkload.Spec.MaximumExecutionTimeSeconds = ptr.To[int32](3600)
+
+	cases := map[string]struct {
+		workload       *kueue.Workload
+		handler        func(w http.ResponseWriter, r *http.Request)
+		wantRequests   []slurmRequest
+		wantState      kueue.AdmissionCheckState
+		wantJobID      string
+		wantFinished   *metav1.Condition
+		wantResult     reconcile.Result
+		ignoreRequests bool
+	}{
+		"submits the job of the workload": {
+			workload: baseWorkload.Clone().Obj(),
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_ = json.NewEncoder(w).Encode(submitResponse{JobID: 42})
+			},
+			wantRequests: []slurmRequest{{
+				method: http.MethodPost,
+				path:   "/slurm/v0.0.40/job/submit",
+				user:   "kueue",
+				token:  "secret-token",
+				submit: &submitRequest{
+					Script: "#!/bin/bash\nexec srun 'train.sh' '--epochs=3'\n",
+					Job: &jobDescription{
+						Name:                    "wl",
+						Comment:                 "Kueue workload ns/wl",
+						Partition:               "batch",
+						CurrentWorkingDirectory: "/tmp",
+						Environment:             []string{"KUEUE_WORKLOAD_NAME=wl", "KUEUE_WORKLOAD_NAMESPACE=ns"},
+						Tasks:                   2,
+						CPUsPerTask:             2,
+						MemoryPerCPU:            setNumber(512),
+						TimeLimit:               setNumber(60),
+					},
+				},
+			}},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "slurm-check",
+				State:   kueue.CheckStatePending,
+				Message: "Submitted as the Slurm job 42",
+			},
+			wantJobID:  "42",
+			wantResult: reconcile.Result{RequeueAfter: 10 * time.Second},
+		},
+		"submission fails": {
+			workload: baseWorkload.Clone().Obj(),
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_ = json.NewEncoder(w).Encode(submitResponse{Errors: []apiError{{Description: "invalid partition"}}})
+			},
+			ignoreRequests: true,
+			wantState: kueue.AdmissionCheckState{
+				Name:    "slurm-check",
+				State:   kueue.CheckStatePending,
+				Message: "Failed to submit the Slurm job: invalid partition",
+			},
+			wantResult: reconcile.Result{RequeueAfter: 10 * time.Second},
+		},
+		"workload which is not eligible is rejected": {
+			workload: baseWorkload.Clone().
+				PodSets(
+					*utiltesting.MakePodSet("driver", 1).Obj(),
+					*utiltesting.MakePodSet("workers", 2).Obj(),
+				).
+				Obj(),
+			wantState: kueue.AdmissionCheckState{
+				Name:    "slurm-check",
+				State:   kueue.CheckStateRejected,
+				Message: "the workload cannot be dispatched to Slurm: the workload has 2 PodSets, only one is supported",
+			},
+		},
+		"job is running": {
+			workload: baseWorkload.Clone().Annotation(kueue.SlurmJobIDAnnotation, "42").Obj(),
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_ = json.NewEncoder(w).Encode(jobsResponse{Jobs: []jobInfo{{JobID: 42, JobState: jobState{"RUNNING"}}}})
+			},
+			wantRequests: []slurmRequest{{
+				method: http.MethodGet,
+				path:   "/slurm/v0.0.40/job/42",
+				user:   "kueue",
+				token:  "secret-token",
+			}},
+			wantState: kueue.AdmissionCheckState{
+				Name:    "slurm-check",
+				State:   kueue.CheckStatePending,
+				Message: "The Slurm job 42 is RUNNING",
+			},
+			wantJobID:  "42",
+			wantResult: reconcile.Result{RequeueAfter: 10 * time.Second},
+		},
+		"job is completed": {
+			workload: baseWorkload.Clone().Annotation(kueue.SlurmJobIDAnnotation, "42").Obj(),
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"jobs":[{"job_id":42,"job_state":"COMPLETED"}]}`))
+			},
+			ignoreRequests: true,
+			wantState: kueue.AdmissionCheckState{
+				Name:  "slurm-check",
+				State: kueue.CheckStatePending,
+			},
+			wantJobID: "42",
+			wantFinished: &metav1.Condition{
+				Type:    kueue.WorkloadFinished,
+				Status:  metav1.ConditionTrue,
+				Reason:  kueue.WorkloadFinishedReasonSucceeded,
+				Message: "The Slurm job 42 is completed",
+			},
+		},
+		"job failed": {
+			workload: baseWorkload.Clone().Annotation(kueue.SlurmJobIDAnnotation, "42").Obj(),
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_ = json.NewEncoder(w).Encode(jobsResponse{Jobs: []jobInfo{{JobID: 42, JobState: jobState{"OUT_OF_MEMORY"}}}})
+			},
+			ignoreRequests: true,
+			wantState: kueue.AdmissionCheckState{
+				Name:  "slurm-check",
+				State: kueue.CheckStatePending,
+			},
+			wantJobID: "42",
+			wantFinished: &metav1.Condition{
+				Type:    kueue.WorkloadFinished,
+				Status:  metav1.ConditionTrue,
+				Reason:  kueue.WorkloadFinishedReasonFailed,
+				Message: "The Slurm job 42 finished in the OUT_OF_MEMORY state",
+			},
+		},
+		"job of an evicted workload is cancelled": {
+			workload: baseWorkload.Clone().
+				Annotation(kueue.SlurmJobIDAnnotation, "42").
+				Condition(metav1.Condition{
+					Type:   kueue.WorkloadEvicted,
+					Status: metav1.ConditionTrue,
+					Reason: kueue.WorkloadEvictedByPreemption,
+				}).
+				Obj(),
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			wantRequests: []slurmRequest{{
+				method: http.MethodDelete,
+				path:   "/slurm/v0.0.40/job/42",
+				user:   "kueue",
+				token:  "secret-token",
+			}},
+			wantState: kueue.AdmissionCheckState{
+				Name:  "slurm-check",
+				State: kueue.CheckStatePending,
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var gotRequests []slurmRequest
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				req := slurmRequest{
+					method: r.Method,
+					path:   r.URL.Path,
+					user:   r.Header.Get(userNameHeader),
+					token:  r.Header.Get(userTokenHeader),
+				}
+				if r.Method == http.MethodPost {
+					req.submit = &submitRequest{}
+					if err := json.NewDecoder(r.Body).Decode(req.submit); err != nil {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+				}
+				gotRequests = append(gotRequests, req)
+				if tc.handler == nil {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				tc.handler(w, r)
+			}))
+			defer server.Close()
+
+			objs := []client.Object{
+				utiltesting.MakeAdmissionCheck("slurm-check").
+					ControllerName(kueue.SlurmAdmissionCheckControllerName).
+					Parameters(kueue.GroupVersion.Group, "SlurmAdmissionCheckConfig", "config").
+					Obj(),
+				&kueue.SlurmAdmissionCheckConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "config"},
+					Spec: kueue.SlurmAdmissionCheckConfigSpec{
+						URL:            server.URL,
+						TokenSecretRef: kueue.SlurmAdmissionCheckSecretReference{Namespace: "kueue-system", Name: "slurm-token"},
+						Partition:      "batch",
+						PollInterval:   &metav1.Duration{Duration: 10 * time.Second},
+					},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "kueue-system", Name: "slurm-token"},
+					Data: map[string][]byte{
+						tokenSecretUserKey:  []byte("kueue"),
+						tokenSecretTokenKey: []byte("secret-token"),
+					},
+				},
+				tc.workload,
+			}
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(objs...).
+				WithStatusSubresource(tc.workload).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+			ctx, _ := utiltesting.ContextWithLog(t)
+
+			controller, err := NewController(cl, WithClock(testingclock.NewFakeClock(now)))
+			if err != nil {
+				t.Fatalf("Failed to create the controller: %v", err)
+			}
+			gotResult, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tc.workload)})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, gotResult); diff != "" {
+				t.Errorf("Unexpected result (-want,+got):\n%s", diff)
+			}
+			if !tc.ignoreRequests {
+				if diff := cmp.Diff(tc.wantRequests, gotRequests, cmp.AllowUnexported(slurmRequest{})); diff != "" {
+					t.Errorf("Unexpected requests to slurmrestd (-want,+got):\n%s", diff)
+				}
+			}
+
+			var updated kueue.Workload
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(tc.workload), &updated); err != nil {
+				t.Fatalf("Failed to get the workload: %v", err)
+			}
+			if diff := cmp.Diff([]kueue.AdmissionCheckState{tc.wantState}, updated.Status.AdmissionChecks, cmpopts.IgnoreFields(kueue.AdmissionCheckState{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected admission check states (-want,+got):\n%s", diff)
+			}
+			if got := updated.Annotations[kueue.SlurmJobIDAnnotation]; got != tc.wantJobID {
+				t.Errorf("Unexpected Slurm job ID, want=%q, got=%q", tc.wantJobID, got)
+			}
+			gotFinished := apimeta.FindStatusCondition(updated.Status.Conditions, kueue.WorkloadFinished)
+			if diff := cmp.Diff(tc.wantFinished, gotFinished, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "ObservedGeneration")); diff != "" {
+				t.Errorf("Unexpected Finished condition (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCancelDeletedWorkload(t *testing.T) {
+	var gotRequests []slurmRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequests = append(gotRequests, slurmRequest{method: r.Method, path: r.URL.Path})
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	cl := utiltesting.NewClientBuilder().
+		WithObjects(
+			&kueue.SlurmAdmissionCheckConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "config"},
+				Spec: kueue.SlurmAdmissionCheckConfigSpec{
+					URL:            server.URL,
+					TokenSecretRef: kueue.SlurmAdmissionCheckSecretReference{Namespace: "kueue-system", Name: "slurm-token"},
+				},
+			},
+			utiltesting.MakeAdmissionCheck("slurm-check").
+				ControllerName(kueue.SlurmAdmissionCheckControllerName).
+				Parameters(kueue.GroupVersion.Group, "SlurmAdmissionCheckConfig", "config").
+				Obj(),
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "kueue-system", Name: "slurm-token"}},
+		).
+		Build()
+	ctx, _ := utiltesting.ContextWithLog(t)
+	controller, err := NewController(cl)
+	if err != nil {
+		t.Fatalf("Failed to create the controller: %v", err)
+	}
+	key := client.ObjectKey{Namespace: "ns", Name: "wl"}
+	controller.trackJob(key, "slurm-check", "42")
+
+	if _, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantRequests := []slurmRequest{{method: http.MethodDelete, path: "/slurm/v0.0.40/job/42"}}
+	if diff := cmp.Diff(wantRequests, gotRequests, cmp.AllowUnexported(slurmRequest{})); diff != "" {
+		t.Errorf("Unexpected requests to slurmrestd (-want,+got):\n%s", diff)
+	}
+	if _, found := controller.jobs[key]; found {
+		t.Error("The job of the deleted workload is still tracked")
+	}
+}
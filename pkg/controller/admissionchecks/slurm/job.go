@@ -0,0 +1,139 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package slurm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	gpuResource corev1.ResourceName = "nvidia.com/gpu"
+
+	mebibyte = 1 << 20
+
+	workloadNameEnv      = "KUEUE_WORKLOAD_NAME"
+	workloadNamespaceEnv = "KUEUE_WORKLOAD_NAMESPACE"
+)
+
+var errNotEligible = errors.New("the workload cannot be dispatched to Slurm")
+
+// Slurm job states, see https://slurm.schedmd.com/job_state_codes.html
+const (
+	stateCompleted = "COMPLETED"
+)
+
+var failedStates = map[string]bool{
+	"BOOT_FAIL":     true,
+	"CANCELLED":     true,
+	"DEADLINE":      true,
+	"FAILED":        true,
+	"NODE_FAIL":     true,
+	"OUT_OF_MEMORY": true,
+	"PREEMPTED":     true,
+	"TIMEOUT":       true,
+}
+
+func notEligible(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", errNotEligible, fmt.Sprintf(format, args...))
+}
+
+// jobForWorkload translates the workload, with quota reserved, into the batch
+// script and the description of a Slurm job. The pods of the single PodSet of
+// the workload are run as the tasks of the job.
+func jobForWorkload(cfg *kueue.SlurmAdmissionCheckConfig, wl *kueue.Workload) (string, *jobDescription, error) {
+	if len(wl.Spec.PodSets) != 1 {
+		return "", nil, notEligible("the workload has %d PodSets, only one is supported", len(wl.Spec.PodSets))
+	}
+	podSpec := &wl.Spec.PodSets[0].Template.Spec
+	if len(podSpec.InitContainers) > 0 {
+		return "", nil, notEligible("init containers are not supported")
+	}
+	if len(podSpec.Containers) != 1 {
+		return "", nil, notEligible("the pods have %d containers, only one is supported", len(podSpec.Containers))
+	}
+	container := &podSpec.Containers[0]
+	command := append(append([]string{}, container.Command...), container.Args...)
+	if len(command) == 0 {
+		return "", nil, notEligible("the container %q does not set its command", container.Name)
+	}
+
+	environment := []string{
+		fmt.Sprintf("%s=%s", workloadNameEnv, wl.Name),
+		fmt.Sprintf("%s=%s", workloadNamespaceEnv, wl.Namespace),
+	}
+	for _, env := range container.Env {
+		if env.ValueFrom != nil {
+			return "", nil, notEligible("the environment variable %q uses valueFrom", env.Name)
+		}
+		environment = append(environment, fmt.Sprintf("%s=%s", env.Name, env.Value))
+	}
+
+	psResources := workload.NewInfo(wl).TotalRequests[0]
+	job := &jobDescription{
+		Name:                    wl.Name,
+		Comment:                 fmt.Sprintf("Kueue workload %s", workload.Key(wl)),
+		Partition:               cfg.Spec.Partition,
+		Account:                 cfg.Spec.Account,
+		CurrentWorkingDirectory: cfg.Spec.WorkingDirectory,
+		Environment:             environment,
+		Tasks:                   psResources.Count,
+		CPUsPerTask:             1,
+	}
+	if job.CurrentWorkingDirectory == "" {
+		job.CurrentWorkingDirectory = "/tmp"
+	}
+	var memory int64
+	for name, value := range psResources.SinglePodRequests() {
+		switch name {
+		case corev1.ResourceCPU:
+			// The requests of CPU are in milli CPUs.
+			job.CPUsPerTask = max(1, int32((value+999)/1000))
+		case corev1.ResourceMemory:
+			memory = value
+		case gpuResource:
+			job.TRESPerTask = fmt.Sprintf("gres/gpu=%d", value)
+		case corev1.ResourceEphemeralStorage, corev1.ResourcePods:
+		default:
+			return "", nil, notEligible("the resource %q is not supported", name)
+		}
+	}
+	if memory > 0 {
+		perCPU := int64(job.CPUsPerTask) * mebibyte
+		job.MemoryPerCPU = setNumber(uint64((memory + perCPU - 1) / perCPU))
+	}
+	if seconds := ptr.Deref(wl.Spec.MaximumExecutionTimeSeconds, 0); seconds > 0 {
+		job.TimeLimit = setNumber(uint64((seconds + 59) / 60))
+	}
+	return batchScript(command), job, nil
+}
+
+// batchScript returns a batch script running the command as the tasks of the job.
+func batchScript(command []string) string {
+	quoted := make([]string, 0, len(command))
+	for _, arg := range command {
+		quoted = append(quoted, "'"+strings.ReplaceAll(arg, "'", `'\''`)+"'")
+	}
+	return "#!/bin/bash\nexec srun " + strings.Join(quoted, " ") + "\n"
+}
@@ -0,0 +1,211 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadgroup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	// defaultAssemblyTimeout is how long the members of a workload group
+	// hold their quota reservation while waiting for the rest of the group.
+	defaultAssemblyTimeout = 5 * time.Minute
+)
+
+var (
+	realClock = clock.RealClock{}
+)
+
+type Option func(*Controller)
+
+// WithAssemblyTimeout sets how long the members of a workload group hold
+// their quota reservation while waiting for the rest of the group.
+func WithAssemblyTimeout(d time.Duration) Option {
+	return func(c *Controller) {
+		c.assemblyTimeout = d
+	}
+}
+
+// WithClock sets the clock used by the controller.
+func WithClock(c clock.Clock) Option {
+	return func(ctrl *Controller) {
+		ctrl.clock = c
+	}
+}
+
+// Controller keeps the workload group admission checks of the workloads
+// Pending until enough members of their group have quota reserved, so the
+// members are admitted together. Once admitted, if a member loses its quota
+// reservation, the admission checks of the other members are set to Retry,
+// so the members are evicted together.
+type Controller struct {
+	client          client.Client
+	clock           clock.Clock
+	assemblyTimeout time.Duration
+}
+
+var _ reconcile.Reconciler = (*Controller)(nil)
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=admissionchecks/status,verbs=get;update;patch
+
+func NewController(client client.Client, opts ...Option) *Controller {
+	c := &Controller{
+		client:          client,
+		clock:           realClock,
+		assemblyTimeout: defaultAssemblyTimeout,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	wl := &kueue.Workload{}
+	if err := c.client.Get(ctx, req.NamespacedName, wl); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	group := wl.Annotations[constants.WorkloadGroupAnnotation]
+	if group == "" {
+		return reconcile.Result{}, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx).WithValues("workloadGroup", group)
+	log.V(2).Info("Reconcile workload group")
+
+	members := &kueue.WorkloadList{}
+	if err := c.client.List(ctx, members, client.InNamespace(wl.Namespace), client.MatchingFields{WorkloadGroupKey: group}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	reserved := 0
+	for i := range members.Items {
+		if isCountedMember(&members.Items[i]) {
+			reserved++
+		}
+	}
+
+	var requeueAfter time.Duration
+	for i := range members.Items {
+		member := &members.Items[i]
+		if !workload.HasQuotaReservation(member) || workload.IsFinished(member) || workload.IsEvicted(member) {
+			continue
+		}
+		checks, err := admissioncheck.FilterForController(ctx, c.client, member.Status.AdmissionChecks, kueue.WorkloadGroupControllerName)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if len(checks) == 0 {
+			continue
+		}
+		wlPatch := workload.BaseSSAWorkload(member, true)
+		updated := false
+		for _, checkName := range checks {
+			current := admissioncheck.FindAdmissionCheck(member.Status.AdmissionChecks, checkName)
+			newState := c.checkState(member, current, reserved)
+			if newState.State == kueue.CheckStatePending && !newState.LastTransitionTime.IsZero() {
+				if after := c.assemblyTimeout - c.clock.Since(newState.LastTransitionTime.Time); requeueAfter == 0 || after < requeueAfter {
+					requeueAfter = after
+				}
+			}
+			if newState.State == current.State && newState.Message == current.Message {
+				continue
+			}
+			workload.SetAdmissionCheckState(&wlPatch.Status.AdmissionChecks, newState, c.clock)
+			updated = true
+		}
+		if !updated {
+			continue
+		}
+		if err := c.client.Status().Patch(ctx, wlPatch, client.Apply, client.FieldOwner(kueue.WorkloadGroupControllerName), client.ForceOwnership); err != nil {
+			return reconcile.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// checkState computes the state of the workload group admission check of
+// the member, given the number of members of the group with quota reserved.
+func (c *Controller) checkState(member *kueue.Workload, current *kueue.AdmissionCheckState, reserved int) kueue.AdmissionCheckState {
+	newState := kueue.AdmissionCheckState{
+		Name:               current.Name,
+		State:              current.State,
+		LastTransitionTime: current.LastTransitionTime,
+		PodSetUpdates:      current.PodSetUpdates,
+	}
+	minCount, err := strconv.Atoi(member.Annotations[constants.WorkloadGroupMinCountAnnotation])
+	if err != nil || minCount < 1 {
+		newState.State = kueue.CheckStateRejected
+		newState.Message = fmt.Sprintf("The %s annotation must be a positive integer", constants.WorkloadGroupMinCountAnnotation)
+		return newState
+	}
+	switch {
+	case reserved >= minCount:
+		newState.State = kueue.CheckStateReady
+		newState.Message = fmt.Sprintf("The workload group has %d members with quota reserved", reserved)
+	case current.State == kueue.CheckStateReady:
+		newState.State = kueue.CheckStateRetry
+		newState.Message = fmt.Sprintf("The workload group has only %d of %d members with quota reserved", reserved, minCount)
+	case c.clock.Since(current.LastTransitionTime.Time) >= c.assemblyTimeout:
+		newState.State = kueue.CheckStateRetry
+		newState.Message = fmt.Sprintf("The workload group didn't reach %d members with quota reserved in %s", minCount, c.assemblyTimeout)
+	default:
+		newState.State = kueue.CheckStatePending
+		newState.Message = fmt.Sprintf("Waiting for the workload group to have %d members with quota reserved, currently %d", minCount, reserved)
+	}
+	if newState.State != current.State {
+		newState.LastTransitionTime = metav1.Time{}
+	}
+	return newState
+}
+
+// isCountedMember returns true if the member counts towards the minimum
+// number of members of the group.
+func isCountedMember(wl *kueue.Workload) bool {
+	return workload.IsFinished(wl) || (workload.HasQuotaReservation(wl) && !workload.IsEvicted(wl))
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named("workloadgroup_workload").
+		For(&kueue.Workload{}).
+		Complete(c)
+	if err != nil {
+		return err
+	}
+	// The workload group admission checks don't take parameters.
+	return admissioncheck.NewConfigReconciler[*kueue.AdmissionCheck](c.client, kueue.WorkloadGroupControllerName, nil).
+		SetupWithManager(mgr, "workloadgroup_admissioncheck")
+}
@@ -0,0 +1,204 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadgroup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestReconcile(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	admission := utiltesting.MakeAdmission("cq").
+		PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+			Assignment(corev1.ResourceCPU, "default", "1").
+			Obj()).
+		Obj()
+	member := func(name, minCount string, state kueue.CheckState, since time.Time) *utiltesting.WorkloadWrapper {
+		return utiltesting.MakeWorkload(name, "ns").
+			Annotation(constants.WorkloadGroupAnnotation, "group").
+			Annotation(constants.WorkloadGroupMinCountAnnotation, minCount).
+			ReserveQuota(admission).
+			AdmissionCheck(kueue.AdmissionCheckState{
+				Name:               "group-check",
+				State:              state,
+				LastTransitionTime: metav1.NewTime(since),
+			})
+	}
+
+	cases := map[string]struct {
+		workloads  []*kueue.Workload
+		wantStates map[string]kueue.AdmissionCheckState
+		wantResult reconcile.Result
+	}{
+		"group below the minimum count": {
+			workloads: []*kueue.Workload{
+				member("driver", "3", kueue.CheckStatePending, now).Obj(),
+				member("worker", "3", kueue.CheckStatePending, now.Add(-time.Minute)).Obj(),
+				utiltesting.MakeWorkload("pending-worker", "ns").
+					Annotation(constants.WorkloadGroupAnnotation, "group").
+					Annotation(constants.WorkloadGroupMinCountAnnotation, "3").
+					Obj(),
+			},
+			wantStates: map[string]kueue.AdmissionCheckState{
+				"driver": {
+					Name:    "group-check",
+					State:   kueue.CheckStatePending,
+					Message: "Waiting for the workload group to have 3 members with quota reserved, currently 2",
+				},
+				"worker": {
+					Name:    "group-check",
+					State:   kueue.CheckStatePending,
+					Message: "Waiting for the workload group to have 3 members with quota reserved, currently 2",
+				},
+			},
+			wantResult: reconcile.Result{RequeueAfter: 4 * time.Minute},
+		},
+		"group reaches the minimum count": {
+			workloads: []*kueue.Workload{
+				member("driver", "2", kueue.CheckStatePending, now).Obj(),
+				member("worker", "2", kueue.CheckStatePending, now).Obj(),
+			},
+			wantStates: map[string]kueue.AdmissionCheckState{
+				"driver": {
+					Name:    "group-check",
+					State:   kueue.CheckStateReady,
+					Message: "The workload group has 2 members with quota reserved",
+				},
+				"worker": {
+					Name:    "group-check",
+					State:   kueue.CheckStateReady,
+					Message: "The workload group has 2 members with quota reserved",
+				},
+			},
+		},
+		"finished members are counted": {
+			workloads: []*kueue.Workload{
+				member("driver", "2", kueue.CheckStatePending, now).Obj(),
+				member("worker", "2", kueue.CheckStateReady, now).Finished().Obj(),
+			},
+			wantStates: map[string]kueue.AdmissionCheckState{
+				"driver": {
+					Name:    "group-check",
+					State:   kueue.CheckStateReady,
+					Message: "The workload group has 2 members with quota reserved",
+				},
+				"worker": {
+					Name:  "group-check",
+					State: kueue.CheckStateReady,
+				},
+			},
+		},
+		"evicted member evicts the rest of the group": {
+			workloads: []*kueue.Workload{
+				member("driver", "2", kueue.CheckStateReady, now).Obj(),
+				member("worker", "2", kueue.CheckStateReady, now).Evicted().Obj(),
+			},
+			wantStates: map[string]kueue.AdmissionCheckState{
+				"driver": {
+					Name:    "group-check",
+					State:   kueue.CheckStateRetry,
+					Message: "The workload group has only 1 of 2 members with quota reserved",
+				},
+				"worker": {
+					Name:  "group-check",
+					State: kueue.CheckStateReady,
+				},
+			},
+		},
+		"group doesn't assemble in time": {
+			workloads: []*kueue.Workload{
+				member("driver", "2", kueue.CheckStatePending, now.Add(-defaultAssemblyTimeout)).Obj(),
+			},
+			wantStates: map[string]kueue.AdmissionCheckState{
+				"driver": {
+					Name:    "group-check",
+					State:   kueue.CheckStateRetry,
+					Message: "The workload group didn't reach 2 members with quota reserved in 5m0s",
+				},
+			},
+		},
+		"invalid minimum count": {
+			workloads: []*kueue.Workload{
+				member("driver", "zero", kueue.CheckStatePending, now).Obj(),
+			},
+			wantStates: map[string]kueue.AdmissionCheckState{
+				"driver": {
+					Name:    "group-check",
+					State:   kueue.CheckStateRejected,
+					Message: "The kueue.x-k8s.io/workload-group-min-count annotation must be a positive integer",
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			builder := utiltesting.NewClientBuilder()
+			if err := SetupIndexer(ctx, utiltesting.AsIndexer(builder)); err != nil {
+				t.Fatalf("Failed to setup the indexer: %v", err)
+			}
+			objs := []client.Object{
+				utiltesting.MakeAdmissionCheck("group-check").ControllerName(kueue.WorkloadGroupControllerName).Obj(),
+			}
+			for _, wl := range tc.workloads {
+				objs = append(objs, wl)
+			}
+			cl := builder.
+				WithObjects(objs...).
+				WithStatusSubresource(objs...).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+
+			controller := NewController(cl, WithClock(testingclock.NewFakeClock(now)))
+			gotResult, err := controller.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tc.workloads[0])})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, gotResult); diff != "" {
+				t.Errorf("Unexpected result (-want,+got):\n%s", diff)
+			}
+
+			gotStates := make(map[string]kueue.AdmissionCheckState)
+			for _, wl := range tc.workloads {
+				var updated kueue.Workload
+				if err := cl.Get(ctx, client.ObjectKeyFromObject(wl), &updated); err != nil {
+					t.Fatalf("Failed to get the workload: %v", err)
+				}
+				for _, state := range updated.Status.AdmissionChecks {
+					gotStates[updated.Name] = state
+				}
+			}
+			if diff := cmp.Diff(tc.wantStates, gotStates, cmpopts.IgnoreFields(kueue.AdmissionCheckState{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected admission check states (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadgroup
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+const (
+	WorkloadGroupKey = "metadata.workloadGroup"
+)
+
+func indexWorkloadGroup(obj client.Object) []string {
+	wl, isWl := obj.(*kueue.Workload)
+	if !isWl {
+		return nil
+	}
+	if group := wl.Annotations[constants.WorkloadGroupAnnotation]; group != "" {
+		return []string{group}
+	}
+	return nil
+}
+
+func SetupIndexer(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &kueue.Workload{}, WorkloadGroupKey, indexWorkloadGroup); err != nil {
+		return fmt.Errorf("setting index on workload group: %w", err)
+	}
+	return nil
+}
@@ -53,4 +53,96 @@ const (
 	// of the PodSet of the admitted Workload corresponding to the PodTemplate.
 	// The label is set when starting the Job, and removed on stopping the Job.
 	PodSetLabel = "kueue.x-k8s.io/podset"
+
+	// CheckpointRequestedAnnotation is set on a job preempted by Kueue while it is
+	// given the grace period to checkpoint. It holds the RFC3339 deadline after which
+	// the job is stopped. The annotation is removed once the job is stopped.
+	CheckpointRequestedAnnotation = "kueue.x-k8s.io/checkpoint-requested"
+
+	// CheckpointReadyAnnotation is set on a job asked to checkpoint, by the job
+	// itself or by its integration, to acknowledge that its progress is saved.
+	// The job is then stopped without waiting for the end of the grace period.
+	// The annotation is removed once the job is stopped.
+	CheckpointReadyAnnotation = "kueue.x-k8s.io/checkpoint-ready"
+
+	// AdmissionTargetAnnotation holds the duration, for example "30m", within
+	// which the workload of the job is expected to reserve quota. It overrides
+	// the admissionTarget of the LocalQueue of the workload.
+	AdmissionTargetAnnotation = "kueue.x-k8s.io/admission-target"
+
+	// ReactivationCountAnnotation holds the number of times the workload was
+	// reactivated by the reactivationPolicy of its ClusterQueue after being
+	// deactivated for exceeding its requeuing limit.
+	ReactivationCountAnnotation = "kueue.x-k8s.io/reactivation-count"
+
+	// SubmitterAnnotation holds the name of the user, or of the service
+	// account, which created the job, recorded by the webhooks of Kueue and
+	// copied to the workload of the job.
+	SubmitterAnnotation = "kueue.x-k8s.io/submitter"
+
+	// BillingNamespaceAnnotation holds the namespace whose LocalQueue, named
+	// by the queue-name of the job, admits the workload of the job and is
+	// charged for its quota, instead of the LocalQueue of the namespace of the
+	// job. Only the users allowed to create workloads in that namespace can
+	// set it.
+	BillingNamespaceAnnotation = "kueue.x-k8s.io/billing-namespace"
+
+	// TraceParentAnnotation holds the W3C traceparent of the span of the
+	// workload which dispatched the workload to a worker cluster, so that the
+	// spans of the admission in the worker cluster are part of the same trace.
+	TraceParentAnnotation = "kueue.x-k8s.io/traceparent"
+
+	// ShrinkableAnnotation is set on the workload slice of an elastic job whose
+	// parallelism can be reduced, instead of the job being evicted, to make room
+	// for a preempting workload.
+	ShrinkableAnnotation = "kueue.x-k8s.io/shrinkable"
+
+	// ShrinkRequestedAnnotation is set by the scheduler on a shrinkable workload
+	// selected as a preemption target. It holds the preemption message.
+	// The annotation is removed, along with the ShrinkableAnnotation, once the
+	// job is shrunk.
+	ShrinkRequestedAnnotation = "kueue.x-k8s.io/shrink-requested"
+
+	// PodSetFlavorsAnnotation is set on the pod template of a PodSet to restrict
+	// the ResourceFlavors the PodSet can be assigned to a comma-separated list.
+	PodSetFlavorsAnnotation = "kueue.x-k8s.io/podset-flavors"
+
+	// PodSetWhenCanBorrowAnnotation is set on the pod template of a PodSet to
+	// override the whenCanBorrow flavor fungibility policy of the ClusterQueue
+	// for the PodSet. The supported values are Borrow and TryNextFlavor.
+	PodSetWhenCanBorrowAnnotation = "kueue.x-k8s.io/podset-when-can-borrow"
+
+	// PodSetWhenCanPreemptAnnotation is set on the pod template of a PodSet to
+	// override the whenCanPreempt flavor fungibility policy of the ClusterQueue
+	// for the PodSet. The supported values are Preempt and TryNextFlavor.
+	PodSetWhenCanPreemptAnnotation = "kueue.x-k8s.io/podset-when-can-preempt"
+
+	// WorkloadGroupAnnotation is the annotation key in the job and workload
+	// that holds the name of the group of workloads which are admitted and
+	// evicted together.
+	WorkloadGroupAnnotation = "kueue.x-k8s.io/workload-group"
+
+	// WorkloadGroupMinCountAnnotation is the annotation key in the job and
+	// workload that holds the minimum number of workloads in the group which
+	// need quota reserved before any of them is admitted.
+	WorkloadGroupMinCountAnnotation = "kueue.x-k8s.io/workload-group-min-count"
+
+	// PriorityChangeAnnotation is set on a workload whose priority was changed
+	// directly on the workload. It holds the JSON record of the last change:
+	// the user who changed it, the time and the previous priority.
+	PriorityChangeAnnotation = "kueue.x-k8s.io/priority-change"
+
+	// PodTemplatesAnnotation is set on a workload whose large pod templates
+	// were compacted. It holds the JSON map of the names of the podSets to the
+	// names of the ConfigMaps storing their full pod templates.
+	PodTemplatesAnnotation = "kueue.x-k8s.io/pod-templates"
+
+	// LocalQueueMovesAnnotation is set on a stopped ClusterQueue whose
+	// LocalQueues are being moved to its successor. It holds the JSON list of
+	// the LocalQueues to recreate, pointing to the successor.
+	LocalQueueMovesAnnotation = "kueue.x-k8s.io/local-queue-moves"
+
+	// PodTemplateConfigMapKey is the key of the full pod template in the data
+	// of the ConfigMaps referenced by the PodTemplatesAnnotation.
+	PodTemplateConfigMapKey = "template"
 )
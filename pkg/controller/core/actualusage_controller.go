@@ -0,0 +1,275 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
+	utilresource "sigs.k8s.io/kueue/pkg/util/resource"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// PodUsageProvider reports the current resource usage of the pods. It returns
+// no usage for the pods which usage is not known yet.
+type PodUsageProvider interface {
+	PodUsage(ctx context.Context, pod *corev1.Pod) (corev1.ResourceList, error)
+}
+
+// +kubebuilder:rbac:groups=metrics.k8s.io,resources=pods,verbs=get
+
+// MetricsServerPodUsageProvider reads the resource usage of the pods from the
+// PodMetrics served by the metrics-server.
+type MetricsServerPodUsageProvider struct {
+	client client.Reader
+}
+
+var _ PodUsageProvider = (*MetricsServerPodUsageProvider)(nil)
+
+func NewMetricsServerPodUsageProvider(client client.Reader) *MetricsServerPodUsageProvider {
+	return &MetricsServerPodUsageProvider{client: client}
+}
+
+// PodUsage returns the sum of the usage of the containers of the pod, or no
+// usage if the metrics of the pod are not available.
+func (p *MetricsServerPodUsageProvider) PodUsage(ctx context.Context, pod *corev1.Pod) (corev1.ResourceList, error) {
+	podMetrics := &metricsv1beta1.PodMetrics{}
+	if err := p.client.Get(ctx, client.ObjectKeyFromObject(pod), podMetrics); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var usage corev1.ResourceList
+	for _, c := range podMetrics.Containers {
+		usage = utilresource.MergeResourceListKeepSum(usage, c.Usage)
+	}
+	return usage, nil
+}
+
+// ActualUsageReconciler samples the resource usage of the pods of the
+// admitted Workloads, and records the peak and average usage of each podSet
+// in the status of the Workloads when they finish.
+// The samples are kept in memory, so a restart of the manager loses the
+// samples of the running Workloads.
+type ActualUsageReconciler struct {
+	client           client.Client
+	provider         PodUsageProvider
+	clock            clock.Clock
+	samplingInterval time.Duration
+
+	lock    sync.Mutex
+	samples map[types.NamespacedName]*workloadUsageSamples
+}
+
+type workloadUsageSamples struct {
+	uid        types.UID
+	lastSample time.Time
+	podSets    map[kueue.PodSetReference]*podSetUsageSamples
+}
+
+type podSetUsageSamples struct {
+	peak    corev1.ResourceList
+	sum     corev1.ResourceList
+	samples int32
+}
+
+var _ reconcile.Reconciler = (*ActualUsageReconciler)(nil)
+
+func NewActualUsageReconciler(client client.Client, provider PodUsageProvider, cfg *config.ActualUsage) *ActualUsageReconciler {
+	return &ActualUsageReconciler{
+		client:           client,
+		provider:         provider,
+		clock:            clock.RealClock{},
+		samplingInterval: cfg.SamplingInterval.Duration,
+		samples:          make(map[types.NamespacedName]*workloadUsageSamples),
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+
+func (r *ActualUsageReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	wl := &kueue.Workload{}
+	if err := r.client.Get(ctx, req.NamespacedName, wl); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.forget(req.NamespacedName)
+		}
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile Workload actual usage")
+
+	if workload.IsFinished(wl) {
+		samples := r.lookup(req.NamespacedName, wl.UID)
+		if samples == nil || len(wl.Status.ActualUsage) > 0 {
+			r.forget(req.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		log.V(3).Info("Recording the actual usage of the finished workload")
+		err := workload.PatchAdmissionStatus(ctx, r.client, wl, r.clock, func() (*kueue.Workload, bool, error) {
+			r.lock.Lock()
+			defer r.lock.Unlock()
+			wl.Status.ActualUsage = samples.actualUsage()
+			return wl, len(wl.Status.ActualUsage) > 0, nil
+		})
+		if err != nil {
+			return reconcile.Result{}, client.IgnoreNotFound(err)
+		}
+		r.forget(req.NamespacedName)
+		return reconcile.Result{}, nil
+	}
+	if !workload.IsAdmitted(wl) || workload.IsEvicted(wl) {
+		return reconcile.Result{}, nil
+	}
+
+	now := r.clock.Now()
+	samples := r.samplesOf(req.NamespacedName, wl.UID)
+	if next := samples.lastSample.Add(r.samplingInterval); now.Before(next) {
+		return reconcile.Result{RequeueAfter: next.Sub(now)}, nil
+	}
+	usages, err := r.podSetUsages(ctx, wl)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	r.lock.Lock()
+	samples.add(usages)
+	samples.lastSample = now
+	r.lock.Unlock()
+	return reconcile.Result{RequeueAfter: r.samplingInterval}, nil
+}
+
+// podSetUsages returns the current total resource usage of the running pods
+// of each podSet of the workload, for the podSets with a known usage.
+func (r *ActualUsageReconciler) podSetUsages(ctx context.Context, wl *kueue.Workload) (map[kueue.PodSetReference]corev1.ResourceList, error) {
+	pods := &corev1.PodList{}
+	if err := r.client.List(ctx, pods, client.InNamespace(wl.Namespace), client.MatchingFields{indexer.PodWorkloadKey: wl.Name}); err != nil {
+		return nil, fmt.Errorf("failed to list the pods of the workload: %w", err)
+	}
+	usages := make(map[kueue.PodSetReference]corev1.ResourceList)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if utilpod.IsTerminated(pod) || pod.Spec.NodeName == "" {
+			continue
+		}
+		usage, err := r.provider.PodUsage(ctx, pod)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the usage of the pod %s: %w", pod.Name, err)
+		}
+		if len(usage) == 0 {
+			continue
+		}
+		psName := kueue.PodSetReference(pod.Labels[controllerconsts.PodSetLabel])
+		usages[psName] = utilresource.MergeResourceListKeepSum(usages[psName], usage)
+	}
+	return usages, nil
+}
+
+func (r *ActualUsageReconciler) samplesOf(key types.NamespacedName, uid types.UID) *workloadUsageSamples {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	samples, found := r.samples[key]
+	if !found || samples.uid != uid {
+		samples = &workloadUsageSamples{uid: uid, podSets: make(map[kueue.PodSetReference]*podSetUsageSamples)}
+		r.samples[key] = samples
+	}
+	return samples
+}
+
+// lookup returns the samples of the workload, if there are any.
+func (r *ActualUsageReconciler) lookup(key types.NamespacedName, uid types.UID) *workloadUsageSamples {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if samples, found := r.samples[key]; found && samples.uid == uid {
+		return samples
+	}
+	return nil
+}
+
+func (r *ActualUsageReconciler) forget(key types.NamespacedName) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.samples, key)
+}
+
+func (s *workloadUsageSamples) add(usages map[kueue.PodSetReference]corev1.ResourceList) {
+	for psName, usage := range usages {
+		ps, found := s.podSets[psName]
+		if !found {
+			ps = &podSetUsageSamples{}
+			s.podSets[psName] = ps
+		}
+		ps.peak = utilresource.MergeResourceListKeepMax(ps.peak, usage)
+		ps.sum = utilresource.MergeResourceListKeepSum(ps.sum, usage)
+		ps.samples++
+	}
+}
+
+// actualUsage returns the peak and average usage of the podSets, sorted by name.
+func (s *workloadUsageSamples) actualUsage() []kueue.PodSetActualUsage {
+	var actualUsage []kueue.PodSetActualUsage
+	for psName, ps := range s.podSets {
+		average := make(corev1.ResourceList, len(ps.sum))
+		for name, q := range ps.sum {
+			average[name] = *resource.NewMilliQuantity(q.MilliValue()/int64(ps.samples), q.Format)
+		}
+		actualUsage = append(actualUsage, kueue.PodSetActualUsage{
+			Name:    psName,
+			Peak:    ps.peak,
+			Average: average,
+			Samples: ps.samples,
+		})
+	}
+	slices.SortFunc(actualUsage, func(a, b kueue.PodSetActualUsage) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return actualUsage
+}
+
+func (r *ActualUsageReconciler) SetupWithManager(mgr ctrl.Manager, cfg *config.Configuration) error {
+	return builder.ControllerManagedBy(mgr).
+		Named("actual_usage_controller").
+		For(&kueue.Workload{}).
+		WithOptions(controller.Options{
+			NeedLeaderElection:      ptr.To(false),
+			MaxConcurrentReconciles: mgr.GetControllerOptions().GroupKindConcurrency[kueue.GroupVersion.WithKind("Workload").GroupKind().String()],
+		}).
+		Complete(WithLeadingManager(mgr, r, &kueue.Workload{}, cfg))
+}
@@ -0,0 +1,151 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingpod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
+)
+
+type fakePodUsageProvider struct {
+	usages map[string]corev1.ResourceList
+}
+
+func (p *fakePodUsageProvider) PodUsage(_ context.Context, pod *corev1.Pod) (corev1.ResourceList, error) {
+	return p.usages[pod.Name], nil
+}
+
+func TestActualUsageReconciler(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.WorkloadActualUsage, true)
+	ctx, _ := utiltesting.ContextWithLog(t)
+	now := time.Now().Truncate(time.Second)
+	wlKey := types.NamespacedName{Name: "wl", Namespace: "ns"}
+
+	wl := utiltesting.MakeWorkload("wl", "ns").
+		UID("uid").
+		PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 2).Request(corev1.ResourceCPU, "1").Obj()).
+		ReserveQuota(utiltesting.MakeAdmission("cq").
+			PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "default", "2").Count(2).Obj()).
+			Obj()).
+		Admitted(true).
+		Obj()
+	pod := func(name string) client.Object {
+		return testingpod.MakePod(name, "ns").
+			Annotation(kueue.WorkloadAnnotation, "wl").
+			Label(controllerconsts.PodSetLabel, string(kueue.DefaultPodSetName)).
+			NodeName("node").
+			Obj()
+	}
+	cl := utiltesting.NewClientBuilder().
+		WithObjects(wl, pod("pod1"), pod("pod2"), testingpod.MakePod("pending", "ns").
+			Annotation(kueue.WorkloadAnnotation, "wl").
+			Label(controllerconsts.PodSetLabel, string(kueue.DefaultPodSetName)).
+			Obj()).
+		WithStatusSubresource(wl).
+		WithIndex(&corev1.Pod{}, indexer.PodWorkloadKey, indexer.IndexPodWorkload).
+		WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+		Build()
+	provider := &fakePodUsageProvider{}
+	r := NewActualUsageReconciler(cl, provider, &config.ActualUsage{SamplingInterval: &metav1.Duration{Duration: 30 * time.Second}})
+	clock := testingclock.NewFakeClock(now)
+	r.clock = clock
+
+	reconcileWorkload := func(wantResult reconcile.Result) {
+		t.Helper()
+		gotResult, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: wlKey})
+		if err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		if diff := cmp.Diff(wantResult, gotResult); diff != "" {
+			t.Errorf("Unexpected result (-want,+got):\n%s", diff)
+		}
+	}
+
+	provider.usages = map[string]corev1.ResourceList{
+		"pod1":    {corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+		"pod2":    {corev1.ResourceCPU: resource.MustParse("300m")},
+		"pending": {corev1.ResourceCPU: resource.MustParse("1")},
+	}
+	reconcileWorkload(reconcile.Result{RequeueAfter: 30 * time.Second})
+
+	clock.Step(10 * time.Second)
+	reconcileWorkload(reconcile.Result{RequeueAfter: 20 * time.Second})
+
+	clock.Step(20 * time.Second)
+	provider.usages = map[string]corev1.ResourceList{
+		"pod1": {corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("3Gi")},
+		"pod2": {corev1.ResourceCPU: resource.MustParse("600m")},
+	}
+	reconcileWorkload(reconcile.Result{RequeueAfter: 30 * time.Second})
+
+	if err := cl.Get(ctx, wlKey, wl); err != nil {
+		t.Fatalf("Failed to get the workload: %v", err)
+	}
+	wl.Status.Conditions = append(wl.Status.Conditions, metav1.Condition{
+		Type:               kueue.WorkloadFinished,
+		Status:             metav1.ConditionTrue,
+		Reason:             kueue.WorkloadFinishedReasonSucceeded,
+		LastTransitionTime: metav1.NewTime(now),
+	})
+	if err := cl.Status().Update(ctx, wl); err != nil {
+		t.Fatalf("Failed to finish the workload: %v", err)
+	}
+	reconcileWorkload(reconcile.Result{})
+
+	if err := cl.Get(ctx, wlKey, wl); err != nil {
+		t.Fatalf("Failed to get the workload: %v", err)
+	}
+	wantActualUsage := []kueue.PodSetActualUsage{
+		{
+			Name: kueue.DefaultPodSetName,
+			Peak: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1600m"),
+				corev1.ResourceMemory: resource.MustParse("3Gi"),
+			},
+			Average: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1200m"),
+				corev1.ResourceMemory: resource.MustParse("2Gi"),
+			},
+			Samples: 2,
+		},
+	}
+	if diff := cmp.Diff(wantActualUsage, wl.Status.ActualUsage, cmp.Comparer(func(a, b resource.Quantity) bool { return a.Cmp(b) == 0 })); diff != "" {
+		t.Errorf("Unexpected actual usage (-want,+got):\n%s", diff)
+	}
+	if len(r.samples) != 0 {
+		t.Errorf("Unexpected samples kept for the finished workload: %v", r.samples)
+	}
+}
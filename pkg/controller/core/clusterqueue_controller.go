@@ -18,12 +18,16 @@ package core
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"iter"
 	"slices"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -48,12 +52,25 @@ import (
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
 	"sigs.k8s.io/kueue/pkg/constants"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/metrics"
+	"sigs.k8s.io/kueue/pkg/resources"
 	"sigs.k8s.io/kueue/pkg/util/resource"
 	utilslices "sigs.k8s.io/kueue/pkg/util/slices"
 	"sigs.k8s.io/kueue/pkg/workload"
 )
 
+// localQueueMoveRetryPeriod is the period at which the pending moves of the
+// LocalQueues of a stopped ClusterQueue to its successor are retried.
+const localQueueMoveRetryPeriod = 5 * time.Second
+
+// decayedUsageRefreshesPerHalfLife is the number of times the status of a
+// ClusterQueue is refreshed per usage half-life, so that its decayed usage
+// doesn't go stale while no workload changes.
+const decayedUsageRefreshesPerHalfLife = 10
+
 type ClusterQueueUpdateWatcher interface {
 	NotifyClusterQueueUpdate(*kueue.ClusterQueue, *kueue.ClusterQueue)
 }
@@ -68,6 +85,8 @@ type ClusterQueueReconciler struct {
 	watchers              []ClusterQueueUpdateWatcher
 	reportResourceMetrics bool
 	fairSharingEnabled    bool
+	usageHalfLife         time.Duration
+	groupingLabels        []string
 	clock                 clock.Clock
 }
 
@@ -78,6 +97,8 @@ type ClusterQueueReconcilerOptions struct {
 	Watchers              []ClusterQueueUpdateWatcher
 	ReportResourceMetrics bool
 	FairSharingEnabled    bool
+	UsageHalfLife         time.Duration
+	GroupingLabels        []string
 	clock                 clock.Clock
 }
 
@@ -102,6 +123,22 @@ func WithFairSharing(enabled bool) ClusterQueueReconcilerOption {
 	}
 }
 
+// WithFairSharingUsageHalfLife sets the half-life of the usage considered for
+// Fair Sharing, at a fraction of which the decayed usage status is refreshed.
+func WithFairSharingUsageHalfLife(halfLife time.Duration) ClusterQueueReconcilerOption {
+	return func(o *ClusterQueueReconcilerOptions) {
+		o.UsageHalfLife = halfLife
+	}
+}
+
+// WithWorkloadGroupingLabels sets the label keys of the workloads by which the
+// resource usage of the ClusterQueues is reported.
+func WithWorkloadGroupingLabels(keys []string) ClusterQueueReconcilerOption {
+	return func(o *ClusterQueueReconcilerOptions) {
+		o.GroupingLabels = keys
+	}
+}
+
 var defaultCQOptions = ClusterQueueReconcilerOptions{
 	clock: realClock,
 }
@@ -125,6 +162,8 @@ func NewClusterQueueReconciler(
 		watchers:              options.Watchers,
 		reportResourceMetrics: options.ReportResourceMetrics,
 		fairSharingEnabled:    options.FairSharingEnabled,
+		usageHalfLife:         options.UsageHalfLife,
+		groupingLabels:        options.GroupingLabels,
 		clock:                 options.clock,
 	}
 }
@@ -172,12 +211,182 @@ func (r *ClusterQueueReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		}
 	}
 
+	var result ctrl.Result
+	if features.Enabled(features.ClusterQueueWorkloadMigration) && cqObj.DeletionTimestamp.IsZero() {
+		pending, err := r.moveLocalQueuesToSuccessor(ctx, &cqObj)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if pending {
+			result.RequeueAfter = localQueueMoveRetryPeriod
+		}
+	}
+
 	newCQObj := cqObj.DeepCopy()
 	cqCondition, reason, msg := r.cache.ClusterQueueReadiness(kueue.ClusterQueueReference(newCQObj.Name))
 	if err := r.updateCqStatusIfChanged(ctx, newCQObj, cqCondition, reason, msg); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
-	return ctrl.Result{}, nil
+	if period := r.decayedUsageRefreshPeriod(); period > 0 && (result.RequeueAfter == 0 || period < result.RequeueAfter) {
+		result.RequeueAfter = period
+	}
+	return result, nil
+}
+
+// decayedUsageRefreshPeriod returns the period at which the ClusterQueue is
+// reconciled to refresh its decayed usage, or 0 if the usage doesn't decay.
+func (r *ClusterQueueReconciler) decayedUsageRefreshPeriod() time.Duration {
+	if !r.fairSharingEnabled || !features.Enabled(features.FairSharingUsageDecay) || r.usageHalfLife <= 0 {
+		return 0
+	}
+	return r.usageHalfLife / decayedUsageRefreshesPerHalfLife
+}
+
+// moveLocalQueuesToSuccessor re-targets the LocalQueues of a stopped ClusterQueue
+// to its successor, so that their pending workloads are considered for admission there.
+// As spec.clusterQueue is immutable, the LocalQueues are recreated, with the same
+// name, pointing to the successor. The LocalQueues are recorded in the
+// LocalQueueMovesAnnotation of the ClusterQueue before they are deleted, so that
+// a LocalQueue which couldn't be created again is retried instead of being lost.
+// Returns true while the successor doesn't exist or some moves are pending.
+func (r *ClusterQueueReconciler) moveLocalQueuesToSuccessor(ctx context.Context, cq *kueue.ClusterQueue) (bool, error) {
+	if cq.Spec.SuccessorClusterQueue == nil || ptr.Deref(cq.Spec.StopPolicy, kueue.None) == kueue.None {
+		return false, nil
+	}
+	successor := *cq.Spec.SuccessorClusterQueue
+	moves, err := localQueueMoves(cq)
+	if err != nil {
+		return false, err
+	}
+	var lqs kueue.LocalQueueList
+	if err := r.client.List(ctx, &lqs, client.MatchingFields{indexer.QueueClusterQueueKey: cq.Name}); err != nil {
+		return false, err
+	}
+	if len(lqs.Items) == 0 && len(moves) == 0 {
+		return false, nil
+	}
+	log := ctrl.LoggerFrom(ctx).WithValues("successorClusterQueue", successor)
+	var successorCQ kueue.ClusterQueue
+	if err := r.client.Get(ctx, types.NamespacedName{Name: string(successor)}, &successorCQ); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(2).Info("Waiting for the successor ClusterQueue to move the LocalQueues")
+			return true, nil
+		}
+		return false, fmt.Errorf("getting the successor ClusterQueue %q: %w", successor, err)
+	}
+	if !successorCQ.DeletionTimestamp.IsZero() {
+		log.V(2).Info("Waiting for the successor ClusterQueue, being deleted, to move the LocalQueues")
+		return true, nil
+	}
+
+	recorded := len(moves)
+	for i := range lqs.Items {
+		lq := &lqs.Items[i]
+		if !lq.DeletionTimestamp.IsZero() || slices.ContainsFunc(moves, func(m kueue.LocalQueue) bool { return m.Namespace == lq.Namespace && m.Name == lq.Name }) {
+			continue
+		}
+		moves = append(moves, kueue.LocalQueue{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       lq.Namespace,
+				Name:            lq.Name,
+				UID:             lq.UID,
+				Labels:          lq.Labels,
+				Annotations:     lq.Annotations,
+				OwnerReferences: lq.OwnerReferences,
+				Finalizers:      lq.Finalizers,
+			},
+			Spec:   lq.Spec,
+			Status: lq.Status,
+		})
+	}
+	if len(moves) != recorded {
+		if err := r.setLocalQueueMoves(ctx, cq, moves); err != nil {
+			return false, err
+		}
+	}
+
+	pending := make([]kueue.LocalQueue, 0, len(moves))
+	for i := range moves {
+		lq := &moves[i]
+		moved, err := r.moveLocalQueue(ctx, lq, successor)
+		if err != nil {
+			log.Error(err, "Failed to move the LocalQueue to the successor ClusterQueue", "localQueue", klog.KObj(lq), "spec", lq.Spec)
+		}
+		if !moved {
+			pending = append(pending, *lq)
+			continue
+		}
+		log.V(2).Info("Moved LocalQueue to the successor ClusterQueue", "localQueue", klog.KObj(lq))
+	}
+	if len(pending) != len(moves) {
+		if err := r.setLocalQueueMoves(ctx, cq, pending); err != nil {
+			return false, err
+		}
+	}
+	return len(pending) > 0, nil
+}
+
+// moveLocalQueue moves the recorded LocalQueue to the successor ClusterQueue: it
+// deletes the LocalQueue, then, once it is gone, creates it again pointing to
+// the successor, with its previous status.
+// Returns true once the LocalQueue was created again, or replaced by another one.
+func (r *ClusterQueueReconciler) moveLocalQueue(ctx context.Context, lq *kueue.LocalQueue, successor kueue.ClusterQueueReference) (bool, error) {
+	var current kueue.LocalQueue
+	err := r.client.Get(ctx, client.ObjectKeyFromObject(lq), &current)
+	switch {
+	case apierrors.IsNotFound(err):
+		newLq := lq.DeepCopy()
+		newLq.UID = ""
+		newLq.Spec.ClusterQueue = successor
+		if err := r.client.Create(ctx, newLq); err != nil {
+			return false, client.IgnoreAlreadyExists(err)
+		}
+		newLq.Status = lq.Status
+		if err := r.client.Status().Update(ctx, newLq); err != nil {
+			return true, fmt.Errorf("restoring the status: %w", err)
+		}
+		return true, nil
+	case err != nil:
+		return false, err
+	case current.UID != lq.UID:
+		return true, nil
+	case current.DeletionTimestamp.IsZero():
+		if err := r.client.Delete(ctx, &current, client.Preconditions{UID: &lq.UID}); err != nil && !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		// The LocalQueue is created again right away if it is already gone.
+		return r.moveLocalQueue(ctx, lq, successor)
+	default:
+		// The LocalQueue is terminating.
+		return false, nil
+	}
+}
+
+// localQueueMoves returns the LocalQueues recorded to be moved to the successor
+// of the ClusterQueue.
+func localQueueMoves(cq *kueue.ClusterQueue) ([]kueue.LocalQueue, error) {
+	value, found := cq.Annotations[controllerconsts.LocalQueueMovesAnnotation]
+	if !found {
+		return nil, nil
+	}
+	var moves []kueue.LocalQueue
+	if err := json.Unmarshal([]byte(value), &moves); err != nil {
+		return nil, fmt.Errorf("decoding the %s annotation: %w", controllerconsts.LocalQueueMovesAnnotation, err)
+	}
+	return moves, nil
+}
+
+func (r *ClusterQueueReconciler) setLocalQueueMoves(ctx context.Context, cq *kueue.ClusterQueue, moves []kueue.LocalQueue) error {
+	if len(moves) == 0 {
+		delete(cq.Annotations, controllerconsts.LocalQueueMovesAnnotation)
+	} else {
+		value, err := json.Marshal(moves)
+		if err != nil {
+			return err
+		}
+		metav1.SetMetaDataAnnotation(&cq.ObjectMeta, controllerconsts.LocalQueueMovesAnnotation, string(value))
+	}
+	return r.client.Update(ctx, cq)
 }
 
 // NotifyTopologyUpdate triggers a topology update event only on creation or deletion,
@@ -246,7 +455,8 @@ func (r *ClusterQueueReconciler) notifyWatchers(oldCQ, newCQ *kueue.ClusterQueue
 	}
 }
 
-// NotifyResourceFlavorUpdate ignores updates since they have no impact on the ClusterQueue's readiness.
+// NotifyResourceFlavorUpdate ignores updates, unless the NodesAvailable
+// condition changes, since they have no other impact on the ClusterQueue's readiness.
 func (r *ClusterQueueReconciler) NotifyResourceFlavorUpdate(oldRF, newRF *kueue.ResourceFlavor) {
 	var rfName string
 	switch {
@@ -256,6 +466,9 @@ func (r *ClusterQueueReconciler) NotifyResourceFlavorUpdate(oldRF, newRF *kueue.
 	case newRF == nil:
 		// Delete Event.
 		rfName = oldRF.Name
+	case features.Enabled(features.FlavorNodeDriftDetection) &&
+		meta.IsStatusConditionFalse(oldRF.Status.Conditions, kueue.ResourceFlavorNodesAvailable) != meta.IsStatusConditionFalse(newRF.Status.Conditions, kueue.ResourceFlavorNodesAvailable):
+		rfName = newRF.Name
 	default:
 		return
 	}
@@ -367,6 +580,7 @@ func recordResourceMetrics(cq *kueue.ClusterQueue) {
 		for ri := range fr.Resources {
 			r := &fr.Resources[ri]
 			metrics.ReportClusterQueueResourceReservations(cq.Spec.Cohort, cq.Name, string(fr.Name), string(r.Name), resource.QuantityToFloat(&r.Total))
+			metrics.ReportClusterQueueResourceBorrowed(cq.Spec.Cohort, cq.Name, string(fr.Name), string(r.Name), resource.QuantityToFloat(&r.Borrowed))
 		}
 	}
 
@@ -530,6 +744,66 @@ func (r *ClusterQueueReconciler) SetupWithManager(mgr ctrl.Manager, cfg *config.
 		Complete(WithLeadingManager(mgr, r, &kueue.ClusterQueue{}, cfg))
 }
 
+// reportUsageByLabel reports the resource usage of the ClusterQueue per value
+// of the grouping labels of its workloads, replacing the previous report so
+// that the values no longer used are dropped.
+func (r *ClusterQueueReconciler) reportUsageByLabel(cq *kueue.ClusterQueue) {
+	metrics.ClearClusterQueueResourceUsageByLabel(cq.Name)
+	for _, key := range r.groupingLabels {
+		usage, err := r.cache.UsageByLabel(kueue.ClusterQueueReference(cq.Name), key)
+		if err != nil {
+			r.log.Error(err, "Failed getting usage by label from cache", "label", key)
+			return
+		}
+		for value, frq := range usage {
+			for fr, q := range frq {
+				quantity := resources.ResourceQuantity(fr.Resource, q)
+				metrics.ReportClusterQueueResourceUsageByLabel(cq.Name, string(fr.Flavor), string(fr.Resource), key, value, resource.QuantityToFloat(&quantity))
+			}
+		}
+	}
+}
+
+// reportFlavorUtilization reports the percentage of the capacity of the flavors
+// used by the admitted workloads of the ClusterQueue and of its cohort tree,
+// replacing the previous report so that the flavors no longer used are dropped.
+func (r *ClusterQueueReconciler) reportFlavorUtilization(ctx context.Context, cq *kueue.ClusterQueue) {
+	stats, err := r.cache.FlavorUtilization(ctx, cq)
+	if err != nil {
+		r.log.Error(err, "Failed getting flavor utilization from cache")
+		return
+	}
+	metrics.ClearClusterQueueFlavorUtilization(cq.Name)
+	reportUtilization(stats.ClusterQueue, func(fr resources.FlavorResource, basis metrics.UtilizationBasis, utilization float64) {
+		metrics.ReportClusterQueueFlavorUtilization(cq.Spec.Cohort, cq.Name, string(fr.Flavor), string(fr.Resource), basis, utilization)
+	})
+	for cohort, utilization := range stats.Cohorts {
+		metrics.ClearCohortFlavorUtilization(cohort)
+		reportUtilization(utilization, func(fr resources.FlavorResource, basis metrics.UtilizationBasis, utilization float64) {
+			metrics.ReportCohortFlavorUtilization(cohort, string(fr.Flavor), string(fr.Resource), basis, utilization)
+		})
+	}
+}
+
+func reportUtilization(utilization schdcache.FlavorUtilization, report func(resources.FlavorResource, metrics.UtilizationBasis, float64)) {
+	for fr, percentage := range utilization.Nominal {
+		report(fr, metrics.UtilizationBasisNominalQuota, percentage)
+	}
+	for fr, percentage := range utilization.Physical {
+		report(fr, metrics.UtilizationBasisPhysicalCapacity, percentage)
+	}
+}
+
+// reportLentResources reports the quota of the ClusterQueue lent to its cohort,
+// replacing the previous report so that the flavors no longer lent are dropped.
+func reportLentResources(cq *kueue.ClusterQueue, lent resources.FlavorResourceQuantities) {
+	metrics.ClearClusterQueueResourceLent(cq.Name)
+	for fr, q := range lent {
+		quantity := resources.ResourceQuantity(fr.Resource, q)
+		metrics.ReportClusterQueueResourceLent(cq.Spec.Cohort, cq.Name, string(fr.Flavor), string(fr.Resource), resource.QuantityToFloat(&quantity))
+	}
+}
+
 func (r *ClusterQueueReconciler) updateCqStatusIfChanged(
 	ctx context.Context,
 	cq *kueue.ClusterQueue,
@@ -549,6 +823,12 @@ func (r *ClusterQueueReconciler) updateCqStatusIfChanged(
 		// but we didn't process that event yet.
 		return err
 	}
+	if len(r.groupingLabels) > 0 {
+		r.reportUsageByLabel(cq)
+	}
+	if r.reportResourceMetrics && features.Enabled(features.FlavorUtilizationMetrics) {
+		r.reportFlavorUtilization(ctx, cq)
+	}
 	cq.Status.FlavorsReservation = stats.ReservedResources
 	cq.Status.FlavorsUsage = stats.AdmittedResources
 	cq.Status.ReservingWorkloads = int32(stats.ReservingWorkloads)
@@ -561,6 +841,9 @@ func (r *ClusterQueueReconciler) updateCqStatusIfChanged(
 		Message:            msg,
 		ObservedGeneration: cq.Generation,
 	})
+	if r.reportResourceMetrics {
+		reportLentResources(cq, stats.LentResources)
+	}
 	if r.fairSharingEnabled {
 		if r.reportResourceMetrics {
 			metrics.ReportClusterQueueWeightedShare(cq.Name, stats.WeightedShare)
@@ -569,9 +852,28 @@ func (r *ClusterQueueReconciler) updateCqStatusIfChanged(
 			cq.Status.FairSharing = &kueue.FairSharingStatus{}
 		}
 		cq.Status.FairSharing.WeightedShare = stats.WeightedShare
+		cq.Status.FairSharing.DecayedUsage = stats.DecayedUsage
 	} else {
 		cq.Status.FairSharing = nil
 	}
+	cq.Status.FlavorsPhysicalCapacity = nil
+	if features.Enabled(features.FlavorPhysicalCapacity) {
+		physicalCapacity, err := r.cache.PhysicalCapacityStatus(ctx, cq)
+		if err != nil {
+			r.log.Error(err, "Failed getting physical capacity from cache")
+			return err
+		}
+		cq.Status.FlavorsPhysicalCapacity = physicalCapacity
+		for _, flvCapacity := range physicalCapacity {
+			for _, rCapacity := range flvCapacity.Resources {
+				metrics.ReportResourceFlavorPhysicalHeadroom(flvCapacity.Name, string(rCapacity.Name), resource.QuantityToFloat(&rCapacity.Headroom))
+			}
+		}
+	}
+	cq.Status.Problems = nil
+	if features.Enabled(features.ClusterQueueProblems) {
+		cq.Status.Problems = r.cache.ClusterQueueProblems(kueue.ClusterQueueReference(cq.Name))
+	}
 	if !equality.Semantic.DeepEqual(cq.Status, oldStatus) {
 		return r.client.Status().Update(ctx, cq)
 	}
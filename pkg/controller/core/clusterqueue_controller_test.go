@@ -17,7 +17,10 @@ limitations under the License.
 package core
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -25,10 +28,15 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/metrics"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	testingmetrics "sigs.k8s.io/kueue/pkg/util/testing/metrics"
@@ -245,6 +253,142 @@ func TestUpdateCqStatusIfChanged(t *testing.T) {
 	}
 }
 
+func TestMoveLocalQueuesToSuccessor(t *testing.T) {
+	successor := utiltesting.MakeClusterQueue("new-cq").Obj()
+	recordedCq := utiltesting.MakeClusterQueue("old-cq").StopPolicy(kueue.Hold).SuccessorClusterQueue("new-cq").Obj()
+	recordedCq.Annotations = map[string]string{
+		controllerconsts.LocalQueueMovesAnnotation: `[{"metadata":{"name":"lq","namespace":"ns","uid":"lq-uid","labels":{"team":"a"}},"spec":{"clusterQueue":"old-cq"},"status":{"pendingWorkloads":1}}]`,
+	}
+	testCases := map[string]struct {
+		cq          *kueue.ClusterQueue
+		objs        []client.Object
+		lqs         []kueue.LocalQueue
+		createErr   error
+		wantLqs     []kueue.LocalQueue
+		wantMoves   []string
+		wantPending bool
+	}{
+		"active ClusterQueue keeps its LocalQueues": {
+			cq:   utiltesting.MakeClusterQueue("old-cq").SuccessorClusterQueue("new-cq").Obj(),
+			objs: []client.Object{successor},
+			lqs: []kueue.LocalQueue{
+				*utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("old-cq").Obj(),
+			},
+			wantLqs: []kueue.LocalQueue{
+				*utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("old-cq").Obj(),
+			},
+		},
+		"stopped ClusterQueue without successor keeps its LocalQueues": {
+			cq: utiltesting.MakeClusterQueue("old-cq").StopPolicy(kueue.Hold).Obj(),
+			lqs: []kueue.LocalQueue{
+				*utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("old-cq").Obj(),
+			},
+			wantLqs: []kueue.LocalQueue{
+				*utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("old-cq").Obj(),
+			},
+		},
+		"stopped ClusterQueue keeps its LocalQueues until the successor exists": {
+			cq: utiltesting.MakeClusterQueue("old-cq").StopPolicy(kueue.Hold).SuccessorClusterQueue("new-cq").Obj(),
+			lqs: []kueue.LocalQueue{
+				*utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("old-cq").Obj(),
+			},
+			wantLqs: []kueue.LocalQueue{
+				*utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("old-cq").Obj(),
+			},
+			wantPending: true,
+		},
+		"stopped ClusterQueue moves its LocalQueues to the successor": {
+			cq:   utiltesting.MakeClusterQueue("old-cq").StopPolicy(kueue.Hold).SuccessorClusterQueue("new-cq").Obj(),
+			objs: []client.Object{successor},
+			lqs: []kueue.LocalQueue{
+				*utiltesting.MakeLocalQueue("lq1", "ns1").UID("lq1-uid").ClusterQueue("old-cq").Label("team", "a").PendingWorkloads(2).Obj(),
+				*utiltesting.MakeLocalQueue("lq2", "ns2").UID("lq2-uid").ClusterQueue("old-cq").StopPolicy(kueue.Hold).Obj(),
+				*utiltesting.MakeLocalQueue("lq3", "ns1").UID("lq3-uid").ClusterQueue("other-cq").Obj(),
+			},
+			wantLqs: []kueue.LocalQueue{
+				*utiltesting.MakeLocalQueue("lq1", "ns1").ClusterQueue("new-cq").Label("team", "a").PendingWorkloads(2).Obj(),
+				*utiltesting.MakeLocalQueue("lq2", "ns2").ClusterQueue("new-cq").StopPolicy(kueue.Hold).Obj(),
+				*utiltesting.MakeLocalQueue("lq3", "ns1").UID("lq3-uid").ClusterQueue("other-cq").Obj(),
+			},
+		},
+		"the move is kept recorded when the LocalQueue can't be created again": {
+			cq:   utiltesting.MakeClusterQueue("old-cq").StopPolicy(kueue.Hold).SuccessorClusterQueue("new-cq").Obj(),
+			objs: []client.Object{successor},
+			lqs: []kueue.LocalQueue{
+				*utiltesting.MakeLocalQueue("lq", "ns").UID("lq-uid").ClusterQueue("old-cq").Obj(),
+			},
+			createErr:   errors.New("create failed"),
+			wantMoves:   []string{"ns/lq"},
+			wantPending: true,
+		},
+		"the recorded LocalQueue is created again in the successor, with its status": {
+			cq:   recordedCq,
+			objs: []client.Object{successor},
+			wantLqs: []kueue.LocalQueue{
+				*utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("new-cq").Label("team", "a").PendingWorkloads(1).Obj(),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			builder := utiltesting.NewClientBuilder().
+				WithObjects(tc.cq).
+				WithObjects(tc.objs...).
+				WithLists(&kueue.LocalQueueList{Items: tc.lqs}).
+				WithStatusSubresource(&kueue.LocalQueue{})
+			if tc.createErr != nil {
+				builder = builder.WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+						if _, isLq := obj.(*kueue.LocalQueue); isLq {
+							return tc.createErr
+						}
+						return c.Create(ctx, obj, opts...)
+					},
+				})
+			}
+			cl := builder.Build()
+			r := &ClusterQueueReconciler{client: cl}
+			cq := &kueue.ClusterQueue{}
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(tc.cq), cq); err != nil {
+				t.Fatalf("Failed to get the ClusterQueue: %v", err)
+			}
+			pending, err := r.moveLocalQueuesToSuccessor(ctx, cq)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if pending != tc.wantPending {
+				t.Errorf("Unexpected pending moves: %v, want %v", pending, tc.wantPending)
+			}
+			var gotLqs kueue.LocalQueueList
+			if err := cl.List(ctx, &gotLqs); err != nil {
+				t.Fatalf("Failed to list LocalQueues: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantLqs, gotLqs.Items,
+				cmpopts.EquateEmpty(),
+				cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion"),
+				cmpopts.SortSlices(func(a, b kueue.LocalQueue) bool { return a.Name < b.Name })); diff != "" {
+				t.Errorf("Unexpected LocalQueues (-want,+got):\n%s", diff)
+			}
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(tc.cq), cq); err != nil {
+				t.Fatalf("Failed to get the ClusterQueue: %v", err)
+			}
+			moves, err := localQueueMoves(cq)
+			if err != nil {
+				t.Fatalf("Failed to decode the moves: %v", err)
+			}
+			gotMoves := make([]string, 0, len(moves))
+			for i := range moves {
+				gotMoves = append(gotMoves, client.ObjectKeyFromObject(&moves[i]).String())
+			}
+			if diff := cmp.Diff(tc.wantMoves, gotMoves, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected recorded moves (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 type cqMetrics struct {
 	NominalDPs   []testingmetrics.MetricDataPoint
 	BorrowingDPs []testingmetrics.MetricDataPoint
@@ -271,6 +415,62 @@ func resourceDataPoint(cohort, name, flavor, res string, v float64) testingmetri
 	}
 }
 
+func TestReconcileRequeuesForDecayedUsage(t *testing.T) {
+	cases := map[string]struct {
+		enableUsageDecay bool
+		fairSharing      bool
+		usageHalfLife    time.Duration
+		wantRequeueAfter time.Duration
+	}{
+		"usage decay enabled": {
+			enableUsageDecay: true,
+			fairSharing:      true,
+			usageHalfLife:    time.Hour,
+			wantRequeueAfter: 6 * time.Minute,
+		},
+		"feature gate disabled": {
+			fairSharing:   true,
+			usageHalfLife: time.Hour,
+		},
+		"fair sharing disabled": {
+			enableUsageDecay: true,
+			usageHalfLife:    time.Hour,
+		},
+		"half-life not set": {
+			enableUsageDecay: true,
+			fairSharing:      true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.FairSharingUsageDecay, tc.enableUsageDecay)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cq := utiltesting.MakeClusterQueue("cq").Obj()
+			cl := utiltesting.NewClientBuilder().WithObjects(cq).WithStatusSubresource(cq).Build()
+			cqCache := schdcache.New(cl)
+			qManager := qcache.NewManager(cl, cqCache)
+			if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue in cache: %v", err)
+			}
+			if err := qManager.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Inserting clusterQueue in manager: %v", err)
+			}
+			r := NewClusterQueueReconciler(cl, qManager, cqCache,
+				WithFairSharing(tc.fairSharing),
+				WithFairSharingUsageHalfLife(tc.usageHalfLife),
+			)
+
+			result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(cq)})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantRequeueAfter, result.RequeueAfter); diff != "" {
+				t.Errorf("Unexpected requeue period (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestRecordResourceMetrics(t *testing.T) {
 	baseQueue := &kueue.ClusterQueue{
 		ObjectMeta: metav1.ObjectMeta{
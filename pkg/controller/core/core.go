@@ -17,15 +17,20 @@ limitations under the License.
 package core
 
 import (
+	"context"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
 	"sigs.k8s.io/kueue/pkg/constants"
 	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/tracing"
 )
 
 const (
@@ -50,8 +55,12 @@ func SetupControllers(mgr ctrl.Manager, qManager *qcache.Manager, cc *schdcache.
 	}
 
 	var fairSharingEnabled bool
+	var usageHalfLife time.Duration
 	if cfg.FairSharing != nil {
 		fairSharingEnabled = cfg.FairSharing.Enable
+		if cfg.FairSharing.UsageHalfLifeTime != nil {
+			usageHalfLife = cfg.FairSharing.UsageHalfLifeTime.Duration
+		}
 	}
 
 	watchers := []ClusterQueueUpdateWatcher{rfRec, acRec}
@@ -69,7 +78,9 @@ func SetupControllers(mgr ctrl.Manager, qManager *qcache.Manager, cc *schdcache.
 		cc,
 		WithReportResourceMetrics(cfg.Metrics.EnableClusterQueueResources),
 		WithFairSharing(fairSharingEnabled),
+		WithFairSharingUsageHalfLife(usageHalfLife),
 		WithWatchers(watchers...),
+		WithWorkloadGroupingLabels(workloadGroupingLabels(cfg)),
 	)
 	rfRec.AddUpdateWatcher(cqRec)
 	acRec.AddUpdateWatchers(cqRec)
@@ -77,11 +88,26 @@ func SetupControllers(mgr ctrl.Manager, qManager *qcache.Manager, cc *schdcache.
 		return "ClusterQueue", err
 	}
 
+	retention, err := workloadRetention(cfg.ObjectRetentionPolicies)
+	if err != nil {
+		return "Workload", err
+	}
+	workloadWatchers := []WorkloadUpdateWatcher{qRec, cqRec}
+	if features.Enabled(features.AdmissionTracing) && cfg.Tracing != nil {
+		tracer, err := tracing.New(context.Background(), cfg.Tracing)
+		if err != nil {
+			return "Tracing", err
+		}
+		if err := mgr.Add(tracer); err != nil {
+			return "Tracing", err
+		}
+		workloadWatchers = append(workloadWatchers, tracer)
+	}
 	workloadRec := NewWorkloadReconciler(mgr.GetClient(), qManager, cc,
 		mgr.GetEventRecorderFor(constants.WorkloadControllerName),
-		WithWorkloadUpdateWatchers(qRec, cqRec),
+		WithWorkloadUpdateWatchers(workloadWatchers...),
 		WithWaitForPodsReady(waitForPodsReady(cfg.WaitForPodsReady)),
-		WithWorkloadRetention(workloadRetention(cfg.ObjectRetentionPolicies)),
+		WithWorkloadRetention(retention),
 	)
 	if features.Enabled(features.DynamicResourceAllocation) {
 		qManager.SetDRAReconcileChannel(workloadRec.GetDRAReconcileChannel())
@@ -90,11 +116,58 @@ func SetupControllers(mgr ctrl.Manager, qManager *qcache.Manager, cc *schdcache.
 	if err := workloadRec.SetupWithManager(mgr, cfg); err != nil {
 		return "Workload", err
 	}
+	if features.Enabled(features.AdvanceReservations) {
+		reservationRec := NewReservationReconciler(mgr.GetClient(), qManager, cc)
+		if err := reservationRec.SetupWithManager(mgr, cfg); err != nil {
+			return "Reservation", err
+		}
+	}
+	if features.Enabled(features.ResourceFlavorDiscovery) && cfg.ResourceFlavorDiscovery != nil {
+		if err := NewResourceFlavorDiscoveryReconciler(mgr.GetClient(), cfg.ResourceFlavorDiscovery).SetupWithManager(mgr); err != nil {
+			return "ResourceFlavorDiscovery", err
+		}
+	}
+	if features.Enabled(features.FlavorNodeDriftDetection) {
+		if err := NewResourceFlavorNodesReconciler(mgr.GetClient()).SetupWithManager(mgr, cfg); err != nil {
+			return "ResourceFlavorNodes", err
+		}
+	}
+	if features.Enabled(features.FlavorCostOrdering) && cfg.FlavorCosts != nil {
+		provider := NewConfigMapFlavorCostProvider(mgr.GetAPIReader(), *cfg.Namespace, cfg.FlavorCosts.ConfigMapName)
+		if err := mgr.Add(NewFlavorCostUpdater(provider, cc, cfg.FlavorCosts.UpdatePeriod.Duration)); err != nil {
+			return "FlavorCostUpdater", err
+		}
+	}
+	// The flavors of the admission checks which timed out are excluded like
+	// the ones of the flavor failover, and their exclusions expire the same way.
+	if (features.Enabled(features.FlavorFailover) && cfg.FlavorFailover != nil) || features.Enabled(features.AdmissionCheckTimeouts) {
+		var flavorFailoverCfg *configapi.FlavorFailover
+		if features.Enabled(features.FlavorFailover) {
+			flavorFailoverCfg = cfg.FlavorFailover
+		}
+		flavorFailoverRec := NewFlavorFailoverReconciler(mgr.GetClient(), mgr.GetEventRecorderFor(constants.WorkloadControllerName), flavorFailoverCfg)
+		if err := flavorFailoverRec.SetupWithManager(mgr, cfg); err != nil {
+			return "FlavorFailover", err
+		}
+	}
+	if features.Enabled(features.WorkloadActualUsage) && cfg.ActualUsage != nil {
+		provider := NewMetricsServerPodUsageProvider(mgr.GetAPIReader())
+		if err := NewActualUsageReconciler(mgr.GetClient(), provider, cfg.ActualUsage).SetupWithManager(mgr, cfg); err != nil {
+			return "ActualUsage", err
+		}
+	}
 	qManager.AddTopologyUpdateWatcher(cqRec)
 	qManager.AddWorkloadUpdateWatcher(qRec)
 	return "", nil
 }
 
+func workloadGroupingLabels(cfg *configapi.Configuration) []string {
+	if !features.Enabled(features.WorkloadGroupingMetrics) {
+		return nil
+	}
+	return cfg.Metrics.WorkloadGroupingLabels
+}
+
 func waitForPodsReady(cfg *configapi.WaitForPodsReady) *waitForPodsReadyConfig {
 	if cfg == nil || !cfg.Enable {
 		return nil
@@ -114,12 +187,40 @@ func waitForPodsReady(cfg *configapi.WaitForPodsReady) *waitForPodsReadyConfig {
 	return &result
 }
 
-func workloadRetention(cfg *configapi.ObjectRetentionPolicies) *workloadRetentionConfig {
-	if cfg == nil || cfg.Workloads == nil || cfg.Workloads.AfterFinished == nil {
-		return nil
+func workloadRetention(cfg *configapi.ObjectRetentionPolicies) (*workloadRetentionConfig, error) {
+	if cfg == nil || cfg.Workloads == nil || (cfg.Workloads.AfterFinished == nil && len(cfg.Workloads.AfterFinishedOverrides) == 0) {
+		return nil, nil
 	}
 
-	return &workloadRetentionConfig{
-		afterFinished: &cfg.Workloads.AfterFinished.Duration,
+	result := &workloadRetentionConfig{}
+	if cfg.Workloads.AfterFinished != nil {
+		result.afterFinished = &cfg.Workloads.AfterFinished.Duration
+	}
+	for _, o := range cfg.Workloads.AfterFinishedOverrides {
+		override := workloadRetentionOverride{
+			clusterQueues: sets.New[kueue.ClusterQueueReference](),
+		}
+		if o.NamespaceSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(o.NamespaceSelector)
+			if err != nil {
+				return nil, err
+			}
+			override.namespaceSelector = selector
+		}
+		for _, cq := range o.ClusterQueues {
+			override.clusterQueues.Insert(kueue.ClusterQueueReference(cq))
+		}
+		if o.AfterFinished != nil {
+			override.afterFinished = &o.AfterFinished.Duration
+		}
+		result.overrides = append(result.overrides, override)
+	}
+	if cfg.Workloads.Archive != nil {
+		archiver, err := newHTTPWorkloadArchiver(cfg.Workloads.Archive)
+		if err != nil {
+			return nil, err
+		}
+		result.archiver = archiver
 	}
+	return result, nil
 }
@@ -0,0 +1,125 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+)
+
+// FlavorCostProvider reports the current costs of the ResourceFlavors, for
+// example the spot prices of the node pools. The flavors missing from the
+// returned costs are considered to have an unknown cost.
+type FlavorCostProvider interface {
+	FlavorCosts(ctx context.Context) (map[kueue.ResourceFlavorReference]resource.Quantity, error)
+}
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get
+
+// ConfigMapFlavorCostProvider reads the costs of the ResourceFlavors from the
+// data of a ConfigMap, in which the keys are the names of the flavors and the
+// values are their costs as quantities.
+type ConfigMapFlavorCostProvider struct {
+	client client.Reader
+	key    types.NamespacedName
+}
+
+var _ FlavorCostProvider = (*ConfigMapFlavorCostProvider)(nil)
+
+func NewConfigMapFlavorCostProvider(client client.Reader, namespace, name string) *ConfigMapFlavorCostProvider {
+	return &ConfigMapFlavorCostProvider{
+		client: client,
+		key:    types.NamespacedName{Namespace: namespace, Name: name},
+	}
+}
+
+// FlavorCosts returns the costs of the flavors held by the ConfigMap, or no
+// costs if the ConfigMap doesn't exist.
+func (p *ConfigMapFlavorCostProvider) FlavorCosts(ctx context.Context) (map[kueue.ResourceFlavorReference]resource.Quantity, error) {
+	cm := &corev1.ConfigMap{}
+	if err := p.client.Get(ctx, p.key, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	costs := make(map[kueue.ResourceFlavorReference]resource.Quantity, len(cm.Data))
+	for flavor, value := range cm.Data {
+		cost, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cost %q of the flavor %q in the ConfigMap %s: %w", value, flavor, p.key, err)
+		}
+		costs[kueue.ResourceFlavorReference(flavor)] = cost
+	}
+	return costs, nil
+}
+
+// FlavorCostUpdater periodically updates the costs of the ResourceFlavors in
+// the cache with the costs reported by a FlavorCostProvider.
+type FlavorCostUpdater struct {
+	provider FlavorCostProvider
+	cache    *schdcache.Cache
+	period   time.Duration
+}
+
+func NewFlavorCostUpdater(provider FlavorCostProvider, cache *schdcache.Cache, period time.Duration) *FlavorCostUpdater {
+	return &FlavorCostUpdater{
+		provider: provider,
+		cache:    cache,
+		period:   period,
+	}
+}
+
+// Start implements the Runnable interface to run the FlavorCostUpdater.
+func (u *FlavorCostUpdater) Start(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx).WithName("flavorCostUpdater")
+	ctx = ctrl.LoggerInto(ctx, log)
+	wait.UntilWithContext(ctx, u.update, u.period)
+	return nil
+}
+
+// NeedLeaderElection implements the LeaderElectionRunnable interface. The
+// costs are updated in the cache of all the replicas, so that they are up to
+// date when a replica becomes the leader.
+func (u *FlavorCostUpdater) NeedLeaderElection() bool {
+	return false
+}
+
+func (u *FlavorCostUpdater) update(ctx context.Context) {
+	log := ctrl.LoggerFrom(ctx)
+	costs, err := u.provider.FlavorCosts(ctx)
+	if err != nil {
+		// Keep the last known costs until the provider recovers.
+		log.Error(err, "Unable to get the costs of the flavors")
+		return
+	}
+	if u.cache.SetFlavorCosts(costs) {
+		log.V(3).Info("Updated the costs of the flavors", "costs", costs)
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestConfigMapFlavorCostProvider(t *testing.T) {
+	costsConfigMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "kueue-system", Name: "flavor-costs"},
+			Data:       data,
+		}
+	}
+	cases := map[string]struct {
+		configMap *corev1.ConfigMap
+		wantCosts map[kueue.ResourceFlavorReference]resource.Quantity
+		wantErr   bool
+	}{
+		"reads the costs of the flavors": {
+			configMap: costsConfigMap(map[string]string{"spot": "0.8", "on-demand": "2500m"}),
+			wantCosts: map[kueue.ResourceFlavorReference]resource.Quantity{
+				"spot":      resource.MustParse("0.8"),
+				"on-demand": resource.MustParse("2500m"),
+			},
+		},
+		"no costs without the ConfigMap": {},
+		"invalid cost": {
+			configMap: costsConfigMap(map[string]string{"spot": "cheap"}),
+			wantErr:   true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			builder := utiltesting.NewClientBuilder()
+			if tc.configMap != nil {
+				builder = builder.WithObjects(tc.configMap)
+			}
+			provider := NewConfigMapFlavorCostProvider(builder.Build(), "kueue-system", "flavor-costs")
+			gotCosts, err := provider.FlavorCosts(ctx)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("Unexpected error, want error %v, got %v", tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.wantCosts, gotCosts); diff != "" {
+				t.Errorf("Unexpected costs (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlavorCostUpdater(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.FlavorCostOrdering, true)
+	ctx, _ := utiltesting.ContextWithLog(t)
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kueue-system", Name: "flavor-costs"},
+		Data:       map[string]string{"spot": "0.8"},
+	}
+	cl := utiltesting.NewClientBuilder().WithObjects(configMap).Build()
+	cache := schdcache.New(cl)
+	if err := cache.AddClusterQueue(ctx, utiltesting.MakeClusterQueue("cq").Obj()); err != nil {
+		t.Fatalf("Failed to add the ClusterQueue: %v", err)
+	}
+	updater := NewFlavorCostUpdater(NewConfigMapFlavorCostProvider(cl, "kueue-system", "flavor-costs"), cache, 0)
+
+	wantCosts := func(want map[kueue.ResourceFlavorReference]resource.Quantity) {
+		t.Helper()
+		snapshot, err := cache.Snapshot(ctx)
+		if err != nil {
+			t.Fatalf("Failed to build the snapshot: %v", err)
+		}
+		if diff := cmp.Diff(want, snapshot.ClusterQueue("cq").FlavorCosts); diff != "" {
+			t.Errorf("Unexpected costs (-want,+got):\n%s", diff)
+		}
+	}
+
+	updater.update(ctx)
+	wantCosts(map[kueue.ResourceFlavorReference]resource.Quantity{"spot": resource.MustParse("0.8")})
+
+	// The last known costs are kept when the provider fails.
+	configMap.Data["spot"] = "unknown"
+	if err := cl.Update(ctx, configMap); err != nil {
+		t.Fatalf("Failed to update the ConfigMap: %v", err)
+	}
+	updater.update(ctx)
+	wantCosts(map[kueue.ResourceFlavorReference]resource.Quantity{"spot": resource.MustParse("0.8")})
+
+	configMap.Data["spot"] = "3"
+	if err := cl.Update(ctx, configMap); err != nil {
+		t.Fatalf("Failed to update the ConfigMap: %v", err)
+	}
+	updater.update(ctx)
+	wantCosts(map[kueue.ResourceFlavorReference]resource.Quantity{"spot": resource.MustParse("3")})
+
+	if err := cl.Delete(ctx, configMap); client.IgnoreNotFound(err) != nil {
+		t.Fatalf("Failed to delete the ConfigMap: %v", err)
+	}
+	updater.update(ctx)
+	wantCosts(nil)
+}
@@ -0,0 +1,207 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const podsUnschedulableEvictionMessageFormat = "Workload eviction triggered because its pods were unschedulable on the flavors %s for more than %s"
+
+// FlavorFailoverReconciler evicts the admitted Workloads whose pods stay
+// unschedulable on the assigned ResourceFlavors for longer than the timeout,
+// and excludes these flavors for the Workloads, so that they are admitted
+// with other flavors. The exclusions are removed once they expire, including
+// the ones recorded for the admission checks which timed out. Without cfg,
+// only the expired exclusions are removed.
+type FlavorFailoverReconciler struct {
+	client   client.Client
+	recorder record.EventRecorder
+	clock    clock.Clock
+	cfg      *config.FlavorFailover
+}
+
+var _ reconcile.Reconciler = (*FlavorFailoverReconciler)(nil)
+
+func NewFlavorFailoverReconciler(client client.Client, recorder record.EventRecorder, cfg *config.FlavorFailover) *FlavorFailoverReconciler {
+	return &FlavorFailoverReconciler{
+		client:   client,
+		recorder: recorder,
+		clock:    clock.RealClock{},
+		cfg:      cfg,
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+
+func (r *FlavorFailoverReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	wl := &kueue.Workload{}
+	if err := r.client.Get(ctx, req.NamespacedName, wl); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile Workload flavor failover")
+	now := r.clock.Now()
+
+	expired, expiresAfter := expiredFlavorExclusions(wl, now)
+	if len(expired) > 0 {
+		log.V(3).Info("Removing the expired flavor exclusions", "flavors", expired)
+		err := workload.PatchAdmissionStatus(ctx, r.client, wl, r.clock, func() (*kueue.Workload, bool, error) {
+			wl.Status.ExcludedFlavors = slices.DeleteFunc(wl.Status.ExcludedFlavors, func(ef kueue.ExcludedFlavor) bool {
+				return slices.Contains(expired, ef.Name)
+			})
+			return wl, true, nil
+		})
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if r.cfg == nil || !workload.IsAdmitted(wl) || workload.IsEvicted(wl) || workload.IsFinished(wl) {
+		return reconcile.Result{RequeueAfter: expiresAfter}, nil
+	}
+	flavors, requeueAfter, err := r.unschedulableFlavors(ctx, wl, now)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(flavors) == 0 {
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	log.V(3).Info("Evicting the workload with unschedulable pods", "flavors", flavors)
+	expirationTime := metav1.NewTime(now.Add(r.cfg.ExclusionDuration.Duration))
+	workload.ExcludeFlavors(wl, flavors, expirationTime)
+	names := make([]string, len(flavors))
+	for i, flavor := range flavors {
+		names[i] = string(flavor)
+	}
+	msg := fmt.Sprintf(podsUnschedulableEvictionMessageFormat, strings.Join(names, ", "), r.cfg.UnschedulableTimeout.Duration)
+	err = workload.Evict(ctx, r.client, r.recorder, wl, kueue.WorkloadEvictedDueToPodsUnschedulable, msg, "", r.clock)
+	return reconcile.Result{}, client.IgnoreNotFound(err)
+}
+
+// unschedulableFlavors returns the flavors assigned to the podSets with pods
+// unschedulable for longer than the timeout. If there are none, it returns
+// the time until the timeout of the first unschedulable pod.
+func (r *FlavorFailoverReconciler) unschedulableFlavors(ctx context.Context, wl *kueue.Workload, now time.Time) ([]kueue.ResourceFlavorReference, time.Duration, error) {
+	pods := &corev1.PodList{}
+	if err := r.client.List(ctx, pods, client.InNamespace(wl.Namespace), client.MatchingFields{indexer.PodWorkloadKey: wl.Name}); err != nil {
+		return nil, 0, fmt.Errorf("failed to list the pods of the workload: %w", err)
+	}
+	timeout := r.cfg.UnschedulableTimeout.Duration
+	flavors := sets.New[kueue.ResourceFlavorReference]()
+	var requeueAfter time.Duration
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		since, unschedulable := unschedulableSince(pod)
+		if !unschedulable {
+			continue
+		}
+		if remaining := since.Add(timeout).Sub(now); remaining > 0 {
+			if requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+			continue
+		}
+		psName := kueue.PodSetReference(pod.Labels[controllerconsts.PodSetLabel])
+		for _, psa := range wl.Status.Admission.PodSetAssignments {
+			if psa.Name == psName {
+				flavors.Insert(slices.Collect(maps.Values(psa.Flavors))...)
+			}
+		}
+	}
+	return sets.List(flavors), requeueAfter, nil
+}
+
+// unschedulableSince returns the time since which the pod is unschedulable,
+// if it is.
+func unschedulableSince(pod *corev1.Pod) (time.Time, bool) {
+	if utilpod.IsTerminated(pod) || !pod.DeletionTimestamp.IsZero() || pod.Spec.NodeName != "" {
+		return time.Time{}, false
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason == corev1.PodReasonUnschedulable {
+			return cond.LastTransitionTime.Time, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// expiredFlavorExclusions returns the flavors which exclusions for the
+// workload are expired. If there are none, it returns the time until the
+// first expiration.
+func expiredFlavorExclusions(wl *kueue.Workload, now time.Time) ([]kueue.ResourceFlavorReference, time.Duration) {
+	var expired []kueue.ResourceFlavorReference
+	var requeueAfter time.Duration
+	for _, ef := range wl.Status.ExcludedFlavors {
+		remaining := ef.ExpirationTime.Sub(now)
+		if remaining <= 0 {
+			expired = append(expired, ef.Name)
+		} else if requeueAfter == 0 || remaining < requeueAfter {
+			requeueAfter = remaining
+		}
+	}
+	return expired, requeueAfter
+}
+
+func (r *FlavorFailoverReconciler) SetupWithManager(mgr ctrl.Manager, cfg *config.Configuration) error {
+	b := builder.ControllerManagedBy(mgr).
+		Named("flavor_failover_controller").
+		For(&kueue.Workload{})
+	if r.cfg != nil {
+		b = b.Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(podToWorkload))
+	}
+	return b.
+		WithOptions(controller.Options{
+			NeedLeaderElection:      ptr.To(false),
+			MaxConcurrentReconciles: mgr.GetControllerOptions().GroupKindConcurrency[kueue.GroupVersion.WithKind("Workload").GroupKind().String()],
+		}).
+		Complete(WithLeadingManager(mgr, r, &kueue.Workload{}, cfg))
+}
+
+func podToWorkload(_ context.Context, obj client.Object) []reconcile.Request {
+	wlName, found := obj.GetAnnotations()[kueue.WorkloadAnnotation]
+	if !found {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: wlName}}}
+}
@@ -0,0 +1,171 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingpod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
+)
+
+func TestFlavorFailoverReconciler(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	wlKey := types.NamespacedName{Name: "wl", Namespace: "ns"}
+	cfg := &config.FlavorFailover{
+		UnschedulableTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+		ExclusionDuration:    &metav1.Duration{Duration: 30 * time.Minute},
+	}
+
+	admittedWorkload := utiltesting.MakeWorkload("wl", "ns").
+		PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 2).Request(corev1.ResourceCPU, "1").Obj()).
+		ReserveQuota(utiltesting.MakeAdmission("cq").
+			PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "spot", "2").Count(2).Obj()).
+			Obj()).
+		Admitted(true).
+		Obj()
+	pod := func(name string, unschedulableFor time.Duration) *corev1.Pod {
+		return testingpod.MakePod(name, "ns").
+			Annotation(kueue.WorkloadAnnotation, "wl").
+			Label(controllerconsts.PodSetLabel, string(kueue.DefaultPodSetName)).
+			StatusConditions(corev1.PodCondition{
+				Type:               corev1.PodScheduled,
+				Status:             corev1.ConditionFalse,
+				Reason:             corev1.PodReasonUnschedulable,
+				LastTransitionTime: metav1.NewTime(now.Add(-unschedulableFor)),
+			}).
+			Obj()
+	}
+	scheduledPod := testingpod.MakePod("scheduled", "ns").
+		Annotation(kueue.WorkloadAnnotation, "wl").
+		Label(controllerconsts.PodSetLabel, string(kueue.DefaultPodSetName)).
+		NodeName("node").
+		Obj()
+
+	cases := map[string]struct {
+		workload            *kueue.Workload
+		pods                []client.Object
+		withoutCfg          bool
+		wantResult          reconcile.Result
+		wantEvictedCond     *metav1.Condition
+		wantExcludedFlavors []kueue.ExcludedFlavor
+	}{
+		"scheduled pods; the workload is kept": {
+			workload: admittedWorkload.DeepCopy(),
+			pods:     []client.Object{scheduledPod.DeepCopy()},
+		},
+		"pod unschedulable for less than the timeout; requeue at the timeout": {
+			workload:   admittedWorkload.DeepCopy(),
+			pods:       []client.Object{scheduledPod.DeepCopy(), pod("pending", 2*time.Minute)},
+			wantResult: reconcile.Result{RequeueAfter: 3 * time.Minute},
+		},
+		"pod unschedulable for longer than the timeout; the workload is evicted and the flavor excluded": {
+			workload: admittedWorkload.DeepCopy(),
+			pods:     []client.Object{scheduledPod.DeepCopy(), pod("pending", 6*time.Minute)},
+			wantEvictedCond: &metav1.Condition{
+				Type:    kueue.WorkloadEvicted,
+				Status:  metav1.ConditionTrue,
+				Reason:  kueue.WorkloadEvictedDueToPodsUnschedulable,
+				Message: fmt.Sprintf(podsUnschedulableEvictionMessageFormat, "spot", 5*time.Minute),
+			},
+			wantExcludedFlavors: []kueue.ExcludedFlavor{{Name: "spot", ExpirationTime: metav1.NewTime(now.Add(30 * time.Minute))}},
+		},
+		"without configuration; the workload with unschedulable pods is kept": {
+			workload:   admittedWorkload.DeepCopy(),
+			pods:       []client.Object{scheduledPod.DeepCopy(), pod("pending", 6*time.Minute)},
+			withoutCfg: true,
+		},
+		"without configuration; the expired exclusion is removed": {
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				ExcludedFlavor("spot", now.Add(-time.Minute)).
+				ExcludedFlavor("on-demand", now.Add(10*time.Minute)).
+				Obj(),
+			withoutCfg:          true,
+			wantExcludedFlavors: []kueue.ExcludedFlavor{{Name: "on-demand", ExpirationTime: metav1.NewTime(now.Add(10 * time.Minute))}},
+		},
+		"pending workload; requeue at the expiration of the exclusion": {
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				ExcludedFlavor("spot", now.Add(10*time.Minute)).
+				Obj(),
+			wantResult:          reconcile.Result{RequeueAfter: 10 * time.Minute},
+			wantExcludedFlavors: []kueue.ExcludedFlavor{{Name: "spot", ExpirationTime: metav1.NewTime(now.Add(10 * time.Minute))}},
+		},
+		"pending workload; the expired exclusion is removed": {
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				ExcludedFlavor("spot", now.Add(-time.Minute)).
+				ExcludedFlavor("on-demand", now.Add(10*time.Minute)).
+				Obj(),
+			wantExcludedFlavors: []kueue.ExcludedFlavor{{Name: "on-demand", ExpirationTime: metav1.NewTime(now.Add(10 * time.Minute))}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.FlavorFailover, true)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			objs := append([]client.Object{tc.workload}, tc.pods...)
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(objs...).
+				WithStatusSubresource(tc.workload).
+				WithIndex(&corev1.Pod{}, indexer.PodWorkloadKey, indexer.IndexPodWorkload).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge}).
+				Build()
+			reconcilerCfg := cfg
+			if tc.withoutCfg {
+				reconcilerCfg = nil
+			}
+			r := NewFlavorFailoverReconciler(cl, &utiltesting.EventRecorder{}, reconcilerCfg)
+			r.clock = testingclock.NewFakeClock(now)
+
+			gotResult, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: wlKey})
+			if err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, gotResult); diff != "" {
+				t.Errorf("Unexpected result (-want,+got):\n%s", diff)
+			}
+			wl := &kueue.Workload{}
+			if err := cl.Get(ctx, wlKey, wl); err != nil {
+				t.Fatalf("Failed to get the workload: %v", err)
+			}
+			evictedCond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadEvicted)
+			if diff := cmp.Diff(tc.wantEvictedCond, evictedCond, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected WorkloadEvicted condition (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantExcludedFlavors, wl.Status.ExcludedFlavors, cmpopts.EquateEmpty(), cmpopts.EquateApproxTime(time.Second)); diff != "" {
+				t.Errorf("Unexpected excluded flavors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -28,7 +28,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/util/queue"
 	"sigs.k8s.io/kueue/pkg/util/slices"
 )
 
@@ -40,6 +42,8 @@ const (
 	WorkloadQuotaReservedKey   = "status.quotaReserved"
 	WorkloadRuntimeClassKey    = "spec.runtimeClass"
 	OwnerReferenceUID          = "metadata.ownerReferences.uid"
+	PodWorkloadKey             = "metadata.annotations.workload"
+	WorkloadBillingQueueKey    = "metadata.annotations.billingQueue"
 
 	// OwnerReferenceGroupKindFmt defines the format string used to construct a field path
 	// for indexing or matching against a specific owner Group and Kind in a Kubernetes object's metadata.
@@ -106,6 +110,20 @@ func IndexWorkloadQueue(obj client.Object) []string {
 	return []string{string(wl.Spec.QueueName)}
 }
 
+// IndexWorkloadBillingQueue indexes the workloads with a billing namespace
+// by the reference of their LocalQueue in that namespace.
+func IndexWorkloadBillingQueue(obj client.Object) []string {
+	wl, ok := obj.(*kueue.Workload)
+	if !ok {
+		return nil
+	}
+	ns := wl.Annotations[constants.BillingNamespaceAnnotation]
+	if ns == "" {
+		return nil
+	}
+	return []string{string(queue.NewLocalQueueReference(ns, wl.Spec.QueueName))}
+}
+
 func IndexWorkloadClusterQueue(obj client.Object) []string {
 	wl, ok := obj.(*kueue.Workload)
 	if !ok {
@@ -167,6 +185,18 @@ func IndexOwnerUID(obj client.Object) []string {
 }
 
 // Setup sets the index with the given fields for core apis.
+func IndexPodWorkload(obj client.Object) []string {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil
+	}
+	value, found := pod.Annotations[kueue.WorkloadAnnotation]
+	if !found {
+		return nil
+	}
+	return []string{value}
+}
+
 func Setup(ctx context.Context, indexer client.FieldIndexer) error {
 	if err := indexer.IndexField(ctx, &kueue.Workload{}, WorkloadQueueKey, IndexWorkloadQueue); err != nil {
 		return fmt.Errorf("setting index on queue for Workload: %w", err)
@@ -174,6 +204,11 @@ func Setup(ctx context.Context, indexer client.FieldIndexer) error {
 	if err := indexer.IndexField(ctx, &kueue.Workload{}, WorkloadClusterQueueKey, IndexWorkloadClusterQueue); err != nil {
 		return fmt.Errorf("setting index on clusterQueue for Workload: %w", err)
 	}
+	if features.Enabled(features.CrossNamespaceBilling) {
+		if err := indexer.IndexField(ctx, &kueue.Workload{}, WorkloadBillingQueueKey, IndexWorkloadBillingQueue); err != nil {
+			return fmt.Errorf("setting index on billing queue for Workload: %w", err)
+		}
+	}
 	if err := indexer.IndexField(ctx, &kueue.Workload{}, WorkloadQuotaReservedKey, IndexWorkloadQuotaReserved); err != nil {
 		return fmt.Errorf("setting index on admitted for Workload: %w", err)
 	}
@@ -196,5 +231,12 @@ func Setup(ctx context.Context, indexer client.FieldIndexer) error {
 			return err
 		}
 	}
+	// Add pod index to be able to find the unschedulable pods of the
+	// admitted workloads, and to sample the resource usage of their pods.
+	if features.Enabled(features.FlavorFailover) || features.Enabled(features.WorkloadActualUsage) {
+		if err := indexer.IndexField(ctx, &corev1.Pod{}, PodWorkloadKey, IndexPodWorkload); err != nil {
+			return fmt.Errorf("setting index on workload for Pod: %w", err)
+		}
+	}
 	return nil
 }
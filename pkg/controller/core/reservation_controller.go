@@ -0,0 +1,198 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+)
+
+const (
+	reservationActiveReason  = "Active"
+	reservationPendingReason = "Pending"
+	reservationExpiredReason = "Expired"
+)
+
+type ReservationReconcilerOptions struct {
+	clock clock.Clock
+}
+
+// ReservationReconcilerOption configures the reconciler.
+type ReservationReconcilerOption func(*ReservationReconcilerOptions)
+
+func WithReservationClock(c clock.Clock) ReservationReconcilerOption {
+	return func(o *ReservationReconcilerOptions) {
+		o.clock = c
+	}
+}
+
+var defaultReservationOptions = ReservationReconcilerOptions{
+	clock: realClock,
+}
+
+// ReservationReconciler is responsible for synchronizing the in-memory
+// representation of Reservations in cache.Cache with Reservation Kubernetes
+// objects, and for maintaining their Active condition.
+type ReservationReconciler struct {
+	client   client.Client
+	log      logr.Logger
+	qManager *qcache.Manager
+	cache    *schdcache.Cache
+	clock    clock.Clock
+}
+
+func NewReservationReconciler(
+	client client.Client,
+	qMgr *qcache.Manager,
+	cache *schdcache.Cache,
+	opts ...ReservationReconcilerOption,
+) *ReservationReconciler {
+	options := defaultReservationOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return &ReservationReconciler{
+		client:   client,
+		log:      ctrl.Log.WithName("reservation-reconciler"),
+		qManager: qMgr,
+		cache:    cache,
+		clock:    options.clock,
+	}
+}
+
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=reservations,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=reservations/status,verbs=get;update;patch
+
+func (r *ReservationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile Reservation")
+
+	var reservation kueue.Reservation
+	if err := r.client.Get(ctx, req.NamespacedName, &reservation); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			log.V(2).Info("Reservation is being deleted")
+			if deleted := r.cache.DeleteReservation(req.Name); deleted != nil {
+				// The released capacity can be used by the pending workloads.
+				r.qManager.QueueInadmissibleWorkloads(ctx, sets.New(deleted.Spec.ClusterQueue))
+			}
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	r.cache.AddOrUpdateReservation(&reservation)
+	// The workloads of other users may fit once the reservation expires or
+	// changes, so we give them a chance to be scheduled again.
+	r.qManager.QueueInadmissibleWorkloads(ctx, sets.New(reservation.Spec.ClusterQueue))
+
+	now := r.clock.Now()
+	if err := r.updateStatusIfChanged(ctx, &reservation, now); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	switch {
+	case now.Before(reservation.Spec.StartTime.Time):
+		return ctrl.Result{RequeueAfter: reservation.Spec.StartTime.Sub(now)}, nil
+	case now.Before(reservation.Spec.EndTime.Time):
+		return ctrl.Result{RequeueAfter: reservation.Spec.EndTime.Sub(now)}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *ReservationReconciler) updateStatusIfChanged(ctx context.Context, reservation *kueue.Reservation, now time.Time) error {
+	oldStatus := reservation.Status.DeepCopy()
+	condition := metav1.Condition{
+		Type:               kueue.ReservationActive,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: reservation.Generation,
+	}
+	switch {
+	case schdcache.IsReservationActive(reservation, now):
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = reservationActiveReason
+		condition.Message = "The reservation window is open"
+	case now.Before(reservation.Spec.StartTime.Time):
+		condition.Reason = reservationPendingReason
+		condition.Message = "The reservation window has not started yet"
+	default:
+		condition.Reason = reservationExpiredReason
+		condition.Message = "The reservation window has ended"
+	}
+	meta.SetStatusCondition(&reservation.Status.Conditions, condition)
+	if !equality.Semantic.DeepEqual(oldStatus, &reservation.Status) {
+		return r.client.Status().Update(ctx, reservation)
+	}
+	return nil
+}
+
+func (r *ReservationReconciler) Create(event.TypedCreateEvent[*kueue.Reservation]) bool {
+	return true
+}
+
+func (r *ReservationReconciler) Update(e event.TypedUpdateEvent[*kueue.Reservation]) bool {
+	if equality.Semantic.DeepEqual(e.ObjectOld.Spec, e.ObjectNew.Spec) {
+		r.log.V(3).Info("Skip Reservation update event as Reservation spec unchanged", "reservation", klog.KObj(e.ObjectNew))
+		return false
+	}
+	return true
+}
+
+func (r *ReservationReconciler) Delete(event.TypedDeleteEvent[*kueue.Reservation]) bool {
+	return true
+}
+
+func (r *ReservationReconciler) Generic(event.TypedGenericEvent[*kueue.Reservation]) bool {
+	return true
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ReservationReconciler) SetupWithManager(mgr ctrl.Manager, cfg *config.Configuration) error {
+	return builder.TypedControllerManagedBy[reconcile.Request](mgr).
+		Named("reservation_controller").
+		WatchesRawSource(source.TypedKind(
+			mgr.GetCache(),
+			&kueue.Reservation{},
+			&handler.TypedEnqueueRequestForObject[*kueue.Reservation]{},
+			r,
+		)).
+		WithOptions(controller.Options{
+			NeedLeaderElection:      ptr.To(false),
+			MaxConcurrentReconciles: mgr.GetControllerOptions().GroupKindConcurrency[kueue.GroupVersion.WithKind("Reservation").GroupKind().String()],
+		}).
+		Complete(WithLeadingManager(mgr, r, &kueue.Reservation{}, cfg))
+}
@@ -0,0 +1,119 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestReservationReconcile(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	cases := map[string]struct {
+		start         time.Time
+		end           time.Time
+		wantCondition metav1.Condition
+		wantResult    reconcile.Result
+	}{
+		"reservation window not started": {
+			start: now.Add(time.Hour),
+			end:   now.Add(2 * time.Hour),
+			wantCondition: metav1.Condition{
+				Type:    kueue.ReservationActive,
+				Status:  metav1.ConditionFalse,
+				Reason:  reservationPendingReason,
+				Message: "The reservation window has not started yet",
+			},
+			wantResult: reconcile.Result{RequeueAfter: time.Hour},
+		},
+		"reservation window open": {
+			start: now.Add(-time.Hour),
+			end:   now.Add(30 * time.Minute),
+			wantCondition: metav1.Condition{
+				Type:    kueue.ReservationActive,
+				Status:  metav1.ConditionTrue,
+				Reason:  reservationActiveReason,
+				Message: "The reservation window is open",
+			},
+			wantResult: reconcile.Result{RequeueAfter: 30 * time.Minute},
+		},
+		"reservation window ended": {
+			start: now.Add(-2 * time.Hour),
+			end:   now.Add(-time.Hour),
+			wantCondition: metav1.Condition{
+				Type:    kueue.ReservationActive,
+				Status:  metav1.ConditionFalse,
+				Reason:  reservationExpiredReason,
+				Message: "The reservation window has ended",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			reservation := utiltesting.MakeReservation("r1", "cq").
+				Holder("team-a").
+				Window(tc.start, tc.end).
+				Resource("default", corev1.ResourceCPU, "5").
+				Obj()
+			cl := utiltesting.NewFakeClient(reservation)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cache := schdcache.New(cl)
+			qManager := qcache.NewManager(cl, cache)
+			reconciler := NewReservationReconciler(cl, qManager, cache, WithReservationClock(testingclock.NewFakeClock(now)))
+
+			gotResult, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(reservation)})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantResult, gotResult); diff != "" {
+				t.Errorf("Unexpected result (-want,+got):\n%s", diff)
+			}
+
+			var updated kueue.Reservation
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(reservation), &updated); err != nil {
+				t.Fatalf("Failed to get the Reservation: %v", err)
+			}
+			if diff := cmp.Diff([]metav1.Condition{tc.wantCondition}, updated.Status.Conditions,
+				cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected conditions (-want,+got):\n%s", diff)
+			}
+
+			if err := cl.Delete(ctx, &updated); err != nil {
+				t.Fatalf("Failed to delete the Reservation: %v", err)
+			}
+			if _, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(reservation)}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if deleted := cache.DeleteReservation(reservation.Name); deleted != nil {
+				t.Error("Expected the Reservation to be removed from the cache")
+			}
+		})
+	}
+}
@@ -0,0 +1,292 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
+)
+
+const (
+	// maxResourceFlavorTaints is the maximum number of nodeTaints, or
+	// tolerations, of a ResourceFlavor.
+	maxResourceFlavorTaints = 8
+)
+
+var (
+	// nodePoolsRequest is the single request reconciling all the node pools,
+	// as the ResourceFlavors depend on the whole set of Nodes.
+	nodePoolsRequest = reconcile.Request{NamespacedName: types.NamespacedName{Name: "node-pools"}}
+
+	invalidFlavorNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+	// transientTaintPrefixes are the prefixes of the taints set by Kubernetes
+	// on the individual Nodes, which don't describe their node pool.
+	transientTaintPrefixes = []string{"node.kubernetes.io/", "node.cloudprovider.kubernetes.io/"}
+)
+
+// ResourceFlavorDiscoveryReconciler creates a ResourceFlavor for each node
+// pool, the Nodes with the same values of the configured labels, and keeps
+// their nodeLabels and taints in sync with the Nodes. The ResourceFlavors of
+// the node pools without Nodes anymore are deleted.
+type ResourceFlavorDiscoveryReconciler struct {
+	client client.Client
+	cfg    *config.ResourceFlavorDiscovery
+}
+
+var _ reconcile.Reconciler = (*ResourceFlavorDiscoveryReconciler)(nil)
+
+func NewResourceFlavorDiscoveryReconciler(client client.Client, cfg *config.ResourceFlavorDiscovery) *ResourceFlavorDiscoveryReconciler {
+	return &ResourceFlavorDiscoveryReconciler{
+		client: client,
+		cfg:    cfg,
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch;create;update;delete
+
+func (r *ResourceFlavorDiscoveryReconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile node pools")
+
+	nodes := &corev1.NodeList{}
+	if err := r.client.List(ctx, nodes); err != nil {
+		return reconcile.Result{}, err
+	}
+	desired := r.desiredFlavors(nodes.Items)
+
+	discovered := &kueue.ResourceFlavorList{}
+	if err := r.client.List(ctx, discovered, client.MatchingLabels{constants.ManagedByKueueLabelKey: constants.ManagedByKueueLabelValue}); err != nil {
+		return reconcile.Result{}, err
+	}
+	for i := range discovered.Items {
+		rf := &discovered.Items[i]
+		if _, found := desired[rf.Name]; found || !rf.DeletionTimestamp.IsZero() {
+			continue
+		}
+		// The ResourceFlavors still used by ClusterQueues are protected by their finalizer.
+		if err := r.client.Delete(ctx, rf); client.IgnoreNotFound(err) != nil {
+			return reconcile.Result{}, err
+		}
+		log.V(2).Info("Deleted the ResourceFlavor of a node pool without Nodes", "resourceFlavor", klog.KObj(rf))
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(desired)) {
+		if err := r.syncFlavor(ctx, desired[name]); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+// syncFlavor creates the ResourceFlavor, or updates it if it was discovered
+// and differs from the node pool.
+func (r *ResourceFlavorDiscoveryReconciler) syncFlavor(ctx context.Context, want *kueue.ResourceFlavor) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("resourceFlavor", klog.KObj(want))
+	rf := &kueue.ResourceFlavor{}
+	err := r.client.Get(ctx, client.ObjectKeyFromObject(want), rf)
+	if apierrors.IsNotFound(err) {
+		if err := r.client.Create(ctx, want); client.IgnoreAlreadyExists(err) != nil {
+			return err
+		}
+		log.V(2).Info("Created the ResourceFlavor of a node pool")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if rf.Labels[constants.ManagedByKueueLabelKey] != constants.ManagedByKueueLabelValue {
+		log.V(3).Info("Skipping the node pool of a ResourceFlavor not created by Kueue")
+		return nil
+	}
+	if equality.Semantic.DeepEqual(rf.Spec.NodeLabels, want.Spec.NodeLabels) &&
+		equality.Semantic.DeepEqual(rf.Spec.NodeTaints, want.Spec.NodeTaints) &&
+		equality.Semantic.DeepEqual(rf.Spec.Tolerations, want.Spec.Tolerations) {
+		return nil
+	}
+	rf.Spec.NodeLabels = want.Spec.NodeLabels
+	rf.Spec.NodeTaints = want.Spec.NodeTaints
+	rf.Spec.Tolerations = want.Spec.Tolerations
+	if err := r.client.Update(ctx, rf); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	log.V(2).Info("Updated the ResourceFlavor of a node pool")
+	return nil
+}
+
+// desiredFlavors returns the ResourceFlavors of the node pools, by name.
+func (r *ResourceFlavorDiscoveryReconciler) desiredFlavors(nodes []corev1.Node) map[string]*kueue.ResourceFlavor {
+	pools := make(map[string][]*corev1.Node)
+	poolLabels := make(map[string]map[string]string)
+	for i := range nodes {
+		node := &nodes[i]
+		labels, found := r.nodePoolLabels(node)
+		if !found {
+			continue
+		}
+		name := r.flavorName(labels)
+		pools[name] = append(pools[name], node)
+		poolLabels[name] = labels
+	}
+
+	tolerate := ptr.Deref(r.cfg.TolerateNodeTaints, false)
+	result := make(map[string]*kueue.ResourceFlavor, len(pools))
+	for name, poolNodes := range pools {
+		rf := &kueue.ResourceFlavor{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{constants.ManagedByKueueLabelKey: constants.ManagedByKueueLabelValue},
+			},
+			Spec: kueue.ResourceFlavorSpec{NodeLabels: poolLabels[name]},
+		}
+		taints := commonTaints(poolNodes)
+		if tolerate {
+			for _, taint := range taints {
+				rf.Spec.Tolerations = append(rf.Spec.Tolerations, tolerationForTaint(taint))
+			}
+		} else {
+			rf.Spec.NodeTaints = taints
+		}
+		result[name] = rf
+	}
+	return result
+}
+
+// nodePoolLabels returns the labels of the Node with the configured keys, and
+// false if the Node misses any of them.
+func (r *ResourceFlavorDiscoveryReconciler) nodePoolLabels(node *corev1.Node) (map[string]string, bool) {
+	labels := make(map[string]string, len(r.cfg.NodeLabelKeys))
+	for _, key := range r.cfg.NodeLabelKeys {
+		value, found := node.Labels[key]
+		if !found {
+			return nil, false
+		}
+		labels[key] = value
+	}
+	return labels, true
+}
+
+// flavorName returns the name of the ResourceFlavor of a node pool, made of
+// the values of its labels. A hash of the values is appended if they are not
+// a valid name as is.
+func (r *ResourceFlavorDiscoveryReconciler) flavorName(labels map[string]string) string {
+	values := make([]string, 0, len(r.cfg.NodeLabelKeys))
+	for _, key := range r.cfg.NodeLabelKeys {
+		values = append(values, labels[key])
+	}
+	joined := strings.Join(values, "-")
+	name := strings.Trim(invalidFlavorNameChars.ReplaceAllString(strings.ToLower(joined), "-"), "-.")
+	if name == joined && name != "" {
+		return name
+	}
+	if len(name) > 200 {
+		name = name[:200]
+	}
+	hash := fmt.Sprintf("%x", sha256.Sum256([]byte(joined)))[:8]
+	if name == "" {
+		return "pool-" + hash
+	}
+	return strings.TrimRight(name, "-.") + "-" + hash
+}
+
+// commonTaints returns the NoSchedule and NoExecute taints shared by all the
+// Nodes, sorted, and ignoring the taints Kubernetes sets on individual Nodes.
+func commonTaints(nodes []*corev1.Node) []corev1.Taint {
+	var result []corev1.Taint
+	for _, taint := range nodes[0].Spec.Taints {
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule || isTransientTaint(taint) {
+			continue
+		}
+		if !slices.ContainsFunc(nodes[1:], func(node *corev1.Node) bool { return !hasTaint(node, taint) }) {
+			result = append(result, corev1.Taint{Key: taint.Key, Value: taint.Value, Effect: taint.Effect})
+		}
+	}
+	slices.SortFunc(result, func(a, b corev1.Taint) int {
+		return cmp.Or(cmp.Compare(a.Key, b.Key), cmp.Compare(a.Value, b.Value), cmp.Compare(a.Effect, b.Effect))
+	})
+	if len(result) > maxResourceFlavorTaints {
+		result = result[:maxResourceFlavorTaints]
+	}
+	return result
+}
+
+func isTransientTaint(taint corev1.Taint) bool {
+	return slices.ContainsFunc(transientTaintPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(taint.Key, prefix)
+	})
+}
+
+func hasTaint(node *corev1.Node, taint corev1.Taint) bool {
+	return slices.ContainsFunc(node.Spec.Taints, func(t corev1.Taint) bool {
+		return t.MatchTaint(&taint) && t.Value == taint.Value
+	})
+}
+
+func tolerationForTaint(taint corev1.Taint) corev1.Toleration {
+	toleration := corev1.Toleration{Key: taint.Key, Operator: corev1.TolerationOpExists, Effect: taint.Effect}
+	if taint.Value != "" {
+		toleration.Operator = corev1.TolerationOpEqual
+		toleration.Value = taint.Value
+	}
+	return toleration
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ResourceFlavorDiscoveryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	toNodePools := handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+		return []reconcile.Request{nodePoolsRequest}
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("resourceflavor_discovery").
+		Watches(&corev1.Node{}, toNodePools, builder.WithPredicates(predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				// Skip the frequent updates of the Node statuses.
+				oldNode, newNode := e.ObjectOld.(*corev1.Node), e.ObjectNew.(*corev1.Node)
+				return !maps.Equal(oldNode.Labels, newNode.Labels) || !equality.Semantic.DeepEqual(oldNode.Spec.Taints, newNode.Spec.Taints)
+			},
+		})).
+		Watches(&kueue.ResourceFlavor{}, toNodePools, builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return obj.GetLabels()[constants.ManagedByKueueLabelKey] == constants.ManagedByKueueLabelValue
+		}))).
+		Complete(r)
+}
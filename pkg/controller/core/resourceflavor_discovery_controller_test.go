@@ -0,0 +1,115 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
+)
+
+func TestResourceFlavorDiscoveryReconcile(t *testing.T) {
+	const (
+		poolLabel = "cloud.provider.com/node-pool"
+		typeLabel = "node.kubernetes.io/instance-type"
+	)
+	gpuTaint := corev1.Taint{Key: "nvidia.com/gpu", Value: "present", Effect: corev1.TaintEffectNoSchedule}
+	notReadyTaint := corev1.Taint{Key: corev1.TaintNodeNotReady, Effect: corev1.TaintEffectNoExecute}
+	nodes := []client.Object{
+		testingnode.MakeNode("gpu-1").Label(poolLabel, "gpu").Label(typeLabel, "a2-highgpu").Taints(gpuTaint).Obj(),
+		testingnode.MakeNode("gpu-2").Label(poolLabel, "gpu").Label(typeLabel, "a2-highgpu").Taints(gpuTaint, notReadyTaint).Obj(),
+		testingnode.MakeNode("cpu-1").Label(poolLabel, "default").Label(typeLabel, "N2_Standard").Obj(),
+		testingnode.MakeNode("unlabeled").Label(poolLabel, "other").Obj(),
+	}
+	discovered := func(name string) *utiltesting.ResourceFlavorWrapper {
+		return utiltesting.MakeResourceFlavor(name).Label(constants.ManagedByKueueLabelKey, constants.ManagedByKueueLabelValue)
+	}
+
+	cases := map[string]struct {
+		tolerateNodeTaints bool
+		flavors            []client.Object
+		wantFlavors        []kueue.ResourceFlavor
+	}{
+		"creates the ResourceFlavors of the node pools": {
+			wantFlavors: []kueue.ResourceFlavor{
+				*discovered("default-n2-standard-1fe8af60").NodeLabel(poolLabel, "default").NodeLabel(typeLabel, "N2_Standard").Obj(),
+				*discovered("gpu-a2-highgpu").NodeLabel(poolLabel, "gpu").NodeLabel(typeLabel, "a2-highgpu").Taint(gpuTaint).Obj(),
+			},
+		},
+		"tolerates the taints of the node pools": {
+			tolerateNodeTaints: true,
+			wantFlavors: []kueue.ResourceFlavor{
+				*discovered("default-n2-standard-1fe8af60").NodeLabel(poolLabel, "default").NodeLabel(typeLabel, "N2_Standard").Obj(),
+				*discovered("gpu-a2-highgpu").NodeLabel(poolLabel, "gpu").NodeLabel(typeLabel, "a2-highgpu").
+					Toleration(corev1.Toleration{Key: gpuTaint.Key, Operator: corev1.TolerationOpEqual, Value: gpuTaint.Value, Effect: gpuTaint.Effect}).
+					Obj(),
+			},
+		},
+		"updates the drifted ResourceFlavors and deletes the stale ones": {
+			flavors: []client.Object{
+				discovered("gpu-a2-highgpu").NodeLabel(poolLabel, "gpu").Obj(),
+				discovered("tpu-ct5lp").NodeLabel(poolLabel, "tpu").Obj(),
+			},
+			wantFlavors: []kueue.ResourceFlavor{
+				*discovered("default-n2-standard-1fe8af60").NodeLabel(poolLabel, "default").NodeLabel(typeLabel, "N2_Standard").Obj(),
+				*discovered("gpu-a2-highgpu").NodeLabel(poolLabel, "gpu").NodeLabel(typeLabel, "a2-highgpu").Taint(gpuTaint).Obj(),
+			},
+		},
+		"doesn't change the ResourceFlavors created by the users": {
+			flavors: []client.Object{
+				utiltesting.MakeResourceFlavor("gpu-a2-highgpu").NodeLabel(poolLabel, "gpu").Obj(),
+				utiltesting.MakeResourceFlavor("spot").Obj(),
+			},
+			wantFlavors: []kueue.ResourceFlavor{
+				*discovered("default-n2-standard-1fe8af60").NodeLabel(poolLabel, "default").NodeLabel(typeLabel, "N2_Standard").Obj(),
+				*utiltesting.MakeResourceFlavor("gpu-a2-highgpu").NodeLabel(poolLabel, "gpu").Obj(),
+				*utiltesting.MakeResourceFlavor("spot").Obj(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().WithObjects(nodes...).WithObjects(tc.flavors...).Build()
+			r := NewResourceFlavorDiscoveryReconciler(cl, &config.ResourceFlavorDiscovery{
+				NodeLabelKeys:      []string{poolLabel, typeLabel},
+				TolerateNodeTaints: ptr.To(tc.tolerateNodeTaints),
+			})
+			if _, err := r.Reconcile(ctx, nodePoolsRequest); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			flavors := &kueue.ResourceFlavorList{}
+			if err := cl.List(ctx, flavors); err != nil {
+				t.Fatalf("Failed to list the ResourceFlavors: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantFlavors, flavors.Items, cmpopts.IgnoreFields(kueue.ResourceFlavor{}, "TypeMeta", "ObjectMeta.ResourceVersion"), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected ResourceFlavors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
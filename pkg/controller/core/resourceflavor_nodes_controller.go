@@ -0,0 +1,171 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
+)
+
+// ResourceFlavorNodesReconciler checks the ResourceFlavors against the live
+// Nodes, and sets their NodesAvailable condition to false when no schedulable
+// Node matches their nodeLabels and taints, so that the ClusterQueues using
+// them are deactivated instead of silently never admitting the workloads.
+type ResourceFlavorNodesReconciler struct {
+	client client.Client
+}
+
+var _ reconcile.Reconciler = (*ResourceFlavorNodesReconciler)(nil)
+
+func NewResourceFlavorNodesReconciler(client client.Client) *ResourceFlavorNodesReconciler {
+	return &ResourceFlavorNodesReconciler{
+		client: client,
+	}
+}
+
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors/status,verbs=get;update;patch
+
+func (r *ResourceFlavorNodesReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	rf := &kueue.ResourceFlavor{}
+	if err := r.client.Get(ctx, req.NamespacedName, rf); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if !rf.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile ResourceFlavor nodes")
+
+	nodes := &corev1.NodeList{}
+	if err := r.client.List(ctx, nodes, client.MatchingLabels(rf.Spec.NodeLabels)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to list the nodes of the ResourceFlavor: %w", err)
+	}
+	matching := 0
+	for i := range nodes.Items {
+		if matchesSchedulableNode(rf, &nodes.Items[i]) {
+			matching++
+		}
+	}
+
+	newCondition := metav1.Condition{
+		Type:               kueue.ResourceFlavorNodesAvailable,
+		Status:             metav1.ConditionTrue,
+		Reason:             kueue.ResourceFlavorNodesAvailableReasonNodesFound,
+		Message:            "Schedulable Nodes match the ResourceFlavor",
+		ObservedGeneration: rf.Generation,
+	}
+	if matching == 0 {
+		newCondition.Status = metav1.ConditionFalse
+		newCondition.Reason = kueue.ResourceFlavorNodesAvailableReasonNoMatchingNodes
+		newCondition.Message = "No schedulable Node matches the nodeLabels, nodeTaints and tolerations of the ResourceFlavor"
+	}
+	oldCondition := apimeta.FindStatusCondition(rf.Status.Conditions, kueue.ResourceFlavorNodesAvailable)
+	if oldCondition != nil && oldCondition.Status == newCondition.Status && oldCondition.Reason == newCondition.Reason &&
+		oldCondition.Message == newCondition.Message && oldCondition.ObservedGeneration == newCondition.ObservedGeneration {
+		return reconcile.Result{}, nil
+	}
+	apimeta.SetStatusCondition(&rf.Status.Conditions, newCondition)
+	if err := r.client.Status().Update(ctx, rf); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	log.V(3).Info("Updated the ResourceFlavor NodesAvailable condition", "status", newCondition.Status, "matchingNodes", matching)
+	return reconcile.Result{}, nil
+}
+
+// matchesSchedulableNode returns whether the pods assigned to the
+// ResourceFlavor can be scheduled on the Node, assuming that the Node has the
+// nodeLabels of the flavor: it is ready and schedulable, and its NoSchedule
+// and NoExecute taints are either nodeTaints of the flavor, which the pods
+// tolerate to get the flavor assigned, or tolerated by its tolerations.
+func matchesSchedulableNode(rf *kueue.ResourceFlavor, node *corev1.Node) bool {
+	if node.Spec.Unschedulable || !utiltas.IsNodeStatusConditionTrue(node.Status.Conditions, corev1.NodeReady) {
+		return false
+	}
+	if !labels.SelectorFromSet(rf.Spec.NodeLabels).Matches(labels.Set(node.Labels)) {
+		return false
+	}
+	tolerations := make([]corev1.Toleration, 0, len(rf.Spec.NodeTaints)+len(rf.Spec.Tolerations))
+	for _, taint := range rf.Spec.NodeTaints {
+		tolerations = append(tolerations, tolerationForTaint(taint))
+	}
+	tolerations = append(tolerations, rf.Spec.Tolerations...)
+	_, untolerated := corev1helpers.FindMatchingUntoleratedTaint(node.Spec.Taints, tolerations, func(t *corev1.Taint) bool {
+		return (t.Effect == corev1.TaintEffectNoSchedule || t.Effect == corev1.TaintEffectNoExecute) && !isTransientTaint(*t)
+	})
+	return !untolerated
+}
+
+// flavorsForNode returns the requests for all the ResourceFlavors when a Node
+// changes, as any of them may match the Node.
+func (r *ResourceFlavorNodesReconciler) flavorsForNode(ctx context.Context, _ client.Object) []reconcile.Request {
+	flavors := &kueue.ResourceFlavorList{}
+	if err := r.client.List(ctx, flavors); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list the ResourceFlavors")
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(flavors.Items))
+	for i := range flavors.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: flavors.Items[i].Name}})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ResourceFlavorNodesReconciler) SetupWithManager(mgr ctrl.Manager, cfg *config.Configuration) error {
+	return builder.ControllerManagedBy(mgr).
+		Named("resourceflavor_nodes_controller").
+		For(&kueue.ResourceFlavor{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Node{}, handler.EnqueueRequestsFromMapFunc(r.flavorsForNode), builder.WithPredicates(predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				// Skip the frequent updates of the Node statuses.
+				oldNode, newNode := e.ObjectOld.(*corev1.Node), e.ObjectNew.(*corev1.Node)
+				return !maps.Equal(oldNode.Labels, newNode.Labels) ||
+					!equality.Semantic.DeepEqual(oldNode.Spec.Taints, newNode.Spec.Taints) ||
+					oldNode.Spec.Unschedulable != newNode.Spec.Unschedulable ||
+					utiltas.IsNodeStatusConditionTrue(oldNode.Status.Conditions, corev1.NodeReady) != utiltas.IsNodeStatusConditionTrue(newNode.Status.Conditions, corev1.NodeReady)
+			},
+		})).
+		WithOptions(controller.Options{
+			NeedLeaderElection:      ptr.To(false),
+			MaxConcurrentReconciles: mgr.GetControllerOptions().GroupKindConcurrency[kueue.GroupVersion.WithKind("ResourceFlavor").GroupKind().String()],
+		}).
+		Complete(WithLeadingManager(mgr, r, &kueue.ResourceFlavor{}, cfg))
+}
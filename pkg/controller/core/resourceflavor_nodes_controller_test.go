@@ -0,0 +1,127 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
+)
+
+func TestResourceFlavorNodesReconciler(t *testing.T) {
+	spotTaint := corev1.Taint{Key: "cloud.com/spot", Value: "true", Effect: corev1.TaintEffectNoSchedule}
+	gpuTaint := corev1.Taint{Key: "nvidia.com/gpu", Effect: corev1.TaintEffectNoSchedule}
+	nodesFound := &metav1.Condition{
+		Type:    kueue.ResourceFlavorNodesAvailable,
+		Status:  metav1.ConditionTrue,
+		Reason:  kueue.ResourceFlavorNodesAvailableReasonNodesFound,
+		Message: "Schedulable Nodes match the ResourceFlavor",
+	}
+	noMatchingNodes := &metav1.Condition{
+		Type:    kueue.ResourceFlavorNodesAvailable,
+		Status:  metav1.ConditionFalse,
+		Reason:  kueue.ResourceFlavorNodesAvailableReasonNoMatchingNodes,
+		Message: "No schedulable Node matches the nodeLabels, nodeTaints and tolerations of the ResourceFlavor",
+	}
+
+	cases := map[string]struct {
+		flavor        *kueue.ResourceFlavor
+		nodes         []client.Object
+		wantCondition *metav1.Condition
+	}{
+		"a ready node matches the nodeLabels": {
+			flavor: utiltesting.MakeResourceFlavor("spot").NodeLabel("instance", "spot").Obj(),
+			nodes: []client.Object{
+				testingnode.MakeNode("n1").Label("instance", "spot").Ready().Obj(),
+			},
+			wantCondition: nodesFound,
+		},
+		"no node matches the nodeLabels": {
+			flavor: utiltesting.MakeResourceFlavor("spot").NodeLabel("instance", "spto").Obj(),
+			nodes: []client.Object{
+				testingnode.MakeNode("n1").Label("instance", "spot").Ready().Obj(),
+			},
+			wantCondition: noMatchingNodes,
+		},
+		"the matching nodes are not ready or unschedulable": {
+			flavor: utiltesting.MakeResourceFlavor("spot").NodeLabel("instance", "spot").Obj(),
+			nodes: []client.Object{
+				testingnode.MakeNode("n1").Label("instance", "spot").NotReady().Obj(),
+				testingnode.MakeNode("n2").Label("instance", "spot").Ready().Unschedulable().Obj(),
+			},
+			wantCondition: noMatchingNodes,
+		},
+		"the taint of the node is a nodeTaint of the flavor": {
+			flavor: utiltesting.MakeResourceFlavor("spot").NodeLabel("instance", "spot").Taint(spotTaint).Obj(),
+			nodes: []client.Object{
+				testingnode.MakeNode("n1").Label("instance", "spot").Taints(spotTaint).Ready().Obj(),
+			},
+			wantCondition: nodesFound,
+		},
+		"the taint of the node is tolerated by the flavor": {
+			flavor: utiltesting.MakeResourceFlavor("spot").NodeLabel("instance", "spot").
+				Toleration(corev1.Toleration{Key: "cloud.com/spot", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}).
+				Obj(),
+			nodes: []client.Object{
+				testingnode.MakeNode("n1").Label("instance", "spot").Taints(spotTaint).Ready().Obj(),
+			},
+			wantCondition: nodesFound,
+		},
+		"the taint of the node is not declared by the flavor": {
+			flavor: utiltesting.MakeResourceFlavor("spot").NodeLabel("instance", "spot").Taint(spotTaint).Obj(),
+			nodes: []client.Object{
+				testingnode.MakeNode("n1").Label("instance", "spot").Taints(spotTaint, gpuTaint).Ready().Obj(),
+			},
+			wantCondition: noMatchingNodes,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			objs := append([]client.Object{tc.flavor}, tc.nodes...)
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(objs...).
+				WithStatusSubresource(tc.flavor).
+				Build()
+			r := NewResourceFlavorNodesReconciler(cl)
+
+			key := types.NamespacedName{Name: tc.flavor.Name}
+			if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key}); err != nil {
+				t.Fatalf("Reconcile() error = %v", err)
+			}
+			rf := &kueue.ResourceFlavor{}
+			if err := cl.Get(ctx, key, rf); err != nil {
+				t.Fatalf("Failed to get the ResourceFlavor: %v", err)
+			}
+			gotCondition := apimeta.FindStatusCondition(rf.Status.Conditions, kueue.ResourceFlavorNodesAvailable)
+			if diff := cmp.Diff(tc.wantCondition, gotCondition, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "ObservedGeneration")); diff != "" {
+				t.Errorf("Unexpected NodesAvailable condition (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -52,11 +53,14 @@ import (
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/constants"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
 	"sigs.k8s.io/kueue/pkg/dra"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/metrics"
 	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
 	utilslices "sigs.k8s.io/kueue/pkg/util/slices"
 	stringsutils "sigs.k8s.io/kueue/pkg/util/strings"
 	"sigs.k8s.io/kueue/pkg/workload"
@@ -67,6 +71,17 @@ var (
 	realClock = clock.RealClock{}
 )
 
+// admissionChecksProgressInterval is the interval of the events reporting the
+// progress of the admission checks of the workloads blocked on them.
+const admissionChecksProgressInterval = 5 * time.Minute
+
+// The defaults of the reactivationPolicy of the ClusterQueues.
+const (
+	defaultReactivationCooldownSeconds    int32 = 600
+	defaultReactivationMaxCooldownSeconds int32 = 86400
+	defaultReactivationLimitCount         int32 = 3
+)
+
 type waitForPodsReadyConfig struct {
 	timeout                     time.Duration
 	recoveryTimeout             *time.Duration
@@ -76,10 +91,6 @@ type waitForPodsReadyConfig struct {
 	requeuingBackoffJitter      float64
 }
 
-type workloadRetentionConfig struct {
-	afterFinished *time.Duration
-}
-
 // Option configures the reconciler.
 type Option func(*WorkloadReconciler)
 
@@ -168,19 +179,31 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 
 	finishedCond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadFinished)
 	if finishedCond != nil && finishedCond.Status == metav1.ConditionTrue {
-		if !features.Enabled(features.ObjectRetentionPolicies) || r.workloadRetention == nil || r.workloadRetention.afterFinished == nil {
+		if !features.Enabled(features.ObjectRetentionPolicies) || r.workloadRetention == nil {
 			return ctrl.Result{}, nil
 		}
+		afterFinished, err := r.workloadRetention.afterFinishedFor(ctx, r.client, &wl)
+		if err != nil || afterFinished == nil {
+			return ctrl.Result{}, err
+		}
 
 		now := r.clock.Now()
-		expirationTime := finishedCond.LastTransitionTime.Add(*r.workloadRetention.afterFinished)
+		expirationTime := finishedCond.LastTransitionTime.Add(*afterFinished)
 		if now.Before(expirationTime) {
 			remainingTime := expirationTime.Sub(now)
 			log.V(3).Info("Requeueing workload for deletion after retention period", "remainingTime", remainingTime)
 			return ctrl.Result{RequeueAfter: remainingTime}, nil
 		}
 
-		log.V(2).Info("Deleting workload because it has finished and the retention period has elapsed", "retention", *r.workloadRetention.afterFinished)
+		if r.workloadRetention.archiver != nil && wl.DeletionTimestamp.IsZero() {
+			if err := r.workloadRetention.archiver.archive(ctx, &wl); err != nil {
+				log.Error(err, "Failed to archive the finished workload")
+				r.recorder.Eventf(&wl, corev1.EventTypeWarning, "ArchiveFailed", "Failed to archive the finished workload: %v", err)
+				return ctrl.Result{}, err
+			}
+		}
+
+		log.V(2).Info("Deleting workload because it has finished and the retention period has elapsed", "retention", *afterFinished)
 		if err := r.client.Delete(ctx, &wl); err != nil {
 			return ctrl.Result{}, client.IgnoreNotFound(err)
 		}
@@ -196,19 +219,13 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	if features.Enabled(features.DynamicResourceAllocation) && workload.Status(&wl) == workload.StatusPending &&
 		workload.HasDRA(&wl) {
 		workload.AdjustResources(ctx, r.client, &wl)
-		if workload.HasResourceClaim(&wl) {
-			log.V(3).Info("Workload is inadmissible because it uses resource claims which is not supported")
-			if workload.UnsetQuotaReservationWithCondition(&wl, kueue.WorkloadInadmissible, "DynamicResourceAllocation feature does not support use of resource claims", r.clock.Now()) {
-				workload.SetRequeuedCondition(&wl, kueue.WorkloadInadmissible, "DRA resource claims not supported", false)
-				if err := workload.ApplyAdmissionStatus(ctx, r.client, &wl, true, r.clock); err != nil {
-					return ctrl.Result{}, fmt.Errorf("failed to update workload status for DRA resource claims error: %w", err)
-				}
-			}
-			return ctrl.Result{}, nil
-		}
 
 		log.V(3).Info("Processing DRA resources for workload")
 		draResources, err := dra.GetResourceRequestsForResourceClaimTemplates(ctx, r.client, &wl)
+		var sharedDRAResources map[kueue.PodSetReference]corev1.ResourceList
+		if err == nil {
+			sharedDRAResources, err = dra.GetResourceRequestsForResourceClaims(ctx, r.client, &wl)
+		}
 		if err != nil {
 			log.Error(err, "Failed to process DRA resources for workload")
 			if workload.UnsetQuotaReservationWithCondition(&wl, kueue.WorkloadInadmissible, err.Error(), r.clock.Now()) {
@@ -241,6 +258,9 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		if len(draResources) > 0 {
 			queueOptions = append(queueOptions, workload.WithPreprocessedDRAResources(draResources))
 		}
+		if len(sharedDRAResources) > 0 {
+			queueOptions = append(queueOptions, workload.WithSharedDRAResources(sharedDRAResources))
+		}
 
 		if workload.IsActive(&wl) && !workload.HasQuotaReservation(&wl) {
 			if err := r.queues.AddOrUpdateWorkload(&wl, queueOptions...); err != nil {
@@ -336,10 +356,16 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 			}
 			return ctrl.Result{}, nil
 		}
+		if features.Enabled(features.WorkloadReactivationPolicy) {
+			recheckAfter, reactivated, err := r.reconcileReactivationPolicy(ctx, &wl)
+			if reactivated || recheckAfter > 0 || err != nil {
+				return ctrl.Result{RequeueAfter: recheckAfter}, client.IgnoreNotFound(err)
+			}
+		}
 	}
 
 	lq := kueue.LocalQueue{}
-	err := r.client.Get(ctx, types.NamespacedName{Namespace: wl.Namespace, Name: string(wl.Spec.QueueName)}, &lq)
+	err := r.client.Get(ctx, types.NamespacedName{Namespace: utilqueue.NamespaceForWorkload(&wl), Name: string(wl.Spec.QueueName)}, &lq)
 	if client.IgnoreNotFound(err) != nil {
 		return ctrl.Result{}, err
 	}
@@ -352,6 +378,7 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		}))
 	}
 
+	var checksRecheckAfter time.Duration
 	cqName, cqOk := r.queues.ClusterQueueForWorkload(&wl)
 	if cqOk {
 		// because we need to react to API cluster cq events, the list of checks from a cache can lead to race conditions
@@ -368,6 +395,13 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		if updated, err := r.reconcileSyncAdmissionChecks(ctx, &wl, &cq); updated || err != nil {
 			return ctrl.Result{}, err
 		}
+		if features.Enabled(features.AdmissionCheckTimeouts) {
+			recheckAfter, updated, err := r.reconcileAdmissionCheckTimeouts(ctx, &wl, &cq)
+			if updated || err != nil {
+				return ctrl.Result{}, client.IgnoreNotFound(err)
+			}
+			checksRecheckAfter = recheckAfter
+		}
 	}
 
 	// If the workload is admitted, updating the status here would set the Admitted condition to
@@ -376,6 +410,12 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		var updated bool
 		if err := workload.PatchAdmissionStatus(ctx, r.client, &wl, r.clock, func() (*kueue.Workload, bool, error) {
 			updated = workload.SyncAdmittedCondition(&wl, r.clock.Now())
+			if features.Enabled(features.WorkloadUnschedulableReasons) && workload.SyncAdmissionCheckUnschedulableReasons(&wl) {
+				updated = true
+			}
+			if features.Enabled(features.AdmissionChecksSummary) && workload.SyncAdmissionChecksSummary(&wl) {
+				updated = true
+			}
 			return &wl, updated, nil
 		}); err != nil {
 			return ctrl.Result{}, client.IgnoreNotFound(err)
@@ -387,6 +427,9 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 			r.recorder.Eventf(&wl, corev1.EventTypeNormal, "Admitted", "Admitted by ClusterQueue %v, wait time since reservation was %.0fs", wl.Status.Admission.ClusterQueue, quotaReservedWaitTime.Seconds())
 			metrics.AdmittedWorkload(cqName, wl.Spec.PriorityClassName, queuedWaitTime)
 			metrics.ReportAdmissionChecksWaitTime(cqName, wl.Spec.PriorityClassName, quotaReservedWaitTime)
+			if sinceCreation, ok := workload.WaitTimeSinceCreation(&wl, r.clock); ok {
+				metrics.ReportTimeToAdmission(cqName, metrics.WorkloadPriorityClassLabel(&wl), sinceCreation)
+			}
 			if features.Enabled(features.LocalQueueMetrics) {
 				metrics.LocalQueueAdmittedWorkload(
 					metrics.LQRefFromWorkload(&wl),
@@ -399,16 +442,30 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 					quotaReservedWaitTime,
 				)
 			}
+			if features.Enabled(features.NamespaceMetrics) {
+				metrics.NamespaceAdmittedWorkload(wl.Namespace, queuedWaitTime)
+			}
 		}
 		if updated {
 			return ctrl.Result{}, nil
 		}
 	}
 
+	sloRecheckAfter, updated, err := r.reconcileAdmissionSLO(ctx, &wl, lqExists, &lq, cqName)
+	if updated || err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
 	if workload.HasQuotaReservation(&wl) {
-		if evictionTriggered, err := r.reconcileCheckBasedEviction(ctx, &wl); evictionTriggered || err != nil {
+		checksGraceRecheckAfter, err := r.continuousChecksGracePeriod(ctx, &wl)
+		if err != nil {
 			return ctrl.Result{}, client.IgnoreNotFound(err)
 		}
+		if checksGraceRecheckAfter == 0 {
+			if evictionTriggered, err := r.reconcileCheckBasedEviction(ctx, &wl); evictionTriggered || err != nil {
+				return ctrl.Result{}, client.IgnoreNotFound(err)
+			}
+		}
 
 		if updated, err := r.reconcileOnLocalQueueActiveState(ctx, &wl, lqExists, &lq); updated || err != nil {
 			return ctrl.Result{}, client.IgnoreNotFound(err)
@@ -427,24 +484,28 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 			return ctrl.Result{}, client.IgnoreNotFound(err)
 		}
 
+		checksProgressRecheckAfter := r.reportAdmissionChecksProgress(&wl)
+
 		// get the minimun non-zero value
-		recheckAfter := min(podsReadyRecheckAfter, maxExecRecheckAfter)
-		if recheckAfter == 0 {
-			recheckAfter = max(podsReadyRecheckAfter, maxExecRecheckAfter)
+		var recheckAfter time.Duration
+		for _, after := range []time.Duration{podsReadyRecheckAfter, maxExecRecheckAfter, checksRecheckAfter, checksGraceRecheckAfter, checksProgressRecheckAfter} {
+			if after > 0 && (recheckAfter == 0 || after < recheckAfter) {
+				recheckAfter = after
+			}
 		}
 		return ctrl.Result{RequeueAfter: recheckAfter}, nil
 	}
 
 	switch {
 	case !lqExists:
-		log.V(3).Info("Workload is inadmissible because of missing LocalQueue", "localQueue", klog.KRef(wl.Namespace, string(wl.Spec.QueueName)))
+		log.V(3).Info("Workload is inadmissible because of missing LocalQueue", "localQueue", klog.KRef(utilqueue.NamespaceForWorkload(&wl), string(wl.Spec.QueueName)))
 		if err := workload.PatchAdmissionStatus(ctx, r.client, &wl, r.clock, func() (*kueue.Workload, bool, error) {
 			return &wl, workload.UnsetQuotaReservationWithCondition(&wl, kueue.WorkloadInadmissible, fmt.Sprintf("LocalQueue %s doesn't exist", wl.Spec.QueueName), r.clock.Now()), nil
 		}); err != nil {
 			return ctrl.Result{}, client.IgnoreNotFound(err)
 		}
 	case !lqActive:
-		log.V(3).Info("Workload is inadmissible because of stopped LocalQueue", "localQueue", klog.KRef(wl.Namespace, string(wl.Spec.QueueName)))
+		log.V(3).Info("Workload is inadmissible because of stopped LocalQueue", "localQueue", klog.KRef(utilqueue.NamespaceForWorkload(&wl), string(wl.Spec.QueueName)))
 		if err := workload.PatchAdmissionStatus(ctx, r.client, &wl, r.clock, func() (*kueue.Workload, bool, error) {
 			return &wl, workload.UnsetQuotaReservationWithCondition(&wl, kueue.WorkloadInadmissible, fmt.Sprintf("LocalQueue %s is inactive", wl.Spec.QueueName), r.clock.Now()), nil
 		}); err != nil {
@@ -466,7 +527,116 @@ func (r *WorkloadReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		}
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: sloRecheckAfter}, nil
+}
+
+// reconcileAdmissionSLO sets the SLOBreached condition of the pending workload
+// once it is pending for longer than its admission target, and sets it to
+// false once the workload reserves quota. Returns the time after which the
+// target of the pending workload is exceeded, and whether the workload was
+// updated.
+func (r *WorkloadReconciler) reconcileAdmissionSLO(ctx context.Context, wl *kueue.Workload, lqExists bool, lq *kueue.LocalQueue, cqName kueue.ClusterQueueReference) (time.Duration, bool, error) {
+	if !features.Enabled(features.WorkloadAdmissionSLO) || !workload.IsActive(wl) {
+		return 0, false, nil
+	}
+	breached := apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadSLOBreached)
+	if workload.HasQuotaReservation(wl) {
+		if !breached {
+			return 0, false, nil
+		}
+		return 0, true, workload.UpdateStatus(ctx, r.client, wl, kueue.WorkloadSLOBreached, metav1.ConditionFalse,
+			kueue.WorkloadQuotaReserved, "The workload reserved quota", constants.WorkloadControllerName, r.clock)
+	}
+	if breached {
+		return 0, false, nil
+	}
+	if !lqExists {
+		lq = nil
+	}
+	target, err := workload.AdmissionTarget(wl, lq)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).V(2).Info("Ignoring the admission target of the workload", "error", err)
+		return 0, false, nil
+	}
+	if target == nil {
+		return 0, false, nil
+	}
+	if remaining := *target - workload.QueuedWaitTime(wl, r.clock); remaining > 0 {
+		return remaining, false, nil
+	}
+	message := fmt.Sprintf("The workload is pending for longer than its admission target of %s", target)
+	if err := workload.UpdateStatus(ctx, r.client, wl, kueue.WorkloadSLOBreached, metav1.ConditionTrue,
+		kueue.WorkloadAdmissionTargetExceeded, message, constants.WorkloadControllerName, r.clock); err != nil {
+		return 0, false, err
+	}
+	r.recorder.Event(wl, corev1.EventTypeWarning, kueue.WorkloadSLOBreached, message)
+	metrics.ReportAdmissionSLOBreached(cqName, wl.Spec.PriorityClassName)
+	return 0, true, nil
+}
+
+// reconcileReactivationPolicy reactivates the workload deactivated after
+// exceeding its requeuing limit once the cool-down of the reactivationPolicy
+// of its ClusterQueue elapsed. Returns the remaining cool-down, and whether
+// the workload was reactivated.
+func (r *WorkloadReconciler) reconcileReactivationPolicy(ctx context.Context, wl *kueue.Workload) (time.Duration, bool, error) {
+	evictedCond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadEvicted)
+	if evictedCond == nil || evictedCond.Status != metav1.ConditionTrue ||
+		evictedCond.Reason != workload.ReasonWithCause(kueue.WorkloadDeactivated, kueue.WorkloadRequeuingLimitExceeded) {
+		return 0, false, nil
+	}
+	cqName, ok := r.queues.ClusterQueueForWorkload(wl)
+	if !ok {
+		return 0, false, nil
+	}
+	cq := kueue.ClusterQueue{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: string(cqName)}, &cq); err != nil {
+		return 0, false, client.IgnoreNotFound(err)
+	}
+	policy := cq.Spec.ReactivationPolicy
+	if policy == nil {
+		return 0, false, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	reactivations := 0
+	if value, found := wl.Annotations[controllerconsts.ReactivationCountAnnotation]; found {
+		count, err := strconv.Atoi(value)
+		if err != nil || count < 0 {
+			log.V(2).Info("Ignoring the invalid reactivation count of the workload", "reactivationCount", value)
+		} else {
+			reactivations = count
+		}
+	}
+	if reactivations >= int(ptr.Deref(policy.LimitCount, defaultReactivationLimitCount)) {
+		return 0, false, nil
+	}
+	cooldown := reactivationCooldown(policy, reactivations)
+	if remaining := evictedCond.LastTransitionTime.Add(cooldown).Sub(r.clock.Now()); remaining > 0 {
+		log.V(4).Info("Workload deactivated and within the cool-down of its reactivation policy", "recheckAfter", remaining)
+		return remaining, false, nil
+	}
+	log.V(2).Info("Reactivating the workload after the cool-down of its reactivation policy", "cooldown", cooldown, "reactivationCount", reactivations+1)
+	wl.Spec.Active = ptr.To(true)
+	metav1.SetMetaDataAnnotation(&wl.ObjectMeta, controllerconsts.ReactivationCountAnnotation, strconv.Itoa(reactivations+1))
+	if err := r.client.Update(ctx, wl); err != nil {
+		return 0, false, err
+	}
+	r.recorder.Eventf(wl, corev1.EventTypeNormal, "Reactivated", "Reactivated by the reactivation policy of ClusterQueue %s after a cool-down of %s", cqName, cooldown)
+	return 0, true, nil
+}
+
+// reactivationCooldown returns the cool-down after which a workload already
+// reactivated the given number of times is reactivated again.
+func reactivationCooldown(policy *kueue.ReactivationPolicy, reactivations int) time.Duration {
+	base := time.Duration(ptr.Deref(policy.CooldownSeconds, defaultReactivationCooldownSeconds)) * time.Second
+	maxCooldown := time.Duration(ptr.Deref(policy.MaxCooldownSeconds, defaultReactivationMaxCooldownSeconds)) * time.Second
+	cooldown := base
+	for range reactivations {
+		if cooldown >= maxCooldown {
+			break
+		}
+		cooldown *= 2
+	}
+	return min(cooldown, maxCooldown)
 }
 
 // isDisabledRequeuedByClusterQueueStopped returns true if the workload is unset requeued by cluster queue stopped.
@@ -514,6 +684,112 @@ func (r *WorkloadReconciler) reconcileMaxExecutionTime(ctx context.Context, wl *
 	return 0, nil
 }
 
+// reportAdmissionChecksProgress records an event with the summary of the
+// admission checks on the workload with quota reserved which is blocked on them,
+// and on its job. Returns the time after which the progress is reported again.
+// The events repeated by other reconciles are aggregated by the recorder.
+func (r *WorkloadReconciler) reportAdmissionChecksProgress(wl *kueue.Workload) time.Duration {
+	if !features.Enabled(features.AdmissionChecksSummary) || workload.IsAdmitted(wl) || workload.HasAllChecksReady(wl) {
+		return 0
+	}
+	message := fmt.Sprintf("Waiting for the admission checks: %s", workload.AdmissionChecksSummary(wl))
+	r.recorder.Event(wl, corev1.EventTypeNormal, "AdmissionChecksPending", message)
+	if owner := metav1.GetControllerOf(wl); owner != nil {
+		job := &metav1.PartialObjectMetadata{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: owner.APIVersion,
+				Kind:       owner.Kind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      owner.Name,
+				Namespace: wl.Namespace,
+				UID:       owner.UID,
+			},
+		}
+		r.recorder.Event(job, corev1.EventTypeNormal, "AdmissionChecksPending", message)
+	}
+	return admissionChecksProgressInterval
+}
+
+// reconcileAdmissionCheckTimeouts applies the timeoutPolicy of the admission checks
+// that stayed Pending for longer than their timeout since the quota was reserved.
+// Returns the time after which the next admission check times out, and true if the
+// Workload was updated.
+func (r *WorkloadReconciler) reconcileAdmissionCheckTimeouts(ctx context.Context, wl *kueue.Workload, cq *kueue.ClusterQueue) (time.Duration, bool, error) {
+	if cq.Spec.AdmissionChecksStrategy == nil || !workload.HasQuotaReservation(wl) || workload.IsAdmitted(wl) {
+		return 0, false, nil
+	}
+	quotaReservedCondition := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadQuotaReserved)
+	var recheckAfter time.Duration
+	var timedOut []kueue.AdmissionCheckStrategyRule
+	for _, rule := range cq.Spec.AdmissionChecksStrategy.AdmissionChecks {
+		if rule.Timeout == nil {
+			continue
+		}
+		state := admissioncheck.FindAdmissionCheck(wl.Status.AdmissionChecks, rule.Name)
+		if state == nil || state.State != kueue.CheckStatePending {
+			continue
+		}
+		pendingSince := state.LastTransitionTime.Time
+		if quotaReservedCondition.LastTransitionTime.After(pendingSince) {
+			pendingSince = quotaReservedCondition.LastTransitionTime.Time
+		}
+		remaining := rule.Timeout.Duration - r.clock.Since(pendingSince)
+		if remaining > 0 {
+			if recheckAfter == 0 || remaining < recheckAfter {
+				recheckAfter = remaining
+			}
+			continue
+		}
+		timedOut = append(timedOut, rule)
+	}
+	if len(timedOut) == 0 {
+		return recheckAfter, false, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	newStates := make([]kueue.AdmissionCheckState, 0, len(timedOut))
+	for _, rule := range timedOut {
+		newState := kueue.AdmissionCheckState{
+			Name:    rule.Name,
+			Message: fmt.Sprintf("The admission check didn't complete within %s", rule.Timeout.Duration),
+		}
+		switch ptr.Deref(rule.TimeoutPolicy, kueue.AdmissionCheckTimeoutRetry) {
+		case kueue.AdmissionCheckTimeoutReject:
+			newState.State = kueue.CheckStateRejected
+		case kueue.AdmissionCheckTimeoutSkip:
+			newState.State = kueue.CheckStateReady
+			newState.Message = fmt.Sprintf("The admission check was skipped after not completing within %s", rule.Timeout.Duration)
+		default:
+			newState.State = kueue.CheckStateRetry
+			// The workload is retried on other flavors, until the exclusion
+			// of the timed out flavors expires after the timeout.
+			workload.ExcludeFlavors(wl, admittedFlavors(wl.Status.Admission), metav1.NewTime(r.clock.Now().Add(rule.Timeout.Duration)))
+		}
+		log.V(3).Info("Admission check timed out", "admissionCheck", rule.Name, "timeout", rule.Timeout.Duration, "newState", newState.State)
+		workload.SetAdmissionCheckState(&wl.Status.AdmissionChecks, newState, r.clock)
+		newStates = append(newStates, newState)
+	}
+	if err := r.client.Status().Update(ctx, wl); err != nil {
+		return 0, true, err
+	}
+	for _, state := range newStates {
+		r.recorder.Eventf(wl, corev1.EventTypeWarning, "AdmissionCheckTimeout", "AdmissionCheck %v timed out and was set to %v", state.Name, state.State)
+	}
+	return 0, true, nil
+}
+
+// admittedFlavors returns the flavors assigned to the podSets in the admission.
+func admittedFlavors(admission *kueue.Admission) []kueue.ResourceFlavorReference {
+	flavors := sets.New[kueue.ResourceFlavorReference]()
+	for _, psa := range admission.PodSetAssignments {
+		for _, flavor := range psa.Flavors {
+			flavors.Insert(flavor)
+		}
+	}
+	return sets.List(flavors)
+}
+
 // reconcileCheckBasedEviction evicts or deactivates the given Workload if any admission checks have failed.
 // Returns true if the Workload was rejected or deactivated, and false otherwise.
 func (r *WorkloadReconciler) reconcileCheckBasedEviction(ctx context.Context, wl *kueue.Workload) (bool, error) {
@@ -522,9 +798,17 @@ func (r *WorkloadReconciler) reconcileCheckBasedEviction(ctx context.Context, wl
 	}
 	log := ctrl.LoggerFrom(ctx)
 	log.V(3).Info("Workload is evicted due to admission checks")
-	if workload.HasRejectedChecks(wl) {
+	rejectedChecks := workload.RejectedChecks(wl)
+	if workload.IsAdmitted(wl) {
+		deactivatedChecks, err := r.continuousChecksToDeactivate(ctx, wl)
+		if err != nil {
+			return false, err
+		}
+		rejectedChecks = append(rejectedChecks, deactivatedChecks...)
+	}
+	if len(rejectedChecks) > 0 {
 		var rejectedCheckNames []kueue.AdmissionCheckReference
-		for _, check := range workload.RejectedChecks(wl) {
+		for _, check := range rejectedChecks {
 			rejectedCheckNames = append(rejectedCheckNames, check.Name)
 		}
 		err := workload.PatchAdmissionStatus(ctx, r.client, wl, r.clock, func() (*kueue.Workload, bool, error) {
@@ -534,8 +818,8 @@ func (r *WorkloadReconciler) reconcileCheckBasedEviction(ctx context.Context, wl
 			return false, err
 		}
 		log.V(3).Info("Workload is evicted due to rejected admission checks", "workload", klog.KObj(wl), "rejectedChecks", rejectedCheckNames)
-		rejectedCheck := workload.RejectedChecks(wl)[0]
-		r.recorder.Eventf(wl, corev1.EventTypeWarning, "AdmissionCheckRejected", "Deactivating workload because AdmissionCheck for %v was Rejected: %s", rejectedCheck.Name, rejectedCheck.Message)
+		rejectedCheck := rejectedChecks[0]
+		r.recorder.Eventf(wl, corev1.EventTypeWarning, "AdmissionCheckRejected", "Deactivating workload because AdmissionCheck for %v was %v: %s", rejectedCheck.Name, rejectedCheck.State, rejectedCheck.Message)
 		return true, nil
 	}
 	// at this point we know a Workload has at least one Retry AdmissionCheck
@@ -546,9 +830,65 @@ func (r *WorkloadReconciler) reconcileCheckBasedEviction(ctx context.Context, wl
 	return true, nil
 }
 
+// continuousChecksGracePeriod returns the time until the end of the grace period
+// of the failed continuous admission checks of the admitted workload, or 0 if
+// the workload should be evicted.
+func (r *WorkloadReconciler) continuousChecksGracePeriod(ctx context.Context, wl *kueue.Workload) (time.Duration, error) {
+	if !features.Enabled(features.ContinuousAdmissionChecks) || !workload.IsAdmitted(wl) || workload.IsEvicted(wl) {
+		return 0, nil
+	}
+	var recheckAfter time.Duration
+	for _, state := range wl.Status.AdmissionChecks {
+		if state.State != kueue.CheckStateRetry && state.State != kueue.CheckStateRejected {
+			continue
+		}
+		continuous, err := admissioncheck.ContinuousEvaluation(ctx, r.client, state.Name)
+		if err != nil {
+			return 0, err
+		}
+		if continuous == nil || continuous.GracePeriod == nil {
+			return 0, nil
+		}
+		remaining := state.LastTransitionTime.Add(continuous.GracePeriod.Duration).Sub(r.clock.Now())
+		if remaining <= 0 {
+			return 0, nil
+		}
+		if recheckAfter == 0 || remaining < recheckAfter {
+			recheckAfter = remaining
+		}
+	}
+	return recheckAfter, nil
+}
+
+// continuousChecksToDeactivate returns the Retry continuous admission checks
+// of the admitted workload with the Deactivate eviction policy.
+func (r *WorkloadReconciler) continuousChecksToDeactivate(ctx context.Context, wl *kueue.Workload) ([]kueue.AdmissionCheckState, error) {
+	var checks []kueue.AdmissionCheckState
+	for _, state := range wl.Status.AdmissionChecks {
+		if state.State != kueue.CheckStateRetry {
+			continue
+		}
+		continuous, err := admissioncheck.ContinuousEvaluation(ctx, r.client, state.Name)
+		if err != nil {
+			return nil, err
+		}
+		if continuous != nil && ptr.Deref(continuous.EvictionPolicy, kueue.ContinuousAdmissionCheckEvictionPolicyRequeue) == kueue.ContinuousAdmissionCheckEvictionPolicyDeactivate {
+			checks = append(checks, state)
+		}
+	}
+	return checks, nil
+}
+
 func (r *WorkloadReconciler) reconcileSyncAdmissionChecks(ctx context.Context, wl *kueue.Workload, cq *kueue.ClusterQueue) (bool, error) {
 	log := ctrl.LoggerFrom(ctx)
-	admissionChecks := workload.AdmissionChecksForWorkload(log, wl, admissioncheck.NewAdmissionChecks(cq))
+	checksFlavors := admissioncheck.NewAdmissionChecks(cq)
+	admissionChecks := workload.AdmissionChecksForWorkload(log, wl, checksFlavors)
+	if features.Enabled(features.AdmissionCheckPodSets) {
+		admissionChecks = workload.ChecksForPodSets(wl, admissionChecks, admissioncheck.NewAdmissionCheckPodSets(cq), checksFlavors)
+	}
+	if features.Enabled(features.AdmissionCheckDependencies) && !workload.IsAdmitted(wl) {
+		admissionChecks = workload.ChecksWithReadyDependencies(wl, admissionChecks, admissioncheck.NewAdmissionCheckDependencies(cq))
+	}
 	newChecks, shouldUpdate := syncAdmissionCheckConditions(wl.Status.AdmissionChecks, admissionChecks, r.clock)
 	if shouldUpdate {
 		log.V(3).Info("The workload needs admission checks updates", "clusterQueue", klog.KRef("", cq.Name), "admissionChecks", admissionChecks)
@@ -572,7 +912,7 @@ func (r *WorkloadReconciler) reconcileOnLocalQueueActiveState(ctx context.Contex
 			log.V(3).Info("Workload is already evicted.")
 			return false, nil
 		}
-		log.V(3).Info("Workload is evicted because the LocalQueue is stopped", "localQueue", klog.KRef(wl.Namespace, string(wl.Spec.QueueName)))
+		log.V(3).Info("Workload is evicted because the LocalQueue is stopped", "localQueue", klog.KRef(utilqueue.NamespaceForWorkload(wl), string(wl.Spec.QueueName)))
 		err := workload.Evict(ctx, r.client, r.recorder, wl, kueue.WorkloadEvictedByLocalQueueStopped, "The LocalQueue is stopped", "", r.clock)
 		return true, err
 	}
@@ -706,8 +1046,12 @@ func (r *WorkloadReconciler) triggerDeactivationOrBackoffRequeue(ctx context.Con
 	if wl.Status.RequeueState == nil {
 		wl.Status.RequeueState = &kueue.RequeueState{}
 	}
+	backoff, err := r.requeuingBackoffFor(ctx, wl)
+	if err != nil {
+		return false, err
+	}
 	// If requeuingBackoffLimitCount equals to null, the workloads is repeatedly and endless re-queued.
-	if r.waitForPodsReady.requeuingBackoffLimitCount != nil && ptr.Deref(wl.Status.RequeueState.Count, 0)+1 > *r.waitForPodsReady.requeuingBackoffLimitCount {
+	if backoff.limitCount != nil && ptr.Deref(wl.Status.RequeueState.Count, 0)+1 > *backoff.limitCount {
 		if err := workload.PatchAdmissionStatus(ctx, r.client, wl, r.clock, func() (*kueue.Workload, bool, error) {
 			return wl, workload.SetDeactivationTarget(wl, kueue.WorkloadRequeuingLimitExceeded, "exceeding the maximum number of re-queuing retries"), nil
 		}); err != nil {
@@ -715,10 +1059,50 @@ func (r *WorkloadReconciler) triggerDeactivationOrBackoffRequeue(ctx context.Con
 		}
 		return true, nil
 	}
-	workload.UpdateRequeueState(wl, r.waitForPodsReady.requeuingBackoffBaseSeconds, int32(r.waitForPodsReady.requeuingBackoffMaxDuration.Seconds()), r.clock)
+	workload.UpdateRequeueStateWithJitter(wl, backoff.baseSeconds, backoff.maxSeconds, backoff.jitter, r.clock)
 	return false, nil
 }
 
+// requeuingBackoff is the backoff of the workloads evicted due to the PodsReady timeout.
+type requeuingBackoff struct {
+	limitCount  *int32
+	baseSeconds int32
+	maxSeconds  int32
+	jitter      float64
+}
+
+// requeuingBackoffFor returns the requeuing backoff of the workload, the one of
+// the waitForPodsReady configuration overridden by the evictionBackoff of the
+// ClusterQueue of the workload.
+func (r *WorkloadReconciler) requeuingBackoffFor(ctx context.Context, wl *kueue.Workload) (requeuingBackoff, error) {
+	backoff := requeuingBackoff{
+		limitCount:  r.waitForPodsReady.requeuingBackoffLimitCount,
+		baseSeconds: r.waitForPodsReady.requeuingBackoffBaseSeconds,
+		maxSeconds:  int32(r.waitForPodsReady.requeuingBackoffMaxDuration.Seconds()),
+		jitter:      r.waitForPodsReady.requeuingBackoffJitter,
+	}
+	if !features.Enabled(features.ClusterQueueEvictionBackoff) || wl.Status.Admission == nil {
+		return backoff, nil
+	}
+	cq := kueue.ClusterQueue{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: string(wl.Status.Admission.ClusterQueue)}, &cq); err != nil {
+		return backoff, client.IgnoreNotFound(err)
+	}
+	cqBackoff := cq.Spec.EvictionBackoff
+	if cqBackoff == nil {
+		return backoff, nil
+	}
+	if cqBackoff.BackoffLimitCount != nil {
+		backoff.limitCount = cqBackoff.BackoffLimitCount
+	}
+	backoff.baseSeconds = ptr.Deref(cqBackoff.BackoffBaseSeconds, backoff.baseSeconds)
+	backoff.maxSeconds = ptr.Deref(cqBackoff.BackoffMaxSeconds, backoff.maxSeconds)
+	if cqBackoff.JitterPercent != nil {
+		backoff.jitter = float64(*cqBackoff.JitterPercent) / 100
+	}
+	return backoff, nil
+}
+
 func (r *WorkloadReconciler) Create(e event.TypedCreateEvent[*kueue.Workload]) bool {
 	defer r.notifyWatchers(nil, e.Object)
 	status := workload.Status(e.Object)
@@ -1163,6 +1547,13 @@ func (w *workloadQueueHandler) queueReconcileForWorkloadsOfLocalQueue(ctx contex
 	if err != nil {
 		log.Error(err, "Could not list cluster queues workloads")
 	}
+	if features.Enabled(features.CrossNamespaceBilling) {
+		billedLst := kueue.WorkloadList{}
+		if err := w.r.client.List(ctx, &billedLst, client.MatchingFields{indexer.WorkloadBillingQueueKey: string(utilqueue.Key(lq))}); err != nil {
+			log.Error(err, "Could not list the workloads billed to the local queue")
+		}
+		lst.Items = append(lst.Items, billedLst.Items...)
+	}
 	for _, wl := range lst.Items {
 		log := log.WithValues("workload", klog.KObj(&wl))
 		req := reconcile.Request{
@@ -42,6 +42,7 @@ import (
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/dra"
 	"sigs.k8s.io/kueue/pkg/features"
 	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
@@ -403,7 +404,15 @@ func TestReconcile(t *testing.T) {
 	cases := map[string]struct {
 		enableObjectRetentionPolicies bool
 		enableDRAFeature              bool
+		enableACDependencies          bool
+		enableACTimeouts              bool
+		enableContinuousACs           bool
+		enableACsSummary              bool
+		enableCQEvictionBackoff       bool
+		enableAdmissionSLO            bool
+		enableReactivationPolicy      bool
 
+		admissionChecks           []*kueue.AdmissionCheck
 		workload                  *kueue.Workload
 		cq                        *kueue.ClusterQueue
 		lq                        *kueue.LocalQueue
@@ -418,11 +427,251 @@ func TestReconcile(t *testing.T) {
 		wantResult                reconcile.Result
 		reconcilerOpts            []Option
 	}{
-		"reconcile DRA ResourceClaim should be rejected as inadmissible": {
-			enableDRAFeature: true,
+		"deactivated workload within the cool-down of the reactivation policy of its ClusterQueue is requeued at the end of the cool-down": {
+			enableReactivationPolicy: true,
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				Active(false).
+				Annotation(controllerconsts.ReactivationCountAnnotation, "1").
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadEvicted,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-15 * time.Minute)),
+					Reason:             "DeactivatedDueToRequeuingLimitExceeded",
+					Message:            "The workload is deactivated due to exceeding the maximum number of re-queuing retries",
+				}).
+				Obj(),
+			cq: utiltesting.MakeClusterQueue("cq").
+				ReactivationPolicy(&kueue.ReactivationPolicy{
+					CooldownSeconds:    ptr.To[int32](600),
+					MaxCooldownSeconds: ptr.To[int32](1800),
+					LimitCount:         ptr.To[int32](3),
+				}).
+				Obj(),
+			lq: utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				Active(false).
+				Annotation(controllerconsts.ReactivationCountAnnotation, "1").
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadEvicted,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-15 * time.Minute)),
+					Reason:             "DeactivatedDueToRequeuingLimitExceeded",
+					Message:            "The workload is deactivated due to exceeding the maximum number of re-queuing retries",
+				}).
+				Obj(),
+			wantResult: reconcile.Result{RequeueAfter: 5 * time.Minute},
+		},
+		"deactivated workload is reactivated at the end of the cool-down of the reactivation policy of its ClusterQueue": {
+			enableReactivationPolicy: true,
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				Active(false).
+				Annotation(controllerconsts.ReactivationCountAnnotation, "2").
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadEvicted,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-time.Hour)),
+					Reason:             "DeactivatedDueToRequeuingLimitExceeded",
+					Message:            "The workload is deactivated due to exceeding the maximum number of re-queuing retries",
+				}).
+				Obj(),
+			cq: utiltesting.MakeClusterQueue("cq").
+				ReactivationPolicy(&kueue.ReactivationPolicy{
+					CooldownSeconds:    ptr.To[int32](600),
+					MaxCooldownSeconds: ptr.To[int32](1800),
+					LimitCount:         ptr.To[int32](3),
+				}).
+				Obj(),
+			lq: utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				Active(false).
+				Annotation(controllerconsts.ReactivationCountAnnotation, "3").
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadEvicted,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-time.Hour)),
+					Reason:             "DeactivatedDueToRequeuingLimitExceeded",
+					Message:            "The workload is deactivated due to exceeding the maximum number of re-queuing retries",
+				}).
+				Active(true).
+				Obj(),
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Namespace: "ns", Name: "wl"},
+					EventType: "Normal",
+					Reason:    "Reactivated",
+					Message:   "Reactivated by the reactivation policy of ClusterQueue cq after a cool-down of 30m0s",
+				},
+			},
+		},
+		"deactivated workload is not reactivated once the limit of the reactivation policy of its ClusterQueue is reached": {
+			enableReactivationPolicy: true,
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				Active(false).
+				Annotation(controllerconsts.ReactivationCountAnnotation, "3").
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadEvicted,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-time.Hour)),
+					Reason:             "DeactivatedDueToRequeuingLimitExceeded",
+					Message:            "The workload is deactivated due to exceeding the maximum number of re-queuing retries",
+				}).
+				Obj(),
+			cq: utiltesting.MakeClusterQueue("cq").
+				ReactivationPolicy(&kueue.ReactivationPolicy{
+					CooldownSeconds:    ptr.To[int32](600),
+					MaxCooldownSeconds: ptr.To[int32](1800),
+					LimitCount:         ptr.To[int32](3),
+				}).
+				Obj(),
+			lq: utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				Active(false).
+				Annotation(controllerconsts.ReactivationCountAnnotation, "3").
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadEvicted,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-time.Hour)),
+					Reason:             "DeactivatedDueToRequeuingLimitExceeded",
+					Message:            "The workload is deactivated due to exceeding the maximum number of re-queuing retries",
+				}).
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadQuotaReserved,
+					Status:  metav1.ConditionFalse,
+					Reason:  kueue.WorkloadInadmissible,
+					Message: "ClusterQueue cq is inactive",
+				}).
+				Obj(),
+		},
+		"deactivated workload is not reactivated when the WorkloadReactivationPolicy feature gate is disabled": {
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				Active(false).
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadEvicted,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-time.Hour)),
+					Reason:             "DeactivatedDueToRequeuingLimitExceeded",
+					Message:            "The workload is deactivated due to exceeding the maximum number of re-queuing retries",
+				}).
+				Obj(),
+			cq: utiltesting.MakeClusterQueue("cq").
+				ReactivationPolicy(&kueue.ReactivationPolicy{
+					CooldownSeconds:    ptr.To[int32](600),
+					MaxCooldownSeconds: ptr.To[int32](1800),
+					LimitCount:         ptr.To[int32](3),
+				}).
+				Obj(),
+			lq: utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				Active(false).
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadEvicted,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-time.Hour)),
+					Reason:             "DeactivatedDueToRequeuingLimitExceeded",
+					Message:            "The workload is deactivated due to exceeding the maximum number of re-queuing retries",
+				}).
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadQuotaReserved,
+					Status:  metav1.ConditionFalse,
+					Reason:  kueue.WorkloadInadmissible,
+					Message: "ClusterQueue cq is inactive",
+				}).
+				Obj(),
+		},
+		"pending workload within the admission target of its LocalQueue is requeued at the target": {
+			enableAdmissionSLO: true,
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				Creation(testStartTime.Add(-10 * time.Minute)).
+				Obj(),
+			cq: utiltesting.MakeClusterQueue("cq").Obj(),
+			lq: utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").AdmissionTarget(30 * time.Minute).Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				Creation(testStartTime.Add(-10 * time.Minute)).
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadQuotaReserved,
+					Status:  metav1.ConditionFalse,
+					Reason:  kueue.WorkloadInadmissible,
+					Message: "ClusterQueue cq is inactive",
+				}).
+				Obj(),
+			wantResult: reconcile.Result{RequeueAfter: 20 * time.Minute},
+		},
+		"pending workload exceeding its admission target gets the SLOBreached condition": {
+			enableAdmissionSLO: true,
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				Annotation(controllerconsts.AdmissionTargetAnnotation, "5m").
+				Creation(testStartTime.Add(-10 * time.Minute)).
+				Obj(),
+			cq: utiltesting.MakeClusterQueue("cq").Obj(),
+			lq: utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").AdmissionTarget(30 * time.Minute).Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				Annotation(controllerconsts.AdmissionTargetAnnotation, "5m").
+				Creation(testStartTime.Add(-10 * time.Minute)).
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadSLOBreached,
+					Status:  metav1.ConditionTrue,
+					Reason:  kueue.WorkloadAdmissionTargetExceeded,
+					Message: "The workload is pending for longer than its admission target of 5m0s",
+				}).
+				Obj(),
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Namespace: "ns", Name: "wl"},
+					EventType: "Warning",
+					Reason:    kueue.WorkloadSLOBreached,
+					Message:   "The workload is pending for longer than its admission target of 5m0s",
+				},
+			},
+		},
+		"workload reserving quota after breaching its admission target gets the SLOBreached condition set to false": {
+			enableAdmissionSLO: true,
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				Annotation(controllerconsts.AdmissionTargetAnnotation, "5m").
+				Creation(testStartTime.Add(-10 * time.Minute)).
+				ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+				Admitted(true).
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadSLOBreached,
+					Status:  metav1.ConditionTrue,
+					Reason:  kueue.WorkloadAdmissionTargetExceeded,
+					Message: "The workload is pending for longer than its admission target of 5m0s",
+				}).
+				Obj(),
+			lq: utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				Annotation(controllerconsts.AdmissionTargetAnnotation, "5m").
+				Creation(testStartTime.Add(-10 * time.Minute)).
+				ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+				Admitted(true).
+				Condition(metav1.Condition{
+					Type:    kueue.WorkloadSLOBreached,
+					Status:  metav1.ConditionFalse,
+					Reason:  kueue.WorkloadQuotaReserved,
+					Message: "The workload reserved quota",
+				}).
+				Obj(),
+		},
+		"reconcile DRA ResourceClaim shared by the pods should be pre-processed and queued": {
+			enableDRAFeature:     true,
+			wantDRAResourceTotal: ptr.To(int64(1)),
+			wantWorkloadsInQueue: ptr.To(1),
 			workload: utiltesting.MakeWorkload("wlWithDRAResourceClaim", "ns").
 				Queue("lq").
-				PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).
+				PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 3).
 					ResourceClaim("gpu", "rc1").
 					Obj()).
 				Obj(),
@@ -443,25 +692,19 @@ func TestReconcile(t *testing.T) {
 			cq: utiltesting.MakeClusterQueue("cq").
 				ResourceGroup(
 					*utiltesting.MakeFlavorQuotas("flavor1").
-						Resource("gpus", "2").Obj(),
+						Resource("gpu", "2").Obj(),
 				).Obj(),
 			lq: utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(),
 			wantWorkload: utiltesting.MakeWorkload("wlWithDRAResourceClaim", "ns").
 				Queue("lq").
-				PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).
+				PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 3).
 					ResourceClaim("gpu", "rc1").
 					Obj()).
 				Condition(metav1.Condition{
 					Type:    kueue.WorkloadQuotaReserved,
 					Status:  metav1.ConditionFalse,
 					Reason:  kueue.WorkloadInadmissible,
-					Message: "DynamicResourceAllocation feature does not support use of resource claims",
-				}).
-				Condition(metav1.Condition{
-					Type:    kueue.WorkloadRequeued,
-					Status:  metav1.ConditionFalse,
-					Reason:  kueue.WorkloadInadmissible,
-					Message: "DRA resource claims not supported",
+					Message: "ClusterQueue cq is inactive",
 				}).
 				Obj(),
 			wantEvents: nil,
@@ -676,18 +919,236 @@ func TestReconcile(t *testing.T) {
 					PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
 						Assignment("cpu", "flavor1", "1").
 						Obj()).
-					Obj()).
+					Obj()).
+				Queue("queue").
+				AdmissionChecks(
+					kueue.AdmissionCheckState{
+						Name:  "ac1",
+						State: kueue.CheckStatePending,
+					},
+					kueue.AdmissionCheckState{
+						Name:  "ac2",
+						State: kueue.CheckStatePending,
+					}).
+				Obj(),
+		},
+		"assign only the Admission Checks with Ready dependencies": {
+			enableACDependencies: true,
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("cq").
+					PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment("cpu", "flavor1", "1").
+						Obj()).
+					Obj()).
+				Queue("queue").
+				Obj(),
+			cq: utiltesting.MakeClusterQueue("cq").
+				AdmissionCheckStrategy(
+					*utiltesting.MakeAdmissionCheckStrategyRule("budget").Obj(),
+					*utiltesting.MakeAdmissionCheckStrategyRule("provisioning").DependsOn("budget").Obj()).
+				Obj(),
+			lq: utiltesting.MakeLocalQueue("queue", "ns").ClusterQueue("cq").Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("cq").
+					PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment("cpu", "flavor1", "1").
+						Obj()).
+					Obj()).
+				Queue("queue").
+				AdmissionChecks(
+					kueue.AdmissionCheckState{
+						Name:  "budget",
+						State: kueue.CheckStatePending,
+					}).
+				Obj(),
+		},
+		"assign the dependent Admission Check once its dependency is Ready": {
+			enableACDependencies: true,
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("cq").
+					PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment("cpu", "flavor1", "1").
+						Obj()).
+					Obj()).
+				Queue("queue").
+				AdmissionChecks(
+					kueue.AdmissionCheckState{
+						Name:  "budget",
+						State: kueue.CheckStateReady,
+					}).
+				Obj(),
+			cq: utiltesting.MakeClusterQueue("cq").
+				AdmissionCheckStrategy(
+					*utiltesting.MakeAdmissionCheckStrategyRule("budget").Obj(),
+					*utiltesting.MakeAdmissionCheckStrategyRule("provisioning").DependsOn("budget").Obj()).
+				Obj(),
+			lq: utiltesting.MakeLocalQueue("queue", "ns").ClusterQueue("cq").Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("cq").
+					PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment("cpu", "flavor1", "1").
+						Obj()).
+					Obj()).
+				Queue("queue").
+				AdmissionChecks(
+					kueue.AdmissionCheckState{
+						Name:  "budget",
+						State: kueue.CheckStateReady,
+					},
+					kueue.AdmissionCheckState{
+						Name:  "provisioning",
+						State: kueue.CheckStatePending,
+					}).
+				Obj(),
+		},
+		"timed out Admission Check is rejected": {
+			enableACTimeouts: true,
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuotaAt(utiltesting.MakeAdmission("cq").
+					PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment("cpu", "flavor1", "1").
+						Obj()).
+					Obj(), testStartTime.Add(-time.Hour)).
+				Queue("queue").
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:               "ac1",
+					State:              kueue.CheckStatePending,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-2 * time.Hour)),
+				}).
+				Obj(),
+			cq: utiltesting.MakeClusterQueue("cq").
+				AdmissionCheckStrategy(
+					*utiltesting.MakeAdmissionCheckStrategyRule("ac1").Timeout(10*time.Minute, kueue.AdmissionCheckTimeoutReject).Obj()).
+				Obj(),
+			lq: utiltesting.MakeLocalQueue("queue", "ns").ClusterQueue("cq").Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("cq").
+					PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment("cpu", "flavor1", "1").
+						Obj()).
+					Obj()).
+				Queue("queue").
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:    "ac1",
+					State:   kueue.CheckStateRejected,
+					Message: "The admission check didn't complete within 10m0s",
+				}).
+				Obj(),
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Namespace: "ns", Name: "wl"},
+					EventType: "Warning",
+					Reason:    "AdmissionCheckTimeout",
+					Message:   "AdmissionCheck ac1 timed out and was set to Rejected",
+				},
+			},
+		},
+		"timed out Admission Check is skipped": {
+			enableACTimeouts: true,
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuotaAt(utiltesting.MakeAdmission("cq").
+					PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment("cpu", "flavor1", "1").
+						Obj()).
+					Obj(), testStartTime.Add(-time.Hour)).
+				Queue("queue").
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:               "ac1",
+					State:              kueue.CheckStatePending,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-2 * time.Hour)),
+				}).
+				Obj(),
+			cq: utiltesting.MakeClusterQueue("cq").
+				AdmissionCheckStrategy(
+					*utiltesting.MakeAdmissionCheckStrategyRule("ac1").Timeout(10*time.Minute, kueue.AdmissionCheckTimeoutSkip).Obj()).
+				Obj(),
+			lq: utiltesting.MakeLocalQueue("queue", "ns").ClusterQueue("cq").Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("cq").
+					PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment("cpu", "flavor1", "1").
+						Obj()).
+					Obj()).
+				Queue("queue").
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:    "ac1",
+					State:   kueue.CheckStateReady,
+					Message: "The admission check was skipped after not completing within 10m0s",
+				}).
+				Obj(),
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Namespace: "ns", Name: "wl"},
+					EventType: "Warning",
+					Reason:    "AdmissionCheckTimeout",
+					Message:   "AdmissionCheck ac1 timed out and was set to Ready",
+				},
+			},
+		},
+		"timed out Admission Check is retried on other flavors": {
+			enableACTimeouts: true,
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuotaAt(utiltesting.MakeAdmission("cq").
+					PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment("cpu", "flavor1", "1").
+						Obj()).
+					Obj(), testStartTime.Add(-time.Hour)).
+				Queue("queue").
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:               "ac1",
+					State:              kueue.CheckStatePending,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-2 * time.Hour)),
+				}).
+				Obj(),
+			cq: utiltesting.MakeClusterQueue("cq").
+				AdmissionCheckStrategy(
+					*utiltesting.MakeAdmissionCheckStrategyRule("ac1").Timeout(10*time.Minute, kueue.AdmissionCheckTimeoutRetry).Obj()).
+				Obj(),
+			lq: utiltesting.MakeLocalQueue("queue", "ns").ClusterQueue("cq").Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("cq").
+					PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment("cpu", "flavor1", "1").
+						Obj()).
+					Obj()).
+				Queue("queue").
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:    "ac1",
+					State:   kueue.CheckStateRetry,
+					Message: "The admission check didn't complete within 10m0s",
+				}).
+				ExcludedFlavor("flavor1", testStartTime.Add(10*time.Minute)).
+				Obj(),
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Namespace: "ns", Name: "wl"},
+					EventType: "Warning",
+					Reason:    "AdmissionCheckTimeout",
+					Message:   "AdmissionCheck ac1 timed out and was set to Retry",
+				},
+			},
+		},
+		"Admission Check timeout counts from the quota reservation": {
+			enableACTimeouts: true,
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuotaAt(utiltesting.MakeAdmission("cq").
+					PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+						Assignment("cpu", "flavor1", "1").
+						Obj()).
+					Obj(), testStartTime.Add(-4*time.Minute)).
 				Queue("queue").
-				AdmissionChecks(
-					kueue.AdmissionCheckState{
-						Name:  "ac1",
-						State: kueue.CheckStatePending,
-					},
-					kueue.AdmissionCheckState{
-						Name:  "ac2",
-						State: kueue.CheckStatePending,
-					}).
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:               "ac1",
+					State:              kueue.CheckStatePending,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-2 * time.Hour)),
+				}).
+				Obj(),
+			cq: utiltesting.MakeClusterQueue("cq").
+				AdmissionCheckStrategy(
+					*utiltesting.MakeAdmissionCheckStrategyRule("ac1").Timeout(10*time.Minute, kueue.AdmissionCheckTimeoutSkip).Obj()).
 				Obj(),
+			lq:         utiltesting.MakeLocalQueue("queue", "ns").ClusterQueue("cq").Obj(),
+			wantResult: reconcile.Result{RequeueAfter: 6 * time.Minute},
 		},
 		"assign Admission Checks from ClusterQueue.spec.AdmissionChecks": {
 			workload: utiltesting.MakeWorkload("wl", "ns").
@@ -885,6 +1346,138 @@ func TestReconcile(t *testing.T) {
 				},
 			},
 		},
+		"admitted workload with a continuous retry check is not evicted during the grace period": {
+			enableContinuousACs: true,
+			admissionChecks: []*kueue.AdmissionCheck{
+				utiltesting.MakeAdmissionCheck("check").
+					Continuous(kueue.ContinuousAdmissionCheck{GracePeriod: &metav1.Duration{Duration: 5 * time.Minute}}).
+					Obj(),
+			},
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("q1").Obj()).
+				Admitted(true).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "ownername", "owneruid").
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:               "check",
+					State:              kueue.CheckStateRetry,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-time.Minute)),
+				}).
+				Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("q1").Obj()).
+				Admitted(true).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "ownername", "owneruid").
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:  "check",
+					State: kueue.CheckStateRetry,
+				}).
+				Obj(),
+			wantResult: reconcile.Result{RequeueAfter: 4 * time.Minute},
+		},
+		"workload blocked on an admission check reports the progress on the workload and its job": {
+			enableACsSummary: true,
+			admissionChecks: []*kueue.AdmissionCheck{
+				utiltesting.MakeAdmissionCheck("check").Active(metav1.ConditionTrue).Obj(),
+			},
+			cq: utiltesting.MakeClusterQueue("cq").AdmissionChecks("check").Obj(),
+			lq: utiltesting.MakeLocalQueue("lq", "ns").ClusterQueue("cq").Obj(),
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "ownername", "owneruid").
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:               "check",
+					State:              kueue.CheckStatePending,
+					LastTransitionTime: metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+				}).
+				AdmissionChecksSummary("0/1 Ready, blocked on check (Pending) since 2025-01-01T00:00:00Z").
+				Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				Queue("lq").
+				ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "ownername", "owneruid").
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:  "check",
+					State: kueue.CheckStatePending,
+				}).
+				AdmissionChecksSummary("0/1 Ready, blocked on check (Pending) since 2025-01-01T00:00:00Z").
+				Obj(),
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Namespace: "ns", Name: "wl"},
+					EventType: "Normal",
+					Reason:    "AdmissionChecksPending",
+					Message:   "Waiting for the admission checks: 0/1 Ready, blocked on check (Pending) since 2025-01-01T00:00:00Z",
+				},
+				{
+					Key:       types.NamespacedName{Namespace: "ns", Name: "ownername"},
+					EventType: "Normal",
+					Reason:    "AdmissionChecksPending",
+					Message:   "Waiting for the admission checks: 0/1 Ready, blocked on check (Pending) since 2025-01-01T00:00:00Z",
+				},
+			},
+			wantResult: reconcile.Result{RequeueAfter: 5 * time.Minute},
+		},
+		"admitted workload with a continuous retry check gets deactivated after the grace period": {
+			enableContinuousACs: true,
+			admissionChecks: []*kueue.AdmissionCheck{
+				utiltesting.MakeAdmissionCheck("check").
+					Continuous(kueue.ContinuousAdmissionCheck{
+						GracePeriod:    &metav1.Duration{Duration: 5 * time.Minute},
+						EvictionPolicy: ptr.To(kueue.ContinuousAdmissionCheckEvictionPolicyDeactivate),
+					}).
+					Obj(),
+			},
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("q1").Obj()).
+				Admitted(true).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "ownername", "owneruid").
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:               "check",
+					State:              kueue.CheckStateRetry,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-10 * time.Minute)),
+					Message:            "node pool in maintenance",
+				}).
+				Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("q1").Obj()).
+				Admitted(true).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "ownername", "owneruid").
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:    "check",
+					State:   kueue.CheckStateRetry,
+					Message: "node pool in maintenance",
+				}).
+				Conditions(
+					metav1.Condition{
+						Type:    kueue.WorkloadQuotaReserved,
+						Status:  metav1.ConditionTrue,
+						Reason:  "AdmittedByTest",
+						Message: "Admitted by ClusterQueue q1",
+					},
+					metav1.Condition{
+						Type:    kueue.WorkloadAdmitted,
+						Status:  metav1.ConditionTrue,
+						Reason:  "ByTest",
+						Message: "Admitted by ClusterQueue q1",
+					},
+					metav1.Condition{
+						Type:    kueue.WorkloadDeactivationTarget,
+						Status:  metav1.ConditionTrue,
+						Reason:  "AdmissionCheck",
+						Message: "Admission check(s): check, were rejected",
+					},
+				).
+				Obj(),
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Namespace: "ns", Name: "wl"},
+					EventType: "Warning",
+					Reason:    "AdmissionCheckRejected",
+					Message:   "Deactivating workload because AdmissionCheck for check was Retry: node pool in maintenance",
+				},
+			},
+		},
 		"workload with deactivation target condition should be deactivated and admission checks reset": {
 			workload: utiltesting.MakeWorkload("wl", "ns").
 				ReserveQuota(utiltesting.MakeAdmission("q1").Obj()).
@@ -1120,6 +1713,97 @@ func TestReconcile(t *testing.T) {
 				},
 			},
 		},
+		"increment re-queue count with the eviction backoff of the ClusterQueue": {
+			enableCQEvictionBackoff: true,
+			cq: utiltesting.MakeClusterQueue("q1").
+				EvictionBackoff(&kueue.ClusterQueueEvictionBackoff{
+					BackoffBaseSeconds: ptr.To[int32](1),
+					JitterPercent:      ptr.To[int32](0),
+				}).
+				Obj(),
+			reconcilerOpts: []Option{
+				WithWaitForPodsReady(&waitForPodsReadyConfig{
+					timeout:                     3 * time.Second,
+					requeuingBackoffLimitCount:  ptr.To[int32](100),
+					requeuingBackoffBaseSeconds: 10,
+					requeuingBackoffJitter:      0,
+					requeuingBackoffMaxDuration: time.Duration(3600) * time.Second,
+				}),
+			},
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("q1").Obj()).
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:  "check",
+					State: kueue.CheckStateReady,
+				}).
+				Condition(metav1.Condition{ // Override LastTransitionTime
+					Type:               kueue.WorkloadAdmitted,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-5 * time.Minute)),
+					Reason:             "ByTest",
+					Message:            "Admitted by ClusterQueue q1",
+				}).
+				Admitted(true).
+				RequeueState(ptr.To[int32](3), nil).
+				SchedulingStatsEviction(
+					kueue.WorkloadSchedulingStatsEviction{
+						Reason:          kueue.WorkloadEvictedByPodsReadyTimeout,
+						UnderlyingCause: kueue.WorkloadWaitForRecovery,
+						Count:           1,
+					},
+				).
+				SchedulingStatsEviction(
+					kueue.WorkloadSchedulingStatsEviction{
+						Reason:          kueue.WorkloadEvictedByPodsReadyTimeout,
+						UnderlyingCause: kueue.WorkloadWaitForStart,
+						Count:           1,
+					},
+				).
+				Generation(1).
+				Obj(),
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("q1").Obj()).
+				Admitted(true).
+				AdmissionCheck(kueue.AdmissionCheckState{
+					Name:    "check",
+					State:   kueue.CheckStatePending,
+					Message: "Reset to Pending after eviction. Previously: Ready",
+				}).
+				Generation(1).
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadEvicted,
+					Status:             metav1.ConditionTrue,
+					Reason:             kueue.WorkloadEvictedByPodsReadyTimeout,
+					Message:            "Exceeded the PodsReady timeout ns/wl",
+					ObservedGeneration: 1,
+				}).
+				// 1s * 2^(4-1) = 8s
+				RequeueState(ptr.To[int32](4), ptr.To(metav1.NewTime(testStartTime.Add(8*time.Second).Truncate(time.Second)))).
+				// check EvictionState mergeStrategy
+				SchedulingStatsEviction(
+					kueue.WorkloadSchedulingStatsEviction{
+						Reason:          kueue.WorkloadEvictedByPodsReadyTimeout,
+						UnderlyingCause: kueue.WorkloadWaitForRecovery,
+						Count:           1,
+					},
+				).
+				SchedulingStatsEviction(
+					kueue.WorkloadSchedulingStatsEviction{
+						Reason:          kueue.WorkloadEvictedByPodsReadyTimeout,
+						UnderlyingCause: kueue.WorkloadWaitForStart,
+						Count:           2,
+					},
+				).
+				Obj(),
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "wl", Namespace: "ns"},
+					EventType: corev1.EventTypeNormal,
+					Reason:    "EvictedDueToPodsReadyTimeout",
+					Message:   "Exceeded the PodsReady timeout ns/wl",
+				},
+			},
+		},
 		"trigger deactivation of workload when reaching backoffLimitCount": {
 			reconcilerOpts: []Option{
 				WithWaitForPodsReady(&waitForPodsReadyConfig{
@@ -2454,16 +3138,98 @@ func TestReconcile(t *testing.T) {
 			},
 			wantError: nil,
 		},
+		"shouldn't delete the workload because the retention period of the matching override hasn't elapsed yet": {
+			enableObjectRetentionPolicies: true,
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadFinished,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-2 * util.LongTimeout)),
+				}).
+				Obj(),
+			reconcilerOpts: []Option{
+				WithWorkloadRetention(
+					&workloadRetentionConfig{
+						afterFinished: ptr.To(util.LongTimeout),
+						overrides: []workloadRetentionOverride{
+							{
+								clusterQueues: sets.New[kueue.ClusterQueueReference]("cq"),
+								afterFinished: ptr.To(3 * util.LongTimeout),
+							},
+						},
+					},
+				),
+			},
+			wantResult: reconcile.Result{
+				RequeueAfter: util.LongTimeout,
+			},
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadFinished,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-2 * util.LongTimeout)),
+				}).
+				Obj(),
+		},
+		"shouldn't delete the workload because its archival failed": {
+			enableObjectRetentionPolicies: true,
+			workload: utiltesting.MakeWorkload("wl", "ns").
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadFinished,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-2 * util.LongTimeout)),
+				}).
+				Obj(),
+			reconcilerOpts: []Option{
+				WithWorkloadRetention(
+					&workloadRetentionConfig{
+						afterFinished: ptr.To(util.LongTimeout),
+						archiver:      &fakeWorkloadArchiver{err: errArchiveUnavailable},
+					},
+				),
+			},
+			wantWorkload: utiltesting.MakeWorkload("wl", "ns").
+				Condition(metav1.Condition{
+					Type:               kueue.WorkloadFinished,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(testStartTime.Add(-2 * util.LongTimeout)),
+				}).
+				Obj(),
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key: types.NamespacedName{
+						Namespace: "ns",
+						Name:      "wl",
+					},
+					EventType: corev1.EventTypeWarning,
+					Reason:    "ArchiveFailed",
+					Message:   "Failed to archive the finished workload: archive unavailable",
+				},
+			},
+			wantError: errArchiveUnavailable,
+		},
 	}
 	for name, tc := range cases {
 		for _, enabled := range []bool{false, true} {
 			t.Run(fmt.Sprintf("%s WorkloadRequestUseMergePatch enabled: %t", name, enabled), func(t *testing.T) {
 				features.SetFeatureGateDuringTest(t, features.ObjectRetentionPolicies, tc.enableObjectRetentionPolicies)
 				features.SetFeatureGateDuringTest(t, features.DynamicResourceAllocation, tc.enableDRAFeature)
+				features.SetFeatureGateDuringTest(t, features.AdmissionCheckDependencies, tc.enableACDependencies)
+				features.SetFeatureGateDuringTest(t, features.AdmissionCheckTimeouts, tc.enableACTimeouts)
+				features.SetFeatureGateDuringTest(t, features.ContinuousAdmissionChecks, tc.enableContinuousACs)
+				features.SetFeatureGateDuringTest(t, features.AdmissionChecksSummary, tc.enableACsSummary)
+				features.SetFeatureGateDuringTest(t, features.ClusterQueueEvictionBackoff, tc.enableCQEvictionBackoff)
+				features.SetFeatureGateDuringTest(t, features.WorkloadAdmissionSLO, tc.enableAdmissionSLO)
+				features.SetFeatureGateDuringTest(t, features.WorkloadReactivationPolicy, tc.enableReactivationPolicy)
 				features.SetFeatureGateDuringTest(t, features.WorkloadRequestUseMergePatch, enabled)
 
 				testWl := tc.workload.DeepCopy()
 				objs := []client.Object{testWl}
+				for _, ac := range tc.admissionChecks {
+					objs = append(objs, ac.DeepCopy())
+				}
 				for _, rc := range tc.resourceClaims {
 					objs = append(objs, rc)
 				}
@@ -2585,7 +3351,7 @@ func TestReconcile(t *testing.T) {
 						for _, wlInfo := range pendingWorkloads {
 							if wlInfo.Obj.Name == workloadKey.Name && wlInfo.Obj.Namespace == workloadKey.Namespace {
 								foundInQueue = true
-								if len(tc.resourceClaimTemplates) > 0 && wlInfo.TotalRequests != nil {
+								if (len(tc.resourceClaimTemplates) > 0 || len(tc.resourceClaims) > 0) && wlInfo.TotalRequests != nil {
 									t.Logf("DRA workload found in queue with TotalRequests: %+v", wlInfo.TotalRequests)
 
 									if tc.wantDRAResourceTotal != nil {
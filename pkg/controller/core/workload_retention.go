@@ -0,0 +1,245 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+const defaultWorkloadArchiveTimeout = 10 * time.Second
+
+var errInvalidArchiveCABundle = errors.New("the CA bundle of the archive doesn't contain any valid PEM encoded certificate")
+
+type workloadRetentionConfig struct {
+	afterFinished *time.Duration
+	overrides     []workloadRetentionOverride
+	archiver      workloadArchiver
+}
+
+type workloadRetentionOverride struct {
+	// namespaceSelector is nil when the override matches all namespaces.
+	namespaceSelector labels.Selector
+	// clusterQueues is empty when the override matches all ClusterQueues.
+	clusterQueues sets.Set[kueue.ClusterQueueReference]
+	afterFinished *time.Duration
+}
+
+// workloadArchiver stores the record of a finished workload before its deletion.
+type workloadArchiver interface {
+	archive(ctx context.Context, wl *kueue.Workload) error
+}
+
+// afterFinishedFor returns the retention of the finished workload, the one of
+// the first matching override, or the default one.
+func (c *workloadRetentionConfig) afterFinishedFor(ctx context.Context, k8sClient client.Client, wl *kueue.Workload) (*time.Duration, error) {
+	var ns *corev1.Namespace
+	for _, o := range c.overrides {
+		if o.clusterQueues.Len() > 0 && (wl.Status.Admission == nil || !o.clusterQueues.Has(wl.Status.Admission.ClusterQueue)) {
+			continue
+		}
+		if o.namespaceSelector != nil {
+			if ns == nil {
+				ns = &corev1.Namespace{}
+				if err := k8sClient.Get(ctx, client.ObjectKey{Name: wl.Namespace}, ns); err != nil {
+					return nil, fmt.Errorf("getting the namespace of the workload: %w", err)
+				}
+			}
+			if !o.namespaceSelector.Matches(labels.Set(ns.Labels)) {
+				continue
+			}
+		}
+		return o.afterFinished, nil
+	}
+	return c.afterFinished, nil
+}
+
+// workloadRecord is the record of a finished workload sent to the archive.
+type workloadRecord struct {
+	Name              string                 `json:"name"`
+	Namespace         string                 `json:"namespace"`
+	UID               string                 `json:"uid"`
+	Owner             *workloadRecordOwner   `json:"owner,omitempty"`
+	Queue             string                 `json:"queue,omitempty"`
+	ClusterQueue      string                 `json:"clusterQueue,omitempty"`
+	PriorityClassName string                 `json:"priorityClassName,omitempty"`
+	Priority          *int32                 `json:"priority,omitempty"`
+	CreationTime      metav1.Time            `json:"creationTime"`
+	QuotaReservedTime *metav1.Time           `json:"quotaReservedTime,omitempty"`
+	AdmittedTime      *metav1.Time           `json:"admittedTime,omitempty"`
+	FinishedTime      *metav1.Time           `json:"finishedTime,omitempty"`
+	FinishedReason    string                 `json:"finishedReason,omitempty"`
+	FinishedMessage   string                 `json:"finishedMessage,omitempty"`
+	RequeueCount      int32                  `json:"requeueCount,omitempty"`
+	PodSets           []workloadRecordPodSet `json:"podSets,omitempty"`
+}
+
+type workloadRecordOwner struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+}
+
+type workloadRecordPodSet struct {
+	Name          kueue.PodSetReference                                 `json:"name"`
+	Count         int32                                                 `json:"count"`
+	Flavors       map[corev1.ResourceName]kueue.ResourceFlavorReference `json:"flavors,omitempty"`
+	ResourceUsage corev1.ResourceList                                   `json:"resourceUsage,omitempty"`
+}
+
+func conditionTime(wl *kueue.Workload, conditionType string) *metav1.Time {
+	if c := apimeta.FindStatusCondition(wl.Status.Conditions, conditionType); c != nil && c.Status == metav1.ConditionTrue {
+		return &c.LastTransitionTime
+	}
+	return nil
+}
+
+func newWorkloadRecord(wl *kueue.Workload) *workloadRecord {
+	record := &workloadRecord{
+		Name:              wl.Name,
+		Namespace:         wl.Namespace,
+		UID:               string(wl.UID),
+		Queue:             string(wl.Spec.QueueName),
+		PriorityClassName: wl.Spec.PriorityClassName,
+		Priority:          wl.Spec.Priority,
+		CreationTime:      wl.CreationTimestamp,
+		QuotaReservedTime: conditionTime(wl, kueue.WorkloadQuotaReserved),
+		AdmittedTime:      conditionTime(wl, kueue.WorkloadAdmitted),
+		FinishedTime:      conditionTime(wl, kueue.WorkloadFinished),
+	}
+	if owner := metav1.GetControllerOf(wl); owner != nil {
+		record.Owner = &workloadRecordOwner{APIVersion: owner.APIVersion, Kind: owner.Kind, Name: owner.Name}
+	}
+	if c := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadFinished); c != nil {
+		record.FinishedReason = c.Reason
+		record.FinishedMessage = c.Message
+	}
+	if wl.Status.RequeueState != nil {
+		record.RequeueCount = ptr.Deref(wl.Status.RequeueState.Count, 0)
+	}
+	if wl.Status.Admission != nil {
+		record.ClusterQueue = string(wl.Status.Admission.ClusterQueue)
+		for _, psa := range wl.Status.Admission.PodSetAssignments {
+			record.PodSets = append(record.PodSets, workloadRecordPodSet{
+				Name:          psa.Name,
+				Count:         ptr.Deref(psa.Count, 0),
+				Flavors:       psa.Flavors,
+				ResourceUsage: psa.ResourceUsage,
+			})
+		}
+	}
+	return record
+}
+
+// httpWorkloadArchiver sends the records of the workloads to an HTTPS sink.
+type httpWorkloadArchiver struct {
+	httpClient      *http.Client
+	url             string
+	method          configapi.WorkloadArchiveMethod
+	bearerTokenFile string
+}
+
+var _ workloadArchiver = (*httpWorkloadArchiver)(nil)
+
+func newHTTPWorkloadArchiver(cfg *configapi.WorkloadArchive) (*httpWorkloadArchiver, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.CABundleFile != "" {
+		caBundle, err := os.ReadFile(cfg.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading the CA bundle of the archive: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBundle) {
+			return nil, errInvalidArchiveCABundle
+		}
+		tlsConfig.RootCAs = pool
+	}
+	timeout := defaultWorkloadArchiveTimeout
+	if cfg.Timeout != nil {
+		timeout = cfg.Timeout.Duration
+	}
+	method := cfg.Method
+	if method == "" {
+		method = configapi.WorkloadArchiveMethodPost
+	}
+	return &httpWorkloadArchiver{
+		httpClient: &http.Client{
+			Timeout:   timeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		url:             cfg.URL,
+		method:          method,
+		bearerTokenFile: cfg.BearerTokenFile,
+	}, nil
+}
+
+// archive sends the record of the workload, succeeding when the sink responds
+// with a 2xx status code.
+func (a *httpWorkloadArchiver) archive(ctx context.Context, wl *kueue.Workload) error {
+	data, err := json.Marshal(newWorkloadRecord(wl))
+	if err != nil {
+		return err
+	}
+	target := a.url
+	if a.method == configapi.WorkloadArchiveMethodPut {
+		target = fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(a.url, "/"), url.PathEscape(wl.Namespace), url.PathEscape(fmt.Sprintf("%s-%s.json", wl.Name, wl.UID)))
+	}
+	req, err := http.NewRequestWithContext(ctx, string(a.method), target, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if a.bearerTokenFile != "" {
+		token, err := os.ReadFile(a.bearerTokenFile)
+		if err != nil {
+			return fmt.Errorf("reading the bearer token of the archive: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("the archive responded with the status code %d", resp.StatusCode)
+	}
+	return nil
+}
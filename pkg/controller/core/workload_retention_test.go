@@ -0,0 +1,216 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+var errArchiveUnavailable = errors.New("archive unavailable")
+
+type fakeWorkloadArchiver struct {
+	err error
+}
+
+func (a *fakeWorkloadArchiver) archive(context.Context, *kueue.Workload) error {
+	return a.err
+}
+
+func TestAfterFinishedFor(t *testing.T) {
+	teamA := labels.SelectorFromSet(labels.Set{"team": "a"})
+	cfg := &workloadRetentionConfig{
+		afterFinished: ptr.To(time.Hour),
+		overrides: []workloadRetentionOverride{
+			{
+				namespaceSelector: teamA,
+				clusterQueues:     sets.New[kueue.ClusterQueueReference]("cq-a"),
+				afterFinished:     ptr.To(time.Minute),
+			},
+			{
+				namespaceSelector: teamA,
+			},
+			{
+				clusterQueues: sets.New[kueue.ClusterQueueReference]("cq-b"),
+				afterFinished: ptr.To(24 * time.Hour),
+			},
+		},
+	}
+	cases := map[string]struct {
+		workload *kueue.Workload
+		want     *time.Duration
+	}{
+		"matches the namespace and the ClusterQueue": {
+			workload: utiltesting.MakeWorkload("wl", "ns-a").ReserveQuota(utiltesting.MakeAdmission("cq-a").Obj()).Obj(),
+			want:     ptr.To(time.Minute),
+		},
+		"matches the namespace only, deletion disabled": {
+			workload: utiltesting.MakeWorkload("wl", "ns-a").ReserveQuota(utiltesting.MakeAdmission("cq-b").Obj()).Obj(),
+		},
+		"matches the ClusterQueue only": {
+			workload: utiltesting.MakeWorkload("wl", "ns-b").ReserveQuota(utiltesting.MakeAdmission("cq-b").Obj()).Obj(),
+			want:     ptr.To(24 * time.Hour),
+		},
+		"no match, default retention": {
+			workload: utiltesting.MakeWorkload("wl", "ns-b").Obj(),
+			want:     ptr.To(time.Hour),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().WithObjects(
+				utiltesting.MakeNamespaceWrapper("ns-a").Label("team", "a").Obj(),
+				utiltesting.MakeNamespaceWrapper("ns-b").Label("team", "b").Obj(),
+			).Build()
+			got, err := cfg.afterFinishedFor(ctx, cl, tc.workload)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected retention (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPWorkloadArchiver(t *testing.T) {
+	finishedTime := metav1.NewTime(time.Now().Truncate(time.Second))
+	wl := utiltesting.MakeWorkload("wl", "ns").
+		UID("uid").
+		Queue("lq").
+		ReserveQuotaAt(utiltesting.MakeAdmission("cq").PodSets(
+			utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "default", "1").Obj(),
+		).Obj(), finishedTime.Add(-time.Minute)).
+		Condition(metav1.Condition{
+			Type:               kueue.WorkloadFinished,
+			Status:             metav1.ConditionTrue,
+			Reason:             kueue.WorkloadFinishedReasonSucceeded,
+			Message:            "Job finished successfully",
+			LastTransitionTime: finishedTime,
+		}).
+		Obj()
+
+	cases := map[string]struct {
+		method     configapi.WorkloadArchiveMethod
+		statusCode int
+		wantPath   string
+		wantErr    bool
+	}{
+		"post": {
+			statusCode: http.StatusOK,
+			wantPath:   "/archive",
+		},
+		"put": {
+			method:     configapi.WorkloadArchiveMethodPut,
+			statusCode: http.StatusCreated,
+			wantPath:   "/archive/ns/wl-uid.json",
+		},
+		"rejected": {
+			statusCode: http.StatusServiceUnavailable,
+			wantPath:   "/archive",
+			wantErr:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var gotMethod, gotPath, gotAuthorization string
+			var gotRecord workloadRecord
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotMethod, gotPath = r.Method, r.URL.Path
+				gotAuthorization = r.Header.Get("Authorization")
+				body, _ := io.ReadAll(r.Body)
+				if err := json.Unmarshal(body, &gotRecord); err != nil {
+					t.Errorf("Unexpected record: %v", err)
+				}
+				w.WriteHeader(tc.statusCode)
+			}))
+			defer server.Close()
+
+			tokenFile := filepath.Join(t.TempDir(), "token")
+			if err := os.WriteFile(tokenFile, []byte("secret\n"), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			archiver, err := newHTTPWorkloadArchiver(&configapi.WorkloadArchive{
+				URL:             server.URL + "/archive",
+				Method:          tc.method,
+				BearerTokenFile: tokenFile,
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			archiver.httpClient = server.Client()
+
+			err = archiver.archive(t.Context(), wl)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			wantMethod := string(tc.method)
+			if wantMethod == "" {
+				wantMethod = http.MethodPost
+			}
+			if gotMethod != wantMethod || gotPath != tc.wantPath {
+				t.Errorf("Unexpected request %s %s, want %s %s", gotMethod, gotPath, wantMethod, tc.wantPath)
+			}
+			if gotAuthorization != "Bearer secret" {
+				t.Errorf("Unexpected Authorization header %q", gotAuthorization)
+			}
+			wantRecord := workloadRecord{
+				Name:              "wl",
+				Namespace:         "ns",
+				UID:               "uid",
+				Queue:             "lq",
+				ClusterQueue:      "cq",
+				QuotaReservedTime: ptr.To(metav1.NewTime(finishedTime.Add(-time.Minute))),
+				FinishedTime:      &finishedTime,
+				FinishedReason:    kueue.WorkloadFinishedReasonSucceeded,
+				FinishedMessage:   "Job finished successfully",
+				PodSets: []workloadRecordPodSet{
+					{
+						Name:          kueue.DefaultPodSetName,
+						Count:         1,
+						Flavors:       map[corev1.ResourceName]kueue.ResourceFlavorReference{corev1.ResourceCPU: "default"},
+						ResourceUsage: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+					},
+				},
+			}
+			if diff := cmp.Diff(wantRecord, gotRecord, cmp.Comparer(func(a, b resource.Quantity) bool { return a.Cmp(b) == 0 })); diff != "" {
+				t.Errorf("Unexpected record (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
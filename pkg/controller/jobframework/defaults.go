@@ -18,14 +18,17 @@ package jobframework
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
@@ -96,6 +99,42 @@ func ApplyDefaultLocalQueue(jobObj client.Object, defaultQueueExist func(string)
 	}
 }
 
+// ApplyDefaultQueueFromOwner sets the queue-name of a job which does not set
+// one to the queue-name of its owner, when the kind of the owner has the
+// InheritQueue child jobs policy.
+func ApplyDefaultQueueFromOwner(ctx context.Context, jobObj client.Object, k8sClient client.Client) error {
+	if QueueNameForObject(jobObj) != "" {
+		return nil
+	}
+	owner := metav1.GetControllerOf(jobObj)
+	if owner == nil {
+		return nil
+	}
+	if policy, _ := manager.getChildJobsPolicy(owner); policy != configapi.ChildJobsPolicyInheritQueue {
+		return nil
+	}
+	ownerObj := &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: owner.APIVersion,
+			Kind:       owner.Kind,
+		},
+	}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: owner.Name, Namespace: jobObj.GetNamespace()}, ownerObj); err != nil {
+		return errors.Join(ErrWorkloadOwnerNotFound, err)
+	}
+	queueName := QueueNameForObject(ownerObj)
+	if queueName == "" {
+		return nil
+	}
+	labels := jobObj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string, 1)
+	}
+	labels[constants.QueueLabel] = string(queueName)
+	jobObj.SetLabels(labels)
+	return nil
+}
+
 func ApplyDefaultForManagedBy(job GenericJob, queues *qcache.Manager, cache *schdcache.Cache, log logr.Logger) {
 	if managedJob, ok := job.(JobWithManagedBy); ok {
 		if managedJob.CanDefaultManagedBy() {
@@ -103,7 +142,7 @@ func ApplyDefaultForManagedBy(job GenericJob, queues *qcache.Manager, cache *sch
 			if !found {
 				return
 			}
-			clusterQueueName, ok := queues.ClusterQueueFromLocalQueue(utilqueue.NewLocalQueueReference(job.Object().GetNamespace(), kueue.LocalQueueName(localQueueName)))
+			clusterQueueName, ok := queues.ClusterQueueFromLocalQueue(utilqueue.NewLocalQueueReference(utilqueue.NamespaceForObject(job.Object()), kueue.LocalQueueName(localQueueName)))
 			if !ok {
 				log.V(5).Info("Cluster queue for local queue not found", "localQueueName", localQueueName)
 				return
@@ -19,11 +19,17 @@ package jobframework
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	utiltestingjob "sigs.k8s.io/kueue/pkg/util/testingjobs/job"
 )
@@ -105,3 +111,66 @@ func TestWorkloadShouldBeSuspended(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyDefaultQueueFromOwner(t *testing.T) {
+	owner := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "owner",
+			Namespace: metav1.NamespaceDefault,
+			UID:       "owner",
+			Labels:    map[string]string{constants.QueueLabel: "owner-queue"},
+		},
+	}
+	ownerGVK := appsv1.SchemeGroupVersion.WithKind("DaemonSet")
+
+	cases := map[string]struct {
+		childJobsPolicies []configapi.ChildJobsPolicy
+		obj               client.Object
+		wantQueueName     kueue.LocalQueueName
+		wantErr           error
+	}{
+		"job of an owner with the InheritQueue policy": {
+			childJobsPolicies: []configapi.ChildJobsPolicy{
+				{OwnerKind: "DaemonSet.v1.apps", Policy: configapi.ChildJobsPolicyInheritQueue},
+			},
+			obj:           utiltestingjob.MakeJob("job", metav1.NamespaceDefault).OwnerReference(owner.Name, ownerGVK).Obj(),
+			wantQueueName: "owner-queue",
+		},
+		"job with a queue name of an owner with the InheritQueue policy": {
+			childJobsPolicies: []configapi.ChildJobsPolicy{
+				{OwnerKind: "DaemonSet.v1.apps", Policy: configapi.ChildJobsPolicyInheritQueue},
+			},
+			obj:           utiltestingjob.MakeJob("job", metav1.NamespaceDefault).OwnerReference(owner.Name, ownerGVK).Queue("job-queue").Obj(),
+			wantQueueName: "job-queue",
+		},
+		"job of an owner with the Queue policy": {
+			childJobsPolicies: []configapi.ChildJobsPolicy{
+				{OwnerKind: "DaemonSet.v1.apps", Policy: configapi.ChildJobsPolicyQueue},
+			},
+			obj: utiltestingjob.MakeJob("job", metav1.NamespaceDefault).OwnerReference(owner.Name, ownerGVK).Obj(),
+		},
+		"job of a missing owner with the InheritQueue policy": {
+			childJobsPolicies: []configapi.ChildJobsPolicy{
+				{OwnerKind: "DaemonSet.v1.apps", Policy: configapi.ChildJobsPolicyInheritQueue},
+			},
+			obj:     utiltestingjob.MakeJob("job", metav1.NamespaceDefault).OwnerReference("missing", ownerGVK).Obj(),
+			wantErr: ErrWorkloadOwnerNotFound,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Cleanup(SetChildJobsPoliciesForTest(t, tc.childJobsPolicies...))
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().WithObjects(owner).Build()
+
+			gotErr := ApplyDefaultQueueFromOwner(ctx, tc.obj, cl)
+			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if got := QueueNameForObject(tc.obj); got != tc.wantQueueName {
+				t.Errorf("Unexpected queue name, want=%q, got=%q", tc.wantQueueName, got)
+			}
+		})
+	}
+}
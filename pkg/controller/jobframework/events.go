@@ -25,8 +25,12 @@ const (
 	ReasonCreatedWorkload       = "CreatedWorkload"
 	ReasonDeletedWorkload       = "DeletedWorkload"
 	ReasonUpdatedWorkload       = "UpdatedWorkload"
+	ReasonAdoptedWorkload       = "AdoptedWorkload"
 	ReasonFinishedWorkload      = "FinishedWorkload"
 	ReasonErrWorkloadCompose    = "ErrWorkloadCompose"
 	ReasonUpdatedAdmissionCheck = "UpdatedAdmissionCheck"
 	ReasonJobNestingTooDeep     = "JobNestingTooDeep"
+	ReasonCheckpointRequested   = "CheckpointRequested"
+	ReasonShrunk                = "Shrunk"
+	ReasonParallelismRestored   = "ParallelismRestored"
 )
@@ -35,12 +35,15 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
 )
 
 var (
 	errDuplicateFrameworkName = errors.New("duplicate framework name")
 	errMissingMandatoryField  = errors.New("mandatory field missing")
 	errFrameworkNameFormat    = errors.New("misformatted external framework name")
+	errOwnerKindFormat        = errors.New("misformatted child jobs policy owner kind")
 
 	errIntegrationNotFound             = errors.New("integration not found")
 	errDependencyIntegrationNotEnabled = errors.New("integration not enabled")
@@ -82,6 +85,11 @@ type IntegrationCallbacks struct {
 	MultiKueueAdapter MultiKueueAdapter
 	// The list of integration that need to be enabled along with the current one.
 	DependencyList []string
+	// StandaloneChildJobs tells that the jobs created by the objects of the
+	// integration are queued as standalone jobs, the objects not being the
+	// Kueue-managed owners of the jobs.
+	// (this callback is optional)
+	StandaloneChildJobs bool
 }
 
 func (i *IntegrationCallbacks) getGVK() schema.GroupVersionKind {
@@ -104,6 +112,7 @@ type integrationManager struct {
 	integrations         map[string]IntegrationCallbacks
 	enabledIntegrations  set.Set[string]
 	externalIntegrations map[string]runtime.Object
+	childJobsPolicies    map[schema.GroupVersionKind]configapi.ChildJobsPolicyType
 	mu                   sync.RWMutex
 }
 
@@ -157,6 +166,30 @@ func (m *integrationManager) registerExternal(kindArg string) error {
 	return nil
 }
 
+func (m *integrationManager) setChildJobsPolicies(policies []configapi.ChildJobsPolicy) error {
+	childJobsPolicies := make(map[schema.GroupVersionKind]configapi.ChildJobsPolicyType, len(policies))
+	for _, p := range policies {
+		gvk, _ := schema.ParseKindArg(p.OwnerKind)
+		if gvk == nil {
+			return fmt.Errorf("%w %q", errOwnerKindFormat, p.OwnerKind)
+		}
+		childJobsPolicies[*gvk] = p.Policy
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.childJobsPolicies = childJobsPolicies
+	return nil
+}
+
+// getChildJobsPolicy returns the policy configured for the jobs owned by
+// objects of the kind of the owner, if any.
+func (m *integrationManager) getChildJobsPolicy(ownerRef *metav1.OwnerReference) (configapi.ChildJobsPolicyType, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	policy, found := m.childJobsPolicies[schema.FromAPIVersionAndKind(ownerRef.APIVersion, ownerRef.Kind)]
+	return policy, found
+}
+
 func (m *integrationManager) forEach(f func(name string, cb IntegrationCallbacks) error) error {
 	for _, name := range m.names {
 		if err := f(name, m.integrations[name]); err != nil {
@@ -210,6 +243,11 @@ func (m *integrationManager) isKnownOwner(ownerRef *metav1.OwnerReference) bool
 			return true
 		}
 	}
+	// The kinds with a child jobs policy are known, so that Kueue looks for
+	// a managed ancestor through the objects of the kinds which are not managed.
+	if _, found := m.getChildJobsPolicy(ownerRef); found {
+		return true
+	}
 	// ReplicaSet is an interim owner from Pod to Deployment. We call it known
 	// so that the users don't need to list	it explicitly in their configs.
 	// Note that Kueue provides RBAC permissions allowing for traversal over it.
@@ -217,9 +255,12 @@ func (m *integrationManager) isKnownOwner(ownerRef *metav1.OwnerReference) bool
 }
 
 func (m *integrationManager) getJobTypeForOwner(ownerRef *metav1.OwnerReference) runtime.Object {
+	if policy, _ := m.getChildJobsPolicy(ownerRef); policy == configapi.ChildJobsPolicyQueue || policy == configapi.ChildJobsPolicyInheritQueue {
+		return nil
+	}
 	for jobKey := range m.getEnabledIntegrations() {
 		cbs, found := m.integrations[jobKey]
-		if found && cbs.matchingOwnerReference(ownerRef) {
+		if found && !cbs.StandaloneChildJobs && cbs.matchingOwnerReference(ownerRef) {
 			return cbs.JobType
 		}
 	}
@@ -305,6 +346,23 @@ func EnableExternalIntegrationsForTest(tb testing.TB, names ...string) func() {
 	}
 }
 
+// SetChildJobsPoliciesForTest - should be used only in tests
+// Sets the child jobs policies and returns a revert function.
+func SetChildJobsPoliciesForTest(tb testing.TB, policies ...configapi.ChildJobsPolicy) func() {
+	tb.Helper()
+	manager.mu.RLock()
+	old := manager.childJobsPolicies
+	manager.mu.RUnlock()
+	if err := manager.setChildJobsPolicies(policies); err != nil {
+		tb.Fatalf("failed to set the child jobs policies: %v", err)
+	}
+	return func() {
+		manager.mu.Lock()
+		manager.childJobsPolicies = old
+		manager.mu.Unlock()
+	}
+}
+
 // GetIntegration looks-up the framework identified by name in the currently registered
 // list of frameworks returning its callbacks and true if found.
 func GetIntegration(name string) (IntegrationCallbacks, bool) {
@@ -31,6 +31,7 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/podset"
 	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
 	"sigs.k8s.io/kueue/pkg/util/maps"
@@ -77,6 +78,20 @@ type JobWithReclaimablePods interface {
 	ReclaimablePods() ([]kueue.ReclaimablePod, error)
 }
 
+// JobWithElasticParallelism interface should be implemented by the elastic jobs
+// whose parallelism can be reduced, instead of the jobs being evicted, to make
+// room for preempting workloads.
+type JobWithElasticParallelism interface {
+	// CanShrink returns true if the parallelism of the job can be reduced.
+	CanShrink() bool
+	// Shrink reduces the parallelism of the job, remembering the original one.
+	// Returns true if the job was changed.
+	Shrink() bool
+	// RestoreParallelism restores the original parallelism of a shrunk job,
+	// once the pods removed by shrinking are gone. Returns true if the job was changed.
+	RestoreParallelism() bool
+}
+
 type StopReason string
 
 const (
@@ -221,7 +236,7 @@ func NewWorkload(name string, obj client.Object, podSets []kueue.PodSet, labelKe
 			Namespace:   obj.GetNamespace(),
 			Labels:      maps.FilterKeys(obj.GetLabels(), labelKeysToCopy),
 			Finalizers:  []string{kueue.ResourceInUseFinalizerName},
-			Annotations: admissioncheck.FilterProvReqAnnotations(obj.GetAnnotations()),
+			Annotations: workloadAnnotations(obj),
 		},
 		Spec: kueue.WorkloadSpec{
 			QueueName:                   QueueNameForObject(obj),
@@ -231,6 +246,29 @@ func NewWorkload(name string, obj client.Object, podSets []kueue.PodSet, labelKe
 	}
 }
 
+// workloadAnnotations returns the annotations of the job which are copied to
+// its workload.
+func workloadAnnotations(obj client.Object) map[string]string {
+	annotations := admissioncheck.FilterProvReqAnnotations(obj.GetAnnotations())
+	if features.Enabled(features.WorkloadGroups) {
+		for _, key := range []string{constants.WorkloadGroupAnnotation, constants.WorkloadGroupMinCountAnnotation} {
+			if v, found := obj.GetAnnotations()[key]; found {
+				annotations[key] = v
+			}
+		}
+	}
+	if v, found := obj.GetAnnotations()[constants.AdmissionTargetAnnotation]; found && features.Enabled(features.WorkloadAdmissionSLO) {
+		annotations[constants.AdmissionTargetAnnotation] = v
+	}
+	if v, found := obj.GetAnnotations()[constants.SubmitterAnnotation]; found && features.Enabled(features.WorkloadSubmitterIdentity) {
+		annotations[constants.SubmitterAnnotation] = v
+	}
+	if v, found := obj.GetAnnotations()[constants.BillingNamespaceAnnotation]; found && features.Enabled(features.CrossNamespaceBilling) {
+		annotations[constants.BillingNamespaceAnnotation] = v
+	}
+	return annotations
+}
+
 // MultiKueueAdapter interface needed for MultiKueue job delegation.
 type MultiKueueAdapter interface {
 	// SyncJob creates the Job object in the worker cluster using remote client, if not already created.
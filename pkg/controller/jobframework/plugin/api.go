@@ -0,0 +1,202 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package plugin defines the JobPlugin gRPC service, the out-of-process
+// version of the jobframework.GenericJob interface, and the GenericJob
+// implementation Kueue uses to manage jobs through the plugins.
+//
+// The messages of the service are encoded as JSON, with the "json" content
+// subtype (application/grpc+json), so that the plugins can be implemented
+// without generated code. The jobs are passed as their JSON representation.
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/podset"
+)
+
+const (
+	// ServiceName is the full name of the JobPlugin gRPC service.
+	ServiceName = "kueue.jobframework.v1alpha1.JobPlugin"
+
+	inspectMethod            = "/" + ServiceName + "/Inspect"
+	suspendMethod            = "/" + ServiceName + "/Suspend"
+	runWithPodSetsInfoMethod = "/" + ServiceName + "/RunWithPodSetsInfo"
+	restorePodSetsInfoMethod = "/" + ServiceName + "/RestorePodSetsInfo"
+)
+
+// InspectRequest is the request of the Inspect method.
+type InspectRequest struct {
+	// Object is the JSON representation of the job.
+	Object json.RawMessage `json:"object"`
+}
+
+// InspectResponse holds the state of a job, as read by the GenericJob
+// methods which do not modify the job.
+type InspectResponse struct {
+	// Suspended is whether the job is suspended.
+	Suspended bool `json:"suspended"`
+	// Active is whether the job has active pods.
+	Active bool `json:"active"`
+	// PodSets are the PodSets of the workload of the job.
+	PodSets []kueue.PodSet `json:"podSets"`
+	// Finished is whether the job finished.
+	Finished bool `json:"finished"`
+	// Success is whether the job finished successfully.
+	Success bool `json:"success,omitempty"`
+	// Message is the message of the finished condition of the workload.
+	Message string `json:"message,omitempty"`
+	// PodsReady is whether the pods of the job are ready.
+	PodsReady bool `json:"podsReady"`
+	// PriorityClass is the name of the priority class of the job, if any.
+	PriorityClass string `json:"priorityClass,omitempty"`
+}
+
+// SuspendRequest is the request of the Suspend method.
+type SuspendRequest struct {
+	// Object is the JSON representation of the job.
+	Object json.RawMessage `json:"object"`
+}
+
+// RunWithPodSetsInfoRequest is the request of the RunWithPodSetsInfo method.
+type RunWithPodSetsInfoRequest struct {
+	// Object is the JSON representation of the job.
+	Object json.RawMessage `json:"object"`
+	// PodSetsInfo holds the node selectors, tolerations, labels, annotations
+	// and counts to apply to the PodSets of the job, in the order of the PodSets.
+	PodSetsInfo []podset.PodSetInfo `json:"podSetsInfo"`
+}
+
+// RestorePodSetsInfoRequest is the request of the RestorePodSetsInfo method.
+type RestorePodSetsInfoRequest struct {
+	// Object is the JSON representation of the job.
+	Object json.RawMessage `json:"object"`
+	// PodSetsInfo holds the node selectors, tolerations, labels, annotations
+	// and counts the PodSets of the job had before it was started.
+	PodSetsInfo []podset.PodSetInfo `json:"podSetsInfo"`
+}
+
+// ObjectResponse is the response of the methods modifying a job.
+type ObjectResponse struct {
+	// Object is the JSON representation of the modified job.
+	Object json.RawMessage `json:"object"`
+	// Changed is whether the job was modified.
+	Changed bool `json:"changed"`
+}
+
+// JobPluginServer is the server API of the JobPlugin service.
+type JobPluginServer interface {
+	// Inspect returns the state of the job.
+	Inspect(context.Context, *InspectRequest) (*InspectResponse, error)
+	// Suspend suspends the job.
+	Suspend(context.Context, *SuspendRequest) (*ObjectResponse, error)
+	// RunWithPodSetsInfo applies the PodSetsInfo to the job, and unsuspends it.
+	RunWithPodSetsInfo(context.Context, *RunWithPodSetsInfoRequest) (*ObjectResponse, error)
+	// RestorePodSetsInfo restores the PodSetsInfo of the job, after it is suspended.
+	RestorePodSetsInfo(context.Context, *RestorePodSetsInfoRequest) (*ObjectResponse, error)
+}
+
+// JobPluginClient is the client API of the JobPlugin service.
+type JobPluginClient interface {
+	Inspect(ctx context.Context, in *InspectRequest, opts ...grpc.CallOption) (*InspectResponse, error)
+	Suspend(ctx context.Context, in *SuspendRequest, opts ...grpc.CallOption) (*ObjectResponse, error)
+	RunWithPodSetsInfo(ctx context.Context, in *RunWithPodSetsInfoRequest, opts ...grpc.CallOption) (*ObjectResponse, error)
+	RestorePodSetsInfo(ctx context.Context, in *RestorePodSetsInfoRequest, opts ...grpc.CallOption) (*ObjectResponse, error)
+}
+
+type jobPluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewJobPluginClient returns a client of the JobPlugin service.
+func NewJobPluginClient(cc grpc.ClientConnInterface) JobPluginClient {
+	return &jobPluginClient{cc: cc}
+}
+
+func (c *jobPluginClient) Inspect(ctx context.Context, in *InspectRequest, opts ...grpc.CallOption) (*InspectResponse, error) {
+	out := &InspectResponse{}
+	if err := c.cc.Invoke(ctx, inspectMethod, in, out, withCodec(opts)...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobPluginClient) Suspend(ctx context.Context, in *SuspendRequest, opts ...grpc.CallOption) (*ObjectResponse, error) {
+	out := &ObjectResponse{}
+	if err := c.cc.Invoke(ctx, suspendMethod, in, out, withCodec(opts)...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobPluginClient) RunWithPodSetsInfo(ctx context.Context, in *RunWithPodSetsInfoRequest, opts ...grpc.CallOption) (*ObjectResponse, error) {
+	out := &ObjectResponse{}
+	if err := c.cc.Invoke(ctx, runWithPodSetsInfoMethod, in, out, withCodec(opts)...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobPluginClient) RestorePodSetsInfo(ctx context.Context, in *RestorePodSetsInfoRequest, opts ...grpc.CallOption) (*ObjectResponse, error) {
+	out := &ObjectResponse{}
+	if err := c.cc.Invoke(ctx, restorePodSetsInfoMethod, in, out, withCodec(opts)...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func withCodec(opts []grpc.CallOption) []grpc.CallOption {
+	return append([]grpc.CallOption{grpc.CallContentSubtype(codecName)}, opts...)
+}
+
+// RegisterJobPluginServer registers the implementation of the JobPlugin service to the gRPC server.
+func RegisterJobPluginServer(s grpc.ServiceRegistrar, srv JobPluginServer) {
+	s.RegisterService(&serviceDesc, srv)
+}
+
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*JobPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Inspect", Handler: unaryHandler(inspectMethod, JobPluginServer.Inspect)},
+		{MethodName: "Suspend", Handler: unaryHandler(suspendMethod, JobPluginServer.Suspend)},
+		{MethodName: "RunWithPodSetsInfo", Handler: unaryHandler(runWithPodSetsInfoMethod, JobPluginServer.RunWithPodSetsInfo)},
+		{MethodName: "RestorePodSetsInfo", Handler: unaryHandler(restorePodSetsInfoMethod, JobPluginServer.RestorePodSetsInfo)},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+func unaryHandler[Req, Resp any](fullMethod string, call func(JobPluginServer, context.Context, *Req) (*Resp, error)) grpc.MethodHandler {
+	return func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+		in := new(Req)
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		if interceptor == nil {
+			return call(srv.(JobPluginServer), ctx, in)
+		}
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}
+		handler := func(ctx context.Context, req any) (any, error) {
+			return call(srv.(JobPluginServer), ctx, req.(*Req))
+		}
+		return interceptor(ctx, in, info, handler)
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/podset"
+	clientutil "sigs.k8s.io/kueue/pkg/util/client"
+)
+
+// callTimeout is the timeout of the calls to the plugins.
+const callTimeout = 10 * time.Second
+
+// Job is the GenericJob of the jobs managed through a plugin.
+//
+// The GenericJob methods which do not modify the job are answered from the
+// result of the Inspect method, which is called once per resourceVersion of
+// the job. The methods which modify the job replace the object with the one
+// returned by the plugin.
+type Job struct {
+	obj    *unstructured.Unstructured
+	gvk    schema.GroupVersionKind
+	client JobPluginClient
+	ctx    context.Context
+
+	inspected        *InspectResponse
+	inspectedVersion string
+	err              error
+}
+
+var _ jobframework.GenericJob = (*Job)(nil)
+var _ jobframework.JobWithCustomStop = (*Job)(nil)
+var _ jobframework.JobWithPriorityClass = (*Job)(nil)
+
+// NewJob returns an empty job of the gvk, managed through the client.
+// The ctx is used for the calls to the plugin made by the GenericJob methods.
+func NewJob(ctx context.Context, gvk schema.GroupVersionKind, c JobPluginClient) *Job {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return &Job{
+		obj:    obj,
+		gvk:    gvk,
+		client: c,
+		ctx:    ctx,
+	}
+}
+
+// Inspect calls the Inspect method of the plugin, unless the current
+// resourceVersion of the job was already inspected.
+func (j *Job) Inspect(ctx context.Context) (*InspectResponse, error) {
+	version := j.obj.GetResourceVersion()
+	if j.inspected != nil && j.inspectedVersion == version {
+		return j.inspected, nil
+	}
+	data, err := j.obj.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+	resp, err := j.client.Inspect(ctx, &InspectRequest{Object: data})
+	if err != nil {
+		return nil, fmt.Errorf("inspecting %s: %w", j.gvk.Kind, err)
+	}
+	j.inspected = resp
+	j.inspectedVersion = version
+	return resp, nil
+}
+
+// state returns the inspected state of the job. When the plugin cannot be
+// reached, the job is reported as a suspended and inactive job, and the
+// error is returned by PodSets.
+func (j *Job) state() *InspectResponse {
+	resp, err := j.Inspect(j.ctx)
+	if err != nil {
+		j.err = err
+		return &InspectResponse{Suspended: true}
+	}
+	j.err = nil
+	return resp
+}
+
+func (j *Job) Object() client.Object {
+	return j.obj
+}
+
+func (j *Job) GVK() schema.GroupVersionKind {
+	return j.gvk
+}
+
+func (j *Job) IsSuspended() bool {
+	return j.state().Suspended
+}
+
+func (j *Job) IsActive() bool {
+	return j.state().Active
+}
+
+func (j *Job) PodSets() ([]kueue.PodSet, error) {
+	state := j.state()
+	if j.err != nil {
+		return nil, j.err
+	}
+	return state.PodSets, nil
+}
+
+func (j *Job) Finished() (message string, success, finished bool) {
+	state := j.state()
+	return state.Message, state.Success, state.Finished
+}
+
+func (j *Job) PodsReady() bool {
+	return j.state().PodsReady
+}
+
+func (j *Job) PriorityClass() string {
+	return j.state().PriorityClass
+}
+
+func (j *Job) Suspend() {
+	if err := j.suspend(j.ctx); err != nil {
+		ctrl.LoggerFrom(j.ctx).Error(err, "Suspending the job through the plugin")
+	}
+}
+
+func (j *Job) RunWithPodSetsInfo(podSetsInfo []podset.PodSetInfo) error {
+	data, err := j.obj.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(j.ctx, callTimeout)
+	defer cancel()
+	resp, err := j.client.RunWithPodSetsInfo(ctx, &RunWithPodSetsInfoRequest{Object: data, PodSetsInfo: podSetsInfo})
+	if err != nil {
+		return fmt.Errorf("running %s: %w", j.gvk.Kind, err)
+	}
+	_, err = j.replaceObject(resp)
+	return err
+}
+
+func (j *Job) RestorePodSetsInfo(podSetsInfo []podset.PodSetInfo) bool {
+	changed, err := j.restorePodSetsInfo(j.ctx, podSetsInfo)
+	if err != nil {
+		ctrl.LoggerFrom(j.ctx).Error(err, "Restoring the PodSetsInfo through the plugin")
+	}
+	return changed
+}
+
+// Stop suspends the job and restores its PodSetsInfo through the plugin,
+// so that the errors of the plugin are surfaced to the reconciler.
+func (j *Job) Stop(ctx context.Context, c client.Client, podSetsInfo []podset.PodSetInfo, _ jobframework.StopReason, _ string) (bool, error) {
+	if j.IsSuspended() {
+		return false, nil
+	}
+	if err := clientutil.Patch(ctx, c, j.obj, func() (client.Object, bool, error) {
+		if err := j.suspend(ctx); err != nil {
+			return nil, false, err
+		}
+		if podSetsInfo != nil {
+			if _, err := j.restorePodSetsInfo(ctx, podSetsInfo); err != nil {
+				return nil, false, err
+			}
+		}
+		return j.obj, true, nil
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (j *Job) suspend(ctx context.Context) error {
+	data, err := j.obj.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+	resp, err := j.client.Suspend(ctx, &SuspendRequest{Object: data})
+	if err != nil {
+		return fmt.Errorf("suspending %s: %w", j.gvk.Kind, err)
+	}
+	_, err = j.replaceObject(resp)
+	return err
+}
+
+func (j *Job) restorePodSetsInfo(ctx context.Context, podSetsInfo []podset.PodSetInfo) (bool, error) {
+	data, err := j.obj.MarshalJSON()
+	if err != nil {
+		return false, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+	resp, err := j.client.RestorePodSetsInfo(ctx, &RestorePodSetsInfoRequest{Object: data, PodSetsInfo: podSetsInfo})
+	if err != nil {
+		return false, fmt.Errorf("restoring the PodSetsInfo of %s: %w", j.gvk.Kind, err)
+	}
+	return j.replaceObject(resp)
+}
+
+// replaceObject replaces the job with the object returned by the plugin,
+// when the plugin reports that the job changed.
+func (j *Job) replaceObject(resp *ObjectResponse) (bool, error) {
+	if !resp.Changed {
+		return false, nil
+	}
+	obj := &unstructured.Unstructured{}
+	if err := json.Unmarshal(resp.Object, &obj.Object); err != nil {
+		return false, fmt.Errorf("decoding the %s returned by the plugin: %w", j.gvk.Kind, err)
+	}
+	if obj.GroupVersionKind() != j.gvk || obj.GetNamespace() != j.obj.GetNamespace() || obj.GetName() != j.obj.GetName() {
+		return false, fmt.Errorf("the plugin returned %s %s/%s instead of %s %s/%s",
+			obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName(), j.gvk, j.obj.GetNamespace(), j.obj.GetName())
+	}
+	j.obj.Object = obj.Object
+	j.inspected = nil
+	return true, nil
+}
@@ -0,0 +1,244 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	workloadjob "sigs.k8s.io/kueue/pkg/controller/jobs/job"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingjob "sigs.k8s.io/kueue/pkg/util/testingjobs/job"
+)
+
+var jobGVK = batchv1.SchemeGroupVersion.WithKind("Job")
+
+// batchJobPlugin implements the JobPlugin service for batch Jobs,
+// by delegating to the in-tree integration.
+type batchJobPlugin struct {
+	inspectCalls int
+}
+
+var _ JobPluginServer = (*batchJobPlugin)(nil)
+
+func decodeJob(data json.RawMessage) (jobframework.GenericJob, error) {
+	job := workloadjob.NewJob()
+	return job, json.Unmarshal(data, job.Object())
+}
+
+func encodeJob(job jobframework.GenericJob, changed bool) (*ObjectResponse, error) {
+	data, err := json.Marshal(job.Object())
+	return &ObjectResponse{Object: data, Changed: changed}, err
+}
+
+func (p *batchJobPlugin) Inspect(_ context.Context, req *InspectRequest) (*InspectResponse, error) {
+	p.inspectCalls++
+	job, err := decodeJob(req.Object)
+	if err != nil {
+		return nil, err
+	}
+	podSets, err := job.PodSets()
+	if err != nil {
+		return nil, err
+	}
+	message, success, finished := job.Finished()
+	return &InspectResponse{
+		Suspended: job.IsSuspended(),
+		Active:    job.IsActive(),
+		PodSets:   podSets,
+		Finished:  finished,
+		Success:   success,
+		Message:   message,
+		PodsReady: job.PodsReady(),
+	}, nil
+}
+
+func (p *batchJobPlugin) Suspend(_ context.Context, req *SuspendRequest) (*ObjectResponse, error) {
+	job, err := decodeJob(req.Object)
+	if err != nil {
+		return nil, err
+	}
+	job.Suspend()
+	return encodeJob(job, true)
+}
+
+func (p *batchJobPlugin) RunWithPodSetsInfo(_ context.Context, req *RunWithPodSetsInfoRequest) (*ObjectResponse, error) {
+	job, err := decodeJob(req.Object)
+	if err != nil {
+		return nil, err
+	}
+	if err := job.RunWithPodSetsInfo(req.PodSetsInfo); err != nil {
+		return nil, err
+	}
+	return encodeJob(job, true)
+}
+
+func (p *batchJobPlugin) RestorePodSetsInfo(_ context.Context, req *RestorePodSetsInfoRequest) (*ObjectResponse, error) {
+	job, err := decodeJob(req.Object)
+	if err != nil {
+		return nil, err
+	}
+	return encodeJob(job, job.RestorePodSetsInfo(req.PodSetsInfo))
+}
+
+// startPlugin serves the plugin on a unix socket and returns a client connected to it.
+func startPlugin(t *testing.T, srv JobPluginServer) JobPluginClient {
+	t.Helper()
+	address := filepath.Join(t.TempDir(), "plugin.sock")
+	lis, err := net.Listen("unix", address)
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	s := grpc.NewServer()
+	RegisterJobPluginServer(s, srv)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("unix://"+address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return NewJobPluginClient(conn)
+}
+
+func newJobFromObject(t *testing.T, ctx context.Context, c JobPluginClient, obj *batchv1.Job) *Job {
+	t.Helper()
+	job := NewJob(ctx, jobGVK, c)
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		t.Fatalf("Failed to convert the job: %v", err)
+	}
+	job.obj.Object = content
+	job.obj.SetGroupVersionKind(jobGVK)
+	return job
+}
+
+func toBatchJob(t *testing.T, obj client.Object) *batchv1.Job {
+	t.Helper()
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("Failed to encode the job: %v", err)
+	}
+	job := &batchv1.Job{}
+	if err := json.Unmarshal(data, job); err != nil {
+		t.Fatalf("Failed to decode the job: %v", err)
+	}
+	return job
+}
+
+func TestJob(t *testing.T) {
+	ctx, _ := utiltesting.ContextWithLog(t)
+	srv := &batchJobPlugin{}
+	pluginClient := startPlugin(t, srv)
+
+	baseJob := testingjob.MakeJob("job", metav1.NamespaceDefault).Parallelism(3).Obj()
+	baseJob.ResourceVersion = "1"
+	job := newJobFromObject(t, ctx, pluginClient, baseJob)
+
+	if !job.IsSuspended() || job.IsActive() {
+		t.Errorf("Unexpected state of the job, suspended=%v, active=%v", job.IsSuspended(), job.IsActive())
+	}
+	podSets, err := job.PodSets()
+	if err != nil {
+		t.Fatalf("Unexpected PodSets error: %v", err)
+	}
+	if len(podSets) != 1 || podSets[0].Count != 3 {
+		t.Errorf("Unexpected PodSets: %v", podSets)
+	}
+	if _, _, finished := job.Finished(); finished {
+		t.Error("Unexpected finished job")
+	}
+	if srv.inspectCalls != 1 {
+		t.Errorf("Unexpected number of Inspect calls, want=1, got=%d", srv.inspectCalls)
+	}
+
+	info := []podset.PodSetInfo{{
+		Name:         podSets[0].Name,
+		Count:        3,
+		NodeSelector: map[string]string{"flavor": "on-demand"},
+	}}
+	if err := job.RunWithPodSetsInfo(info); err != nil {
+		t.Fatalf("Unexpected RunWithPodSetsInfo error: %v", err)
+	}
+	started := toBatchJob(t, job.Object())
+	if ptr.Deref(started.Spec.Suspend, true) {
+		t.Error("The job was not unsuspended")
+	}
+	if diff := cmp.Diff(map[string]string{"flavor": "on-demand"}, started.Spec.Template.Spec.NodeSelector); diff != "" {
+		t.Errorf("Unexpected node selector (-want,+got):\n%s", diff)
+	}
+	if job.IsSuspended() {
+		t.Error("The started job is reported as suspended")
+	}
+
+	cl := utiltesting.NewClientBuilder().WithObjects(started).Build()
+	stoppedNow, err := job.Stop(ctx, cl, []podset.PodSetInfo{{Name: podSets[0].Name, Count: 3}}, jobframework.StopReasonWorkloadEvicted, "")
+	if err != nil {
+		t.Fatalf("Unexpected Stop error: %v", err)
+	}
+	if !stoppedNow {
+		t.Error("The job was not stopped")
+	}
+	stopped := &batchv1.Job{}
+	if err := cl.Get(ctx, client.ObjectKeyFromObject(started), stopped); err != nil {
+		t.Fatalf("Failed to get the job: %v", err)
+	}
+	if !ptr.Deref(stopped.Spec.Suspend, false) {
+		t.Error("The stopped job is not suspended")
+	}
+	if len(stopped.Spec.Template.Spec.NodeSelector) != 0 {
+		t.Errorf("The node selector was not restored: %v", stopped.Spec.Template.Spec.NodeSelector)
+	}
+}
+
+func TestJobPluginUnavailable(t *testing.T) {
+	ctx, _ := utiltesting.ContextWithLog(t)
+	conn, err := grpc.NewClient("unix://"+filepath.Join(t.TempDir(), "missing.sock"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to create the client: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	job := newJobFromObject(t, ctx, NewJobPluginClient(conn), testingjob.MakeJob("job", metav1.NamespaceDefault).Suspend(false).Obj())
+
+	if !job.IsSuspended() {
+		t.Error("The job of an unavailable plugin is not reported as suspended")
+	}
+	if _, err := job.PodSets(); err == nil {
+		t.Error("Expected a PodSets error for an unavailable plugin")
+	}
+}
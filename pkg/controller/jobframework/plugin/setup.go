@@ -0,0 +1,114 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+)
+
+var errPluginNameFormat = errors.New("plugin name must be format, 'Kind.version.group.com'")
+
+// SetupIndexes setups the indexers for the jobs managed through the plugins.
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer, plugins []configapi.IntegrationPlugin) error {
+	for _, p := range plugins {
+		gvk, err := parseGVK(p.Name)
+		if err != nil {
+			return err
+		}
+		if err := jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk); err != nil {
+			return fmt.Errorf("plugin %q: %w", p.Name, err)
+		}
+	}
+	return nil
+}
+
+// SetupControllers connects to the plugins and setups the reconcilers
+// of the jobs managed through the plugins.
+func SetupControllers(mgr ctrl.Manager, plugins []configapi.IntegrationPlugin, opts ...jobframework.Option) error {
+	for _, p := range plugins {
+		gvk, err := parseGVK(p.Name)
+		if err != nil {
+			return err
+		}
+		conn, err := grpc.NewClient(p.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return fmt.Errorf("plugin %q: connecting to %q: %w", p.Name, p.Address, err)
+		}
+		if err := jobframework.RegisterExternalJobType(p.Name); err != nil {
+			return err
+		}
+		r := NewReconciler(gvk, NewJobPluginClient(conn), mgr.GetClient(),
+			mgr.GetEventRecorderFor(fmt.Sprintf("%s-plugin-controller", p.Name)), opts...)
+		if err := r.SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("plugin %q: %w", p.Name, err)
+		}
+	}
+	return nil
+}
+
+func parseGVK(name string) (schema.GroupVersionKind, error) {
+	gvk, _ := schema.ParseKindArg(name)
+	if gvk == nil {
+		return schema.GroupVersionKind{}, fmt.Errorf("%w: %q", errPluginNameFormat, name)
+	}
+	return *gvk, nil
+}
+
+// Reconciler reconciles the jobs of a GVK managed through a plugin.
+type Reconciler struct {
+	jr     *jobframework.JobReconciler
+	gvk    schema.GroupVersionKind
+	plugin JobPluginClient
+}
+
+var _ jobframework.JobReconcilerInterface = (*Reconciler)(nil)
+
+// NewReconciler returns a reconciler of the jobs of the gvk, managed through the plugin.
+func NewReconciler(gvk schema.GroupVersionKind, plugin JobPluginClient, c client.Client, record record.EventRecorder, opts ...jobframework.Option) *Reconciler {
+	return &Reconciler{
+		jr:     jobframework.NewReconciler(c, record, opts...),
+		gvk:    gvk,
+		plugin: plugin,
+	}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.jr.ReconcileGenericJob(ctx, req, NewJob(ctx, r.gvk, r.plugin))
+}
+
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(r.gvk)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(obj).
+		Owns(&kueue.Workload{}).
+		Complete(r)
+}
@@ -18,6 +18,7 @@ package jobframework
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -74,6 +75,7 @@ var (
 	ErrNoMatchingWorkloads            = errors.New("no matching workloads")
 	ErrExtraWorkloads                 = errors.New("extra workloads")
 	ErrPrebuiltWorkloadNotFound       = errors.New("prebuilt workload not found")
+	ErrWorkloadOwnedByAnotherObject   = errors.New("workload owned by another object")
 )
 
 type WorkloadRetentionPolicy struct {
@@ -90,6 +92,7 @@ type JobReconciler struct {
 	labelKeysToCopy              []string
 	clock                        clock.Clock
 	workloadRetentionPolicy      WorkloadRetentionPolicy
+	preemptionGracePeriod        time.Duration
 }
 
 type Options struct {
@@ -106,6 +109,8 @@ type Options struct {
 	Cache                        *schdcache.Cache
 	Clock                        clock.Clock
 	WorkloadRetentionPolicy      WorkloadRetentionPolicy
+	PreemptionGracePeriod        time.Duration
+	ChildJobsPolicies            []configapi.ChildJobsPolicy
 }
 
 // Option configures the reconciler.
@@ -226,6 +231,24 @@ func WithObjectRetentionPolicies(value *configapi.ObjectRetentionPolicies) Optio
 	}
 }
 
+// WithGracefulPreemption sets the duration a preempted job is given to checkpoint
+// before it is stopped.
+func WithGracefulPreemption(value *configapi.GracefulPreemption) Option {
+	return func(o *Options) {
+		if value != nil && value.GracePeriod != nil {
+			o.PreemptionGracePeriod = value.GracePeriod.Duration
+		}
+	}
+}
+
+// WithChildJobsPolicies sets the policies of the jobs owned by the objects of the
+// configured kinds.
+func WithChildJobsPolicies(value []configapi.ChildJobsPolicy) Option {
+	return func(o *Options) {
+		o.ChildJobsPolicies = value
+	}
+}
+
 var defaultOptions = Options{
 	Clock: clock.RealClock{},
 }
@@ -245,6 +268,7 @@ func NewReconciler(
 		labelKeysToCopy:              options.LabelKeysToCopy,
 		clock:                        options.Clock,
 		workloadRetentionPolicy:      options.WorkloadRetentionPolicy,
+		preemptionGracePeriod:        options.PreemptionGracePeriod,
 	}
 }
 
@@ -527,9 +551,22 @@ func (r *JobReconciler) ReconcileGenericJob(ctx context.Context, req ctrl.Reques
 	// 6. handle eviction
 	if evCond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadEvicted); evCond != nil && evCond.Status == metav1.ConditionTrue {
 		log.V(3).Info("Handling a job with evicted condition")
+		if deadline, ok := r.checkpointDeadline(job, evCond); ok {
+			if remaining := deadline.Sub(r.clock.Now()); remaining > 0 && !checkpointReady(object) {
+				log.V(3).Info("Waiting for the job to checkpoint before stopping it", "deadline", deadline)
+				if err := r.requestCheckpoint(ctx, job, wl, deadline, evCond.Message); err != nil {
+					return ctrl.Result{}, err
+				}
+				return ctrl.Result{RequeueAfter: remaining}, nil
+			}
+		}
 		if err := r.stopJob(ctx, job, wl, StopReasonWorkloadEvicted, evCond.Message); err != nil {
 			return ctrl.Result{}, err
 		}
+		if updated, err := r.clearCheckpointRequest(ctx, job, wl); err != nil || updated {
+			// The workload update triggers a new reconcile clearing its admission.
+			return ctrl.Result{}, err
+		}
 		if workload.HasQuotaReservation(wl) {
 			if !job.IsActive() {
 				log.V(6).Info("The job is no longer active, clear the workloads admission")
@@ -589,7 +626,7 @@ func (r *JobReconciler) ReconcileGenericJob(ctx context.Context, req ctrl.Reques
 			return ctrl.Result{}, err
 		}
 		// update workload priority if job's label changed
-		if WorkloadPriorityClassName(object) != wl.Spec.PriorityClassName {
+		if WorkloadPriorityClassName(object) != wl.Spec.PriorityClassName && !priorityChangedOnWorkload(object, wl) {
 			log.V(2).Info("Job changed priority, updating workload", "oldPriority", wl.Spec.PriorityClassName, "newPriority", WorkloadPriorityClassName(object))
 			if _, err = r.updateWorkloadToMatchJob(ctx, job, object, wl); err != nil {
 				log.Error(err, "Updating workload priority")
@@ -618,6 +655,9 @@ func (r *JobReconciler) ReconcileGenericJob(ctx context.Context, req ctrl.Reques
 	}
 
 	if workloadSliceEnabled(job) {
+		if updated, err := r.reconcileElasticParallelism(ctx, job, wl); updated || err != nil {
+			return ctrl.Result{}, err
+		}
 		// Start workload-slice schedule-gated pods (if any).
 		log.V(3).Info("Job running with admitted workload slice, start pods.")
 		return ctrl.Result{}, workloadslicing.StartWorkloadSlicePods(ctx, r.client, object)
@@ -1041,8 +1081,19 @@ func EquivalentToWorkload(ctx context.Context, c client.Client, job GenericJob,
 		return false, err
 	}
 	jobPodSets := clearMinCountsIfFeatureDisabled(getPodSets)
+	wlPodSets := wl.Spec.PodSets
+	runningPodSets := expectedRunningPodSets(ctx, c, wl)
+	if features.Enabled(features.PodTemplateDeduplication) {
+		// The large pod templates of the workload are compacted, so compare
+		// the compacted pod templates of both sides.
+		jobPodSets = workload.CompactPodSets(jobPodSets)
+		wlPodSets = workload.CompactPodSets(wlPodSets)
+		if runningPodSets != nil {
+			runningPodSets = workload.CompactPodSets(runningPodSets)
+		}
+	}
 
-	if runningPodSets := expectedRunningPodSets(ctx, c, wl); runningPodSets != nil {
+	if runningPodSets != nil {
 		if equality.ComparePodSetSlices(jobPodSets, runningPodSets, workload.IsAdmitted(wl)) {
 			return true, nil
 		}
@@ -1050,12 +1101,24 @@ func EquivalentToWorkload(ctx context.Context, c client.Client, job GenericJob,
 		// against the non-running info.
 		// This might allow some violating jobs to pass equivalency checks, but their
 		// workloads would be invalidated in the next sync after unsuspending.
-		return job.IsSuspended() && equality.ComparePodSetSlices(jobPodSets, wl.Spec.PodSets, workload.IsAdmitted(wl)), nil
+		return job.IsSuspended() && equality.ComparePodSetSlices(jobPodSets, wlPodSets, workload.IsAdmitted(wl)), nil
 	}
 
-	return equality.ComparePodSetSlices(jobPodSets, wl.Spec.PodSets, workload.IsAdmitted(wl)), nil
+	return equality.ComparePodSetSlices(jobPodSets, wlPodSets, workload.IsAdmitted(wl)), nil
+}
+
+// priorityChangedOnWorkload returns true if the priority was changed directly
+// on the workload, and the priority class of the job was not changed since.
+func priorityChangedOnWorkload(object client.Object, wl *kueue.Workload) bool {
+	if !features.Enabled(features.WorkloadPriorityChange) {
+		return false
+	}
+	change, err := workload.GetPriorityChange(wl)
+	return err == nil && change != nil && change.PreviousPriorityClassName == WorkloadPriorityClassName(object)
 }
 
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/priority,verbs=update
+
 func (r *JobReconciler) updateWorkloadToMatchJob(ctx context.Context, job GenericJob, object client.Object, wl *kueue.Workload) (*kueue.Workload, error) {
 	newWl, err := r.constructWorkload(ctx, job)
 	if err != nil {
@@ -1066,6 +1129,15 @@ func (r *JobReconciler) updateWorkloadToMatchJob(ctx context.Context, job Generi
 		return nil, fmt.Errorf("can't construct workload for update: %w", err)
 	}
 	wl.Spec = newWl.Spec
+	if features.Enabled(features.PodTemplateDeduplication) {
+		configMaps, err := workload.CompactPodTemplates(wl)
+		if err != nil {
+			return nil, fmt.Errorf("compacting the pod templates of the workload: %w", err)
+		}
+		if err := r.storePodTemplates(ctx, wl, configMaps); err != nil {
+			return nil, err
+		}
+	}
 	if err = r.client.Update(ctx, wl); err != nil {
 		return nil, fmt.Errorf("updating existed workload: %w", err)
 	}
@@ -1146,6 +1218,132 @@ func (r *JobReconciler) stopJob(ctx context.Context, job GenericJob, wl *kueue.W
 	return nil
 }
 
+// checkpointDeadline returns the time until which a job preempted by Kueue is
+// allowed to keep running, so that it can checkpoint its progress.
+// The second return value is false if graceful preemption does not apply to the job.
+func (r *JobReconciler) checkpointDeadline(job GenericJob, evCond *metav1.Condition) (time.Time, bool) {
+	if !features.Enabled(features.GracefulPreemption) || r.preemptionGracePeriod <= 0 {
+		return time.Time{}, false
+	}
+	if evCond.Reason != kueue.WorkloadEvictedByPreemption || job.IsSuspended() {
+		return time.Time{}, false
+	}
+	if _, isComposable := job.(ComposableJob); isComposable {
+		return time.Time{}, false
+	}
+	return evCond.LastTransitionTime.Add(r.preemptionGracePeriod), true
+}
+
+// checkpointReady returns true if the job acknowledged that its checkpoint is saved.
+func checkpointReady(object client.Object) bool {
+	_, found := object.GetAnnotations()[controllerconsts.CheckpointReadyAnnotation]
+	return found
+}
+
+// requestCheckpoint signals the job that it is going to be stopped at the deadline
+// by setting the CheckpointRequestedAnnotation, and sets the PreemptionRequested
+// condition of the workload.
+func (r *JobReconciler) requestCheckpoint(ctx context.Context, job GenericJob, wl *kueue.Workload, deadline time.Time, eventMsg string) error {
+	object := job.Object()
+	deadlineStr := deadline.UTC().Format(time.RFC3339)
+	if _, found := object.GetAnnotations()[controllerconsts.CheckpointRequestedAnnotation]; !found {
+		if err := clientutil.Patch(ctx, r.client, object, func() (client.Object, bool, error) {
+			annotations := object.GetAnnotations()
+			if annotations == nil {
+				annotations = make(map[string]string, 1)
+			}
+			annotations[controllerconsts.CheckpointRequestedAnnotation] = deadlineStr
+			object.SetAnnotations(annotations)
+			return object, true, nil
+		}); err != nil {
+			return err
+		}
+		r.record.Eventf(object, corev1.EventTypeNormal, ReasonCheckpointRequested, "%s, the job will be stopped at %s", eventMsg, deadlineStr)
+	}
+	if apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadPreemptionRequested) {
+		return nil
+	}
+	message := fmt.Sprintf("%s, the job will be stopped once its checkpoint is ready, or at %s", eventMsg, deadlineStr)
+	return workload.UpdateStatus(ctx, r.client, wl, kueue.WorkloadPreemptionRequested, metav1.ConditionTrue,
+		kueue.WorkloadCheckpointRequested, message, constants.JobControllerName, r.clock)
+}
+
+// clearCheckpointRequest removes the CheckpointRequestedAnnotation and the
+// CheckpointReadyAnnotation from the stopped job, and sets the PreemptionRequested
+// condition of the workload to false. Returns true if the workload was updated.
+func (r *JobReconciler) clearCheckpointRequest(ctx context.Context, job GenericJob, wl *kueue.Workload) (bool, error) {
+	object := job.Object()
+	ready := checkpointReady(object)
+	_, requested := object.GetAnnotations()[controllerconsts.CheckpointRequestedAnnotation]
+	if requested || ready {
+		if err := clientutil.Patch(ctx, r.client, object, func() (client.Object, bool, error) {
+			annotations := object.GetAnnotations()
+			delete(annotations, controllerconsts.CheckpointRequestedAnnotation)
+			delete(annotations, controllerconsts.CheckpointReadyAnnotation)
+			object.SetAnnotations(annotations)
+			return object, true, nil
+		}); err != nil {
+			return false, err
+		}
+	}
+	if !apimeta.IsStatusConditionTrue(wl.Status.Conditions, kueue.WorkloadPreemptionRequested) {
+		return false, nil
+	}
+	reason, message := kueue.WorkloadCheckpointDeadlineExceeded, "The job was stopped at the end of the grace period"
+	if ready {
+		reason, message = kueue.WorkloadCheckpointReady, "The job was stopped once its checkpoint was ready"
+	}
+	return true, workload.UpdateStatus(ctx, r.client, wl, kueue.WorkloadPreemptionRequested, metav1.ConditionFalse,
+		reason, message, constants.JobControllerName, r.clock)
+}
+
+// reconcileElasticParallelism shrinks the job when it is requested by the scheduler,
+// and restores the parallelism of a shrunk job once its workload slice is scaled down.
+// Restoring the parallelism creates a new workload slice, admitted once the quota
+// is available again. Returns true if the job or the workload was updated.
+func (r *JobReconciler) reconcileElasticParallelism(ctx context.Context, job GenericJob, wl *kueue.Workload) (bool, error) {
+	ejob, implements := job.(JobWithElasticParallelism)
+	if !implements || !features.Enabled(features.ElasticJobsShrinkOnPreemption) {
+		return false, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	object := job.Object()
+
+	if message, requested := wl.Annotations[controllerconsts.ShrinkRequestedAnnotation]; requested {
+		if ejob.Shrink() {
+			log.V(2).Info("Shrinking the job to make room for a preempting workload")
+			if err := r.client.Update(ctx, object); err != nil {
+				return false, err
+			}
+			r.record.Eventf(object, corev1.EventTypeNormal, ReasonShrunk, "%s, reduced the parallelism of the job", message)
+		}
+		// The workload slice is no longer shrinkable, it is evicted if more quota is needed.
+		return true, clientutil.Patch(ctx, r.client, wl, func() (client.Object, bool, error) {
+			delete(wl.Annotations, controllerconsts.ShrinkRequestedAnnotation)
+			delete(wl.Annotations, controllerconsts.ShrinkableAnnotation)
+			return wl, true, nil
+		})
+	}
+
+	podSets, err := job.PodSets()
+	if err != nil {
+		return false, err
+	}
+	if !workload.ExtractPodSetCounts(podSets).EqualTo(workload.ExtractPodSetCountsFromWorkload(wl)) {
+		// Wait for the workload slice to be scaled down.
+		return false, nil
+	}
+	if !ejob.RestoreParallelism() {
+		return false, nil
+	}
+	log.V(2).Info("Restoring the parallelism of the shrunk job")
+	if err := r.client.Update(ctx, object); err != nil {
+		return false, err
+	}
+	r.record.Event(object, corev1.EventTypeNormal, ReasonParallelismRestored, "Restored the parallelism of the job, the added pods start once admitted")
+	return true, nil
+}
+
 func (r *JobReconciler) finalizeJob(ctx context.Context, job GenericJob) error {
 	if jwf, implements := job.(JobWithFinalize); implements {
 		if err := jwf.Finalize(ctx, r.client); err != nil {
@@ -1249,6 +1447,9 @@ func (r *JobReconciler) prepareWorkload(ctx context.Context, job GenericJob, wl
 	wl.Spec.PodSets = clearMinCountsIfFeatureDisabled(wl.Spec.PodSets)
 
 	if workloadSliceEnabled(job) {
+		if ejob, implements := job.(JobWithElasticParallelism); implements && features.Enabled(features.ElasticJobsShrinkOnPreemption) && ejob.CanShrink() {
+			metav1.SetMetaDataAnnotation(&wl.ObjectMeta, controllerconsts.ShrinkableAnnotation, "true")
+		}
 		return prepareWorkloadSlice(ctx, r.client, job, wl)
 	}
 	return nil
@@ -1295,7 +1496,7 @@ func getPodSetsInfoFromStatus(ctx context.Context, c client.Client, w *kueue.Wor
 		if err != nil {
 			return nil, err
 		}
-		if features.Enabled(features.TopologyAwareScheduling) {
+		if features.Enabled(features.TopologyAwareScheduling) || features.Enabled(features.FlavorFailover) || features.Enabled(features.WorkloadActualUsage) {
 			info.Annotations[kueue.WorkloadAnnotation] = w.Name
 		}
 
@@ -1348,7 +1549,28 @@ func (r *JobReconciler) handleJobWithNoWorkload(ctx context.Context, job Generic
 	if err != nil {
 		return err
 	}
+	var podTemplates []*corev1.ConfigMap
+	if features.Enabled(features.PodTemplateDeduplication) {
+		if podTemplates, err = workload.CompactPodTemplates(wl); err != nil {
+			return fmt.Errorf("compacting the pod templates of the workload: %w", err)
+		}
+	}
 	if err = r.client.Create(ctx, wl); err != nil {
+		if _, composable := job.(ComposableJob); apierrors.IsAlreadyExists(err) && !composable {
+			return r.adoptWorkload(ctx, object, wl, err)
+		}
+		return err
+	}
+	if err := r.storePodTemplates(ctx, wl, podTemplates); err != nil {
+		// The workload, which isn't used yet, is deleted, so that its pod
+		// templates are stored again when it is recreated.
+		deleteErr := workload.RemoveFinalizer(ctx, r.client, wl)
+		if deleteErr == nil {
+			deleteErr = r.client.Delete(ctx, wl, client.Preconditions{UID: &wl.UID})
+		}
+		if client.IgnoreNotFound(deleteErr) != nil {
+			return errors.Join(err, deleteErr)
+		}
 		return err
 	}
 	r.record.Eventf(object, corev1.EventTypeNormal, ReasonCreatedWorkload,
@@ -1356,6 +1578,98 @@ func (r *JobReconciler) handleJobWithNoWorkload(ctx context.Context, job Generic
 	return nil
 }
 
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;patch
+
+// storePodTemplates creates the ConfigMaps storing the full pod templates of
+// the compacted podSets of the workload, owned by the workload. The
+// ConfigMaps are shared by the workloads with identical pod templates, so an
+// existing ConfigMap holding the same pod template only gets the workload
+// added to its owners.
+func (r *JobReconciler) storePodTemplates(ctx context.Context, wl *kueue.Workload, configMaps []*corev1.ConfigMap) error {
+	ownerRef := metav1.OwnerReference{
+		APIVersion: kueue.GroupVersion.String(),
+		Kind:       "Workload",
+		Name:       wl.Name,
+		UID:        wl.UID,
+	}
+	for _, cm := range configMaps {
+		cm.OwnerReferences = []metav1.OwnerReference{ownerRef}
+		err := r.client.Create(ctx, cm)
+		if err == nil {
+			continue
+		}
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("storing the pod template of the workload %s: %w", workload.Key(wl), err)
+		}
+		// The names of the ConfigMaps are predictable, so the existing
+		// ConfigMap is only shared if it holds the same pod template.
+		test, err := json.Marshal([]map[string]any{{
+			"op":    "test",
+			"path":  "/data/" + controllerconsts.PodTemplateConfigMapKey,
+			"value": cm.Data[controllerconsts.PodTemplateConfigMapKey],
+		}})
+		if err != nil {
+			return err
+		}
+		if err := r.client.Patch(ctx, cm, client.RawPatch(types.JSONPatchType, test)); err != nil {
+			return fmt.Errorf("verifying the pod template %s of the workload %s: %w", cm.Name, workload.Key(wl), err)
+		}
+		// The owner references are merged by UID, so the patch adds the
+		// workload to the owners of the ConfigMap without reading it.
+		patch, err := json.Marshal(map[string]any{
+			"metadata": map[string]any{"ownerReferences": []metav1.OwnerReference{ownerRef}},
+		})
+		if err != nil {
+			return err
+		}
+		if err := r.client.Patch(ctx, cm, client.RawPatch(types.StrategicMergePatchType, patch)); err != nil {
+			return fmt.Errorf("adding the workload %s to the owners of the pod template %s: %w", workload.Key(wl), cm.Name, err)
+		}
+	}
+	return nil
+}
+
+// adoptWorkload handles the creation of a workload which name, derived from
+// the UID of the job, is already taken. Either the cache is not synced yet
+// with the workload of the job, for example after a restart or a failover of
+// the manager, or the workload lost its owner reference, in which case it is
+// adopted by the job instead of creating a duplicate workload, as long as its
+// job UID label matches the job.
+// The workloads of the ComposableJobs, like the Pod groups, are not adopted:
+// they are owned by all the objects of the group, not by a single job.
+func (r *JobReconciler) adoptWorkload(ctx context.Context, object client.Object, wl *kueue.Workload, createErr error) error {
+	log := ctrl.LoggerFrom(ctx)
+	existing := &kueue.Workload{}
+	if err := r.client.Get(ctx, client.ObjectKeyFromObject(wl), existing); err != nil {
+		if apierrors.IsNotFound(err) {
+			return createErr
+		}
+		return err
+	}
+	if metav1.IsControlledBy(existing, object) {
+		log.V(2).Info("The workload of the job already exists", "workload", klog.KObj(existing))
+		return nil
+	}
+	if controlledBy := metav1.GetControllerOfNoCopy(existing); controlledBy != nil {
+		return fmt.Errorf("%w: the workload %s is controlled by %s %s", ErrWorkloadOwnedByAnotherObject, klog.KObj(existing), controlledBy.Kind, controlledBy.Name)
+	}
+	// Only the workloads created for this job are adopted, so a workload
+	// without the UID of the job, for example created by a user, is not.
+	jobUID, found := existing.Labels[controllerconsts.JobUIDLabel]
+	if !found {
+		return fmt.Errorf("%w: the workload %s has no %s label", ErrWorkloadOwnedByAnotherObject, klog.KObj(existing), controllerconsts.JobUIDLabel)
+	}
+	if jobUID != string(object.GetUID()) {
+		return fmt.Errorf("%w: the workload %s was created for the job with the UID %s", ErrWorkloadOwnedByAnotherObject, klog.KObj(existing), jobUID)
+	}
+	if err := EnsurePrebuiltWorkloadOwnership(ctx, r.client, existing, object); err != nil {
+		return err
+	}
+	r.record.Eventf(object, corev1.EventTypeNormal, ReasonAdoptedWorkload,
+		"Adopted Workload: %v", workload.Key(existing))
+	return nil
+}
+
 func (r *JobReconciler) ignoreUnretryableError(log logr.Logger, err error) error {
 	if IsUnretryableError(err) {
 		log.V(2).Info("Received an unretryable error", "error", err)
@@ -207,6 +207,7 @@ func TestFindAncestorJobManagedByKueue(t *testing.T) {
 		manageJobsWithoutQueueName bool
 		integrations               []string
 		externalFrameworks         []string
+		childJobsPolicies          []configapi.ChildJobsPolicy
 		ancestors                  []client.Object
 		job                        client.Object
 		wantManaged                client.Object
@@ -225,6 +226,13 @@ func TestFindAncestorJobManagedByKueue(t *testing.T) {
 				OwnerReference(cronJob.Name, batchv1.SchemeGroupVersion.WithKind("CronJob")).
 				Obj(),
 		},
+		"child job has ownerReference with CronJob integration enabled, and the CronJob has a queue-name": {
+			integrations: []string{"batch/job", "batch/cronjob"},
+			ancestors:    []client.Object{cronJobWithQueueNameLabel.DeepCopy()},
+			job: testingjob.MakeJob(childJobName, jobNamespace).
+				OwnerReference(cronJob.Name, batchv1.SchemeGroupVersion.WithKind("CronJob")).
+				Obj(),
+		},
 		"child job has ownerReference with unknown non-existing workload owner": {
 			job: testingjob.MakeJob(childJobName, jobNamespace).
 				OwnerReference(cronJob.Name, kfmpi.SchemeGroupVersionKind).
@@ -522,11 +530,74 @@ func TestFindAncestorJobManagedByKueue(t *testing.T) {
 				Obj(),
 			wantManaged: testingdeployment.MakeDeployment("deploy", jobNamespace).UID("deploy").Queue("test-q").Obj(),
 		},
+		"Job -> DaemonSet -> MPIJob (queue-name) => DaemonSet not known": {
+			integrations: []string{"kubeflow.org/mpijob", "batch/job"},
+			ancestors: []client.Object{
+				testingmpijob.MakeMPIJob(parentJobName, jobNamespace).UID(parentJobName).Queue("test-q").Obj(),
+				&appsv1.DaemonSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ds",
+						Namespace: jobNamespace,
+						UID:       "ds",
+						OwnerReferences: []metav1.OwnerReference{{
+							Name:       parentJobName,
+							APIVersion: kfmpi.SchemeGroupVersion.String(),
+							Kind:       kfmpi.SchemeGroupVersionKind.Kind,
+							UID:        types.UID(parentJobName),
+							Controller: ptr.To(true),
+						}},
+					},
+				},
+			},
+			job: testingjob.MakeJob(childJobName, jobNamespace).UID(childJobName).
+				OwnerReference("ds", appsv1.SchemeGroupVersion.WithKind("DaemonSet")).
+				Obj(),
+		},
+		"Job -> DaemonSet (Exempt policy) -> MPIJob (queue-name) => MPIJob": {
+			integrations: []string{"kubeflow.org/mpijob", "batch/job"},
+			childJobsPolicies: []configapi.ChildJobsPolicy{
+				{OwnerKind: "DaemonSet.v1.apps", Policy: configapi.ChildJobsPolicyExempt},
+			},
+			ancestors: []client.Object{
+				testingmpijob.MakeMPIJob(parentJobName, jobNamespace).UID(parentJobName).Queue("test-q").Obj(),
+				&appsv1.DaemonSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ds",
+						Namespace: jobNamespace,
+						UID:       "ds",
+						OwnerReferences: []metav1.OwnerReference{{
+							Name:       parentJobName,
+							APIVersion: kfmpi.SchemeGroupVersion.String(),
+							Kind:       kfmpi.SchemeGroupVersionKind.Kind,
+							UID:        types.UID(parentJobName),
+							Controller: ptr.To(true),
+						}},
+					},
+				},
+			},
+			job: testingjob.MakeJob(childJobName, jobNamespace).UID(childJobName).
+				OwnerReference("ds", appsv1.SchemeGroupVersion.WithKind("DaemonSet")).
+				Obj(),
+			wantManaged: testingmpijob.MakeMPIJob(parentJobName, jobNamespace).UID(parentJobName).Queue("test-q").Obj(),
+		},
+		"child job of a managed owner with the Queue policy is a top-level job": {
+			integrations: []string{"kubeflow.org/mpijob"},
+			childJobsPolicies: []configapi.ChildJobsPolicy{
+				{OwnerKind: "MPIJob.v2beta1.kubeflow.org", Policy: configapi.ChildJobsPolicyQueue},
+			},
+			ancestors: []client.Object{
+				testingmpijob.MakeMPIJob(parentJobName, jobNamespace).UID(parentJobName).Queue("test-q").Obj(),
+			},
+			job: testingjob.MakeJob(childJobName, jobNamespace).
+				OwnerReference(parentJobName, kfmpi.SchemeGroupVersionKind).
+				Obj(),
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			t.Cleanup(EnableIntegrationsForTest(t, tc.integrations...))
 			t.Cleanup(EnableExternalIntegrationsForTest(t, tc.externalFrameworks...))
+			t.Cleanup(SetChildJobsPoliciesForTest(t, tc.childJobsPolicies...))
 			ctx, _ := utiltesting.ContextWithLog(t)
 			recorder := &utiltesting.EventRecorder{}
 			builder := utiltesting.NewClientBuilder(kfmpi.AddToScheme, awv1beta2.AddToScheme, v1alpha2.AddToScheme)
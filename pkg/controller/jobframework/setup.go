@@ -65,6 +65,9 @@ func (m *integrationManager) setupControllers(ctx context.Context, mgr ctrl.Mana
 			return err
 		}
 	}
+	if err := m.setChildJobsPolicies(options.ChildJobsPolicies); err != nil {
+		return err
+	}
 	return m.forEach(func(name string, cb IntegrationCallbacks) error {
 		logger := log.WithValues("jobFrameworkName", name)
 		fwkNamePrefix := fmt.Sprintf("jobFrameworkName %q", name)
@@ -34,6 +34,9 @@ func ValidateTASPodSetRequest(replicaPath *field.Path, replicaMetadata *metav1.O
 	_, unconstrainedFound := replicaMetadata.Annotations[kueuebeta.PodSetUnconstrainedTopologyAnnotation]
 	sliceRequiredValue, sliceRequiredFound := replicaMetadata.Annotations[kueuebeta.PodSetSliceRequiredTopologyAnnotation]
 	_, sliceSizeFound := replicaMetadata.Annotations[kueuebeta.PodSetSliceSizeAnnotation]
+	exclusiveValue, exclusiveFound := replicaMetadata.Annotations[kueuebeta.PodSetExclusiveTopologyAnnotation]
+	balancedValue, balancedFound := replicaMetadata.Annotations[kueuebeta.PodSetBalancedTopologyAnnotation]
+	_, balancedDomainCountFound := replicaMetadata.Annotations[kueuebeta.PodSetBalancedDomainCountAnnotation]
 
 	// validate no more than 1 annotation
 	asInt := func(b bool) int {
@@ -63,6 +66,12 @@ func ValidateTASPodSetRequest(replicaPath *field.Path, replicaMetadata *metav1.O
 	if sliceRequiredFound {
 		allErrs = append(allErrs, metavalidation.ValidateLabelName(sliceRequiredValue, annotationsPath.Key(kueuebeta.PodSetSliceRequiredTopologyAnnotation))...)
 	}
+	if exclusiveFound {
+		allErrs = append(allErrs, metavalidation.ValidateLabelName(exclusiveValue, annotationsPath.Key(kueuebeta.PodSetExclusiveTopologyAnnotation))...)
+	}
+	if balancedFound {
+		allErrs = append(allErrs, metavalidation.ValidateLabelName(balancedValue, annotationsPath.Key(kueuebeta.PodSetBalancedTopologyAnnotation))...)
+	}
 
 	// validate PodSetGroupName annotation
 	podSetGroupNameValue, podSetGroupNameFound := replicaMetadata.Annotations[kueuebeta.PodSetGroupName]
@@ -87,9 +96,70 @@ func ValidateTASPodSetRequest(replicaPath *field.Path, replicaMetadata *metav1.O
 		allErrs = append(allErrs, field.Forbidden(annotationsPath.Key(kueuebeta.PodSetSliceSizeAnnotation), fmt.Sprintf("cannot be set when '%s' is not present", kueuebeta.PodSetSliceRequiredTopologyAnnotation)))
 	}
 
+	// validate exclusive topology annotation
+	if exclusiveFound && annotationFoundCount == 0 {
+		allErrs = append(allErrs, field.Forbidden(annotationsPath.Key(kueuebeta.PodSetExclusiveTopologyAnnotation),
+			fmt.Sprintf("cannot be set when none of [%q, %q, %q] is present",
+				kueuebeta.PodSetRequiredTopologyAnnotation,
+				kueuebeta.PodSetPreferredTopologyAnnotation,
+				kueuebeta.PodSetUnconstrainedTopologyAnnotation),
+		))
+	}
+
+	// validate balanced topology annotations
+	allErrs = append(allErrs, validateBalancedDomainCountAnnotation(annotationsPath, replicaMetadata)...)
+	if balancedFound && !balancedDomainCountFound {
+		allErrs = append(allErrs, field.Required(annotationsPath.Key(kueuebeta.PodSetBalancedDomainCountAnnotation), "balanced domain count is required if balanced topology is requested"))
+	}
+	if !balancedFound && balancedDomainCountFound {
+		allErrs = append(allErrs, field.Forbidden(annotationsPath.Key(kueuebeta.PodSetBalancedDomainCountAnnotation), fmt.Sprintf("cannot be set when '%s' is not present", kueuebeta.PodSetBalancedTopologyAnnotation)))
+	}
+	if balancedFound && annotationFoundCount == 0 {
+		allErrs = append(allErrs, field.Forbidden(annotationsPath.Key(kueuebeta.PodSetBalancedTopologyAnnotation),
+			fmt.Sprintf("cannot be set when none of [%q, %q, %q] is present",
+				kueuebeta.PodSetRequiredTopologyAnnotation,
+				kueuebeta.PodSetPreferredTopologyAnnotation,
+				kueuebeta.PodSetUnconstrainedTopologyAnnotation),
+		))
+	}
+	if balancedFound && (sliceRequiredFound || sliceSizeFound) {
+		allErrs = append(allErrs, field.Forbidden(annotationsPath.Key(kueuebeta.PodSetBalancedTopologyAnnotation),
+			fmt.Sprintf("cannot be set along with [%q, %q]",
+				kueuebeta.PodSetSliceRequiredTopologyAnnotation,
+				kueuebeta.PodSetSliceSizeAnnotation),
+		))
+	}
+
 	return allErrs
 }
 
+func validateBalancedDomainCountAnnotation(annotationsPath *field.Path, replicaMetadata *metav1.ObjectMeta) field.ErrorList {
+	domainCountValue, domainCountFound := replicaMetadata.Annotations[kueuebeta.PodSetBalancedDomainCountAnnotation]
+	if !domainCountFound {
+		return nil
+	}
+
+	val, err := strconv.ParseInt(domainCountValue, 10, 32)
+	if err != nil {
+		return field.ErrorList{
+			field.Invalid(
+				annotationsPath.Key(kueuebeta.PodSetBalancedDomainCountAnnotation), domainCountValue, "must be a numeric value",
+			),
+		}
+	}
+
+	if int32(val) < 1 {
+		return field.ErrorList{
+			field.Invalid(
+				annotationsPath.Key(kueuebeta.PodSetBalancedDomainCountAnnotation), domainCountValue,
+				"must be greater than or equal to 1",
+			),
+		}
+	}
+
+	return nil
+}
+
 func validateTASUnconstrained(annotationsPath *field.Path, replicaMetadata *metav1.ObjectMeta) field.ErrorList {
 	if val, ok := replicaMetadata.Annotations[kueuebeta.PodSetUnconstrainedTopologyAnnotation]; ok {
 		if _, err := strconv.ParseBool(val); err != nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package jobframework
 
 import (
+	"context"
 	"fmt"
 	"maps"
 	"slices"
@@ -31,15 +32,18 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/features"
 	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
+	utilwebhook "sigs.k8s.io/kueue/pkg/util/webhook"
 )
 
 var (
@@ -78,6 +82,7 @@ func ValidateJobOnUpdate(oldJob, newJob GenericJob, defaultQueueExist func(strin
 	allErrs = append(allErrs, validateUpdateForPrebuiltWorkload(oldJob, newJob)...)
 	allErrs = append(allErrs, validateUpdateForMaxExecTime(oldJob, newJob)...)
 	allErrs = append(allErrs, validateJobUpdateForWorkloadPriorityClassName(oldJob, newJob)...)
+	allErrs = append(allErrs, utilwebhook.ValidateUpdateForBillingNamespace(oldJob.Object(), newJob.Object())...)
 	return allErrs
 }
 
@@ -122,6 +127,45 @@ func ValidateQueueName(obj client.Object) field.ErrorList {
 	return allErrs
 }
 
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+// ValidateBillingNamespace checks the billing namespace of a job on creation.
+// The jobs whose owner is managed by Kueue are not checked, the workload of
+// their owner being used instead of their own.
+func ValidateBillingNamespace(ctx context.Context, c client.Client, obj client.Object) field.ErrorList {
+	if IsOwnerManagedByKueueForObject(obj) {
+		return nil
+	}
+	return utilwebhook.ValidateBillingNamespacePermission(ctx, c, obj)
+}
+
+// ValidatePodSetFlavorSelection validates the annotations of the pod template of a PodSet
+// restricting the flavors of the PodSet and overriding its flavor fungibility.
+func ValidatePodSetFlavorSelection(replicaPath *field.Path, replicaMetadata *metav1.ObjectMeta) field.ErrorList {
+	var allErrs field.ErrorList
+	annotationsPath := replicaPath.Child("annotations")
+	if value, found := replicaMetadata.Annotations[constants.PodSetFlavorsAnnotation]; found {
+		for _, flavor := range strings.Split(value, ",") {
+			for _, msg := range validation.IsDNS1123Subdomain(flavor) {
+				allErrs = append(allErrs, field.Invalid(annotationsPath.Key(constants.PodSetFlavorsAnnotation), value, msg))
+			}
+		}
+	}
+	if value, found := replicaMetadata.Annotations[constants.PodSetWhenCanBorrowAnnotation]; found {
+		supported := []string{string(kueue.Borrow), string(kueue.TryNextFlavor)}
+		if !slices.Contains(supported, value) {
+			allErrs = append(allErrs, field.NotSupported(annotationsPath.Key(constants.PodSetWhenCanBorrowAnnotation), value, supported))
+		}
+	}
+	if value, found := replicaMetadata.Annotations[constants.PodSetWhenCanPreemptAnnotation]; found {
+		supported := []string{string(kueue.Preempt), string(kueue.TryNextFlavor)}
+		if !slices.Contains(supported, value) {
+			allErrs = append(allErrs, field.NotSupported(annotationsPath.Key(constants.PodSetWhenCanPreemptAnnotation), value, supported))
+		}
+	}
+	return allErrs
+}
+
 func validateUpdateForQueueName(oldJob, newJob GenericJob, defaultQueueExist func(string) bool) field.ErrorList {
 	var allErrs field.ErrorList
 	if !newJob.IsSuspended() {
@@ -257,6 +257,7 @@ func TestValidateJobOnUpdate(t *testing.T) {
 		newJob                   *batchv1.Job
 		defaultLocalQueueEnabled bool
 		nsHasDefaultQueue        bool
+		enableBilling            bool
 		wantErr                  field.ErrorList
 	}{
 		"local queue cannot be changed if job is not suspended": {
@@ -306,11 +307,27 @@ func TestValidateJobOnUpdate(t *testing.T) {
 			nsHasDefaultQueue:        true,
 			defaultLocalQueueEnabled: false,
 		},
+		"billing namespace cannot be changed": {
+			oldJob:        utiltestingjob.MakeJob("test-job", "ns1").Suspend(true).Queue("lq1").SetAnnotation(constants.BillingNamespaceAnnotation, "ns2").Obj(),
+			newJob:        utiltestingjob.MakeJob("test-job", "ns1").Suspend(true).Queue("lq1").SetAnnotation(constants.BillingNamespaceAnnotation, "ns3").Obj(),
+			enableBilling: true,
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: field.NewPath("metadata", "annotations").Key(constants.BillingNamespaceAnnotation).String(),
+				},
+			},
+		},
+		"billing namespace can be changed if feature is not enabled": {
+			oldJob: utiltestingjob.MakeJob("test-job", "ns1").Suspend(true).Queue("lq1").SetAnnotation(constants.BillingNamespaceAnnotation, "ns2").Obj(),
+			newJob: utiltestingjob.MakeJob("test-job", "ns1").Suspend(true).Queue("lq1").SetAnnotation(constants.BillingNamespaceAnnotation, "ns3").Obj(),
+		},
 	}
 
 	for tcName, tc := range testCases {
 		t.Run(tcName, func(t *testing.T) {
 			features.SetFeatureGateDuringTest(t, features.LocalQueueDefaulting, tc.defaultLocalQueueEnabled)
+			features.SetFeatureGateDuringTest(t, features.CrossNamespaceBilling, tc.enableBilling)
 
 			mockctrl := gomock.NewController(t)
 
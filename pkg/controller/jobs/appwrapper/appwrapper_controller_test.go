@@ -87,11 +87,45 @@ func TestPodSets(t *testing.T) {
 		Parallelism(2).
 		Obj()
 
+	configMap := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		Data:       map[string]string{"config.yaml": "epochs: 10"},
+	}
+	service := &corev1.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{Name: "job"},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  map[string]string{"job-name": "job"},
+		},
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+		ObjectMeta: metav1.ObjectMeta{Name: "data"},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+		},
+	}
+
 	testCases := map[string]struct {
 		job                           *awv1beta2.AppWrapper
 		wantPodSets                   []kueue.PodSet
 		enableTopologyAwareScheduling bool
 	}{
+		"bundle with components which do not create pods": {
+			job: testingappwrapper.MakeAppWrapper("aw", "ns").
+				Component(testingappwrapper.Component{Template: configMap}).
+				Component(testingappwrapper.Component{Template: pvc}).
+				Component(testingappwrapper.Component{Template: batchJob}).
+				Component(testingappwrapper.Component{Template: service}).
+				Obj(),
+			wantPodSets: []kueue.PodSet{
+				*utiltesting.MakePodSet("aw-0", 2).
+					PodSpec(batchJob.Spec.Template.Spec).
+					Obj(),
+			},
+		},
 		"no annotations": {
 			job: testingappwrapper.MakeAppWrapper("aw", "ns").
 				Component(testingappwrapper.Component{Template: pytorchJob}).
@@ -0,0 +1,433 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argoworkflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	resourcehelpers "k8s.io/component-helpers/resource"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utilunstructured "sigs.k8s.io/kueue/pkg/util/unstructured"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+var (
+	gvk = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Workflow"}
+
+	FrameworkName = "argoproj.io/workflow"
+
+	NewReconciler = jobframework.NewGenericReconcilerFactory(NewJob)
+
+	SetupWorkflowWebhook = jobframework.BaseWebhookFactory(
+		NewJob(),
+		func(o runtime.Object) jobframework.GenericJob {
+			return fromObject(o)
+		},
+	)
+)
+
+const (
+	// WorkflowMinParallelismAnnotation enables the partial admission of a
+	// Workflow, with its parallelism reduced down to the value of the
+	// annotation when the quota doesn't cover the full parallelism.
+	WorkflowMinParallelismAnnotation = "kueue.x-k8s.io/workflow-min-parallelism"
+
+	// mainContainerName is the name of the container of the estimated pod
+	// template, requesting the resources of the largest pod of the Workflow.
+	mainContainerName = "main"
+)
+
+var errNoPodTemplates = errors.New("the Workflow has no template creating pods")
+
+func init() {
+	utilruntime.Must(jobframework.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:  SetupIndexes,
+		NewJob:        NewJob,
+		NewReconciler: NewReconciler,
+		SetupWebhook:  SetupWorkflowWebhook,
+		JobType:       utilunstructured.NewObject(gvk),
+		GVK:           gvk,
+		AddToScheme:   utilunstructured.AddToSchemeFunc(gvk),
+	}))
+}
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update
+// +kubebuilder:rbac:groups=argoproj.io,resources=workflows,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=argoproj.io,resources=workflows/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=argoproj.io,resources=workflows/finalizers,verbs=get;update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloadpriorityclasses,verbs=get;list;watch
+//+kubebuilder:webhook:path=/mutate-argoproj-io-v1alpha1-workflow,mutating=true,failurePolicy=fail,sideEffects=None,groups=argoproj.io,resources=workflows,verbs=create,versions=v1alpha1,name=mworkflow.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-argoproj-io-v1alpha1-workflow,mutating=false,failurePolicy=fail,sideEffects=None,groups=argoproj.io,resources=workflows,verbs=create;update,versions=v1alpha1,name=vworkflow.kb.io,admissionReviewVersions=v1
+
+func NewJob() jobframework.GenericJob {
+	return &Workflow{Unstructured: utilunstructured.NewObject(gvk)}
+}
+
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
+}
+
+// Workflow is an Argo Workflow. The integration doesn't depend on the Argo
+// Workflows API packages, and handles the Workflows as unstructured objects,
+// so that updating the Workflows doesn't drop the fields unknown to Kueue.
+type Workflow struct {
+	*unstructured.Unstructured
+}
+
+var _ jobframework.GenericJob = (*Workflow)(nil)
+var _ jobframework.JobWithPriorityClass = (*Workflow)(nil)
+var _ jobframework.JobWithCustomValidation = (*Workflow)(nil)
+
+func fromObject(o runtime.Object) *Workflow {
+	return &Workflow{Unstructured: o.(*unstructured.Unstructured)}
+}
+
+// workflowSpec is the subset of the spec of a Workflow read by Kueue.
+type workflowSpec struct {
+	Suspend              *bool                `json:"suspend,omitempty"`
+	Parallelism          *int64               `json:"parallelism,omitempty"`
+	Templates            []workflowTemplate   `json:"templates,omitempty"`
+	WorkflowTemplateRef  *workflowTemplateRef `json:"workflowTemplateRef,omitempty"`
+	NodeSelector         map[string]string    `json:"nodeSelector,omitempty"`
+	Tolerations          []corev1.Toleration  `json:"tolerations,omitempty"`
+	PodMetadata          *podMetadata         `json:"podMetadata,omitempty"`
+	PodPriorityClassName string               `json:"podPriorityClassName,omitempty"`
+}
+
+type workflowTemplate struct {
+	Name           string             `json:"name,omitempty"`
+	Container      *corev1.Container  `json:"container,omitempty"`
+	Script         *corev1.Container  `json:"script,omitempty"`
+	ContainerSet   *containerSet      `json:"containerSet,omitempty"`
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+	Sidecars       []corev1.Container `json:"sidecars,omitempty"`
+}
+
+type workflowTemplateRef struct {
+	Name string `json:"name,omitempty"`
+}
+
+type containerSet struct {
+	Containers []corev1.Container `json:"containers,omitempty"`
+}
+
+type podMetadata struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// workflowStatus is the subset of the status of a Workflow read by Kueue.
+type workflowStatus struct {
+	Phase   string                  `json:"phase,omitempty"`
+	Message string                  `json:"message,omitempty"`
+	Nodes   map[string]workflowNode `json:"nodes,omitempty"`
+}
+
+type workflowNode struct {
+	Type  string `json:"type,omitempty"`
+	Phase string `json:"phase,omitempty"`
+}
+
+const (
+	workflowPhaseRunning   = "Running"
+	workflowPhaseSucceeded = "Succeeded"
+	workflowPhaseFailed    = "Failed"
+	workflowPhaseError     = "Error"
+
+	nodeTypePod      = "Pod"
+	nodePhasePending = "Pending"
+	nodePhaseRunning = "Running"
+)
+
+func (j *Workflow) Object() client.Object {
+	return j.Unstructured
+}
+
+func (j *Workflow) spec() (*workflowSpec, error) {
+	spec := &workflowSpec{}
+	if err := utilunstructured.FromField(j.Unstructured.Object, spec, "spec"); err != nil {
+		return nil, fmt.Errorf("failed to read the spec of the Workflow: %w", err)
+	}
+	return spec, nil
+}
+
+func (j *Workflow) status() *workflowStatus {
+	status := &workflowStatus{}
+	// The status is only written by the Argo controller, ignore it if malformed.
+	_ = utilunstructured.FromField(j.Unstructured.Object, status, "status")
+	return status
+}
+
+func (j *Workflow) IsSuspended() bool {
+	suspend, _, _ := unstructured.NestedBool(j.Unstructured.Object, "spec", "suspend")
+	return suspend
+}
+
+// IsActive returns whether Pods of the Workflow are running. Note that
+// suspending a Workflow only prevents Argo from starting new steps, so the
+// Workflow remains active until its running steps complete.
+func (j *Workflow) IsActive() bool {
+	for _, node := range j.status().Nodes {
+		if node.Type == nodeTypePod && (node.Phase == nodePhasePending || node.Phase == nodePhaseRunning) {
+			return true
+		}
+	}
+	return false
+}
+
+func (j *Workflow) Suspend() {
+	utilruntime.Must(unstructured.SetNestedField(j.Unstructured.Object, true, "spec", "suspend"))
+}
+
+func (j *Workflow) GVK() schema.GroupVersionKind {
+	return gvk
+}
+
+func (j *Workflow) PriorityClass() string {
+	name, _, _ := unstructured.NestedString(j.Unstructured.Object, "spec", "podPriorityClassName")
+	return name
+}
+
+// PodSets estimates the resources used by the Workflow as a single PodSet of
+// the largest pod of its templates, with a count equal to the parallelism of
+// the Workflow, or to the number of its templates creating pods, if the
+// parallelism isn't set.
+func (j *Workflow) PodSets() ([]kueue.PodSet, error) {
+	spec, err := j.spec()
+	if err != nil {
+		return nil, err
+	}
+	requests := corev1.ResourceList{}
+	var podTemplates int32
+	for _, tmpl := range spec.Templates {
+		podSpec := tmpl.podSpec()
+		if podSpec == nil {
+			continue
+		}
+		podTemplates++
+		workload.UseLimitsAsMissingRequestsInPod(podSpec)
+		for name, quantity := range resourcehelpers.PodRequests(&corev1.Pod{Spec: *podSpec}, resourcehelpers.PodResourcesOptions{}) {
+			if current, found := requests[name]; !found || quantity.Cmp(current) > 0 {
+				requests[name] = quantity
+			}
+		}
+	}
+	if podTemplates == 0 {
+		return nil, errNoPodTemplates
+	}
+	podSet := kueue.PodSet{
+		Name:     kueue.DefaultPodSetName,
+		Template: spec.podTemplate(requests),
+		Count:    podTemplates,
+	}
+	if spec.Parallelism != nil {
+		podSet.Count = int32(*spec.Parallelism)
+	}
+	if minCount := j.minParallelism(); minCount != nil {
+		podSet.MinCount = minCount
+	}
+	return []kueue.PodSet{podSet}, nil
+}
+
+// podSpec returns the spec of the pods created by the template, or nil if
+// the template doesn't create pods, as the DAG, steps or suspend templates.
+// The executor containers injected by Argo aren't accounted.
+func (t *workflowTemplate) podSpec() *corev1.PodSpec {
+	spec := &corev1.PodSpec{
+		InitContainers: t.InitContainers,
+		Containers:     t.Sidecars,
+	}
+	switch {
+	case t.Container != nil:
+		spec.Containers = append([]corev1.Container{*t.Container}, spec.Containers...)
+	case t.Script != nil:
+		spec.Containers = append([]corev1.Container{*t.Script}, spec.Containers...)
+	case t.ContainerSet != nil:
+		spec.Containers = slices.Concat(t.ContainerSet.Containers, spec.Containers)
+	default:
+		return nil
+	}
+	return spec
+}
+
+// podTemplate returns the estimated pod template of the Workflow, with the
+// scheduling constraints and the pod metadata of the Workflow.
+func (s *workflowSpec) podTemplate(requests corev1.ResourceList) corev1.PodTemplateSpec {
+	template := corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:      mainContainerName,
+				Resources: corev1.ResourceRequirements{Requests: requests},
+			}},
+			NodeSelector:      s.NodeSelector,
+			Tolerations:       s.Tolerations,
+			PriorityClassName: s.PodPriorityClassName,
+		},
+	}
+	if s.PodMetadata != nil {
+		template.ObjectMeta = metav1.ObjectMeta{
+			Labels:      s.PodMetadata.Labels,
+			Annotations: s.PodMetadata.Annotations,
+		}
+	}
+	return template
+}
+
+func (j *Workflow) minParallelism() *int32 {
+	if strVal, found := j.Object().GetAnnotations()[WorkflowMinParallelismAnnotation]; found {
+		if iVal, err := strconv.Atoi(strVal); err == nil {
+			return ptr.To(int32(iVal))
+		}
+	}
+	return nil
+}
+
+func (j *Workflow) RunWithPodSetsInfo(podSetsInfo []podset.PodSetInfo) error {
+	if len(podSetsInfo) != 1 {
+		return podset.BadPodSetsInfoLenError(1, len(podSetsInfo))
+	}
+	info := podSetsInfo[0]
+	spec, err := j.spec()
+	if err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(j.Unstructured.Object, false, "spec", "suspend"); err != nil {
+		return err
+	}
+	if j.minParallelism() != nil {
+		if err := unstructured.SetNestedField(j.Unstructured.Object, int64(info.Count), "spec", "parallelism"); err != nil {
+			return err
+		}
+	}
+	template := spec.podTemplate(nil)
+	if err := podset.Merge(&template.ObjectMeta, &template.Spec, info); err != nil {
+		return err
+	}
+	return j.setPodTemplate(&template)
+}
+
+func (j *Workflow) RestorePodSetsInfo(podSetsInfo []podset.PodSetInfo) bool {
+	if len(podSetsInfo) == 0 {
+		return false
+	}
+	spec, err := j.spec()
+	if err != nil {
+		return false
+	}
+	info := podSetsInfo[0]
+	changed := false
+	if j.minParallelism() != nil && ptr.Deref(spec.Parallelism, 0) != int64(info.Count) {
+		utilruntime.Must(unstructured.SetNestedField(j.Unstructured.Object, int64(info.Count), "spec", "parallelism"))
+		changed = true
+	}
+	template := spec.podTemplate(nil)
+	if !podset.RestorePodSpec(&template.ObjectMeta, &template.Spec, info) {
+		return changed
+	}
+	if err := j.setPodTemplate(&template); err != nil {
+		return changed
+	}
+	return true
+}
+
+// setPodTemplate writes the pod metadata and the scheduling constraints of
+// the pod template to the Workflow.
+func (j *Workflow) setPodTemplate(template *corev1.PodTemplateSpec) error {
+	if err := utilunstructured.SetOrRemoveStringMap(j.Unstructured.Object, template.Labels, "spec", "podMetadata", "labels"); err != nil {
+		return err
+	}
+	if err := utilunstructured.SetOrRemoveStringMap(j.Unstructured.Object, template.Annotations, "spec", "podMetadata", "annotations"); err != nil {
+		return err
+	}
+	utilunstructured.RemoveIfEmpty(j.Unstructured.Object, "spec", "podMetadata")
+	if err := utilunstructured.SetOrRemoveStringMap(j.Unstructured.Object, template.Spec.NodeSelector, "spec", "nodeSelector"); err != nil {
+		return err
+	}
+	return utilunstructured.SetOrRemoveTolerations(j.Unstructured.Object, template.Spec.Tolerations, "spec", "tolerations")
+}
+
+func (j *Workflow) Finished() (message string, success, finished bool) {
+	status := j.status()
+	switch status.Phase {
+	case workflowPhaseSucceeded:
+		return "Workflow finished successfully", true, true
+	case workflowPhaseFailed, workflowPhaseError:
+		return status.Message, false, true
+	}
+	return "", false, false
+}
+
+func (j *Workflow) PodsReady() bool {
+	return j.status().Phase == workflowPhaseRunning
+}
+
+func (j *Workflow) ValidateOnCreate() (field.ErrorList, error) {
+	if jobframework.QueueName(j) == "" {
+		return nil, nil
+	}
+	spec, err := j.spec()
+	if err != nil {
+		return nil, err
+	}
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	if spec.WorkflowTemplateRef != nil {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("workflowTemplateRef"), "the pods of a Workflow referencing a WorkflowTemplate cannot be estimated"))
+	} else if !slices.ContainsFunc(spec.Templates, func(t workflowTemplate) bool { return t.podSpec() != nil }) {
+		allErrs = append(allErrs, field.Required(specPath.Child("templates"), errNoPodTemplates.Error()))
+	}
+	if strVal, found := j.Object().GetAnnotations()[WorkflowMinParallelismAnnotation]; found {
+		annotationPath := field.NewPath("metadata", "annotations").Key(WorkflowMinParallelismAnnotation)
+		if v, err := strconv.Atoi(strVal); err != nil || v < 1 {
+			allErrs = append(allErrs, field.Invalid(annotationPath, strVal, "must be a positive integer"))
+		} else if spec.Parallelism == nil {
+			allErrs = append(allErrs, field.Forbidden(annotationPath, "requires spec.parallelism to be set"))
+		} else if int64(v) > *spec.Parallelism {
+			allErrs = append(allErrs, field.Invalid(annotationPath, strVal, "must be lower than or equal to spec.parallelism"))
+		}
+	}
+	return allErrs, nil
+}
+
+func (j *Workflow) ValidateOnUpdate(_ jobframework.GenericJob) (field.ErrorList, error) {
+	return j.ValidateOnCreate()
+}
+
+func GetWorkloadNameForWorkflow(workflowName string, workflowUID types.UID) string {
+	return jobframework.GetWorkloadNameForOwnerWithGVK(workflowName, workflowUID, gvk)
+}
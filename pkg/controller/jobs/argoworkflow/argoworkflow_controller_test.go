@@ -0,0 +1,342 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argoworkflow
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/podset"
+	testingworkflow "sigs.k8s.io/kueue/pkg/util/testingjobs/argoworkflow"
+)
+
+func requests(cpu, memory string) corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(cpu),
+		corev1.ResourceMemory: resource.MustParse(memory),
+	}
+}
+
+func mainPodTemplate(requests corev1.ResourceList) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:      mainContainerName,
+				Resources: corev1.ResourceRequirements{Requests: requests},
+			}},
+		},
+	}
+}
+
+func TestPodSets(t *testing.T) {
+	cases := map[string]struct {
+		workflow    *unstructured.Unstructured
+		wantPodSets []kueue.PodSet
+		wantErr     error
+	}{
+		"a pod per template creating pods without parallelism": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				StepsTemplate("main", "a", "b").
+				ContainerTemplate("a", requests("1", "1Gi")).
+				ContainerTemplate("b", requests("1", "1Gi")).
+				Obj(),
+			wantPodSets: []kueue.PodSet{{
+				Name:     kueue.DefaultPodSetName,
+				Template: mainPodTemplate(requests("1", "1Gi")),
+				Count:    2,
+			}},
+		},
+		"the largest requests of the templates and the parallelism": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				Parallelism(4).
+				StepsTemplate("main", "a", "b").
+				ContainerTemplate("a", requests("2", "1Gi")).
+				ContainerTemplate("b", requests("1", "4Gi")).
+				Obj(),
+			wantPodSets: []kueue.PodSet{{
+				Name:     kueue.DefaultPodSetName,
+				Template: mainPodTemplate(requests("2", "4Gi")),
+				Count:    4,
+			}},
+		},
+		"script, containerSet and sidecars": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				Template(map[string]any{
+					"name":   "script",
+					"script": map[string]any{"image": "python", "source": "print(1)", "resources": map[string]any{"requests": map[string]any{"cpu": "1"}}},
+					"sidecars": []any{
+						map[string]any{"name": "proxy", "resources": map[string]any{"requests": map[string]any{"cpu": "500m"}}},
+					},
+				}).
+				Template(map[string]any{
+					"name": "set",
+					"containerSet": map[string]any{"containers": []any{
+						map[string]any{"name": "a", "resources": map[string]any{"requests": map[string]any{"cpu": "1"}}},
+						map[string]any{"name": "b", "resources": map[string]any{"limits": map[string]any{"cpu": "1"}}},
+					}},
+				}).
+				Obj(),
+			wantPodSets: []kueue.PodSet{{
+				Name:     kueue.DefaultPodSetName,
+				Template: mainPodTemplate(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}),
+				Count:    2,
+			}},
+		},
+		"the scheduling constraints and the pod metadata of the workflow": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				NodeSelector("instance", "spot").
+				PodLabel("team", "data").
+				ContainerTemplate("a", requests("1", "1Gi")).
+				Obj(),
+			wantPodSets: []kueue.PodSet{{
+				Name: kueue.DefaultPodSetName,
+				Template: func() corev1.PodTemplateSpec {
+					template := mainPodTemplate(requests("1", "1Gi"))
+					template.Labels = map[string]string{"team": "data"}
+					template.Spec.NodeSelector = map[string]string{"instance": "spot"}
+					return template
+				}(),
+				Count: 1,
+			}},
+		},
+		"partial admission": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				Annotation(WorkflowMinParallelismAnnotation, "2").
+				Parallelism(4).
+				ContainerTemplate("a", requests("1", "1Gi")).
+				Obj(),
+			wantPodSets: []kueue.PodSet{{
+				Name:     kueue.DefaultPodSetName,
+				Template: mainPodTemplate(requests("1", "1Gi")),
+				Count:    4,
+				MinCount: ptr.To[int32](2),
+			}},
+		},
+		"no template creating pods": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				StepsTemplate("main").
+				Obj(),
+			wantErr: errNoPodTemplates,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotPodSets, gotErr := fromObject(tc.workflow).PodSets()
+			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantPodSets, gotPodSets); diff != "" {
+				t.Errorf("Unexpected podSets (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRunWithPodSetsInfoAndRestore(t *testing.T) {
+	original := testingworkflow.MakeWorkflow("wf", "ns").
+		Annotation(WorkflowMinParallelismAnnotation, "1").
+		Parallelism(4).
+		NodeSelector("team", "data").
+		ContainerTemplate("a", requests("1", "1Gi")).
+		Obj()
+	wf := fromObject(original.DeepCopy())
+
+	err := wf.RunWithPodSetsInfo([]podset.PodSetInfo{{
+		Count:        2,
+		Labels:       map[string]string{"kueue.x-k8s.io/podset": "main"},
+		NodeSelector: map[string]string{"instance": "spot"},
+		Tolerations:  []corev1.Toleration{{Key: "spot", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}},
+	}})
+	if err != nil {
+		t.Fatalf("RunWithPodSetsInfo() error = %v", err)
+	}
+	want := testingworkflow.MakeWorkflow("wf", "ns").
+		Annotation(WorkflowMinParallelismAnnotation, "1").
+		Suspend(false).
+		Parallelism(2).
+		NodeSelector("team", "data").
+		NodeSelector("instance", "spot").
+		PodLabel("kueue.x-k8s.io/podset", "main").
+		ContainerTemplate("a", requests("1", "1Gi")).
+		Obj()
+	want.Object["spec"].(map[string]any)["tolerations"] = []any{
+		map[string]any{"key": "spot", "operator": "Exists", "effect": "NoSchedule"},
+	}
+	if diff := cmp.Diff(want.Object, wf.Unstructured.Object); diff != "" {
+		t.Errorf("Unexpected running workflow (-want,+got):\n%s", diff)
+	}
+
+	podSets, err := fromObject(original.DeepCopy()).PodSets()
+	if err != nil {
+		t.Fatalf("PodSets() error = %v", err)
+	}
+	wf.Suspend()
+	if !wf.RestorePodSetsInfo([]podset.PodSetInfo{podset.FromPodSet(&podSets[0])}) {
+		t.Errorf("RestorePodSetsInfo() returned no change")
+	}
+	if diff := cmp.Diff(original.Object, wf.Unstructured.Object); diff != "" {
+		t.Errorf("Unexpected restored workflow (-want,+got):\n%s", diff)
+	}
+}
+
+func TestStatus(t *testing.T) {
+	cases := map[string]struct {
+		workflow      *unstructured.Unstructured
+		wantActive    bool
+		wantPodsReady bool
+		wantFinished  bool
+		wantSuccess   bool
+		wantMessage   string
+	}{
+		"pending": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").Obj(),
+		},
+		"running": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				Phase("Running").
+				PodNode("wf-1", "Succeeded").
+				PodNode("wf-2", "Running").
+				Obj(),
+			wantActive:    true,
+			wantPodsReady: true,
+		},
+		"running without pods": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				Phase("Running").
+				PodNode("wf-1", "Succeeded").
+				Obj(),
+			wantPodsReady: true,
+		},
+		"succeeded": {
+			workflow:     testingworkflow.MakeWorkflow("wf", "ns").Phase("Succeeded").Obj(),
+			wantFinished: true,
+			wantSuccess:  true,
+			wantMessage:  "Workflow finished successfully",
+		},
+		"failed": {
+			workflow:     testingworkflow.MakeWorkflow("wf", "ns").Phase("Failed").Message("child 'wf-1' failed").Obj(),
+			wantFinished: true,
+			wantMessage:  "child 'wf-1' failed",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wf := fromObject(tc.workflow)
+			if got := wf.IsActive(); got != tc.wantActive {
+				t.Errorf("IsActive() = %v, want %v", got, tc.wantActive)
+			}
+			if got := wf.PodsReady(); got != tc.wantPodsReady {
+				t.Errorf("PodsReady() = %v, want %v", got, tc.wantPodsReady)
+			}
+			message, success, finished := wf.Finished()
+			if message != tc.wantMessage || success != tc.wantSuccess || finished != tc.wantFinished {
+				t.Errorf("Finished() = (%q, %v, %v), want (%q, %v, %v)", message, success, finished, tc.wantMessage, tc.wantSuccess, tc.wantFinished)
+			}
+		})
+	}
+}
+
+func TestValidateOnCreate(t *testing.T) {
+	annotationPath := field.NewPath("metadata", "annotations").Key(WorkflowMinParallelismAnnotation)
+	cases := map[string]struct {
+		workflow *unstructured.Unstructured
+		wantErrs field.ErrorList
+	}{
+		"valid": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				Queue("queue").
+				Annotation(WorkflowMinParallelismAnnotation, "2").
+				Parallelism(4).
+				ContainerTemplate("a", requests("1", "1Gi")).
+				Obj(),
+		},
+		"not managed": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				WorkflowTemplateRef("template").
+				Obj(),
+		},
+		"workflowTemplateRef": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				Queue("queue").
+				WorkflowTemplateRef("template").
+				Obj(),
+			wantErrs: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "workflowTemplateRef"), ""),
+			},
+		},
+		"no template creating pods": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				Queue("queue").
+				StepsTemplate("main").
+				Obj(),
+			wantErrs: field.ErrorList{
+				field.Required(field.NewPath("spec", "templates"), ""),
+			},
+		},
+		"invalid min parallelism": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				Queue("queue").
+				Annotation(WorkflowMinParallelismAnnotation, "0").
+				Parallelism(4).
+				ContainerTemplate("a", requests("1", "1Gi")).
+				Obj(),
+			wantErrs: field.ErrorList{
+				field.Invalid(annotationPath, "0", ""),
+			},
+		},
+		"min parallelism without parallelism": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				Queue("queue").
+				Annotation(WorkflowMinParallelismAnnotation, "2").
+				ContainerTemplate("a", requests("1", "1Gi")).
+				Obj(),
+			wantErrs: field.ErrorList{
+				field.Forbidden(annotationPath, ""),
+			},
+		},
+		"min parallelism greater than parallelism": {
+			workflow: testingworkflow.MakeWorkflow("wf", "ns").
+				Queue("queue").
+				Annotation(WorkflowMinParallelismAnnotation, "5").
+				Parallelism(4).
+				ContainerTemplate("a", requests("1", "1Gi")).
+				Obj(),
+			wantErrs: field.ErrorList{
+				field.Invalid(annotationPath, "5", ""),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotErrs, err := fromObject(tc.workflow).ValidateOnCreate()
+			if err != nil {
+				t.Fatalf("ValidateOnCreate() error = %v", err)
+			}
+			if diff := cmp.Diff(tc.wantErrs, gotErrs, cmpopts.IgnoreFields(field.Error{}, "Detail")); diff != "" {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cronjob integrates the CronJobs. The Jobs spawned by a CronJob
+// inherit its queue and are queued as standalone Jobs by the batch/job
+// integration. Optionally, the occurrences of a CronJob are skipped or
+// coalesced while its previous Jobs are still queued.
+package cronjob
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	workloadjob "sigs.k8s.io/kueue/pkg/controller/jobs/job"
+	"sigs.k8s.io/kueue/pkg/util/parallelize"
+)
+
+var (
+	gvk = batchv1.SchemeGroupVersion.WithKind("CronJob")
+)
+
+const (
+	FrameworkName = "batch/cronjob"
+
+	// PendingJobsPolicyAnnotation sets what happens to the occurrences of a
+	// CronJob while its previous Jobs are still queued.
+	PendingJobsPolicyAnnotation = "kueue.x-k8s.io/pending-jobs-policy"
+)
+
+// PendingJobsPolicy is the policy applied to the queued Jobs of a CronJob.
+type PendingJobsPolicy string
+
+const (
+	// PendingJobsPolicyAllow keeps all the queued Jobs, the default.
+	PendingJobsPolicyAllow PendingJobsPolicy = "Allow"
+	// PendingJobsPolicySkip deletes the new Jobs while an older one is
+	// queued, skipping the occurrences.
+	PendingJobsPolicySkip PendingJobsPolicy = "Skip"
+	// PendingJobsPolicyCoalesce deletes the older queued Jobs, keeping only
+	// the latest occurrence queued.
+	PendingJobsPolicyCoalesce PendingJobsPolicy = "Coalesce"
+)
+
+const (
+	ReasonSkippedJob   = "SkippedJob"
+	ReasonCoalescedJob = "CoalescedJob"
+)
+
+var pendingJobsPolicies = []PendingJobsPolicy{PendingJobsPolicyAllow, PendingJobsPolicySkip, PendingJobsPolicyCoalesce}
+
+func init() {
+	utilruntime.Must(jobframework.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:        SetupIndexes,
+		NewReconciler:       NewReconciler,
+		GVK:                 gvk,
+		SetupWebhook:        SetupWebhook,
+		JobType:             &batchv1.CronJob{},
+		AddToScheme:         batchv1.AddToScheme,
+		DependencyList:      []string{workloadjob.FrameworkName},
+		StandaloneChildJobs: true,
+	}))
+}
+
+type CronJob batchv1.CronJob
+
+func fromObject(o runtime.Object) *CronJob {
+	return (*CronJob)(o.(*batchv1.CronJob))
+}
+
+func (c *CronJob) Object() client.Object {
+	return (*batchv1.CronJob)(c)
+}
+
+func (c *CronJob) GVK() schema.GroupVersionKind {
+	return gvk
+}
+
+func (c *CronJob) pendingJobsPolicy() PendingJobsPolicy {
+	if policy, found := c.Annotations[PendingJobsPolicyAnnotation]; found {
+		return PendingJobsPolicy(policy)
+	}
+	return PendingJobsPolicyAllow
+}
+
+// SetupIndexes relies on the index of the Jobs by owner UID set up by the
+// batch/job integration.
+func SetupIndexes(context.Context, client.FieldIndexer) error {
+	return nil
+}
+
+type Reconciler struct {
+	client client.Client
+	log    logr.Logger
+	record record.EventRecorder
+}
+
+var _ jobframework.JobReconcilerInterface = (*Reconciler)(nil)
+
+func NewReconciler(client client.Client, eventRecorder record.EventRecorder, _ ...jobframework.Option) jobframework.JobReconcilerInterface {
+	return &Reconciler{
+		client: client,
+		log:    ctrl.Log.WithName("cronjob-reconciler"),
+		record: eventRecorder,
+	}
+}
+
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	ctrl.Log.V(3).Info("Setting up CronJob reconciler")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&batchv1.CronJob{}).
+		Owns(&batchv1.Job{}).
+		Named("cronjob").
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	cj := &batchv1.CronJob{}
+	if err := r.client.Get(ctx, req.NamespacedName, cj); err != nil {
+		// we'll ignore not-found errors, since there is nothing to do.
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	cronJob := fromObject(cj)
+
+	policy := cronJob.pendingJobsPolicy()
+	if policy == PendingJobsPolicyAllow {
+		return ctrl.Result{}, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile CronJob", "pendingJobsPolicy", policy)
+
+	jobList := &batchv1.JobList{}
+	if err := r.client.List(ctx, jobList, client.InNamespace(cj.Namespace),
+		client.MatchingFields{indexer.OwnerReferenceUID: string(cj.UID)},
+	); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	toDelete := jobsToDelete(cj, jobList.Items, policy)
+	reason := ReasonSkippedJob
+	if policy == PendingJobsPolicyCoalesce {
+		reason = ReasonCoalescedJob
+	}
+	err := parallelize.Until(ctx, len(toDelete), func(i int) error {
+		job := toDelete[i]
+		if err := r.client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		log.V(3).Info("Deleted the queued Job", "job", klog.KObj(job))
+		r.record.Eventf(cj, corev1.EventTypeNormal, reason, "Deleted the queued Job %s", job.Name)
+		return nil
+	})
+	return ctrl.Result{}, err
+}
+
+// jobsToDelete returns the queued Jobs of the CronJob deleted by the policy:
+// all but the oldest for Skip, and all but the latest for Coalesce.
+func jobsToDelete(cj *batchv1.CronJob, jobs []batchv1.Job, policy PendingJobsPolicy) []*batchv1.Job {
+	var pending []*batchv1.Job
+	for i := range jobs {
+		job := &jobs[i]
+		if metav1.IsControlledBy(job, cj) && isQueued(job) {
+			pending = append(pending, job)
+		}
+	}
+	if len(pending) <= 1 {
+		return nil
+	}
+	slices.SortFunc(pending, func(a, b *batchv1.Job) int {
+		if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	if policy == PendingJobsPolicyCoalesce {
+		return pending[:len(pending)-1]
+	}
+	return pending[1:]
+}
+
+// isQueued returns whether the Job is suspended by Kueue and not yet started
+// or finished.
+func isQueued(job *batchv1.Job) bool {
+	wlJob := (*workloadjob.Job)(job)
+	if job.DeletionTimestamp != nil || !wlJob.IsSuspended() || job.Status.StartTime != nil || job.Status.Active > 0 {
+		return false
+	}
+	if _, _, finished := wlJob.Finished(); finished {
+		return false
+	}
+	return jobframework.QueueNameForObject(job) != ""
+}
@@ -0,0 +1,156 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronjob
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingcronjob "sigs.k8s.io/kueue/pkg/util/testingjobs/cronjob"
+	testingjob "sigs.k8s.io/kueue/pkg/util/testingjobs/job"
+)
+
+func TestReconciler(t *testing.T) {
+	now := time.Now()
+	cronJob := testingcronjob.MakeCronJob("cj", "ns").UID("cj").Queue("queue")
+	queuedJob := func(name string, created time.Time) *testingjob.JobWrapper {
+		return testingjob.MakeJob(name, "ns").
+			Queue("queue").
+			OwnerReference("cj", gvk).
+			CreationTimestamp(created)
+	}
+
+	cases := map[string]struct {
+		cronJob      *batchv1.CronJob
+		jobs         []batchv1.Job
+		wantJobNames []string
+		wantEvents   []utiltesting.EventRecord
+	}{
+		"no policy keeps the queued jobs": {
+			cronJob: cronJob.Clone().Obj(),
+			jobs: []batchv1.Job{
+				*queuedJob("job1", now.Add(-2*time.Hour)).Obj(),
+				*queuedJob("job2", now.Add(-time.Hour)).Obj(),
+			},
+			wantJobNames: []string{"job1", "job2"},
+		},
+		"Skip deletes the newer queued jobs": {
+			cronJob: cronJob.Clone().Annotation(PendingJobsPolicyAnnotation, string(PendingJobsPolicySkip)).Obj(),
+			jobs: []batchv1.Job{
+				*queuedJob("job1", now.Add(-2*time.Hour)).Obj(),
+				*queuedJob("job2", now.Add(-time.Hour)).Obj(),
+				*queuedJob("job3", now).Obj(),
+			},
+			wantJobNames: []string{"job1"},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "cj", Namespace: "ns"},
+					EventType: corev1.EventTypeNormal,
+					Reason:    ReasonSkippedJob,
+					Message:   "Deleted the queued Job job2",
+				},
+				{
+					Key:       types.NamespacedName{Name: "cj", Namespace: "ns"},
+					EventType: corev1.EventTypeNormal,
+					Reason:    ReasonSkippedJob,
+					Message:   "Deleted the queued Job job3",
+				},
+			},
+		},
+		"Coalesce deletes the older queued jobs": {
+			cronJob: cronJob.Clone().Annotation(PendingJobsPolicyAnnotation, string(PendingJobsPolicyCoalesce)).Obj(),
+			jobs: []batchv1.Job{
+				*queuedJob("job1", now.Add(-2*time.Hour)).Obj(),
+				*queuedJob("job2", now.Add(-time.Hour)).Obj(),
+				*queuedJob("job3", now).Obj(),
+			},
+			wantJobNames: []string{"job3"},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "cj", Namespace: "ns"},
+					EventType: corev1.EventTypeNormal,
+					Reason:    ReasonCoalescedJob,
+					Message:   "Deleted the queued Job job1",
+				},
+				{
+					Key:       types.NamespacedName{Name: "cj", Namespace: "ns"},
+					EventType: corev1.EventTypeNormal,
+					Reason:    ReasonCoalescedJob,
+					Message:   "Deleted the queued Job job2",
+				},
+			},
+		},
+		"Coalesce ignores the running, finished and not owned jobs": {
+			cronJob: cronJob.Clone().Annotation(PendingJobsPolicyAnnotation, string(PendingJobsPolicyCoalesce)).Obj(),
+			jobs: []batchv1.Job{
+				*queuedJob("running", now.Add(-3*time.Hour)).Suspend(false).StartTime(now.Add(-3 * time.Hour)).Obj(),
+				*queuedJob("finished", now.Add(-2*time.Hour)).Condition(batchv1.JobCondition{
+					Type:   batchv1.JobFailed,
+					Status: corev1.ConditionTrue,
+				}).Obj(),
+				*testingjob.MakeJob("not-owned", "ns").Queue("queue").CreationTimestamp(now.Add(-time.Hour)).Obj(),
+				*queuedJob("job", now).Obj(),
+			},
+			wantJobNames: []string{"finished", "job", "not-owned", "running"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			objs := []client.Object{tc.cronJob}
+			for i := range tc.jobs {
+				objs = append(objs, &tc.jobs[i])
+			}
+			kClient := utiltesting.NewClientBuilder().
+				WithIndex(&batchv1.Job{}, indexer.OwnerReferenceUID, indexer.IndexOwnerUID).
+				WithObjects(objs...).
+				Build()
+			recorder := &utiltesting.EventRecorder{}
+
+			reconciler := NewReconciler(kClient, recorder)
+			if _, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(tc.cronJob)}); err != nil {
+				t.Fatalf("Reconcile returned error: %v", err)
+			}
+
+			gotJobs := batchv1.JobList{}
+			if err := kClient.List(ctx, &gotJobs, client.InNamespace("ns")); err != nil {
+				t.Fatalf("Could not list Jobs after reconcile: %v", err)
+			}
+			gotJobNames := make([]string, 0, len(gotJobs.Items))
+			for _, job := range gotJobs.Items {
+				gotJobNames = append(gotJobNames, job.Name)
+			}
+			if diff := cmp.Diff(tc.wantJobNames, gotJobNames, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("Jobs after reconcile (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantEvents, recorder.RecordedEvents, cmpopts.SortSlices(utiltesting.SortEvents)); diff != "" {
+				t.Errorf("Unexpected events (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
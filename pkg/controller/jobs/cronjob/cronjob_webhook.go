@@ -0,0 +1,126 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronjob
+
+import (
+	"context"
+	"slices"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework/webhook"
+	utilwebhook "sigs.k8s.io/kueue/pkg/util/webhook"
+)
+
+type Webhook struct {
+	queues *qcache.Manager
+}
+
+func SetupWebhook(mgr ctrl.Manager, opts ...jobframework.Option) error {
+	options := jobframework.ProcessOptions(opts...)
+	wh := &Webhook{
+		queues: options.Queues,
+	}
+	obj := &batchv1.CronJob{}
+	return webhook.WebhookManagedBy(mgr).
+		For(obj).
+		WithMutationHandler(admission.WithCustomDefaulter(mgr.GetScheme(), obj, wh)).
+		WithValidator(wh).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-batch-v1-cronjob,mutating=true,failurePolicy=fail,sideEffects=None,groups=batch,resources=cronjobs,verbs=create;update,versions=v1,name=mcronjob.kb.io,admissionReviewVersions=v1
+
+var _ admission.CustomDefaulter = &Webhook{}
+
+// Default propagates the queue and the priority class of the CronJob to its
+// Job template, so that the spawned Jobs are queued by the batch/job
+// integration.
+func (wh *Webhook) Default(ctx context.Context, obj runtime.Object) error {
+	cronJob := fromObject(obj)
+
+	log := ctrl.LoggerFrom(ctx).WithName("cronjob-webhook")
+	log.V(5).Info("Propagating queue-name")
+
+	jobframework.ApplyDefaultLocalQueue(cronJob.Object(), wh.queues.DefaultLocalQueueExist)
+	if err := utilwebhook.ApplyDefaultSubmitter(ctx, cronJob.Object()); err != nil {
+		return err
+	}
+
+	queueName := jobframework.QueueNameForObject(cronJob.Object())
+	priorityClass := jobframework.WorkloadPriorityClassName(cronJob.Object())
+	if queueName == "" && priorityClass == "" {
+		return nil
+	}
+	if cronJob.Spec.JobTemplate.Labels == nil {
+		cronJob.Spec.JobTemplate.Labels = make(map[string]string, 2)
+	}
+	if queueName != "" {
+		cronJob.Spec.JobTemplate.Labels[controllerconstants.QueueLabel] = string(queueName)
+	}
+	if priorityClass != "" {
+		cronJob.Spec.JobTemplate.Labels[controllerconstants.WorkloadPriorityClassLabel] = priorityClass
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-batch-v1-cronjob,mutating=false,failurePolicy=fail,sideEffects=None,groups=batch,resources=cronjobs,verbs=create;update,versions=v1,name=vcronjob.kb.io,admissionReviewVersions=v1
+
+var _ admission.CustomValidator = &Webhook{}
+
+func (wh *Webhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	cronJob := fromObject(obj)
+
+	log := ctrl.LoggerFrom(ctx).WithName("cronjob-webhook")
+	log.V(5).Info("Validating create")
+
+	return nil, validateCreate(cronJob).ToAggregate()
+}
+
+var (
+	pendingJobsPolicyAnnotationPath = field.NewPath("metadata", "annotations").Key(PendingJobsPolicyAnnotation)
+)
+
+func validateCreate(cronJob *CronJob) field.ErrorList {
+	allErrs := jobframework.ValidateQueueName(cronJob.Object())
+	if policy := cronJob.pendingJobsPolicy(); !slices.Contains(pendingJobsPolicies, policy) {
+		allErrs = append(allErrs, field.NotSupported(pendingJobsPolicyAnnotationPath, policy, pendingJobsPolicies))
+	}
+	return allErrs
+}
+
+// ValidateUpdate allows to change the queue of the CronJob at any time, as
+// it only applies to the Jobs spawned later.
+func (wh *Webhook) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (warnings admission.Warnings, err error) {
+	newCronJob := fromObject(newObj)
+
+	log := ctrl.LoggerFrom(ctx).WithName("cronjob-webhook")
+	log.V(5).Info("Validating update")
+
+	return nil, validateCreate(newCronJob).ToAggregate()
+}
+
+func (wh *Webhook) ValidateDelete(context.Context, runtime.Object) (warnings admission.Warnings, err error) {
+	return nil, nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronjob
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingcronjob "sigs.k8s.io/kueue/pkg/util/testingjobs/cronjob"
+)
+
+func TestDefault(t *testing.T) {
+	testCases := map[string]struct {
+		cronJob *batchv1.CronJob
+		want    *batchv1.CronJob
+	}{
+		"CronJob without queue": {
+			cronJob: testingcronjob.MakeCronJob("cj", "ns").Obj(),
+			want:    testingcronjob.MakeCronJob("cj", "ns").Obj(),
+		},
+		"CronJob with queue": {
+			cronJob: testingcronjob.MakeCronJob("cj", "ns").
+				Queue("queue").
+				JobTemplateLabel(constants.QueueLabel, "old-queue").
+				Obj(),
+			want: testingcronjob.MakeCronJob("cj", "ns").
+				Queue("queue").
+				JobTemplateLabel(constants.QueueLabel, "queue").
+				Obj(),
+		},
+		"CronJob with queue and priority class": {
+			cronJob: testingcronjob.MakeCronJob("cj", "ns").
+				Queue("queue").
+				Label(constants.WorkloadPriorityClassLabel, "nightly").
+				Obj(),
+			want: testingcronjob.MakeCronJob("cj", "ns").
+				Queue("queue").
+				Label(constants.WorkloadPriorityClassLabel, "nightly").
+				JobTemplateLabel(constants.QueueLabel, "queue").
+				JobTemplateLabel(constants.WorkloadPriorityClassLabel, "nightly").
+				Obj(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			client := utiltesting.NewClientBuilder().Build()
+			w := &Webhook{
+				queues: qcache.NewManager(client, schdcache.New(client)),
+			}
+
+			if err := w.Default(ctx, tc.cronJob); err != nil {
+				t.Errorf("failed to set defaults: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.cronJob); len(diff) != 0 {
+				t.Errorf("Default() mismatch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateCreate(t *testing.T) {
+	testCases := map[string]struct {
+		cronJob *batchv1.CronJob
+		wantErr error
+	}{
+		"without policy": {
+			cronJob: testingcronjob.MakeCronJob("cj", "ns").Queue("queue").Obj(),
+		},
+		"with Coalesce policy": {
+			cronJob: testingcronjob.MakeCronJob("cj", "ns").
+				Queue("queue").
+				Annotation(PendingJobsPolicyAnnotation, string(PendingJobsPolicyCoalesce)).
+				Obj(),
+		},
+		"with invalid policy": {
+			cronJob: testingcronjob.MakeCronJob("cj", "ns").
+				Queue("queue").
+				Annotation(PendingJobsPolicyAnnotation, "Replace").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeNotSupported,
+					Field: "metadata.annotations[kueue.x-k8s.io/pending-jobs-policy]",
+				},
+			}.ToAggregate(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			w := &Webhook{}
+
+			_, err := w.ValidateCreate(ctx, tc.cronJob)
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,449 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package flinkcluster contains the helpers shared by the integrations of the
+// FlinkDeployments and the FlinkSessionJobs of the Flink Kubernetes operator,
+// reading the JobManager and the TaskManagers of the Flink clusters.
+package flinkcluster
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utilunstructured "sigs.k8s.io/kueue/pkg/util/unstructured"
+)
+
+const (
+	JobManagerPodSetName  kueue.PodSetReference = "jobmanager"
+	TaskManagerPodSetName kueue.PodSetReference = "taskmanager"
+
+	// MainContainerName is the name of the Flink container of the pods of
+	// the JobManager and the TaskManagers.
+	MainContainerName = "flink-main-container"
+
+	// JobStateRunning and JobStateSuspended are the desired states of the
+	// jobs, in spec.job.state.
+	JobStateRunning   = "running"
+	JobStateSuspended = "suspended"
+
+	// The states of the Flink jobs, in status.jobStatus.state.
+	JobStatusRunning   = "RUNNING"
+	JobStatusFinished  = "FINISHED"
+	JobStatusFailed    = "FAILED"
+	JobStatusCanceled  = "CANCELED"
+	JobStatusSuspended = "SUSPENDED"
+
+	slotsConfigKey = "taskmanager.numberOfTaskSlots"
+)
+
+// Role is the JobManager or the TaskManagers of a Flink cluster.
+type Role struct {
+	PodSetName kueue.PodSetReference
+	// Field is the field of the role in the spec of the FlinkDeployments.
+	Field string
+	// memoryConfigKey is the Flink configuration of the process memory of
+	// the role, used when the resource of the role doesn't set it.
+	memoryConfigKey string
+}
+
+var (
+	JobManager  = Role{PodSetName: JobManagerPodSetName, Field: "jobManager", memoryConfigKey: "jobmanager.memory.process.size"}
+	TaskManager = Role{PodSetName: TaskManagerPodSetName, Field: "taskManager", memoryConfigKey: "taskmanager.memory.process.size"}
+)
+
+// ClusterSpec is the subset of the spec of a FlinkDeployment read by Kueue.
+type ClusterSpec struct {
+	PodTemplate        *corev1.PodTemplateSpec `json:"podTemplate,omitempty"`
+	JobManager         ComponentSpec           `json:"jobManager"`
+	TaskManager        ComponentSpec           `json:"taskManager"`
+	Job                *JobSpec                `json:"job,omitempty"`
+	FlinkConfiguration map[string]any          `json:"flinkConfiguration,omitempty"`
+}
+
+// ComponentSpec is the subset of the JobManager and TaskManager specs read
+// by Kueue.
+type ComponentSpec struct {
+	Resource    ResourceSpec            `json:"resource"`
+	Replicas    *int32                  `json:"replicas,omitempty"`
+	PodTemplate *corev1.PodTemplateSpec `json:"podTemplate,omitempty"`
+}
+
+// ResourceSpec is the resource of the main container of the JobManager or
+// the TaskManagers.
+type ResourceSpec struct {
+	CPU              *float64 `json:"cpu,omitempty"`
+	Memory           *string  `json:"memory,omitempty"`
+	EphemeralStorage *string  `json:"ephemeralStorage,omitempty"`
+}
+
+// JobSpec is the subset of the job spec of the FlinkDeployments and the
+// FlinkSessionJobs read by Kueue.
+type JobSpec struct {
+	Parallelism *int32 `json:"parallelism,omitempty"`
+	State       string `json:"state,omitempty"`
+}
+
+// Status is the subset of the status of the FlinkDeployments and the
+// FlinkSessionJobs read by Kueue.
+type Status struct {
+	JobManagerDeploymentStatus string `json:"jobManagerDeploymentStatus,omitempty"`
+	JobStatus                  struct {
+		State string `json:"state,omitempty"`
+	} `json:"jobStatus"`
+	Error string `json:"error,omitempty"`
+}
+
+// ReadSpec reads the spec of a FlinkDeployment.
+func ReadSpec(obj *unstructured.Unstructured) (*ClusterSpec, error) {
+	spec := &ClusterSpec{}
+	if err := utilunstructured.FromField(obj.Object, spec, "spec"); err != nil {
+		return nil, fmt.Errorf("failed to read the spec of the %s: %w", obj.GetKind(), err)
+	}
+	return spec, nil
+}
+
+// ReadJobSpec reads the job spec of a FlinkDeployment or a FlinkSessionJob.
+func ReadJobSpec(obj *unstructured.Unstructured) (*JobSpec, error) {
+	job := &JobSpec{}
+	if err := utilunstructured.FromField(obj.Object, job, "spec", "job"); err != nil {
+		return nil, fmt.Errorf("failed to read the job of the %s: %w", obj.GetKind(), err)
+	}
+	return job, nil
+}
+
+// ReadStatus reads the status of a FlinkDeployment or a FlinkSessionJob.
+func ReadStatus(obj *unstructured.Unstructured) *Status {
+	status := &Status{}
+	// The status is only written by the Flink operator, ignore it if malformed.
+	_ = utilunstructured.FromField(obj.Object, status, "status")
+	return status
+}
+
+// IsSuspended returns whether the desired state of the job is suspended.
+func IsSuspended(obj *unstructured.Unstructured) bool {
+	state, _, _ := unstructured.NestedString(obj.Object, "spec", "job", "state")
+	return state == JobStateSuspended
+}
+
+// SetJobState sets the desired state of the job.
+func SetJobState(obj *unstructured.Unstructured, state string) error {
+	return unstructured.SetNestedField(obj.Object, state, "spec", "job", "state")
+}
+
+// IsJobActive returns whether the Flink job is submitted and not terminated
+// or suspended.
+func IsJobActive(state string) bool {
+	switch state {
+	case "", JobStatusFinished, JobStatusFailed, JobStatusCanceled, JobStatusSuspended:
+		return false
+	}
+	return true
+}
+
+// Finished returns whether the Flink job finished, and if it succeeded.
+func Finished(kind string, status *Status) (message string, success, finished bool) {
+	switch status.JobStatus.State {
+	case JobStatusFinished:
+		return fmt.Sprintf("%s finished successfully", kind), true, true
+	case JobStatusFailed:
+		return status.Error, false, true
+	}
+	return "", false, false
+}
+
+func (s *ClusterSpec) component(role Role) *ComponentSpec {
+	if role.PodSetName == JobManagerPodSetName {
+		return &s.JobManager
+	}
+	return &s.TaskManager
+}
+
+// JobManagers returns the number of JobManager replicas.
+func (s *ClusterSpec) JobManagers() int32 {
+	return ptr.Deref(s.JobManager.Replicas, 1)
+}
+
+// TaskManagers returns the number of TaskManagers of the cluster, the
+// replicas of the TaskManagers if set, or the number of TaskManagers
+// providing the task slots of the parallelism otherwise.
+func (s *ClusterSpec) TaskManagers(parallelism int32) (int32, error) {
+	if s.TaskManager.Replicas != nil {
+		return *s.TaskManager.Replicas, nil
+	}
+	return s.TaskManagersForParallelism(parallelism)
+}
+
+// TaskManagersForParallelism returns the number of TaskManagers providing the
+// task slots of the parallelism.
+func (s *ClusterSpec) TaskManagersForParallelism(parallelism int32) (int32, error) {
+	slots, err := s.TaskSlots()
+	if err != nil {
+		return 0, err
+	}
+	return int32(math.Ceil(float64(max(parallelism, 1)) / float64(slots))), nil
+}
+
+// TaskSlots returns the number of task slots of the TaskManagers.
+func (s *ClusterSpec) TaskSlots() (int32, error) {
+	value, found := s.FlinkConfiguration[slotsConfigKey]
+	if !found {
+		return 1, nil
+	}
+	slots, err := strconv.Atoi(fmt.Sprint(value))
+	if err != nil || slots < 1 {
+		return 0, fmt.Errorf("invalid %s %v, must be a positive integer", slotsConfigKey, value)
+	}
+	return int32(slots), nil
+}
+
+// RolePodTemplate returns the pod template of the JobManager or the TaskManagers,
+// the common pod template merged with the pod template of the role, with
+// the main container requesting the resource of the role.
+func (s *ClusterSpec) RolePodTemplate(role Role) (corev1.PodTemplateSpec, error) {
+	component := s.component(role)
+	template := mergePodTemplates(s.PodTemplate, component.PodTemplate)
+	requests, err := s.requests(role)
+	if err != nil {
+		return corev1.PodTemplateSpec{}, fmt.Errorf("failed to compute the requests of the %s: %w", role.Field, err)
+	}
+	idx := slices.IndexFunc(template.Spec.Containers, func(c corev1.Container) bool { return c.Name == MainContainerName })
+	if idx == -1 {
+		template.Spec.Containers = slices.Insert(template.Spec.Containers, 0, corev1.Container{Name: MainContainerName})
+		idx = 0
+	}
+	template.Spec.Containers[idx].Resources.Requests = requests
+	return template, nil
+}
+
+// requests returns the requests of the main container of the role, the cpu
+// defaulting to 1 and the memory to the process memory of the configuration.
+func (s *ClusterSpec) requests(role Role) (corev1.ResourceList, error) {
+	resources := s.component(role).Resource
+	cpu := ptr.Deref(resources.CPU, 1)
+	requests := corev1.ResourceList{
+		corev1.ResourceCPU: *resource.NewMilliQuantity(int64(math.Round(cpu*1000)), resource.DecimalSI),
+	}
+	memoryValue := resources.Memory
+	if memoryValue == nil {
+		if value, found := s.FlinkConfiguration[role.memoryConfigKey]; found {
+			memoryValue = ptr.To(fmt.Sprint(value))
+		}
+	}
+	if memoryValue != nil {
+		memory, err := ParseMemory(*memoryValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid memory: %w", err)
+		}
+		requests[corev1.ResourceMemory] = memory
+	}
+	if resources.EphemeralStorage != nil {
+		storage, err := resource.ParseQuantity(*resources.EphemeralStorage)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ephemeralStorage: %w", err)
+		}
+		requests[corev1.ResourceEphemeralStorage] = storage
+	}
+	return requests, nil
+}
+
+// ParseMemory parses a memory size in the Flink format, as "2048m" or
+// "2 gb", with a binary unit, in bytes if not set, or as a Kubernetes
+// quantity, as "2Gi".
+func ParseMemory(s string) (resource.Quantity, error) {
+	value := strings.ToLower(strings.TrimSpace(s))
+	multiplier := int64(1)
+	for _, unit := range []struct {
+		suffixes   []string
+		multiplier int64
+	}{
+		{suffixes: []string{"tebibytes", "tb", "t"}, multiplier: 1 << 40},
+		{suffixes: []string{"gibibytes", "gb", "g"}, multiplier: 1 << 30},
+		{suffixes: []string{"mebibytes", "mb", "m"}, multiplier: 1 << 20},
+		{suffixes: []string{"kibibytes", "kb", "k"}, multiplier: 1 << 10},
+		{suffixes: []string{"bytes", "b"}, multiplier: 1},
+	} {
+		found := false
+		for _, suffix := range unit.suffixes {
+			if trimmed, ok := strings.CutSuffix(value, suffix); ok {
+				value, multiplier, found = strings.TrimSpace(trimmed), unit.multiplier, true
+				break
+			}
+		}
+		if found {
+			break
+		}
+	}
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil && n >= 0 {
+		return *resource.NewQuantity(n*multiplier, resource.BinarySI), nil
+	}
+	if q, err := resource.ParseQuantity(strings.TrimSpace(s)); err == nil {
+		return q, nil
+	}
+	return resource.Quantity{}, fmt.Errorf("%q is not a valid Flink memory size", s)
+}
+
+// mergePodTemplates merges the pod template of a role over the common pod
+// template, like the Flink operator does for the fields relevant to Kueue.
+func mergePodTemplates(common, role *corev1.PodTemplateSpec) corev1.PodTemplateSpec {
+	var template corev1.PodTemplateSpec
+	if common != nil {
+		template = *common.DeepCopy()
+	}
+	if role == nil {
+		return template
+	}
+	role = role.DeepCopy()
+	template.Labels = mergeMaps(template.Labels, role.Labels)
+	template.Annotations = mergeMaps(template.Annotations, role.Annotations)
+	template.Spec.NodeSelector = mergeMaps(template.Spec.NodeSelector, role.Spec.NodeSelector)
+	template.Spec.Tolerations = append(template.Spec.Tolerations, role.Spec.Tolerations...)
+	if role.Spec.Affinity != nil {
+		template.Spec.Affinity = role.Spec.Affinity
+	}
+	if role.Spec.PriorityClassName != "" {
+		template.Spec.PriorityClassName = role.Spec.PriorityClassName
+	}
+	template.Spec.InitContainers = mergeContainers(template.Spec.InitContainers, role.Spec.InitContainers)
+	template.Spec.Containers = mergeContainers(template.Spec.Containers, role.Spec.Containers)
+	return template
+}
+
+func mergeMaps(common, role map[string]string) map[string]string {
+	if len(role) == 0 {
+		return common
+	}
+	merged := maps.Clone(common)
+	if merged == nil {
+		merged = make(map[string]string, len(role))
+	}
+	maps.Copy(merged, role)
+	return merged
+}
+
+// mergeContainers merges the containers by name, the resources of the
+// containers of the role replacing the ones of the common containers.
+func mergeContainers(common, role []corev1.Container) []corev1.Container {
+	for _, c := range role {
+		idx := slices.IndexFunc(common, func(cc corev1.Container) bool { return cc.Name == c.Name })
+		if idx == -1 {
+			common = append(common, c)
+			continue
+		}
+		if !equality.Semantic.DeepEqual(c.Resources, corev1.ResourceRequirements{}) {
+			common[idx].Resources = c.Resources
+		}
+	}
+	return common
+}
+
+// RunWithPodSetInfo merges the PodSet info into the pod template of the role.
+func RunWithPodSetInfo(obj *unstructured.Unstructured, spec *ClusterSpec, role Role, info podset.PodSetInfo) error {
+	template := ptr.Deref(spec.component(role).PodTemplate, corev1.PodTemplateSpec{})
+	if err := podset.Merge(&template.ObjectMeta, &template.Spec, info); err != nil {
+		return err
+	}
+	return setPodTemplate(obj, role, &template)
+}
+
+// RestorePodSetInfo restores the pod template of the role from the PodSet
+// info, without the metadata and the scheduling constraints of the common
+// pod template, which keep applying to the pods.
+func RestorePodSetInfo(obj *unstructured.Unstructured, spec *ClusterSpec, role Role, info podset.PodSetInfo) bool {
+	common := ptr.Deref(spec.PodTemplate, corev1.PodTemplateSpec{})
+	info.Labels = withoutCommon(info.Labels, common.Labels)
+	info.Annotations = withoutCommon(info.Annotations, common.Annotations)
+	info.NodeSelector = withoutCommon(info.NodeSelector, common.Spec.NodeSelector)
+	info.Tolerations = slices.DeleteFunc(slices.Clone(info.Tolerations), func(t corev1.Toleration) bool {
+		return slices.Contains(common.Spec.Tolerations, t)
+	})
+	template := ptr.Deref(spec.component(role).PodTemplate, corev1.PodTemplateSpec{})
+	if !podset.RestorePodSpec(&template.ObjectMeta, &template.Spec, info) {
+		return false
+	}
+	return setPodTemplate(obj, role, &template) == nil
+}
+
+func withoutCommon(values, common map[string]string) map[string]string {
+	values = maps.Clone(values)
+	maps.DeleteFunc(values, func(k, v string) bool {
+		commonValue, found := common[k]
+		return found && commonValue == v
+	})
+	return values
+}
+
+// setPodTemplate writes the pod metadata and the scheduling constraints of
+// the pod template of the role, removing the pod template if left empty.
+func setPodTemplate(obj *unstructured.Unstructured, role Role, template *corev1.PodTemplateSpec) error {
+	path := []string{"spec", role.Field, "podTemplate"}
+	if err := utilunstructured.SetOrRemoveStringMap(obj.Object, template.Labels, append(path, "metadata", "labels")...); err != nil {
+		return err
+	}
+	if err := utilunstructured.SetOrRemoveStringMap(obj.Object, template.Annotations, append(path, "metadata", "annotations")...); err != nil {
+		return err
+	}
+	if err := utilunstructured.SetOrRemoveStringMap(obj.Object, template.Spec.NodeSelector, append(path, "spec", "nodeSelector")...); err != nil {
+		return err
+	}
+	if err := utilunstructured.SetOrRemoveTolerations(obj.Object, template.Spec.Tolerations, append(path, "spec", "tolerations")...); err != nil {
+		return err
+	}
+	utilunstructured.RemoveIfEmpty(obj.Object, append(path, "metadata")...)
+	utilunstructured.RemoveIfEmpty(obj.Object, append(path, "spec")...)
+	utilunstructured.RemoveIfEmpty(obj.Object, path...)
+	return nil
+}
+
+// ValidateRole validates the resource of the JobManager or the TaskManagers.
+func (s *ClusterSpec) ValidateRole(role Role, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	resources := s.component(role).Resource
+	resourcePath := specPath.Child(role.Field, "resource")
+	if resources.Memory != nil {
+		if _, err := ParseMemory(*resources.Memory); err != nil {
+			allErrs = append(allErrs, field.Invalid(resourcePath.Child("memory"), *resources.Memory, err.Error()))
+		}
+	} else if _, found := s.FlinkConfiguration[role.memoryConfigKey]; !found {
+		allErrs = append(allErrs, field.Required(resourcePath.Child("memory"), fmt.Sprintf("must be set, or %s, for the %s to be quota managed", role.memoryConfigKey, role.Field)))
+	}
+	if resources.EphemeralStorage != nil {
+		if _, err := resource.ParseQuantity(*resources.EphemeralStorage); err != nil {
+			allErrs = append(allErrs, field.Invalid(resourcePath.Child("ephemeralStorage"), *resources.EphemeralStorage, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// ValidateTaskSlots validates the task slots of the Flink configuration.
+func (s *ClusterSpec) ValidateTaskSlots(specPath *field.Path) field.ErrorList {
+	if _, err := s.TaskSlots(); err != nil {
+		return field.ErrorList{field.Invalid(specPath.Child("flinkConfiguration").Key(slotsConfigKey), s.FlinkConfiguration[slotsConfigKey], "must be a positive integer")}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flinkcluster
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+func TestParseMemory(t *testing.T) {
+	cases := map[string]struct {
+		value   string
+		want    resource.Quantity
+		wantErr bool
+	}{
+		"no unit":               {value: "1048576", want: resource.MustParse("1Mi")},
+		"megabytes":             {value: "2048m", want: resource.MustParse("2Gi")},
+		"gigabytes with spaces": {value: "2 gb", want: resource.MustParse("2Gi")},
+		"long unit":             {value: "512 mebibytes", want: resource.MustParse("512Mi")},
+		"kilobytes":             {value: "1024K", want: resource.MustParse("1Mi")},
+		"kubernetes quantity":   {value: "1.5Gi", want: resource.MustParse("1536Mi")},
+		"invalid":               {value: "lots", wantErr: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseMemory(tc.value)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ParseMemory() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if !tc.wantErr && got.Cmp(tc.want) != 0 {
+				t.Errorf("ParseMemory() = %v, want %v", got.String(), tc.want.String())
+			}
+		})
+	}
+}
+
+func TestTaskManagers(t *testing.T) {
+	cases := map[string]struct {
+		spec        ClusterSpec
+		parallelism int32
+		want        int32
+		wantErr     bool
+	}{
+		"one slot per TaskManager by default": {
+			parallelism: 3,
+			want:        3,
+		},
+		"numeric task slots": {
+			spec:        ClusterSpec{FlinkConfiguration: map[string]any{slotsConfigKey: int64(4)}},
+			parallelism: 9,
+			want:        3,
+		},
+		"replicas": {
+			spec:        ClusterSpec{TaskManager: ComponentSpec{Replicas: ptr.To[int32](2)}},
+			parallelism: 9,
+			want:        2,
+		},
+		"invalid task slots": {
+			spec:    ClusterSpec{FlinkConfiguration: map[string]any{slotsConfigKey: "0"}},
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.spec.TaskManagers(tc.parallelism)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("TaskManagers() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("TaskManagers() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flinkdeployment
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/flink/flinkcluster"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utilunstructured "sigs.k8s.io/kueue/pkg/util/unstructured"
+)
+
+var (
+	gvk = schema.GroupVersionKind{Group: "flink.apache.org", Version: "v1beta1", Kind: "FlinkDeployment"}
+
+	FrameworkName = "flink.apache.org/flinkdeployment"
+
+	NewReconciler = jobframework.NewGenericReconcilerFactory(NewJob)
+
+	SetupFlinkDeploymentWebhook = jobframework.BaseWebhookFactory(
+		NewJob(),
+		func(o runtime.Object) jobframework.GenericJob {
+			return fromObject(o)
+		},
+	)
+)
+
+const (
+	jobManagerDeploymentReady   = "READY"
+	jobManagerDeploymentMissing = "MISSING"
+)
+
+func init() {
+	utilruntime.Must(jobframework.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:  SetupIndexes,
+		NewJob:        NewJob,
+		NewReconciler: NewReconciler,
+		SetupWebhook:  SetupFlinkDeploymentWebhook,
+		JobType:       utilunstructured.NewObject(gvk),
+		GVK:           gvk,
+		AddToScheme:   utilunstructured.AddToSchemeFunc(gvk),
+	}))
+}
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update
+// +kubebuilder:rbac:groups=flink.apache.org,resources=flinkdeployments,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=flink.apache.org,resources=flinkdeployments/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=flink.apache.org,resources=flinkdeployments/finalizers,verbs=get;update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloadpriorityclasses,verbs=get;list;watch
+//+kubebuilder:webhook:path=/mutate-flink-apache-org-v1beta1-flinkdeployment,mutating=true,failurePolicy=fail,sideEffects=None,groups=flink.apache.org,resources=flinkdeployments,verbs=create,versions=v1beta1,name=mflinkdeployment.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-flink-apache-org-v1beta1-flinkdeployment,mutating=false,failurePolicy=fail,sideEffects=None,groups=flink.apache.org,resources=flinkdeployments,verbs=create;update,versions=v1beta1,name=vflinkdeployment.kb.io,admissionReviewVersions=v1
+
+func NewJob() jobframework.GenericJob {
+	return &FlinkDeployment{Unstructured: utilunstructured.NewObject(gvk)}
+}
+
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
+}
+
+// FlinkDeployment is a FlinkDeployment of the Flink Kubernetes operator
+// running a job in application mode, handled as an unstructured object.
+type FlinkDeployment struct {
+	*unstructured.Unstructured
+}
+
+var _ jobframework.GenericJob = (*FlinkDeployment)(nil)
+var _ jobframework.JobWithPriorityClass = (*FlinkDeployment)(nil)
+var _ jobframework.JobWithCustomValidation = (*FlinkDeployment)(nil)
+
+// roles are the JobManager and the TaskManagers, in the order of the PodSets.
+var roles = []flinkcluster.Role{flinkcluster.JobManager, flinkcluster.TaskManager}
+
+func fromObject(o runtime.Object) *FlinkDeployment {
+	return &FlinkDeployment{Unstructured: o.(*unstructured.Unstructured)}
+}
+
+func (j *FlinkDeployment) Object() client.Object {
+	return j.Unstructured
+}
+
+// IsSuspended returns whether the job of the FlinkDeployment is suspended,
+// as the Flink operator tears down the cluster of the suspended jobs.
+func (j *FlinkDeployment) IsSuspended() bool {
+	return flinkcluster.IsSuspended(j.Unstructured)
+}
+
+// IsActive returns whether the JobManager deployment of the cluster exists.
+func (j *FlinkDeployment) IsActive() bool {
+	switch flinkcluster.ReadStatus(j.Unstructured).JobManagerDeploymentStatus {
+	case "", jobManagerDeploymentMissing:
+		return false
+	}
+	return true
+}
+
+func (j *FlinkDeployment) Suspend() {
+	utilruntime.Must(flinkcluster.SetJobState(j.Unstructured, flinkcluster.JobStateSuspended))
+}
+
+func (j *FlinkDeployment) GVK() schema.GroupVersionKind {
+	return gvk
+}
+
+func (j *FlinkDeployment) PriorityClass() string {
+	spec, err := flinkcluster.ReadSpec(j.Unstructured)
+	if err != nil {
+		return ""
+	}
+	template, err := spec.RolePodTemplate(flinkcluster.JobManager)
+	if err != nil {
+		return ""
+	}
+	return template.Spec.PriorityClassName
+}
+
+// PodSets returns a PodSet for the JobManager and one for the TaskManagers,
+// providing the task slots of the parallelism of the job unless their
+// replicas are set.
+func (j *FlinkDeployment) PodSets() ([]kueue.PodSet, error) {
+	spec, err := flinkcluster.ReadSpec(j.Unstructured)
+	if err != nil {
+		return nil, err
+	}
+	podSets := make([]kueue.PodSet, 0, len(roles))
+	for _, role := range roles {
+		template, err := spec.RolePodTemplate(role)
+		if err != nil {
+			return nil, err
+		}
+		ps := kueue.PodSet{
+			Name:     role.PodSetName,
+			Template: template,
+			Count:    spec.JobManagers(),
+		}
+		if role == flinkcluster.TaskManager {
+			var parallelism int32
+			if spec.Job != nil {
+				parallelism = ptr.Deref(spec.Job.Parallelism, 1)
+			}
+			if ps.Count, err = spec.TaskManagers(parallelism); err != nil {
+				return nil, err
+			}
+		}
+		podSets = append(podSets, ps)
+	}
+	return podSets, nil
+}
+
+func (j *FlinkDeployment) RunWithPodSetsInfo(podSetsInfo []podset.PodSetInfo) error {
+	if len(podSetsInfo) != len(roles) {
+		return podset.BadPodSetsInfoLenError(len(roles), len(podSetsInfo))
+	}
+	spec, err := flinkcluster.ReadSpec(j.Unstructured)
+	if err != nil {
+		return err
+	}
+	if err := flinkcluster.SetJobState(j.Unstructured, flinkcluster.JobStateRunning); err != nil {
+		return err
+	}
+	for i, role := range roles {
+		if err := flinkcluster.RunWithPodSetInfo(j.Unstructured, spec, role, podSetsInfo[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *FlinkDeployment) RestorePodSetsInfo(podSetsInfo []podset.PodSetInfo) bool {
+	if len(podSetsInfo) != len(roles) {
+		return false
+	}
+	spec, err := flinkcluster.ReadSpec(j.Unstructured)
+	if err != nil {
+		return false
+	}
+	changed := false
+	for i, role := range roles {
+		changed = flinkcluster.RestorePodSetInfo(j.Unstructured, spec, role, podSetsInfo[i]) || changed
+	}
+	return changed
+}
+
+func (j *FlinkDeployment) Finished() (message string, success, finished bool) {
+	return flinkcluster.Finished(gvk.Kind, flinkcluster.ReadStatus(j.Unstructured))
+}
+
+func (j *FlinkDeployment) PodsReady() bool {
+	status := flinkcluster.ReadStatus(j.Unstructured)
+	return status.JobManagerDeploymentStatus == jobManagerDeploymentReady && status.JobStatus.State == flinkcluster.JobStatusRunning
+}
+
+// ValidateOnCreate rejects the queued session clusters, which have no job to
+// suspend, and the clusters which resources cannot be computed.
+func (j *FlinkDeployment) ValidateOnCreate() (field.ErrorList, error) {
+	if jobframework.QueueName(j) == "" {
+		return nil, nil
+	}
+	spec, err := flinkcluster.ReadSpec(j.Unstructured)
+	if err != nil {
+		return nil, err
+	}
+	specPath := field.NewPath("spec")
+	if spec.Job == nil {
+		return field.ErrorList{field.Required(specPath.Child("job"), "must be set for the FlinkDeployment to be queued, the FlinkSessionJobs of the session clusters are queued instead")}, nil
+	}
+	var allErrs field.ErrorList
+	for _, role := range roles {
+		allErrs = append(allErrs, spec.ValidateRole(role, specPath)...)
+	}
+	allErrs = append(allErrs, spec.ValidateTaskSlots(specPath)...)
+	return allErrs, nil
+}
+
+func (j *FlinkDeployment) ValidateOnUpdate(_ jobframework.GenericJob) (field.ErrorList, error) {
+	return j.ValidateOnCreate()
+}
+
+func GetWorkloadNameForFlinkDeployment(name string, uid types.UID) string {
+	return jobframework.GetWorkloadNameForOwnerWithGVK(name, uid, gvk)
+}
@@ -0,0 +1,320 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flinkdeployment
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/flink/flinkcluster"
+	"sigs.k8s.io/kueue/pkg/podset"
+	testingflink "sigs.k8s.io/kueue/pkg/util/testingjobs/flink"
+)
+
+func mainContainer(requests corev1.ResourceList) corev1.Container {
+	return corev1.Container{
+		Name:      flinkcluster.MainContainerName,
+		Resources: corev1.ResourceRequirements{Requests: requests},
+	}
+}
+
+func requests(cpu, memory string) corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(cpu),
+		corev1.ResourceMemory: resource.MustParse(memory),
+	}
+}
+
+func TestPodSets(t *testing.T) {
+	cases := map[string]struct {
+		deployment  *unstructured.Unstructured
+		wantPodSets []kueue.PodSet
+	}{
+		"TaskManagers providing the task slots of the parallelism": {
+			deployment: testingflink.MakeFlinkDeployment("flink", "ns").
+				Parallelism(5).
+				TaskSlots("2").
+				Obj(),
+			wantPodSets: []kueue.PodSet{
+				{
+					Name:     flinkcluster.JobManagerPodSetName,
+					Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{mainContainer(requests("1", "2Gi"))}}},
+					Count:    1,
+				},
+				{
+					Name:     flinkcluster.TaskManagerPodSetName,
+					Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{mainContainer(requests("1", "2Gi"))}}},
+					Count:    3,
+				},
+			},
+		},
+		"merged pod templates and replicas": {
+			deployment: testingflink.MakeFlinkDeployment("flink", "ns").
+				Parallelism(8).
+				Replicas("taskManager", 2).
+				Resource("taskManager", "cpu", 0.5).
+				Resource("taskManager", "memory", "4g").
+				Resource("taskManager", "ephemeralStorage", "10Gi").
+				PodTemplateLabel("", "team", "data").
+				PodTemplateNodeSelector("", "zone", "a").
+				PodTemplateContainer("", map[string]any{"name": "flink-main-container", "image": "flink:1.20"}).
+				PodTemplateContainer("taskManager", map[string]any{
+					"name":      "sidecar",
+					"resources": map[string]any{"requests": map[string]any{"cpu": "100m"}},
+				}).
+				PodTemplateNodeSelector("taskManager", "instance", "spot").
+				Obj(),
+			wantPodSets: []kueue.PodSet{
+				{
+					Name: flinkcluster.JobManagerPodSetName,
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "data"}},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name:      flinkcluster.MainContainerName,
+								Image:     "flink:1.20",
+								Resources: corev1.ResourceRequirements{Requests: requests("1", "2Gi")},
+							}},
+							NodeSelector: map[string]string{"zone": "a"},
+						},
+					},
+					Count: 1,
+				},
+				{
+					Name: flinkcluster.TaskManagerPodSetName,
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "data"}},
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Name:  flinkcluster.MainContainerName,
+									Image: "flink:1.20",
+									Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+										corev1.ResourceCPU:              resource.MustParse("500m"),
+										corev1.ResourceMemory:           resource.MustParse("4Gi"),
+										corev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+									}},
+								},
+								{
+									Name:      "sidecar",
+									Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")}},
+								},
+							},
+							NodeSelector: map[string]string{"zone": "a", "instance": "spot"},
+						},
+					},
+					Count: 2,
+				},
+			},
+		},
+		"memory of the Flink configuration": {
+			deployment: testingflink.MakeFlinkDeployment("flink", "ns").
+				Remove("spec", "jobManager", "resource", "memory").
+				Set("1600m", "spec", "flinkConfiguration", "jobmanager.memory.process.size").
+				Obj(),
+			wantPodSets: []kueue.PodSet{
+				{
+					Name:     flinkcluster.JobManagerPodSetName,
+					Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{mainContainer(requests("1", "1600Mi"))}}},
+					Count:    1,
+				},
+				{
+					Name:     flinkcluster.TaskManagerPodSetName,
+					Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{mainContainer(requests("1", "2Gi"))}}},
+					Count:    1,
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotPodSets, err := fromObject(tc.deployment).PodSets()
+			if err != nil {
+				t.Fatalf("PodSets() error = %v", err)
+			}
+			if diff := cmp.Diff(tc.wantPodSets, gotPodSets); diff != "" {
+				t.Errorf("Unexpected podSets (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRunWithPodSetsInfoAndRestore(t *testing.T) {
+	original := testingflink.MakeFlinkDeployment("flink", "ns").
+		PodTemplateNodeSelector("", "zone", "a").
+		PodTemplateLabel("taskManager", "team", "data").
+		Obj()
+	deployment := fromObject(original.DeepCopy())
+	err := deployment.RunWithPodSetsInfo([]podset.PodSetInfo{
+		{NodeSelector: map[string]string{"instance": "on-demand"}},
+		{
+			NodeSelector: map[string]string{"instance": "spot"},
+			Tolerations:  []corev1.Toleration{{Key: "spot", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("RunWithPodSetsInfo() error = %v", err)
+	}
+	wantRunning := testingflink.MakeFlinkDeployment("flink", "ns").
+		JobState(flinkcluster.JobStateRunning).
+		PodTemplateNodeSelector("", "zone", "a").
+		PodTemplateNodeSelector("jobManager", "instance", "on-demand").
+		PodTemplateLabel("taskManager", "team", "data").
+		PodTemplateNodeSelector("taskManager", "instance", "spot").
+		Set([]any{map[string]any{"key": "spot", "operator": "Exists", "effect": "NoSchedule"}}, "spec", "taskManager", "podTemplate", "spec", "tolerations").
+		Obj()
+	if diff := cmp.Diff(wantRunning.Object, deployment.Unstructured.Object); diff != "" {
+		t.Errorf("Unexpected running FlinkDeployment (-want,+got):\n%s", diff)
+	}
+
+	podSets, err := fromObject(original.DeepCopy()).PodSets()
+	if err != nil {
+		t.Fatalf("PodSets() error = %v", err)
+	}
+	deployment.Suspend()
+	if !deployment.RestorePodSetsInfo([]podset.PodSetInfo{podset.FromPodSet(&podSets[0]), podset.FromPodSet(&podSets[1])}) {
+		t.Errorf("RestorePodSetsInfo() returned no change")
+	}
+	if diff := cmp.Diff(original.Object, deployment.Unstructured.Object); diff != "" {
+		t.Errorf("Unexpected restored FlinkDeployment (-want,+got):\n%s", diff)
+	}
+}
+
+func TestStatus(t *testing.T) {
+	cases := map[string]struct {
+		deployment    *unstructured.Unstructured
+		wantSuspended bool
+		wantActive    bool
+		wantPodsReady bool
+		wantFinished  bool
+		wantSuccess   bool
+		wantMessage   string
+	}{
+		"new": {
+			deployment:    testingflink.MakeFlinkDeployment("flink", "ns").Obj(),
+			wantSuspended: true,
+		},
+		"deploying": {
+			deployment: testingflink.MakeFlinkDeployment("flink", "ns").
+				JobState(flinkcluster.JobStateRunning).
+				JobManagerDeploymentStatus("DEPLOYING").
+				JobStatus("RECONCILING").
+				Obj(),
+			wantActive: true,
+		},
+		"running": {
+			deployment: testingflink.MakeFlinkDeployment("flink", "ns").
+				JobState(flinkcluster.JobStateRunning).
+				JobManagerDeploymentStatus("READY").
+				JobStatus("RUNNING").
+				Obj(),
+			wantActive:    true,
+			wantPodsReady: true,
+		},
+		"suspended": {
+			deployment: testingflink.MakeFlinkDeployment("flink", "ns").
+				JobManagerDeploymentStatus("MISSING").
+				JobStatus("FINISHED").
+				Obj(),
+			wantSuspended: true,
+			wantFinished:  true,
+			wantSuccess:   true,
+			wantMessage:   "FlinkDeployment finished successfully",
+		},
+		"failed": {
+			deployment: testingflink.MakeFlinkDeployment("flink", "ns").
+				JobState(flinkcluster.JobStateRunning).
+				JobManagerDeploymentStatus("READY").
+				JobStatus("FAILED").
+				Error("job failed").
+				Obj(),
+			wantActive:   true,
+			wantFinished: true,
+			wantMessage:  "job failed",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deployment := fromObject(tc.deployment)
+			if got := deployment.IsSuspended(); got != tc.wantSuspended {
+				t.Errorf("IsSuspended() = %v, want %v", got, tc.wantSuspended)
+			}
+			if got := deployment.IsActive(); got != tc.wantActive {
+				t.Errorf("IsActive() = %v, want %v", got, tc.wantActive)
+			}
+			if got := deployment.PodsReady(); got != tc.wantPodsReady {
+				t.Errorf("PodsReady() = %v, want %v", got, tc.wantPodsReady)
+			}
+			message, success, finished := deployment.Finished()
+			if message != tc.wantMessage || success != tc.wantSuccess || finished != tc.wantFinished {
+				t.Errorf("Finished() = (%q, %v, %v), want (%q, %v, %v)", message, success, finished, tc.wantMessage, tc.wantSuccess, tc.wantFinished)
+			}
+		})
+	}
+}
+
+func TestValidateOnCreate(t *testing.T) {
+	cases := map[string]struct {
+		deployment *unstructured.Unstructured
+		wantErrs   field.ErrorList
+	}{
+		"valid": {
+			deployment: testingflink.MakeFlinkDeployment("flink", "ns").Queue("queue").TaskSlots("4").Obj(),
+		},
+		"session cluster not managed": {
+			deployment: testingflink.MakeFlinkDeployment("flink", "ns").Remove("spec", "job").Obj(),
+		},
+		"queued session cluster": {
+			deployment: testingflink.MakeFlinkDeployment("flink", "ns").Queue("queue").Remove("spec", "job").Obj(),
+			wantErrs: field.ErrorList{
+				field.Required(field.NewPath("spec", "job"), ""),
+			},
+		},
+		"invalid resources": {
+			deployment: testingflink.MakeFlinkDeployment("flink", "ns").
+				Queue("queue").
+				Remove("spec", "jobManager", "resource", "memory").
+				Resource("taskManager", "memory", "lots").
+				TaskSlots("zero").
+				Obj(),
+			wantErrs: field.ErrorList{
+				field.Required(field.NewPath("spec", "jobManager", "resource", "memory"), ""),
+				field.Invalid(field.NewPath("spec", "taskManager", "resource", "memory"), "lots", ""),
+				field.Invalid(field.NewPath("spec", "flinkConfiguration").Key("taskmanager.numberOfTaskSlots"), "zero", ""),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotErrs, err := fromObject(tc.deployment).ValidateOnCreate()
+			if err != nil {
+				t.Fatalf("ValidateOnCreate() error = %v", err)
+			}
+			if diff := cmp.Diff(tc.wantErrs, gotErrs, cmpopts.IgnoreFields(field.Error{}, "Detail")); diff != "" {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flinksessionjob
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/flink/flinkcluster"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utilunstructured "sigs.k8s.io/kueue/pkg/util/unstructured"
+)
+
+var (
+	gvk           = schema.GroupVersionKind{Group: "flink.apache.org", Version: "v1beta1", Kind: "FlinkSessionJob"}
+	deploymentGVK = schema.GroupVersionKind{Group: "flink.apache.org", Version: "v1beta1", Kind: "FlinkDeployment"}
+
+	FrameworkName = "flink.apache.org/flinksessionjob"
+
+	SetupFlinkSessionJobWebhook = jobframework.BaseWebhookFactory(
+		NewJob(),
+		func(o runtime.Object) jobframework.GenericJob {
+			return fromObject(o)
+		},
+	)
+)
+
+func init() {
+	utilruntime.Must(jobframework.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:  SetupIndexes,
+		NewJob:        NewJob,
+		NewReconciler: NewReconciler,
+		SetupWebhook:  SetupFlinkSessionJobWebhook,
+		JobType:       utilunstructured.NewObject(gvk),
+		GVK:           gvk,
+		AddToScheme:   utilunstructured.AddToSchemeFunc(gvk),
+	}))
+}
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update
+// +kubebuilder:rbac:groups=flink.apache.org,resources=flinksessionjobs,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=flink.apache.org,resources=flinksessionjobs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=flink.apache.org,resources=flinksessionjobs/finalizers,verbs=get;update
+// +kubebuilder:rbac:groups=flink.apache.org,resources=flinkdeployments,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloadpriorityclasses,verbs=get;list;watch
+//+kubebuilder:webhook:path=/mutate-flink-apache-org-v1beta1-flinksessionjob,mutating=true,failurePolicy=fail,sideEffects=None,groups=flink.apache.org,resources=flinksessionjobs,verbs=create,versions=v1beta1,name=mflinksessionjob.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-flink-apache-org-v1beta1-flinksessionjob,mutating=false,failurePolicy=fail,sideEffects=None,groups=flink.apache.org,resources=flinksessionjobs,verbs=create;update,versions=v1beta1,name=vflinksessionjob.kb.io,admissionReviewVersions=v1
+
+type sessionJobReconciler struct {
+	jr     *jobframework.JobReconciler
+	client client.Client
+}
+
+var reconciler sessionJobReconciler
+var _ jobframework.JobReconcilerInterface = (*sessionJobReconciler)(nil)
+
+func NewReconciler(client client.Client, eventRecorder record.EventRecorder, opts ...jobframework.Option) jobframework.JobReconcilerInterface {
+	reconciler = sessionJobReconciler{
+		jr:     jobframework.NewReconciler(client, eventRecorder, opts...),
+		client: client,
+	}
+	return &reconciler
+}
+
+func (r *sessionJobReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	job := utilunstructured.NewObject(gvk)
+	if err := r.client.Get(ctx, req.NamespacedName, job); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if _, err := getSessionCluster(ctx, fromObject(job)); err != nil {
+		// we only reconcile the FlinkSessionJob when its session cluster is available
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	return r.jr.ReconcileGenericJob(ctx, req, NewJob())
+}
+
+func (r *sessionJobReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(utilunstructured.NewObject(gvk)).Owns(&kueue.Workload{}).
+		Watches(utilunstructured.NewObject(deploymentGVK), handler.EnqueueRequestsFromMapFunc(r.sessionJobsOfCluster))
+	return b.Complete(r)
+}
+
+// sessionJobsOfCluster returns the FlinkSessionJobs of the session cluster,
+// for them to be reconciled once the cluster is available.
+func (r *sessionJobReconciler) sessionJobsOfCluster(ctx context.Context, obj client.Object) []reconcile.Request {
+	jobs := &unstructured.UnstructuredList{}
+	jobs.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := r.client.List(ctx, jobs, client.InNamespace(obj.GetNamespace())); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list the FlinkSessionJobs of the session cluster", "flinkDeployment", klog.KObj(obj))
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range jobs.Items {
+		if deploymentName(&jobs.Items[i]) == obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&jobs.Items[i])})
+		}
+	}
+	return requests
+}
+
+func NewJob() jobframework.GenericJob {
+	return &FlinkSessionJob{Unstructured: utilunstructured.NewObject(gvk)}
+}
+
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
+}
+
+// FlinkSessionJob is a FlinkSessionJob of the Flink Kubernetes operator,
+// running a job on the TaskManagers that its session cluster, a
+// FlinkDeployment without a job, allocates for it.
+type FlinkSessionJob struct {
+	*unstructured.Unstructured
+}
+
+var _ jobframework.GenericJob = (*FlinkSessionJob)(nil)
+var _ jobframework.JobWithCustomValidation = (*FlinkSessionJob)(nil)
+
+func fromObject(o runtime.Object) *FlinkSessionJob {
+	return &FlinkSessionJob{Unstructured: o.(*unstructured.Unstructured)}
+}
+
+func deploymentName(obj *unstructured.Unstructured) string {
+	name, _, _ := unstructured.NestedString(obj.Object, "spec", "deploymentName")
+	return name
+}
+
+func getSessionCluster(ctx context.Context, j *FlinkSessionJob) (*unstructured.Unstructured, error) {
+	cluster := utilunstructured.NewObject(deploymentGVK)
+	err := reconciler.client.Get(ctx, types.NamespacedName{Name: deploymentName(j.Unstructured), Namespace: j.GetNamespace()}, cluster)
+	return cluster, err
+}
+
+func (j *FlinkSessionJob) Object() client.Object {
+	return j.Unstructured
+}
+
+func (j *FlinkSessionJob) IsSuspended() bool {
+	return flinkcluster.IsSuspended(j.Unstructured)
+}
+
+func (j *FlinkSessionJob) IsActive() bool {
+	return flinkcluster.IsJobActive(flinkcluster.ReadStatus(j.Unstructured).JobStatus.State)
+}
+
+func (j *FlinkSessionJob) Suspend() {
+	utilruntime.Must(flinkcluster.SetJobState(j.Unstructured, flinkcluster.JobStateSuspended))
+}
+
+func (j *FlinkSessionJob) GVK() schema.GroupVersionKind {
+	return gvk
+}
+
+// PodSets returns a PodSet for the TaskManagers providing the task slots of
+// the parallelism of the job, with the TaskManager pod template of the
+// session cluster. The JobManager of the session cluster is shared by its
+// jobs and isn't part of the PodSets.
+func (j *FlinkSessionJob) PodSets() ([]kueue.PodSet, error) {
+	// TODO: Ideally we should be using the parent context here
+	cluster, err := getSessionCluster(context.Background(), j)
+	if err != nil {
+		return nil, err
+	}
+	return j.podSets(cluster)
+}
+
+func (j *FlinkSessionJob) podSets(cluster *unstructured.Unstructured) ([]kueue.PodSet, error) {
+	clusterSpec, err := flinkcluster.ReadSpec(cluster)
+	if err != nil {
+		return nil, err
+	}
+	job, err := flinkcluster.ReadJobSpec(j.Unstructured)
+	if err != nil {
+		return nil, err
+	}
+	template, err := clusterSpec.RolePodTemplate(flinkcluster.TaskManager)
+	if err != nil {
+		return nil, err
+	}
+	count, err := clusterSpec.TaskManagersForParallelism(ptr.Deref(job.Parallelism, 1))
+	if err != nil {
+		return nil, err
+	}
+	return []kueue.PodSet{{
+		Name:     flinkcluster.TaskManagerPodSetName,
+		Template: template,
+		Count:    count,
+	}}, nil
+}
+
+// RunWithPodSetsInfo resumes the job. The TaskManagers are created by the
+// session cluster, the node selectors and tolerations of the flavors have to
+// be part of its TaskManager pod template.
+func (j *FlinkSessionJob) RunWithPodSetsInfo(podSetsInfo []podset.PodSetInfo) error {
+	if len(podSetsInfo) != 1 {
+		return podset.BadPodSetsInfoLenError(1, len(podSetsInfo))
+	}
+	return flinkcluster.SetJobState(j.Unstructured, flinkcluster.JobStateRunning)
+}
+
+func (j *FlinkSessionJob) RestorePodSetsInfo(_ []podset.PodSetInfo) bool {
+	return false
+}
+
+func (j *FlinkSessionJob) Finished() (message string, success, finished bool) {
+	return flinkcluster.Finished(gvk.Kind, flinkcluster.ReadStatus(j.Unstructured))
+}
+
+func (j *FlinkSessionJob) PodsReady() bool {
+	return flinkcluster.ReadStatus(j.Unstructured).JobStatus.State == flinkcluster.JobStatusRunning
+}
+
+func (j *FlinkSessionJob) ValidateOnCreate() (field.ErrorList, error) {
+	if jobframework.QueueName(j) == "" {
+		return nil, nil
+	}
+	if _, found, _ := unstructured.NestedMap(j.Unstructured.Object, "spec", "job"); !found {
+		return field.ErrorList{field.Required(field.NewPath("spec", "job"), "must be set for the FlinkSessionJob to be queued")}, nil
+	}
+	return nil, nil
+}
+
+func (j *FlinkSessionJob) ValidateOnUpdate(_ jobframework.GenericJob) (field.ErrorList, error) {
+	return j.ValidateOnCreate()
+}
+
+func GetWorkloadNameForFlinkSessionJob(name string, uid types.UID) string {
+	return jobframework.GetWorkloadNameForOwnerWithGVK(name, uid, gvk)
+}
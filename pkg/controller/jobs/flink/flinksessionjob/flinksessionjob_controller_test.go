@@ -0,0 +1,157 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flinksessionjob
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/jobs/flink/flinkcluster"
+	"sigs.k8s.io/kueue/pkg/podset"
+	testingflink "sigs.k8s.io/kueue/pkg/util/testingjobs/flink"
+)
+
+func TestPodSets(t *testing.T) {
+	cluster := testingflink.MakeFlinkDeployment("session", "ns").
+		Remove("spec", "job").
+		TaskSlots("2").
+		PodTemplateNodeSelector("taskManager", "instance", "spot").
+		Obj()
+	job := testingflink.MakeFlinkSessionJob("job", "ns", "session").Parallelism(5).Obj()
+
+	gotPodSets, err := fromObject(job).podSets(cluster)
+	if err != nil {
+		t.Fatalf("podSets() error = %v", err)
+	}
+	wantPodSets := []kueue.PodSet{{
+		Name: flinkcluster.TaskManagerPodSetName,
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name: flinkcluster.MainContainerName,
+					Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("2Gi"),
+					}},
+				}},
+				NodeSelector: map[string]string{"instance": "spot"},
+			},
+		},
+		Count: 3,
+	}}
+	if diff := cmp.Diff(wantPodSets, gotPodSets); diff != "" {
+		t.Errorf("Unexpected podSets (-want,+got):\n%s", diff)
+	}
+}
+
+func TestRunWithPodSetsInfo(t *testing.T) {
+	job := fromObject(testingflink.MakeFlinkSessionJob("job", "ns", "session").Obj())
+	if !job.IsSuspended() {
+		t.Fatalf("IsSuspended() = false, want true")
+	}
+	if err := job.RunWithPodSetsInfo([]podset.PodSetInfo{{Count: 3}}); err != nil {
+		t.Fatalf("RunWithPodSetsInfo() error = %v", err)
+	}
+	if job.IsSuspended() {
+		t.Errorf("IsSuspended() = true after RunWithPodSetsInfo(), want false")
+	}
+	job.Suspend()
+	if !job.IsSuspended() {
+		t.Errorf("IsSuspended() = false after Suspend(), want true")
+	}
+}
+
+func TestStatus(t *testing.T) {
+	cases := map[string]struct {
+		job           *unstructured.Unstructured
+		wantActive    bool
+		wantPodsReady bool
+		wantFinished  bool
+		wantSuccess   bool
+	}{
+		"new": {
+			job: testingflink.MakeFlinkSessionJob("job", "ns", "session").Obj(),
+		},
+		"running": {
+			job:           testingflink.MakeFlinkSessionJob("job", "ns", "session").JobStatus("RUNNING").Obj(),
+			wantActive:    true,
+			wantPodsReady: true,
+		},
+		"cancelling": {
+			job:        testingflink.MakeFlinkSessionJob("job", "ns", "session").JobStatus("CANCELLING").Obj(),
+			wantActive: true,
+		},
+		"canceled": {
+			job: testingflink.MakeFlinkSessionJob("job", "ns", "session").JobStatus("CANCELED").Obj(),
+		},
+		"finished": {
+			job:          testingflink.MakeFlinkSessionJob("job", "ns", "session").JobStatus("FINISHED").Obj(),
+			wantFinished: true,
+			wantSuccess:  true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			job := fromObject(tc.job)
+			if got := job.IsActive(); got != tc.wantActive {
+				t.Errorf("IsActive() = %v, want %v", got, tc.wantActive)
+			}
+			if got := job.PodsReady(); got != tc.wantPodsReady {
+				t.Errorf("PodsReady() = %v, want %v", got, tc.wantPodsReady)
+			}
+			_, success, finished := job.Finished()
+			if success != tc.wantSuccess || finished != tc.wantFinished {
+				t.Errorf("Finished() = (%v, %v), want (%v, %v)", success, finished, tc.wantSuccess, tc.wantFinished)
+			}
+		})
+	}
+}
+
+func TestValidateOnCreate(t *testing.T) {
+	cases := map[string]struct {
+		job      *unstructured.Unstructured
+		wantErrs field.ErrorList
+	}{
+		"valid": {
+			job: testingflink.MakeFlinkSessionJob("job", "ns", "session").Queue("queue").Obj(),
+		},
+		"queued without a job": {
+			job: testingflink.MakeFlinkSessionJob("job", "ns", "session").Queue("queue").Remove("spec", "job").Obj(),
+			wantErrs: field.ErrorList{
+				field.Required(field.NewPath("spec", "job"), ""),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotErrs, err := fromObject(tc.job).ValidateOnCreate()
+			if err != nil {
+				t.Fatalf("ValidateOnCreate() error = %v", err)
+			}
+			if diff := cmp.Diff(tc.wantErrs, gotErrs, cmpopts.IgnoreFields(field.Error{}, "Detail")); diff != "" {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -43,6 +43,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/podset"
 	clientutil "sigs.k8s.io/kueue/pkg/util/client"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 )
 
 var (
@@ -55,6 +56,12 @@ const (
 	JobMinParallelismAnnotation              = "kueue.x-k8s.io/job-min-parallelism"
 	JobCompletionsEqualParallelismAnnotation = "kueue.x-k8s.io/job-completions-equal-parallelism"
 	StoppingAnnotation                       = "kueue.x-k8s.io/stopping"
+	// JobShrinkMinParallelismAnnotation sets the parallelism to which Kueue can reduce
+	// the parallelism of an admitted elastic job, instead of evicting the job, to make
+	// room for a preempting workload.
+	JobShrinkMinParallelismAnnotation = "kueue.x-k8s.io/job-shrink-min-parallelism"
+	// JobOriginalParallelismAnnotation records the parallelism of a job shrunk by Kueue.
+	JobOriginalParallelismAnnotation = "kueue.x-k8s.io/job-original-parallelism"
 )
 
 func init() {
@@ -141,6 +148,7 @@ var _ jobframework.GenericJob = (*Job)(nil)
 var _ jobframework.JobWithReclaimablePods = (*Job)(nil)
 var _ jobframework.JobWithCustomStop = (*Job)(nil)
 var _ jobframework.JobWithManagedBy = (*Job)(nil)
+var _ jobframework.JobWithElasticParallelism = (*Job)(nil)
 
 func (j *Job) Object() client.Object {
 	return (*batchv1.Job)(j)
@@ -251,7 +259,7 @@ func (j *Job) PodSets() ([]kueue.PodSet, error) {
 		MinCount: j.minPodsCount(),
 	}
 	if features.Enabled(features.TopologyAwareScheduling) {
-		topologyRequest, err := jobframework.NewPodSetTopologyRequest(
+		topologyRequest, err := utiltas.NewPodSetTopologyRequest(
 			&j.Spec.Template.ObjectMeta).PodIndexLabel(
 			ptr.To(batchv1.JobCompletionIndexAnnotation)).Build()
 		if err != nil {
@@ -339,6 +347,48 @@ func (j *Job) SetManagedBy(managedBy *string) {
 	j.Spec.ManagedBy = managedBy
 }
 
+// CanShrink returns true if the parallelism of the job can be reduced to the one
+// set by the JobShrinkMinParallelismAnnotation. Shrinking never changes the completions
+// of the job, so it only delays the completion of the remaining pods.
+func (j *Job) CanShrink() bool {
+	minParallelism := j.shrinkMinParallelism()
+	if minParallelism == nil || j.syncCompletionWithParallelism() {
+		return false
+	}
+	if _, shrunk := j.Annotations[JobOriginalParallelismAnnotation]; shrunk {
+		return false
+	}
+	return j.podsCount() > *minParallelism
+}
+
+func (j *Job) Shrink() bool {
+	if !j.CanShrink() {
+		return false
+	}
+	if j.Annotations == nil {
+		j.Annotations = make(map[string]string, 1)
+	}
+	j.Annotations[JobOriginalParallelismAnnotation] = strconv.Itoa(int(*j.Spec.Parallelism))
+	j.Spec.Parallelism = j.shrinkMinParallelism()
+	return true
+}
+
+func (j *Job) RestoreParallelism() bool {
+	strVal, shrunk := j.Annotations[JobOriginalParallelismAnnotation]
+	if !shrunk {
+		return false
+	}
+	// Wait for the Job controller to remove the pods exceeding the reduced parallelism.
+	if j.Status.Active > ptr.Deref(j.Spec.Parallelism, 1) {
+		return false
+	}
+	if iVal, err := strconv.Atoi(strVal); err == nil {
+		j.Spec.Parallelism = ptr.To(int32(iVal))
+	}
+	delete(j.Annotations, JobOriginalParallelismAnnotation)
+	return true
+}
+
 func (j *Job) podsCount() int32 {
 	// parallelism is always set as it is otherwise defaulted by k8s to 1
 	podsCount := *(j.Spec.Parallelism)
@@ -357,6 +407,15 @@ func (j *Job) minPodsCount() *int32 {
 	return nil
 }
 
+func (j *Job) shrinkMinParallelism() *int32 {
+	if strVal, found := j.GetAnnotations()[JobShrinkMinParallelismAnnotation]; found {
+		if iVal, err := strconv.Atoi(strVal); err == nil && iVal > 0 {
+			return ptr.To[int32](int32(iVal))
+		}
+	}
+	return nil
+}
+
 func (j *Job) syncCompletionWithParallelism() bool {
 	if strVal, found := j.GetAnnotations()[JobCompletionsEqualParallelismAnnotation]; found {
 		if bVal, err := strconv.ParseBool(strVal); err == nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package job
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -40,12 +41,14 @@ import (
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/constants"
 	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/core/indexer"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/podset"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	utiltestingjob "sigs.k8s.io/kueue/pkg/util/testingjobs/job"
 	"sigs.k8s.io/kueue/pkg/workload"
+	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
 func TestPodsReady(t *testing.T) {
@@ -583,19 +586,37 @@ func TestReconciler(t *testing.T) {
 
 	baseWaitForPodsReadyConf := &configapi.WaitForPodsReady{Enable: true}
 
+	largeJobWrapper := baseJobWrapper.Clone().
+		Image("", []string{strings.Repeat("x", workload.PodTemplateCompactionThreshold)}).
+		UID("test-uid")
+	largePodTemplate, err := json.Marshal(&largeJobWrapper.Spec.Template)
+	if err != nil {
+		t.Fatalf("Could not encode the pod template: %v", err)
+	}
+	largePodTemplateConfigMap := workload.PodTemplateConfigMapName(largePodTemplate)
+
 	cases := map[string]struct {
 		enableObjectRetentionPolicies                     bool
 		enableTopologyAwareScheduling                     bool
 		enableManagedJobsNamespaceSelectorAlwaysRespected bool
+		enableGracefulPreemption                          bool
+		enableElasticJobsViaWorkloadSlices                bool
+		enableElasticJobsShrinkOnPreemption               bool
+		enableWorkloadPriorityChange                      bool
+		enablePodTemplateDeduplication                    bool
+		// workloadsWithoutOwner skips the setup of the owner references of the workloads.
+		workloadsWithoutOwner bool
 
 		reconcilerOptions []jobframework.Option
 		job               batchv1.Job
 		workloads         []kueue.Workload
 		otherJobs         []batchv1.Job
+		configMaps        []corev1.ConfigMap
 		priorityClasses   []client.Object
 		wantJob           batchv1.Job
 		wantWorkloads     []kueue.Workload
 		wantEvents        []utiltesting.EventRecord
+		wantConfigMaps    []corev1.ConfigMap
 		wantErr           error
 	}{
 		"PodsReady is set to False before Workload is Admitted": {
@@ -978,6 +999,151 @@ func TestReconciler(t *testing.T) {
 				},
 			},
 		},
+		"when workload is created, its large pod template is compacted": {
+			enablePodTemplateDeduplication: true,
+			job:                            *largeJobWrapper.DeepCopy(),
+			wantJob:                        *largeJobWrapper.DeepCopy(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("job", "ns").
+					Annotations(map[string]string{
+						controllerconsts.PodTemplatesAnnotation: fmt.Sprintf(`{"%s":"%s"}`, kueue.DefaultPodSetName, largePodTemplateConfigMap),
+					}).
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Priority(0).
+					Labels(map[string]string{controllerconsts.JobUIDLabel: "test-uid"}).
+					Obj(),
+			},
+			wantConfigMaps: []corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      largePodTemplateConfigMap,
+						Namespace: "ns",
+						OwnerReferences: []metav1.OwnerReference{{
+							APIVersion: kueue.GroupVersion.String(),
+							Kind:       "Workload",
+							Name:       GetWorkloadNameForJob(baseJobWrapper.Name, types.UID("test-uid")),
+						}},
+					},
+					Data: map[string]string{controllerconsts.PodTemplateConfigMapKey: string(largePodTemplate)},
+				},
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "CreatedWorkload",
+					Message:   "Created Workload: ns/" + GetWorkloadNameForJob(baseJobWrapper.Name, types.UID("test-uid")),
+				},
+			},
+		},
+		"when workload is created, the existing ConfigMap of its large pod template is shared": {
+			enablePodTemplateDeduplication: true,
+			job:                            *largeJobWrapper.DeepCopy(),
+			wantJob:                        *largeJobWrapper.DeepCopy(),
+			configMaps: []corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      largePodTemplateConfigMap,
+						Namespace: "ns",
+						OwnerReferences: []metav1.OwnerReference{{
+							APIVersion: kueue.GroupVersion.String(),
+							Kind:       "Workload",
+							Name:       "other-workload",
+							UID:        "other-workload-uid",
+						}},
+					},
+					Data: map[string]string{controllerconsts.PodTemplateConfigMapKey: string(largePodTemplate)},
+				},
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("job", "ns").
+					Annotations(map[string]string{
+						controllerconsts.PodTemplatesAnnotation: fmt.Sprintf(`{"%s":"%s"}`, kueue.DefaultPodSetName, largePodTemplateConfigMap),
+					}).
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Priority(0).
+					Labels(map[string]string{controllerconsts.JobUIDLabel: "test-uid"}).
+					Obj(),
+			},
+			wantConfigMaps: []corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      largePodTemplateConfigMap,
+						Namespace: "ns",
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion: kueue.GroupVersion.String(),
+								Kind:       "Workload",
+								Name:       GetWorkloadNameForJob(baseJobWrapper.Name, types.UID("test-uid")),
+							},
+							{
+								APIVersion: kueue.GroupVersion.String(),
+								Kind:       "Workload",
+								Name:       "other-workload",
+							},
+						},
+					},
+					Data: map[string]string{controllerconsts.PodTemplateConfigMapKey: string(largePodTemplate)},
+				},
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "CreatedWorkload",
+					Message:   "Created Workload: ns/" + GetWorkloadNameForJob(baseJobWrapper.Name, types.UID("test-uid")),
+				},
+			},
+		},
+		"when workload is created, the existing ConfigMap of another pod template isn't shared": {
+			enablePodTemplateDeduplication: true,
+			job:                            *largeJobWrapper.DeepCopy(),
+			wantJob:                        *largeJobWrapper.DeepCopy(),
+			configMaps: []corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      largePodTemplateConfigMap,
+						Namespace: "ns",
+					},
+					Data: map[string]string{controllerconsts.PodTemplateConfigMapKey: "{}"},
+				},
+			},
+			wantConfigMaps: []corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      largePodTemplateConfigMap,
+						Namespace: "ns",
+					},
+					Data: map[string]string{controllerconsts.PodTemplateConfigMapKey: "{}"},
+				},
+			},
+			wantErr: cmpopts.AnyError,
+		},
+		"the workload with the compacted pod template matches the job": {
+			enablePodTemplateDeduplication: true,
+			job:                            *largeJobWrapper.DeepCopy(),
+			wantJob:                        *largeJobWrapper.DeepCopy(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("job", "ns").
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Priority(0).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("job", "ns").
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Priority(0).
+					Obj(),
+			},
+		},
 		"when workload is created, it has correct labels set": {
 			job: *baseJobWrapper.Clone().
 				Label("toCopyKey", "toCopyValue").
@@ -1983,6 +2149,182 @@ func TestReconciler(t *testing.T) {
 				},
 			},
 		},
+		"when workload is evicted due to preemption and graceful preemption is enabled, job is asked to checkpoint": {
+			enableGracefulPreemption: true,
+			reconcilerOptions: []jobframework.Option{
+				jobframework.WithGracefulPreemption(&configapi.GracefulPreemption{
+					GracePeriod: &metav1.Duration{Duration: time.Minute},
+				}),
+			},
+			job: *baseJobWrapper.Clone().
+				Suspend(false).
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				Suspend(false).
+				SetAnnotation(controllerconsts.CheckpointRequestedAnnotation, testStartTime.Add(50*time.Second).UTC().Format(time.RFC3339)).
+				Obj(),
+			workloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					AdmittedAt(true, testStartTime.Add(-time.Minute)).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadEvicted,
+						Status:             metav1.ConditionTrue,
+						Reason:             kueue.WorkloadEvictedByPreemption,
+						Message:            "Preempted",
+						LastTransitionTime: metav1.NewTime(testStartTime.Add(-10 * time.Second)),
+					}).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					AdmittedAt(true, testStartTime.Add(-time.Minute)).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadEvicted,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadEvictedByPreemption,
+						Message: "Preempted",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadPreemptionRequested,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadCheckpointRequested,
+						Message: "Preempted, the job will be stopped once its checkpoint is ready, or at " + testStartTime.Add(50*time.Second).UTC().Format(time.RFC3339),
+					}).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    jobframework.ReasonCheckpointRequested,
+					Message:   "Preempted, the job will be stopped at " + testStartTime.Add(50*time.Second).UTC().Format(time.RFC3339),
+				},
+			},
+		},
+		"when workload is evicted due to preemption and the job acknowledged its checkpoint, job gets suspended before the deadline": {
+			enableGracefulPreemption: true,
+			reconcilerOptions: []jobframework.Option{
+				jobframework.WithGracefulPreemption(&configapi.GracefulPreemption{
+					GracePeriod: &metav1.Duration{Duration: time.Minute},
+				}),
+			},
+			job: *baseJobWrapper.Clone().
+				Suspend(false).
+				SetAnnotation(controllerconsts.CheckpointRequestedAnnotation, testStartTime.Add(50*time.Second).UTC().Format(time.RFC3339)).
+				SetAnnotation(controllerconsts.CheckpointReadyAnnotation, "true").
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				Suspend(true).
+				Obj(),
+			workloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					AdmittedAt(true, testStartTime.Add(-time.Minute)).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadEvicted,
+						Status:             metav1.ConditionTrue,
+						Reason:             kueue.WorkloadEvictedByPreemption,
+						Message:            "Preempted",
+						LastTransitionTime: metav1.NewTime(testStartTime.Add(-10 * time.Second)),
+					}).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadPreemptionRequested,
+						Status:             metav1.ConditionTrue,
+						Reason:             kueue.WorkloadCheckpointRequested,
+						Message:            "Preempted, the job will be stopped once its checkpoint is ready, or at " + testStartTime.Add(50*time.Second).UTC().Format(time.RFC3339),
+						LastTransitionTime: metav1.NewTime(testStartTime.Add(-10 * time.Second)),
+					}).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					AdmittedAt(true, testStartTime.Add(-time.Minute)).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadEvicted,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadEvictedByPreemption,
+						Message: "Preempted",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadPreemptionRequested,
+						Status:  metav1.ConditionFalse,
+						Reason:  kueue.WorkloadCheckpointReady,
+						Message: "The job was stopped once its checkpoint was ready",
+					}).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "Stopped",
+					Message:   "Preempted",
+				},
+			},
+		},
+		"when workload is evicted due to preemption and the checkpoint grace period elapsed, job gets suspended": {
+			enableGracefulPreemption: true,
+			reconcilerOptions: []jobframework.Option{
+				jobframework.WithGracefulPreemption(&configapi.GracefulPreemption{
+					GracePeriod: &metav1.Duration{Duration: time.Minute},
+				}),
+			},
+			job: *baseJobWrapper.Clone().
+				Suspend(false).
+				SetAnnotation(controllerconsts.CheckpointRequestedAnnotation, testStartTime.Add(-time.Minute).UTC().Format(time.RFC3339)).
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				Suspend(true).
+				Obj(),
+			workloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					AdmittedAt(true, testStartTime.Add(-3*time.Minute)).
+					Condition(metav1.Condition{
+						Type:               kueue.WorkloadEvicted,
+						Status:             metav1.ConditionTrue,
+						Reason:             kueue.WorkloadEvictedByPreemption,
+						Message:            "Preempted",
+						LastTransitionTime: metav1.NewTime(testStartTime.Add(-2 * time.Minute)),
+					}).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					PastAdmittedTime(180).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadAdmitted,
+						Status:  metav1.ConditionFalse,
+						Reason:  "NoReservation",
+						Message: "The workload has no reservation",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadQuotaReserved,
+						Status:  metav1.ConditionFalse,
+						Reason:  "Pending",
+						Message: "Preempted",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadRequeued,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadEvictedByPreemption,
+						Message: "Preempted",
+					}).
+					Condition(metav1.Condition{
+						Type:    kueue.WorkloadEvicted,
+						Status:  metav1.ConditionTrue,
+						Reason:  kueue.WorkloadEvictedByPreemption,
+						Message: "Preempted",
+					}).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "Stopped",
+					Message:   "Preempted",
+				},
+			},
+		},
 		"when job is initially suspended, the Workload has active=false and it's not admitted, " +
 			"it should not get an evicted condition, but the job should remain suspended": {
 			job: *baseJobWrapper.Clone().
@@ -2054,6 +2396,113 @@ func TestReconciler(t *testing.T) {
 					Obj(),
 			},
 		},
+		"when an elastic job is requested to shrink, the parallelism of the job is reduced": {
+			enableElasticJobsViaWorkloadSlices:  true,
+			enableElasticJobsShrinkOnPreemption: true,
+			job: *baseJobWrapper.Clone().
+				Suspend(false).
+				SetAnnotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				SetAnnotation(JobShrinkMinParallelismAnnotation, "4").
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				Suspend(false).
+				Parallelism(4).
+				Obj(),
+			workloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					Annotation(controllerconsts.ShrinkableAnnotation, "true").
+					Annotation(controllerconsts.ShrinkRequestedAnnotation, "Preempted").
+					Admitted(true).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*baseWorkloadWrapper.Clone().
+					Admitted(true).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    jobframework.ReasonShrunk,
+					Message:   "Preempted, reduced the parallelism of the job",
+				},
+			},
+		},
+		"when a shrunk elastic job has its workload slice scaled down, the parallelism of the job is restored": {
+			enableElasticJobsViaWorkloadSlices:  true,
+			enableElasticJobsShrinkOnPreemption: true,
+			job: *baseJobWrapper.Clone().
+				Suspend(false).
+				Parallelism(4).
+				Active(4).
+				SetAnnotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				SetAnnotation(JobShrinkMinParallelismAnnotation, "4").
+				SetAnnotation(JobOriginalParallelismAnnotation, "10").
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				Suspend(false).
+				Parallelism(10).
+				Active(4).
+				Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("wl", "ns").
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 4).Request(corev1.ResourceCPU, "1").Obj()).
+					ReserveQuota(utiltesting.MakeAdmission("cq").PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Count(4).Obj()).Obj()).
+					Admitted(true).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("wl", "ns").
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 4).Request(corev1.ResourceCPU, "1").Obj()).
+					ReserveQuota(utiltesting.MakeAdmission("cq").PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Count(4).Obj()).Obj()).
+					Admitted(true).
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    jobframework.ReasonParallelismRestored,
+					Message:   "Restored the parallelism of the job, the added pods start once admitted",
+				},
+			},
+		},
+		"when a shrunk elastic job still has the removed pods active, the parallelism of the job is not restored": {
+			enableElasticJobsViaWorkloadSlices:  true,
+			enableElasticJobsShrinkOnPreemption: true,
+			job: *baseJobWrapper.Clone().
+				Suspend(false).
+				Parallelism(4).
+				Active(10).
+				SetAnnotation(workloadslicing.EnabledAnnotationKey, workloadslicing.EnabledAnnotationValue).
+				SetAnnotation(JobShrinkMinParallelismAnnotation, "4").
+				SetAnnotation(JobOriginalParallelismAnnotation, "10").
+				Obj(),
+			wantJob: *baseJobWrapper.Clone().
+				Suspend(false).
+				Parallelism(4).
+				Active(10).
+				Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("wl", "ns").
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 4).Request(corev1.ResourceCPU, "1").Obj()).
+					ReserveQuota(utiltesting.MakeAdmission("cq").PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Count(4).Obj()).Obj()).
+					Admitted(true).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("wl", "ns").
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 4).Request(corev1.ResourceCPU, "1").Obj()).
+					ReserveQuota(utiltesting.MakeAdmission("cq").PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Count(4).Obj()).Obj()).
+					Admitted(true).
+					Obj(),
+			},
+		},
 		"when workload is admitted and PodSetUpdates conflict between admission checks on labels, the workload is finished with failure": {
 			job: *baseJobWrapper.Clone().
 				Obj(),
@@ -2629,6 +3078,104 @@ func TestReconciler(t *testing.T) {
 				},
 			},
 		},
+		"the orphaned workload with the name of the job is adopted": {
+			workloadsWithoutOwner: true,
+			job: *baseJobWrapper.
+				Clone().
+				UID("test-uid").
+				Obj(),
+			wantJob: *baseJobWrapper.
+				Clone().
+				UID("test-uid").
+				Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload(GetWorkloadNameForJob(baseJobWrapper.Name, "test-uid"), "ns").
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Labels(map[string]string{
+						controllerconsts.JobUIDLabel: "test-uid",
+					}).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload(GetWorkloadNameForJob(baseJobWrapper.Name, "test-uid"), "ns").
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Labels(map[string]string{
+						controllerconsts.JobUIDLabel: "test-uid",
+					}).
+					ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "job", "test-uid").
+					Obj(),
+			},
+			wantEvents: []utiltesting.EventRecord{
+				{
+					Key:       types.NamespacedName{Name: "job", Namespace: "ns"},
+					EventType: "Normal",
+					Reason:    "AdoptedWorkload",
+					Message:   "Adopted Workload: ns/" + GetWorkloadNameForJob(baseJobWrapper.Name, "test-uid"),
+				},
+			},
+		},
+		"the orphaned workload with the name of the job created for another job is not adopted": {
+			workloadsWithoutOwner: true,
+			job: *baseJobWrapper.
+				Clone().
+				UID("test-uid").
+				Obj(),
+			wantJob: *baseJobWrapper.
+				Clone().
+				UID("test-uid").
+				Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload(GetWorkloadNameForJob(baseJobWrapper.Name, "test-uid"), "ns").
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Labels(map[string]string{
+						controllerconsts.JobUIDLabel: "other-uid",
+					}).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload(GetWorkloadNameForJob(baseJobWrapper.Name, "test-uid"), "ns").
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Labels(map[string]string{
+						controllerconsts.JobUIDLabel: "other-uid",
+					}).
+					Obj(),
+			},
+			wantErr: jobframework.ErrWorkloadOwnedByAnotherObject,
+		},
+		"the orphaned workload with the name of the job without the job UID label is not adopted": {
+			workloadsWithoutOwner: true,
+			job: *baseJobWrapper.
+				Clone().
+				UID("test-uid").
+				Obj(),
+			wantJob: *baseJobWrapper.
+				Clone().
+				UID("test-uid").
+				Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload(GetWorkloadNameForJob(baseJobWrapper.Name, "test-uid"), "ns").
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload(GetWorkloadNameForJob(baseJobWrapper.Name, "test-uid"), "ns").
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Obj(),
+			},
+			wantErr: jobframework.ErrWorkloadOwnedByAnotherObject,
+		},
 		"the workload is updated when queue name has changed for suspended job": {
 			job: *baseJobWrapper.
 				Clone().
@@ -2714,6 +3261,51 @@ func TestReconciler(t *testing.T) {
 				},
 			},
 		},
+		"the workload keeps the priority changed on the workload for suspended job": {
+			enableWorkloadPriorityChange: true,
+			job: *baseJobWrapper.
+				Clone().
+				Suspend(true).
+				WorkloadPriorityClass(baseWPCWrapper.Name).
+				UID("test-uid").
+				Obj(),
+			wantJob: *baseJobWrapper.
+				Clone().
+				WorkloadPriorityClass(baseWPCWrapper.Name).
+				UID("test-uid").
+				Obj(),
+			priorityClasses: []client.Object{
+				baseWPCWrapper.Obj(), highWPCWrapper.Obj(),
+			},
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("job", "ns").
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Priority(highWPCWrapper.Value).
+					PriorityClassSource(constants.WorkloadPriorityClassSource).
+					PriorityClass(highWPCWrapper.Name).
+					Labels(map[string]string{
+						controllerconsts.JobUIDLabel: "test-uid",
+					}).
+					Annotation(controllerconsts.PriorityChangeAnnotation, `{"user":"admin","time":null,"previousPriorityClassName":"`+baseWPCWrapper.Name+`"}`).
+					Obj(),
+			},
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("job", "ns").
+					Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 10).Request(corev1.ResourceCPU, "1").Obj()).
+					Queue("foo").
+					Priority(highWPCWrapper.Value).
+					PriorityClassSource(constants.WorkloadPriorityClassSource).
+					PriorityClass(highWPCWrapper.Name).
+					Labels(map[string]string{
+						controllerconsts.JobUIDLabel: "test-uid",
+					}).
+					Annotation(controllerconsts.PriorityChangeAnnotation, `{"user":"admin","time":null,"previousPriorityClassName":"`+baseWPCWrapper.Name+`"}`).
+					Obj(),
+			},
+		},
 		"the workload without uid label is created when job's uid is longer than 63 characters": {
 			job: *baseJobWrapper.
 				Clone().
@@ -3859,6 +4451,11 @@ func TestReconciler(t *testing.T) {
 				features.SetFeatureGateDuringTest(t, features.TopologyAwareScheduling, tc.enableTopologyAwareScheduling)
 				features.SetFeatureGateDuringTest(t, features.ObjectRetentionPolicies, tc.enableObjectRetentionPolicies)
 				features.SetFeatureGateDuringTest(t, features.ManagedJobsNamespaceSelectorAlwaysRespected, tc.enableManagedJobsNamespaceSelectorAlwaysRespected)
+				features.SetFeatureGateDuringTest(t, features.GracefulPreemption, tc.enableGracefulPreemption)
+				features.SetFeatureGateDuringTest(t, features.ElasticJobsViaWorkloadSlices, tc.enableElasticJobsViaWorkloadSlices)
+				features.SetFeatureGateDuringTest(t, features.ElasticJobsShrinkOnPreemption, tc.enableElasticJobsShrinkOnPreemption)
+				features.SetFeatureGateDuringTest(t, features.WorkloadPriorityChange, tc.enableWorkloadPriorityChange)
+				features.SetFeatureGateDuringTest(t, features.PodTemplateDeduplication, tc.enablePodTemplateDeduplication)
 				features.SetFeatureGateDuringTest(t, features.WorkloadRequestUseMergePatch, enabled)
 
 				ctx, _ := utiltesting.ContextWithLog(t)
@@ -3866,6 +4463,7 @@ func TestReconciler(t *testing.T) {
 				if err := SetupIndexes(ctx, utiltesting.AsIndexer(clientBuilder)); err != nil {
 					t.Fatalf("Could not setup indexes: %v", err)
 				}
+				clientBuilder = clientBuilder.WithIndex(&corev1.Pod{}, indexer.OwnerReferenceUID, indexer.IndexOwnerUID)
 
 				labelledNamespace := utiltesting.MakeNamespaceWrapper("labelled-ns").
 					Label("managed-by-kueue", "true").
@@ -3878,6 +4476,9 @@ func TestReconciler(t *testing.T) {
 				if len(tc.otherJobs) > 0 {
 					kcBuilder = kcBuilder.WithLists(&batchv1.JobList{Items: tc.otherJobs})
 				}
+				if len(tc.configMaps) > 0 {
+					kcBuilder = kcBuilder.WithLists(&corev1.ConfigMapList{Items: tc.configMaps})
+				}
 
 				for i := range tc.workloads {
 					kcBuilder = kcBuilder.WithStatusSubresource(&tc.workloads[i])
@@ -3890,7 +4491,7 @@ func TestReconciler(t *testing.T) {
 				kClient := kcBuilder.Build()
 				for _, testWl := range tc.workloads {
 					controller := metav1.GetControllerOfNoCopy(&testWl)
-					if !useesPrebuiltWorkload && controller == nil {
+					if !useesPrebuiltWorkload && !tc.workloadsWithoutOwner && controller == nil {
 						if err := ctrl.SetControllerReference(&tc.job, &testWl, kClient.Scheme()); err != nil {
 							t.Fatalf("Could not setup owner reference in Workloads: %v", err)
 						}
@@ -3917,13 +4518,17 @@ func TestReconciler(t *testing.T) {
 				if diff := cmp.Diff(tc.wantJob, gotJob, jobCmpOpts...); diff != "" {
 					t.Errorf("Job after reconcile (-want,+got):\n%s", diff)
 				}
+				// The annotations are ignored by jobCmpOpts, the checkpoint request is checked explicitly.
+				if diff := cmp.Diff(tc.wantJob.Annotations[controllerconsts.CheckpointRequestedAnnotation], gotJob.Annotations[controllerconsts.CheckpointRequestedAnnotation]); diff != "" {
+					t.Errorf("Unexpected %s annotation after reconcile (-want,+got):\n%s", controllerconsts.CheckpointRequestedAnnotation, diff)
+				}
 				var gotWorkloads kueue.WorkloadList
 				if err := kClient.List(ctx, &gotWorkloads); err != nil {
 					t.Fatalf("Could not get Workloads after reconcile: %v", err)
 				}
 
 				wlCheckOpts := workloadCmpOpts
-				if useesPrebuiltWorkload {
+				if useesPrebuiltWorkload || tc.workloadsWithoutOwner {
 					wlCheckOpts = workloadCmpOptsWithOwner
 				}
 
@@ -3940,6 +4545,16 @@ func TestReconciler(t *testing.T) {
 				if diff := cmp.Diff(tc.wantEvents, recorder.RecordedEvents); diff != "" {
 					t.Errorf("unexpected events (-want/+got):\n%s", diff)
 				}
+
+				var gotConfigMaps corev1.ConfigMapList
+				if err := kClient.List(ctx, &gotConfigMaps); err != nil {
+					t.Fatalf("Could not get ConfigMaps after reconcile: %v", err)
+				}
+				if diff := cmp.Diff(tc.wantConfigMaps, gotConfigMaps.Items, cmpopts.EquateEmpty(),
+					cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion"),
+					cmpopts.IgnoreFields(metav1.OwnerReference{}, "UID")); diff != "" {
+					t.Errorf("ConfigMaps after reconcile (-want,+got):\n%s", diff)
+				}
 			})
 		}
 	}
@@ -38,12 +38,14 @@ import (
 	"sigs.k8s.io/kueue/pkg/controller/jobframework/webhook"
 	"sigs.k8s.io/kueue/pkg/features"
 	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
+	utilwebhook "sigs.k8s.io/kueue/pkg/util/webhook"
 	"sigs.k8s.io/kueue/pkg/workloadslicing"
 )
 
 var (
 	minPodsCountAnnotationsPath   = field.NewPath("metadata", "annotations").Key(JobMinParallelismAnnotation)
 	syncCompletionAnnotationsPath = field.NewPath("metadata", "annotations").Key(JobCompletionsEqualParallelismAnnotation)
+	shrinkMinParallelismPath      = field.NewPath("metadata", "annotations").Key(JobShrinkMinParallelismAnnotation)
 	replicaMetaPath               = field.NewPath("spec", "template", "metadata")
 )
 
@@ -102,7 +104,13 @@ func (w *JobWebhook) Default(ctx context.Context, obj runtime.Object) error {
 	log := ctrl.LoggerFrom(ctx).WithName("job-webhook")
 	log.V(5).Info("Applying defaults")
 
+	if err := jobframework.ApplyDefaultQueueFromOwner(ctx, job.Object(), w.client); err != nil {
+		return err
+	}
 	jobframework.ApplyDefaultLocalQueue(job.Object(), w.queues.DefaultLocalQueueExist)
+	if err := utilwebhook.ApplyDefaultSubmitter(ctx, job.Object()); err != nil {
+		return err
+	}
 	if err := jobframework.ApplyDefaultForSuspend(ctx, job, w.client, w.manageJobsWithoutQueueName, w.managedJobsNamespaceSelector); err != nil {
 		return err
 	}
@@ -126,6 +134,7 @@ func (w *JobWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (ad
 	if err != nil {
 		return nil, err
 	}
+	validationErrs = append(validationErrs, jobframework.ValidateBillingNamespace(ctx, w.client, job.Object())...)
 	return nil, validationErrs.ToAggregate()
 }
 
@@ -134,6 +143,7 @@ func (w *JobWebhook) validateCreate(job *Job) (field.ErrorList, error) {
 	allErrs = append(allErrs, jobframework.ValidateJobOnCreate(job)...)
 	allErrs = append(allErrs, w.validatePartialAdmissionCreate(job)...)
 	allErrs = append(allErrs, w.validateSyncCompletionCreate(job)...)
+	allErrs = append(allErrs, validateShrinkMinParallelism(job)...)
 	if features.Enabled(features.TopologyAwareScheduling) {
 		validationErrs, err := w.validateTopologyRequest(job)
 		if err != nil {
@@ -176,6 +186,26 @@ func (w *JobWebhook) validateSyncCompletionCreate(job *Job) field.ErrorList {
 	return allErrs
 }
 
+func validateShrinkMinParallelism(job *Job) field.ErrorList {
+	var allErrs field.ErrorList
+	strVal, found := job.Annotations[JobShrinkMinParallelismAnnotation]
+	if !found {
+		return nil
+	}
+	if v, err := strconv.Atoi(strVal); err != nil {
+		allErrs = append(allErrs, field.Invalid(shrinkMinParallelismPath, strVal, err.Error()))
+	} else if v <= 0 {
+		allErrs = append(allErrs, field.Invalid(shrinkMinParallelismPath, v, "should be greater than 0"))
+	}
+	if _, found := job.Annotations[JobMinParallelismAnnotation]; found {
+		allErrs = append(allErrs, field.Forbidden(shrinkMinParallelismPath, fmt.Sprintf("cannot be set together with %s", JobMinParallelismAnnotation)))
+	}
+	if job.syncCompletionWithParallelism() {
+		allErrs = append(allErrs, field.Forbidden(shrinkMinParallelismPath, fmt.Sprintf("cannot be set together with %s, shrinking does not change the completions", JobCompletionsEqualParallelismAnnotation)))
+	}
+	return allErrs
+}
+
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
 func (w *JobWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
 	oldJob := fromObject(oldObj)
@@ -196,6 +226,7 @@ func (w *JobWebhook) validateUpdate(oldJob, newJob *Job) (field.ErrorList, error
 		allErrs = append(allErrs, w.validatePartialAdmissionCreate(newJob)...)
 	}
 	allErrs = append(allErrs, w.validateSyncCompletionCreate(newJob)...)
+	allErrs = append(allErrs, validateShrinkMinParallelism(newJob)...)
 	allErrs = append(allErrs, jobframework.ValidateJobOnUpdate(oldJob, newJob, w.queues.DefaultLocalQueueExist)...)
 	allErrs = append(allErrs, validatePartialAdmissionUpdate(oldJob, newJob)...)
 	if features.Enabled(features.TopologyAwareScheduling) {
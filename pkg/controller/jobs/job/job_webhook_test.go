@@ -117,6 +117,51 @@ func TestValidateCreate(t *testing.T) {
 				Obj(),
 			wantValidationErrs: nil,
 		},
+		{
+			name: "valid shrink min parallelism annotation",
+			job: testingutil.MakeJob("job", "default").
+				Parallelism(4).
+				Completions(6).
+				SetAnnotation(JobShrinkMinParallelismAnnotation, "2").
+				Obj(),
+			wantValidationErrs: nil,
+		},
+		{
+			name: "invalid shrink min parallelism annotation (badValue)",
+			job: testingutil.MakeJob("job", "default").
+				Parallelism(4).
+				Completions(6).
+				SetAnnotation(JobShrinkMinParallelismAnnotation, "0").
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Invalid(shrinkMinParallelismPath, 0, "should be greater than 0"),
+			},
+		},
+		{
+			name: "shrink min parallelism annotation with partial admission",
+			job: testingutil.MakeJob("job", "default").
+				Parallelism(4).
+				Completions(6).
+				SetAnnotation(JobMinParallelismAnnotation, "3").
+				SetAnnotation(JobShrinkMinParallelismAnnotation, "2").
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Forbidden(shrinkMinParallelismPath, "cannot be set together with kueue.x-k8s.io/job-min-parallelism"),
+			},
+		},
+		{
+			name: "shrink min parallelism annotation with sync completions",
+			job: testingutil.MakeJob("job", "default").
+				Parallelism(4).
+				Completions(4).
+				SetAnnotation(JobCompletionsEqualParallelismAnnotation, "true").
+				SetAnnotation(JobShrinkMinParallelismAnnotation, "2").
+				Indexed(true).
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Forbidden(shrinkMinParallelismPath, "cannot be set together with kueue.x-k8s.io/job-completions-equal-parallelism, shrinking does not change the completions"),
+			},
+		},
 		{
 			name: "invalid sync completions annotation (format)",
 			job: testingutil.MakeJob("job", "default").
@@ -351,6 +396,74 @@ func TestValidateCreate(t *testing.T) {
 			},
 			topologyAwareScheduling: true,
 		},
+		{
+			name: "valid topology request - exclusive topology",
+			job: testingutil.MakeJob("job", "default").
+				PodAnnotation(kueue.PodSetRequiredTopologyAnnotation, "cloud.com/block").
+				PodAnnotation(kueue.PodSetExclusiveTopologyAnnotation, "cloud.com/rack").
+				Obj(),
+			wantValidationErrs:      nil,
+			topologyAwareScheduling: true,
+		},
+		{
+			name: "invalid topology request - exclusive topology without topology request",
+			job: testingutil.MakeJob("job", "default").
+				PodAnnotation(kueue.PodSetExclusiveTopologyAnnotation, "cloud.com/rack").
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Forbidden(replicaMetaPath.Child("annotations").Key("kueue.x-k8s.io/podset-exclusive-topology"),
+					`cannot be set when none of ["kueue.x-k8s.io/podset-required-topology", "kueue.x-k8s.io/podset-preferred-topology", "kueue.x-k8s.io/podset-unconstrained-topology"] is present`),
+			},
+			topologyAwareScheduling: true,
+		},
+		{
+			name: "valid topology request - balanced topology",
+			job: testingutil.MakeJob("job", "default").
+				PodAnnotation(kueue.PodSetRequiredTopologyAnnotation, "cloud.com/block").
+				PodAnnotation(kueue.PodSetBalancedTopologyAnnotation, "cloud.com/rack").
+				PodAnnotation(kueue.PodSetBalancedDomainCountAnnotation, "4").
+				Obj(),
+			wantValidationErrs:      nil,
+			topologyAwareScheduling: true,
+		},
+		{
+			name: "invalid topology request - balanced topology without domain count",
+			job: testingutil.MakeJob("job", "default").
+				PodAnnotation(kueue.PodSetRequiredTopologyAnnotation, "cloud.com/block").
+				PodAnnotation(kueue.PodSetBalancedTopologyAnnotation, "cloud.com/rack").
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Required(replicaMetaPath.Child("annotations").Key("kueue.x-k8s.io/podset-balanced-domain-count"), "balanced domain count is required if balanced topology is requested"),
+			},
+			topologyAwareScheduling: true,
+		},
+		{
+			name: "invalid topology request - balanced domain count is zero",
+			job: testingutil.MakeJob("job", "default").
+				PodAnnotation(kueue.PodSetRequiredTopologyAnnotation, "cloud.com/block").
+				PodAnnotation(kueue.PodSetBalancedTopologyAnnotation, "cloud.com/rack").
+				PodAnnotation(kueue.PodSetBalancedDomainCountAnnotation, "0").
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Invalid(replicaMetaPath.Child("annotations").Key("kueue.x-k8s.io/podset-balanced-domain-count"), "0", "must be greater than or equal to 1"),
+			},
+			topologyAwareScheduling: true,
+		},
+		{
+			name: "invalid topology request - balanced topology with slice topology",
+			job: testingutil.MakeJob("job", "default").
+				PodAnnotation(kueue.PodSetRequiredTopologyAnnotation, "cloud.com/block").
+				PodAnnotation(kueue.PodSetSliceRequiredTopologyAnnotation, "cloud.com/block").
+				PodAnnotation(kueue.PodSetSliceSizeAnnotation, "1").
+				PodAnnotation(kueue.PodSetBalancedTopologyAnnotation, "cloud.com/rack").
+				PodAnnotation(kueue.PodSetBalancedDomainCountAnnotation, "4").
+				Obj(),
+			wantValidationErrs: field.ErrorList{
+				field.Forbidden(replicaMetaPath.Child("annotations").Key("kueue.x-k8s.io/podset-balanced-topology"),
+					`cannot be set along with ["kueue.x-k8s.io/podset-slice-required-topology", "kueue.x-k8s.io/podset-slice-size"]`),
+			},
+			topologyAwareScheduling: true,
+		},
 		{
 			name: "invalid topology request - slice size provided without slice topology",
 			job: testingutil.MakeJob("job", "default").
@@ -19,6 +19,7 @@ package jobset
 import (
 	"context"
 	"fmt"
+	goslices "slices"
 
 	batchv1 "k8s.io/api/batch/v1"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
@@ -36,6 +37,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/podset"
 	"sigs.k8s.io/kueue/pkg/util/slices"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 )
 
 var (
@@ -120,7 +122,7 @@ func (j *JobSet) PodSets() ([]kueue.PodSet, error) {
 			Count:    podsCount(&replicatedJob),
 		}
 		if features.Enabled(features.TopologyAwareScheduling) {
-			topologyRequest, err := jobframework.NewPodSetTopologyRequest(
+			topologyRequest, err := utiltas.NewPodSetTopologyRequest(
 				&replicatedJob.Template.Spec.Template.ObjectMeta).PodIndexLabel(
 				ptr.To(batchv1.JobCompletionIndexAnnotation)).SubGroup(
 				ptr.To(jobsetapi.JobIndexKey),
@@ -194,21 +196,64 @@ func (j *JobSet) ReclaimablePods() ([]kueue.ReclaimablePod, error) {
 
 	ret := make([]kueue.ReclaimablePod, 0, len(j.Spec.ReplicatedJobs))
 	statuses := slices.ToRefMap(j.Status.ReplicatedJobsStatus, func(js *jobsetapi.ReplicatedJobStatus) string { return js.Name })
+	policySatisfied := j.successPolicySatisfied(statuses)
 
 	for i := range j.Spec.ReplicatedJobs {
 		spec := &j.Spec.ReplicatedJobs[i]
-		if status, found := statuses[spec.Name]; found && status.Succeeded > 0 {
-			if status.Succeeded > 0 && status.Succeeded <= spec.Replicas {
-				ret = append(ret, kueue.ReclaimablePod{
-					Name:  kueue.NewPodSetReference(spec.Name),
-					Count: status.Succeeded * podsCountPerReplica(spec),
-				})
+		succeeded := spec.Replicas
+		if !policySatisfied {
+			// The pods of the succeeded Jobs are reclaimable, so that the quota
+			// of a finished replicatedJob is released before the whole JobSet
+			// finishes.
+			status, found := statuses[spec.Name]
+			if !found || status.Succeeded == 0 {
+				continue
 			}
+			succeeded = min(status.Succeeded, spec.Replicas)
 		}
+		ret = append(ret, kueue.ReclaimablePod{
+			Name:  kueue.NewPodSetReference(spec.Name),
+			Count: succeeded * podsCountPerReplica(spec),
+		})
 	}
 	return ret, nil
 }
 
+// successPolicySatisfied returns whether the success policy of the JobSet is
+// satisfied, in which case the Jobs that are still running are no longer
+// needed and the pods of all the replicatedJobs are reclaimable.
+func (j *JobSet) successPolicySatisfied(statuses map[string]*jobsetapi.ReplicatedJobStatus) bool {
+	operator := jobsetapi.OperatorAll
+	var targets []string
+	if policy := j.Spec.SuccessPolicy; policy != nil {
+		operator = policy.Operator
+		targets = policy.TargetReplicatedJobs
+	}
+	matched := false
+	for i := range j.Spec.ReplicatedJobs {
+		spec := &j.Spec.ReplicatedJobs[i]
+		if len(targets) > 0 && !goslices.Contains(targets, spec.Name) {
+			continue
+		}
+		var succeeded int32
+		if status, found := statuses[spec.Name]; found {
+			succeeded = status.Succeeded
+		}
+		switch operator {
+		case jobsetapi.OperatorAny:
+			if succeeded > 0 {
+				return true
+			}
+		default:
+			if succeeded < spec.Replicas {
+				return false
+			}
+			matched = true
+		}
+	}
+	return matched
+}
+
 func (j *JobSet) CanDefaultManagedBy() bool {
 	jobSpecManagedBy := j.Spec.ManagedBy
 	return features.Enabled(features.MultiKueue) &&
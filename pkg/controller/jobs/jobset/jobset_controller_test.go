@@ -162,6 +162,99 @@ func TestReclaimablePods(t *testing.T) {
 				Count: 3,
 			}},
 		},
+		"replicated job done while another one is running": {
+			jobSet: baseWrapper.Clone().JobsStatus(
+				jobset.ReplicatedJobStatus{
+					Name:   "replicated-job-1",
+					Active: 1,
+				},
+				jobset.ReplicatedJobStatus{
+					Name:      "replicated-job-2",
+					Succeeded: 2,
+				},
+			).Obj(),
+			want: []kueue.ReclaimablePod{{
+				Name:  "replicated-job-2",
+				Count: 6,
+			}},
+		},
+		"more succeeded jobs than replicas": {
+			jobSet: baseWrapper.Clone().JobsStatus(jobset.ReplicatedJobStatus{
+				Name:      "replicated-job-2",
+				Succeeded: 3,
+			}).Obj(),
+			want: []kueue.ReclaimablePod{{
+				Name:  "replicated-job-2",
+				Count: 6,
+			}},
+		},
+		"success policy with the Any operator satisfied": {
+			jobSet: baseWrapper.Clone().
+				SuccessPolicy(jobset.OperatorAny, "replicated-job-2").
+				JobsStatus(
+					jobset.ReplicatedJobStatus{
+						Name:   "replicated-job-1",
+						Active: 1,
+					},
+					jobset.ReplicatedJobStatus{
+						Name:      "replicated-job-2",
+						Active:    1,
+						Succeeded: 1,
+					},
+				).Obj(),
+			want: []kueue.ReclaimablePod{
+				{
+					Name:  "replicated-job-1",
+					Count: 2,
+				},
+				{
+					Name:  "replicated-job-2",
+					Count: 6,
+				},
+			},
+		},
+		"success policy with the Any operator not satisfied": {
+			jobSet: baseWrapper.Clone().
+				SuccessPolicy(jobset.OperatorAny, "replicated-job-2").
+				JobsStatus(
+					jobset.ReplicatedJobStatus{
+						Name:      "replicated-job-1",
+						Succeeded: 1,
+					},
+					jobset.ReplicatedJobStatus{
+						Name:   "replicated-job-2",
+						Active: 2,
+					},
+				).Obj(),
+			want: []kueue.ReclaimablePod{{
+				Name:  "replicated-job-1",
+				Count: 2,
+			}},
+		},
+		"success policy with target replicated jobs satisfied": {
+			jobSet: baseWrapper.Clone().
+				SuccessPolicy(jobset.OperatorAll, "replicated-job-1").
+				JobsStatus(
+					jobset.ReplicatedJobStatus{
+						Name:      "replicated-job-1",
+						Succeeded: 1,
+					},
+					jobset.ReplicatedJobStatus{
+						Name:   "replicated-job-2",
+						Active: 2,
+					},
+				).Obj(),
+			want: []kueue.ReclaimablePod{
+				{
+					Name:  "replicated-job-1",
+					Count: 2,
+				},
+				{
+					Name:  "replicated-job-2",
+					Count: 6,
+				},
+			},
+		},
 		"all done": {
 			jobSet: baseWrapper.Clone().JobsStatus(
 				jobset.ReplicatedJobStatus{
@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/controller/jobframework/webhook"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/podset"
+	utilwebhook "sigs.k8s.io/kueue/pkg/util/webhook"
 )
 
 var (
@@ -77,6 +78,9 @@ func (w *JobSetWebhook) Default(ctx context.Context, obj runtime.Object) error {
 	log.V(5).Info("Applying defaults")
 
 	jobframework.ApplyDefaultLocalQueue(jobSet.Object(), w.queues.DefaultLocalQueueExist)
+	if err := utilwebhook.ApplyDefaultSubmitter(ctx, jobSet.Object()); err != nil {
+		return err
+	}
 	if err := jobframework.ApplyDefaultForSuspend(ctx, jobSet, w.client, w.manageJobsWithoutQueueName, w.managedJobsNamespaceSelector); err != nil {
 		return err
 	}
@@ -99,6 +103,7 @@ func (w *JobSetWebhook) ValidateCreate(ctx context.Context, obj runtime.Object)
 	if err != nil {
 		return nil, err
 	}
+	validationErrs = append(validationErrs, jobframework.ValidateBillingNamespace(ctx, w.client, jobSet.Object())...)
 	return nil, validationErrs.ToAggregate()
 }
 
@@ -129,6 +134,12 @@ func (w *JobSetWebhook) validateUpdate(oldJob, newJob *JobSet) (field.ErrorList,
 func (w *JobSetWebhook) validateCreate(jobSet *JobSet) (field.ErrorList, error) {
 	var allErrs field.ErrorList
 	allErrs = append(allErrs, jobframework.ValidateJobOnCreate(jobSet)...)
+	if features.Enabled(features.PodSetFlavorSelection) {
+		for i := range jobSet.Spec.ReplicatedJobs {
+			podTemplateMetaPath := replicatedJobsPath.Index(i).Child("template", "spec", "template", "metadata")
+			allErrs = append(allErrs, jobframework.ValidatePodSetFlavorSelection(podTemplateMetaPath, &jobSet.Spec.ReplicatedJobs[i].Template.Spec.Template.ObjectMeta)...)
+		}
+	}
 	if features.Enabled(features.TopologyAwareScheduling) {
 		validationErrs, err := w.validateTopologyRequest(jobSet)
 		if err != nil {
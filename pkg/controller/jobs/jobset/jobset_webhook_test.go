@@ -51,6 +51,7 @@ func TestValidateCreate(t *testing.T) {
 		job                     *jobset.JobSet
 		wantErr                 error
 		topologyAwareScheduling bool
+		podSetFlavorSelection   bool
 	}{
 		{
 			name:    "simple",
@@ -118,11 +119,47 @@ func TestValidateCreate(t *testing.T) {
 			}.ToAggregate(),
 			topologyAwareScheduling: true,
 		},
+		{
+			name: "valid podset flavor selection",
+			job: testingutil.MakeJobSet("job", "default").ReplicatedJobs(testingutil.ReplicatedJobRequirements{
+				Name: "driver",
+				PodAnnotations: map[string]string{
+					constants.PodSetFlavorsAnnotation: "on-demand",
+				},
+			}, testingutil.ReplicatedJobRequirements{
+				Name: "worker",
+				PodAnnotations: map[string]string{
+					constants.PodSetFlavorsAnnotation:        "spot,on-demand",
+					constants.PodSetWhenCanPreemptAnnotation: "Preempt",
+				},
+			}).Obj(),
+			podSetFlavorSelection: true,
+		},
+		{
+			name: "invalid podset flavor selection",
+			job: testingutil.MakeJobSet("job", "default").ReplicatedJobs(testingutil.ReplicatedJobRequirements{
+				Name: "driver",
+				PodAnnotations: map[string]string{
+					constants.PodSetFlavorsAnnotation: "on-demand",
+				},
+			}, testingutil.ReplicatedJobRequirements{
+				Name: "worker",
+				PodAnnotations: map[string]string{
+					constants.PodSetWhenCanBorrowAnnotation: "Preempt",
+				},
+			}).Obj(),
+			wantErr: field.ErrorList{
+				field.NotSupported(field.NewPath("spec", "replicatedJobs").Index(1).Child("template", "spec", "template", "metadata", "annotations").
+					Key(constants.PodSetWhenCanBorrowAnnotation), "Preempt", []string{"Borrow", "TryNextFlavor"}),
+			}.ToAggregate(),
+			podSetFlavorSelection: true,
+		},
 	}
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
 			features.SetFeatureGateDuringTest(t, features.TopologyAwareScheduling, tc.topologyAwareScheduling)
+			features.SetFeatureGateDuringTest(t, features.PodSetFlavorSelection, tc.podSetFlavorSelection)
 
 			jsw := &JobSetWebhook{}
 			ctx, _ := utiltesting.ContextWithLog(t)
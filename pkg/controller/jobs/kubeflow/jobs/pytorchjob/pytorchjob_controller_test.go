@@ -339,6 +339,72 @@ func TestPodSets(t *testing.T) {
 	}
 }
 
+func TestReclaimablePods(t *testing.T) {
+	baseWrapper := testingpytorchjob.MakePyTorchJob("pytorchjob", "ns").
+		PyTorchReplicaSpecs(
+			testingpytorchjob.PyTorchReplicaSpecRequirement{
+				ReplicaType:  kftraining.PyTorchJobReplicaTypeMaster,
+				ReplicaCount: 1,
+			},
+			testingpytorchjob.PyTorchReplicaSpecRequirement{
+				ReplicaType:  kftraining.PyTorchJobReplicaTypeWorker,
+				ReplicaCount: 3,
+			},
+		)
+
+	testCases := map[string]struct {
+		job  *kftraining.PyTorchJob
+		want []kueue.ReclaimablePod
+	}{
+		"no status": {
+			job: baseWrapper.Clone().Obj(),
+		},
+		"running replicas": {
+			job: baseWrapper.Clone().
+				ReplicaStatus(kftraining.PyTorchJobReplicaTypeMaster, kftraining.ReplicaStatus{Active: 1}).
+				ReplicaStatus(kftraining.PyTorchJobReplicaTypeWorker, kftraining.ReplicaStatus{Active: 3}).
+				Obj(),
+		},
+		"some workers succeeded": {
+			job: baseWrapper.Clone().
+				ReplicaStatus(kftraining.PyTorchJobReplicaTypeMaster, kftraining.ReplicaStatus{Active: 1}).
+				ReplicaStatus(kftraining.PyTorchJobReplicaTypeWorker, kftraining.ReplicaStatus{Active: 1, Succeeded: 2}).
+				Obj(),
+			want: []kueue.ReclaimablePod{{
+				Name:  kueue.NewPodSetReference(string(kftraining.PyTorchJobReplicaTypeWorker)),
+				Count: 2,
+			}},
+		},
+		"more succeeded pods than replicas": {
+			job: baseWrapper.Clone().
+				ReplicaStatus(kftraining.PyTorchJobReplicaTypeMaster, kftraining.ReplicaStatus{Succeeded: 1}).
+				ReplicaStatus(kftraining.PyTorchJobReplicaTypeWorker, kftraining.ReplicaStatus{Succeeded: 4}).
+				Obj(),
+			want: []kueue.ReclaimablePod{
+				{
+					Name:  kueue.NewPodSetReference(string(kftraining.PyTorchJobReplicaTypeMaster)),
+					Count: 1,
+				},
+				{
+					Name:  kueue.NewPodSetReference(string(kftraining.PyTorchJobReplicaTypeWorker)),
+					Count: 3,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := fromObject(tc.job).ReclaimablePods()
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected reclaimable pods (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestValidate(t *testing.T) {
 	testCases := map[string]struct {
 		job                     *kftraining.PyTorchJob
@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/podset"
 	utilpodset "sigs.k8s.io/kueue/pkg/util/podset"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 )
 
 type KubeflowJob struct {
@@ -39,6 +40,7 @@ var _ jobframework.GenericJob = (*KubeflowJob)(nil)
 var _ jobframework.JobWithPriorityClass = (*KubeflowJob)(nil)
 var _ jobframework.JobWithCustomValidation = (*KubeflowJob)(nil)
 var _ jobframework.JobWithManagedBy = (*KubeflowJob)(nil)
+var _ jobframework.JobWithReclaimablePods = (*KubeflowJob)(nil)
 
 func (j *KubeflowJob) Object() client.Object {
 	return j.KFJobControl.Object()
@@ -107,7 +109,7 @@ func (j *KubeflowJob) PodSets() ([]kueue.PodSet, error) {
 			Count:    podsCount(j.KFJobControl.ReplicaSpecs(), replicaType),
 		}
 		if features.Enabled(features.TopologyAwareScheduling) {
-			topologyRequest, err := jobframework.NewPodSetTopologyRequest(
+			topologyRequest, err := utiltas.NewPodSetTopologyRequest(
 				&j.KFJobControl.ReplicaSpecs()[replicaType].Template.ObjectMeta).PodIndexLabel(
 				ptr.To(kftraining.ReplicaIndexLabel)).Build()
 			if err != nil {
@@ -119,6 +121,28 @@ func (j *KubeflowJob) PodSets() ([]kueue.PodSet, error) {
 	return podSets, nil
 }
 
+func (j *KubeflowJob) ReclaimablePods() ([]kueue.ReclaimablePod, error) {
+	statuses := j.KFJobControl.JobStatus().ReplicaStatuses
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	var ret []kueue.ReclaimablePod
+	for _, replicaType := range j.OrderedReplicaTypes() {
+		status, found := statuses[replicaType]
+		if !found || status == nil || status.Succeeded == 0 {
+			continue
+		}
+		// The succeeded pods of a replica type are reclaimable, so that the
+		// quota of the finished workers is released before the whole job finishes.
+		ret = append(ret, kueue.ReclaimablePod{
+			Name:  kueue.NewPodSetReference(string(replicaType)),
+			Count: min(status.Succeeded, podsCount(j.KFJobControl.ReplicaSpecs(), replicaType)),
+		})
+	}
+	return ret, nil
+}
+
 func (j *KubeflowJob) IsActive() bool {
 	for _, replicaStatus := range j.KFJobControl.JobStatus().ReplicaStatuses {
 		if replicaStatus.Active != 0 {
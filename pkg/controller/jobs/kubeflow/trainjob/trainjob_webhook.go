@@ -32,6 +32,7 @@ import (
 	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework/webhook"
+	utilwebhook "sigs.k8s.io/kueue/pkg/util/webhook"
 )
 
 type TrainJobWebhook struct {
@@ -71,6 +72,9 @@ func (w *TrainJobWebhook) Default(ctx context.Context, obj runtime.Object) error
 	log.V(5).Info("Applying defaults")
 
 	jobframework.ApplyDefaultLocalQueue(trainJob.Object(), w.queues.DefaultLocalQueueExist)
+	if err := utilwebhook.ApplyDefaultSubmitter(ctx, trainJob.Object()); err != nil {
+		return err
+	}
 	jobframework.ApplyDefaultForManagedBy(trainJob, w.queues, w.cache, log)
 	suspend, err := jobframework.WorkloadShouldBeSuspended(ctx, trainJob.Object(), w.client, w.manageJobsWithoutQueueName, w.managedJobsNamespaceSelector)
 	if err != nil {
@@ -97,7 +101,9 @@ func (w *TrainJobWebhook) ValidateCreate(ctx context.Context, obj runtime.Object
 	trainjob := fromObject(obj)
 	log := ctrl.LoggerFrom(ctx).WithName("trainjob-webhook")
 	log.Info("Validating create")
-	return nil, w.validateCreate(trainjob).ToAggregate()
+	allErrs := w.validateCreate(trainjob)
+	allErrs = append(allErrs, jobframework.ValidateBillingNamespace(ctx, w.client, trainjob.Object())...)
+	return nil, allErrs.ToAggregate()
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
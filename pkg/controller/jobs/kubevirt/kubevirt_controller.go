@@ -0,0 +1,71 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kubevirt integrates the VirtualMachineInstances and the
+// VirtualMachineInstanceReplicaSets of KubeVirt. Like for the Deployments,
+// Kueue manages the virt-launcher pods of the VirtualMachineInstances, which
+// request the CPU and memory of the guests with the virtualization overhead,
+// and the GPUs and host devices passed through to the guests.
+package kubevirt
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	utilunstructured "sigs.k8s.io/kueue/pkg/util/unstructured"
+)
+
+var (
+	vmiGVK   = schema.GroupVersionKind{Group: "kubevirt.io", Version: "v1", Kind: "VirtualMachineInstance"}
+	vmirsGVK = schema.GroupVersionKind{Group: "kubevirt.io", Version: "v1", Kind: "VirtualMachineInstanceReplicaSet"}
+)
+
+const (
+	VirtualMachineInstanceFrameworkName           = "kubevirt.io/virtualmachineinstance"
+	VirtualMachineInstanceReplicaSetFrameworkName = "kubevirt.io/virtualmachineinstancereplicaset"
+)
+
+func init() {
+	utilruntime.Must(jobframework.RegisterIntegration(VirtualMachineInstanceFrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:   SetupIndexes,
+		NewReconciler:  jobframework.NewNoopReconcilerFactory(vmiGVK),
+		GVK:            vmiGVK,
+		SetupWebhook:   SetupVirtualMachineInstanceWebhook,
+		JobType:        utilunstructured.NewObject(vmiGVK),
+		AddToScheme:    utilunstructured.AddToSchemeFunc(vmiGVK),
+		DependencyList: []string{"pod"},
+	}))
+	utilruntime.Must(jobframework.RegisterIntegration(VirtualMachineInstanceReplicaSetFrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:   SetupIndexes,
+		NewReconciler:  jobframework.NewNoopReconcilerFactory(vmirsGVK),
+		GVK:            vmirsGVK,
+		SetupWebhook:   SetupVirtualMachineInstanceReplicaSetWebhook,
+		JobType:        utilunstructured.NewObject(vmirsGVK),
+		AddToScheme:    utilunstructured.AddToSchemeFunc(vmirsGVK),
+		DependencyList: []string{"pod"},
+	}))
+}
+
+// +kubebuilder:rbac:groups=kubevirt.io,resources=virtualmachineinstances,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kubevirt.io,resources=virtualmachineinstancereplicasets,verbs=get;list;watch
+
+func SetupIndexes(context.Context, client.FieldIndexer) error {
+	return nil
+}
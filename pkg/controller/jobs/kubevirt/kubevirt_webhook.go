@@ -0,0 +1,209 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubevirt
+
+import (
+	"context"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/constants"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework/webhook"
+	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
+	utilunstructured "sigs.k8s.io/kueue/pkg/util/unstructured"
+	utilwebhook "sigs.k8s.io/kueue/pkg/util/webhook"
+)
+
+// kind describes how the metadata of a KubeVirt kind is propagated to the
+// virt-launcher pods.
+type kind struct {
+	gvk           schema.GroupVersionKind
+	frameworkName string
+	// podMetadataPath is the path of the metadata copied by KubeVirt to the
+	// virt-launcher pods, the metadata of the VirtualMachineInstances or of
+	// their template.
+	podMetadataPath []string
+	// isSuspended returns whether none of the virt-launcher pods is running,
+	// allowing to change the queue of the object.
+	isSuspended func(obj *unstructured.Unstructured) bool
+}
+
+var (
+	vmiKind = kind{
+		gvk:             vmiGVK,
+		frameworkName:   VirtualMachineInstanceFrameworkName,
+		podMetadataPath: []string{"metadata"},
+		// The virt-launcher pod is created with the VirtualMachineInstance.
+		isSuspended: func(*unstructured.Unstructured) bool { return false },
+	}
+	vmirsKind = kind{
+		gvk:             vmirsGVK,
+		frameworkName:   VirtualMachineInstanceReplicaSetFrameworkName,
+		podMetadataPath: []string{"spec", "template", "metadata"},
+		isSuspended: func(obj *unstructured.Unstructured) bool {
+			readyReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+			return readyReplicas == 0
+		},
+	}
+)
+
+type Webhook struct {
+	kind                         kind
+	client                       client.Client
+	manageJobsWithoutQueueName   bool
+	managedJobsNamespaceSelector labels.Selector
+	queues                       *qcache.Manager
+}
+
+func SetupVirtualMachineInstanceWebhook(mgr ctrl.Manager, opts ...jobframework.Option) error {
+	return setupWebhook(mgr, vmiKind, opts...)
+}
+
+func SetupVirtualMachineInstanceReplicaSetWebhook(mgr ctrl.Manager, opts ...jobframework.Option) error {
+	return setupWebhook(mgr, vmirsKind, opts...)
+}
+
+func setupWebhook(mgr ctrl.Manager, k kind, opts ...jobframework.Option) error {
+	options := jobframework.ProcessOptions(opts...)
+	wh := &Webhook{
+		kind:                         k,
+		client:                       mgr.GetClient(),
+		manageJobsWithoutQueueName:   options.ManageJobsWithoutQueueName,
+		managedJobsNamespaceSelector: options.ManagedJobsNamespaceSelector,
+		queues:                       options.Queues,
+	}
+	obj := utilunstructured.NewObject(k.gvk)
+	return webhook.WebhookManagedBy(mgr).
+		For(obj).
+		WithMutationHandler(admission.WithCustomDefaulter(mgr.GetScheme(), obj, wh)).
+		WithValidator(wh).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-kubevirt-io-v1-virtualmachineinstance,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubevirt.io,resources=virtualmachineinstances,verbs=create,versions=v1,name=mvirtualmachineinstance.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-kubevirt-io-v1-virtualmachineinstancereplicaset,mutating=true,failurePolicy=fail,sideEffects=None,groups=kubevirt.io,resources=virtualmachineinstancereplicasets,verbs=create;update,versions=v1,name=mvirtualmachineinstancereplicaset.kb.io,admissionReviewVersions=v1
+
+var _ admission.CustomDefaulter = &Webhook{}
+
+// Default marks the virt-launcher pods to be suspended by Kueue, with the
+// queue and the priority class of the object, which the pod integration
+// then manages like the pods of the Deployments.
+func (wh *Webhook) Default(ctx context.Context, obj runtime.Object) error {
+	u := obj.(*unstructured.Unstructured)
+
+	log := ctrl.LoggerFrom(ctx).WithName("kubevirt-webhook")
+	log.V(5).Info("Propagating queue-name", "kind", wh.kind.gvk.Kind)
+
+	jobframework.ApplyDefaultLocalQueue(u, wh.queues.DefaultLocalQueueExist)
+	if err := utilwebhook.ApplyDefaultSubmitter(ctx, u); err != nil {
+		return err
+	}
+	suspend, err := jobframework.WorkloadShouldBeSuspended(ctx, u, wh.client, wh.manageJobsWithoutQueueName, wh.managedJobsNamespaceSelector)
+	if err != nil || !suspend {
+		return err
+	}
+
+	podAnnotations, _, err := unstructured.NestedStringMap(u.Object, append(wh.kind.podMetadataPath, "annotations")...)
+	if err != nil {
+		return err
+	}
+	if podAnnotations == nil {
+		podAnnotations = make(map[string]string, 1)
+	}
+	podAnnotations[podconstants.SuspendedByParentAnnotation] = wh.kind.frameworkName
+
+	podLabels, _, err := unstructured.NestedStringMap(u.Object, append(wh.kind.podMetadataPath, "labels")...)
+	if err != nil {
+		return err
+	}
+	if podLabels == nil {
+		podLabels = make(map[string]string, 1)
+	}
+	podLabels[constants.ManagedByKueueLabelKey] = constants.ManagedByKueueLabelValue
+	if queueName := jobframework.QueueNameForObject(u); queueName != "" {
+		podLabels[controllerconstants.QueueLabel] = string(queueName)
+	}
+	if priorityClass := jobframework.WorkloadPriorityClassName(u); priorityClass != "" {
+		podLabels[controllerconstants.WorkloadPriorityClassLabel] = priorityClass
+	}
+
+	if err := unstructured.SetNestedStringMap(u.Object, podAnnotations, append(wh.kind.podMetadataPath, "annotations")...); err != nil {
+		return err
+	}
+	return unstructured.SetNestedStringMap(u.Object, podLabels, append(wh.kind.podMetadataPath, "labels")...)
+}
+
+// +kubebuilder:webhook:path=/validate-kubevirt-io-v1-virtualmachineinstance,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubevirt.io,resources=virtualmachineinstances,verbs=create;update,versions=v1,name=vvirtualmachineinstance.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-kubevirt-io-v1-virtualmachineinstancereplicaset,mutating=false,failurePolicy=fail,sideEffects=None,groups=kubevirt.io,resources=virtualmachineinstancereplicasets,verbs=create;update,versions=v1,name=vvirtualmachineinstancereplicaset.kb.io,admissionReviewVersions=v1
+
+var _ admission.CustomValidator = &Webhook{}
+
+func (wh *Webhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warnings admission.Warnings, err error) {
+	u := obj.(*unstructured.Unstructured)
+
+	log := ctrl.LoggerFrom(ctx).WithName("kubevirt-webhook")
+	log.V(5).Info("Validating create", "kind", wh.kind.gvk.Kind)
+
+	allErrs := jobframework.ValidateQueueName(u)
+	allErrs = append(allErrs, jobframework.ValidateBillingNamespace(ctx, wh.client, u)...)
+
+	return nil, allErrs.ToAggregate()
+}
+
+var (
+	labelsPath         = field.NewPath("metadata", "labels")
+	queueNameLabelPath = labelsPath.Key(controllerconstants.QueueLabel)
+)
+
+func (wh *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (warnings admission.Warnings, err error) {
+	oldU := oldObj.(*unstructured.Unstructured)
+	newU := newObj.(*unstructured.Unstructured)
+
+	log := ctrl.LoggerFrom(ctx).WithName("kubevirt-webhook")
+	log.V(5).Info("Validating update", "kind", wh.kind.gvk.Kind)
+
+	oldQueueName := jobframework.QueueNameForObject(oldU)
+	newQueueName := jobframework.QueueNameForObject(newU)
+
+	allErrs := jobframework.ValidateQueueName(newU)
+
+	// Prevents updating the queue-name if at least one virt-launcher pod may
+	// be running or if the queue-name has been deleted.
+	isSuspended := wh.kind.isSuspended(oldU)
+	if !isSuspended || newQueueName == "" {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newQueueName, oldQueueName, queueNameLabelPath)...)
+	}
+	if !isSuspended || jobframework.IsWorkloadPriorityClassNameEmpty(newU) {
+		allErrs = append(allErrs, jobframework.ValidateUpdateForWorkloadPriorityClassName(oldU, newU)...)
+	}
+	return warnings, allErrs.ToAggregate()
+}
+
+func (wh *Webhook) ValidateDelete(context.Context, runtime.Object) (warnings admission.Warnings, err error) {
+	return nil, nil
+}
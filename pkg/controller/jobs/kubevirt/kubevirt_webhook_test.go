@@ -0,0 +1,161 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubevirt
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingkubevirt "sigs.k8s.io/kueue/pkg/util/testingjobs/kubevirt"
+)
+
+func TestDefault(t *testing.T) {
+	testCases := map[string]struct {
+		kind kind
+		obj  *unstructured.Unstructured
+		want *unstructured.Unstructured
+	}{
+		"VirtualMachineInstance without queue": {
+			kind: vmiKind,
+			obj:  testingkubevirt.MakeVirtualMachineInstance("vmi", "ns").Obj(),
+			want: testingkubevirt.MakeVirtualMachineInstance("vmi", "ns").Obj(),
+		},
+		"VirtualMachineInstance with queue and priority class": {
+			kind: vmiKind,
+			obj: testingkubevirt.MakeVirtualMachineInstance("vmi", "ns").
+				Queue("queue").
+				Label(constants.WorkloadPriorityClassLabel, "ci").
+				Obj(),
+			want: testingkubevirt.MakeVirtualMachineInstance("vmi", "ns").
+				Queue("queue").
+				Label(constants.WorkloadPriorityClassLabel, "ci").
+				PodManagedByKueue().
+				PodAnnotation(podconstants.SuspendedByParentAnnotation, VirtualMachineInstanceFrameworkName).
+				Obj(),
+		},
+		"VirtualMachineInstanceReplicaSet without queue": {
+			kind: vmirsKind,
+			obj:  testingkubevirt.MakeVirtualMachineInstanceReplicaSet("vmirs", "ns").Obj(),
+			want: testingkubevirt.MakeVirtualMachineInstanceReplicaSet("vmirs", "ns").Obj(),
+		},
+		"VirtualMachineInstanceReplicaSet with queue": {
+			kind: vmirsKind,
+			obj: testingkubevirt.MakeVirtualMachineInstanceReplicaSet("vmirs", "ns").
+				Queue("queue").
+				PodLabel(constants.QueueLabel, "old-queue").
+				Obj(),
+			want: testingkubevirt.MakeVirtualMachineInstanceReplicaSet("vmirs", "ns").
+				Queue("queue").
+				PodManagedByKueue().
+				PodLabel(constants.QueueLabel, "queue").
+				PodAnnotation(podconstants.SuspendedByParentAnnotation, VirtualMachineInstanceReplicaSetFrameworkName).
+				Obj(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			t.Cleanup(jobframework.EnableIntegrationsForTest(t, "pod"))
+			client := utiltesting.NewClientBuilder().Build()
+			queueManager := qcache.NewManager(client, schdcache.New(client))
+			w := &Webhook{
+				kind:   tc.kind,
+				client: client,
+				queues: queueManager,
+			}
+
+			if err := w.Default(ctx, tc.obj); err != nil {
+				t.Errorf("failed to set defaults: %s", err)
+			}
+			if diff := cmp.Diff(tc.want.Object, tc.obj.Object); len(diff) != 0 {
+				t.Errorf("Default() mismatch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	testCases := map[string]struct {
+		kind    kind
+		oldObj  *unstructured.Unstructured
+		newObj  *unstructured.Unstructured
+		wantErr error
+	}{
+		"VirtualMachineInstance queue change": {
+			kind:   vmiKind,
+			oldObj: testingkubevirt.MakeVirtualMachineInstance("vmi", "ns").Queue("queue").Obj(),
+			newObj: testingkubevirt.MakeVirtualMachineInstance("vmi", "ns").Queue("new-queue").Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.labels[kueue.x-k8s.io/queue-name]",
+				},
+			}.ToAggregate(),
+		},
+		"VirtualMachineInstanceReplicaSet queue change without ready replicas": {
+			kind:   vmirsKind,
+			oldObj: testingkubevirt.MakeVirtualMachineInstanceReplicaSet("vmirs", "ns").Queue("queue").Obj(),
+			newObj: testingkubevirt.MakeVirtualMachineInstanceReplicaSet("vmirs", "ns").Queue("new-queue").Obj(),
+		},
+		"VirtualMachineInstanceReplicaSet queue change with ready replicas": {
+			kind:   vmirsKind,
+			oldObj: testingkubevirt.MakeVirtualMachineInstanceReplicaSet("vmirs", "ns").Queue("queue").ReadyReplicas(1).Obj(),
+			newObj: testingkubevirt.MakeVirtualMachineInstanceReplicaSet("vmirs", "ns").Queue("new-queue").ReadyReplicas(1).Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.labels[kueue.x-k8s.io/queue-name]",
+				},
+			}.ToAggregate(),
+		},
+		"VirtualMachineInstanceReplicaSet queue removal": {
+			kind:   vmirsKind,
+			oldObj: testingkubevirt.MakeVirtualMachineInstanceReplicaSet("vmirs", "ns").Queue("queue").Obj(),
+			newObj: testingkubevirt.MakeVirtualMachineInstanceReplicaSet("vmirs", "ns").Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.labels[kueue.x-k8s.io/queue-name]",
+				},
+			}.ToAggregate(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Cleanup(jobframework.EnableIntegrationsForTest(t, "pod"))
+			w := &Webhook{kind: tc.kind, client: utiltesting.NewClientBuilder().Build()}
+			ctx, _ := utiltesting.ContextWithLog(t)
+
+			_, err := w.ValidateUpdate(ctx, tc.oldObj, tc.newObj)
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
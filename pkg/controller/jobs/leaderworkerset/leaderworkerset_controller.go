@@ -34,6 +34,10 @@ var (
 
 const (
 	FrameworkName = "leaderworkerset.x-k8s.io/leaderworkerset"
+
+	// SurgeWorkloadAnnotation marks the Workloads of the groups that a
+	// LeaderWorkerSet creates above its replicas during a rolling update.
+	SurgeWorkloadAnnotation = "kueue.x-k8s.io/rolling-update-surge"
 )
 
 func init() {
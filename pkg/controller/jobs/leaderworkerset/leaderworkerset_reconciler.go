@@ -25,7 +25,9 @@ import (
 	"github.com/go-logr/logr"
 	"golang.org/x/sync/errgroup"
 	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
@@ -44,6 +46,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/parallelize"
 	utilslices "sigs.k8s.io/kueue/pkg/util/slices"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 	"sigs.k8s.io/kueue/pkg/workload"
 )
 
@@ -112,7 +115,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 	eg.Go(func() error {
 		return parallelize.Until(ctx, len(toCreate), func(i int) error {
-			return r.createPrebuiltWorkload(ctx, lws, toCreate[i])
+			return r.createPrebuiltWorkload(ctx, lws, toCreate[i].name, toCreate[i].surge)
 		})
 	})
 
@@ -130,38 +133,68 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	return ctrl.Result{}, nil
 }
 
+// workloadToCreate is a prebuilt workload to create for a group of a LeaderWorkerSet.
+type workloadToCreate struct {
+	name string
+	// surge indicates that the group is created above the replicas
+	// during a rolling update.
+	surge bool
+}
+
 // filterWorkloads compares the desired state in a LeaderWorkerSet with existing workloads,
 // identifying workloads to create and those to finalize.
 //
 // It takes a LeaderWorkerSet and a slice of existing Workload objects as input and returns:
-// 1. A slice of workload names that need to be created
+// 1. A slice of workloads that need to be created
 // 2. A slice of Workload pointers that need to be finalized
-func (r *Reconciler) filterWorkloads(lws *leaderworkersetv1.LeaderWorkerSet, existingWorkloads []kueue.Workload) ([]string, []*kueue.Workload) {
+func (r *Reconciler) filterWorkloads(lws *leaderworkersetv1.LeaderWorkerSet, existingWorkloads []kueue.Workload) ([]workloadToCreate, []*kueue.Workload) {
 	var (
-		toCreate   []string
+		toCreate   []workloadToCreate
 		toFinalize = utilslices.ToRefMap(existingWorkloads, func(e *kueue.Workload) string {
 			return e.Name
 		})
 		replicas = ptr.Deref(lws.Spec.Replicas, 1)
 	)
 
-	for i := range replicas {
+	for i := range replicas + surgeReplicas(lws) {
 		workloadName := GetWorkloadName(lws.UID, lws.Name, fmt.Sprint(i))
 		if _, ok := toFinalize[workloadName]; ok {
 			delete(toFinalize, workloadName)
 		} else {
-			toCreate = append(toCreate, workloadName)
+			toCreate = append(toCreate, workloadToCreate{name: workloadName, surge: i >= replicas})
 		}
 	}
 
 	return toCreate, slices.Collect(maps.Values(toFinalize))
 }
 
-func (r *Reconciler) createPrebuiltWorkload(ctx context.Context, lws *leaderworkersetv1.LeaderWorkerSet, workloadName string) error {
+// surgeReplicas returns the number of groups that the LeaderWorkerSet
+// can create above its replicas during a rolling update, according to
+// its maxSurge.
+func surgeReplicas(lws *leaderworkersetv1.LeaderWorkerSet) int32 {
+	if !features.Enabled(features.RollingUpdateSurgeAccounting) ||
+		!apimeta.IsStatusConditionTrue(lws.Status.Conditions, string(leaderworkersetv1.LeaderWorkerSetUpdateInProgress)) {
+		return 0
+	}
+	config := lws.Spec.RolloutStrategy.RollingUpdateConfiguration
+	if config == nil {
+		return 0
+	}
+	surge, err := intstr.GetScaledValueFromIntOrPercent(&config.MaxSurge, int(ptr.Deref(lws.Spec.Replicas, 1)), true)
+	if err != nil || surge < 0 {
+		return 0
+	}
+	return int32(surge)
+}
+
+func (r *Reconciler) createPrebuiltWorkload(ctx context.Context, lws *leaderworkersetv1.LeaderWorkerSet, workloadName string, surge bool) error {
 	createdWorkload, err := r.constructWorkload(lws, workloadName)
 	if err != nil {
 		return err
 	}
+	if surge {
+		createdWorkload.Annotations[SurgeWorkloadAnnotation] = "true"
+	}
 
 	priorityClassName, source, p, err := jobframework.ExtractPriority(ctx, r.client, lws, createdWorkload.Spec.PodSets, nil)
 	if err != nil {
@@ -207,7 +240,7 @@ func podSets(lws *leaderworkersetv1.LeaderWorkerSet) ([]kueue.PodSet, error) {
 			},
 		}
 		if features.Enabled(features.TopologyAwareScheduling) {
-			topologyRequest, err := jobframework.NewPodSetTopologyRequest(
+			topologyRequest, err := utiltas.NewPodSetTopologyRequest(
 				&lws.Spec.LeaderWorkerTemplate.LeaderTemplate.ObjectMeta).Build()
 			if err != nil {
 				return nil, err
@@ -236,7 +269,7 @@ func podSets(lws *leaderworkersetv1.LeaderWorkerSet) ([]kueue.PodSet, error) {
 	}
 
 	if features.Enabled(features.TopologyAwareScheduling) {
-		topologyRequest, err := jobframework.NewPodSetTopologyRequest(
+		topologyRequest, err := utiltas.NewPodSetTopologyRequest(
 			&lws.Spec.LeaderWorkerTemplate.WorkerTemplate.ObjectMeta).PodIndexLabel(
 			ptr.To(leaderworkersetv1.WorkerIndexLabelKey)).Build()
 		if err != nil {
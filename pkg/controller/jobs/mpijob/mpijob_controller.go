@@ -19,6 +19,7 @@ package mpijob
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	kfmpi "github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
 	corev1 "k8s.io/api/core/v1"
@@ -33,6 +34,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/podset"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 )
 
 var (
@@ -41,6 +43,17 @@ var (
 	FrameworkName = "kubeflow.org/mpijob"
 )
 
+const (
+	// MPIJobExcludeLauncherFromGangAnnotation excludes the launcher from the pods
+	// which need to be ready for the MPIJob to be considered as PodsReady, since the
+	// launcher tolerates waiting for the workers.
+	MPIJobExcludeLauncherFromGangAnnotation = "kueue.x-k8s.io/mpijob-exclude-launcher-from-gang"
+	// MPIJobWaitForWorkersAnnotation delays the creation of the launcher until all
+	// the workers are ready, by defaulting the launcherCreationPolicy of the MPIJob
+	// to WaitForWorkersReady.
+	MPIJobWaitForWorkersAnnotation = "kueue.x-k8s.io/mpijob-wait-for-workers"
+)
+
 func init() {
 	utilruntime.Must(jobframework.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
 		SetupIndexes:      SetupIndexes,
@@ -75,6 +88,7 @@ type MPIJob kfmpi.MPIJob
 var _ jobframework.GenericJob = (*MPIJob)(nil)
 var _ jobframework.JobWithPriorityClass = (*MPIJob)(nil)
 var _ jobframework.JobWithManagedBy = (*MPIJob)(nil)
+var _ jobframework.JobWithReclaimablePods = (*MPIJob)(nil)
 
 func (j *MPIJob) Object() client.Object {
 	return (*kfmpi.MPIJob)(j)
@@ -97,6 +111,25 @@ func (j *MPIJob) IsActive() bool {
 	return false
 }
 
+func (j *MPIJob) ReclaimablePods() ([]kueue.ReclaimablePod, error) {
+	if len(j.Status.ReplicaStatuses) == 0 {
+		return nil, nil
+	}
+
+	var ret []kueue.ReclaimablePod
+	for _, mpiReplicaType := range orderedReplicaTypes(&j.Spec) {
+		status, found := j.Status.ReplicaStatuses[mpiReplicaType]
+		if !found || status == nil || status.Succeeded == 0 {
+			continue
+		}
+		ret = append(ret, kueue.ReclaimablePod{
+			Name:  kueue.NewPodSetReference(string(mpiReplicaType)),
+			Count: min(status.Succeeded, podsCount(&j.Spec, mpiReplicaType)),
+		})
+	}
+	return ret, nil
+}
+
 func (j *MPIJob) Suspend() {
 	j.Spec.RunPolicy.Suspend = ptr.To(true)
 }
@@ -119,7 +152,7 @@ func (j *MPIJob) PodSets() ([]kueue.PodSet, error) {
 			Count:    podsCount(&j.Spec, mpiReplicaType),
 		}
 		if features.Enabled(features.TopologyAwareScheduling) {
-			topologyRequest, err := jobframework.NewPodSetTopologyRequest(
+			topologyRequest, err := utiltas.NewPodSetTopologyRequest(
 				&j.Spec.MPIReplicaSpecs[mpiReplicaType].Template.ObjectMeta).PodIndexLabel(
 				ptr.To(kfmpi.ReplicaIndexLabel)).Build()
 			if err != nil {
@@ -192,6 +225,10 @@ func (j *MPIJob) PriorityClass() string {
 }
 
 func (j *MPIJob) PodsReady() bool {
+	if _, hasWorkers := j.Spec.MPIReplicaSpecs[kfmpi.MPIReplicaTypeWorker]; hasWorkers && j.excludesLauncherFromGang() {
+		workersStatus := j.Status.ReplicaStatuses[kfmpi.MPIReplicaTypeWorker]
+		return workersStatus != nil && workersStatus.Active >= podsCount(&j.Spec, kfmpi.MPIReplicaTypeWorker)
+	}
 	for _, c := range j.Status.Conditions {
 		if c.Type == kfmpi.JobRunning && c.Status == corev1.ConditionTrue {
 			return true
@@ -200,6 +237,23 @@ func (j *MPIJob) PodsReady() bool {
 	return false
 }
 
+func (j *MPIJob) excludesLauncherFromGang() bool {
+	return annotationEnabled(j.Annotations, MPIJobExcludeLauncherFromGangAnnotation)
+}
+
+func (j *MPIJob) waitsForWorkers() bool {
+	return annotationEnabled(j.Annotations, MPIJobWaitForWorkersAnnotation)
+}
+
+func annotationEnabled(annotations map[string]string, key string) bool {
+	if strVal, found := annotations[key]; found {
+		if bVal, err := strconv.ParseBool(strVal); err == nil {
+			return bVal
+		}
+	}
+	return false
+}
+
 func (j *MPIJob) CanDefaultManagedBy() bool {
 	jobSpecManagedBy := j.Spec.RunPolicy.ManagedBy
 	return features.Enabled(features.MultiKueue) &&
@@ -339,6 +339,58 @@ func TestPodSets(t *testing.T) {
 	}
 }
 
+func TestPodsReady(t *testing.T) {
+	jobTemplate := testingmpijob.MakeMPIJob("job", "ns").MPIJobReplicaSpecs(
+		testingmpijob.MPIJobReplicaSpecRequirement{
+			ReplicaType:  kfmpi.MPIReplicaTypeLauncher,
+			ReplicaCount: 1,
+		},
+		testingmpijob.MPIJobReplicaSpecRequirement{
+			ReplicaType:  kfmpi.MPIReplicaTypeWorker,
+			ReplicaCount: 3,
+		},
+	)
+	runningCondition := kfmpi.JobCondition{Type: kfmpi.JobRunning, Status: corev1.ConditionTrue}
+
+	testCases := map[string]struct {
+		job           *kfmpi.MPIJob
+		workersStatus *kfmpi.ReplicaStatus
+		wantPodsReady bool
+	}{
+		"running": {
+			job:           jobTemplate.Clone().StatusConditions(runningCondition).Obj(),
+			wantPodsReady: true,
+		},
+		"workers are running, the launcher is not": {
+			job:           jobTemplate.Clone().Obj(),
+			workersStatus: &kfmpi.ReplicaStatus{Active: 3},
+			wantPodsReady: false,
+		},
+		"launcher excluded from gang; workers are running": {
+			job:           jobTemplate.Clone().Annotation(MPIJobExcludeLauncherFromGangAnnotation, "true").Obj(),
+			workersStatus: &kfmpi.ReplicaStatus{Active: 3},
+			wantPodsReady: true,
+		},
+		"launcher excluded from gang; some workers are not running": {
+			job:           jobTemplate.Clone().Annotation(MPIJobExcludeLauncherFromGangAnnotation, "true").StatusConditions(runningCondition).Obj(),
+			workersStatus: &kfmpi.ReplicaStatus{Active: 2},
+			wantPodsReady: false,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if tc.workersStatus != nil {
+				tc.job.Status.ReplicaStatuses = map[kfmpi.MPIReplicaType]*kfmpi.ReplicaStatus{
+					kfmpi.MPIReplicaTypeWorker: tc.workersStatus,
+				}
+			}
+			if got := (*MPIJob)(tc.job).PodsReady(); got != tc.wantPodsReady {
+				t.Errorf("Unexpected PodsReady, want=%v, got=%v", tc.wantPodsReady, got)
+			}
+		})
+	}
+}
+
 var (
 	jobCmpOpts = cmp.Options{
 		cmpopts.EquateEmpty(),
@@ -19,7 +19,9 @@ package mpijob
 import (
 	"cmp"
 	"context"
+	"fmt"
 	"slices"
+	"strconv"
 
 	"github.com/kubeflow/mpi-operator/pkg/apis/kubeflow/v2beta1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -37,10 +39,12 @@ import (
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/util/kubeversion"
 	"sigs.k8s.io/kueue/pkg/util/podset"
+	utilwebhook "sigs.k8s.io/kueue/pkg/util/webhook"
 )
 
 var (
 	mpiReplicaSpecsPath = field.NewPath("spec", "mpiReplicaSpecs")
+	annotationsPath     = field.NewPath("metadata", "annotations")
 )
 
 type MpiJobWebhook struct {
@@ -82,12 +86,19 @@ func (w *MpiJobWebhook) Default(ctx context.Context, obj runtime.Object) error {
 	log.V(5).Info("Applying defaults")
 
 	jobframework.ApplyDefaultLocalQueue(mpiJob.Object(), w.queues.DefaultLocalQueueExist)
+	if err := utilwebhook.ApplyDefaultSubmitter(ctx, mpiJob.Object()); err != nil {
+		return err
+	}
 	if err := jobframework.ApplyDefaultForSuspend(ctx, mpiJob, w.client, w.manageJobsWithoutQueueName, w.managedJobsNamespaceSelector); err != nil {
 		return err
 	}
 
 	jobframework.ApplyDefaultForManagedBy(mpiJob, w.queues, w.cache, log)
 
+	if mpiJob.waitsForWorkers() && mpiJob.Spec.LauncherCreationPolicy == "" {
+		mpiJob.Spec.LauncherCreationPolicy = v2beta1.LauncherCreationPolicyWaitForWorkersReady
+	}
+
 	return nil
 }
 
@@ -104,6 +115,7 @@ func (w *MpiJobWebhook) ValidateCreate(ctx context.Context, obj runtime.Object)
 	if err != nil {
 		return nil, err
 	}
+	validationErrs = append(validationErrs, jobframework.ValidateBillingNamespace(ctx, w.client, mpiJob.Object())...)
 	slices.SortFunc(validationErrs, func(a, b *field.Error) int {
 		return cmp.Compare(a.Field, b.Field)
 	})
@@ -136,6 +148,7 @@ func (w *MpiJobWebhook) ValidateDelete(context.Context, runtime.Object) (admissi
 func (w *MpiJobWebhook) validateCommon(mpiJob *MPIJob) (field.ErrorList, error) {
 	var allErrs field.ErrorList
 	allErrs = jobframework.ValidateJobOnCreate(mpiJob)
+	allErrs = append(allErrs, validateLauncherAnnotations(mpiJob)...)
 	if features.Enabled(features.TopologyAwareScheduling) {
 		validationErrs, err := w.validateTopologyRequest(mpiJob)
 		if err != nil {
@@ -146,6 +159,22 @@ func (w *MpiJobWebhook) validateCommon(mpiJob *MPIJob) (field.ErrorList, error)
 	return allErrs, nil
 }
 
+func validateLauncherAnnotations(mpiJob *MPIJob) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, key := range []string{MPIJobExcludeLauncherFromGangAnnotation, MPIJobWaitForWorkersAnnotation} {
+		if strVal, found := mpiJob.Annotations[key]; found {
+			if _, err := strconv.ParseBool(strVal); err != nil {
+				allErrs = append(allErrs, field.Invalid(annotationsPath.Key(key), strVal, err.Error()))
+			}
+		}
+	}
+	if mpiJob.waitsForWorkers() && mpiJob.Spec.LauncherCreationPolicy != v2beta1.LauncherCreationPolicyWaitForWorkersReady {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "launcherCreationPolicy"), mpiJob.Spec.LauncherCreationPolicy,
+			fmt.Sprintf("should be %s when the %s annotation is true", v2beta1.LauncherCreationPolicyWaitForWorkersReady, MPIJobWaitForWorkersAnnotation)))
+	}
+	return allErrs
+}
+
 func (w *MpiJobWebhook) validateTopologyRequest(mpiJob *MPIJob) (field.ErrorList, error) {
 	var allErrs field.ErrorList
 
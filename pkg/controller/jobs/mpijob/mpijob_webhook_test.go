@@ -148,6 +148,30 @@ func TestValidateCreate(t *testing.T) {
 			}.ToAggregate(),
 			topologyAwareScheduling: true,
 		},
+		{
+			name: "valid launcher annotations",
+			job: testingutil.MakeMPIJob("job", "default").
+				Queue("queue").
+				Annotation(MPIJobExcludeLauncherFromGangAnnotation, "true").
+				Annotation(MPIJobWaitForWorkersAnnotation, "true").
+				LauncherCreationPolicy(v2beta1.LauncherCreationPolicyWaitForWorkersReady).
+				Obj(),
+		},
+		{
+			name: "invalid launcher annotations",
+			job: testingutil.MakeMPIJob("job", "default").
+				Queue("queue").
+				Annotation(MPIJobExcludeLauncherFromGangAnnotation, "yes").
+				Annotation(MPIJobWaitForWorkersAnnotation, "true").
+				LauncherCreationPolicy(v2beta1.LauncherCreationPolicyAtStartup).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("metadata", "annotations").Key(MPIJobExcludeLauncherFromGangAnnotation), "yes",
+					`strconv.ParseBool: parsing "yes": invalid syntax`),
+				field.Invalid(field.NewPath("spec", "launcherCreationPolicy"), v2beta1.LauncherCreationPolicyAtStartup,
+					"should be WaitForWorkersReady when the kueue.x-k8s.io/mpijob-wait-for-workers annotation is true"),
+			}.ToAggregate(),
+		},
 	}
 
 	for _, tc := range testcases {
@@ -372,6 +396,25 @@ func TestDefault(t *testing.T) {
 			multiKueueEnabled: true,
 			wantManagedBy:     nil,
 		},
+		{
+			name:   "wait for workers annotation defaults the launcher creation policy",
+			mpiJob: testingutil.MakeMPIJob("job", "default").Annotation(MPIJobWaitForWorkersAnnotation, "true").Obj(),
+			want: testingutil.MakeMPIJob("job", "default").
+				Annotation(MPIJobWaitForWorkersAnnotation, "true").
+				LauncherCreationPolicy(v2beta1.LauncherCreationPolicyWaitForWorkersReady).
+				Obj(),
+		},
+		{
+			name: "wait for workers annotation keeps the launcher creation policy",
+			mpiJob: testingutil.MakeMPIJob("job", "default").
+				Annotation(MPIJobWaitForWorkersAnnotation, "true").
+				LauncherCreationPolicy(v2beta1.LauncherCreationPolicyAtStartup).
+				Obj(),
+			want: testingutil.MakeMPIJob("job", "default").
+				Annotation(MPIJobWaitForWorkersAnnotation, "true").
+				LauncherCreationPolicy(v2beta1.LauncherCreationPolicyAtStartup).
+				Obj(),
+		},
 		{
 			name:                 "LocalQueueDefaulting enabled, default lq is created, job doesn't have queue label",
 			localQueueDefaulting: true,
@@ -56,6 +56,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/util/parallelize"
 	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
 	utilslices "sigs.k8s.io/kueue/pkg/util/slices"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 )
 
 const (
@@ -277,12 +278,13 @@ func (p *Pod) Run(ctx context.Context, c client.Client, podSetsInfo []podset.Pod
 		return nil
 	}
 
-	return parallelize.Until(ctx, len(p.list.Items), func(i int) error {
-		pod := &p.list.Items[i]
+	pods, err := p.podsToStart(podSetsInfo)
+	if err != nil {
+		return err
+	}
 
-		if !isGated(pod) {
-			return nil
-		}
+	return parallelize.Until(ctx, len(pods), func(i int) error {
+		pod := pods[i]
 
 		if err := clientutil.Patch(ctx, c, pod, func() (client.Object, bool, error) {
 			roleHash, err := getRoleHash(*pod)
@@ -316,6 +318,57 @@ func (p *Pod) Run(ctx context.Context, c client.Client, podSetsInfo []podset.Pod
 	})
 }
 
+// podsToStart returns the gated pods of the group to start. For a group with
+// a GroupMinAvailableAnnotation, the pods of each role are started up to the
+// admitted count of the role, including the pods already started and not
+// terminated, so that the rest of the pods remain gated until the started
+// pods terminate.
+func (p *Pod) podsToStart(podSetsInfo []podset.PodSetInfo) ([]*corev1.Pod, error) {
+	var gatedPods []*corev1.Pod
+	for i := range p.list.Items {
+		if isGated(&p.list.Items[i]) {
+			gatedPods = append(gatedPods, &p.list.Items[i])
+		}
+	}
+
+	minAvailable, err := p.groupMinAvailable()
+	if err != nil {
+		return nil, err
+	}
+	if minAvailable == nil {
+		return gatedPods, nil
+	}
+
+	availableCounts := make(map[string]int32, len(podSetsInfo))
+	for _, info := range podSetsInfo {
+		availableCounts[string(info.Name)] = info.Count
+	}
+	for i := range p.list.Items {
+		pod := &p.list.Items[i]
+		if isGated(pod) || utilpod.IsTerminated(pod) {
+			continue
+		}
+		roleHash, err := getRoleHash(*pod)
+		if err != nil {
+			return nil, err
+		}
+		availableCounts[roleHash]--
+	}
+
+	podsToStart := make([]*corev1.Pod, 0, len(gatedPods))
+	for _, pod := range gatedPods {
+		roleHash, err := getRoleHash(*pod)
+		if err != nil {
+			return nil, err
+		}
+		if availableCounts[roleHash] > 0 {
+			availableCounts[roleHash]--
+			podsToStart = append(podsToStart, pod)
+		}
+	}
+	return podsToStart, nil
+}
+
 func (p *Pod) IsTopLevel() bool {
 	return true
 }
@@ -440,7 +493,13 @@ func (p *Pod) PodsReady() bool {
 		return hasPodReadyTrue(p.pod.Status.Conditions)
 	}
 
+	// The pods of a group with a GroupMinAvailableAnnotation which remain
+	// gated after the admission are not expected to be ready.
+	minAvailable, _ := p.groupMinAvailable()
 	for i := range p.list.Items {
+		if minAvailable != nil && isGated(&p.list.Items[i]) {
+			continue
+		}
 		if !hasPodReadyTrue(p.list.Items[i].Status.Conditions) {
 			return false
 		}
@@ -600,6 +659,27 @@ func (p *Pod) groupTotalCount() (int, error) {
 	return gtc, nil
 }
 
+// groupMinAvailable returns the value of GroupMinAvailableAnnotation for the pod being reconciled at the moment,
+// or nil if the annotation is not set.
+func (p *Pod) groupMinAvailable() (*int32, error) {
+	gmaAnnotation, ok := p.Object().GetAnnotations()[podconstants.GroupMinAvailableAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	gma, err := strconv.Atoi(gmaAnnotation)
+	if err != nil {
+		return nil, err
+	}
+
+	if gma < 1 {
+		return nil, fmt.Errorf("incorrect annotation value '%s=%s': group min available should be greater than zero",
+			podconstants.GroupMinAvailableAnnotation, gmaAnnotation)
+	}
+
+	return ptr.To(int32(gma)), nil
+}
+
 // getRoleHash will filter all the fields of the pod that are relevant to admission (pod role) and return a sha256
 // checksum of those fields. This is used to group the pods of the same roles when interacting with the workload.
 func getRoleHash(p corev1.Pod) (string, error) {
@@ -655,14 +735,41 @@ func (p *Pod) Load(ctx context.Context, c client.Client, key *types.NamespacedNa
 }
 
 func (p *Pod) constructGroupPodSets() ([]kueue.PodSet, error) {
+	var podSets []kueue.PodSet
+	var err error
 	if _, useFastAdmission := p.pod.GetAnnotations()[podconstants.GroupFastAdmissionAnnotationKey]; useFastAdmission {
-		tc, err := p.groupTotalCount()
-		if err != nil {
+		var tc int
+		if tc, err = p.groupTotalCount(); err != nil {
 			return nil, err
 		}
-		return constructGroupPodSetsFast(p.list.Items, tc)
+		podSets, err = constructGroupPodSetsFast(p.list.Items, tc)
+	} else {
+		podSets, err = constructGroupPodSets(p.list.Items)
 	}
-	return constructGroupPodSets(p.list.Items)
+	if err != nil {
+		return nil, err
+	}
+	return podSets, p.setMinCounts(podSets)
+}
+
+// setMinCounts sets the minimum counts of the pod sets of a group with a
+// GroupMinAvailableAnnotation. The minimum count of each role is its share
+// of the minimum available pods of the group, rounded up.
+func (p *Pod) setMinCounts(podSets []kueue.PodSet) error {
+	minAvailable, err := p.groupMinAvailable()
+	if err != nil || minAvailable == nil {
+		return err
+	}
+	groupTotalCount, err := p.groupTotalCount()
+	if err != nil {
+		return err
+	}
+	for i := range podSets {
+		ps := &podSets[i]
+		minCount := (int(ps.Count)*int(*minAvailable) + groupTotalCount - 1) / groupTotalCount
+		ps.MinCount = ptr.To(min(int32(minCount), ps.Count))
+	}
+	return nil
 }
 
 func constructPodSets(p *corev1.Pod) ([]kueue.PodSet, error) {
@@ -684,7 +791,7 @@ func constructPodSet(p *corev1.Pod) (kueue.PodSet, error) {
 		},
 	}
 	if features.Enabled(features.TopologyAwareScheduling) {
-		topologyRequest, err := jobframework.NewPodSetTopologyRequest(
+		topologyRequest, err := utiltas.NewPodSetTopologyRequest(
 			&p.ObjectMeta).PodIndexLabel(
 			ptr.To(kueue.PodGroupPodIndexLabel)).Build()
 		if err != nil {
@@ -802,6 +909,13 @@ func (p *Pod) validatePodGroupMetadata(r record.EventRecorder, activePods []core
 				podconstants.GroupTotalCountAnnotation,
 				groupTotalCount, tc))
 		}
+
+		if gma, podInGroupGma := p.pod.GetAnnotations()[podconstants.GroupMinAvailableAnnotation], podInGroup.GetAnnotations()[podconstants.GroupMinAvailableAnnotation]; gma != podInGroupGma {
+			return jobframework.UnretryableError(fmt.Sprintf("pods '%s' and '%s' has different '%s' values: %s!=%s",
+				p.pod.GetName(), podInGroup.GetName(),
+				podconstants.GroupMinAvailableAnnotation,
+				gma, podInGroupGma))
+		}
 	}
 
 	return nil
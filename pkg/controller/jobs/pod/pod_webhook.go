@@ -42,17 +42,19 @@ import (
 	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
 	"sigs.k8s.io/kueue/pkg/features"
 	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
+	utilwebhook "sigs.k8s.io/kueue/pkg/util/webhook"
 )
 
 var (
-	metaPath                       = field.NewPath("metadata")
-	labelsPath                     = metaPath.Child("labels")
-	annotationsPath                = metaPath.Child("annotations")
-	managedLabelPath               = labelsPath.Key(constants.ManagedByKueueLabelKey)
-	groupNameLabelPath             = labelsPath.Key(podconstants.GroupNameLabel)
-	prebuiltWorkloadLabelPath      = labelsPath.Key(ctrlconstants.PrebuiltWorkloadLabel)
-	groupTotalCountAnnotationPath  = annotationsPath.Key(podconstants.GroupTotalCountAnnotation)
-	retriableInGroupAnnotationPath = annotationsPath.Key(podconstants.RetriableInGroupAnnotationKey)
+	metaPath                        = field.NewPath("metadata")
+	labelsPath                      = metaPath.Child("labels")
+	annotationsPath                 = metaPath.Child("annotations")
+	managedLabelPath                = labelsPath.Key(constants.ManagedByKueueLabelKey)
+	groupNameLabelPath              = labelsPath.Key(podconstants.GroupNameLabel)
+	prebuiltWorkloadLabelPath       = labelsPath.Key(ctrlconstants.PrebuiltWorkloadLabel)
+	groupTotalCountAnnotationPath   = annotationsPath.Key(podconstants.GroupTotalCountAnnotation)
+	groupMinAvailableAnnotationPath = annotationsPath.Key(podconstants.GroupMinAvailableAnnotation)
+	retriableInGroupAnnotationPath  = annotationsPath.Key(podconstants.RetriableInGroupAnnotationKey)
 
 	errPodOptsTypeAssertion = errors.New("options are not of type PodIntegrationOptions")
 )
@@ -203,6 +205,9 @@ func (w *PodWebhook) Default(ctx context.Context, obj runtime.Object) error {
 		if err := pod.addRoleHash(); err != nil {
 			return err
 		}
+		if err := utilwebhook.ApplyDefaultSubmitter(ctx, pod.Object()); err != nil {
+			return err
+		}
 		// copy back changes to the object
 		pod.pod.DeepCopyInto(obj.(*corev1.Pod))
 	}
@@ -223,6 +228,7 @@ func (w *PodWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (ad
 
 	allErrs := jobframework.ValidateJobOnCreate(pod)
 	allErrs = append(allErrs, validateCommon(pod)...)
+	allErrs = append(allErrs, jobframework.ValidateBillingNamespace(ctx, w.client, pod.Object())...)
 
 	if warn := warningForPodManagedLabel(pod); warn != "" {
 		warnings = append(warnings, warn)
@@ -245,6 +251,8 @@ func (w *PodWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.
 
 	if podGroupName(oldPod.pod) != "" {
 		allErrs = append(allErrs, validation.ValidateImmutableField(podGroupName(newPod.pod), podGroupName(oldPod.pod), groupNameLabelPath)...)
+		allErrs = append(allErrs, validation.ValidateImmutableField(newPod.pod.Annotations[podconstants.GroupMinAvailableAnnotation],
+			oldPod.pod.Annotations[podconstants.GroupMinAvailableAnnotation], groupMinAvailableAnnotationPath)...)
 	}
 
 	if _, suspendByParent := newPod.pod.Annotations[podconstants.SuspendedByParentAnnotation]; !suspendByParent {
@@ -312,7 +320,8 @@ func validatePodGroupMetadata(p *Pod) field.ErrorList {
 		}
 	}
 
-	if _, err := p.groupTotalCount(); gtcExists && err != nil {
+	groupTotalCount, err := p.groupTotalCount()
+	if gtcExists && err != nil {
 		return append(allErrs, field.Invalid(
 			groupTotalCountAnnotationPath,
 			gtc,
@@ -320,6 +329,26 @@ func validatePodGroupMetadata(p *Pod) field.ErrorList {
 		))
 	}
 
+	if gma, gmaExists := p.pod.GetAnnotations()[podconstants.GroupMinAvailableAnnotation]; gmaExists {
+		if podGroupName(p.pod) == "" {
+			return append(allErrs, field.Forbidden(
+				groupMinAvailableAnnotationPath,
+				fmt.Sprintf("the '%s' annotation can only be set for the pods of a group", podconstants.GroupMinAvailableAnnotation),
+			))
+		}
+		minAvailable, err := p.groupMinAvailable()
+		if err != nil {
+			return append(allErrs, field.Invalid(groupMinAvailableAnnotationPath, gma, err.Error()))
+		}
+		if int(*minAvailable) > groupTotalCount {
+			return append(allErrs, field.Invalid(
+				groupMinAvailableAnnotationPath,
+				gma,
+				fmt.Sprintf("should not be greater than the '%s' annotation", podconstants.GroupTotalCountAnnotation),
+			))
+		}
+	}
+
 	return allErrs
 }
 
@@ -716,6 +716,54 @@ func TestValidateCreate(t *testing.T) {
 				},
 			}.ToAggregate(),
 		},
+		"pod with group min available": {
+			pod: testingpod.MakePod("test-pod", "test-ns").
+				ManagedByKueueLabel().
+				Group("test-group").
+				GroupTotalCount("3").
+				GroupMinAvailable("2").
+				Obj(),
+		},
+		"pod with group min available and no group name": {
+			pod: testingpod.MakePod("test-pod", "test-ns").
+				ManagedByKueueLabel().
+				GroupMinAvailable("2").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeForbidden,
+					Field: "metadata.annotations[kueue.x-k8s.io/pod-group-min-available]",
+				},
+			}.ToAggregate(),
+		},
+		"pod with 0 group min available": {
+			pod: testingpod.MakePod("test-pod", "test-ns").
+				ManagedByKueueLabel().
+				Group("test-group").
+				GroupTotalCount("3").
+				GroupMinAvailable("0").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.annotations[kueue.x-k8s.io/pod-group-min-available]",
+				},
+			}.ToAggregate(),
+		},
+		"pod with group min available greater than the group total count": {
+			pod: testingpod.MakePod("test-pod", "test-ns").
+				ManagedByKueueLabel().
+				Group("test-group").
+				GroupTotalCount("3").
+				GroupMinAvailable("4").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.annotations[kueue.x-k8s.io/pod-group-min-available]",
+				},
+			}.ToAggregate(),
+		},
 		"pod with incorrect group name": {
 			pod: testingpod.MakePod("test-pod", "test-ns").
 				ManagedByKueueLabel().
@@ -897,6 +945,24 @@ func TestValidateUpdate(t *testing.T) {
 				},
 			}.ToAggregate(),
 		},
+		"pod group min available is changed": {
+			oldPod: testingpod.MakePod("test-pod", "test-ns").
+				Group("test-group").
+				GroupTotalCount("2").
+				GroupMinAvailable("1").
+				Obj(),
+			newPod: testingpod.MakePod("test-pod", "test-ns").
+				Group("test-group").
+				GroupTotalCount("2").
+				GroupMinAvailable("2").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.annotations[kueue.x-k8s.io/pod-group-min-available]",
+				},
+			}.ToAggregate(),
+		},
 		"assign pod group name": {
 			oldPod: testingpod.MakePod("test-pod", "test-ns").
 				Obj(),
@@ -33,6 +33,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/podset"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 )
 
 var (
@@ -113,7 +114,7 @@ func (j *RayCluster) PodSets() ([]kueue.PodSet, error) {
 	}
 
 	if features.Enabled(features.TopologyAwareScheduling) {
-		topologyRequest, err := jobframework.NewPodSetTopologyRequest(
+		topologyRequest, err := utiltas.NewPodSetTopologyRequest(
 			&j.Spec.HeadGroupSpec.Template.ObjectMeta).Build()
 		if err != nil {
 			return nil, err
@@ -124,20 +125,13 @@ func (j *RayCluster) PodSets() ([]kueue.PodSet, error) {
 	// workers
 	for index := range j.Spec.WorkerGroupSpecs {
 		wgs := &j.Spec.WorkerGroupSpecs[index]
-		count := int32(1)
-		if wgs.Replicas != nil {
-			count = *wgs.Replicas
-		}
-		if wgs.NumOfHosts > 1 {
-			count *= wgs.NumOfHosts
-		}
 		podSets[index+1] = kueue.PodSet{
 			Name:     kueue.NewPodSetReference(wgs.GroupName),
 			Template: *wgs.Template.DeepCopy(),
-			Count:    count,
+			Count:    workerGroupCount(&j.Spec, wgs),
 		}
 		if features.Enabled(features.TopologyAwareScheduling) {
-			topologyRequest, err := jobframework.NewPodSetTopologyRequest(
+			topologyRequest, err := utiltas.NewPodSetTopologyRequest(
 				&wgs.Template.ObjectMeta).Build()
 			if err != nil {
 				return nil, err
@@ -148,6 +142,32 @@ func (j *RayCluster) PodSets() ([]kueue.PodSet, error) {
 	return podSets, nil
 }
 
+// workerGroupCount returns the number of pods of the worker group.
+// When the in-tree autoscaler is enabled, the count follows the desired replicas
+// computed by KubeRay, that is, the replicas set by the autoscaler within the
+// min and max replicas of the group, and no pods for a suspended group.
+func workerGroupCount(spec *rayv1.RayClusterSpec, wgs *rayv1.WorkerGroupSpec) int32 {
+	count := int32(1)
+	if wgs.Replicas != nil {
+		count = *wgs.Replicas
+	}
+	if rayutils.IsAutoscalingEnabled(spec) {
+		if ptr.Deref(wgs.Suspend, false) {
+			return 0
+		}
+		if wgs.MinReplicas != nil && (wgs.Replicas == nil || count < *wgs.MinReplicas) {
+			count = *wgs.MinReplicas
+		}
+		if wgs.MaxReplicas != nil && count > *wgs.MaxReplicas {
+			count = *wgs.MaxReplicas
+		}
+	}
+	if wgs.NumOfHosts > 1 {
+		count *= wgs.NumOfHosts
+	}
+	return count
+}
+
 func (j *RayCluster) RunWithPodSetsInfo(podSetsInfo []podset.PodSetInfo) error {
 	expectedLen := len(j.Spec.WorkerGroupSpecs) + 1
 	if len(podSetsInfo) != expectedLen {
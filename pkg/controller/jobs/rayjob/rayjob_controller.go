@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/podset"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 )
 
 var (
@@ -78,6 +79,7 @@ type RayJob rayv1.RayJob
 
 var _ jobframework.GenericJob = (*RayJob)(nil)
 var _ jobframework.JobWithManagedBy = (*RayJob)(nil)
+var _ jobframework.JobWithReclaimablePods = (*RayJob)(nil)
 
 func (j *RayJob) Object() client.Object {
 	return (*rayv1.RayJob)(j)
@@ -121,7 +123,7 @@ func (j *RayJob) PodSets() ([]kueue.PodSet, error) {
 		Count:    1,
 	}
 	if features.Enabled(features.TopologyAwareScheduling) {
-		topologyRequest, err := jobframework.NewPodSetTopologyRequest(
+		topologyRequest, err := utiltas.NewPodSetTopologyRequest(
 			&j.Spec.RayClusterSpec.HeadGroupSpec.Template.ObjectMeta).Build()
 		if err != nil {
 			return nil, err
@@ -146,7 +148,7 @@ func (j *RayJob) PodSets() ([]kueue.PodSet, error) {
 			Count:    count,
 		}
 		if features.Enabled(features.TopologyAwareScheduling) {
-			topologyRequest, err := jobframework.NewPodSetTopologyRequest(&wgs.Template.ObjectMeta).Build()
+			topologyRequest, err := utiltas.NewPodSetTopologyRequest(&wgs.Template.ObjectMeta).Build()
 			if err != nil {
 				return nil, err
 			}
@@ -166,7 +168,7 @@ func (j *RayJob) PodSets() ([]kueue.PodSet, error) {
 		// Create the TopologyRequest for the Submitter Job PodSet, based on the annotations
 		// in rayJob.Spec.SubmitterPodTemplate, which can be specified by the user.
 		if features.Enabled(features.TopologyAwareScheduling) {
-			topologyRequest, err := jobframework.NewPodSetTopologyRequest(&submitterJobPodSet.Template.ObjectMeta).Build()
+			topologyRequest, err := utiltas.NewPodSetTopologyRequest(&submitterJobPodSet.Template.ObjectMeta).Build()
 			if err != nil {
 				return nil, err
 			}
@@ -256,6 +258,15 @@ func (j *RayJob) Finished() (message string, success, finished bool) {
 	return message, success, finished
 }
 
+func (j *RayJob) ReclaimablePods() ([]kueue.ReclaimablePod, error) {
+	// The submitter Job completes once the Ray job reaches a terminal status,
+	// so its pod is reclaimable while the RayCluster is torn down.
+	if j.Spec.SubmissionMode != rayv1.K8sJobMode || !rayv1.IsJobTerminal(j.Status.JobStatus) {
+		return nil, nil
+	}
+	return []kueue.ReclaimablePod{{Name: submitterJobPodSetName, Count: 1}}, nil
+}
+
 func (j *RayJob) PodsReady() bool {
 	return j.Status.RayClusterStatus.State == rayv1.Ready
 }
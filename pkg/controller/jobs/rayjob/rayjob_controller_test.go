@@ -751,3 +751,41 @@ func Test_RayJobFinished(t *testing.T) {
 		})
 	}
 }
+
+func TestReclaimablePods(t *testing.T) {
+	testCases := map[string]struct {
+		job  *rayv1.RayJob
+		want []kueue.ReclaimablePod
+	}{
+		"running ray job": {
+			job: testingrayutil.MakeJob("job", "ns").
+				WithSubmissionMode(rayv1.K8sJobMode).
+				JobStatus(rayv1.JobStatusRunning).
+				Obj(),
+		},
+		"succeeded ray job; the submitter pod is reclaimable": {
+			job: testingrayutil.MakeJob("job", "ns").
+				WithSubmissionMode(rayv1.K8sJobMode).
+				JobStatus(rayv1.JobStatusSucceeded).
+				Obj(),
+			want: []kueue.ReclaimablePod{{Name: submitterJobPodSetName, Count: 1}},
+		},
+		"succeeded ray job without submitter job": {
+			job: testingrayutil.MakeJob("job", "ns").
+				WithSubmissionMode(rayv1.HTTPMode).
+				JobStatus(rayv1.JobStatusSucceeded).
+				Obj(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ((*RayJob)(tc.job)).ReclaimablePods()
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected reclaimable pods (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,634 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utilunstructured "sigs.k8s.io/kueue/pkg/util/unstructured"
+)
+
+var (
+	gvk = schema.GroupVersionKind{Group: "sparkoperator.k8s.io", Version: "v1beta2", Kind: "SparkApplication"}
+
+	FrameworkName = "sparkoperator.k8s.io/sparkapplication"
+
+	NewReconciler = jobframework.NewGenericReconcilerFactory(NewJob)
+
+	SetupSparkApplicationWebhook = jobframework.BaseWebhookFactory(
+		NewJob(),
+		func(o runtime.Object) jobframework.GenericJob {
+			return fromObject(o)
+		},
+	)
+)
+
+const (
+	// SparkMinExecutorsAnnotation enables the partial admission of a
+	// SparkApplication, with its executors reduced down to the value of the
+	// annotation when the quota doesn't cover all of them.
+	SparkMinExecutorsAnnotation = "kueue.x-k8s.io/spark-min-executors"
+
+	driverPodSetName   kueue.PodSetReference = "driver"
+	executorPodSetName kueue.PodSetReference = "executor"
+
+	driverContainerName   = "spark-kubernetes-driver"
+	executorContainerName = "spark-kubernetes-executor"
+)
+
+func init() {
+	utilruntime.Must(jobframework.RegisterIntegration(FrameworkName, jobframework.IntegrationCallbacks{
+		SetupIndexes:  SetupIndexes,
+		NewJob:        NewJob,
+		NewReconciler: NewReconciler,
+		SetupWebhook:  SetupSparkApplicationWebhook,
+		JobType:       utilunstructured.NewObject(gvk),
+		GVK:           gvk,
+		AddToScheme:   utilunstructured.AddToSchemeFunc(gvk),
+	}))
+}
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;watch;update
+// +kubebuilder:rbac:groups=sparkoperator.k8s.io,resources=sparkapplications,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=sparkoperator.k8s.io,resources=sparkapplications/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=sparkoperator.k8s.io,resources=sparkapplications/finalizers,verbs=get;update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/finalizers,verbs=update
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloadpriorityclasses,verbs=get;list;watch
+//+kubebuilder:webhook:path=/mutate-sparkoperator-k8s-io-v1beta2-sparkapplication,mutating=true,failurePolicy=fail,sideEffects=None,groups=sparkoperator.k8s.io,resources=sparkapplications,verbs=create,versions=v1beta2,name=msparkapplication.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-sparkoperator-k8s-io-v1beta2-sparkapplication,mutating=false,failurePolicy=fail,sideEffects=None,groups=sparkoperator.k8s.io,resources=sparkapplications,verbs=create;update,versions=v1beta2,name=vsparkapplication.kb.io,admissionReviewVersions=v1
+
+func NewJob() jobframework.GenericJob {
+	return &SparkApplication{Unstructured: utilunstructured.NewObject(gvk)}
+}
+
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
+}
+
+// SparkApplication is a SparkApplication of the Spark operator, handled as
+// an unstructured object, like the Argo Workflows.
+type SparkApplication struct {
+	*unstructured.Unstructured
+}
+
+var _ jobframework.GenericJob = (*SparkApplication)(nil)
+var _ jobframework.JobWithPriorityClass = (*SparkApplication)(nil)
+var _ jobframework.JobWithReclaimablePods = (*SparkApplication)(nil)
+var _ jobframework.JobWithCustomValidation = (*SparkApplication)(nil)
+
+func fromObject(o runtime.Object) *SparkApplication {
+	return &SparkApplication{Unstructured: o.(*unstructured.Unstructured)}
+}
+
+// sparkApplicationSpec is the subset of the spec of a SparkApplication read
+// by Kueue.
+type sparkApplicationSpec struct {
+	Type                 string             `json:"type,omitempty"`
+	Suspend              *bool              `json:"suspend,omitempty"`
+	Driver               sparkPodSpec       `json:"driver"`
+	Executor             sparkPodSpec       `json:"executor"`
+	DynamicAllocation    *dynamicAllocation `json:"dynamicAllocation,omitempty"`
+	MemoryOverheadFactor *string            `json:"memoryOverheadFactor,omitempty"`
+	NodeSelector         map[string]string  `json:"nodeSelector,omitempty"`
+	RestartPolicy        restartPolicy      `json:"restartPolicy,omitempty"`
+}
+
+// sparkPodSpec is the subset of the driver and executor specs read by Kueue.
+type sparkPodSpec struct {
+	Cores             *int32              `json:"cores,omitempty"`
+	CoreRequest       *string             `json:"coreRequest,omitempty"`
+	Memory            *string             `json:"memory,omitempty"`
+	MemoryOverhead    *string             `json:"memoryOverhead,omitempty"`
+	GPU               *gpuSpec            `json:"gpu,omitempty"`
+	Instances         *int32              `json:"instances,omitempty"`
+	Labels            map[string]string   `json:"labels,omitempty"`
+	Annotations       map[string]string   `json:"annotations,omitempty"`
+	NodeSelector      map[string]string   `json:"nodeSelector,omitempty"`
+	Tolerations       []corev1.Toleration `json:"tolerations,omitempty"`
+	PriorityClassName *string             `json:"priorityClassName,omitempty"`
+	InitContainers    []corev1.Container  `json:"initContainers,omitempty"`
+	Sidecars          []corev1.Container  `json:"sidecars,omitempty"`
+}
+
+type gpuSpec struct {
+	Name     string `json:"name"`
+	Quantity int64  `json:"quantity"`
+}
+
+type dynamicAllocation struct {
+	Enabled          bool   `json:"enabled,omitempty"`
+	InitialExecutors *int32 `json:"initialExecutors,omitempty"`
+	MinExecutors     *int32 `json:"minExecutors,omitempty"`
+	MaxExecutors     *int32 `json:"maxExecutors,omitempty"`
+}
+
+type restartPolicy struct {
+	Type string `json:"type,omitempty"`
+}
+
+// sparkApplicationStatus is the subset of the status of a SparkApplication
+// read by Kueue.
+type sparkApplicationStatus struct {
+	ApplicationState struct {
+		State        string `json:"state,omitempty"`
+		ErrorMessage string `json:"errorMessage,omitempty"`
+	} `json:"applicationState"`
+}
+
+const (
+	stateRunning          = "RUNNING"
+	stateCompleted        = "COMPLETED"
+	stateFailed           = "FAILED"
+	stateSubmissionFailed = "SUBMISSION_FAILED"
+	stateSucceeding       = "SUCCEEDING"
+	stateFailing          = "FAILING"
+	stateSuspended        = "SUSPENDED"
+
+	restartPolicyOnFailure = "OnFailure"
+	restartPolicyAlways    = "Always"
+
+	// defaultMemory is the memory of the driver and the executors when not set.
+	defaultMemory = "1g"
+	// minMemoryOverhead is the minimum memory overhead added by Spark to the
+	// memory of the driver and the executors.
+	minMemoryOverhead = 384 * 1024 * 1024
+)
+
+// roles are the driver and the executor, in the order of the PodSets.
+var roles = []struct {
+	name          kueue.PodSetReference
+	field         string
+	containerName string
+}{
+	{name: driverPodSetName, field: "driver", containerName: driverContainerName},
+	{name: executorPodSetName, field: "executor", containerName: executorContainerName},
+}
+
+func (j *SparkApplication) Object() client.Object {
+	return j.Unstructured
+}
+
+func (j *SparkApplication) spec() (*sparkApplicationSpec, error) {
+	spec := &sparkApplicationSpec{}
+	if err := utilunstructured.FromField(j.Unstructured.Object, spec, "spec"); err != nil {
+		return nil, fmt.Errorf("failed to read the spec of the SparkApplication: %w", err)
+	}
+	return spec, nil
+}
+
+func (j *SparkApplication) status() *sparkApplicationStatus {
+	status := &sparkApplicationStatus{}
+	// The status is only written by the Spark operator, ignore it if malformed.
+	_ = utilunstructured.FromField(j.Unstructured.Object, status, "status")
+	return status
+}
+
+func (j *SparkApplication) state() string {
+	return j.status().ApplicationState.State
+}
+
+func (s *sparkApplicationSpec) role(name kueue.PodSetReference) *sparkPodSpec {
+	if name == driverPodSetName {
+		return &s.Driver
+	}
+	return &s.Executor
+}
+
+func (s *sparkApplicationSpec) dynamicAllocationEnabled() bool {
+	return s.DynamicAllocation != nil && s.DynamicAllocation.Enabled
+}
+
+// executors returns the maximum number of executors of the application, the
+// maximum executors with dynamic allocation, or the executor instances.
+func (s *sparkApplicationSpec) executors() int32 {
+	if s.dynamicAllocationEnabled() {
+		return ptr.Deref(s.DynamicAllocation.MaxExecutors, 0)
+	}
+	return ptr.Deref(s.Executor.Instances, 1)
+}
+
+func (j *SparkApplication) IsSuspended() bool {
+	suspend, _, _ := unstructured.NestedBool(j.Unstructured.Object, "spec", "suspend")
+	return suspend
+}
+
+// IsActive returns whether the driver of the application is submitted and
+// not terminated yet, or the application is being suspended.
+func (j *SparkApplication) IsActive() bool {
+	switch j.state() {
+	case "", stateSuspended, stateCompleted, stateFailed, stateSubmissionFailed:
+		return false
+	}
+	return true
+}
+
+func (j *SparkApplication) Suspend() {
+	utilruntime.Must(unstructured.SetNestedField(j.Unstructured.Object, true, "spec", "suspend"))
+}
+
+func (j *SparkApplication) GVK() schema.GroupVersionKind {
+	return gvk
+}
+
+func (j *SparkApplication) PriorityClass() string {
+	name, _, _ := unstructured.NestedString(j.Unstructured.Object, "spec", "driver", "priorityClassName")
+	return name
+}
+
+// PodSets returns a PodSet for the driver and one for the executors, with
+// the pods requesting the cores, the memory with its overhead, and the GPUs
+// set for the driver and the executors. With dynamic allocation, the
+// executor PodSet covers the maximum executors.
+func (j *SparkApplication) PodSets() ([]kueue.PodSet, error) {
+	spec, err := j.spec()
+	if err != nil {
+		return nil, err
+	}
+	podSets := make([]kueue.PodSet, 0, len(roles))
+	for _, role := range roles {
+		podSpec := spec.role(role.name)
+		requests, err := spec.podRequests(podSpec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute the requests of the %s: %w", role.field, err)
+		}
+		ps := kueue.PodSet{
+			Name:     role.name,
+			Template: spec.podTemplate(podSpec, role.containerName, requests),
+			Count:    1,
+		}
+		if role.name == executorPodSetName {
+			ps.Count = spec.executors()
+			ps.MinCount = j.minExecutors()
+		}
+		podSets = append(podSets, ps)
+	}
+	return podSets, nil
+}
+
+// podRequests returns the requests of the pods of the driver or the
+// executors, as submitted by Spark.
+func (s *sparkApplicationSpec) podRequests(podSpec *sparkPodSpec) (corev1.ResourceList, error) {
+	cpu := *resource.NewQuantity(int64(ptr.Deref(podSpec.Cores, 1)), resource.DecimalSI)
+	if podSpec.CoreRequest != nil {
+		var err error
+		if cpu, err = resource.ParseQuantity(*podSpec.CoreRequest); err != nil {
+			return nil, fmt.Errorf("invalid coreRequest: %w", err)
+		}
+	}
+	memory, err := parseSparkMemory(ptr.Deref(podSpec.Memory, defaultMemory))
+	if err != nil {
+		return nil, fmt.Errorf("invalid memory: %w", err)
+	}
+	overhead, err := s.memoryOverhead(podSpec, memory)
+	if err != nil {
+		return nil, err
+	}
+	memory.Add(overhead)
+	requests := corev1.ResourceList{
+		corev1.ResourceCPU:    cpu,
+		corev1.ResourceMemory: memory,
+	}
+	if podSpec.GPU != nil && podSpec.GPU.Quantity > 0 {
+		requests[corev1.ResourceName(podSpec.GPU.Name)] = *resource.NewQuantity(podSpec.GPU.Quantity, resource.DecimalSI)
+	}
+	return requests, nil
+}
+
+// memoryOverhead returns the memoryOverhead if set, or the memory multiplied
+// by the memoryOverheadFactor, 0.1 for the JVM applications and 0.4 for the
+// others by default, with a minimum of 384MiB.
+func (s *sparkApplicationSpec) memoryOverhead(podSpec *sparkPodSpec, memory resource.Quantity) (resource.Quantity, error) {
+	if podSpec.MemoryOverhead != nil {
+		overhead, err := parseSparkMemory(*podSpec.MemoryOverhead)
+		if err != nil {
+			return resource.Quantity{}, fmt.Errorf("invalid memoryOverhead: %w", err)
+		}
+		return overhead, nil
+	}
+	factor := 0.1
+	if s.Type == "Python" || s.Type == "R" {
+		factor = 0.4
+	}
+	if s.MemoryOverheadFactor != nil {
+		var err error
+		if factor, err = strconv.ParseFloat(*s.MemoryOverheadFactor, 64); err != nil {
+			return resource.Quantity{}, fmt.Errorf("invalid memoryOverheadFactor: %w", err)
+		}
+	}
+	overhead := max(int64(float64(memory.Value())*factor), minMemoryOverhead)
+	return *resource.NewQuantity(overhead, resource.BinarySI), nil
+}
+
+// parseSparkMemory parses a Spark memory string, as "512m" or "2g", with a
+// binary unit, in MiB if not set.
+func parseSparkMemory(s string) (resource.Quantity, error) {
+	value := strings.ToLower(strings.TrimSpace(s))
+	multiplier := int64(1024 * 1024)
+	for _, unit := range []struct {
+		suffixes   []string
+		multiplier int64
+	}{
+		{suffixes: []string{"pb", "p"}, multiplier: 1 << 50},
+		{suffixes: []string{"tb", "t"}, multiplier: 1 << 40},
+		{suffixes: []string{"gb", "g"}, multiplier: 1 << 30},
+		{suffixes: []string{"mb", "m"}, multiplier: 1 << 20},
+		{suffixes: []string{"kb", "k"}, multiplier: 1 << 10},
+		{suffixes: []string{"b"}, multiplier: 1},
+	} {
+		found := false
+		for _, suffix := range unit.suffixes {
+			if trimmed, ok := strings.CutSuffix(value, suffix); ok {
+				value, multiplier, found = trimmed, unit.multiplier, true
+				break
+			}
+		}
+		if found {
+			break
+		}
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		return resource.Quantity{}, fmt.Errorf("%q is not a valid Spark memory string", s)
+	}
+	return *resource.NewQuantity(n*multiplier, resource.BinarySI), nil
+}
+
+// podTemplate returns the pod template of the driver or the executors, with
+// the node selector of the application merged with their node selector.
+// With SparkApplicationSidecarsAccounting, the template has the init containers
+// and the sidecars of the role, so that the pod requests account them.
+func (s *sparkApplicationSpec) podTemplate(podSpec *sparkPodSpec, containerName string, requests corev1.ResourceList) corev1.PodTemplateSpec {
+	var nodeSelector map[string]string
+	if len(s.NodeSelector) > 0 || len(podSpec.NodeSelector) > 0 {
+		nodeSelector = maps.Clone(s.NodeSelector)
+		if nodeSelector == nil {
+			nodeSelector = make(map[string]string, len(podSpec.NodeSelector))
+		}
+		maps.Copy(nodeSelector, podSpec.NodeSelector)
+	}
+	template := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      podSpec.Labels,
+			Annotations: podSpec.Annotations,
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:      containerName,
+				Resources: corev1.ResourceRequirements{Requests: requests},
+			}},
+			NodeSelector:      nodeSelector,
+			Tolerations:       podSpec.Tolerations,
+			PriorityClassName: ptr.Deref(podSpec.PriorityClassName, ""),
+		},
+	}
+	if features.Enabled(features.SparkApplicationSidecarsAccounting) {
+		template.Spec.InitContainers = podSpec.InitContainers
+		template.Spec.Containers = append(template.Spec.Containers, podSpec.Sidecars...)
+	}
+	return template
+}
+
+func (j *SparkApplication) minExecutors() *int32 {
+	if strVal, found := j.Object().GetAnnotations()[SparkMinExecutorsAnnotation]; found {
+		if iVal, err := strconv.Atoi(strVal); err == nil {
+			return ptr.To(int32(iVal))
+		}
+	}
+	return nil
+}
+
+// executorsField returns the path of the field limiting the executors of the
+// application.
+func (s *sparkApplicationSpec) executorsField() []string {
+	if s.dynamicAllocationEnabled() {
+		return []string{"spec", "dynamicAllocation", "maxExecutors"}
+	}
+	return []string{"spec", "executor", "instances"}
+}
+
+func (j *SparkApplication) RunWithPodSetsInfo(podSetsInfo []podset.PodSetInfo) error {
+	if len(podSetsInfo) != len(roles) {
+		return podset.BadPodSetsInfoLenError(len(roles), len(podSetsInfo))
+	}
+	spec, err := j.spec()
+	if err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(j.Unstructured.Object, false, "spec", "suspend"); err != nil {
+		return err
+	}
+	if j.minExecutors() != nil {
+		if err := unstructured.SetNestedField(j.Unstructured.Object, int64(podSetsInfo[1].Count), spec.executorsField()...); err != nil {
+			return err
+		}
+	}
+	for i, role := range roles {
+		podSpec := spec.role(role.name)
+		meta := metav1.ObjectMeta{Labels: podSpec.Labels, Annotations: podSpec.Annotations}
+		tmpl := corev1.PodSpec{NodeSelector: podSpec.NodeSelector, Tolerations: podSpec.Tolerations}
+		if err := podset.Merge(&meta, &tmpl, podSetsInfo[i]); err != nil {
+			return err
+		}
+		if err := j.setRole(role.field, &meta, &tmpl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *SparkApplication) RestorePodSetsInfo(podSetsInfo []podset.PodSetInfo) bool {
+	if len(podSetsInfo) != len(roles) {
+		return false
+	}
+	spec, err := j.spec()
+	if err != nil {
+		return false
+	}
+	changed := false
+	if j.minExecutors() != nil && spec.executors() != podSetsInfo[1].Count {
+		utilruntime.Must(unstructured.SetNestedField(j.Unstructured.Object, int64(podSetsInfo[1].Count), spec.executorsField()...))
+		changed = true
+	}
+	for i, role := range roles {
+		podSpec := spec.role(role.name)
+		info := podSetsInfo[i]
+		// The node selector of the PodSet includes the node selector of the
+		// application, which keeps applying to the pods.
+		info.NodeSelector = maps.Clone(info.NodeSelector)
+		maps.DeleteFunc(info.NodeSelector, func(k, v string) bool {
+			appValue, found := spec.NodeSelector[k]
+			return found && appValue == v
+		})
+		meta := metav1.ObjectMeta{Labels: podSpec.Labels, Annotations: podSpec.Annotations}
+		tmpl := corev1.PodSpec{NodeSelector: podSpec.NodeSelector, Tolerations: podSpec.Tolerations}
+		if !podset.RestorePodSpec(&meta, &tmpl, info) {
+			continue
+		}
+		if err := j.setRole(role.field, &meta, &tmpl); err != nil {
+			continue
+		}
+		changed = true
+	}
+	return changed
+}
+
+// setRole writes the pod metadata and the scheduling constraints of the
+// driver or the executors to the application.
+func (j *SparkApplication) setRole(role string, meta *metav1.ObjectMeta, podSpec *corev1.PodSpec) error {
+	obj := j.Unstructured.Object
+	if err := utilunstructured.SetOrRemoveStringMap(obj, meta.Labels, "spec", role, "labels"); err != nil {
+		return err
+	}
+	if err := utilunstructured.SetOrRemoveStringMap(obj, meta.Annotations, "spec", role, "annotations"); err != nil {
+		return err
+	}
+	if err := utilunstructured.SetOrRemoveStringMap(obj, podSpec.NodeSelector, "spec", role, "nodeSelector"); err != nil {
+		return err
+	}
+	return utilunstructured.SetOrRemoveTolerations(obj, podSpec.Tolerations, "spec", role, "tolerations")
+}
+
+func (j *SparkApplication) Finished() (message string, success, finished bool) {
+	status := j.status()
+	switch status.ApplicationState.State {
+	case stateCompleted:
+		return "SparkApplication finished successfully", true, true
+	case stateFailed, stateSubmissionFailed:
+		return status.ApplicationState.ErrorMessage, false, true
+	}
+	return "", false, false
+}
+
+func (j *SparkApplication) PodsReady() bool {
+	return j.state() == stateRunning
+}
+
+// ReclaimablePods returns the driver and the executors once the driver
+// terminated, while the executors are torn down, unless the application is
+// restarted. The executors released by the dynamic allocation during the run
+// aren't reclaimed, as the reclaimed quota cannot be acquired again when the
+// application scales up.
+func (j *SparkApplication) ReclaimablePods() ([]kueue.ReclaimablePod, error) {
+	spec, err := j.spec()
+	if err != nil {
+		return nil, err
+	}
+	var terminating bool
+	switch j.state() {
+	case stateSucceeding:
+		terminating = spec.RestartPolicy.Type != restartPolicyAlways
+	case stateFailing:
+		terminating = spec.RestartPolicy.Type != restartPolicyAlways && spec.RestartPolicy.Type != restartPolicyOnFailure
+	}
+	if !terminating {
+		return nil, nil
+	}
+	return []kueue.ReclaimablePod{
+		{Name: driverPodSetName, Count: 1},
+		{Name: executorPodSetName, Count: spec.executors()},
+	}, nil
+}
+
+func (j *SparkApplication) ValidateOnCreate() (field.ErrorList, error) {
+	if jobframework.QueueName(j) == "" {
+		return nil, nil
+	}
+	spec, err := j.spec()
+	if err != nil {
+		return nil, err
+	}
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	for _, role := range roles {
+		allErrs = append(allErrs, validateSparkPodSpec(spec.role(role.name), specPath.Child(role.field))...)
+	}
+	if spec.MemoryOverheadFactor != nil {
+		if _, err := strconv.ParseFloat(*spec.MemoryOverheadFactor, 64); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("memoryOverheadFactor"), *spec.MemoryOverheadFactor, "must be a number"))
+		}
+	}
+	dynamicAllocationPath := specPath.Child("dynamicAllocation")
+	if spec.dynamicAllocationEnabled() && spec.DynamicAllocation.MaxExecutors == nil {
+		allErrs = append(allErrs, field.Required(dynamicAllocationPath.Child("maxExecutors"), "must be set for the dynamic allocation of the executors to be quota managed"))
+	}
+	if strVal, found := j.Object().GetAnnotations()[SparkMinExecutorsAnnotation]; found {
+		annotationPath := field.NewPath("metadata", "annotations").Key(SparkMinExecutorsAnnotation)
+		v, err := strconv.Atoi(strVal)
+		switch {
+		case err != nil || v < 1:
+			allErrs = append(allErrs, field.Invalid(annotationPath, strVal, "must be a positive integer"))
+		case int32(v) > spec.executors():
+			allErrs = append(allErrs, field.Invalid(annotationPath, strVal, "must be lower than or equal to the number of executors"))
+		case spec.dynamicAllocationEnabled() && int32(v) < ptr.Deref(spec.DynamicAllocation.MinExecutors, 0):
+			allErrs = append(allErrs, field.Invalid(annotationPath, strVal, "must be greater than or equal to spec.dynamicAllocation.minExecutors"))
+		case spec.dynamicAllocationEnabled() && int32(v) < ptr.Deref(spec.DynamicAllocation.InitialExecutors, 0):
+			allErrs = append(allErrs, field.Invalid(annotationPath, strVal, "must be greater than or equal to spec.dynamicAllocation.initialExecutors"))
+		}
+	}
+	return allErrs, nil
+}
+
+func validateSparkPodSpec(podSpec *sparkPodSpec, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if podSpec.CoreRequest != nil {
+		if _, err := resource.ParseQuantity(*podSpec.CoreRequest); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("coreRequest"), *podSpec.CoreRequest, err.Error()))
+		}
+	}
+	if podSpec.Memory != nil {
+		if _, err := parseSparkMemory(*podSpec.Memory); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("memory"), *podSpec.Memory, err.Error()))
+		}
+	}
+	if podSpec.MemoryOverhead != nil {
+		if _, err := parseSparkMemory(*podSpec.MemoryOverhead); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("memoryOverhead"), *podSpec.MemoryOverhead, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+func (j *SparkApplication) ValidateOnUpdate(_ jobframework.GenericJob) (field.ErrorList, error) {
+	return j.ValidateOnCreate()
+}
+
+func GetWorkloadNameForSparkApplication(name string, uid types.UID) string {
+	return jobframework.GetWorkloadNameForOwnerWithGVK(name, uid, gvk)
+}
@@ -0,0 +1,453 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/podset"
+	testingspark "sigs.k8s.io/kueue/pkg/util/testingjobs/sparkapplication"
+)
+
+func podTemplate(containerName string, requests corev1.ResourceList) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:      containerName,
+				Resources: corev1.ResourceRequirements{Requests: requests},
+			}},
+		},
+	}
+}
+
+func requests(cpu, memory string) corev1.ResourceList {
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(cpu),
+		corev1.ResourceMemory: resource.MustParse(memory),
+	}
+}
+
+func TestPodSets(t *testing.T) {
+	sidecar := map[string]any{
+		"name": "proxy",
+		"resources": map[string]any{
+			"requests": map[string]any{"cpu": "500m", "memory": "1Gi"},
+		},
+	}
+	initContainer := map[string]any{
+		"name": "init",
+		"resources": map[string]any{
+			"requests": map[string]any{"cpu": "2"},
+		},
+	}
+	cases := map[string]struct {
+		app                                      *unstructured.Unstructured
+		enableSparkApplicationSidecarsAccounting bool
+		wantPodSets                              []kueue.PodSet
+	}{
+		"driver and executors with the minimum memory overhead": {
+			app: testingspark.MakeSparkApplication("app", "ns").
+				ExecutorInstances(4).
+				Obj(),
+			wantPodSets: []kueue.PodSet{
+				{
+					Name:     driverPodSetName,
+					Template: podTemplate(driverContainerName, requests("1", "1408Mi")),
+					Count:    1,
+				},
+				{
+					Name:     executorPodSetName,
+					Template: podTemplate(executorContainerName, requests("1", "1408Mi")),
+					Count:    4,
+				},
+			},
+		},
+		"memory overhead factor of the non-JVM applications, coreRequest and GPUs": {
+			app: testingspark.MakeSparkApplication("app", "ns").
+				Type("Python").
+				Role("driver", "memory", "5g").
+				Role("executor", "memory", "10g").
+				Role("executor", "coreRequest", "1500m").
+				Role("executor", "gpu", map[string]any{"name": "nvidia.com/gpu", "quantity": int64(2)}).
+				Obj(),
+			wantPodSets: []kueue.PodSet{
+				{
+					Name:     driverPodSetName,
+					Template: podTemplate(driverContainerName, requests("1", "7Gi")),
+					Count:    1,
+				},
+				{
+					Name: executorPodSetName,
+					Template: podTemplate(executorContainerName, corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1500m"),
+						corev1.ResourceMemory: resource.MustParse("14Gi"),
+						"nvidia.com/gpu":      resource.MustParse("2"),
+					}),
+					Count: 1,
+				},
+			},
+		},
+		"explicit memory overhead and node selectors": {
+			app: testingspark.MakeSparkApplication("app", "ns").
+				NodeSelector("team", "data").
+				RoleNodeSelector("executor", "instance", "spot").
+				Role("driver", "memoryOverhead", "512m").
+				Obj(),
+			wantPodSets: []kueue.PodSet{
+				{
+					Name: driverPodSetName,
+					Template: func() corev1.PodTemplateSpec {
+						template := podTemplate(driverContainerName, requests("1", "1536Mi"))
+						template.Spec.NodeSelector = map[string]string{"team": "data"}
+						return template
+					}(),
+					Count: 1,
+				},
+				{
+					Name: executorPodSetName,
+					Template: func() corev1.PodTemplateSpec {
+						template := podTemplate(executorContainerName, requests("1", "1408Mi"))
+						template.Spec.NodeSelector = map[string]string{"team": "data", "instance": "spot"}
+						return template
+					}(),
+					Count: 1,
+				},
+			},
+		},
+		"dynamic allocation with partial admission": {
+			app: testingspark.MakeSparkApplication("app", "ns").
+				Annotation(SparkMinExecutorsAnnotation, "2").
+				DynamicAllocation(2, 10).
+				Obj(),
+			wantPodSets: []kueue.PodSet{
+				{
+					Name:     driverPodSetName,
+					Template: podTemplate(driverContainerName, requests("1", "1408Mi")),
+					Count:    1,
+				},
+				{
+					Name:     executorPodSetName,
+					Template: podTemplate(executorContainerName, requests("1", "1408Mi")),
+					Count:    10,
+					MinCount: ptr.To[int32](2),
+				},
+			},
+		},
+		"init containers and sidecars are not accounted by default": {
+			app: testingspark.MakeSparkApplication("app", "ns").
+				Role("executor", "initContainers", []any{initContainer}).
+				Role("executor", "sidecars", []any{sidecar}).
+				Obj(),
+			wantPodSets: []kueue.PodSet{
+				{
+					Name:     driverPodSetName,
+					Template: podTemplate(driverContainerName, requests("1", "1408Mi")),
+					Count:    1,
+				},
+				{
+					Name:     executorPodSetName,
+					Template: podTemplate(executorContainerName, requests("1", "1408Mi")),
+					Count:    1,
+				},
+			},
+		},
+		"init containers and sidecars with SparkApplicationSidecarsAccounting": {
+			app: testingspark.MakeSparkApplication("app", "ns").
+				Role("executor", "initContainers", []any{initContainer}).
+				Role("executor", "sidecars", []any{sidecar}).
+				Obj(),
+			enableSparkApplicationSidecarsAccounting: true,
+			wantPodSets: []kueue.PodSet{
+				{
+					Name:     driverPodSetName,
+					Template: podTemplate(driverContainerName, requests("1", "1408Mi")),
+					Count:    1,
+				},
+				{
+					Name: executorPodSetName,
+					Template: func() corev1.PodTemplateSpec {
+						template := podTemplate(executorContainerName, requests("1", "1408Mi"))
+						template.Spec.InitContainers = []corev1.Container{{
+							Name:      "init",
+							Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}},
+						}}
+						template.Spec.Containers = append(template.Spec.Containers, corev1.Container{
+							Name:      "proxy",
+							Resources: corev1.ResourceRequirements{Requests: requests("500m", "1Gi")},
+						})
+						return template
+					}(),
+					Count: 1,
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.SparkApplicationSidecarsAccounting, tc.enableSparkApplicationSidecarsAccounting)
+			gotPodSets, err := fromObject(tc.app).PodSets()
+			if err != nil {
+				t.Fatalf("PodSets() error = %v", err)
+			}
+			if diff := cmp.Diff(tc.wantPodSets, gotPodSets); diff != "" {
+				t.Errorf("Unexpected podSets (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseSparkMemory(t *testing.T) {
+	cases := map[string]struct {
+		value   string
+		want    resource.Quantity
+		wantErr bool
+	}{
+		"no unit":   {value: "512", want: resource.MustParse("512Mi")},
+		"megabytes": {value: "512m", want: resource.MustParse("512Mi")},
+		"gigabytes": {value: "2G", want: resource.MustParse("2Gi")},
+		"gb suffix": {value: "2gb", want: resource.MustParse("2Gi")},
+		"kilobytes": {value: "1024k", want: resource.MustParse("1Mi")},
+		"bytes":     {value: "1024b", want: resource.MustParse("1Ki")},
+		"invalid":   {value: "1.5g", wantErr: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := parseSparkMemory(tc.value)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("parseSparkMemory() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if !tc.wantErr && got.Cmp(tc.want) != 0 {
+				t.Errorf("parseSparkMemory() = %v, want %v", got.String(), tc.want.String())
+			}
+		})
+	}
+}
+
+func TestRunWithPodSetsInfoAndRestore(t *testing.T) {
+	cases := map[string]struct {
+		app  *testingspark.SparkApplicationWrapper
+		want *testingspark.SparkApplicationWrapper
+	}{
+		"static executors": {
+			app: testingspark.MakeSparkApplication("app", "ns").
+				Annotation(SparkMinExecutorsAnnotation, "1").
+				NodeSelector("team", "data").
+				ExecutorInstances(4),
+			want: testingspark.MakeSparkApplication("app", "ns").
+				Annotation(SparkMinExecutorsAnnotation, "1").
+				Suspend(false).
+				NodeSelector("team", "data").
+				RoleNodeSelector("driver", "instance", "on-demand").
+				RoleNodeSelector("executor", "instance", "spot").
+				ExecutorInstances(2),
+		},
+		"dynamic allocation": {
+			app: testingspark.MakeSparkApplication("app", "ns").
+				Annotation(SparkMinExecutorsAnnotation, "1").
+				DynamicAllocation(1, 4),
+			want: testingspark.MakeSparkApplication("app", "ns").
+				Annotation(SparkMinExecutorsAnnotation, "1").
+				Suspend(false).
+				RoleNodeSelector("driver", "instance", "on-demand").
+				RoleNodeSelector("executor", "instance", "spot").
+				DynamicAllocation(1, 2),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			original := tc.app.Obj()
+			app := fromObject(original.DeepCopy())
+			err := app.RunWithPodSetsInfo([]podset.PodSetInfo{
+				{Count: 1, NodeSelector: map[string]string{"instance": "on-demand"}},
+				{Count: 2, NodeSelector: map[string]string{"instance": "spot"}},
+			})
+			if err != nil {
+				t.Fatalf("RunWithPodSetsInfo() error = %v", err)
+			}
+			if diff := cmp.Diff(tc.want.Obj().Object, app.Unstructured.Object); diff != "" {
+				t.Errorf("Unexpected running application (-want,+got):\n%s", diff)
+			}
+
+			podSets, err := fromObject(original.DeepCopy()).PodSets()
+			if err != nil {
+				t.Fatalf("PodSets() error = %v", err)
+			}
+			app.Suspend()
+			if !app.RestorePodSetsInfo([]podset.PodSetInfo{podset.FromPodSet(&podSets[0]), podset.FromPodSet(&podSets[1])}) {
+				t.Errorf("RestorePodSetsInfo() returned no change")
+			}
+			if diff := cmp.Diff(original.Object, app.Unstructured.Object); diff != "" {
+				t.Errorf("Unexpected restored application (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	cases := map[string]struct {
+		app                 *unstructured.Unstructured
+		wantActive          bool
+		wantPodsReady       bool
+		wantFinished        bool
+		wantSuccess         bool
+		wantMessage         string
+		wantReclaimablePods []kueue.ReclaimablePod
+	}{
+		"new": {
+			app: testingspark.MakeSparkApplication("app", "ns").Obj(),
+		},
+		"running": {
+			app:           testingspark.MakeSparkApplication("app", "ns").State("RUNNING").Obj(),
+			wantActive:    true,
+			wantPodsReady: true,
+		},
+		"suspended": {
+			app: testingspark.MakeSparkApplication("app", "ns").State("SUSPENDED").Obj(),
+		},
+		"succeeding": {
+			app:        testingspark.MakeSparkApplication("app", "ns").ExecutorInstances(3).State("SUCCEEDING").Obj(),
+			wantActive: true,
+			wantReclaimablePods: []kueue.ReclaimablePod{
+				{Name: driverPodSetName, Count: 1},
+				{Name: executorPodSetName, Count: 3},
+			},
+		},
+		"failing with the OnFailure restart policy": {
+			app:        testingspark.MakeSparkApplication("app", "ns").RestartPolicy("OnFailure").State("FAILING").Obj(),
+			wantActive: true,
+		},
+		"succeeding with the Always restart policy": {
+			app:        testingspark.MakeSparkApplication("app", "ns").RestartPolicy("Always").State("SUCCEEDING").Obj(),
+			wantActive: true,
+		},
+		"completed": {
+			app:          testingspark.MakeSparkApplication("app", "ns").State("COMPLETED").Obj(),
+			wantFinished: true,
+			wantSuccess:  true,
+			wantMessage:  "SparkApplication finished successfully",
+		},
+		"submission failed": {
+			app:          testingspark.MakeSparkApplication("app", "ns").State("SUBMISSION_FAILED").ErrorMessage("failed to run spark-submit").Obj(),
+			wantFinished: true,
+			wantMessage:  "failed to run spark-submit",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			app := fromObject(tc.app)
+			if got := app.IsActive(); got != tc.wantActive {
+				t.Errorf("IsActive() = %v, want %v", got, tc.wantActive)
+			}
+			if got := app.PodsReady(); got != tc.wantPodsReady {
+				t.Errorf("PodsReady() = %v, want %v", got, tc.wantPodsReady)
+			}
+			message, success, finished := app.Finished()
+			if message != tc.wantMessage || success != tc.wantSuccess || finished != tc.wantFinished {
+				t.Errorf("Finished() = (%q, %v, %v), want (%q, %v, %v)", message, success, finished, tc.wantMessage, tc.wantSuccess, tc.wantFinished)
+			}
+			gotReclaimablePods, err := app.ReclaimablePods()
+			if err != nil {
+				t.Fatalf("ReclaimablePods() error = %v", err)
+			}
+			if diff := cmp.Diff(tc.wantReclaimablePods, gotReclaimablePods); diff != "" {
+				t.Errorf("Unexpected reclaimable pods (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateOnCreate(t *testing.T) {
+	annotationPath := field.NewPath("metadata", "annotations").Key(SparkMinExecutorsAnnotation)
+	cases := map[string]struct {
+		app      *unstructured.Unstructured
+		wantErrs field.ErrorList
+	}{
+		"valid": {
+			app: testingspark.MakeSparkApplication("app", "ns").
+				Queue("queue").
+				Annotation(SparkMinExecutorsAnnotation, "2").
+				DynamicAllocation(1, 4).
+				Obj(),
+		},
+		"not managed": {
+			app: testingspark.MakeSparkApplication("app", "ns").
+				Role("executor", "memory", "lots").
+				Obj(),
+		},
+		"invalid memory and coreRequest": {
+			app: testingspark.MakeSparkApplication("app", "ns").
+				Queue("queue").
+				Role("driver", "coreRequest", "one").
+				Role("executor", "memory", "lots").
+				Obj(),
+			wantErrs: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "driver", "coreRequest"), "one", ""),
+				field.Invalid(field.NewPath("spec", "executor", "memory"), "lots", ""),
+			},
+		},
+		"dynamic allocation without maxExecutors": {
+			app: testingspark.MakeSparkApplication("app", "ns").
+				Queue("queue").
+				Role("dynamicAllocation", "enabled", true).
+				Obj(),
+			wantErrs: field.ErrorList{
+				field.Required(field.NewPath("spec", "dynamicAllocation", "maxExecutors"), ""),
+			},
+		},
+		"min executors greater than the executors": {
+			app: testingspark.MakeSparkApplication("app", "ns").
+				Queue("queue").
+				Annotation(SparkMinExecutorsAnnotation, "3").
+				ExecutorInstances(2).
+				Obj(),
+			wantErrs: field.ErrorList{
+				field.Invalid(annotationPath, "3", ""),
+			},
+		},
+		"min executors lower than the minExecutors of the dynamic allocation": {
+			app: testingspark.MakeSparkApplication("app", "ns").
+				Queue("queue").
+				Annotation(SparkMinExecutorsAnnotation, "1").
+				DynamicAllocation(2, 4).
+				Obj(),
+			wantErrs: field.ErrorList{
+				field.Invalid(annotationPath, "1", ""),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotErrs, err := fromObject(tc.app).ValidateOnCreate()
+			if err != nil {
+				t.Fatalf("ValidateOnCreate() error = %v", err)
+			}
+			if diff := cmp.Diff(tc.wantErrs, gotErrs, cmpopts.IgnoreFields(field.Error{}, "Detail")); diff != "" {
+				t.Errorf("Unexpected validation errors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,14 +18,21 @@ package statefulset
 
 import (
 	"context"
+	"strconv"
 
 	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/podset"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 )
 
 var (
@@ -34,6 +41,16 @@ var (
 
 const (
 	FrameworkName = "statefulset"
+
+	// BatchAnnotation, when set to "true", makes Kueue manage the StatefulSet
+	// as a fixed-size batch workload: the whole StatefulSet is queued as one
+	// Workload and suspended by scaling it to zero replicas, instead of
+	// gating its pods.
+	BatchAnnotation = "kueue.x-k8s.io/statefulset-batch"
+
+	// DesiredReplicasAnnotation records the replicas of a batch StatefulSet
+	// while it is suspended by Kueue.
+	DesiredReplicasAnnotation = "kueue.x-k8s.io/statefulset-desired-replicas"
 )
 
 func init() {
@@ -62,6 +79,93 @@ func (d *StatefulSet) GVK() schema.GroupVersionKind {
 	return gvk
 }
 
-func SetupIndexes(context.Context, client.FieldIndexer) error {
-	return nil
+// IsBatch returns whether the StatefulSet is managed as a batch workload.
+func IsBatch(obj metav1.Object) bool {
+	return obj.GetAnnotations()[BatchAnnotation] == "true"
+}
+
+var _ jobframework.GenericJob = (*StatefulSet)(nil)
+var _ jobframework.JobWithPriorityClass = (*StatefulSet)(nil)
+
+func (d *StatefulSet) IsSuspended() bool {
+	return ptr.Deref(d.Spec.Replicas, 1) == 0
+}
+
+func (d *StatefulSet) IsActive() bool {
+	return d.Status.Replicas > 0
+}
+
+func (d *StatefulSet) Suspend() {
+	if replicas := ptr.Deref(d.Spec.Replicas, 1); replicas != 0 {
+		if d.Annotations == nil {
+			d.Annotations = make(map[string]string, 1)
+		}
+		d.Annotations[DesiredReplicasAnnotation] = strconv.Itoa(int(replicas))
+	}
+	d.Spec.Replicas = ptr.To[int32](0)
+}
+
+// desiredReplicas returns the replicas of the StatefulSet when it runs.
+func (d *StatefulSet) desiredReplicas() int32 {
+	if !d.IsSuspended() {
+		return ptr.Deref(d.Spec.Replicas, 1)
+	}
+	replicas, err := strconv.ParseInt(d.Annotations[DesiredReplicasAnnotation], 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(replicas)
+}
+
+func (d *StatefulSet) PodSets() ([]kueue.PodSet, error) {
+	podSet := kueue.PodSet{
+		Name:     kueue.DefaultPodSetName,
+		Template: *d.Spec.Template.DeepCopy(),
+		Count:    d.desiredReplicas(),
+	}
+	if features.Enabled(features.TopologyAwareScheduling) {
+		topologyRequest, err := utiltas.NewPodSetTopologyRequest(
+			&d.Spec.Template.ObjectMeta).PodIndexLabel(
+			ptr.To(appsv1.PodIndexLabel)).Build()
+		if err != nil {
+			return nil, err
+		}
+		podSet.TopologyRequest = topologyRequest
+	}
+	return []kueue.PodSet{podSet}, nil
+}
+
+func (d *StatefulSet) RunWithPodSetsInfo(podSetsInfo []podset.PodSetInfo) error {
+	if len(podSetsInfo) != 1 {
+		return podset.BadPodSetsInfoLenError(1, len(podSetsInfo))
+	}
+	info := podSetsInfo[0]
+	d.Spec.Replicas = ptr.To(info.Count)
+	delete(d.Annotations, DesiredReplicasAnnotation)
+	return podset.Merge(&d.Spec.Template.ObjectMeta, &d.Spec.Template.Spec, info)
+}
+
+func (d *StatefulSet) RestorePodSetsInfo(podSetsInfo []podset.PodSetInfo) bool {
+	if len(podSetsInfo) == 0 {
+		return false
+	}
+	return podset.RestorePodSpec(&d.Spec.Template.ObjectMeta, &d.Spec.Template.Spec, podSetsInfo[0])
+}
+
+// Finished always returns false, as the pods of a StatefulSet are restarted
+// until the StatefulSet is deleted.
+func (d *StatefulSet) Finished() (message string, success, finished bool) {
+	return "", false, false
+}
+
+func (d *StatefulSet) PodsReady() bool {
+	return d.Status.ReadyReplicas >= d.desiredReplicas()
+}
+
+func (d *StatefulSet) PriorityClass() string {
+	return d.Spec.Template.Spec.PriorityClassName
+}
+
+func SetupIndexes(ctx context.Context, indexer client.FieldIndexer) error {
+	return jobframework.SetupWorkloadOwnerIndex(ctx, indexer, gvk)
 }
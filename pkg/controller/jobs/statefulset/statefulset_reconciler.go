@@ -37,6 +37,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	podcontroller "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
 	clientutil "sigs.k8s.io/kueue/pkg/util/client"
@@ -48,7 +49,8 @@ const (
 	podBatchPeriod = time.Second
 )
 
-// +kubebuilder:rbac:groups="apps",resources=statefulsets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="apps",resources=statefulsets,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="apps",resources=statefulsets/finalizers,verbs=get;update
 
 var (
 	_ jobframework.JobReconcilerInterface = (*Reconciler)(nil)
@@ -56,6 +58,7 @@ var (
 
 type Reconciler struct {
 	client                       client.Client
+	jr                           *jobframework.JobReconciler
 	log                          logr.Logger
 	manageJobsWithoutQueueName   bool
 	managedJobsNamespaceSelector labels.Selector
@@ -65,6 +68,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	log := ctrl.LoggerFrom(ctx)
 	log.V(2).Info("Reconcile StatefulSet")
 
+	sts := &appsv1.StatefulSet{}
+	if err := r.client.Get(ctx, req.NamespacedName, sts); client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, err
+	} else if err == nil && IsBatch(sts) {
+		return r.jr.ReconcileGenericJob(ctx, req, &StatefulSet{})
+	}
+
 	err := r.fetchAndFinalizePods(ctx, req)
 	return ctrl.Result{}, err
 }
@@ -144,15 +154,17 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&appsv1.StatefulSet{}).
 		WithEventFilter(r).
+		Owns(&kueue.Workload{}).
 		Watches(&corev1.Pod{}, &podHandler{}).
 		Complete(r)
 }
 
-func NewReconciler(client client.Client, _ record.EventRecorder, opts ...jobframework.Option) jobframework.JobReconcilerInterface {
+func NewReconciler(client client.Client, record record.EventRecorder, opts ...jobframework.Option) jobframework.JobReconcilerInterface {
 	options := jobframework.ProcessOptions(opts...)
 
 	return &Reconciler{
 		client:                       client,
+		jr:                           jobframework.NewReconciler(client, record, opts...),
 		log:                          ctrl.Log.WithName("statefulset-reconciler"),
 		manageJobsWithoutQueueName:   options.ManageJobsWithoutQueueName,
 		managedJobsNamespaceSelector: options.ManagedJobsNamespaceSelector,
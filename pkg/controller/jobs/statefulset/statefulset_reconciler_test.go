@@ -24,9 +24,16 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	testingjobspod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
@@ -38,6 +45,14 @@ var (
 		cmpopts.EquateEmpty(),
 		cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion"),
 	}
+	workloadCmpOpts = cmp.Options{
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(kueue.Workload{}, "TypeMeta"),
+		cmpopts.IgnoreFields(metav1.ObjectMeta{}, "Name", "Labels", "ResourceVersion", "OwnerReferences", "Finalizers"),
+		cmpopts.IgnoreFields(kueue.WorkloadSpec{}, "Priority"),
+		cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
+		cmpopts.IgnoreFields(kueue.PodSet{}, "Template"),
+	}
 )
 
 func TestReconciler(t *testing.T) {
@@ -218,3 +233,125 @@ func TestReconciler(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchReconciler(t *testing.T) {
+	testNamespace := utiltesting.MakeNamespaceWrapper("ns").Label(corev1.LabelMetadataName, "ns").Obj()
+	cases := map[string]struct {
+		statefulSet     *appsv1.StatefulSet
+		workloads       []kueue.Workload
+		wantStatefulSet *appsv1.StatefulSet
+		wantWorkloads   []kueue.Workload
+	}{
+		"workload is created with the desired replicas": {
+			statefulSet: statefulsettesting.MakeStatefulSet("sts", "ns").
+				Annotation(BatchAnnotation, "true").
+				Annotation(DesiredReplicasAnnotation, "3").
+				Replicas(0).
+				Queue("lq").
+				Obj(),
+			wantStatefulSet: statefulsettesting.MakeStatefulSet("sts", "ns").
+				Annotation(BatchAnnotation, "true").
+				Annotation(DesiredReplicasAnnotation, "3").
+				Replicas(0).
+				Queue("lq").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("sts", "ns").
+					Queue("lq").
+					PodSets(
+						*utiltesting.MakePodSet(kueue.DefaultPodSetName, 3).
+							PodIndexLabel(ptr.To(appsv1.PodIndexLabel)).
+							Obj(),
+					).
+					Obj(),
+			},
+		},
+		"statefulset is scaled up when the workload is admitted": {
+			statefulSet: statefulsettesting.MakeStatefulSet("sts", "ns").
+				Annotation(BatchAnnotation, "true").
+				Annotation(DesiredReplicasAnnotation, "3").
+				Replicas(0).
+				Queue("lq").
+				Obj(),
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("sts", "ns").
+					Queue("lq").
+					PodSets(
+						*utiltesting.MakePodSet(kueue.DefaultPodSetName, 3).
+							Labels(map[string]string{"app": "sts-pod"}).
+							PodSpec(statefulsettesting.MakeStatefulSet("sts", "ns").Obj().Spec.Template.Spec).
+							PodIndexLabel(ptr.To(appsv1.PodIndexLabel)).
+							Obj(),
+					).
+					ReserveQuota(utiltesting.MakeAdmission("cq").PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Count(3).Obj()).Obj()).
+					Admitted(true).
+					Obj(),
+			},
+			wantStatefulSet: statefulsettesting.MakeStatefulSet("sts", "ns").
+				Annotation(BatchAnnotation, "true").
+				Replicas(3).
+				Queue("lq").
+				PodTemplateSpecLabel(controllerconstants.PodSetLabel, string(kueue.DefaultPodSetName)).
+				PodTemplateSpecAnnotation(kueue.WorkloadAnnotation, "sts").
+				Obj(),
+			wantWorkloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("sts", "ns").
+					Queue("lq").
+					PodSets(
+						*utiltesting.MakePodSet(kueue.DefaultPodSetName, 3).
+							PodIndexLabel(ptr.To(appsv1.PodIndexLabel)).
+							Obj(),
+					).
+					ReserveQuota(utiltesting.MakeAdmission("cq").PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Count(3).Obj()).Obj()).
+					Admitted(true).
+					Obj(),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			clientBuilder := utiltesting.NewClientBuilder()
+			if err := SetupIndexes(ctx, utiltesting.AsIndexer(clientBuilder)); err != nil {
+				t.Fatalf("Failed to setup indexes: %v", err)
+			}
+			clientBuilder = clientBuilder.WithObjects(tc.statefulSet, testNamespace)
+			for i := range tc.workloads {
+				clientBuilder = clientBuilder.WithStatusSubresource(&tc.workloads[i])
+			}
+			kClient := clientBuilder.Build()
+			for i := range tc.workloads {
+				if err := ctrl.SetControllerReference(tc.statefulSet, &tc.workloads[i], kClient.Scheme()); err != nil {
+					t.Fatalf("Could not set controller reference: %v", err)
+				}
+				if err := kClient.Create(ctx, &tc.workloads[i]); err != nil {
+					t.Fatalf("Could not create Workload: %v", err)
+				}
+			}
+
+			recorder := record.NewBroadcaster().NewRecorder(kClient.Scheme(), corev1.EventSource{Component: "test"})
+			reconciler := NewReconciler(kClient, recorder, jobframework.WithManagedJobsNamespaceSelector(labels.Everything()))
+
+			stsKey := client.ObjectKeyFromObject(tc.statefulSet)
+			if _, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: stsKey}); err != nil {
+				t.Errorf("Reconcile returned error: %v", err)
+			}
+
+			gotStatefulSet := &appsv1.StatefulSet{}
+			if err := kClient.Get(ctx, stsKey, gotStatefulSet); err != nil {
+				t.Fatalf("Could not get StatefulSet after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantStatefulSet, gotStatefulSet, baseCmpOpts...); diff != "" {
+				t.Errorf("StatefulSet after reconcile (-want,+got):\n%s", diff)
+			}
+
+			var gotWorkloads kueue.WorkloadList
+			if err := kClient.List(ctx, &gotWorkloads); err != nil {
+				t.Fatalf("Could not list Workloads after reconcile: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantWorkloads, gotWorkloads.Items, workloadCmpOpts...); diff != "" {
+				t.Errorf("Workloads after reconcile (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	admissionv1 "k8s.io/api/admission/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/labels"
@@ -37,6 +38,7 @@ import (
 	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/jobframework"
 	podconstants "sigs.k8s.io/kueue/pkg/controller/jobs/pod/constants"
+	utilwebhook "sigs.k8s.io/kueue/pkg/util/webhook"
 )
 
 type Webhook struct {
@@ -71,6 +73,21 @@ func (wh *Webhook) Default(ctx context.Context, obj runtime.Object) error {
 	log.V(5).Info("Propagating queue-name")
 
 	jobframework.ApplyDefaultLocalQueue(ss.Object(), wh.queues.DefaultLocalQueueExist)
+	if err := utilwebhook.ApplyDefaultSubmitter(ctx, ss.Object()); err != nil {
+		return err
+	}
+	if IsBatch(ss) {
+		req, err := admission.RequestFromContext(ctx)
+		if err != nil {
+			return err
+		}
+		// A batch StatefulSet is suspended on creation only, since it is
+		// later scaled up by Kueue when its Workload is admitted.
+		if req.Operation != admissionv1.Create {
+			return nil
+		}
+		return jobframework.ApplyDefaultForSuspend(ctx, ss, wh.client, wh.manageJobsWithoutQueueName, wh.managedJobsNamespaceSelector)
+	}
 	suspend, err := jobframework.WorkloadShouldBeSuspended(ctx, ss.Object(), wh.client, wh.manageJobsWithoutQueueName, wh.managedJobsNamespaceSelector)
 	if err != nil {
 		return err
@@ -111,6 +128,10 @@ func (wh *Webhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warn
 	log := ctrl.LoggerFrom(ctx).WithName("statefulset-webhook")
 	log.V(5).Info("Validating create")
 
+	if IsBatch(sts) {
+		return nil, jobframework.ValidateJobOnCreate(sts).ToAggregate()
+	}
+
 	allErrs := jobframework.ValidateQueueName(sts.Object())
 
 	return nil, allErrs.ToAggregate()
@@ -118,6 +139,7 @@ func (wh *Webhook) ValidateCreate(ctx context.Context, obj runtime.Object) (warn
 
 var (
 	labelsPath                 = field.NewPath("metadata", "labels")
+	annotationsPath            = field.NewPath("metadata", "annotations")
 	queueNameLabelPath         = labelsPath.Key(controllerconstants.QueueLabel)
 	priorityClassNameLabelPath = labelsPath.Key(controllerconstants.WorkloadPriorityClassLabel)
 	specPath                   = field.NewPath("spec")
@@ -133,6 +155,10 @@ func (wh *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Ob
 	log := ctrl.LoggerFrom(ctx).WithName("statefulset-webhook")
 	log.V(5).Info("Validating update")
 
+	if IsBatch(oldStatefulSet) || IsBatch(newStatefulSet) {
+		return nil, wh.validateBatchUpdate(oldStatefulSet, newStatefulSet).ToAggregate()
+	}
+
 	oldQueueName := jobframework.QueueNameForObject(oldStatefulSet.Object())
 	newQueueName := jobframework.QueueNameForObject(newStatefulSet.Object())
 
@@ -183,6 +209,22 @@ func (wh *Webhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Ob
 	return warnings, allErrs.ToAggregate()
 }
 
+func (wh *Webhook) validateBatchUpdate(oldStatefulSet, newStatefulSet *StatefulSet) field.ErrorList {
+	allErrs := apivalidation.ValidateImmutableField(IsBatch(newStatefulSet), IsBatch(oldStatefulSet), annotationsPath.Key(BatchAnnotation))
+	allErrs = append(allErrs, jobframework.ValidateJobOnUpdate(oldStatefulSet, newStatefulSet, wh.queues.DefaultLocalQueueExist)...)
+
+	// The replicas of a running batch StatefulSet are admitted by Kueue,
+	// so they can only be scaled down to zero, when Kueue suspends it.
+	if !oldStatefulSet.IsSuspended() && !newStatefulSet.IsSuspended() {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(
+			newStatefulSet.Spec.Replicas,
+			oldStatefulSet.Spec.Replicas,
+			replicasPath,
+		)...)
+	}
+	return allErrs
+}
+
 func (wh *Webhook) ValidateDelete(context.Context, runtime.Object) (warnings admission.Warnings, err error) {
 	return nil, nil
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	awv1beta2 "github.com/project-codeflare/appwrapper/api/v1beta2"
+	admissionv1 "k8s.io/api/admission/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -206,6 +207,64 @@ func TestDefault(t *testing.T) {
 	}
 }
 
+func TestDefaultBatch(t *testing.T) {
+	testCases := map[string]struct {
+		operation   admissionv1.Operation
+		statefulset *appsv1.StatefulSet
+		want        *appsv1.StatefulSet
+	}{
+		"batch statefulset is suspended on create": {
+			operation: admissionv1.Create,
+			statefulset: testingstatefulset.MakeStatefulSet("test-pod", "default").
+				Annotation(BatchAnnotation, "true").
+				Replicas(3).
+				Queue("test-queue").
+				Obj(),
+			want: testingstatefulset.MakeStatefulSet("test-pod", "default").
+				Annotation(BatchAnnotation, "true").
+				Annotation(DesiredReplicasAnnotation, "3").
+				Replicas(0).
+				Queue("test-queue").
+				Obj(),
+		},
+		"batch statefulset is not suspended on update": {
+			operation: admissionv1.Update,
+			statefulset: testingstatefulset.MakeStatefulSet("test-pod", "default").
+				Annotation(BatchAnnotation, "true").
+				Replicas(3).
+				Queue("test-queue").
+				Obj(),
+			want: testingstatefulset.MakeStatefulSet("test-pod", "default").
+				Annotation(BatchAnnotation, "true").
+				Replicas(3).
+				Queue("test-queue").
+				Obj(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			ctx = admission.NewContextWithRequest(ctx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{Operation: tc.operation},
+			})
+
+			cli := utiltesting.NewClientBuilder().Build()
+			w := &Webhook{
+				client: cli,
+				queues: qcache.NewManager(cli, schdcache.New(cli)),
+			}
+
+			if err := w.Default(ctx, tc.statefulset); err != nil {
+				t.Errorf("failed to set defaults for v1/statefulset: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.statefulset); len(diff) != 0 {
+				t.Errorf("Default() mismatch (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestValidateCreate(t *testing.T) {
 	testCases := map[string]struct {
 		sts       *appsv1.StatefulSet
@@ -231,6 +290,18 @@ func TestValidateCreate(t *testing.T) {
 				},
 			}.ToAggregate(),
 		},
+		"batch statefulset with invalid queue name": {
+			sts: testingstatefulset.MakeStatefulSet("test-pod", "").
+				Annotation(BatchAnnotation, "true").
+				Queue("test/queue").
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "metadata.labels[kueue.x-k8s.io/queue-name]",
+				},
+			}.ToAggregate(),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -666,6 +737,88 @@ func TestValidateUpdate(t *testing.T) {
 				},
 			}.ToAggregate(),
 		},
+		"batch: suspend a running statefulset": {
+			oldObj: testingstatefulset.MakeStatefulSet("test-sts", "test-ns").
+				Annotation(BatchAnnotation, "true").
+				Queue("test-queue").
+				Replicas(3).
+				Obj(),
+			newObj: testingstatefulset.MakeStatefulSet("test-sts", "test-ns").
+				Annotation(BatchAnnotation, "true").
+				Annotation(DesiredReplicasAnnotation, "3").
+				Queue("test-queue").
+				Replicas(0).
+				Obj(),
+		},
+		"batch: scale a running statefulset": {
+			oldObj: testingstatefulset.MakeStatefulSet("test-sts", "test-ns").
+				Annotation(BatchAnnotation, "true").
+				Queue("test-queue").
+				Replicas(3).
+				Obj(),
+			newObj: testingstatefulset.MakeStatefulSet("test-sts", "test-ns").
+				Annotation(BatchAnnotation, "true").
+				Queue("test-queue").
+				Replicas(4).
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: replicasPath.String(),
+				},
+			}.ToAggregate(),
+		},
+		"batch: change the queue of a suspended statefulset": {
+			oldObj: testingstatefulset.MakeStatefulSet("test-sts", "test-ns").
+				Annotation(BatchAnnotation, "true").
+				Annotation(DesiredReplicasAnnotation, "3").
+				Queue("test-queue").
+				Replicas(0).
+				Obj(),
+			newObj: testingstatefulset.MakeStatefulSet("test-sts", "test-ns").
+				Annotation(BatchAnnotation, "true").
+				Annotation(DesiredReplicasAnnotation, "3").
+				Queue("test-queue-new").
+				Replicas(0).
+				Obj(),
+		},
+		"batch: change the queue of a running statefulset": {
+			oldObj: testingstatefulset.MakeStatefulSet("test-sts", "test-ns").
+				Annotation(BatchAnnotation, "true").
+				Queue("test-queue").
+				Replicas(3).
+				Obj(),
+			newObj: testingstatefulset.MakeStatefulSet("test-sts", "test-ns").
+				Annotation(BatchAnnotation, "true").
+				Queue("test-queue-new").
+				Replicas(3).
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: queueNameLabelPath.String(),
+				},
+			}.ToAggregate(),
+		},
+		"batch: remove the batch annotation": {
+			oldObj: testingstatefulset.MakeStatefulSet("test-sts", "test-ns").
+				Annotation(BatchAnnotation, "true").
+				Annotation(DesiredReplicasAnnotation, "3").
+				Queue("test-queue").
+				Replicas(0).
+				Obj(),
+			newObj: testingstatefulset.MakeStatefulSet("test-sts", "test-ns").
+				Annotation(DesiredReplicasAnnotation, "3").
+				Queue("test-queue").
+				Replicas(0).
+				Obj(),
+			wantErr: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: annotationsPath.Key(BatchAnnotation).String(),
+				},
+			}.ToAggregate(),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -23,6 +23,7 @@ import (
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
 	"sigs.k8s.io/kueue/pkg/features"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 )
 
 func SetupControllers(mgr ctrl.Manager, queues *qcache.Manager, cache *schdcache.Cache, cfg *configapi.Configuration) (string, error) {
@@ -35,7 +36,11 @@ func SetupControllers(mgr ctrl.Manager, queues *qcache.Manager, cache *schdcache
 	if ctrlName, err := rfRec.setupWithManager(mgr, cache, cfg); err != nil {
 		return ctrlName, err
 	}
-	topologyUngater := newTopologyUngater(mgr.GetClient())
+	var hostScorer utiltas.HostScorer
+	if features.Enabled(features.TASHostScorer) {
+		hostScorer = cache.HostScorer()
+	}
+	topologyUngater := newTopologyUngater(mgr.GetClient(), hostScorer)
 	if ctrlName, err := topologyUngater.setupWithManager(mgr, cfg); err != nil {
 		return ctrlName, err
 	}
@@ -45,5 +50,11 @@ func SetupControllers(mgr ctrl.Manager, queues *qcache.Manager, cache *schdcache
 			return ctrlName, err
 		}
 	}
+	if features.Enabled(features.TASUnhealthyDomainExclusion) {
+		domainHealthReconciler := newDomainHealthReconciler(mgr.GetClient(), recorder)
+		if ctrlName, err := domainHealthReconciler.setupWithManager(mgr, cfg); err != nil {
+			return ctrlName, err
+		}
+	}
 	return "", nil
 }
@@ -0,0 +1,225 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/core"
+	"sigs.k8s.io/kueue/pkg/controller/tas/indexer"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const unhealthyDomainEvictionMessageFormat = "Workload eviction triggered because its pods are placed in the unhealthy topology domains: %s"
+
+// domainHealthReconciler reconciles the Topologies with the Evict
+// domainHealth eviction policy, to evict the Workloads with pods placed in
+// the unhealthy domains.
+type domainHealthReconciler struct {
+	client   client.Client
+	clock    clock.Clock
+	recorder record.EventRecorder
+}
+
+var _ reconcile.Reconciler = (*domainHealthReconciler)(nil)
+
+func newDomainHealthReconciler(client client.Client, recorder record.EventRecorder) *domainHealthReconciler {
+	return &domainHealthReconciler{
+		client:   client,
+		clock:    clock.RealClock{},
+		recorder: recorder,
+	}
+}
+
+func (r *domainHealthReconciler) setupWithManager(mgr ctrl.Manager, cfg *config.Configuration) (string, error) {
+	return TASDomainHealthController, builder.ControllerManagedBy(mgr).
+		Named("tas_domain_health_controller").
+		For(&kueue.Topology{}).
+		Watches(&corev1.Node{}, handler.EnqueueRequestsFromMapFunc(r.topologiesToEvict)).
+		WithOptions(controller.Options{
+			NeedLeaderElection:      ptr.To(false),
+			MaxConcurrentReconciles: mgr.GetControllerOptions().GroupKindConcurrency[kueue.GroupVersion.WithKind("Topology").GroupKind().String()],
+		}).
+		Complete(core.WithLeadingManager(mgr, r, &kueue.Topology{}, cfg))
+}
+
+// topologiesToEvict returns the requests for the Topologies evicting the
+// Workloads from the unhealthy domains, when a Node changes.
+func (r *domainHealthReconciler) topologiesToEvict(ctx context.Context, _ client.Object) []reconcile.Request {
+	var topologies kueue.TopologyList
+	if err := r.client.List(ctx, &topologies); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list the Topologies")
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range topologies.Items {
+		if evictsFromUnhealthyDomains(&topologies.Items[i]) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: topologies.Items[i].Name}})
+		}
+	}
+	return requests
+}
+
+func evictsFromUnhealthyDomains(topology *kueue.Topology) bool {
+	health := topology.Spec.DomainHealth
+	return health != nil && ptr.Deref(health.EvictionPolicy, kueue.TopologyDomainEvictionPolicyNever) == kueue.TopologyDomainEvictionPolicyEvict
+}
+
+//+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=topologies,verbs=get;list;watch
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=resourceflavors,verbs=get;list;watch
+//+kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch;patch
+
+func (r *domainHealthReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	topology := &kueue.Topology{}
+	if err := r.client.Get(ctx, req.NamespacedName, topology); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !evictsFromUnhealthyDomains(topology) {
+		return ctrl.Result{}, nil
+	}
+	log := ctrl.LoggerFrom(ctx)
+	log.V(2).Info("Reconcile Topology domain health")
+
+	flavors := &kueue.ResourceFlavorList{}
+	if err := r.client.List(ctx, flavors, client.MatchingFields{indexer.ResourceFlavorTopologyNameKey: topology.Name}); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list the ResourceFlavors of the topology: %w", err)
+	}
+	levels := utiltas.Levels(topology)
+	domainHealth := utiltas.NewDomainHealth(levels, topology.Spec.DomainHealth)
+	unhealthyPerFlavor := make(map[kueue.ResourceFlavorReference]*unhealthyDomains, len(flavors.Items))
+	for _, rf := range flavors.Items {
+		nodes := &corev1.NodeList{}
+		if err := r.client.List(ctx, nodes, client.MatchingLabels(rf.Spec.NodeLabels), client.HasLabels(levels)); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to list the nodes of the ResourceFlavor %s: %w", rf.Name, err)
+		}
+		if unhealthy := newUnhealthyDomains(domainHealth, nodes.Items); unhealthy != nil {
+			unhealthyPerFlavor[kueue.ResourceFlavorReference(rf.Name)] = unhealthy
+		}
+	}
+	if len(unhealthyPerFlavor) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	workloads := &kueue.WorkloadList{}
+	if err := r.client.List(ctx, workloads); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list workloads: %w", err)
+	}
+	var errs []error
+	for i := range workloads.Items {
+		wl := &workloads.Items[i]
+		if !isAdmittedByTAS(wl) || workload.IsEvicted(wl) {
+			continue
+		}
+		domains := placedInUnhealthyDomains(wl, unhealthyPerFlavor)
+		if len(domains) == 0 {
+			continue
+		}
+		log.V(3).Info("Evicting workload placed in unhealthy topology domains", "workload", klog.KObj(wl), "domains", domains)
+		msg := fmt.Sprintf(unhealthyDomainEvictionMessageFormat, strings.Join(domains, ", "))
+		if err := workload.Evict(ctx, r.client, r.recorder, wl, kueue.WorkloadEvictedDueToUnhealthyTopologyDomain, msg, "", r.clock); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return ctrl.Result{}, errors.Join(errs...)
+}
+
+// unhealthyDomains holds the unhealthy domains of the nodes of a flavor, and
+// maps the domains of the topology assignments to them.
+type unhealthyDomains struct {
+	health    *utiltas.DomainHealth
+	nodes     []corev1.Node
+	unhealthy sets.Set[utiltas.TopologyDomainID]
+	// domainsPerLevels maps the domains of the nodes, at the levels of the
+	// topology assignments, to their domains at the level of the domain
+	// health.
+	domainsPerLevels map[string]map[utiltas.TopologyDomainID]utiltas.TopologyDomainID
+}
+
+func newUnhealthyDomains(health *utiltas.DomainHealth, nodes []corev1.Node) *unhealthyDomains {
+	unhealthy := health.UnhealthyDomains(nodes)
+	if len(unhealthy) == 0 {
+		return nil
+	}
+	return &unhealthyDomains{
+		health:           health,
+		nodes:            nodes,
+		unhealthy:        unhealthy,
+		domainsPerLevels: make(map[string]map[utiltas.TopologyDomainID]utiltas.TopologyDomainID),
+	}
+}
+
+// isUnhealthy returns whether the domain of a topology assignment with the
+// given levels is in an unhealthy domain.
+func (u *unhealthyDomains) isUnhealthy(levels []string, values []string) bool {
+	key := strings.Join(levels, ",")
+	domains, found := u.domainsPerLevels[key]
+	if !found {
+		domains = make(map[utiltas.TopologyDomainID]utiltas.TopologyDomainID, len(u.nodes))
+		for i := range u.nodes {
+			domains[utiltas.DomainID(utiltas.LevelValues(levels, u.nodes[i].Labels))] = u.health.NodeDomain(&u.nodes[i])
+		}
+		u.domainsPerLevels[key] = domains
+	}
+	domain, found := domains[utiltas.DomainID(values)]
+	return found && u.unhealthy.Has(domain)
+}
+
+// placedInUnhealthyDomains returns the domains of the topology assignments of
+// the Workload which are in unhealthy domains.
+func placedInUnhealthyDomains(wl *kueue.Workload, unhealthyPerFlavor map[kueue.ResourceFlavorReference]*unhealthyDomains) []string {
+	found := sets.New[string]()
+	for _, psa := range wl.Status.Admission.PodSetAssignments {
+		if psa.TopologyAssignment == nil {
+			continue
+		}
+		for _, flavor := range sets.New(slices.Collect(maps.Values(psa.Flavors))...).UnsortedList() {
+			unhealthy, ok := unhealthyPerFlavor[flavor]
+			if !ok {
+				continue
+			}
+			for _, domain := range psa.TopologyAssignment.Domains {
+				if unhealthy.isUnhealthy(psa.TopologyAssignment.Levels, domain.Values) {
+					found.Insert(strings.Join(domain.Values, ","))
+				}
+			}
+		}
+	}
+	return sets.List(found)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tas
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/tas/indexer"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
+)
+
+func TestDomainHealthReconciler(t *testing.T) {
+	const (
+		rackLabel = "cloud.com/topology-rack"
+		taintKey  = "example.com/gpu-degraded"
+		wlName    = "test-workload"
+		nsName    = "default"
+	)
+	wlKey := types.NamespacedName{Name: wlName, Namespace: nsName}
+	topologyRequest := reconcile.Request{NamespacedName: types.NamespacedName{Name: "default"}}
+
+	topology := func(policy kueue.TopologyDomainEvictionPolicy) *kueue.Topology {
+		return utiltesting.MakeTopology("default").
+			Levels(rackLabel, corev1.LabelHostname).
+			DomainHealth(kueue.TopologyDomainHealth{
+				Level:                  ptr.To(rackLabel),
+				UnhealthyNodeTaintKeys: []string{taintKey},
+				EvictionPolicy:         ptr.To(policy),
+			}).
+			Obj()
+	}
+	flavor := utiltesting.MakeResourceFlavor("tas-flavor").TopologyName("default").Obj()
+	healthyNode := func(name, rack string) *testingnode.NodeWrapper {
+		return testingnode.MakeNode(name).Label(rackLabel, rack).Label(corev1.LabelHostname, name).Ready()
+	}
+	workload := utiltesting.MakeWorkload(wlName, nsName).
+		Finalizers(kueue.ResourceInUseFinalizerName).
+		PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Request(corev1.ResourceCPU, "1").Obj()).
+		ReserveQuota(
+			utiltesting.MakeAdmission("cq").
+				PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+					Assignment(corev1.ResourceCPU, "tas-flavor", "1").
+					TopologyAssignment(utiltesting.MakeTopologyAssignment([]string{corev1.LabelHostname}).
+						Domains(utiltesting.MakeTopologyDomainAssignment([]string{"x1"}, 1).Obj()).
+						Obj()).
+					Obj()).
+				Obj(),
+		).
+		Admitted(true).
+		Obj()
+
+	tests := map[string]struct {
+		initObjs        []client.Object
+		wantEvictedCond *metav1.Condition
+	}{
+		"healthy rack; the workload is kept": {
+			initObjs: []client.Object{
+				topology(kueue.TopologyDomainEvictionPolicyEvict),
+				flavor.DeepCopy(),
+				healthyNode("x1", "r1").Obj(),
+				healthyNode("x2", "r1").Obj(),
+				workload.DeepCopy(),
+			},
+		},
+		"unhealthy node in another rack; the workload is kept": {
+			initObjs: []client.Object{
+				topology(kueue.TopologyDomainEvictionPolicyEvict),
+				flavor.DeepCopy(),
+				healthyNode("x1", "r1").Obj(),
+				healthyNode("x2", "r2").NotReady().Obj(),
+				workload.DeepCopy(),
+			},
+		},
+		"unhealthy node in the rack with the Never policy; the workload is kept": {
+			initObjs: []client.Object{
+				topology(kueue.TopologyDomainEvictionPolicyNever),
+				flavor.DeepCopy(),
+				healthyNode("x1", "r1").Obj(),
+				healthyNode("x2", "r1").NotReady().Obj(),
+				workload.DeepCopy(),
+			},
+		},
+		"tainted node in the rack; the workload is evicted": {
+			initObjs: []client.Object{
+				topology(kueue.TopologyDomainEvictionPolicyEvict),
+				flavor.DeepCopy(),
+				healthyNode("x1", "r1").Obj(),
+				healthyNode("x2", "r1").Taints(corev1.Taint{Key: taintKey, Effect: corev1.TaintEffectNoSchedule}).Obj(),
+				workload.DeepCopy(),
+			},
+			wantEvictedCond: &metav1.Condition{
+				Type:    kueue.WorkloadEvicted,
+				Status:  metav1.ConditionTrue,
+				Reason:  kueue.WorkloadEvictedDueToUnhealthyTopologyDomain,
+				Message: fmt.Sprintf(unhealthyDomainEvictionMessageFormat, "x1"),
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TASUnhealthyDomainExclusion, true)
+			clientBuilder := utiltesting.NewClientBuilder().
+				WithObjects(tc.initObjs...).
+				WithStatusSubresource(tc.initObjs...).
+				WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: utiltesting.TreatSSAAsStrategicMerge})
+			ctx, _ := utiltesting.ContextWithLog(t)
+			if err := indexer.SetupIndexes(ctx, utiltesting.AsIndexer(clientBuilder)); err != nil {
+				t.Fatalf("Failed to setup indexes: %v", err)
+			}
+			cl := clientBuilder.Build()
+			r := newDomainHealthReconciler(cl, &utiltesting.EventRecorder{})
+			r.clock = testingclock.NewFakeClock(time.Now().Truncate(time.Second))
+
+			if _, err := r.Reconcile(ctx, topologyRequest); err != nil {
+				t.Errorf("Reconcile() error = %v", err)
+			}
+			wl := &kueue.Workload{}
+			if err := cl.Get(ctx, wlKey, wl); err != nil {
+				t.Fatalf("Failed to get workload %q: %v", wlName, err)
+			}
+			evictedCond := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadEvicted)
+			if diff := cmp.Diff(tc.wantEvictedCond, evictedCond, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("Unexpected WorkloadEvicted condition (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -139,7 +140,13 @@ func (r *topologyReconciler) Create(e event.TypedCreateEvent[*kueue.Topology]) b
 	return true
 }
 
-func (r *topologyReconciler) Update(event.TypedUpdateEvent[*kueue.Topology]) bool {
+func (r *topologyReconciler) Update(e event.TypedUpdateEvent[*kueue.Topology]) bool {
+	if !ptr.Equal(e.ObjectOld.Spec.PlacementPolicy, e.ObjectNew.Spec.PlacementPolicy) ||
+		!equality.Semantic.DeepEqual(e.ObjectOld.Spec.DomainHealth, e.ObjectNew.Spec.DomainHealth) {
+		log := r.log.WithValues("topology", klog.KObj(e.ObjectNew))
+		log.V(2).Info("Topology placement policy or domain health update event")
+		r.cache.AddOrUpdateTopology(log, e.ObjectNew)
+	}
 	return true
 }
 
@@ -62,6 +62,9 @@ var (
 type topologyUngater struct {
 	client            client.Client
 	expectationsStore *expectations.Store
+	// hostScorer provides the annotations conveying the host-local placement
+	// of the pods, if set.
+	hostScorer utiltas.HostScorer
 }
 
 type podWithUngateInfo struct {
@@ -81,10 +84,11 @@ var _ predicate.TypedPredicate[*kueue.Workload] = (*topologyUngater)(nil)
 // +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads,verbs=get;list;watch
 // +kubebuilder:rbac:groups=kueue.x-k8s.io,resources=workloads/status,verbs=get
 
-func newTopologyUngater(c client.Client) *topologyUngater {
+func newTopologyUngater(c client.Client, hostScorer utiltas.HostScorer) *topologyUngater {
 	return &topologyUngater{
 		client:            c,
 		expectationsStore: expectations.NewStore(TASTopologyUngater),
+		hostScorer:        hostScorer,
 	}
 }
 
@@ -246,6 +250,7 @@ func (r *topologyUngater) Reconcile(ctx context.Context, req reconcile.Request)
 				podWithUngateInfo.pod.Spec.NodeSelector = make(map[string]string)
 			}
 			maps.Copy(podWithUngateInfo.pod.Spec.NodeSelector, podWithUngateInfo.nodeLabels)
+			r.injectHostLocalAnnotations(podWithUngateInfo)
 			return podWithUngateInfo.pod, true, nil
 		})
 		if e != nil {
@@ -262,6 +267,26 @@ func (r *topologyUngater) Reconcile(ctx context.Context, req reconcile.Request)
 	return reconcile.Result{}, err
 }
 
+// injectHostLocalAnnotations adds the annotations of the host scorer to a pod
+// assigned to a host.
+func (r *topologyUngater) injectHostLocalAnnotations(p *podWithUngateInfo) {
+	if r.hostScorer == nil {
+		return
+	}
+	hostname, found := p.nodeLabels[corev1.LabelHostname]
+	if !found {
+		return
+	}
+	annotations := r.hostScorer.PodAnnotations(utiltas.HostInfo{Name: hostname, Labels: p.nodeLabels}, p.pod)
+	if len(annotations) == 0 {
+		return
+	}
+	if p.pod.Annotations == nil {
+		p.pod.Annotations = make(map[string]string, len(annotations))
+	}
+	maps.Copy(p.pod.Annotations, annotations)
+}
+
 func (r *topologyUngater) Create(event event.TypedCreateEvent[*kueue.Workload]) bool {
 	return isAdmittedByTAS(event.Object)
 }
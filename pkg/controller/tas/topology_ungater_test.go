@@ -40,6 +40,7 @@ import (
 	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/controller/tas/indexer"
 	utilpod "sigs.k8s.io/kueue/pkg/util/pod"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
 	testingpod "sigs.k8s.io/kueue/pkg/util/testingjobs/pod"
 
@@ -109,6 +110,7 @@ func TestReconcile(t *testing.T) {
 
 	testCases := map[string]struct {
 		expectUIDs []types.UID
+		hostScorer utiltas.HostScorer
 		workloads  []kueue.Workload
 		pods       []corev1.Pod
 		cmpNS      bool
@@ -116,6 +118,49 @@ func TestReconcile(t *testing.T) {
 		wantCounts []counts
 		wantErr    error
 	}{
+		"ungate single pod with the annotations of the host scorer": {
+			hostScorer: &pcieSwitchHostScorer{},
+			workloads: []kueue.Workload{
+				*utiltesting.MakeWorkload("unit-test", "ns").Finalizers(kueue.ResourceInUseFinalizerName).
+					PodSets(*utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Request(corev1.ResourceCPU, "1").Obj()).
+					ReserveQuota(
+						utiltesting.MakeAdmission("cq").
+							PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+								Assignment(corev1.ResourceCPU, "unit-test-flavor", "1").
+								TopologyAssignment(utiltesting.MakeTopologyAssignment([]string{corev1.LabelHostname}).
+									Domains(utiltesting.MakeTopologyDomainAssignment([]string{"x1"}, 1).Obj()).
+									Obj()).
+								Obj()).
+							Obj(),
+					).
+					Admitted(true).
+					Obj(),
+			},
+			pods: []corev1.Pod{
+				*testingpod.MakePod("pod", "ns").
+					Annotation(kueue.WorkloadAnnotation, "unit-test").
+					Label(controllerconsts.PodSetLabel, string(kueue.DefaultPodSetName)).
+					TopologySchedulingGate().
+					Obj(),
+			},
+			cmpNS: true,
+			wantPods: []corev1.Pod{
+				*testingpod.MakePod("pod", "ns").
+					Annotation(kueue.WorkloadAnnotation, "unit-test").
+					Annotation("example.com/pcie-switch", "x1-switch0").
+					Label(controllerconsts.PodSetLabel, string(kueue.DefaultPodSetName)).
+					NodeSelector(corev1.LabelHostname, "x1").
+					Obj(),
+			},
+			wantCounts: []counts{
+				{
+					NodeSelector: map[string]string{
+						corev1.LabelHostname: "x1",
+					},
+					Count: 1,
+				},
+			},
+		},
 		"ungate single pod": {
 			workloads: []kueue.Workload{
 				*utiltesting.MakeWorkload("unit-test", "ns").Finalizers(kueue.ResourceInUseFinalizerName).
@@ -2267,7 +2312,7 @@ func TestReconcile(t *testing.T) {
 					t.Fatalf("Could not create workload: %v", err)
 				}
 			}
-			topologyUngater := newTopologyUngater(kClient)
+			topologyUngater := newTopologyUngater(kClient, tc.hostScorer)
 			key := client.ObjectKeyFromObject(&tc.workloads[0])
 			request := reconcile.Request{NamespacedName: key}
 			if len(tc.expectUIDs) > 0 {
@@ -2314,3 +2359,13 @@ func TestReconcile(t *testing.T) {
 		})
 	}
 }
+
+// pcieSwitchHostScorer is a HostScorer assigning the pods to the first PCIe
+// switch of the host.
+type pcieSwitchHostScorer struct {
+	utiltas.NoopHostScorer
+}
+
+func (*pcieSwitchHostScorer) PodAnnotations(host utiltas.HostInfo, _ *corev1.Pod) map[string]string {
+	return map[string]string{"example.com/pcie-switch": host.Name + "-switch0"}
+}
@@ -33,38 +33,68 @@ import (
 )
 
 var (
-	ErrDeviceClassNotMapped = errors.New("DeviceClass is not mapped in DRA configuration")
-	ErrResourceClaimInUse   = errors.New("ResourceClaim is in use")
-	ErrClaimSpecNotFound    = errors.New("failed to get claim spec")
+	ErrDeviceClassNotMapped      = errors.New("DeviceClass is not mapped in DRA configuration")
+	ErrResourceClaimInUse        = errors.New("ResourceClaim is in use")
+	ErrClaimSpecNotFound         = errors.New("failed to get claim spec")
+	ErrUnsupportedAllocationMode = errors.New("device allocation mode is not supported")
+	ErrUnsupportedDeviceRequest  = errors.New("device request is not supported")
 )
 
 // countDevicesPerClass returns a resources.Requests representing the
 // total number of devices requested for each DeviceClass inside the provided
 // ResourceClaimSpec.
-func countDevicesPerClass(claimSpec *resourcev1beta2.ResourceClaimSpec) resources.Requests {
+//
+// For the requests with alternatives (FirstAvailable), the devices of the
+// first alternative, which is the preferred one, are counted.
+func countDevicesPerClass(claimSpec *resourcev1beta2.ResourceClaimSpec) (resources.Requests, error) {
 	out := resources.Requests{}
 	if claimSpec == nil {
-		return out
+		return out, nil
 	}
 	for _, req := range claimSpec.Devices.Requests {
-		// v1beta2 DeviceRequest has Exactly or FirstAvailable. For Step 1, we
-		// preserve existing semantics by only supporting Exactly with Count.
 		var dcName string
-		var q int64
-		if req.Exactly != nil {
-			dcName = req.Exactly.DeviceClassName
-			if req.Exactly.AllocationMode == resourcev1beta2.DeviceAllocationModeExactCount {
-				q = req.Exactly.Count
-			}
+		var mode resourcev1beta2.DeviceAllocationMode
+		var count int64
+		switch {
+		case req.Exactly != nil:
+			dcName, mode, count = req.Exactly.DeviceClassName, req.Exactly.AllocationMode, req.Exactly.Count
+		case len(req.FirstAvailable) > 0:
+			sub := req.FirstAvailable[0]
+			dcName, mode, count = sub.DeviceClassName, sub.AllocationMode, sub.Count
+		default:
+			return nil, fmt.Errorf("%w: request %s has neither exactly nor firstAvailable", ErrUnsupportedDeviceRequest, req.Name)
 		}
 		dc := corev1.ResourceName(dcName)
 		if dc == "" {
 			continue
 		}
-		// TODO: handle other Allocation modes
-		out[dc] += q
+		// The number of devices allocated with the All mode is only known
+		// at allocation time, so it can't be accounted in the quota.
+		if mode != "" && mode != resourcev1beta2.DeviceAllocationModeExactCount {
+			return nil, fmt.Errorf("%w: request %s uses the %s allocation mode", ErrUnsupportedAllocationMode, req.Name, mode)
+		}
+		// The count defaults to 1 in the ExactCount mode.
+		out[dc] += max(count, 1)
+	}
+	return out, nil
+}
+
+// logicalResources maps the devices requested in the claim spec to the
+// logical resources of the DRA configuration.
+func logicalResources(claimSpec *resourcev1beta2.ResourceClaimSpec, wl *kueue.Workload, ps *kueue.PodSet) (corev1.ResourceList, error) {
+	devices, err := countDevicesPerClass(claimSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count the devices for workload %s podset %s: %w", wl.Name, ps.Name, err)
+	}
+	out := corev1.ResourceList{}
+	for dc, qty := range devices {
+		logical, found := Mapper().lookup(dc)
+		if !found {
+			return nil, fmt.Errorf("DeviceClass %s is not mapped in DRA configuration for workload %s podset %s: %w", dc, wl.Name, ps.Name, ErrDeviceClassNotMapped)
+		}
+		out = utilresource.MergeResourceListKeepSum(out, corev1.ResourceList{logical: resource.MustParse(strconv.FormatInt(qty, 10))})
 	}
-	return out
+	return out, nil
 }
 
 // getClaimSpec resolves the ResourceClaim(Template) referenced by the PodResourceClaim
@@ -116,13 +146,55 @@ func GetResourceRequestsForResourceClaimTemplates(
 				continue
 			}
 
-			for dc, qty := range countDevicesPerClass(spec) {
-				logical, found := Mapper().lookup(dc)
-				if !found {
-					return nil, fmt.Errorf("DeviceClass %s is not mapped in DRA configuration for workload %s podset %s: %w", dc, wl.Name, ps.Name, ErrDeviceClassNotMapped)
-				}
-				aggregated = utilresource.MergeResourceListKeepSum(aggregated, corev1.ResourceList{logical: resource.MustParse(strconv.FormatInt(qty, 10))})
+			logical, err := logicalResources(spec, wl, ps)
+			if err != nil {
+				return nil, err
+			}
+			aggregated = utilresource.MergeResourceListKeepSum(aggregated, logical)
+		}
+
+		if len(aggregated) > 0 {
+			perPodSet[ps.Name] = aggregated
+		}
+	}
+
+	return perPodSet, nil
+}
+
+// GetResourceRequestsForResourceClaims walks all the ResourceClaims referenced
+// by name by the PodSets of the Workload, converts their DeviceClass counts
+// into logical resources and returns the aggregated quantities per PodSet.
+//
+// The devices of a ResourceClaim are shared by all the pods referencing it, so
+// they are returned once per Workload, for the first PodSet referencing the
+// ResourceClaim, instead of once per pod.
+//
+// If at least one DeviceClass is not present in the DRA configuration the function
+// returns an error.
+func GetResourceRequestsForResourceClaims(
+	ctx context.Context,
+	cl client.Client,
+	wl *kueue.Workload) (map[kueue.PodSetReference]corev1.ResourceList, error) {
+	perPodSet := make(map[kueue.PodSetReference]corev1.ResourceList)
+	seen := make(map[string]bool)
+	for i := range wl.Spec.PodSets {
+		ps := &wl.Spec.PodSets[i]
+		aggregated := corev1.ResourceList{}
+
+		for _, prc := range ps.Template.Spec.ResourceClaims {
+			if prc.ResourceClaimName == nil || seen[*prc.ResourceClaimName] {
+				continue
+			}
+			seen[*prc.ResourceClaimName] = true
+			spec, err := getClaimSpec(ctx, cl, wl.Namespace, prc)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get claim spec for ResourceClaim %s in workload %s podset %s: %w", *prc.ResourceClaimName, wl.Name, ps.Name, fmt.Errorf("%w: %v", ErrClaimSpecNotFound, err))
+			}
+			logical, err := logicalResources(spec, wl, ps)
+			if err != nil {
+				return nil, err
 			}
+			aggregated = utilresource.MergeResourceListKeepSum(aggregated, logical)
 		}
 
 		if len(aggregated) > 0 {
@@ -18,6 +18,7 @@ package dra_test
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 
@@ -303,3 +304,108 @@ func Test_GetResourceRequests(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetResourceRequestsForResourceClaims(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = kueuev1beta1.AddToScheme(scheme)
+	_ = resourcev1beta2.AddToScheme(scheme)
+
+	if err := dra.CreateMapperFromConfiguration([]configapi.DeviceClassMapping{
+		{Name: "res-1", DeviceClassNames: []corev1.ResourceName{"test-deviceclass-1"}},
+		{Name: "res-2", DeviceClassNames: []corev1.ResourceName{"test-deviceclass-2"}},
+	}); err != nil {
+		t.Fatalf("Failed to initialize DRA mapper: %v", err)
+	}
+
+	claims := []client.Object{
+		&resourcev1beta2.ResourceClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "exact", Namespace: "ns1"},
+			Spec: resourcev1beta2.ResourceClaimSpec{Devices: resourcev1beta2.DeviceClaim{Requests: []resourcev1beta2.DeviceRequest{{
+				Name: "gpus",
+				Exactly: &resourcev1beta2.ExactDeviceRequest{
+					AllocationMode:  resourcev1beta2.DeviceAllocationModeExactCount,
+					Count:           2,
+					DeviceClassName: "test-deviceclass-1",
+				},
+			}}}},
+		},
+		&resourcev1beta2.ResourceClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "first-available", Namespace: "ns1"},
+			Spec: resourcev1beta2.ResourceClaimSpec{Devices: resourcev1beta2.DeviceClaim{Requests: []resourcev1beta2.DeviceRequest{{
+				Name: "nic",
+				FirstAvailable: []resourcev1beta2.DeviceSubRequest{
+					{Name: "fast", DeviceClassName: "test-deviceclass-2", AllocationMode: resourcev1beta2.DeviceAllocationModeExactCount, Count: 1},
+					{Name: "slow", DeviceClassName: "test-deviceclass-1", AllocationMode: resourcev1beta2.DeviceAllocationModeExactCount, Count: 2},
+				},
+			}}}},
+		},
+		&resourcev1beta2.ResourceClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "all", Namespace: "ns1"},
+			Spec: resourcev1beta2.ResourceClaimSpec{Devices: resourcev1beta2.DeviceClaim{Requests: []resourcev1beta2.DeviceRequest{{
+				Name: "gpus",
+				Exactly: &resourcev1beta2.ExactDeviceRequest{
+					AllocationMode:  resourcev1beta2.DeviceAllocationModeAll,
+					DeviceClassName: "test-deviceclass-1",
+				},
+			}}}},
+		},
+	}
+	podSet := func(name kueuev1beta1.PodSetReference, claimNames ...string) kueuev1beta1.PodSet {
+		ps := kueuev1beta1.PodSet{
+			Name:  name,
+			Count: 4,
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "c", Image: "pause"}}},
+			},
+		}
+		for _, claimName := range claimNames {
+			ps.Template.Spec.ResourceClaims = append(ps.Template.Spec.ResourceClaims, corev1.PodResourceClaim{Name: claimName, ResourceClaimName: ptr.To(claimName)})
+		}
+		return ps
+	}
+
+	tests := map[string]struct {
+		podSets []kueuev1beta1.PodSet
+		want    map[kueuev1beta1.PodSetReference]corev1.ResourceList
+		wantErr error
+	}{
+		"claim shared by the PodSets is counted once": {
+			podSets: []kueuev1beta1.PodSet{podSet("driver", "exact"), podSet("workers", "exact")},
+			want:    map[kueuev1beta1.PodSetReference]corev1.ResourceList{"driver": {"res-1": resource.MustParse("2")}},
+		},
+		"first alternative of the request is counted": {
+			podSets: []kueuev1beta1.PodSet{podSet("main", "exact", "first-available")},
+			want: map[kueuev1beta1.PodSetReference]corev1.ResourceList{
+				"main": {"res-1": resource.MustParse("2"), "res-2": resource.MustParse("1")},
+			},
+		},
+		"All allocation mode is not supported": {
+			podSets: []kueuev1beta1.PodSet{podSet("main", "all")},
+			wantErr: dra.ErrUnsupportedAllocationMode,
+		},
+		"missing claim": {
+			podSets: []kueuev1beta1.PodSet{podSet("main", "missing")},
+			wantErr: dra.ErrClaimSpecNotFound,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(claims...).Build()
+			wl := &kueuev1beta1.Workload{
+				ObjectMeta: metav1.ObjectMeta{Name: "wl", Namespace: "ns1"},
+				Spec:       kueuev1beta1.WorkloadSpec{PodSets: tc.podSets},
+			}
+			got, err := dra.GetResourceRequestsForResourceClaims(context.Background(), cl, wl)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("Unexpected error, want %v, got %v", tc.wantErr, err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Unexpected result; got=%v want=%v", got, tc.want)
+			}
+		})
+	}
+}
@@ -198,6 +198,393 @@ const (
 	//
 	// Enable all updates to Workload objects to use Patch Merge instead of Patch Apply.
 	WorkloadRequestUseMergePatch featuregate.Feature = "WorkloadRequestUseMergePatch"
+
+	// Enable a grace period for preempted workloads, during which the job is signaled
+	// to checkpoint its progress before it is stopped.
+	GracefulPreemption featuregate.Feature = "GracefulPreemption"
+
+	// Enable re-targeting the LocalQueues of a stopped ClusterQueue to its successorClusterQueue.
+	ClusterQueueWorkloadMigration featuregate.Feature = "ClusterQueueWorkloadMigration"
+
+	// Enable PodSets to request exclusive ownership of the topology domains they are placed in,
+	// using the kueue.x-k8s.io/podset-exclusive-topology annotation.
+	TASExclusivePlacement featuregate.Feature = "TASExclusivePlacement"
+
+	// Enables the Reservation API, which books capacity in a ClusterQueue
+	// for the workloads of a given holder during a time window.
+	AdvanceReservations featuregate.Feature = "AdvanceReservations"
+
+	// Enables capping the quota a ClusterQueue can borrow from specific peers in its cohort.
+	PeerBorrowingLimits featuregate.Feature = "PeerBorrowingLimits"
+
+	// Enables recording a structured breakdown in the Workload status of why it couldn't be admitted.
+	WorkloadUnschedulableReasons featuregate.Feature = "WorkloadUnschedulableReasons"
+
+	// Enables parking the head workload of a StrictFIFO ClusterQueue
+	// after it blocks the queue for longer than headOfLineTimeoutSeconds.
+	StrictFIFOHeadOfLineTimeout featuregate.Feature = "StrictFIFOHeadOfLineTimeout"
+
+	// Enables scaling the nominalQuota of a ClusterQueue by the overcommitPercent.
+	FlavorQuotaOvercommit featuregate.Feature = "FlavorQuotaOvercommit"
+
+	// Enables the fairSharing.usageHalfLifeTime configuration to decay the usage considered for Fair Sharing.
+	FairSharingUsageDecay featuregate.Feature = "FairSharingUsageDecay"
+
+	// Enables the workload group admission check controller, to admit and evict groups of workloads together.
+	WorkloadGroups featuregate.Feature = "WorkloadGroups"
+
+	// Enables the reservedHeadroom of ClusterQueue quotas, kept for the workloads above the headroomPriorityThreshold.
+	ReservedHeadroom featuregate.Feature = "ReservedHeadroom"
+
+	// Enables preferring the flavors a workload was previously admitted to, when it is admitted again.
+	// The last topology assignment is preferred as long as it fits.
+	FlavorStickiness featuregate.Feature = "FlavorStickiness"
+
+	// Enables the maxRatio of ClusterQueue quotas, limiting the usage of a resource per unit of another resource of the flavor.
+	FlavorResourceRatios featuregate.Feature = "FlavorResourceRatios"
+
+	// Enables the dependsOn of the AdmissionCheck strategy rules, running the AdmissionChecks
+	// of a ClusterQueue in stages.
+	AdmissionCheckDependencies featuregate.Feature = "AdmissionCheckDependencies"
+
+	// Enables the timeout and timeoutPolicy of the AdmissionCheck strategy rules.
+	AdmissionCheckTimeouts featuregate.Feature = "AdmissionCheckTimeouts"
+
+	// Enables the HTTP admission check controller, gating the admission of Workloads
+	// on the responses of an HTTPS endpoint.
+	HTTPAdmissionCheck featuregate.Feature = "HTTPAdmissionCheck"
+
+	// Enables the budget admission check controller, limiting the monthly cost of the
+	// Workloads admitted per namespace or LocalQueue.
+	BudgetAdmissionCheck featuregate.Feature = "BudgetAdmissionCheck"
+
+	// Enables onPodSets in the AdmissionCheck strategy rules of ClusterQueues, to run
+	// AdmissionChecks only for some PodSets of the Workloads.
+	AdmissionCheckPodSets featuregate.Feature = "AdmissionCheckPodSets"
+
+	// Enables failurePolicy in the retryStrategy of ProvisioningRequestConfigs, to admit
+	// the Workloads without provisioned capacity once the retries are exhausted.
+	ProvisioningRequestFailurePolicy featuregate.Feature = "ProvisioningRequestFailurePolicy"
+
+	// Enables consolidation in ProvisioningRequestConfigs, to batch the ProvisioningRequests
+	// of multiple Workloads into a single ProvisioningRequest.
+	ProvisioningRequestConsolidation featuregate.Feature = "ProvisioningRequestConsolidation"
+
+	// Enables the Karpenter admission check controller, provisioning the capacity
+	// of the Workloads with Karpenter NodeClaims.
+	KarpenterAdmissionCheck featuregate.Feature = "KarpenterAdmissionCheck"
+
+	// Enables the capacity reservation admission check controller, admitting the
+	// Workloads on the capacity held in the reservations of a provider.
+	CapacityReservationAdmissionCheck featuregate.Feature = "CapacityReservationAdmissionCheck"
+
+	// Enables the continuous evaluation of AdmissionChecks after the admission of
+	// the Workloads, with a grace period and an eviction policy.
+	ContinuousAdmissionChecks featuregate.Feature = "ContinuousAdmissionChecks"
+
+	// Enables the image pre-pull admission check controller, pulling the images
+	// of the Workloads on the Nodes of their flavors before admitting them.
+	ImagePrePullAdmissionCheck featuregate.Feature = "ImagePrePullAdmissionCheck"
+
+	// Enables the summary of the progress of the AdmissionChecks in the Workload status,
+	// and periodic events on the Workloads and their jobs while they are blocked on AdmissionChecks.
+	AdmissionChecksSummary featuregate.Feature = "AdmissionChecksSummary"
+
+	// Enables the image verification admission check controller, rejecting the Workloads
+	// whose images are not compliant with the verifier of the admission check.
+	ImageVerificationAdmissionCheck featuregate.Feature = "ImageVerificationAdmissionCheck"
+
+	// Enables the references to the labels and annotations of the Workloads in the
+	// provisioningClassName and parameters of the ProvisioningRequestConfigs.
+	ProvisioningRequestConfigTemplates featuregate.Feature = "ProvisioningRequestConfigTemplates"
+
+	// Enables the topology requests of the Workloads built outside of Kueue from the annotations
+	// of their PodSets, and the topology assignment annotation on the objects of the MultiKueue external
+	// frameworks. Only the MultiKueue generic adapter injects the topology assignment.
+	TASExternalFrameworks featuregate.Feature = "TASExternalFrameworks"
+
+	// Enables the placementPolicy of the Topologies and the topologyPlacementPolicy of the
+	// ClusterQueues, to select the topology domains minimizing the fragmentation.
+	TASPlacementPolicy featuregate.Feature = "TASPlacementPolicy"
+
+	// Records the topology level achieved for the PodSets requesting a preferred
+	// topology level in the Workload admission.
+	TASAchievedTopologyLevel featuregate.Feature = "TASAchievedTopologyLevel"
+
+	// Enables the controller creating and updating the ResourceFlavors from the
+	// node pools of the cluster, configured with resourceFlavorDiscovery.
+	ResourceFlavorDiscovery featuregate.Feature = "ResourceFlavorDiscovery"
+
+	// Tracks the allocatable capacity of the Nodes of the ResourceFlavors, reported in the
+	// ClusterQueues status and metrics, and enables the physicalCapacityPolicy of the ClusterQueues.
+	FlavorPhysicalCapacity featuregate.Feature = "FlavorPhysicalCapacity"
+
+	// Enables the preemption of the victims within a single topology domain of the
+	// level requested by the preempting workload, when it is assigned to a TAS flavor.
+	TASDomainAwarePreemption featuregate.Feature = "TASDomainAwarePreemption"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables accounting the partitions of devices, such as MIG slices of GPUs,
+	// as fractions of the devices in the quota of the ResourceFlavors.
+	FlavorResourcePartitions featuregate.Feature = "FlavorResourcePartitions"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables ordering the flavors of the ClusterQueues by the costs reported
+	// by the flavor cost provider.
+	FlavorCostOrdering featuregate.Feature = "FlavorCostOrdering"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables adding the pod overhead of the ResourceFlavors to the requests of
+	// each pod in the quota computation.
+	FlavorPodOverhead featuregate.Feature = "FlavorPodOverhead"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables excluding the unhealthy topology domains from the new TAS
+	// assignments, and optionally evicting the workloads placed in them.
+	TASUnhealthyDomainExclusion featuregate.Feature = "TASUnhealthyDomainExclusion"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables evicting the admitted workloads whose pods stay unschedulable
+	// on the assigned flavors, and excluding those flavors for the workload
+	// for some time.
+	FlavorFailover featuregate.Feature = "FlavorFailover"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables balancing the pods of a PodSet evenly across a number of topology
+	// domains, while packing them within each domain.
+	TASBalancedPlacement featuregate.Feature = "TASBalancedPlacement"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables checking that the ResourceFlavors match schedulable nodes, and
+	// deactivating the ClusterQueues using the ResourceFlavors without them.
+	FlavorNodeDriftDetection featuregate.Feature = "FlavorNodeDriftDetection"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables evaluating the host-local constraints, such as the NUMA or PCIe
+	// affinity of the devices, of the pods placed by TAS on a host with a
+	// pluggable host scorer.
+	TASHostScorer featuregate.Feature = "TASHostScorer"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables reducing the parallelism of the elastic batch Jobs, instead of
+	// evicting them, when their quota is needed by preempting workloads.
+	ElasticJobsShrinkOnPreemption featuregate.Feature = "ElasticJobsShrinkOnPreemption"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables restricting the flavors of a PodSet, and overriding the flavor
+	// fungibility of the ClusterQueue for the PodSet, with annotations of its
+	// pod template.
+	PodSetFlavorSelection featuregate.Feature = "PodSetFlavorSelection"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the job frameworks implemented by out-of-process plugins,
+	// which Kueue calls through a gRPC service.
+	JobFrameworkPlugins featuregate.Feature = "JobFrameworkPlugins"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables configuring, per kind of owner, whether the jobs owned by the
+	// objects of the kind are queued separately.
+	ChildJobsPolicies featuregate.Feature = "ChildJobsPolicies"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables accounting the init containers and the sidecars of the driver
+	// and the executors of the SparkApplications, whose pod templates are
+	// estimated by Kueue.
+	SparkApplicationSidecarsAccounting featuregate.Feature = "SparkApplicationSidecarsAccounting"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables creating the Workloads of the groups that a LeaderWorkerSet
+	// surges during a rolling update.
+	RollingUpdateSurgeAccounting featuregate.Feature = "RollingUpdateSurgeAccounting"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the Slurm admission check controller, dispatching the Workloads
+	// to a Slurm cluster through slurmrestd.
+	SlurmAdmissionCheck featuregate.Feature = "SlurmAdmissionCheck"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables recording the last evictions of a Workload, which requeued it,
+	// in its status.
+	WorkloadRequeueHistory featuregate.Feature = "WorkloadRequeueHistory"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the evictionBackoff of the ClusterQueues, overriding the
+	// requeuing backoff of the Workloads evicted due to the PodsReady timeout.
+	ClusterQueueEvictionBackoff featuregate.Feature = "ClusterQueueEvictionBackoff"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the held field of the Workloads, parking pending Workloads
+	// until they are released.
+	WorkloadHold featuregate.Feature = "WorkloadHold"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the changes of the priority of the Workloads authorized by the
+	// workloads/priority subresource, validated against the priority classes
+	// and recorded on the Workloads.
+	WorkloadPriorityChange featuregate.Feature = "WorkloadPriorityChange"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the sampling of the resource usage of the pods of the admitted
+	// Workloads, recorded in the status of the Workloads when they finish.
+	WorkloadActualUsage featuregate.Feature = "WorkloadActualUsage"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the compaction of the large pod templates of the Workloads, which
+	// full templates are stored once in content-addressed ConfigMaps.
+	PodTemplateDeduplication featuregate.Feature = "PodTemplateDeduplication"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the admission targets of the Workloads, set by the Workloads or by
+	// their LocalQueues, and the SLOBreached condition of the Workloads pending
+	// for longer than their target.
+	WorkloadAdmissionSLO featuregate.Feature = "WorkloadAdmissionSLO"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the reactivationPolicy of the ClusterQueues, which reactivates the
+	// Workloads deactivated after exceeding their requeuing limit.
+	WorkloadReactivationPolicy featuregate.Feature = "WorkloadReactivationPolicy"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the recording of the users creating the jobs and the Workloads
+	// in the kueue.x-k8s.io/submitter annotation of their Workloads.
+	WorkloadSubmitterIdentity featuregate.Feature = "WorkloadSubmitterIdentity"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the kueue.x-k8s.io/billing-namespace annotation, which makes the
+	// Workloads of the jobs admitted by a LocalQueue of another namespace.
+	CrossNamespaceBilling featuregate.Feature = "CrossNamespaceBilling"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the workloadGroupingLabels of the metrics configuration, which
+	// report the resource usage of the ClusterQueues per value of the labels.
+	WorkloadGroupingMetrics featuregate.Feature = "WorkloadGroupingMetrics"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the tracing configuration, which emits OpenTelemetry spans for
+	// the admission lifecycle of the Workloads.
+	AdmissionTracing featuregate.Feature = "AdmissionTracing"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the audit records of the preemptions, recorded as events on the
+	// preempting Workloads and written to the preemptionAudit sink.
+	PreemptionAuditLog featuregate.Feature = "PreemptionAuditLog"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the metrics of the state of the Workloads, ClusterQueues, LocalQueues
+	// and MultiKueueClusters, collected from the informer caches on scrape.
+	ObjectStateMetrics featuregate.Feature = "ObjectStateMetrics"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the deduplication and the rate limiting of the events recorded by
+	// the controllers, configured by eventThrottling.
+	EventThrottling featuregate.Feature = "EventThrottling"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the health checks of the scheduler and of the informers, and the
+	// report of the health of the controllers on the metrics server.
+	ControllerHealthDetails featuregate.Feature = "ControllerHealthDetails"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables listing each of the problems of the ClusterQueues in their status.
+	ClusterQueueProblems featuregate.Feature = "ClusterQueueProblems"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables limiting the cardinality of the metrics, as set in the configuration.
+	MetricsCardinalityLimits featuregate.Feature = "MetricsCardinalityLimits"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the capacity snapshot of the cohorts, served on the metrics server.
+	FleetCapacitySnapshot featuregate.Feature = "FleetCapacitySnapshot"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the structured log of the decisions taken by the scheduler on each
+	// of the admission attempts.
+	SchedulingDecisionLog featuregate.Feature = "SchedulingDecisionLog"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the metrics of the percentage of the nominal quota and of the physical
+	// capacity of the flavors used by the admitted workloads in the ClusterQueues and Cohorts.
+	FlavorUtilizationMetrics featuregate.Feature = "FlavorUtilizationMetrics"
+
+	// owner: @openshift-pipelines/kueue
+	// kep: <none>
+	//
+	// Enables the metrics of the admission wait time and of the numbers of admitted
+	// and evicted workloads per namespace, for the namespaces of the namespaceAllowlist
+	// of the metrics configuration.
+	NamespaceMetrics featuregate.Feature = "NamespaceMetrics"
 )
 
 func init() {
@@ -312,6 +699,216 @@ var defaultVersionedFeatureGates = map[featuregate.Feature]featuregate.Versioned
 	WorkloadRequestUseMergePatch: {
 		{Version: version.MustParse("0.14"), Default: false, PreRelease: featuregate.Alpha},
 	},
+	GracefulPreemption: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ClusterQueueWorkloadMigration: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	TASExclusivePlacement: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	AdvanceReservations: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	PeerBorrowingLimits: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	WorkloadUnschedulableReasons: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	StrictFIFOHeadOfLineTimeout: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	FlavorQuotaOvercommit: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	FairSharingUsageDecay: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	WorkloadGroups: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ReservedHeadroom: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	FlavorStickiness: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	FlavorResourceRatios: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	AdmissionCheckDependencies: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	AdmissionCheckTimeouts: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	HTTPAdmissionCheck: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	BudgetAdmissionCheck: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	AdmissionCheckPodSets: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ProvisioningRequestFailurePolicy: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ProvisioningRequestConsolidation: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	KarpenterAdmissionCheck: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	CapacityReservationAdmissionCheck: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ContinuousAdmissionChecks: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ImagePrePullAdmissionCheck: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	AdmissionChecksSummary: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ImageVerificationAdmissionCheck: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ProvisioningRequestConfigTemplates: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	TASExternalFrameworks: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	TASPlacementPolicy: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	TASAchievedTopologyLevel: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ResourceFlavorDiscovery: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	FlavorPhysicalCapacity: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	TASDomainAwarePreemption: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	FlavorResourcePartitions: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	FlavorCostOrdering: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	FlavorPodOverhead: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	TASUnhealthyDomainExclusion: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	FlavorFailover: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	TASBalancedPlacement: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	FlavorNodeDriftDetection: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	TASHostScorer: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ElasticJobsShrinkOnPreemption: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	PodSetFlavorSelection: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	JobFrameworkPlugins: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ChildJobsPolicies: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	SparkApplicationSidecarsAccounting: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	RollingUpdateSurgeAccounting: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	SlurmAdmissionCheck: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	WorkloadRequeueHistory: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ClusterQueueEvictionBackoff: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	WorkloadHold: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	WorkloadPriorityChange: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	WorkloadActualUsage: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	PodTemplateDeduplication: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	WorkloadAdmissionSLO: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	WorkloadReactivationPolicy: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	WorkloadSubmitterIdentity: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	CrossNamespaceBilling: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	WorkloadGroupingMetrics: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	AdmissionTracing: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	PreemptionAuditLog: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ObjectStateMetrics: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	EventThrottling: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ControllerHealthDetails: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	ClusterQueueProblems: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	MetricsCardinalityLimits: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	FleetCapacitySnapshot: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	SchedulingDecisionLog: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	FlavorUtilizationMetrics: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
+	NamespaceMetrics: {
+		{Version: version.MustParse("0.15"), Default: false, PreRelease: featuregate.Alpha},
+	},
 }
 
 func SetFeatureGateDuringTest(tb testing.TB, f featuregate.Feature, value bool) {
@@ -0,0 +1,272 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package health reports the health of the controllers of Kueue: the depth of
+// their workqueues, the sync status of the informers and the progress of the
+// scheduler, such that operators can tell a wedged scheduler from a slow API server.
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+const (
+	// DefaultSchedulingCycleTimeout is the time after which a running scheduling
+	// cycle is considered stuck.
+	DefaultSchedulingCycleTimeout = 5 * time.Minute
+
+	// DetailsPath is the path of the endpoint serving the report, on the metrics server.
+	DetailsPath = "/debug/health"
+)
+
+var (
+	workqueueDepth                   = prometheus.BuildFQName("", metrics.WorkQueueSubsystem, metrics.DepthKey)
+	workqueueUnfinishedWork          = prometheus.BuildFQName("", metrics.WorkQueueSubsystem, metrics.UnfinishedWorkKey)
+	workqueueLongestRunningProcessor = prometheus.BuildFQName("", metrics.WorkQueueSubsystem, metrics.LongestRunningProcessorKey)
+)
+
+// informerKinds are the kinds of the objects whose informers are reported.
+var informerKinds = []struct {
+	kind string
+	obj  client.Object
+}{
+	{kind: "AdmissionCheck", obj: &kueue.AdmissionCheck{}},
+	{kind: "ClusterQueue", obj: &kueue.ClusterQueue{}},
+	{kind: "Cohort", obj: &kueue.Cohort{}},
+	{kind: "LocalQueue", obj: &kueue.LocalQueue{}},
+	{kind: "ResourceFlavor", obj: &kueue.ResourceFlavor{}},
+	{kind: "Workload", obj: &kueue.Workload{}},
+}
+
+// Report is the health report of the controllers.
+type Report struct {
+	Controllers []ControllerReport `json:"controllers"`
+	Informers   []InformerReport   `json:"informers"`
+	Scheduler   SchedulerReport    `json:"scheduler"`
+}
+
+// ControllerReport is the state of the workqueue of a controller.
+type ControllerReport struct {
+	Name string `json:"name"`
+	// QueueDepth is the number of items waiting in the workqueue.
+	QueueDepth int64 `json:"queueDepth"`
+	// LongestRunningProcessorSeconds is the age of the oldest item being processed.
+	LongestRunningProcessorSeconds float64 `json:"longestRunningProcessorSeconds"`
+	// UnfinishedWorkSeconds is the time spent processing the items which are not done yet.
+	UnfinishedWorkSeconds float64 `json:"unfinishedWorkSeconds"`
+}
+
+// InformerReport is the sync status of an informer.
+type InformerReport struct {
+	Kind   string `json:"kind"`
+	Synced bool   `json:"synced"`
+}
+
+// SchedulerReport is the progress of the scheduler.
+type SchedulerReport struct {
+	// LastSuccessfulCycle is the end time of the last scheduling cycle that completed.
+	LastSuccessfulCycle *metav1.Time `json:"lastSuccessfulCycle,omitempty"`
+	// RunningCycleStart is the start time of the running scheduling cycle, if any.
+	// No cycle runs while the scheduler waits for pending workloads.
+	RunningCycleStart *metav1.Time `json:"runningCycleStart,omitempty"`
+}
+
+// SchedulerTracker tracks the scheduling cycles of the scheduler.
+type SchedulerTracker struct {
+	mu          sync.Mutex
+	lastStart   time.Time
+	lastSuccess time.Time
+	running     bool
+}
+
+func (t *SchedulerTracker) CycleStarted(start time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lastStart = start
+	t.running = true
+}
+
+func (t *SchedulerTracker) CycleFinished(end time.Time, success bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if success {
+		t.lastSuccess = end
+	}
+	t.running = false
+}
+
+func (t *SchedulerTracker) report() SchedulerReport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var r SchedulerReport
+	if !t.lastSuccess.IsZero() {
+		r.LastSuccessfulCycle = &metav1.Time{Time: t.lastSuccess}
+	}
+	if t.running {
+		r.RunningCycleStart = &metav1.Time{Time: t.lastStart}
+	}
+	return r
+}
+
+// Reporter reports the health of the controllers.
+type Reporter struct {
+	cache                  cache.Cache
+	gatherer               prometheus.Gatherer
+	scheduler              *SchedulerTracker
+	clock                  clock.Clock
+	schedulingCycleTimeout time.Duration
+}
+
+func NewReporter(c cache.Cache, gatherer prometheus.Gatherer, scheduler *SchedulerTracker, clock clock.Clock) *Reporter {
+	return &Reporter{
+		cache:                  c,
+		gatherer:               gatherer,
+		scheduler:              scheduler,
+		clock:                  clock,
+		schedulingCycleTimeout: DefaultSchedulingCycleTimeout,
+	}
+}
+
+// Report returns the health report of the controllers.
+func (r *Reporter) Report(req *http.Request) (*Report, error) {
+	controllers, err := r.controllers()
+	if err != nil {
+		return nil, err
+	}
+	return &Report{
+		Controllers: controllers,
+		Informers:   r.informers(req),
+		Scheduler:   r.scheduler.report(),
+	}, nil
+}
+
+func (r *Reporter) controllers() ([]ControllerReport, error) {
+	families, err := r.gatherer.Gather()
+	if err != nil {
+		return nil, fmt.Errorf("gathering the workqueue metrics: %w", err)
+	}
+	reports := make(map[string]*ControllerReport)
+	for _, family := range families {
+		name := family.GetName()
+		if name != workqueueDepth && name != workqueueUnfinishedWork && name != workqueueLongestRunningProcessor {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			var controller string
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "controller" {
+					controller = l.GetValue()
+				}
+			}
+			report, found := reports[controller]
+			if !found {
+				report = &ControllerReport{Name: controller}
+				reports[controller] = report
+			}
+			value := m.GetGauge().GetValue()
+			switch name {
+			case workqueueDepth:
+				report.QueueDepth += int64(value)
+			case workqueueUnfinishedWork:
+				report.UnfinishedWorkSeconds = value
+			case workqueueLongestRunningProcessor:
+				report.LongestRunningProcessorSeconds = value
+			}
+		}
+	}
+	result := make([]ControllerReport, 0, len(reports))
+	for _, report := range reports {
+		result = append(result, *report)
+	}
+	slices.SortFunc(result, func(a, b ControllerReport) int { return strings.Compare(a.Name, b.Name) })
+	return result, nil
+}
+
+func (r *Reporter) informers(req *http.Request) []InformerReport {
+	result := make([]InformerReport, 0, len(informerKinds))
+	for _, ik := range informerKinds {
+		report := InformerReport{Kind: ik.kind}
+		if informer, err := r.cache.GetInformer(req.Context(), ik.obj, cache.BlockUntilSynced(false)); err == nil {
+			report.Synced = informer.HasSynced()
+		}
+		result = append(result, report)
+	}
+	return result
+}
+
+// ServeHTTP serves the health report of the controllers as JSON.
+func (r *Reporter) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
+	report, err := r.Report(req)
+	if err != nil {
+		http.Error(resp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resp.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(resp).Encode(report); err != nil {
+		http.Error(resp, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// InformersSynced returns the checker failing while some of the informers are not synced.
+func (r *Reporter) InformersSynced() healthz.Checker {
+	return func(req *http.Request) error {
+		var notSynced []string
+		for _, informer := range r.informers(req) {
+			if !informer.Synced {
+				notSynced = append(notSynced, informer.Kind)
+			}
+		}
+		if len(notSynced) > 0 {
+			return fmt.Errorf("informers not synced: %s", strings.Join(notSynced, ", "))
+		}
+		return nil
+	}
+}
+
+// SchedulerProgressing returns the checker failing when a scheduling cycle is
+// running for longer than the scheduling cycle timeout.
+func (r *Reporter) SchedulerProgressing() healthz.Checker {
+	return func(*http.Request) error {
+		report := r.scheduler.report()
+		if report.RunningCycleStart == nil {
+			return nil
+		}
+		if running := r.clock.Since(report.RunningCycleStart.Time); running > r.schedulingCycleTimeout {
+			lastSuccess := "never"
+			if report.LastSuccessfulCycle != nil {
+				lastSuccess = report.LastSuccessfulCycle.UTC().Format(time.RFC3339)
+			}
+			return fmt.Errorf("scheduling cycle running for %s, last successful cycle: %s", running.Truncate(time.Second), lastSuccess)
+		}
+		return nil
+	}
+}
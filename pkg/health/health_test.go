@@ -0,0 +1,123 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+func TestSchedulerProgressing(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	cases := map[string]struct {
+		track      func(*SchedulerTracker)
+		wantReport SchedulerReport
+		wantErr    bool
+	}{
+		"no cycle": {},
+		"cycle finished": {
+			track: func(tracker *SchedulerTracker) {
+				tracker.CycleStarted(now.Add(-10 * time.Minute))
+				tracker.CycleFinished(now.Add(-9*time.Minute), true)
+			},
+			wantReport: SchedulerReport{
+				LastSuccessfulCycle: &metav1.Time{Time: now.Add(-9 * time.Minute)},
+			},
+		},
+		"cycle running within the timeout": {
+			track: func(tracker *SchedulerTracker) {
+				tracker.CycleStarted(now.Add(-10 * time.Minute))
+				tracker.CycleFinished(now.Add(-9*time.Minute), true)
+				tracker.CycleStarted(now.Add(-time.Minute))
+			},
+			wantReport: SchedulerReport{
+				LastSuccessfulCycle: &metav1.Time{Time: now.Add(-9 * time.Minute)},
+				RunningCycleStart:   &metav1.Time{Time: now.Add(-time.Minute)},
+			},
+		},
+		"cycle running beyond the timeout": {
+			track: func(tracker *SchedulerTracker) {
+				tracker.CycleStarted(now.Add(-10 * time.Minute))
+			},
+			wantReport: SchedulerReport{
+				RunningCycleStart: &metav1.Time{Time: now.Add(-10 * time.Minute)},
+			},
+			wantErr: true,
+		},
+		"failed cycle doesn't update the last successful cycle": {
+			track: func(tracker *SchedulerTracker) {
+				tracker.CycleStarted(now.Add(-10 * time.Minute))
+				tracker.CycleFinished(now.Add(-9*time.Minute), true)
+				tracker.CycleStarted(now.Add(-2 * time.Minute))
+				tracker.CycleFinished(now.Add(-time.Minute), false)
+			},
+			wantReport: SchedulerReport{
+				LastSuccessfulCycle: &metav1.Time{Time: now.Add(-9 * time.Minute)},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tracker := &SchedulerTracker{}
+			if tc.track != nil {
+				tc.track(tracker)
+			}
+			reporter := NewReporter(nil, prometheus.NewRegistry(), tracker, testingclock.NewFakeClock(now))
+			if diff := cmp.Diff(tc.wantReport, tracker.report()); diff != "" {
+				t.Errorf("Unexpected report (-want,+got):\n%s", diff)
+			}
+			err := reporter.SchedulerProgressing()(nil)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("Unexpected error, want error: %v, got: %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestControllers(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	depth := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: workqueueDepth}, []string{"name", "controller", "priority"})
+	unfinishedWork := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: workqueueUnfinishedWork}, []string{"name", "controller"})
+	longestRunningProcessor := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: workqueueLongestRunningProcessor}, []string{"name", "controller"})
+	other := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "other"}, []string{"controller"})
+	registry.MustRegister(depth, unfinishedWork, longestRunningProcessor, other)
+
+	depth.WithLabelValues("workload", "workload", "").Set(3)
+	depth.WithLabelValues("workload", "workload", "10").Set(2)
+	depth.WithLabelValues("clusterqueue", "clusterqueue", "").Set(1)
+	unfinishedWork.WithLabelValues("workload", "workload").Set(12.5)
+	longestRunningProcessor.WithLabelValues("workload", "workload").Set(4)
+	other.WithLabelValues("localqueue").Set(7)
+
+	reporter := NewReporter(nil, registry, &SchedulerTracker{}, testingclock.NewFakeClock(time.Now()))
+	got, err := reporter.controllers()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := []ControllerReport{
+		{Name: "clusterqueue", QueueDepth: 1},
+		{Name: "workload", QueueDepth: 5, LongestRunningProcessorSeconds: 4, UnfinishedWorkSeconds: 12.5},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Unexpected controllers (-want,+got):\n%s", diff)
+	}
+}
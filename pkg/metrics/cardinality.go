@@ -0,0 +1,164 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
+)
+
+var droppedSeries = prometheus.NewDesc(
+	prometheus.BuildFQName(constants.KueueName, "", "metrics_dropped_series"),
+	"The number of series of a metric dropped on the last scrape, beyond the maximum number of series per metric",
+	[]string{"metric"}, nil,
+)
+
+// cardinalityLimitingCollector collects the metrics of the collectors, without
+// the excluded labels and with at most maxSeries series per metric.
+type cardinalityLimitingCollector struct {
+	registry       *prometheus.Registry
+	excludedLabels sets.Set[string]
+	maxSeries      int
+	log            logr.Logger
+}
+
+var _ prometheus.Collector = (*cardinalityLimitingCollector)(nil)
+
+func newCardinalityLimitingCollector(cfg *config.MetricsCardinality, collectors ...prometheus.Collector) *cardinalityLimitingCollector {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors...)
+	c := &cardinalityLimitingCollector{
+		registry:       registry,
+		excludedLabels: sets.New[string](),
+		maxSeries:      int(ptr.Deref(cfg.MaxSeriesPerMetric, 0)),
+		log:            ctrl.Log.WithName("metrics-cardinality"),
+	}
+	for _, label := range cfg.ExcludedLabels {
+		c.excludedLabels.Insert(string(label))
+	}
+	return c
+}
+
+// Describe implements prometheus.Collector interface. The collector is unchecked,
+// as the labels of the metrics are only known on collection.
+func (c *cardinalityLimitingCollector) Describe(chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector interface
+func (c *cardinalityLimitingCollector) Collect(ch chan<- prometheus.Metric) {
+	families, err := c.registry.Gather()
+	if err != nil {
+		// The families gathered successfully are still collected.
+		c.log.Error(err, "Failed to gather the metrics")
+	}
+	for _, family := range families {
+		c.collectFamily(ch, family)
+	}
+}
+
+// series is the aggregation of the series of a metric with the same values
+// of the labels which are not excluded.
+type series struct {
+	labelValues []string
+	value       float64
+	count       uint64
+	sum         float64
+	buckets     map[float64]uint64
+}
+
+func (c *cardinalityLimitingCollector) collectFamily(ch chan<- prometheus.Metric, family *dto.MetricFamily) {
+	metrics := family.GetMetric()
+	if len(metrics) == 0 {
+		return
+	}
+	var labelNames []string
+	for _, l := range metrics[0].GetLabel() {
+		if !c.excludedLabels.Has(l.GetName()) {
+			labelNames = append(labelNames, l.GetName())
+		}
+	}
+	desc := prometheus.NewDesc(family.GetName(), family.GetHelp(), labelNames, nil)
+
+	var keys []string
+	aggregated := make(map[string]*series)
+	for _, m := range metrics {
+		labelValues := make([]string, 0, len(labelNames))
+		for _, l := range m.GetLabel() {
+			if !c.excludedLabels.Has(l.GetName()) {
+				labelValues = append(labelValues, l.GetValue())
+			}
+		}
+		key := strings.Join(labelValues, "\xff")
+		s, found := aggregated[key]
+		if !found {
+			s = &series{labelValues: labelValues, buckets: make(map[float64]uint64)}
+			aggregated[key] = s
+			keys = append(keys, key)
+		}
+		switch family.GetType() {
+		case dto.MetricType_COUNTER:
+			s.value += m.GetCounter().GetValue()
+		case dto.MetricType_GAUGE:
+			s.value += m.GetGauge().GetValue()
+		case dto.MetricType_HISTOGRAM:
+			h := m.GetHistogram()
+			s.count += h.GetSampleCount()
+			s.sum += h.GetSampleSum()
+			for _, b := range h.GetBucket() {
+				s.buckets[b.GetUpperBound()] += b.GetCumulativeCount()
+			}
+		default:
+			s.value += m.GetUntyped().GetValue()
+		}
+	}
+
+	if c.maxSeries > 0 {
+		dropped := max(0, len(keys)-c.maxSeries)
+		keys = keys[:len(keys)-dropped]
+		ch <- prometheus.MustNewConstMetric(droppedSeries, prometheus.GaugeValue, float64(dropped), family.GetName())
+	}
+	for _, key := range keys {
+		s := aggregated[key]
+		var (
+			metric prometheus.Metric
+			err    error
+		)
+		switch family.GetType() {
+		case dto.MetricType_COUNTER:
+			metric, err = prometheus.NewConstMetric(desc, prometheus.CounterValue, s.value, s.labelValues...)
+		case dto.MetricType_GAUGE:
+			metric, err = prometheus.NewConstMetric(desc, prometheus.GaugeValue, s.value, s.labelValues...)
+		case dto.MetricType_HISTOGRAM:
+			metric, err = prometheus.NewConstHistogram(desc, s.count, s.sum, s.buckets, s.labelValues...)
+		default:
+			metric, err = prometheus.NewConstMetric(desc, prometheus.UntypedValue, s.value, s.labelValues...)
+		}
+		if err != nil {
+			c.log.Error(err, "Failed to collect the metric", "metric", family.GetName())
+			continue
+		}
+		ch <- metric
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/utils/ptr"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+)
+
+type gatheredSeries struct {
+	Labels map[string]string
+	Value  float64
+	Count  uint64
+}
+
+func gatherSeries(t *testing.T, collector prometheus.Collector) map[string][]gatheredSeries {
+	t.Helper()
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("Failed to gather the metrics: %v", err)
+	}
+	result := make(map[string][]gatheredSeries, len(families))
+	for _, family := range families {
+		for _, m := range family.GetMetric() {
+			s := gatheredSeries{Labels: make(map[string]string)}
+			for _, l := range m.GetLabel() {
+				s.Labels[l.GetName()] = l.GetValue()
+			}
+			switch {
+			case m.GetCounter() != nil:
+				s.Value = m.GetCounter().GetValue()
+			case m.GetGauge() != nil:
+				s.Value = m.GetGauge().GetValue()
+			case m.GetHistogram() != nil:
+				s.Value = m.GetHistogram().GetSampleSum()
+				s.Count = m.GetHistogram().GetSampleCount()
+			}
+			result[family.GetName()] = append(result[family.GetName()], s)
+		}
+	}
+	return result
+}
+
+func TestCardinalityLimitingCollector(t *testing.T) {
+	newCollectors := func() []prometheus.Collector {
+		usage := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "usage"}, []string{"cluster_queue", "flavor"})
+		usage.WithLabelValues("cq1", "on-demand").Set(3)
+		usage.WithLabelValues("cq1", "spot").Set(2)
+		usage.WithLabelValues("cq2", "spot").Set(1)
+		admitted := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "admitted_total"}, []string{"name", "namespace", "priority_class"})
+		admitted.WithLabelValues("lq", "ns1", "high").Add(4)
+		admitted.WithLabelValues("lq", "ns2", "low").Add(1)
+		waitTime := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "wait_time_seconds", Buckets: []float64{1, 10}}, []string{"cluster_queue", "priority_class"})
+		waitTime.WithLabelValues("cq1", "high").Observe(0.5)
+		waitTime.WithLabelValues("cq1", "low").Observe(5)
+		return []prometheus.Collector{usage, admitted, waitTime}
+	}
+
+	cases := map[string]struct {
+		cfg  config.MetricsCardinality
+		want map[string][]gatheredSeries
+	}{
+		"no limit": {
+			want: map[string][]gatheredSeries{
+				"usage": {
+					{Labels: map[string]string{"cluster_queue": "cq1", "flavor": "on-demand"}, Value: 3},
+					{Labels: map[string]string{"cluster_queue": "cq1", "flavor": "spot"}, Value: 2},
+					{Labels: map[string]string{"cluster_queue": "cq2", "flavor": "spot"}, Value: 1},
+				},
+				"admitted_total": {
+					{Labels: map[string]string{"name": "lq", "namespace": "ns1", "priority_class": "high"}, Value: 4},
+					{Labels: map[string]string{"name": "lq", "namespace": "ns2", "priority_class": "low"}, Value: 1},
+				},
+				"wait_time_seconds": {
+					{Labels: map[string]string{"cluster_queue": "cq1", "priority_class": "high"}, Value: 0.5, Count: 1},
+					{Labels: map[string]string{"cluster_queue": "cq1", "priority_class": "low"}, Value: 5, Count: 1},
+				},
+			},
+		},
+		"excluded labels": {
+			cfg: config.MetricsCardinality{
+				ExcludedLabels: []config.MetricsLabel{config.MetricsLabelNamespace, config.MetricsLabelPriorityClass, config.MetricsLabelFlavor},
+			},
+			want: map[string][]gatheredSeries{
+				"usage": {
+					{Labels: map[string]string{"cluster_queue": "cq1"}, Value: 5},
+					{Labels: map[string]string{"cluster_queue": "cq2"}, Value: 1},
+				},
+				"admitted_total": {
+					{Labels: map[string]string{"name": "lq"}, Value: 5},
+				},
+				"wait_time_seconds": {
+					{Labels: map[string]string{"cluster_queue": "cq1"}, Value: 5.5, Count: 2},
+				},
+			},
+		},
+		"max series per metric": {
+			cfg: config.MetricsCardinality{
+				MaxSeriesPerMetric: ptr.To[int32](2),
+			},
+			want: map[string][]gatheredSeries{
+				"usage": {
+					{Labels: map[string]string{"cluster_queue": "cq1", "flavor": "on-demand"}, Value: 3},
+					{Labels: map[string]string{"cluster_queue": "cq1", "flavor": "spot"}, Value: 2},
+				},
+				"admitted_total": {
+					{Labels: map[string]string{"name": "lq", "namespace": "ns1", "priority_class": "high"}, Value: 4},
+					{Labels: map[string]string{"name": "lq", "namespace": "ns2", "priority_class": "low"}, Value: 1},
+				},
+				"wait_time_seconds": {
+					{Labels: map[string]string{"cluster_queue": "cq1", "priority_class": "high"}, Value: 0.5, Count: 1},
+					{Labels: map[string]string{"cluster_queue": "cq1", "priority_class": "low"}, Value: 5, Count: 1},
+				},
+				"kueue_metrics_dropped_series": {
+					{Labels: map[string]string{"metric": "admitted_total"}, Value: 0},
+					{Labels: map[string]string{"metric": "usage"}, Value: 1},
+					{Labels: map[string]string{"metric": "wait_time_seconds"}, Value: 0},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := gatherSeries(t, newCardinalityLimitingCollector(&tc.cfg, newCollectors()...))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected series (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,16 +21,22 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/constants"
 	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/util/queue"
 	"sigs.k8s.io/kueue/pkg/version"
 )
 
 type AdmissionResult string
 type ClusterQueueStatus string
+type SchedulingCyclePhase string
+type SkippedHeadsReason string
+type UtilizationBasis string
 
 type LocalQueueReference struct {
 	Name      kueue.LocalQueueName
@@ -41,6 +47,17 @@ const (
 	AdmissionResultSuccess      AdmissionResult = "success"
 	AdmissionResultInadmissible AdmissionResult = "inadmissible"
 
+	SchedulingCyclePhaseSnapshot SchedulingCyclePhase = "snapshot"
+	SchedulingCyclePhaseNominate SchedulingCyclePhase = "nominate"
+	SchedulingCyclePhaseAdmit    SchedulingCyclePhase = "admit"
+	SchedulingCyclePhaseRequeue  SchedulingCyclePhase = "requeue"
+
+	SkippedHeadsReasonMaxHeads               SkippedHeadsReason = "MaxHeads"
+	SkippedHeadsReasonMaxAdmissionsPerCohort SkippedHeadsReason = "MaxAdmissionsPerCohort"
+
+	UtilizationBasisNominalQuota     UtilizationBasis = "nominal_quota"
+	UtilizationBasisPhysicalCapacity UtilizationBasis = "physical_capacity"
+
 	PendingStatusActive       = "active"
 	PendingStatusInadmissible = "inadmissible"
 
@@ -96,6 +113,30 @@ The label 'result' can have the following values:
 		}, []string{"cluster_queue"},
 	)
 
+	schedulingCycleDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: constants.KueueName,
+			Name:      "scheduling_cycle_duration_seconds",
+			Help: `The latency of the phases of a scheduling cycle.
+The label 'phase' can have the following values:
+- 'snapshot' means taking the snapshot of the cache,
+- 'nominate' means computing the flavor assignments of the heads,
+- 'admit' means admitting the nominated workloads or issuing preemptions,
+- 'requeue' means putting the workloads which weren't admitted back in their queues.`,
+		}, []string{"phase"},
+	)
+
+	SchedulingCycleSkippedHeadsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: constants.KueueName,
+			Name:      "scheduling_cycle_skipped_heads_total",
+			Help: `The total number of ClusterQueue heads put back in their queues because of the limits of the scheduling cycle.
+The label 'reason' can have the following values:
+- 'MaxHeads' means that the head wasn't considered, because of the schedulingCycle.maxHeads limit,
+- 'MaxAdmissionsPerCohort' means that the head wasn't admitted, because of the schedulingCycle.maxAdmissionsPerCohort limit.`,
+		}, []string{"reason"},
+	)
+
 	// Metrics tied to the queue system.
 
 	buildInfo = prometheus.NewGaugeVec(
@@ -359,6 +400,78 @@ The label 'reason' can have the following values:
 		}, []string{"preempting_cluster_queue", "reason"},
 	)
 
+	AdmissionSLOBreachedWorkloadsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: constants.KueueName,
+			Name:      "admission_slo_breached_workloads_total",
+			Help:      "The number of workloads pending for longer than their admission target, per 'cluster_queue'",
+		}, []string{"cluster_queue", "priority_class"},
+	)
+
+	TimeToQuotaReservation = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: constants.KueueName,
+			Name:      "time_to_quota_reservation_seconds",
+			Help: `The time between a workload was created until it got its first quota reservation, per 'cluster_queue'.
+The label 'priority_class' is the name of the WorkloadPriorityClass of the workload, empty for the workloads without a WorkloadPriorityClass.`,
+			Buckets: generateExponentialBuckets(14),
+		}, []string{"cluster_queue", "priority_class"},
+	)
+
+	TimeToAdmission = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: constants.KueueName,
+			Name:      "time_to_admission_seconds",
+			Help: `The time between a workload was created until its first admission, per 'cluster_queue'.
+The label 'priority_class' is the name of the WorkloadPriorityClass of the workload, empty for the workloads without a WorkloadPriorityClass.`,
+			Buckets: generateExponentialBuckets(14),
+		}, []string{"cluster_queue", "priority_class"},
+	)
+
+	StaleProvisioningRequestsDeletedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: constants.KueueName,
+			Name:      "stale_provisioning_requests_deleted_total",
+			Help: `The number of stale ProvisioningRequests deleted by Kueue,
+The label 'reason' can have the following values:
+- "WorkloadDeleted" means that the workload owning the ProvisioningRequest was deleted.
+- "WorkloadFinished" means that the workload owning the ProvisioningRequest finished.
+- "CheckRejected" means that the admission check of the ProvisioningRequest was rejected.
+- "FlavorReassigned" means that the workload got quota reserved on other flavors.
+- "BookingExpired" means that the booking of the capacity of the admitted workload expired.`,
+		}, []string{"reason"},
+	)
+
+	NamespaceAdmittedWorkloadsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: constants.KueueName,
+			Name:      "namespace_admitted_workloads_total",
+			Help:      "The total number of admitted workloads per 'namespace', for the namespaces of the namespaceAllowlist of the metrics configuration",
+		}, []string{"namespace"},
+	)
+
+	NamespaceAdmissionWaitTime = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: constants.KueueName,
+			Name:      "namespace_admission_wait_time_seconds",
+			Help:      "The time between a workload was created or requeued until admission, per 'namespace', for the namespaces of the namespaceAllowlist of the metrics configuration",
+			Buckets:   generateExponentialBuckets(14),
+		}, []string{"namespace"},
+	)
+
+	NamespaceEvictedWorkloadsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: constants.KueueName,
+			Name:      "namespace_evicted_workloads_total",
+			Help: `The number of evicted workloads per 'namespace', for the namespaces of the namespaceAllowlist of the metrics configuration.
+The label 'reason' has the same values as in the evicted_workloads_total metric.`,
+		}, []string{"namespace", "reason"},
+	)
+
+	// namespaceAllowlist is the set of namespaces for which the metrics per
+	// namespace are reported.
+	namespaceAllowlist sets.Set[string]
+
 	// Metrics tied to the cache.
 
 	ReservingActiveWorkloads = prometheus.NewGaugeVec(
@@ -429,6 +542,32 @@ For a LocalQueue, the metric only reports a value of 1 for one of the statuses.`
 		}, []string{"cohort", "cluster_queue", "flavor", "resource"},
 	)
 
+	ClusterQueueResourceBorrowed = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: constants.KueueName,
+			Name:      "cluster_queue_borrowed_quota",
+			Help:      `Reports the cluster_queue's resource reservation above its nominal quota, borrowed from the cohort, within all the flavors`,
+		}, []string{"cohort", "cluster_queue", "flavor", "resource"},
+	)
+
+	ClusterQueueResourceLent = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: constants.KueueName,
+			Name:      "cluster_queue_lent_quota",
+			Help: `Reports the cluster_queue's nominal quota used by the other cluster_queues of the cohort, within all the flavors.
+The quota borrowed in the cohort is attributed to the lending cluster_queues in proportion to their unused lendable quota`,
+		}, []string{"cohort", "cluster_queue", "flavor", "resource"},
+	)
+
+	ClusterQueueResourceUsageByLabel = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: constants.KueueName,
+			Name:      "cluster_queue_resource_usage_by_label",
+			Help: `Reports the cluster_queue's total resource usage within all the flavors,
+per value of the workload labels configured in the workloadGroupingLabels of the metrics configuration`,
+		}, []string{"cluster_queue", "flavor", "resource", "label", "value"},
+	)
+
 	LocalQueueResourceReservations = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Subsystem: constants.KueueName,
@@ -469,6 +608,37 @@ For a LocalQueue, the metric only reports a value of 1 for one of the statuses.`
 		}, []string{"cohort", "cluster_queue", "flavor", "resource"},
 	)
 
+	ResourceFlavorPhysicalHeadroom = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: constants.KueueName,
+			Name:      "resource_flavor_physical_headroom",
+			Help: `Reports the allocatable quantity of the resource on the Nodes of the flavor
+which is not used by the workloads in all the ClusterQueues`,
+		}, []string{"flavor", "resource"},
+	)
+
+	ClusterQueueFlavorUtilization = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: constants.KueueName,
+			Name:      "cluster_queue_flavor_utilization",
+			Help: `Reports the percentage of the capacity of the flavor resource used by the admitted workloads of the cluster_queue.
+The label 'basis' can have the following values:
+- 'nominal_quota' means that the capacity is the nominal quota of the cluster_queue.
+- 'physical_capacity' means that the capacity is the allocatable quantity of the resource on the Nodes of the flavor.`,
+		}, []string{"cohort", "cluster_queue", "flavor", "resource", "basis"},
+	)
+
+	CohortFlavorUtilization = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: constants.KueueName,
+			Name:      "cohort_flavor_utilization",
+			Help: `Reports the percentage of the capacity of the flavor resource used by the admitted workloads of the cohort subtree.
+The label 'basis' can have the following values:
+- 'nominal_quota' means that the capacity is the nominal quota of the cohort subtree.
+- 'physical_capacity' means that the capacity is the allocatable quantity of the resource on the Nodes of the flavor.`,
+		}, []string{"cohort", "flavor", "resource", "basis"},
+	)
+
 	ClusterQueueWeightedShare = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Subsystem: constants.KueueName,
@@ -510,6 +680,14 @@ func AdmissionAttempt(result AdmissionResult, duration time.Duration) {
 	admissionAttemptDuration.WithLabelValues(string(result)).Observe(duration.Seconds())
 }
 
+func ReportSchedulingCyclePhase(phase SchedulingCyclePhase, duration time.Duration) {
+	schedulingCycleDuration.WithLabelValues(string(phase)).Observe(duration.Seconds())
+}
+
+func ReportSchedulingCycleSkippedHeads(reason SkippedHeadsReason, count int) {
+	SchedulingCycleSkippedHeadsTotal.WithLabelValues(string(reason)).Add(float64(count))
+}
+
 func QuotaReservedWorkload(cqName kueue.ClusterQueueReference, priorityClass string, waitTime time.Duration) {
 	QuotaReservedWorkloadsTotal.WithLabelValues(string(cqName), priorityClass).Inc()
 	QuotaReservedWaitTime.WithLabelValues(string(cqName), priorityClass).Observe(waitTime.Seconds())
@@ -530,6 +708,27 @@ func LocalQueueAdmittedWorkload(lq LocalQueueReference, priorityClass string, wa
 	LocalQueueAdmissionWaitTime.WithLabelValues(string(lq.Name), lq.Namespace, priorityClass).Observe(waitTime.Seconds())
 }
 
+// SetNamespaceAllowlist sets the namespaces for which the metrics per namespace
+// are reported. It is expected to be called before the metrics are reported.
+func SetNamespaceAllowlist(namespaces []string) {
+	namespaceAllowlist = sets.New(namespaces...)
+}
+
+func NamespaceAdmittedWorkload(namespace string, waitTime time.Duration) {
+	if !namespaceAllowlist.Has(namespace) {
+		return
+	}
+	NamespaceAdmittedWorkloadsTotal.WithLabelValues(namespace).Inc()
+	NamespaceAdmissionWaitTime.WithLabelValues(namespace).Observe(waitTime.Seconds())
+}
+
+func ReportNamespaceEvictedWorkloads(namespace, reason string) {
+	if !namespaceAllowlist.Has(namespace) {
+		return
+	}
+	NamespaceEvictedWorkloadsTotal.WithLabelValues(namespace, reason).Inc()
+}
+
 func ReportAdmissionChecksWaitTime(cqName kueue.ClusterQueueReference, priorityClass string, waitTime time.Duration) {
 	AdmissionChecksWaitTime.WithLabelValues(string(cqName), priorityClass).Observe(waitTime.Seconds())
 }
@@ -584,10 +783,35 @@ func ReportPreemption(preemptingCqName kueue.ClusterQueueReference, preemptingRe
 	PreemptedWorkloadsTotal.WithLabelValues(string(preemptingCqName), preemptingReason).Inc()
 }
 
+func ReportAdmissionSLOBreached(cqName kueue.ClusterQueueReference, priorityClass string) {
+	AdmissionSLOBreachedWorkloadsTotal.WithLabelValues(string(cqName), priorityClass).Inc()
+}
+
+func ReportTimeToQuotaReservation(cqName kueue.ClusterQueueReference, priorityClass string, waitTime time.Duration) {
+	TimeToQuotaReservation.WithLabelValues(string(cqName), priorityClass).Observe(waitTime.Seconds())
+}
+
+func ReportTimeToAdmission(cqName kueue.ClusterQueueReference, priorityClass string, waitTime time.Duration) {
+	TimeToAdmission.WithLabelValues(string(cqName), priorityClass).Observe(waitTime.Seconds())
+}
+
+// WorkloadPriorityClassLabel returns the value of the 'priority_class' label of the
+// admission latency metrics, which is bounded to the WorkloadPriorityClasses.
+func WorkloadPriorityClassLabel(wl *kueue.Workload) string {
+	if wl.Spec.PriorityClassSource != constants.WorkloadPriorityClassSource {
+		return ""
+	}
+	return wl.Spec.PriorityClassName
+}
+
+func ReportStaleProvisioningRequestDeleted(reason string) {
+	StaleProvisioningRequestsDeletedTotal.WithLabelValues(reason).Inc()
+}
+
 func LQRefFromWorkload(wl *kueue.Workload) LocalQueueReference {
 	return LocalQueueReference{
 		Name:      wl.Spec.QueueName,
-		Namespace: wl.Namespace,
+		Namespace: queue.NamespaceForWorkload(wl),
 	}
 }
 
@@ -606,6 +830,10 @@ func ClearClusterQueueMetrics(cqName string) {
 	EvictedWorkloadsTotal.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
 	EvictedWorkloadsOnceTotal.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
 	PreemptedWorkloadsTotal.DeletePartialMatch(prometheus.Labels{"preempting_cluster_queue": cqName})
+	AdmissionSLOBreachedWorkloadsTotal.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
+	TimeToQuotaReservation.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
+	TimeToAdmission.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
+	ClusterQueueResourceUsageByLabel.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
 }
 
 func ClearLocalQueueMetrics(lq LocalQueueReference) {
@@ -673,6 +901,18 @@ func ReportClusterQueueResourceReservations(cohort kueue.CohortReference, queue,
 	ClusterQueueResourceReservations.WithLabelValues(string(cohort), queue, flavor, resource).Set(usage)
 }
 
+func ReportClusterQueueResourceBorrowed(cohort kueue.CohortReference, queue, flavor, resource string, borrowed float64) {
+	ClusterQueueResourceBorrowed.WithLabelValues(string(cohort), queue, flavor, resource).Set(borrowed)
+}
+
+func ReportClusterQueueResourceLent(cohort kueue.CohortReference, queue, flavor, resource string, lent float64) {
+	ClusterQueueResourceLent.WithLabelValues(string(cohort), queue, flavor, resource).Set(lent)
+}
+
+func ClearClusterQueueResourceLent(cqName string) {
+	ClusterQueueResourceLent.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
+}
+
 func ReportLocalQueueResourceReservations(lq LocalQueueReference, flavor, resource string, usage float64) {
 	LocalQueueResourceReservations.WithLabelValues(string(lq.Name), lq.Namespace, flavor, resource).Set(usage)
 }
@@ -681,6 +921,14 @@ func ReportClusterQueueResourceUsage(cohort kueue.CohortReference, queue, flavor
 	ClusterQueueResourceUsage.WithLabelValues(string(cohort), queue, flavor, resource).Set(usage)
 }
 
+func ReportClusterQueueResourceUsageByLabel(queue, flavor, resource, label, value string, usage float64) {
+	ClusterQueueResourceUsageByLabel.WithLabelValues(queue, flavor, resource, label, value).Set(usage)
+}
+
+func ClearClusterQueueResourceUsageByLabel(cqName string) {
+	ClusterQueueResourceUsageByLabel.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
+}
+
 func ReportLocalQueueResourceUsage(lq LocalQueueReference, flavor, resource string, usage float64) {
 	LocalQueueResourceUsage.WithLabelValues(string(lq.Name), lq.Namespace, flavor, resource).Set(usage)
 }
@@ -704,6 +952,9 @@ func ClearClusterQueueResourceMetrics(cqName string) {
 	}
 	ClusterQueueResourceUsage.DeletePartialMatch(lbls)
 	ClusterQueueResourceReservations.DeletePartialMatch(lbls)
+	ClusterQueueResourceBorrowed.DeletePartialMatch(lbls)
+	ClusterQueueResourceLent.DeletePartialMatch(lbls)
+	ClusterQueueFlavorUtilization.DeletePartialMatch(lbls)
 }
 
 func ClearLocalQueueResourceMetrics(lq LocalQueueReference) {
@@ -715,6 +966,30 @@ func ClearLocalQueueResourceMetrics(lq LocalQueueReference) {
 	LocalQueueResourceUsage.DeletePartialMatch(lbls)
 }
 
+func ReportResourceFlavorPhysicalHeadroom(flavor kueue.ResourceFlavorReference, resource string, headroom float64) {
+	ResourceFlavorPhysicalHeadroom.WithLabelValues(string(flavor), resource).Set(headroom)
+}
+
+func ClearResourceFlavorPhysicalHeadroom(flavor kueue.ResourceFlavorReference) {
+	ResourceFlavorPhysicalHeadroom.DeletePartialMatch(prometheus.Labels{"flavor": string(flavor)})
+}
+
+func ReportClusterQueueFlavorUtilization(cohort kueue.CohortReference, queue, flavor, resource string, basis UtilizationBasis, utilization float64) {
+	ClusterQueueFlavorUtilization.WithLabelValues(string(cohort), queue, flavor, resource, string(basis)).Set(utilization)
+}
+
+func ClearClusterQueueFlavorUtilization(cqName string) {
+	ClusterQueueFlavorUtilization.DeletePartialMatch(prometheus.Labels{"cluster_queue": cqName})
+}
+
+func ReportCohortFlavorUtilization(cohort kueue.CohortReference, flavor, resource string, basis UtilizationBasis, utilization float64) {
+	CohortFlavorUtilization.WithLabelValues(string(cohort), flavor, resource, string(basis)).Set(utilization)
+}
+
+func ClearCohortFlavorUtilization(cohort kueue.CohortReference) {
+	CohortFlavorUtilization.DeletePartialMatch(prometheus.Labels{"cohort": string(cohort)})
+}
+
 func ClearClusterQueueResourceQuotas(cqName, flavor, resource string) {
 	lbls := prometheus.Labels{
 		"cluster_queue": cqName,
@@ -756,14 +1031,20 @@ func ClearClusterQueueResourceReservations(cqName, flavor, resource string) {
 	}
 
 	ClusterQueueResourceReservations.DeletePartialMatch(lbls)
+	ClusterQueueResourceBorrowed.DeletePartialMatch(lbls)
 }
 
-func Register() {
-	metrics.Registry.MustRegister(
+// Register registers the metrics of Kueue in the registry of the controller-runtime
+// metrics. When the MetricsCardinalityLimits feature gate is enabled, the
+// cardinality of the metrics is limited as set in the configuration.
+func Register(cardinality *config.MetricsCardinality) {
+	collectors := []prometheus.Collector{
 		buildInfo,
 		AdmissionAttemptsTotal,
 		admissionAttemptDuration,
 		AdmissionCyclePreemptionSkips,
+		schedulingCycleDuration,
+		SchedulingCycleSkippedHeadsTotal,
 		PendingWorkloads,
 		ReservingActiveWorkloads,
 		AdmittedActiveWorkloads,
@@ -774,6 +1055,10 @@ func Register() {
 		EvictedWorkloadsTotal,
 		EvictedWorkloadsOnceTotal,
 		PreemptedWorkloadsTotal,
+		AdmissionSLOBreachedWorkloadsTotal,
+		TimeToQuotaReservation,
+		TimeToAdmission,
+		StaleProvisioningRequestsDeletedTotal,
 		AdmissionWaitTime,
 		AdmissionChecksWaitTime,
 		QueuedUntilReadyWaitTime,
@@ -781,32 +1066,49 @@ func Register() {
 		ClusterQueueResourceUsage,
 		ClusterQueueByStatus,
 		ClusterQueueResourceReservations,
+		ClusterQueueResourceBorrowed,
+		ClusterQueueResourceLent,
 		ClusterQueueResourceNominalQuota,
 		ClusterQueueResourceBorrowingLimit,
 		ClusterQueueResourceLendingLimit,
 		ClusterQueueWeightedShare,
 		CohortWeightedShare,
-	)
+	}
 	if features.Enabled(features.LocalQueueMetrics) {
-		RegisterLQMetrics()
+		collectors = append(collectors, lqMetrics...)
+	}
+	if features.Enabled(features.FlavorPhysicalCapacity) {
+		collectors = append(collectors, ResourceFlavorPhysicalHeadroom)
+	}
+	if features.Enabled(features.WorkloadGroupingMetrics) {
+		collectors = append(collectors, ClusterQueueResourceUsageByLabel)
+	}
+	if features.Enabled(features.FlavorUtilizationMetrics) {
+		collectors = append(collectors, ClusterQueueFlavorUtilization, CohortFlavorUtilization)
 	}
+	if features.Enabled(features.NamespaceMetrics) {
+		collectors = append(collectors, NamespaceAdmittedWorkloadsTotal, NamespaceAdmissionWaitTime, NamespaceEvictedWorkloadsTotal)
+	}
+	if features.Enabled(features.MetricsCardinalityLimits) && cardinality != nil {
+		metrics.Registry.MustRegister(newCardinalityLimitingCollector(cardinality, collectors...))
+		return
+	}
+	metrics.Registry.MustRegister(collectors...)
 }
 
-func RegisterLQMetrics() {
-	metrics.Registry.MustRegister(
-		LocalQueuePendingWorkloads,
-		LocalQueueReservingActiveWorkloads,
-		LocalQueueAdmittedActiveWorkloads,
-		LocalQueueQuotaReservedWorkloadsTotal,
-		LocalQueueQuotaReservedWaitTime,
-		LocalQueueAdmittedWorkloadsTotal,
-		LocalQueueAdmissionWaitTime,
-		LocalQueueAdmissionChecksWaitTime,
-		LocalQueueQueuedUntilReadyWaitTime,
-		LocalQueueAdmittedUntilReadyWaitTime,
-		LocalQueueEvictedWorkloadsTotal,
-		LocalQueueByStatus,
-		LocalQueueResourceReservations,
-		LocalQueueResourceUsage,
-	)
+var lqMetrics = []prometheus.Collector{
+	LocalQueuePendingWorkloads,
+	LocalQueueReservingActiveWorkloads,
+	LocalQueueAdmittedActiveWorkloads,
+	LocalQueueQuotaReservedWorkloadsTotal,
+	LocalQueueQuotaReservedWaitTime,
+	LocalQueueAdmittedWorkloadsTotal,
+	LocalQueueAdmissionWaitTime,
+	LocalQueueAdmissionChecksWaitTime,
+	LocalQueueQueuedUntilReadyWaitTime,
+	LocalQueueAdmittedUntilReadyWaitTime,
+	LocalQueueEvictedWorkloadsTotal,
+	LocalQueueByStatus,
+	LocalQueueResourceReservations,
+	LocalQueueResourceUsage,
 }
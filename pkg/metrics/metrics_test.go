@@ -18,11 +18,13 @@ package metrics
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/prometheus/client_golang/prometheus"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
 	"sigs.k8s.io/kueue/pkg/util/testing/metrics"
 	"sigs.k8s.io/kueue/pkg/version"
 )
@@ -61,8 +63,16 @@ func TestReportAndCleanupClusterQueueMetrics(t *testing.T) {
 	ReportClusterQueueResourceUsage("cohort", "queue", "flavor", "res", 7)
 	ReportClusterQueueResourceUsage("cohort", "queue", "flavor2", "res", 3)
 
+	ReportClusterQueueResourceBorrowed("cohort", "queue", "flavor", "res", 2)
+	ReportClusterQueueResourceBorrowed("cohort", "queue", "flavor2", "res", 0)
+
+	ReportClusterQueueResourceLent("cohort", "queue", "flavor", "res", 0)
+	ReportClusterQueueResourceLent("cohort", "queue", "flavor2", "res", 1)
+
 	expectFilteredMetricsCount(t, ClusterQueueResourceReservations, 2, "cluster_queue", "queue")
 	expectFilteredMetricsCount(t, ClusterQueueResourceUsage, 2, "cluster_queue", "queue")
+	expectFilteredMetricsCount(t, ClusterQueueResourceBorrowed, 2, "cluster_queue", "queue")
+	expectFilteredMetricsCount(t, ClusterQueueResourceLent, 2, "cluster_queue", "queue")
 
 	ClearClusterQueueResourceMetrics("queue")
 
@@ -71,6 +81,8 @@ func TestReportAndCleanupClusterQueueMetrics(t *testing.T) {
 	expectFilteredMetricsCount(t, ClusterQueueResourceLendingLimit, 0, "cluster_queue", "queue")
 	expectFilteredMetricsCount(t, ClusterQueueResourceReservations, 0, "cluster_queue", "queue")
 	expectFilteredMetricsCount(t, ClusterQueueResourceUsage, 0, "cluster_queue", "queue")
+	expectFilteredMetricsCount(t, ClusterQueueResourceBorrowed, 0, "cluster_queue", "queue")
+	expectFilteredMetricsCount(t, ClusterQueueResourceLent, 0, "cluster_queue", "queue")
 }
 
 func TestReportAndCleanupClusterQueueQuotas(t *testing.T) {
@@ -219,3 +231,59 @@ func TestLocalQueueQuotaReservedWaitTimeHasPriorityLabel(t *testing.T) {
 	ClearLocalQueueMetrics(lq)
 	expectFilteredMetricsCount(t, LocalQueueQuotaReservedWaitTime, 0, "name", "lq2", "namespace", "ns2")
 }
+
+func TestReportAndCleanupClusterQueueTimeToAdmission(t *testing.T) {
+	ReportTimeToQuotaReservation("cq1", "high", time.Minute)
+	ReportTimeToAdmission("cq1", "high", time.Minute)
+	ReportTimeToAdmission("cq1", "", time.Second)
+	ReportTimeToAdmission("cq2", "high", time.Second)
+
+	expectFilteredMetricsCount(t, TimeToQuotaReservation, 1, "cluster_queue", "cq1")
+	expectFilteredMetricsCount(t, TimeToAdmission, 2, "cluster_queue", "cq1")
+	expectFilteredMetricsCount(t, TimeToAdmission, 1, "cluster_queue", "cq2")
+
+	ClearClusterQueueMetrics("cq1")
+	expectFilteredMetricsCount(t, TimeToQuotaReservation, 0, "cluster_queue", "cq1")
+	expectFilteredMetricsCount(t, TimeToAdmission, 0, "cluster_queue", "cq1")
+	expectFilteredMetricsCount(t, TimeToAdmission, 1, "cluster_queue", "cq2")
+}
+
+func TestWorkloadPriorityClassLabel(t *testing.T) {
+	cases := map[string]struct {
+		spec kueue.WorkloadSpec
+		want string
+	}{
+		"workload priority class": {
+			spec: kueue.WorkloadSpec{PriorityClassName: "high", PriorityClassSource: constants.WorkloadPriorityClassSource},
+			want: "high",
+		},
+		"pod priority class": {
+			spec: kueue.WorkloadSpec{PriorityClassName: "system-node-critical", PriorityClassSource: constants.PodPriorityClassSource},
+		},
+		"no priority class": {},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := WorkloadPriorityClassLabel(&kueue.Workload{Spec: tc.spec}); got != tc.want {
+				t.Errorf("Unexpected label, want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestReportNamespaceMetrics(t *testing.T) {
+	SetNamespaceAllowlist([]string{"team-a"})
+	t.Cleanup(func() { SetNamespaceAllowlist(nil) })
+
+	NamespaceAdmittedWorkload("team-a", time.Minute)
+	NamespaceAdmittedWorkload("team-b", time.Minute)
+	ReportNamespaceEvictedWorkloads("team-a", kueue.WorkloadEvictedByPreemption)
+	ReportNamespaceEvictedWorkloads("team-b", kueue.WorkloadEvictedByPreemption)
+
+	expectFilteredMetricsCount(t, NamespaceAdmittedWorkloadsTotal, 1, "namespace", "team-a")
+	expectFilteredMetricsCount(t, NamespaceAdmissionWaitTime, 1, "namespace", "team-a")
+	expectFilteredMetricsCount(t, NamespaceEvictedWorkloadsTotal, 1, "namespace", "team-a", "reason", kueue.WorkloadEvictedByPreemption)
+	expectFilteredMetricsCount(t, NamespaceAdmittedWorkloadsTotal, 0, "namespace", "team-b")
+	expectFilteredMetricsCount(t, NamespaceAdmissionWaitTime, 0, "namespace", "team-b")
+	expectFilteredMetricsCount(t, NamespaceEvictedWorkloadsTotal, 0, "namespace", "team-b")
+}
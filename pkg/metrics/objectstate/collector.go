@@ -0,0 +1,196 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package objectstate exposes the state of the Kueue objects as metrics, in
+// the way of kube-state-metrics, such that dashboards don't need to query the
+// API server.
+package objectstate
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// listTimeout is the maximum time to list the objects of a kind on scrape.
+const listTimeout = 10 * time.Second
+
+var (
+	workloadStatusPhase = prometheus.NewDesc(
+		prometheus.BuildFQName(constants.KueueName, "", "workload_status_phase"),
+		`The phase of the unfinished Workloads, set to 1 for the current phase only.
+The label 'phase' can have the following values: "pending", "quotaReserved" or "admitted".`,
+		[]string{"name", "namespace", "local_queue", "cluster_queue", "phase"}, nil,
+	)
+	clusterQueueStatusCondition = prometheus.NewDesc(
+		prometheus.BuildFQName(constants.KueueName, "", "cluster_queue_status_condition"),
+		"The conditions of the ClusterQueues, set to 1 for the current status of each condition only.",
+		[]string{"cluster_queue", "condition", "status"}, nil,
+	)
+	clusterQueueStatusWorkloads = prometheus.NewDesc(
+		prometheus.BuildFQName(constants.KueueName, "", "cluster_queue_status_workloads"),
+		`The number of workloads in the status of the ClusterQueues.
+The label 'status' can have the following values: "pending", "reserving" or "admitted".`,
+		[]string{"cluster_queue", "status"}, nil,
+	)
+	localQueueStatusCondition = prometheus.NewDesc(
+		prometheus.BuildFQName(constants.KueueName, "", "local_queue_status_condition"),
+		"The conditions of the LocalQueues, set to 1 for the current status of each condition only.",
+		[]string{"name", "namespace", "condition", "status"}, nil,
+	)
+	localQueueStatusWorkloads = prometheus.NewDesc(
+		prometheus.BuildFQName(constants.KueueName, "", "local_queue_status_workloads"),
+		`The number of workloads in the status of the LocalQueues.
+The label 'status' can have the following values: "pending", "reserving" or "admitted".`,
+		[]string{"name", "namespace", "status"}, nil,
+	)
+	multiKueueClusterStatusCondition = prometheus.NewDesc(
+		prometheus.BuildFQName(constants.KueueName, "", "multikueue_cluster_status_condition"),
+		"The conditions of the MultiKueueClusters, set to 1 for the current status of each condition only.",
+		[]string{"name", "condition", "status"}, nil,
+	)
+)
+
+// Collector collects the state of the Kueue objects from the informer caches
+// on each scrape. The objects which are deleted don't leave stale series behind,
+// and only the current phase and status of the conditions are reported, to
+// keep the cardinality of the metrics bounded by the number of objects.
+type Collector struct {
+	reader client.Reader
+	log    logr.Logger
+}
+
+var _ prometheus.Collector = (*Collector)(nil)
+
+func NewCollector(reader client.Reader) *Collector {
+	return &Collector{
+		reader: reader,
+		log:    ctrl.Log.WithName("object-state-metrics"),
+	}
+}
+
+// Register registers the collector reading the objects from the reader in the
+// registry of the controller-runtime metrics.
+func Register(reader client.Reader) {
+	metrics.Registry.MustRegister(NewCollector(reader))
+}
+
+// Describe implements prometheus.Collector interface
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- workloadStatusPhase
+	ch <- clusterQueueStatusCondition
+	ch <- clusterQueueStatusWorkloads
+	ch <- localQueueStatusCondition
+	ch <- localQueueStatusWorkloads
+	ch <- multiKueueClusterStatusCondition
+}
+
+// Collect implements prometheus.Collector interface
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), listTimeout)
+	defer cancel()
+	c.collectWorkloads(ctx, ch)
+	c.collectClusterQueues(ctx, ch)
+	c.collectLocalQueues(ctx, ch)
+	c.collectMultiKueueClusters(ctx, ch)
+}
+
+func (c *Collector) collectWorkloads(ctx context.Context, ch chan<- prometheus.Metric) {
+	var wls kueue.WorkloadList
+	if err := c.reader.List(ctx, &wls); err != nil {
+		c.log.Error(err, "Failed to list the Workloads")
+		return
+	}
+	for i := range wls.Items {
+		wl := &wls.Items[i]
+		phase := workload.Status(wl)
+		if phase == workload.StatusFinished {
+			continue
+		}
+		var cqName kueue.ClusterQueueReference
+		if wl.Status.Admission != nil {
+			cqName = wl.Status.Admission.ClusterQueue
+		}
+		ch <- prometheus.MustNewConstMetric(workloadStatusPhase, prometheus.GaugeValue, 1,
+			wl.Name, wl.Namespace, string(wl.Spec.QueueName), string(cqName), phase)
+	}
+}
+
+func (c *Collector) collectClusterQueues(ctx context.Context, ch chan<- prometheus.Metric) {
+	var cqs kueue.ClusterQueueList
+	if err := c.reader.List(ctx, &cqs); err != nil {
+		c.log.Error(err, "Failed to list the ClusterQueues")
+		return
+	}
+	for i := range cqs.Items {
+		cq := &cqs.Items[i]
+		collectConditions(ch, clusterQueueStatusCondition, cq.Status.Conditions, cq.Name)
+		collectWorkloadCounts(ch, clusterQueueStatusWorkloads, cq.Status.PendingWorkloads, cq.Status.ReservingWorkloads, cq.Status.AdmittedWorkloads, cq.Name)
+	}
+}
+
+func (c *Collector) collectLocalQueues(ctx context.Context, ch chan<- prometheus.Metric) {
+	var lqs kueue.LocalQueueList
+	if err := c.reader.List(ctx, &lqs); err != nil {
+		c.log.Error(err, "Failed to list the LocalQueues")
+		return
+	}
+	for i := range lqs.Items {
+		lq := &lqs.Items[i]
+		collectConditions(ch, localQueueStatusCondition, lq.Status.Conditions, lq.Name, lq.Namespace)
+		collectWorkloadCounts(ch, localQueueStatusWorkloads, lq.Status.PendingWorkloads, lq.Status.ReservingWorkloads, lq.Status.AdmittedWorkloads, lq.Name, lq.Namespace)
+	}
+}
+
+func (c *Collector) collectMultiKueueClusters(ctx context.Context, ch chan<- prometheus.Metric) {
+	var clusters kueue.MultiKueueClusterList
+	if err := c.reader.List(ctx, &clusters); err != nil {
+		c.log.Error(err, "Failed to list the MultiKueueClusters")
+		return
+	}
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		collectConditions(ch, multiKueueClusterStatusCondition, cluster.Status.Conditions, cluster.Name)
+	}
+}
+
+func collectConditions(ch chan<- prometheus.Metric, desc *prometheus.Desc, conditions []metav1.Condition, objectLabels ...string) {
+	for _, cond := range conditions {
+		labels := append(append(make([]string, 0, len(objectLabels)+2), objectLabels...), cond.Type, string(cond.Status))
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, 1, labels...)
+	}
+}
+
+func collectWorkloadCounts(ch chan<- prometheus.Metric, desc *prometheus.Desc, pending, reserving, admitted int32, objectLabels ...string) {
+	for status, count := range map[string]int32{
+		"pending":   pending,
+		"reserving": reserving,
+		"admitted":  admitted,
+	} {
+		labels := append(append(make([]string, 0, len(objectLabels)+1), objectLabels...), status)
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(count), labels...)
+	}
+}
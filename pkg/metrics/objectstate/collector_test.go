@@ -0,0 +1,77 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectstate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingmetrics "sigs.k8s.io/kueue/pkg/util/testing/metrics"
+)
+
+func TestCollect(t *testing.T) {
+	cl := utiltesting.NewFakeClient(
+		utiltesting.MakeWorkload("pending", "ns").Queue("lq").Obj(),
+		utiltesting.MakeWorkload("admitted", "ns").
+			Queue("lq").
+			ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+			Admitted(true).
+			Obj(),
+		utiltesting.MakeWorkload("finished", "ns").
+			Queue("lq").
+			ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+			Finished().
+			Obj(),
+		utiltesting.MakeClusterQueue("cq").
+			Active(metav1.ConditionTrue).
+			PendingWorkloads(1).
+			AdmittedWorkloads(1).
+			Obj(),
+		utiltesting.MakeLocalQueue("lq", "ns").
+			ClusterQueue("cq").
+			Active(metav1.ConditionFalse).
+			PendingWorkloads(1).
+			ReservingWorkloads(1).
+			AdmittedWorkloads(1).
+			Obj(),
+		utiltesting.MakeMultiKueueCluster("worker").
+			Active(metav1.ConditionFalse, "BadConfig", "", 1).
+			Obj(),
+	)
+
+	got := testingmetrics.CollectFilteredGaugeVec(NewCollector(cl), nil)
+	want := []testingmetrics.MetricDataPoint{
+		{Labels: map[string]string{"name": "pending", "namespace": "ns", "local_queue": "lq", "cluster_queue": "", "phase": "pending"}, Value: 1},
+		{Labels: map[string]string{"name": "admitted", "namespace": "ns", "local_queue": "lq", "cluster_queue": "cq", "phase": "admitted"}, Value: 1},
+		{Labels: map[string]string{"cluster_queue": "cq", "condition": "Active", "status": "True"}, Value: 1},
+		{Labels: map[string]string{"cluster_queue": "cq", "status": "pending"}, Value: 1},
+		{Labels: map[string]string{"cluster_queue": "cq", "status": "reserving"}, Value: 0},
+		{Labels: map[string]string{"cluster_queue": "cq", "status": "admitted"}, Value: 1},
+		{Labels: map[string]string{"name": "lq", "namespace": "ns", "condition": "Active", "status": "False"}, Value: 1},
+		{Labels: map[string]string{"name": "lq", "namespace": "ns", "status": "pending"}, Value: 1},
+		{Labels: map[string]string{"name": "lq", "namespace": "ns", "status": "reserving"}, Value: 1},
+		{Labels: map[string]string{"name": "lq", "namespace": "ns", "status": "admitted"}, Value: 1},
+		{Labels: map[string]string{"name": "worker", "condition": "Active", "status": "False"}, Value: 1},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.SortSlices(func(a, b testingmetrics.MetricDataPoint) bool { return a.Less(&b) })); diff != "" {
+		t.Errorf("Unexpected metrics (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// fractionalResources are the resources, other than cpu, tracked in
+// milli-units. It is set once when Kueue starts.
+var fractionalResources sets.Set[corev1.ResourceName]
+
+// SetFractionalResources sets the resources, other than cpu, of which the
+// values are tracked in milli-units.
+func SetFractionalResources(names []corev1.ResourceName) {
+	fractionalResources = sets.New(names...)
+}
+
+// SetFractionalResourcesDuringTest sets the fractional resources for the
+// duration of the test.
+func SetFractionalResourcesDuringTest(tb testing.TB, names ...corev1.ResourceName) {
+	prev := fractionalResources
+	SetFractionalResources(names)
+	tb.Cleanup(func() {
+		fractionalResources = prev
+	})
+}
+
+func isMilliValued(name corev1.ResourceName) bool {
+	return name == corev1.ResourceCPU || fractionalResources.Has(name)
+}
@@ -95,18 +95,20 @@ func (r Requests) ToResourceList() corev1.ResourceList {
 }
 
 // ResourceValue returns the integer value for the resource name.
-// It's milli-units for CPU and absolute units for everything else.
+// It's milli-units for CPU and the fractional resources, and absolute units
+// for everything else.
 func ResourceValue(name corev1.ResourceName, q resource.Quantity) int64 {
-	if name == corev1.ResourceCPU {
+	if isMilliValued(name) {
 		return q.MilliValue()
 	}
 	return q.Value()
 }
 
 func ResourceQuantity(name corev1.ResourceName, v int64) resource.Quantity {
-	switch name {
-	case corev1.ResourceCPU:
+	if isMilliValued(name) {
 		return *resource.NewMilliQuantity(v, resource.DecimalSI)
+	}
+	switch name {
 	case corev1.ResourceMemory, corev1.ResourceEphemeralStorage:
 		return *resource.NewQuantity(v, resource.BinarySI)
 	default:
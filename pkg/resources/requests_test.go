@@ -20,7 +20,9 @@ import (
 	"math"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func TestCountIn(t *testing.T) {
@@ -121,3 +123,24 @@ func TestCountIn(t *testing.T) {
 		})
 	}
 }
+
+func TestFractionalResources(t *testing.T) {
+	SetFractionalResourcesDuringTest(t, "example.com/gpu")
+	rl := corev1.ResourceList{
+		corev1.ResourceCPU: resource.MustParse("1500m"),
+		"example.com/gpu":  resource.MustParse("250m"),
+		"example.com/tpu":  resource.MustParse("2"),
+	}
+	wantRequests := Requests{
+		corev1.ResourceCPU: 1_500,
+		"example.com/gpu":  250,
+		"example.com/tpu":  2,
+	}
+	gotRequests := NewRequests(rl)
+	if diff := cmp.Diff(wantRequests, gotRequests); diff != "" {
+		t.Errorf("Unexpected requests (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(rl, gotRequests.ToResourceList()); diff != "" {
+		t.Errorf("Unexpected resource list (-want,+got):\n%s", diff)
+	}
+}
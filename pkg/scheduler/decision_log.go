@@ -0,0 +1,228 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"encoding/json"
+	"io"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"gopkg.in/natefinch/lumberjack.v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+)
+
+// DecisionOutcome is the outcome of an admission attempt.
+type DecisionOutcome string
+
+const (
+	// DecisionOutcomeQuotaReserved means that quota was reserved for the workload.
+	DecisionOutcomeQuotaReserved DecisionOutcome = "QuotaReserved"
+	// DecisionOutcomePreempting means that preemptions were issued to fit the workload.
+	DecisionOutcomePreempting DecisionOutcome = "Preempting"
+	// DecisionOutcomeSkipped means that the workload was skipped in the scheduling cycle.
+	DecisionOutcomeSkipped DecisionOutcome = "Skipped"
+	// DecisionOutcomeEvicted means that the workload was evicted by the scheduler.
+	DecisionOutcomeEvicted DecisionOutcome = "Evicted"
+	// DecisionOutcomeFailed means that the reservation of the quota failed.
+	DecisionOutcomeFailed DecisionOutcome = "Failed"
+	// DecisionOutcomeInadmissible means that the workload doesn't fit.
+	DecisionOutcomeInadmissible DecisionOutcome = "Inadmissible"
+)
+
+// DecisionRecord is the record of the decision taken by the scheduler on an
+// admission attempt of a workload.
+type DecisionRecord struct {
+	// Timestamp is the end time of the scheduling cycle.
+	Timestamp time.Time `json:"timestamp"`
+	// SchedulingCycle is the number of the scheduling cycle since the last restart.
+	SchedulingCycle int64 `json:"schedulingCycle"`
+	// Workload is the workload attempted.
+	Workload DecisionWorkload `json:"workload"`
+	// Mode is the representative mode of the flavor assignment: NoFit, Preempt or Fit.
+	Mode string `json:"mode"`
+	// Borrowing is the height of the smallest cohort tree that fits the workload,
+	// 0 when no borrowing is required.
+	Borrowing int `json:"borrowing"`
+	// PodSets are the flavors assigned to the pod sets of the workload.
+	PodSets []DecisionPodSet `json:"podSets,omitempty"`
+	// UnschedulableReasons are the reasons why the candidate flavors couldn't
+	// be assigned to the pod sets.
+	UnschedulableReasons []kueue.UnschedulableReason `json:"unschedulableReasons,omitempty"`
+	// PreemptionTargets are the workloads considered for preemption.
+	PreemptionTargets []DecisionPreemptionTarget `json:"preemptionTargets,omitempty"`
+	// Outcome is the outcome of the admission attempt.
+	Outcome DecisionOutcome `json:"outcome"`
+	// Message is the reason why the workload is not admitted, if any.
+	Message string `json:"message,omitempty"`
+}
+
+// DecisionWorkload identifies a workload in the decision records.
+type DecisionWorkload struct {
+	Name         string                      `json:"name"`
+	Namespace    string                      `json:"namespace"`
+	UID          types.UID                   `json:"uid"`
+	ClusterQueue kueue.ClusterQueueReference `json:"clusterQueue"`
+	Priority     int32                       `json:"priority"`
+}
+
+// DecisionPodSet is the flavor assignment of a pod set in the decision records.
+type DecisionPodSet struct {
+	Name    kueue.PodSetReference `json:"name"`
+	Count   int32                 `json:"count"`
+	Flavors []DecisionFlavor      `json:"flavors,omitempty"`
+}
+
+// DecisionFlavor is the flavor assigned to a resource of a pod set.
+type DecisionFlavor struct {
+	Resource corev1.ResourceName           `json:"resource"`
+	Flavor   kueue.ResourceFlavorReference `json:"flavor"`
+	// Mode is the mode of the assignment of the flavor: NoFit, Preempt or Fit.
+	Mode string `json:"mode"`
+	// TriedFlavorIdx is the index of the last flavor tried among the flavors
+	// of the resource group, -1 when all the flavors were tried.
+	TriedFlavorIdx int `json:"triedFlavorIdx"`
+}
+
+// DecisionPreemptionTarget is a workload considered for preemption.
+type DecisionPreemptionTarget struct {
+	Name         string                      `json:"name"`
+	Namespace    string                      `json:"namespace"`
+	ClusterQueue kueue.ClusterQueueReference `json:"clusterQueue"`
+	// Reason is the reason of the preemption, like InClusterQueue or InCohortReclamation.
+	Reason string `json:"reason"`
+}
+
+// DecisionSink records the decision records of the admission attempts.
+type DecisionSink interface {
+	Record(record *DecisionRecord)
+}
+
+// NewDecisionSink returns the sink writing the decision records to the rotated
+// file set in the configuration, or to the log of the manager when no file is set.
+func NewDecisionSink(cfg *config.SchedulingDecisionLog) DecisionSink {
+	if cfg == nil || cfg.Path == "" {
+		return &logDecisionSink{log: ctrl.Log.WithName("scheduling-decision")}
+	}
+	return newFileDecisionSink(&lumberjack.Logger{
+		Filename:   cfg.Path,
+		MaxSize:    int(ptr.Deref(cfg.MaxSizeMegabytes, config.DefaultSchedulingDecisionLogMaxSize)),
+		MaxBackups: int(ptr.Deref(cfg.MaxBackups, config.DefaultSchedulingDecisionLogBackups)),
+	})
+}
+
+type logDecisionSink struct {
+	log logr.Logger
+}
+
+func (s *logDecisionSink) Record(record *DecisionRecord) {
+	s.log.Info("Admission attempted", "schedulingCycle", record.SchedulingCycle, "workload", record.Workload, "mode", record.Mode,
+		"borrowing", record.Borrowing, "podSets", record.PodSets, "unschedulableReasons", record.UnschedulableReasons,
+		"preemptionTargets", record.PreemptionTargets, "outcome", record.Outcome, "message", record.Message)
+}
+
+type fileDecisionSink struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	log     logr.Logger
+}
+
+func newFileDecisionSink(w io.Writer) *fileDecisionSink {
+	return &fileDecisionSink{
+		encoder: json.NewEncoder(w),
+		log:     ctrl.Log.WithName("scheduling-decision"),
+	}
+}
+
+func (s *fileDecisionSink) Record(record *DecisionRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.encoder.Encode(record); err != nil {
+		s.log.Error(err, "Failed to write the scheduling decision record", "workload", record.Workload)
+	}
+}
+
+// newDecisionRecord returns the decision record of the admission attempt of the entry.
+func newDecisionRecord(e *entry, schedulingCycle int64, now time.Time) *DecisionRecord {
+	record := &DecisionRecord{
+		Timestamp:       now,
+		SchedulingCycle: schedulingCycle,
+		Workload: DecisionWorkload{
+			Name:         e.Obj.Name,
+			Namespace:    e.Obj.Namespace,
+			UID:          e.Obj.UID,
+			ClusterQueue: e.ClusterQueue,
+			Priority:     priority.Priority(e.Obj),
+		},
+		Mode:                 e.assignment.RepresentativeMode().String(),
+		Borrowing:            e.assignment.Borrowing,
+		UnschedulableReasons: e.assignment.UnschedulableReasons(),
+		Outcome:              decisionOutcome(e),
+		Message:              e.inadmissibleMsg,
+	}
+	for _, ps := range e.assignment.PodSets {
+		podSet := DecisionPodSet{Name: ps.Name, Count: ps.Count}
+		for _, rName := range slices.Sorted(maps.Keys(ps.Flavors)) {
+			if fa := ps.Flavors[rName]; fa != nil {
+				podSet.Flavors = append(podSet.Flavors, DecisionFlavor{
+					Resource:       rName,
+					Flavor:         fa.Name,
+					Mode:           fa.Mode.String(),
+					TriedFlavorIdx: fa.TriedFlavorIdx,
+				})
+			}
+		}
+		record.PodSets = append(record.PodSets, podSet)
+	}
+	for _, target := range e.preemptionTargets {
+		record.PreemptionTargets = append(record.PreemptionTargets, DecisionPreemptionTarget{
+			Name:         target.WorkloadInfo.Obj.Name,
+			Namespace:    target.WorkloadInfo.Obj.Namespace,
+			ClusterQueue: target.WorkloadInfo.ClusterQueue,
+			Reason:       target.Reason,
+		})
+	}
+	return record
+}
+
+func decisionOutcome(e *entry) DecisionOutcome {
+	switch e.status {
+	case assumed:
+		return DecisionOutcomeQuotaReserved
+	case evicted:
+		return DecisionOutcomeEvicted
+	case skipped:
+		return DecisionOutcomeSkipped
+	case nominated:
+		return DecisionOutcomeFailed
+	}
+	if e.requeueReason == qcache.RequeueReasonPendingPreemption {
+		return DecisionOutcomePreempting
+	}
+	return DecisionOutcomeInadmissible
+}
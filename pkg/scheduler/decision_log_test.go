@@ -0,0 +1,130 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/util/routine"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestScheduleDecisionLog(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	ctx, log := utiltesting.ContextWithLog(t)
+	cl := utiltesting.NewClientBuilder().
+		WithObjects(utiltesting.MakeNamespace("ns")).
+		Build()
+	cqCache := schdcache.New(cl)
+	qManager := qcache.NewManager(cl, cqCache)
+	cqCache.AddOrUpdateResourceFlavor(log, utiltesting.MakeResourceFlavor("default").Obj())
+	for _, cqName := range []string{"cq-a", "cq-b"} {
+		cq := utiltesting.MakeClusterQueue(cqName).
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "2").Obj()).
+			Obj()
+		if err := cqCache.AddClusterQueue(ctx, cq); err != nil {
+			t.Fatalf("Inserting clusterQueue %s in cache: %v", cqName, err)
+		}
+		if err := qManager.AddClusterQueue(ctx, cq); err != nil {
+			t.Fatalf("Inserting clusterQueue %s in manager: %v", cqName, err)
+		}
+		if err := qManager.AddLocalQueue(ctx, utiltesting.MakeLocalQueue("lq-"+cqName, "ns").ClusterQueue(cqName).Obj()); err != nil {
+			t.Fatalf("Inserting queue in manager: %v", err)
+		}
+	}
+	for _, wl := range []*kueue.Workload{
+		utiltesting.MakeWorkload("fits", "ns").UID("fits-uid").Queue("lq-cq-a").Request(corev1.ResourceCPU, "1").Obj(),
+		utiltesting.MakeWorkload("too-big", "ns").UID("too-big-uid").Queue("lq-cq-b").Request(corev1.ResourceCPU, "5").Obj(),
+	} {
+		if err := qManager.AddOrUpdateWorkload(wl); err != nil {
+			t.Fatalf("Inserting workload %s in manager: %v", wl.Name, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	scheduler := New(qManager, cqCache, cl, &utiltesting.EventRecorder{}, WithDecisionSink(newFileDecisionSink(&buf)), WithClock(t, clocktesting.NewFakeClock(now)))
+	wg := sync.WaitGroup{}
+	scheduler.setAdmissionRoutineWrapper(routine.NewWrapper(
+		func() { wg.Add(1) },
+		func() { wg.Done() },
+	))
+	scheduler.patchAdmission = func(context.Context, *kueue.Workload, *kueue.Workload) error {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, queueingTimeout)
+	go qManager.CleanUpOnContext(ctx)
+	defer cancel()
+	scheduler.schedule(ctx)
+	wg.Wait()
+
+	var got []DecisionRecord
+	decoder := json.NewDecoder(&buf)
+	for decoder.More() {
+		var record DecisionRecord
+		if err := decoder.Decode(&record); err != nil {
+			t.Fatalf("Failed to decode the decision record: %v", err)
+		}
+		got = append(got, record)
+	}
+	want := []DecisionRecord{
+		{
+			Timestamp:       now,
+			SchedulingCycle: 1,
+			Workload:        DecisionWorkload{Name: "fits", Namespace: "ns", UID: "fits-uid", ClusterQueue: "cq-a"},
+			Mode:            "Fit",
+			PodSets: []DecisionPodSet{{
+				Name:    kueue.DefaultPodSetName,
+				Count:   1,
+				Flavors: []DecisionFlavor{{Resource: corev1.ResourceCPU, Flavor: "default", Mode: "Fit", TriedFlavorIdx: -1}},
+			}},
+			Outcome: DecisionOutcomeQuotaReserved,
+		},
+		{
+			Timestamp:       now,
+			SchedulingCycle: 1,
+			Workload:        DecisionWorkload{Name: "too-big", Namespace: "ns", UID: "too-big-uid", ClusterQueue: "cq-b"},
+			Mode:            "NoFit",
+			PodSets:         []DecisionPodSet{{Name: kueue.DefaultPodSetName, Count: 1}},
+			UnschedulableReasons: []kueue.UnschedulableReason{{
+				Reason:   kueue.UnschedulableReasonInsufficientQuota,
+				PodSet:   kueue.DefaultPodSetName,
+				Flavor:   "default",
+				Resource: corev1.ResourceCPU,
+			}},
+			Outcome: DecisionOutcomeInadmissible,
+			Message: "couldn't assign flavors to pod set main: insufficient quota for cpu in flavor default, request > maximum capacity (5 > 2)",
+		},
+	}
+	// The records of a scheduling cycle follow the order of the heads of the ClusterQueues.
+	sortRecords := cmpopts.SortSlices(func(a, b DecisionRecord) bool { return a.Workload.Name < b.Workload.Name })
+	if diff := cmp.Diff(want, got, sortRecords, cmpopts.IgnoreFields(kueue.UnschedulableReason{}, "Message")); diff != "" {
+		t.Errorf("Unexpected decision records (-want,+got):\n%s", diff)
+	}
+}
@@ -168,6 +168,11 @@ func (e *entryComparer) less(a, b *entry, parentCohort kueue.CohortReference) bo
 	aDrs := e.drsValues[drsKey{parentCohort: parentCohort, workloadKey: workload.Key(a.Obj)}]
 	bDrs := e.drsValues[drsKey{parentCohort: parentCohort, workloadKey: workload.Key(b.Obj)}]
 
+	// 0: Holders of active reservations
+	if a.reservationHolder != b.reservationHolder {
+		return a.reservationHolder
+	}
+
 	// 1: DRF
 	if cmp := schdcache.CompareDRS(aDrs, bDrs); cmp != 0 {
 		return cmp == -1
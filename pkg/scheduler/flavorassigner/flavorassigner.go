@@ -17,6 +17,7 @@ limitations under the License.
 package flavorassigner
 
 import (
+	"cmp"
 	"fmt"
 	"maps"
 	"slices"
@@ -34,10 +35,12 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/resources"
 	"sigs.k8s.io/kueue/pkg/scheduler/preemption/classical"
 	preemptioncommon "sigs.k8s.io/kueue/pkg/scheduler/preemption/common"
+	"sigs.k8s.io/kueue/pkg/util/api"
 	utilmaps "sigs.k8s.io/kueue/pkg/util/maps"
 	"sigs.k8s.io/kueue/pkg/workload"
 )
@@ -66,6 +69,11 @@ type Assignment struct {
 	// In these scenarios, flavor assignment proceeds as in the original flow—i.e., as for regular,
 	// non-sliced workloads.
 	replaceWorkloadSlice *workload.Info
+
+	// quota computes the quota needed for the requests assigned to the
+	// flavors, accounting the partitioned resources as fractions of the
+	// devices and adding the pod overhead of the flavors.
+	quota flavorQuota
 }
 
 // UpdateForTASResult updates the Assignment with the TAS result
@@ -73,6 +81,7 @@ func (a *Assignment) UpdateForTASResult(result schdcache.TASAssignmentsResult) {
 	for psName, psResult := range result {
 		psAssignment := a.podSetAssignmentByName(psName)
 		psAssignment.TopologyAssignment = psResult.TopologyAssignment
+		psAssignment.AchievedTopologyLevel = psResult.AchievedTopologyLevel
 		if psResult.TopologyAssignment != nil && psAssignment.DelayedTopologyRequest != nil {
 			psAssignment.DelayedTopologyRequest = ptr.To(kueue.DelayedTopologyRequestStateReady)
 		}
@@ -98,6 +107,7 @@ func (a *Assignment) ComputeTASNetUsage(prevAdmission *kueue.Admission) workload
 						Values:            domain.Values,
 						SinglePodRequests: singlePodRequests.Clone(),
 						Count:             domain.Count,
+						ExclusiveLevel:    psa.ExclusiveTopologyLevel,
 					})
 				}
 			}
@@ -159,6 +169,34 @@ func (a *Assignment) Message() string {
 	return builder.String()
 }
 
+const maxUnschedulableReasons = 64
+
+// UnschedulableReasons returns the structured reasons why flavors couldn't be
+// assigned to the pod sets, sorted by pod set, flavor and resource.
+func (a *Assignment) UnschedulableReasons() []kueue.UnschedulableReason {
+	var result []kueue.UnschedulableReason
+	for _, ps := range a.PodSets {
+		for _, detail := range ps.Status.details {
+			detail.PodSet = ps.Name
+			detail.Message = api.TruncateUnschedulableReasonMessage(detail.Message)
+			result = append(result, detail)
+		}
+	}
+	slices.SortStableFunc(result, func(a, b kueue.UnschedulableReason) int {
+		return cmp.Or(
+			strings.Compare(string(a.PodSet), string(b.PodSet)),
+			strings.Compare(string(a.Flavor), string(b.Flavor)),
+			strings.Compare(string(a.Resource), string(b.Resource)),
+			strings.Compare(string(a.Reason), string(b.Reason)),
+			strings.Compare(a.Message, b.Message),
+		)
+	})
+	if len(result) > maxUnschedulableReasons {
+		result = result[:maxUnschedulableReasons]
+	}
+	return result
+}
+
 func (a *Assignment) ToAPI() []kueue.PodSetAssignment {
 	psFlavors := make([]kueue.PodSetAssignment, len(a.PodSets))
 	for i := range psFlavors {
@@ -181,8 +219,8 @@ func (a *Assignment) TotalRequestsFor(wl *workload.Info) resources.FlavorResourc
 		ps = *ps.ScaledTo(newCount)
 
 		for res, q := range ps.Requests {
-			flv := a.PodSets[i].Flavors[res].Name
-			usage[resources.FlavorResource{Flavor: flv, Resource: res}] += q
+			fr := resources.FlavorResource{Flavor: a.PodSets[i].Flavors[res].Name, Resource: res}
+			usage[fr] += a.quota.quotaValue(fr, q, ps.Count)
 		}
 	}
 	return usage
@@ -191,6 +229,8 @@ func (a *Assignment) TotalRequestsFor(wl *workload.Info) resources.FlavorResourc
 type Status struct {
 	reasons []string
 	err     error
+	// details hold the structured counterpart of reasons.
+	details []kueue.UnschedulableReason
 }
 
 func NewStatus(reasons ...string) *Status {
@@ -212,6 +252,19 @@ func (s *Status) appendf(format string, args ...any) *Status {
 	return s
 }
 
+// appendDetailf appends a reason, along with its structured counterpart.
+func (s *Status) appendDetailf(detail kueue.UnschedulableReason, format string, args ...any) *Status {
+	s.appendf(format, args...)
+	detail.Message = s.reasons[len(s.reasons)-1]
+	s.details = append(s.details, detail)
+	return s
+}
+
+func (s *Status) merge(other *Status) {
+	s.reasons = append(s.reasons, other.reasons...)
+	s.details = append(s.details, other.details...)
+}
+
 func (s *Status) Message() string {
 	if s == nil {
 		return ""
@@ -239,6 +292,14 @@ type PodSetAssignment struct {
 
 	TopologyAssignment     *kueue.TopologyAssignment
 	DelayedTopologyRequest *kueue.DelayedTopologyRequestState
+
+	// ExclusiveTopologyLevel is the topology level at which the pod set
+	// requests exclusive placement, if any.
+	ExclusiveTopologyLevel string
+
+	// AchievedTopologyLevel is the lowest topology level with a single domain
+	// containing all the pods of the pod set requesting a preferred level.
+	AchievedTopologyLevel *string
 }
 
 // RepresentativeMode calculates the representative mode for this assignment as
@@ -265,8 +326,16 @@ func (psa *PodSetAssignment) updateMode(newMode FlavorAssignmentMode) {
 	}
 }
 
-func (psa *PodSetAssignment) reason(reason string) {
+func (psa *PodSetAssignment) topologyUnavailable(reason string) {
 	psa.Status.reasons = append(psa.Status.reasons, reason)
+	detail := kueue.UnschedulableReason{
+		Reason:  kueue.UnschedulableReasonTopologyUnavailable,
+		Message: reason,
+	}
+	if flavor, err := onlyFlavor(psa.Flavors); err == nil {
+		detail.Flavor = *flavor
+	}
+	psa.Status.details = append(psa.Status.details, detail)
 }
 
 func (psa *PodSetAssignment) error(err error) {
@@ -287,6 +356,7 @@ func (psa *PodSetAssignment) toAPI() kueue.PodSetAssignment {
 		Count:                  ptr.To(psa.Count),
 		TopologyAssignment:     psa.TopologyAssignment.DeepCopy(),
 		DelayedTopologyRequest: psa.DelayedTopologyRequest,
+		AchievedTopologyLevel:  psa.AchievedTopologyLevel,
 	}
 }
 
@@ -432,6 +502,8 @@ type FlavorAssigner struct {
 	// In these scenarios, flavor assignment proceeds as in the original flow—i.e., as for regular,
 	// non-sliced workloads.
 	replaceWorkloadSlice *workload.Info
+
+	quota flavorQuota
 }
 
 func New(wl *workload.Info, cq *schdcache.ClusterQueueSnapshot, resourceFlavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor, enableFairSharing bool, oracle preemptionOracle, preemptWorkloadSlice *workload.Info) *FlavorAssigner {
@@ -442,6 +514,7 @@ func New(wl *workload.Info, cq *schdcache.ClusterQueueSnapshot, resourceFlavors
 		enableFairSharing:    enableFairSharing,
 		oracle:               oracle,
 		replaceWorkloadSlice: preemptWorkloadSlice,
+		quota:                newFlavorQuota(resourceFlavors),
 	}
 }
 
@@ -493,6 +566,7 @@ func (a *FlavorAssigner) assignFlavors(log logr.Logger, counts []int32) Assignme
 			ClusterQueueGeneration: a.cq.AllocatableResourceGeneration,
 		},
 		replaceWorkloadSlice: a.replaceWorkloadSlice,
+		quota:                a.quota,
 	}
 
 	groupedRequests := newPodSetGroups()
@@ -503,10 +577,11 @@ func (a *FlavorAssigner) assignFlavors(log logr.Logger, counts []int32) Assignme
 		}
 
 		psAssignment := PodSetAssignment{
-			Name:     podSet.Name,
-			Flavors:  make(ResourceAssignment, len(podSet.Requests)),
-			Requests: podSet.Requests.ToResourceList(),
-			Count:    podSet.Count,
+			Name:                   podSet.Name,
+			Flavors:                make(ResourceAssignment, len(podSet.Requests)),
+			Requests:               podSet.Requests.ToResourceList(),
+			Count:                  podSet.Count,
+			ExclusiveTopologyLevel: workload.ExclusiveTopologyLevel(&a.wl.Obj.Spec.PodSets[i]),
 		}
 
 		if features.Enabled(features.TopologyAwareScheduling) {
@@ -523,6 +598,7 @@ func (a *FlavorAssigner) assignFlavors(log logr.Logger, counts []int32) Assignme
 			}
 			if podSet.TopologyRequest != nil {
 				psAssignment.TopologyAssignment = a.wl.Obj.Status.Admission.PodSetAssignments[i].TopologyAssignment
+				psAssignment.AchievedTopologyLevel = a.wl.Obj.Status.Admission.PodSetAssignments[i].AchievedTopologyLevel
 			}
 		}
 
@@ -537,9 +613,11 @@ func (a *FlavorAssigner) assignFlavors(log logr.Logger, counts []int32) Assignme
 	for _, podSets := range groupedRequests.orderedPodSetGroups() {
 		requests := make(resources.Requests)
 		psIDs := make([]int, len(podSets))
+		var count int32
 		for idx, podset := range podSets {
 			psIDs[idx] = podset.originalIndex
 			requests.Add(podset.podSet.Requests)
+			count += podset.podSet.Count
 		}
 
 		groupFlavors := make(ResourceAssignment)
@@ -556,7 +634,7 @@ func (a *FlavorAssigner) assignFlavors(log logr.Logger, counts []int32) Assignme
 				// No need to compute again.
 				continue
 			}
-			flavors, status := a.findFlavorForPodSets(log, psIDs, requests, resName, assignment.Usage.Quota)
+			flavors, status := a.findFlavorForPodSets(log, psIDs, requests, count, resName, assignment.Usage.Quota)
 			if status.IsError() || len(flavors) == 0 {
 				groupFlavors = nil
 				groupStatus = *status
@@ -564,7 +642,7 @@ func (a *FlavorAssigner) assignFlavors(log logr.Logger, counts []int32) Assignme
 			}
 			maps.Copy(groupFlavors, flavors)
 			if status != nil {
-				groupStatus.reasons = append(groupStatus.reasons, status.reasons...)
+				groupStatus.merge(status)
 			}
 		}
 		atLeastOnePodsAssignmentFailed := false
@@ -574,7 +652,11 @@ func (a *FlavorAssigner) assignFlavors(log logr.Logger, counts []int32) Assignme
 			podSet.podSetAssignment.Flavors = podSetFlavors
 			podSet.podSetAssignment.Status = groupStatus
 
-			assignment.append(podSet.podSet.Requests, podSet.podSetAssignment)
+			quotaRequests := assignment.quota.assignedQuotaRequests(podSetFlavors, podSet.podSet.Requests, podSet.podSet.Count)
+			if !assignment.quota.empty() {
+				podSet.podSetAssignment.Requests = quotaRequests.ToResourceList()
+			}
+			assignment.append(quotaRequests, podSet.podSetAssignment)
 			if podSet.podSetAssignment.Status.IsError() || (len(podSet.podSet.Requests) > 0 && len(podSet.podSetAssignment.Flavors) == 0) {
 				atLeastOnePodsAssignmentFailed = true
 			}
@@ -594,7 +676,7 @@ func (a *FlavorAssigner) assignFlavors(log logr.Logger, counts []int32) Assignme
 			if failure := result.Failure(); failure != nil {
 				// There is at least one PodSet which does not fit
 				psAssignment := assignment.podSetAssignmentByName(failure.PodSetName)
-				psAssignment.reason(failure.Reason)
+				psAssignment.topologyUnavailable(failure.Reason)
 				// update the mode for all flavors and the representative mode
 				psAssignment.updateMode(Preempt)
 				assignment.representativeMode = ptr.To(Preempt)
@@ -642,12 +724,16 @@ func (a *FlavorAssigner) findFlavorForPodSets(
 	log logr.Logger,
 	psIDs []int,
 	requests resources.Requests,
+	count int32,
 	resName corev1.ResourceName,
 	assignmentUsage resources.FlavorResourceQuantities,
 ) (ResourceAssignment, *Status) {
 	resourceGroup := a.cq.RGByResource(resName)
 	if resourceGroup == nil {
-		return nil, NewStatus(fmt.Sprintf("resource %s unavailable in ClusterQueue", resName))
+		return nil, NewStatus().appendDetailf(kueue.UnschedulableReason{
+			Reason:   kueue.UnschedulableReasonInsufficientQuota,
+			Resource: resName,
+		}, "resource %s unavailable in ClusterQueue", resName)
 	}
 
 	status := NewStatus()
@@ -663,15 +749,23 @@ func (a *FlavorAssigner) findFlavorForPodSets(
 		selectors[idx] = flavorSelector(&ps.Template.Spec, resourceGroup.LabelKeys)
 	}
 
+	if features.Enabled(features.FlavorStickiness) && a.replaceWorkloadSlice == nil {
+		if assignments := a.lastAdmittedFlavorAssignment(log, psIDs, podSets, selectors, requests, count, resName, assignmentUsage); assignments != nil {
+			return assignments, nil
+		}
+	}
+
+	fungibility := a.flavorFungibilityFor(podSets)
 	var bestAssignment ResourceAssignment
 	bestAssignmentMode := granularMode{preemptionMode: noFit, needsBorrowing: true}
 
 	// We will only check against the flavors' labels for the resource.
+	flavors := a.flavorsInOrder(resourceGroup)
 	attemptedFlavorIdx := -1
 	idx := a.wl.LastAssignment.NextFlavorToTryForPodSetResource(psIDs[0], resName)
-	for ; idx < len(resourceGroup.Flavors); idx++ {
+	for ; idx < len(flavors); idx++ {
 		attemptedFlavorIdx = idx
-		fName := resourceGroup.Flavors[idx]
+		fName := flavors[idx]
 
 		if fit, err := a.checkFlavorForPodSets(log, fName, psIDs, podSets, selectors, status); !fit {
 			if err != nil {
@@ -684,6 +778,8 @@ func (a *FlavorAssigner) findFlavorForPodSets(
 		// Calculate representativeMode for this assignment as the worst mode among all requests.
 		representativeMode := granularMode{preemptionMode: fit, needsBorrowing: false}
 		for rName, val := range requests {
+			fr := resources.FlavorResource{Flavor: fName, Resource: rName}
+			val = a.quota.quotaValue(fr, val, count)
 			// Ensure the same resource flavor is used for the workload slice as in the original admitted slice.
 			if features.Enabled(features.ElasticJobsViaWorkloadSlices) && a.replaceWorkloadSlice != nil {
 				for _, psID := range psIDs {
@@ -693,7 +789,11 @@ func (a *FlavorAssigner) findFlavorForPodSets(
 					if originalFlavor := preemptWorkloadRequests.Flavors[rName]; originalFlavor != fName {
 						// Flavor mismatch. Skip further checks for this resource.
 						representativeMode = granularMode{preemptionMode: noFit, needsBorrowing: true}
-						status.reasons = append(status.reasons, fmt.Sprintf("could not assign %s flavor since the original workload is assigned: %s", fName, originalFlavor))
+						status.appendDetailf(kueue.UnschedulableReason{
+							Reason:   kueue.UnschedulableReasonFlavorMismatch,
+							Flavor:   fName,
+							Resource: rName,
+						}, "could not assign %s flavor since the original workload is assigned: %s", fName, originalFlavor)
 						break
 					}
 
@@ -702,15 +802,14 @@ func (a *FlavorAssigner) findFlavorForPodSets(
 				}
 			}
 
-			resQuota := a.cq.QuotaFor(resources.FlavorResource{Flavor: fName, Resource: rName})
+			resQuota := a.cq.QuotaFor(fr)
 			// Check considering the flavor usage by previous pod sets.
-			fr := resources.FlavorResource{Flavor: fName, Resource: rName}
 			preemptionMode, borrow, s := a.fitsResourceQuota(log, fr, val+assignmentUsage[fr], resQuota)
 			if s != nil {
-				status.reasons = append(status.reasons, s.reasons...)
+				status.merge(s)
 			}
 			mode := granularMode{preemptionMode, borrow > 0}
-			if isPreferred(representativeMode, mode, a.cq.FlavorFungibility) {
+			if isPreferred(representativeMode, mode, fungibility) {
 				representativeMode = mode
 			}
 			if representativeMode.preemptionMode == noFit {
@@ -724,13 +823,25 @@ func (a *FlavorAssigner) findFlavorForPodSets(
 				borrow: borrow,
 			}
 		}
+		if features.Enabled(features.FlavorResourceRatios) && representativeMode.preemptionMode != noFit {
+			if s := a.checkResourceRatios(fName, a.quota.quotaRequests(fName, requests, count), assignmentUsage); s != nil {
+				status.merge(s)
+				representativeMode = granularMode{preemptionMode: noFit, needsBorrowing: true}
+			}
+		}
+		if a.limitsPhysicalCapacity() && representativeMode.preemptionMode != noFit {
+			if s := a.checkPhysicalCapacity(fName, requests, assignmentUsage); s != nil {
+				status.merge(s)
+				representativeMode = granularMode{preemptionMode: noFit, needsBorrowing: true}
+			}
+		}
 		if features.Enabled(features.FlavorFungibility) {
-			if !shouldTryNextFlavor(representativeMode, a.cq.FlavorFungibility) {
+			if !shouldTryNextFlavor(representativeMode, fungibility) {
 				bestAssignment = assignments
 				bestAssignmentMode = representativeMode
 				break
 			}
-			if isPreferred(representativeMode, bestAssignmentMode, a.cq.FlavorFungibility) {
+			if isPreferred(representativeMode, bestAssignmentMode, fungibility) {
 				bestAssignment = assignments
 				bestAssignmentMode = representativeMode
 			}
@@ -746,7 +857,7 @@ func (a *FlavorAssigner) findFlavorForPodSets(
 
 	if features.Enabled(features.FlavorFungibility) {
 		for _, assignment := range bestAssignment {
-			if attemptedFlavorIdx == len(resourceGroup.Flavors)-1 {
+			if attemptedFlavorIdx == len(flavors)-1 {
 				// we have reach the last flavor, try from the first flavor next time
 				assignment.TriedFlavorIdx = -1
 			} else {
@@ -760,6 +871,143 @@ func (a *FlavorAssigner) findFlavorForPodSets(
 	return bestAssignment, status
 }
 
+// flavorsInOrder returns the flavors of the resource group in the order in
+// which they are tried. The flavors are sorted by increasing cost when the
+// ClusterQueue uses the LowestCost flavorOrder, with the flavors without a
+// cost last. Otherwise, the declared order is kept.
+func (a *FlavorAssigner) flavorsInOrder(rg *schdcache.ResourceGroup) []kueue.ResourceFlavorReference {
+	if !features.Enabled(features.FlavorCostOrdering) || a.cq.FlavorFungibility.FlavorOrder != kueue.FlavorOrderLowestCost || len(a.cq.FlavorCosts) == 0 {
+		return rg.Flavors
+	}
+	flavors := slices.Clone(rg.Flavors)
+	slices.SortStableFunc(flavors, func(x, y kueue.ResourceFlavorReference) int {
+		xCost, xFound := a.cq.FlavorCosts[x]
+		yCost, yFound := a.cq.FlavorCosts[y]
+		switch {
+		case xFound && yFound:
+			return xCost.Cmp(yCost)
+		case xFound:
+			return -1
+		case yFound:
+			return 1
+		}
+		return 0
+	})
+	return flavors
+}
+
+// checkResourceRatios returns a status with the reasons why assigning the
+// requests to the flavor would exceed the maxRatio of the flavor resources,
+// or nil if the ratios are respected.
+func (a *FlavorAssigner) checkResourceRatios(fName kueue.ResourceFlavorReference, requests resources.Requests, assignmentUsage resources.FlavorResourceQuantities) *Status {
+	usage := make(resources.FlavorResourceQuantities, len(requests))
+	for fr, q := range assignmentUsage {
+		if fr.Flavor == fName {
+			usage[fr] = q
+		}
+	}
+	for rName, val := range requests {
+		usage[resources.FlavorResource{Flavor: fName, Resource: rName}] += val
+	}
+	violations := a.cq.RatioViolations(usage)
+	if len(violations) == 0 {
+		return nil
+	}
+	status := NewStatus()
+	for _, fr := range violations {
+		limit, _ := a.cq.RatioLimit(fr, usage)
+		status.appendDetailf(unschedulableReason(kueue.UnschedulableReasonResourceRatioExceeded, fr), "usage of %s in flavor %s would exceed the maximum ratio per %s (%s > %s)",
+			fr.Resource, fr.Flavor, a.cq.QuotaFor(fr).MaxRatio.PerResource,
+			resources.ResourceQuantityString(fr.Resource, a.cq.ResourceNode.Usage[fr]+usage[fr]), resources.ResourceQuantityString(fr.Resource, limit))
+	}
+	return status
+}
+
+// limitsPhysicalCapacity returns whether the ClusterQueue only admits the
+// workloads fitting in the physical headroom of the flavors.
+func (a *FlavorAssigner) limitsPhysicalCapacity() bool {
+	return features.Enabled(features.FlavorPhysicalCapacity) && a.cq.PhysicalCapacity != nil &&
+		ptr.Deref(a.cq.PhysicalCapacityPolicy, kueue.PhysicalCapacityPolicyIgnore) == kueue.PhysicalCapacityPolicyLimitAdmission
+}
+
+// checkPhysicalCapacity returns a status with the reasons why assigning the
+// requests to the flavor would exceed its physical headroom, or nil if they
+// fit.
+func (a *FlavorAssigner) checkPhysicalCapacity(fName kueue.ResourceFlavorReference, requests resources.Requests, assignmentUsage resources.FlavorResourceQuantities) *Status {
+	usage := make(resources.FlavorResourceQuantities, len(requests))
+	for fr, q := range assignmentUsage {
+		if fr.Flavor == fName {
+			usage[fr] = q
+		}
+	}
+	for rName, val := range requests {
+		usage[resources.FlavorResource{Flavor: fName, Resource: rName}] += val
+	}
+	violations := a.cq.PhysicalCapacity.Violations(usage)
+	if len(violations) == 0 {
+		return nil
+	}
+	status := NewStatus()
+	for _, fr := range violations {
+		headroom, _ := a.cq.PhysicalCapacity.Headroom(fr)
+		status.appendDetailf(unschedulableReason(kueue.UnschedulableReasonInsufficientPhysicalCapacity, fr), "insufficient physical capacity for %s in flavor %s, request > headroom (%s > %s)",
+			fr.Resource, fr.Flavor, resources.ResourceQuantityString(fr.Resource, usage[fr]), resources.ResourceQuantityString(fr.Resource, headroom))
+	}
+	return status
+}
+
+// lastAdmittedFlavorAssignment returns the assignment of the requests to the
+// flavor the podSets were assigned to when the workload was last admitted, if
+// the flavor still fits without preemption and the flavor fungibility policy
+// of the podSets allows the borrowing it needs. Otherwise, it returns nil.
+func (a *FlavorAssigner) lastAdmittedFlavorAssignment(
+	log logr.Logger,
+	psIDs []int,
+	podSets []*kueue.PodSet,
+	selectors []nodeaffinity.RequiredNodeAffinity,
+	requests resources.Requests,
+	count int32,
+	resName corev1.ResourceName,
+	assignmentUsage resources.FlavorResourceQuantities,
+) ResourceAssignment {
+	psName := a.wl.Obj.Spec.PodSets[psIDs[0]].Name
+	idx := slices.IndexFunc(a.wl.Obj.Status.LastAdmittedFlavors, func(psf kueue.PodSetFlavors) bool {
+		return psf.Name == psName
+	})
+	if idx < 0 {
+		return nil
+	}
+	fName, found := a.wl.Obj.Status.LastAdmittedFlavors[idx].Flavors[resName]
+	if !found || !slices.Contains(a.cq.RGByResource(resName).Flavors, fName) {
+		return nil
+	}
+	if fit, _ := a.checkFlavorForPodSets(log, fName, psIDs, podSets, selectors, NewStatus()); !fit {
+		return nil
+	}
+	assignments := make(ResourceAssignment, len(requests))
+	for rName, val := range requests {
+		fr := resources.FlavorResource{Flavor: fName, Resource: rName}
+		preemptionMode, borrow, _ := a.fitsResourceQuota(log, fr, a.quota.quotaValue(fr, val, count)+assignmentUsage[fr], a.cq.QuotaFor(fr))
+		if preemptionMode != fit || (borrow > 0 && a.flavorFungibilityFor(podSets).WhenCanBorrow != kueue.Borrow) {
+			return nil
+		}
+		assignments[rName] = &FlavorAssignment{
+			Name:           fName,
+			Mode:           preemptionMode.flavorAssignmentMode(),
+			borrow:         borrow,
+			TriedFlavorIdx: -1,
+		}
+	}
+	if features.Enabled(features.FlavorResourceRatios) && a.checkResourceRatios(fName, a.quota.quotaRequests(fName, requests, count), assignmentUsage) != nil {
+		return nil
+	}
+	if a.limitsPhysicalCapacity() && a.checkPhysicalCapacity(fName, requests, assignmentUsage) != nil {
+		return nil
+	}
+	log.V(3).Info("Assigning the last admitted flavor", "podSet", psName, "flavor", fName, "resource", resName)
+	return assignments
+}
+
 func (a *FlavorAssigner) checkFlavorForPodSets(
 	log logr.Logger,
 	flavorName kueue.ResourceFlavorReference,
@@ -771,16 +1019,24 @@ func (a *FlavorAssigner) checkFlavorForPodSets(
 	flavor, exist := a.resourceFlavors[flavorName]
 	if !exist {
 		log.Error(nil, "Flavor not found", "Flavor", flavorName)
-		status.appendf("flavor %s not found", flavorName)
+		status.appendDetailf(kueue.UnschedulableReason{Reason: kueue.UnschedulableReasonFlavorMismatch, Flavor: flavorName}, "flavor %s not found", flavorName)
+		return false, nil
+	}
+	if (features.Enabled(features.FlavorFailover) || features.Enabled(features.AdmissionCheckTimeouts)) && workload.IsFlavorExcluded(a.wl.Obj, flavorName) {
+		status.appendDetailf(kueue.UnschedulableReason{Reason: kueue.UnschedulableReasonFlavorMismatch, Flavor: flavorName}, "flavor %s is excluded for the workload", flavorName)
 		return false, nil
 	}
 
 	for psIdx, psID := range psIDs {
+		if features.Enabled(features.PodSetFlavorSelection) && !podSetAllowsFlavor(podSets[psIdx], flavorName) {
+			status.appendDetailf(kueue.UnschedulableReason{Reason: kueue.UnschedulableReasonFlavorMismatch, Flavor: flavorName}, "flavor %s is not allowed for podSet %s", flavorName, podSets[psIdx].Name)
+			return false, nil
+		}
 		if features.Enabled(features.TopologyAwareScheduling) {
 			ps := &a.wl.Obj.Spec.PodSets[psID]
 			if message := checkPodSetAndFlavorMatchForTAS(a.cq, ps, flavor); message != nil {
 				log.Error(nil, *message)
-				status.appendf("%s", *message)
+				status.appendDetailf(kueue.UnschedulableReason{Reason: kueue.UnschedulableReasonFlavorMismatch, Flavor: flavorName}, "%s", *message)
 				return false, nil
 			}
 		}
@@ -789,7 +1045,7 @@ func (a *FlavorAssigner) checkFlavorForPodSets(
 			return t.Effect == corev1.TaintEffectNoSchedule || t.Effect == corev1.TaintEffectNoExecute
 		})
 		if untolerated {
-			status.appendf("untolerated taint %s in flavor %s", taint, flavorName)
+			status.appendDetailf(kueue.UnschedulableReason{Reason: kueue.UnschedulableReasonFlavorMismatch, Flavor: flavorName}, "untolerated taint %s in flavor %s", taint, flavorName)
 			return false, nil
 		}
 		selector := selectors[psIdx]
@@ -798,13 +1054,40 @@ func (a *FlavorAssigner) checkFlavorForPodSets(
 				status.err = err
 				return false, err
 			}
-			status.appendf("flavor %s doesn't match node affinity", flavorName)
+			status.appendDetailf(kueue.UnschedulableReason{Reason: kueue.UnschedulableReasonFlavorMismatch, Flavor: flavorName}, "flavor %s doesn't match node affinity", flavorName)
 			return false, nil
 		}
 	}
 	return true, nil
 }
 
+// podSetAllowsFlavor returns false if the flavors of the PodSet are restricted,
+// with the PodSetFlavorsAnnotation, to a list which doesn't contain the flavor.
+func podSetAllowsFlavor(ps *kueue.PodSet, flavorName kueue.ResourceFlavorReference) bool {
+	value, found := ps.Template.Annotations[controllerconstants.PodSetFlavorsAnnotation]
+	if !found {
+		return true
+	}
+	return slices.Contains(strings.Split(value, ","), string(flavorName))
+}
+
+// flavorFungibilityFor returns the flavor fungibility of the ClusterQueue, with the
+// policies overridden by the annotations of the first of the podSets, if any.
+func (a *FlavorAssigner) flavorFungibilityFor(podSets []*kueue.PodSet) kueue.FlavorFungibility {
+	fungibility := a.cq.FlavorFungibility
+	if !features.Enabled(features.PodSetFlavorSelection) || len(podSets) == 0 {
+		return fungibility
+	}
+	annotations := podSets[0].Template.Annotations
+	if value, found := annotations[controllerconstants.PodSetWhenCanBorrowAnnotation]; found {
+		fungibility.WhenCanBorrow = kueue.FlavorFungibilityPolicy(value)
+	}
+	if value, found := annotations[controllerconstants.PodSetWhenCanPreemptAnnotation]; found {
+		fungibility.WhenCanPreempt = kueue.FlavorFungibilityPolicy(value)
+	}
+	return fungibility
+}
+
 func shouldTryNextFlavor(representativeMode granularMode, flavorFungibility kueue.FlavorFungibility) bool {
 	policyPreempt := flavorFungibility.WhenCanPreempt
 	policyBorrow := flavorFungibility.WhenCanBorrow
@@ -885,7 +1168,7 @@ func (a *FlavorAssigner) fitsResourceQuota(log logr.Logger, fr resources.FlavorR
 
 	// No Fit
 	if val > maxCapacity {
-		status.appendf("insufficient quota for %s in flavor %s, request > maximum capacity (%s > %s)",
+		status.appendDetailf(unschedulableReason(kueue.UnschedulableReasonInsufficientQuota, fr), "insufficient quota for %s in flavor %s, request > maximum capacity (%s > %s)",
 			fr.Resource, fr.Flavor, resources.ResourceQuantityString(fr.Resource, val), resources.ResourceQuantityString(fr.Resource, maxCapacity))
 		return noFit, 0, &status
 	}
@@ -897,17 +1180,31 @@ func (a *FlavorAssigner) fitsResourceQuota(log logr.Logger, fr resources.FlavorR
 	}
 
 	// Preempt
-	status.appendf("insufficient unused quota for %s in flavor %s, %s more needed",
-		fr.Resource, fr.Flavor, resources.ResourceQuantityString(fr.Resource, val-available))
+	format := "insufficient unused quota for %s in flavor %s, %s more needed"
+	args := []any{fr.Resource, fr.Flavor, resources.ResourceQuantityString(fr.Resource, val-available)}
 
 	if val <= rQuota.Nominal || mayReclaimInHierarchy || a.canPreemptWhileBorrowing() {
 		preemptionPossiblity, borrowAfterPreemptions := a.oracle.SimulatePreemption(log, a.cq, *a.wl, fr, val)
 		mode := fromPreemptionPossibility(preemptionPossiblity)
+		reason := kueue.UnschedulableReasonPreemptionRequired
+		if mode == noPreemptionCandidates {
+			reason = kueue.UnschedulableReasonPreemptionInsufficient
+		}
+		status.appendDetailf(unschedulableReason(reason, fr), format, args...)
 		return mode, borrowAfterPreemptions, &status
 	}
+	status.appendDetailf(unschedulableReason(kueue.UnschedulableReasonBorrowingBlocked, fr), format, args...)
 	return noFit, borrow, &status
 }
 
+func unschedulableReason(reason kueue.UnschedulableReasonType, fr resources.FlavorResource) kueue.UnschedulableReason {
+	return kueue.UnschedulableReason{
+		Reason:   reason,
+		Flavor:   fr.Flavor,
+		Resource: fr.Resource,
+	}
+}
+
 func (a *FlavorAssigner) canPreemptWhileBorrowing() bool {
 	return (a.cq.Preemption.BorrowWithinCohort != nil && a.cq.Preemption.BorrowWithinCohort.Policy != kueue.BorrowWithinCohortPolicyNever) ||
 		(a.enableFairSharing && a.cq.Preemption.ReclaimWithinCohort != kueue.PreemptionPolicyNever)
@@ -26,13 +26,16 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/resources"
 	preemptioncommon "sigs.k8s.io/kueue/pkg/scheduler/preemption/common"
 	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	testingnode "sigs.k8s.io/kueue/pkg/util/testingjobs/node"
 	"sigs.k8s.io/kueue/pkg/workload"
 )
 
@@ -72,6 +75,11 @@ func TestAssignFlavors(t *testing.T) {
 		"two":     utiltesting.MakeResourceFlavor("two").NodeLabel("type", "two").Obj(),
 		"b_one":   utiltesting.MakeResourceFlavor("b_one").NodeLabel("b_type", "one").Obj(),
 		"b_two":   utiltesting.MakeResourceFlavor("b_two").NodeLabel("b_type", "two").Obj(),
+		"mig":     utiltesting.MakeResourceFlavor("mig").NodeLabel("type", "mig").ResourcePartition("example.com/gpu", "125m").Obj(),
+		"overhead": utiltesting.MakeResourceFlavor("overhead").NodeLabel("type", "overhead").
+			PodOverhead(corev1.ResourceCPU, "500m").
+			PodOverhead(corev1.ResourceMemory, "1Gi").
+			Obj(),
 		"tainted": utiltesting.MakeResourceFlavor("tainted").
 			Taint(corev1.Taint{
 				Key:    "instance",
@@ -108,6 +116,20 @@ func TestAssignFlavors(t *testing.T) {
 		elasticJobsViaWorkloadSlicesEnabled bool
 		preemptWorkloadSlice                *workload.Info
 		enableImplicitPreferenceDefault     bool
+		wantUnschedulableReasons            []kueue.UnschedulableReason
+		enableFlavorStickiness              bool
+		wlLastAdmittedFlavors               []kueue.PodSetFlavors
+		enableResourceRatios                bool
+		enablePhysicalCapacity              bool
+		enableResourcePartitions            bool
+		enableFlavorCostOrdering            bool
+		enablePodOverhead                   bool
+		enableFlavorFailover                bool
+		enableACTimeouts                    bool
+		enablePodSetFlavorSelection         bool
+		wlExcludedFlavors                   []kueue.ExcludedFlavor
+		flavorCosts                         map[kueue.ResourceFlavorReference]resource.Quantity
+		nodes                               []*corev1.Node
 	}{
 		"single flavor, fits": {
 			wlPods: []kueue.PodSet{
@@ -1035,6 +1057,13 @@ func TestAssignFlavors(t *testing.T) {
 				}},
 				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{}},
 			},
+			wantUnschedulableReasons: []kueue.UnschedulableReason{{
+				Reason:   kueue.UnschedulableReasonInsufficientQuota,
+				PodSet:   kueue.DefaultPodSetName,
+				Flavor:   "one",
+				Resource: corev1.ResourceCPU,
+				Message:  "insufficient quota for cpu in flavor one, request > maximum capacity (2 > 1)",
+			}},
 		},
 		"past max, but can preempt in ClusterQueue": {
 			wlPods: []kueue.PodSet{
@@ -1084,6 +1113,13 @@ func TestAssignFlavors(t *testing.T) {
 					{Flavor: "one", Resource: corev1.ResourceCPU}: 2_000,
 				}},
 			},
+			wantUnschedulableReasons: []kueue.UnschedulableReason{{
+				Reason:   kueue.UnschedulableReasonPreemptionRequired,
+				PodSet:   kueue.DefaultPodSetName,
+				Flavor:   "one",
+				Resource: corev1.ResourceCPU,
+				Message:  "insufficient unused quota for cpu in flavor one, 1 more needed",
+			}},
 		},
 		"past min, but can preempt in ClusterQueue": {
 			wlPods: []kueue.PodSet{
@@ -2422,174 +2458,971 @@ func TestAssignFlavors(t *testing.T) {
 				}},
 			},
 		},
-	}
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			ctx, log := utiltesting.ContextWithLog(t)
-			if tc.disableLendingLimit {
-				features.SetFeatureGateDuringTest(t, features.LendingLimit, false)
-			}
-			if tc.enableImplicitPreferenceDefault {
-				features.SetFeatureGateDuringTest(t, features.FlavorFungibilityImplicitPreferenceDefault, true)
-			}
-			wlInfo := workload.NewInfo(&kueue.Workload{
-				Spec: kueue.WorkloadSpec{
-					PodSets: tc.wlPods,
-				},
-				Status: kueue.WorkloadStatus{
-					ReclaimablePods: tc.wlReclaimablePods,
-				},
-			})
-
-			cache := schdcache.New(utiltesting.NewFakeClient())
-			if err := cache.AddClusterQueue(ctx, &tc.clusterQueue); err != nil {
-				t.Fatalf("Failed to add CQ to cache")
-			}
-			if tc.secondaryClusterQueue != nil {
-				if err := cache.AddClusterQueue(ctx, tc.secondaryClusterQueue); err != nil {
-					t.Fatalf("Failed to add secondary CQ to cache")
-				}
-			}
-			for _, rf := range resourceFlavors {
-				cache.AddOrUpdateResourceFlavor(log, rf)
-			}
-
-			if err := cache.AddOrUpdateCohort(utiltesting.MakeCohort(tc.clusterQueue.Spec.Cohort).Obj()); err != nil {
-				t.Fatalf("Failed to create a cohort")
-			}
-
-			snapshot, err := cache.Snapshot(ctx)
-			if err != nil {
-				t.Fatalf("unexpected error while building snapshot: %v", err)
-			}
-			clusterQueue := snapshot.ClusterQueue(kueue.ClusterQueueReference(tc.clusterQueue.Name))
-
-			if clusterQueue == nil {
-				t.Fatalf("Failed to create CQ snapshot")
-			}
-			if tc.clusterQueueUsage != nil {
-				clusterQueue.AddUsage(workload.Usage{Quota: tc.clusterQueueUsage})
-			}
-
-			if tc.secondaryClusterQueue != nil {
-				secondaryClusterQueue := snapshot.ClusterQueue(kueue.ClusterQueueReference(tc.secondaryClusterQueue.Name))
-				if secondaryClusterQueue == nil {
-					t.Fatalf("Failed to create secondary CQ snapshot")
-				}
-				secondaryClusterQueue.AddUsage(workload.Usage{Quota: tc.secondaryClusterQueueUsage})
-			}
-
-			if tc.elasticJobsViaWorkloadSlicesEnabled {
-				features.SetFeatureGateDuringTest(t, features.ElasticJobsViaWorkloadSlices, true)
-			}
-
-			flvAssigner := New(wlInfo, clusterQueue, resourceFlavors, tc.enableFairSharing, &testOracle{simulationResult: tc.simulationResult}, tc.preemptWorkloadSlice)
-			assignment := flvAssigner.Assign(log, nil)
-			if repMode := assignment.RepresentativeMode(); repMode != tc.wantRepMode {
-				t.Errorf("e.assignFlavors(_).RepresentativeMode()=%s, want %s", repMode, tc.wantRepMode)
-			}
-
-			if diff := cmp.Diff(tc.wantAssignment, assignment,
-				cmpopts.EquateEmpty(),
-				cmpopts.IgnoreUnexported(Assignment{}, FlavorAssignment{}), statusComparer, cmpopts.IgnoreFields(Assignment{}, "LastState"),
-			); diff != "" {
-				t.Errorf("Unexpected assignment (-want,+got):\n%s", diff)
-			}
-		})
-	}
-}
-
-// We have 3 flavors: uno, due, tre. Each has 10 compute and 10 gpu.
-// These FlavorResources are provided by test-clusterqueue, and made
-// available to its Cohort.
-func TestReclaimBeforePriorityPreemption(t *testing.T) {
-	type rfMap = map[corev1.ResourceName]kueue.ResourceFlavorReference
-	cases := map[string]struct {
-		workloadRequests       *utiltesting.PodSetWrapper
-		testClusterQueueUsage  resources.FlavorResourceQuantities
-		otherClusterQueueUsage resources.FlavorResourceQuantities
-		flavorFungibility      *kueue.FlavorFungibility
-		wantMode               FlavorAssignmentMode
-		wantAssigment          rfMap
-		simulationResult       map[resources.FlavorResource]simulationResultForFlavor
-	}{
-		"Select first flavor which fits": {
-			workloadRequests: utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Request("gpu", "10"),
-			testClusterQueueUsage: resources.FlavorResourceQuantities{
-				{Flavor: "uno", Resource: "gpu"}: 1,
+		"pod overhead; added to the requests of each pod": {
+			enablePodOverhead: true,
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 3).
+					Request(corev1.ResourceCPU, "1").
+					Obj(),
 			},
-			otherClusterQueueUsage: resources.FlavorResourceQuantities{
-				{Flavor: "due", Resource: "gpu"}: 1,
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("overhead").
+						Resource(corev1.ResourceCPU, "5").
+						Resource(corev1.ResourceMemory, "10Gi").
+						Obj(),
+				).Obj(),
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "overhead", Mode: Fit, TriedFlavorIdx: -1},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("4500m"),
+					},
+					Count: 3,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "overhead", Resource: corev1.ResourceCPU}: 4_500,
+				}},
 			},
-			wantMode:      Fit,
-			wantAssigment: rfMap{"gpu": "tre"},
 		},
-		"Select first flavor where gpu reclamation is possible": {
-			workloadRequests: utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Request("gpu", "10"),
-			testClusterQueueUsage: resources.FlavorResourceQuantities{
-				{Flavor: "uno", Resource: "gpu"}: 1,
-			},
-			otherClusterQueueUsage: resources.FlavorResourceQuantities{
-				{Flavor: "due", Resource: "gpu"}: 1,
-				{Flavor: "tre", Resource: "gpu"}: 1,
+		"pod overhead; falls back to the next flavor when the overhead doesn't fit": {
+			enablePodOverhead: true,
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 4).
+					Request(corev1.ResourceCPU, "1").
+					Obj(),
 			},
-			simulationResult: map[resources.FlavorResource]simulationResultForFlavor{
-				{Flavor: "uno", Resource: "gpu"}: {preemptioncommon.Preempt, 0},
-				{Flavor: "due", Resource: "gpu"}: {preemptioncommon.Reclaim, 0},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("overhead").
+						Resource(corev1.ResourceCPU, "5").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("default").
+						Resource(corev1.ResourceCPU, "5").
+						Obj(),
+				).Obj(),
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "default", Mode: Fit, TriedFlavorIdx: -1},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("4"),
+					},
+					Count: 4,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "default", Resource: corev1.ResourceCPU}: 4_000,
+				}},
 			},
-			wantMode:      Preempt,
-			wantAssigment: rfMap{"gpu": "due"},
 		},
-		"Select first flavor when flavor fungibility is disabled": {
-			workloadRequests: utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Request("gpu", "10"),
-			testClusterQueueUsage: resources.FlavorResourceQuantities{
-				{Flavor: "uno", Resource: "gpu"}: 1,
-			},
-			otherClusterQueueUsage: resources.FlavorResourceQuantities{
-				{Flavor: "due", Resource: "gpu"}: 1,
-				{Flavor: "tre", Resource: "gpu"}: 1,
-			},
-			flavorFungibility: &kueue.FlavorFungibility{
-				WhenCanPreempt: kueue.Preempt,
+		"flavor failover; the excluded flavor is skipped": {
+			enableFlavorFailover: true,
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "1").
+					Obj(),
 			},
-			wantMode:      Preempt,
-			wantAssigment: rfMap{"gpu": "uno"},
-		},
-		"Select first flavor where priority based preemption is possible": {
-			workloadRequests: utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Request("gpu", "10"),
-			testClusterQueueUsage: resources.FlavorResourceQuantities{
-				{Flavor: "uno", Resource: "gpu"}: 1,
-				{Flavor: "due", Resource: "gpu"}: 1,
-				{Flavor: "tre", Resource: "gpu"}: 1,
+			wlExcludedFlavors: []kueue.ExcludedFlavor{{Name: "one"}},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource(corev1.ResourceCPU, "5").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("two").
+						Resource(corev1.ResourceCPU, "5").
+						Obj(),
+				).Obj(),
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "two", Mode: Fit, TriedFlavorIdx: -1},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("1"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "two", Resource: corev1.ResourceCPU}: 1_000,
+				}},
 			},
-			wantMode:      Preempt,
-			wantAssigment: rfMap{"gpu": "uno"},
 		},
-		"Select second flavor where gpu reclamation is possible, as compute Fits": {
-			workloadRequests: utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Request("gpu", "10").Request("compute", "10"),
-			testClusterQueueUsage: resources.FlavorResourceQuantities{
-				{Flavor: "uno", Resource: "gpu"}:     1,
-				{Flavor: "uno", Resource: "compute"}: 1,
-				{Flavor: "due", Resource: "compute"}: 1,
-			},
-			otherClusterQueueUsage: resources.FlavorResourceQuantities{
-				{Flavor: "due", Resource: "gpu"}: 1,
-				{Flavor: "tre", Resource: "gpu"}: 1,
+		"admission check timeouts; the flavor of the timed out admission check is skipped on retry": {
+			enableACTimeouts: true,
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "1").
+					Obj(),
 			},
-			simulationResult: map[resources.FlavorResource]simulationResultForFlavor{
-				{Flavor: "uno", Resource: "gpu"}: {preemptioncommon.Preempt, 0},
-				{Flavor: "due", Resource: "gpu"}: {preemptioncommon.Reclaim, 0},
-				{Flavor: "tre", Resource: "gpu"}: {preemptioncommon.Reclaim, 0},
+			wlExcludedFlavors: []kueue.ExcludedFlavor{{Name: "one"}},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource(corev1.ResourceCPU, "5").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("two").
+						Resource(corev1.ResourceCPU, "5").
+						Obj(),
+				).Obj(),
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "two", Mode: Fit, TriedFlavorIdx: -1},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("1"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "two", Resource: corev1.ResourceCPU}: 1_000,
+				}},
 			},
-			wantMode:      Preempt,
-			wantAssigment: rfMap{"gpu": "tre", "compute": "tre"},
 		},
-	}
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			ctx, _ := utiltesting.ContextWithLog(t)
-			resourceFlavors := map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor{
-				"uno": utiltesting.MakeResourceFlavor("uno").Obj(),
+		"podset flavor selection; each podset is assigned to its allowed flavor": {
+			enablePodSetFlavorSelection: true,
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("driver", 1).
+					Annotations(map[string]string{controllerconstants.PodSetFlavorsAnnotation: "one"}).
+					Request(corev1.ResourceCPU, "1").
+					Obj(),
+				*utiltesting.MakePodSet("workers", 2).
+					Annotations(map[string]string{controllerconstants.PodSetFlavorsAnnotation: "two"}).
+					Request(corev1.ResourceCPU, "1").
+					Obj(),
+			},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource(corev1.ResourceCPU, "5").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("two").
+						Resource(corev1.ResourceCPU, "5").
+						Obj(),
+				).Obj(),
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{
+					{
+						Name: "driver",
+						Flavors: ResourceAssignment{
+							corev1.ResourceCPU: {Name: "one", Mode: Fit, TriedFlavorIdx: 0},
+						},
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("1"),
+						},
+						Count: 1,
+					},
+					{
+						Name: "workers",
+						Flavors: ResourceAssignment{
+							corev1.ResourceCPU: {Name: "two", Mode: Fit, TriedFlavorIdx: -1},
+						},
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("2"),
+						},
+						Count: 2,
+					},
+				},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "one", Resource: corev1.ResourceCPU}: 1_000,
+					{Flavor: "two", Resource: corev1.ResourceCPU}: 2_000,
+				}},
+			},
+		},
+		"podset flavor selection; no allowed flavor fits": {
+			enablePodSetFlavorSelection: true,
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Annotations(map[string]string{controllerconstants.PodSetFlavorsAnnotation: "two"}).
+					Request(corev1.ResourceCPU, "1").
+					Obj(),
+			},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource(corev1.ResourceCPU, "5").
+						Obj(),
+				).Obj(),
+			wantRepMode: NoFit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name:   "main",
+					Status: *NewStatus("flavor one is not allowed for podSet main"),
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("1"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{}},
+			},
+		},
+		"resource partitions; falls back from the full devices to the partitions": {
+			enableResourcePartitions: true,
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request("example.com/gpu", "4").
+					Obj(),
+			},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource("example.com/gpu", "2").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("mig").
+						Resource("example.com/gpu", "1").
+						Obj(),
+				).Obj(),
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						"example.com/gpu": {Name: "mig", Mode: Fit, TriedFlavorIdx: -1},
+					},
+					Requests: corev1.ResourceList{
+						"example.com/gpu": resource.MustParse("500m"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "mig", Resource: "example.com/gpu"}: 500,
+				}},
+			},
+		},
+		"resource partitions; the fractions aggregate in the quota": {
+			enableResourcePartitions: true,
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request("example.com/gpu", "1").
+					Obj(),
+			},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("mig").
+						Resource("example.com/gpu", "1").
+						Obj(),
+				).Obj(),
+			clusterQueueUsage: resources.FlavorResourceQuantities{
+				{Flavor: "mig", Resource: "example.com/gpu"}: 875,
+			},
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						"example.com/gpu": {Name: "mig", Mode: Fit, TriedFlavorIdx: -1},
+					},
+					Requests: corev1.ResourceList{
+						"example.com/gpu": resource.MustParse("125m"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "mig", Resource: "example.com/gpu"}: 125,
+				}},
+			},
+		},
+		"flavor cost ordering; the cheapest flavor is tried first": {
+			enableFlavorCostOrdering: true,
+			flavorCosts: map[kueue.ResourceFlavorReference]resource.Quantity{
+				"one": resource.MustParse("3"),
+				"two": resource.MustParse("1.5"),
+			},
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "2").
+					Obj(),
+			},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				FlavorFungibility(kueue.FlavorFungibility{FlavorOrder: kueue.FlavorOrderLowestCost}).
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("default").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("two").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+				).Obj(),
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "two", Mode: Fit, TriedFlavorIdx: 0},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("2"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "two", Resource: corev1.ResourceCPU}: 2_000,
+				}},
+			},
+		},
+		"flavor cost ordering; falls back to the next cheapest flavor": {
+			enableFlavorCostOrdering: true,
+			flavorCosts: map[kueue.ResourceFlavorReference]resource.Quantity{
+				"one": resource.MustParse("3"),
+				"two": resource.MustParse("1.5"),
+			},
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "2").
+					Obj(),
+			},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				FlavorFungibility(kueue.FlavorFungibility{FlavorOrder: kueue.FlavorOrderLowestCost}).
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("default").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("two").
+						Resource(corev1.ResourceCPU, "1").
+						Obj(),
+				).Obj(),
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "one", Mode: Fit, TriedFlavorIdx: 1},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("2"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "one", Resource: corev1.ResourceCPU}: 2_000,
+				}},
+			},
+		},
+		"flavor cost ordering; the declared order is kept without the LowestCost flavorOrder": {
+			enableFlavorCostOrdering: true,
+			flavorCosts: map[kueue.ResourceFlavorReference]resource.Quantity{
+				"one": resource.MustParse("3"),
+				"two": resource.MustParse("1.5"),
+			},
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "2").
+					Obj(),
+			},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("default").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("two").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+				).Obj(),
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "default", Mode: Fit, TriedFlavorIdx: 0},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("2"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "default", Resource: corev1.ResourceCPU}: 2_000,
+				}},
+			},
+		},
+		"physical capacity; workload doesn't fit in the Nodes of the first flavor": {
+			enablePhysicalCapacity: true,
+			nodes: []*corev1.Node{
+				testingnode.MakeNode("one-1").Label("type", "one").StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}).Ready().Obj(),
+				testingnode.MakeNode("two-1").Label("type", "two").StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")}).Ready().Obj(),
+			},
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "6").
+					Obj(),
+			},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				PhysicalCapacityPolicy(kueue.PhysicalCapacityPolicyLimitAdmission).
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("two").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+				).Obj(),
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "two", Mode: Fit, TriedFlavorIdx: -1},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("6"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "two", Resource: corev1.ResourceCPU}: 6_000,
+				}},
+			},
+		},
+		"physical capacity; the usage of the other ClusterQueues is deducted from the headroom": {
+			enablePhysicalCapacity: true,
+			nodes: []*corev1.Node{
+				testingnode.MakeNode("one-1").Label("type", "one").StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}).Ready().Obj(),
+				testingnode.MakeNode("one-2").Label("type", "one").StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}).NotReady().Obj(),
+				testingnode.MakeNode("two-1").Label("type", "two").StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")}).Ready().Obj(),
+			},
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "2").
+					Obj(),
+			},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				PhysicalCapacityPolicy(kueue.PhysicalCapacityPolicyLimitAdmission).
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("two").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+				).Obj(),
+			secondaryClusterQueue: utiltesting.MakeClusterQueue("cq-secondary").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+				).Obj(),
+			secondaryClusterQueueUsage: resources.FlavorResourceQuantities{
+				{Flavor: "one", Resource: corev1.ResourceCPU}: 3_000,
+			},
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "two", Mode: Fit, TriedFlavorIdx: -1},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("2"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "two", Resource: corev1.ResourceCPU}: 2_000,
+				}},
+			},
+		},
+		"physical capacity; workload doesn't fit in the Nodes of any flavor": {
+			enablePhysicalCapacity: true,
+			nodes: []*corev1.Node{
+				testingnode.MakeNode("one-1").Label("type", "one").StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}).Ready().Obj(),
+				testingnode.MakeNode("two-1").Label("type", "two").StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")}).Ready().Obj(),
+			},
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "9").
+					Obj(),
+			},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				PhysicalCapacityPolicy(kueue.PhysicalCapacityPolicyLimitAdmission).
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("two").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+				).Obj(),
+			wantRepMode: NoFit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("9"),
+					},
+					Status: *NewStatus(
+						"insufficient physical capacity for cpu in flavor one, request > headroom (9 > 4)",
+						"insufficient physical capacity for cpu in flavor two, request > headroom (9 > 8)",
+					),
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{}},
+			},
+			wantUnschedulableReasons: []kueue.UnschedulableReason{
+				{
+					Reason:   kueue.UnschedulableReasonInsufficientPhysicalCapacity,
+					PodSet:   "main",
+					Flavor:   "one",
+					Resource: corev1.ResourceCPU,
+					Message:  "insufficient physical capacity for cpu in flavor one, request > headroom (9 > 4)",
+				},
+				{
+					Reason:   kueue.UnschedulableReasonInsufficientPhysicalCapacity,
+					PodSet:   "main",
+					Flavor:   "two",
+					Resource: corev1.ResourceCPU,
+					Message:  "insufficient physical capacity for cpu in flavor two, request > headroom (9 > 8)",
+				},
+			},
+		},
+		"physical capacity; ignored by default": {
+			enablePhysicalCapacity: true,
+			nodes: []*corev1.Node{
+				testingnode.MakeNode("one-1").Label("type", "one").StatusAllocatable(corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}).Ready().Obj(),
+			},
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "6").
+					Obj(),
+			},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource(corev1.ResourceCPU, "10").
+						Obj(),
+				).Obj(),
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "one", Mode: Fit, TriedFlavorIdx: -1},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("6"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "one", Resource: corev1.ResourceCPU}: 6_000,
+				}},
+			},
+		},
+		"resource ratios; workload without GPUs doesn't use the CPUs of the GPU flavor": {
+			enableResourceRatios: true,
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "3").
+					Obj(),
+			},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						ResourceQuotaWrapper(corev1.ResourceCPU).NominalQuota("16").MaxRatio("example.com/gpu", "4").Append().
+						ResourceQuotaWrapper("example.com/gpu").NominalQuota("4").Append().
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("two").
+						Resource(corev1.ResourceCPU, "16").
+						Resource("example.com/gpu", "0").
+						Obj(),
+				).Obj(),
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "two", Mode: Fit, TriedFlavorIdx: -1},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("3"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "two", Resource: corev1.ResourceCPU}: 3_000,
+				}},
+			},
+		},
+		"resource ratios; workload within the ratio uses the GPU flavor": {
+			enableResourceRatios: true,
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "4").
+					Request("example.com/gpu", "1").
+					Obj(),
+			},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						ResourceQuotaWrapper(corev1.ResourceCPU).NominalQuota("16").MaxRatio("example.com/gpu", "4").Append().
+						ResourceQuotaWrapper("example.com/gpu").NominalQuota("4").Append().
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("two").
+						Resource(corev1.ResourceCPU, "16").
+						Resource("example.com/gpu", "0").
+						Obj(),
+				).Obj(),
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "one", Mode: Fit, TriedFlavorIdx: 0},
+						"example.com/gpu":  {Name: "one", Mode: Fit, TriedFlavorIdx: 0},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("4"),
+						"example.com/gpu":  resource.MustParse("1"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "one", Resource: corev1.ResourceCPU}: 4_000,
+					{Flavor: "one", Resource: "example.com/gpu"}:  1,
+				}},
+			},
+		},
+		"resource ratios; workload over the ratio doesn't fit": {
+			enableResourceRatios: true,
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "8").
+					Request("example.com/gpu", "1").
+					Obj(),
+			},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						ResourceQuotaWrapper(corev1.ResourceCPU).NominalQuota("16").MaxRatio("example.com/gpu", "4").Append().
+						ResourceQuotaWrapper("example.com/gpu").NominalQuota("4").Append().
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("two").
+						Resource(corev1.ResourceCPU, "16").
+						Resource("example.com/gpu", "0").
+						Obj(),
+				).Obj(),
+			wantRepMode: NoFit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("8"),
+						"example.com/gpu":  resource.MustParse("1"),
+					},
+					Status: *NewStatus(
+						"usage of cpu in flavor one would exceed the maximum ratio per example.com/gpu (8 > 4)",
+						"insufficient quota for example.com/gpu in flavor two, request > maximum capacity (1 > 0)",
+					),
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{}},
+			},
+		},
+		"flavor stickiness; last admitted flavor is preferred when it fits": {
+			enableFlavorStickiness: true,
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "3").
+					Obj(),
+			},
+			wlLastAdmittedFlavors: []kueue.PodSetFlavors{{
+				Name:    "main",
+				Flavors: map[corev1.ResourceName]kueue.ResourceFlavorReference{corev1.ResourceCPU: "two"},
+			}},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource(corev1.ResourceCPU, "5").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("two").
+						Resource(corev1.ResourceCPU, "5").
+						Obj(),
+				).Obj(),
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "two", Mode: Fit, TriedFlavorIdx: -1},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("3"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "two", Resource: corev1.ResourceCPU}: 3_000,
+				}},
+			},
+		},
+		"flavor stickiness; other flavors are tried when the last admitted flavor doesn't fit": {
+			enableFlavorStickiness: true,
+			wlPods: []kueue.PodSet{
+				*utiltesting.MakePodSet("main", 1).
+					Request(corev1.ResourceCPU, "3").
+					Obj(),
+			},
+			wlLastAdmittedFlavors: []kueue.PodSetFlavors{{
+				Name:    "main",
+				Flavors: map[corev1.ResourceName]kueue.ResourceFlavorReference{corev1.ResourceCPU: "two"},
+			}},
+			clusterQueue: *utiltesting.MakeClusterQueue("cq-test").
+				ResourceGroup(
+					*utiltesting.MakeFlavorQuotas("one").
+						Resource(corev1.ResourceCPU, "5").
+						Obj(),
+					*utiltesting.MakeFlavorQuotas("two").
+						Resource(corev1.ResourceCPU, "5").
+						Obj(),
+				).Obj(),
+			clusterQueueUsage: resources.FlavorResourceQuantities{
+				{Flavor: "two", Resource: corev1.ResourceCPU}: 4_000,
+			},
+			wantRepMode: Fit,
+			wantAssignment: Assignment{
+				PodSets: []PodSetAssignment{{
+					Name: "main",
+					Flavors: ResourceAssignment{
+						corev1.ResourceCPU: {Name: "one", Mode: Fit, TriedFlavorIdx: 0},
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("3"),
+					},
+					Count: 1,
+				}},
+				Usage: workload.Usage{Quota: resources.FlavorResourceQuantities{
+					{Flavor: "one", Resource: corev1.ResourceCPU}: 3_000,
+				}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+			if tc.enableFlavorStickiness {
+				features.SetFeatureGateDuringTest(t, features.FlavorStickiness, true)
+			}
+			if tc.enableResourceRatios {
+				features.SetFeatureGateDuringTest(t, features.FlavorResourceRatios, true)
+			}
+			if tc.enablePhysicalCapacity {
+				features.SetFeatureGateDuringTest(t, features.FlavorPhysicalCapacity, true)
+			}
+			if tc.enablePodOverhead {
+				features.SetFeatureGateDuringTest(t, features.FlavorPodOverhead, true)
+			}
+			if tc.enableFlavorFailover {
+				features.SetFeatureGateDuringTest(t, features.FlavorFailover, true)
+			}
+			if tc.enableACTimeouts {
+				features.SetFeatureGateDuringTest(t, features.AdmissionCheckTimeouts, true)
+			}
+			if tc.enablePodSetFlavorSelection {
+				features.SetFeatureGateDuringTest(t, features.PodSetFlavorSelection, true)
+			}
+			if tc.enableResourcePartitions {
+				features.SetFeatureGateDuringTest(t, features.FlavorResourcePartitions, true)
+				resources.SetFractionalResourcesDuringTest(t, "example.com/gpu")
+			}
+			if tc.disableLendingLimit {
+				features.SetFeatureGateDuringTest(t, features.LendingLimit, false)
+			}
+			if tc.enableImplicitPreferenceDefault {
+				features.SetFeatureGateDuringTest(t, features.FlavorFungibilityImplicitPreferenceDefault, true)
+			}
+			wlInfo := workload.NewInfo(&kueue.Workload{
+				Spec: kueue.WorkloadSpec{
+					PodSets: tc.wlPods,
+				},
+				Status: kueue.WorkloadStatus{
+					ReclaimablePods:     tc.wlReclaimablePods,
+					LastAdmittedFlavors: tc.wlLastAdmittedFlavors,
+					ExcludedFlavors:     tc.wlExcludedFlavors,
+				},
+			})
+
+			objs := make([]client.Object, 0, len(tc.nodes))
+			for _, node := range tc.nodes {
+				objs = append(objs, node)
+			}
+			if tc.enableFlavorCostOrdering {
+				features.SetFeatureGateDuringTest(t, features.FlavorCostOrdering, true)
+			}
+			cache := schdcache.New(utiltesting.NewFakeClient(objs...))
+			if err := cache.AddClusterQueue(ctx, &tc.clusterQueue); err != nil {
+				t.Fatalf("Failed to add CQ to cache")
+			}
+			cache.SetFlavorCosts(tc.flavorCosts)
+			if tc.secondaryClusterQueue != nil {
+				if err := cache.AddClusterQueue(ctx, tc.secondaryClusterQueue); err != nil {
+					t.Fatalf("Failed to add secondary CQ to cache")
+				}
+			}
+			for _, rf := range resourceFlavors {
+				cache.AddOrUpdateResourceFlavor(log, rf)
+			}
+
+			if err := cache.AddOrUpdateCohort(utiltesting.MakeCohort(tc.clusterQueue.Spec.Cohort).Obj()); err != nil {
+				t.Fatalf("Failed to create a cohort")
+			}
+
+			snapshot, err := cache.Snapshot(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error while building snapshot: %v", err)
+			}
+			clusterQueue := snapshot.ClusterQueue(kueue.ClusterQueueReference(tc.clusterQueue.Name))
+
+			if clusterQueue == nil {
+				t.Fatalf("Failed to create CQ snapshot")
+			}
+			if tc.clusterQueueUsage != nil {
+				clusterQueue.AddUsage(workload.Usage{Quota: tc.clusterQueueUsage})
+			}
+
+			if tc.secondaryClusterQueue != nil {
+				secondaryClusterQueue := snapshot.ClusterQueue(kueue.ClusterQueueReference(tc.secondaryClusterQueue.Name))
+				if secondaryClusterQueue == nil {
+					t.Fatalf("Failed to create secondary CQ snapshot")
+				}
+				secondaryClusterQueue.AddUsage(workload.Usage{Quota: tc.secondaryClusterQueueUsage})
+			}
+
+			if tc.elasticJobsViaWorkloadSlicesEnabled {
+				features.SetFeatureGateDuringTest(t, features.ElasticJobsViaWorkloadSlices, true)
+			}
+
+			flvAssigner := New(wlInfo, clusterQueue, resourceFlavors, tc.enableFairSharing, &testOracle{simulationResult: tc.simulationResult}, tc.preemptWorkloadSlice)
+			assignment := flvAssigner.Assign(log, nil)
+			if repMode := assignment.RepresentativeMode(); repMode != tc.wantRepMode {
+				t.Errorf("e.assignFlavors(_).RepresentativeMode()=%s, want %s", repMode, tc.wantRepMode)
+			}
+
+			if diff := cmp.Diff(tc.wantAssignment, assignment,
+				cmpopts.EquateEmpty(),
+				cmpopts.IgnoreUnexported(Assignment{}, FlavorAssignment{}), statusComparer, cmpopts.IgnoreFields(Assignment{}, "LastState"),
+			); diff != "" {
+				t.Errorf("Unexpected assignment (-want,+got):\n%s", diff)
+			}
+			if tc.wantUnschedulableReasons != nil {
+				if diff := cmp.Diff(tc.wantUnschedulableReasons, assignment.UnschedulableReasons()); diff != "" {
+					t.Errorf("Unexpected unschedulable reasons (-want,+got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+// We have 3 flavors: uno, due, tre. Each has 10 compute and 10 gpu.
+// These FlavorResources are provided by test-clusterqueue, and made
+// available to its Cohort.
+func TestReclaimBeforePriorityPreemption(t *testing.T) {
+	type rfMap = map[corev1.ResourceName]kueue.ResourceFlavorReference
+	cases := map[string]struct {
+		workloadRequests       *utiltesting.PodSetWrapper
+		testClusterQueueUsage  resources.FlavorResourceQuantities
+		otherClusterQueueUsage resources.FlavorResourceQuantities
+		flavorFungibility      *kueue.FlavorFungibility
+		wantMode               FlavorAssignmentMode
+		wantAssigment          rfMap
+		simulationResult       map[resources.FlavorResource]simulationResultForFlavor
+	}{
+		"Select first flavor which fits": {
+			workloadRequests: utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Request("gpu", "10"),
+			testClusterQueueUsage: resources.FlavorResourceQuantities{
+				{Flavor: "uno", Resource: "gpu"}: 1,
+			},
+			otherClusterQueueUsage: resources.FlavorResourceQuantities{
+				{Flavor: "due", Resource: "gpu"}: 1,
+			},
+			wantMode:      Fit,
+			wantAssigment: rfMap{"gpu": "tre"},
+		},
+		"Select first flavor where gpu reclamation is possible": {
+			workloadRequests: utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Request("gpu", "10"),
+			testClusterQueueUsage: resources.FlavorResourceQuantities{
+				{Flavor: "uno", Resource: "gpu"}: 1,
+			},
+			otherClusterQueueUsage: resources.FlavorResourceQuantities{
+				{Flavor: "due", Resource: "gpu"}: 1,
+				{Flavor: "tre", Resource: "gpu"}: 1,
+			},
+			simulationResult: map[resources.FlavorResource]simulationResultForFlavor{
+				{Flavor: "uno", Resource: "gpu"}: {preemptioncommon.Preempt, 0},
+				{Flavor: "due", Resource: "gpu"}: {preemptioncommon.Reclaim, 0},
+			},
+			wantMode:      Preempt,
+			wantAssigment: rfMap{"gpu": "due"},
+		},
+		"Select first flavor when flavor fungibility is disabled": {
+			workloadRequests: utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Request("gpu", "10"),
+			testClusterQueueUsage: resources.FlavorResourceQuantities{
+				{Flavor: "uno", Resource: "gpu"}: 1,
+			},
+			otherClusterQueueUsage: resources.FlavorResourceQuantities{
+				{Flavor: "due", Resource: "gpu"}: 1,
+				{Flavor: "tre", Resource: "gpu"}: 1,
+			},
+			flavorFungibility: &kueue.FlavorFungibility{
+				WhenCanPreempt: kueue.Preempt,
+			},
+			wantMode:      Preempt,
+			wantAssigment: rfMap{"gpu": "uno"},
+		},
+		"Select first flavor where priority based preemption is possible": {
+			workloadRequests: utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Request("gpu", "10"),
+			testClusterQueueUsage: resources.FlavorResourceQuantities{
+				{Flavor: "uno", Resource: "gpu"}: 1,
+				{Flavor: "due", Resource: "gpu"}: 1,
+				{Flavor: "tre", Resource: "gpu"}: 1,
+			},
+			wantMode:      Preempt,
+			wantAssigment: rfMap{"gpu": "uno"},
+		},
+		"Select second flavor where gpu reclamation is possible, as compute Fits": {
+			workloadRequests: utiltesting.MakePodSet(kueue.DefaultPodSetName, 1).Request("gpu", "10").Request("compute", "10"),
+			testClusterQueueUsage: resources.FlavorResourceQuantities{
+				{Flavor: "uno", Resource: "gpu"}:     1,
+				{Flavor: "uno", Resource: "compute"}: 1,
+				{Flavor: "due", Resource: "compute"}: 1,
+			},
+			otherClusterQueueUsage: resources.FlavorResourceQuantities{
+				{Flavor: "due", Resource: "gpu"}: 1,
+				{Flavor: "tre", Resource: "gpu"}: 1,
+			},
+			simulationResult: map[resources.FlavorResource]simulationResultForFlavor{
+				{Flavor: "uno", Resource: "gpu"}: {preemptioncommon.Preempt, 0},
+				{Flavor: "due", Resource: "gpu"}: {preemptioncommon.Reclaim, 0},
+				{Flavor: "tre", Resource: "gpu"}: {preemptioncommon.Reclaim, 0},
+			},
+			wantMode:      Preempt,
+			wantAssigment: rfMap{"gpu": "tre", "compute": "tre"},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := utiltesting.ContextWithLog(t)
+			resourceFlavors := map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor{
+				"uno": utiltesting.MakeResourceFlavor("uno").Obj(),
 				"due": utiltesting.MakeResourceFlavor("due").Obj(),
 				"tre": utiltesting.MakeResourceFlavor("tre").Obj(),
 			}
@@ -3168,3 +4001,55 @@ func TestAssignment_TotalRequestsFor(t *testing.T) {
 		})
 	}
 }
+
+func TestFlavorFungibilityFor(t *testing.T) {
+	cqFungibility := kueue.FlavorFungibility{
+		WhenCanBorrow:  kueue.Borrow,
+		WhenCanPreempt: kueue.TryNextFlavor,
+	}
+	cases := map[string]struct {
+		enablePodSetFlavorSelection bool
+		podSets                     []*kueue.PodSet
+		want                        kueue.FlavorFungibility
+	}{
+		"no override": {
+			enablePodSetFlavorSelection: true,
+			podSets:                     []*kueue.PodSet{utiltesting.MakePodSet("main", 1).Obj()},
+			want:                        cqFungibility,
+		},
+		"podset overrides the policies": {
+			enablePodSetFlavorSelection: true,
+			podSets: []*kueue.PodSet{
+				utiltesting.MakePodSet("main", 1).
+					Annotations(map[string]string{
+						controllerconstants.PodSetWhenCanBorrowAnnotation:  string(kueue.TryNextFlavor),
+						controllerconstants.PodSetWhenCanPreemptAnnotation: string(kueue.Preempt),
+					}).
+					Obj(),
+			},
+			want: kueue.FlavorFungibility{
+				WhenCanBorrow:  kueue.TryNextFlavor,
+				WhenCanPreempt: kueue.Preempt,
+			},
+		},
+		"podset overrides are ignored when the feature is disabled": {
+			podSets: []*kueue.PodSet{
+				utiltesting.MakePodSet("main", 1).
+					Annotations(map[string]string{
+						controllerconstants.PodSetWhenCanPreemptAnnotation: string(kueue.Preempt),
+					}).
+					Obj(),
+			},
+			want: cqFungibility,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.PodSetFlavorSelection, tc.enablePodSetFlavorSelection)
+			a := &FlavorAssigner{cq: &schdcache.ClusterQueueSnapshot{FlavorFungibility: cqFungibility}}
+			if diff := cmp.Diff(tc.want, a.flavorFungibilityFor(tc.podSets)); diff != "" {
+				t.Errorf("Unexpected flavor fungibility (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
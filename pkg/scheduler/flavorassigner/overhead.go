@@ -0,0 +1,99 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavorassigner
+
+import (
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+)
+
+// flavorOverheads maps the flavor resources to the overhead added to the
+// requests of each pod assigned to the flavor.
+type flavorOverheads map[resources.FlavorResource]int64
+
+func newFlavorOverheads(resourceFlavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor) flavorOverheads {
+	if !features.Enabled(features.FlavorPodOverhead) {
+		return nil
+	}
+	var overheads flavorOverheads
+	for fName, rf := range resourceFlavors {
+		for rName, q := range rf.Spec.PodOverhead {
+			if overheads == nil {
+				overheads = make(flavorOverheads)
+			}
+			overheads[resources.FlavorResource{Flavor: fName, Resource: rName}] = resources.ResourceValue(rName, q)
+		}
+	}
+	return overheads
+}
+
+// flavorQuota computes the quota needed for the requests assigned to the
+// flavors, which differs from the requests for the partitioned resources and
+// for the resources with a pod overhead.
+type flavorQuota struct {
+	partitions flavorPartitions
+	overheads  flavorOverheads
+}
+
+func newFlavorQuota(resourceFlavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor) flavorQuota {
+	return flavorQuota{
+		partitions: newFlavorPartitions(resourceFlavors),
+		overheads:  newFlavorOverheads(resourceFlavors),
+	}
+}
+
+func (q flavorQuota) empty() bool {
+	return len(q.partitions) == 0 && len(q.overheads) == 0
+}
+
+// quotaValue returns the quota needed for the value requested of the flavor
+// resource by count pods. The pod overhead is added before accounting the
+// partitioned resources as fractions.
+func (q flavorQuota) quotaValue(fr resources.FlavorResource, val int64, count int32) int64 {
+	val += q.overheads[fr] * int64(count)
+	return q.partitions.quotaValue(fr, val)
+}
+
+// quotaRequests returns the quota needed for the requests of count pods
+// assigned to the flavor.
+func (q flavorQuota) quotaRequests(fName kueue.ResourceFlavorReference, requests resources.Requests, count int32) resources.Requests {
+	if q.empty() {
+		return requests
+	}
+	quota := make(resources.Requests, len(requests))
+	for rName, val := range requests {
+		quota[rName] = q.quotaValue(resources.FlavorResource{Flavor: fName, Resource: rName}, val, count)
+	}
+	return quota
+}
+
+// assignedQuotaRequests returns the quota needed for the requests of the
+// count pods of a pod set, given the flavors assigned to its resources.
+func (q flavorQuota) assignedQuotaRequests(flavors ResourceAssignment, requests resources.Requests, count int32) resources.Requests {
+	if q.empty() {
+		return requests
+	}
+	quota := make(resources.Requests, len(requests))
+	for rName, val := range requests {
+		if flvAssignment, found := flavors[rName]; found {
+			val = q.quotaValue(resources.FlavorResource{Flavor: flvAssignment.Name, Resource: rName}, val, count)
+		}
+		quota[rName] = val
+	}
+	return quota
+}
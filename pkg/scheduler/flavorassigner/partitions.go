@@ -0,0 +1,57 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flavorassigner
+
+import (
+	"math"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/features"
+	"sigs.k8s.io/kueue/pkg/resources"
+)
+
+// flavorPartitions maps the resources that the flavors offer as partitions
+// of devices to the fraction of the device, in milli-units, that a unit of
+// the resource represents.
+type flavorPartitions map[resources.FlavorResource]int64
+
+func newFlavorPartitions(resourceFlavors map[kueue.ResourceFlavorReference]*kueue.ResourceFlavor) flavorPartitions {
+	if !features.Enabled(features.FlavorResourcePartitions) {
+		return nil
+	}
+	var partitions flavorPartitions
+	for fName, rf := range resourceFlavors {
+		for _, partition := range rf.Spec.ResourcePartitions {
+			if partitions == nil {
+				partitions = make(flavorPartitions)
+			}
+			partitions[resources.FlavorResource{Flavor: fName, Resource: partition.Name}] = partition.Fraction.MilliValue()
+		}
+	}
+	return partitions
+}
+
+// quotaValue returns the quota needed for the value requested of the flavor
+// resource. For the partitioned resources, it is the fraction of the value,
+// rounded up.
+func (p flavorPartitions) quotaValue(fr resources.FlavorResource, val int64) int64 {
+	fraction, found := p[fr]
+	if !found {
+		return val
+	}
+	return int64(math.Ceil(float64(val) * float64(fraction) / 1000))
+}
@@ -19,12 +19,14 @@ package flavorassigner
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
 
 	"k8s.io/utils/ptr"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/workload"
 )
 
@@ -102,17 +104,34 @@ func podSetTopologyRequest(psAssignment *PodSetAssignment,
 		podSetGroupName = podSet.TopologyRequest.PodSetGroupName
 	}
 
+	var preferredAssignment *kueue.TopologyAssignment
+	if features.Enabled(features.FlavorStickiness) {
+		preferredAssignment = lastTopologyAssignment(wl.Obj, podSet.Name, *tasFlvr)
+	}
+
 	return &schdcache.TASPodSetRequests{
-		Count:             podCount,
-		SinglePodRequests: singlePodRequests,
-		PodSet:            podSet,
-		PodSetUpdates:     podSetUpdates,
-		Flavor:            *tasFlvr,
-		Implied:           isTASImplied,
-		PodSetGroupName:   podSetGroupName,
+		Count:               podCount,
+		SinglePodRequests:   singlePodRequests,
+		PodSet:              podSet,
+		PodSetUpdates:       podSetUpdates,
+		Flavor:              *tasFlvr,
+		Implied:             isTASImplied,
+		PodSetGroupName:     podSetGroupName,
+		PreferredAssignment: preferredAssignment,
 	}, nil
 }
 
+// lastTopologyAssignment returns the topology assignment of the podSet when the
+// workload was last admitted, if it was admitted with the flavor.
+func lastTopologyAssignment(wl *kueue.Workload, psName kueue.PodSetReference, flavor kueue.ResourceFlavorReference) *kueue.TopologyAssignment {
+	for _, psf := range wl.Status.LastAdmittedFlavors {
+		if psf.Name == psName && slices.Contains(slices.Collect(maps.Values(psf.Flavors)), flavor) {
+			return psf.TopologyAssignment
+		}
+	}
+	return nil
+}
+
 func onlyFlavor(ra ResourceAssignment) (*kueue.ResourceFlavorReference, error) {
 	var result *kueue.ResourceFlavorReference
 	for _, v := range ra {
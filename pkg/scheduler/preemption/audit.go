@@ -0,0 +1,156 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preemption
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/resources"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// AuditRecord is the audit record of the preemptions issued to fit a workload.
+type AuditRecord struct {
+	// Timestamp is the time the preemptions were issued.
+	Timestamp time.Time `json:"timestamp"`
+	// Preemptor is the workload the preemptions were issued for.
+	Preemptor AuditWorkload `json:"preemptor"`
+	// Victims are the preempted workloads.
+	Victims []AuditVictim `json:"victims"`
+}
+
+// AuditWorkload identifies a workload in the audit records.
+type AuditWorkload struct {
+	Name         string                      `json:"name"`
+	Namespace    string                      `json:"namespace"`
+	UID          types.UID                   `json:"uid"`
+	JobUID       string                      `json:"jobUID,omitempty"`
+	ClusterQueue kueue.ClusterQueueReference `json:"clusterQueue"`
+	Priority     int32                       `json:"priority"`
+}
+
+// AuditVictim is a preempted workload in the audit records.
+type AuditVictim struct {
+	AuditWorkload
+	// Reason is the reason of the preemption, like InClusterQueue or InCohortReclamation.
+	Reason string `json:"reason"`
+	// QuotaReleased is the quota reserved by the workload, released by its preemption.
+	QuotaReleased map[kueue.ResourceFlavorReference]corev1.ResourceList `json:"quotaReleased"`
+}
+
+// AuditSink records the audit records of the preemptions.
+type AuditSink interface {
+	Record(record *AuditRecord)
+}
+
+// NewAuditSink returns the sink writing the audit records to the file set
+// in the configuration, or to the log of the manager when no file is set.
+func NewAuditSink(cfg *config.PreemptionAudit) (AuditSink, error) {
+	if cfg == nil || cfg.Path == "" {
+		return &logAuditSink{log: ctrl.Log.WithName("preemption-audit")}, nil
+	}
+	f, err := os.OpenFile(cfg.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening the preemption audit file: %w", err)
+	}
+	return newFileAuditSink(f), nil
+}
+
+type logAuditSink struct {
+	log logr.Logger
+}
+
+func (s *logAuditSink) Record(record *AuditRecord) {
+	s.log.Info("Preemptions issued", "timestamp", record.Timestamp, "preemptor", record.Preemptor, "victims", record.Victims)
+}
+
+type fileAuditSink struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	log     logr.Logger
+}
+
+func newFileAuditSink(w io.Writer) *fileAuditSink {
+	return &fileAuditSink{
+		encoder: json.NewEncoder(w),
+		log:     ctrl.Log.WithName("preemption-audit"),
+	}
+}
+
+func (s *fileAuditSink) Record(record *AuditRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.encoder.Encode(record); err != nil {
+		s.log.Error(err, "Failed to write the preemption audit record", "preemptor", record.Preemptor)
+	}
+}
+
+func newAuditWorkload(wlInfo *workload.Info) AuditWorkload {
+	return AuditWorkload{
+		Name:         wlInfo.Obj.Name,
+		Namespace:    wlInfo.Obj.Namespace,
+		UID:          wlInfo.Obj.UID,
+		JobUID:       wlInfo.Obj.Labels[constants.JobUIDLabel],
+		ClusterQueue: wlInfo.ClusterQueue,
+		Priority:     priority.Priority(wlInfo.Obj),
+	}
+}
+
+func newAuditVictim(target *Target) AuditVictim {
+	quota := make(map[kueue.ResourceFlavorReference]corev1.ResourceList)
+	for fr, v := range target.WorkloadInfo.FlavorResourceUsage() {
+		if quota[fr.Flavor] == nil {
+			quota[fr.Flavor] = make(corev1.ResourceList)
+		}
+		quota[fr.Flavor][fr.Resource] = resources.ResourceQuantity(fr.Resource, v)
+	}
+	return AuditVictim{
+		AuditWorkload: newAuditWorkload(target.WorkloadInfo),
+		Reason:        target.Reason,
+		QuotaReleased: quota,
+	}
+}
+
+// auditEventMessage returns the message of the event recorded on the preemptor
+// for the preemption of the victim.
+func auditEventMessage(victim *AuditVictim) string {
+	quota := make([]string, 0, len(victim.QuotaReleased))
+	for flavor, rl := range victim.QuotaReleased {
+		for r, q := range rl {
+			quota = append(quota, fmt.Sprintf("%s/%s: %s", flavor, r, q.String()))
+		}
+	}
+	slices.Sort(quota)
+	return fmt.Sprintf("Preempted workload %s/%s (UID: %s) in ClusterQueue %s due to %s, releasing %s",
+		victim.Namespace, victim.Name, victim.UID, victim.ClusterQueue, HumanReadablePreemptionReasons[victim.Reason], strings.Join(quota, ", "))
+}
@@ -0,0 +1,165 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preemption
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+func TestIssuePreemptionsAudit(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	low := utiltesting.MakeWorkload("low", "ns").
+		UID("low-uid").
+		Priority(0).
+		Request(corev1.ResourceCPU, "2").
+		ReserveQuota(utiltesting.MakeAdmission("cq").
+			PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "default", "2").Obj()).
+			Obj()).
+		Obj()
+	borrowing := utiltesting.MakeWorkload("borrowing", "ns").
+		UID("borrowing-uid").
+		Label(controllerconstants.JobUIDLabel, "job-uid").
+		Priority(10).
+		Request(corev1.ResourceCPU, "1").
+		Request(corev1.ResourceMemory, "1Gi").
+		ReserveQuota(utiltesting.MakeAdmission("other-cq").
+			PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+				Assignment(corev1.ResourceCPU, "default", "1").
+				Assignment(corev1.ResourceMemory, "default", "1Gi").
+				Obj()).
+			Obj()).
+		Obj()
+	incoming := utiltesting.MakeWorkload("incoming", "ns").
+		UID("incoming-uid").
+		Priority(100).
+		Obj()
+
+	ctx, _ := utiltesting.ContextWithLog(t)
+	cl := utiltesting.NewClientBuilder().WithObjects(low.DeepCopy(), borrowing.DeepCopy()).Build()
+	recorder := &utiltesting.EventRecorder{}
+	preemptor := New(cl, workload.Ordering{}, recorder, config.FairSharing{}, false, clocktesting.NewFakeClock(now))
+	preemptor.OverrideApply(func(context.Context, *kueue.Workload, *workload.Info, string, string) error {
+		return nil
+	})
+	var buf bytes.Buffer
+	preemptor.SetAuditSink(newFileAuditSink(&buf))
+
+	incomingInfo := workload.NewInfo(incoming)
+	incomingInfo.ClusterQueue = "cq"
+	targets := []*Target{
+		{WorkloadInfo: workload.NewInfo(low), Reason: kueue.InClusterQueueReason},
+		{WorkloadInfo: workload.NewInfo(borrowing), Reason: kueue.InCohortReclamationReason},
+	}
+	if _, err := preemptor.IssuePreemptions(ctx, incomingInfo, targets); err != nil {
+		t.Fatalf("Failed doing preemption: %v", err)
+	}
+
+	var gotRecord AuditRecord
+	if err := json.NewDecoder(&buf).Decode(&gotRecord); err != nil {
+		t.Fatalf("Failed to decode the audit record: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Unexpected data after the audit record: %q", buf.String())
+	}
+	wantRecord := AuditRecord{
+		Timestamp: now,
+		Preemptor: AuditWorkload{
+			Name:         "incoming",
+			Namespace:    "ns",
+			UID:          "incoming-uid",
+			ClusterQueue: "cq",
+			Priority:     100,
+		},
+		Victims: []AuditVictim{
+			{
+				AuditWorkload: AuditWorkload{
+					Name:         "borrowing",
+					Namespace:    "ns",
+					UID:          "borrowing-uid",
+					JobUID:       "job-uid",
+					ClusterQueue: "other-cq",
+					Priority:     10,
+				},
+				Reason: kueue.InCohortReclamationReason,
+				QuotaReleased: map[kueue.ResourceFlavorReference]corev1.ResourceList{
+					"default": {
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+				},
+			},
+			{
+				AuditWorkload: AuditWorkload{
+					Name:         "low",
+					Namespace:    "ns",
+					UID:          "low-uid",
+					ClusterQueue: "cq",
+				},
+				Reason: kueue.InClusterQueueReason,
+				QuotaReleased: map[kueue.ResourceFlavorReference]corev1.ResourceList{
+					"default": {
+						corev1.ResourceCPU: resource.MustParse("2"),
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(wantRecord, gotRecord); diff != "" {
+		t.Errorf("Unexpected audit record (-want,+got):\n%s", diff)
+	}
+
+	var gotEvents []utiltesting.EventRecord
+	for _, e := range recorder.RecordedEvents {
+		if e.Reason == "Preempting" {
+			gotEvents = append(gotEvents, e)
+		}
+	}
+	incomingKey := types.NamespacedName{Namespace: "ns", Name: "incoming"}
+	wantEvents := []utiltesting.EventRecord{
+		{
+			Key:       incomingKey,
+			EventType: corev1.EventTypeNormal,
+			Reason:    "Preempting",
+			Message:   "Preempted workload ns/borrowing (UID: borrowing-uid) in ClusterQueue other-cq due to reclamation within the cohort, releasing default/cpu: 1, default/memory: 1Gi",
+		},
+		{
+			Key:       incomingKey,
+			EventType: corev1.EventTypeNormal,
+			Reason:    "Preempting",
+			Message:   "Preempted workload ns/low (UID: low-uid) in ClusterQueue cq due to prioritization in the ClusterQueue, releasing default/cpu: 2",
+		},
+	}
+	if diff := cmp.Diff(wantEvents, gotEvents); diff != "" {
+		t.Errorf("Unexpected events (-want,+got):\n%s", diff)
+	}
+}
@@ -40,6 +40,7 @@ type candidateIterator struct {
 	NoCandidateFromOtherQueues        bool
 	NoCandidateForHierarchicalReclaim bool
 	hierarchicalReclaimCtx            *HierarchicalPreemptionCtx
+	filter                            func(*workload.Info) bool
 }
 
 type candidateElem struct {
@@ -124,7 +125,7 @@ func (c *candidateIterator) Next(borrow bool) (*workload.Info, string) {
 	}
 	candidate := c.candidates[c.runIndex]
 	c.runIndex++
-	if !c.candidateIsValid(candidate, borrow) {
+	if (c.filter != nil && !c.filter(candidate.wl)) || !c.candidateIsValid(candidate, borrow) {
 		return c.Next(borrow)
 	}
 	return candidate.wl, candidate.preemptionVariant.PreemptionReason()
@@ -156,6 +157,12 @@ func (c *candidateIterator) candidateIsValid(candidate *candidateElem, borrow bo
 	return true
 }
 
+// SetFilter restricts the candidates yielded by Next to the ones accepted by
+// the filter. A nil filter removes the restriction.
+func (c *candidateIterator) SetFilter(filter func(*workload.Info) bool) {
+	c.filter = filter
+}
+
 // Reset moves the candidate iterator back to the starting position.
 // It is required to reset the iterator before each run.
 func (c *candidateIterator) Reset() {
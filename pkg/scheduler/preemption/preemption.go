@@ -20,11 +20,14 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
+	"sync"
 	"sync/atomic"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -37,11 +40,13 @@ import (
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/resources"
 	"sigs.k8s.io/kueue/pkg/scheduler/flavorassigner"
 	"sigs.k8s.io/kueue/pkg/scheduler/preemption/classical"
 	preemptioncommon "sigs.k8s.io/kueue/pkg/scheduler/preemption/common"
 	"sigs.k8s.io/kueue/pkg/scheduler/preemption/fairsharing"
+	clientutil "sigs.k8s.io/kueue/pkg/util/client"
 	"sigs.k8s.io/kueue/pkg/util/logging"
 	"sigs.k8s.io/kueue/pkg/util/priority"
 	"sigs.k8s.io/kueue/pkg/util/routine"
@@ -61,9 +66,12 @@ type Preemptor struct {
 	fsStrategies      []fairsharing.Strategy
 
 	// stubs
-	applyPreemption func(ctx context.Context, w *kueue.Workload, reason, message string) error
+	applyPreemption func(ctx context.Context, w *kueue.Workload, preemptor *workload.Info, reason, message string) error
 
 	enabledAfs bool
+
+	// auditSink records the audit records of the preemptions, if set.
+	auditSink AuditSink
 }
 
 type preemptionCtx struct {
@@ -97,7 +105,12 @@ func New(
 	return p
 }
 
-func (p *Preemptor) OverrideApply(f func(context.Context, *kueue.Workload, string, string) error) {
+// SetAuditSink sets the sink of the audit records of the preemptions.
+func (p *Preemptor) SetAuditSink(sink AuditSink) {
+	p.auditSink = sink
+}
+
+func (p *Preemptor) OverrideApply(f func(context.Context, *kueue.Workload, *workload.Info, string, string) error) {
 	p.applyPreemption = f
 }
 
@@ -165,18 +178,33 @@ func preemptionMessage(preemptor *kueue.Workload, reason string) string {
 	return fmt.Sprintf("Preempted to accommodate a workload (UID: %s, JobUID: %s) due to %s", wUID, jUID, HumanReadablePreemptionReasons[reason])
 }
 
-// IssuePreemptions marks the target workloads as evicted.
+// IssuePreemptions marks the target workloads as evicted, or asks the shrinkable
+// target workloads to reduce the parallelism of their jobs.
 func (p *Preemptor) IssuePreemptions(ctx context.Context, preemptor *workload.Info, targets []*Target) (int, error) {
 	log := ctrl.LoggerFrom(ctx)
 	errCh := routine.NewErrorChannel()
 	ctx, cancel := context.WithCancel(ctx)
 	var successfullyPreempted atomic.Int64
+	var victimsMu sync.Mutex
+	var victims []AuditVictim
 	defer cancel()
 	workqueue.ParallelizeUntil(ctx, parallelPreemptions, len(targets), func(i int) {
 		target := targets[i]
-		if !meta.IsStatusConditionTrue(target.WorkloadInfo.Obj.Status.Conditions, kueue.WorkloadEvicted) {
+		switch {
+		case isShrinkRequested(target.WorkloadInfo.Obj):
+			log.V(3).Info("Shrinking ongoing", "targetWorkload", klog.KObj(target.WorkloadInfo.Obj), "preemptingWorkload", klog.KObj(preemptor.Obj))
+		case isShrinkable(target.WorkloadInfo.Obj):
+			message := preemptionMessage(preemptor.Obj, target.Reason)
+			if err := p.requestShrink(ctx, target.WorkloadInfo.Obj, message); err != nil {
+				errCh.SendErrorWithCancel(err, cancel)
+				return
+			}
+
+			log.V(3).Info("Shrink requested", "targetWorkload", klog.KObj(target.WorkloadInfo.Obj), "preemptingWorkload", klog.KObj(preemptor.Obj), "reason", target.Reason, "message", message, "targetClusterQueue", klog.KRef("", string(target.WorkloadInfo.ClusterQueue)))
+			p.recorder.Eventf(target.WorkloadInfo.Obj, corev1.EventTypeNormal, "ShrinkRequested", message)
+		case !meta.IsStatusConditionTrue(target.WorkloadInfo.Obj.Status.Conditions, kueue.WorkloadEvicted):
 			message := preemptionMessage(preemptor.Obj, target.Reason)
-			err := p.applyPreemption(ctx, target.WorkloadInfo.Obj, target.Reason, message)
+			err := p.applyPreemption(ctx, target.WorkloadInfo.Obj, preemptor, target.Reason, message)
 			if err != nil {
 				errCh.SendErrorWithCancel(err, cancel)
 				return
@@ -185,20 +213,78 @@ func (p *Preemptor) IssuePreemptions(ctx context.Context, preemptor *workload.In
 			log.V(3).Info("Preempted", "targetWorkload", klog.KObj(target.WorkloadInfo.Obj), "preemptingWorkload", klog.KObj(preemptor.Obj), "preemptorUID", string(preemptor.Obj.UID), "preemptorJobUID", preemptor.Obj.Labels[constants.JobUIDLabel], "reason", target.Reason, "message", message, "targetClusterQueue", klog.KRef("", string(target.WorkloadInfo.ClusterQueue)))
 			p.recorder.Eventf(target.WorkloadInfo.Obj, corev1.EventTypeNormal, "Preempted", message)
 			workload.ReportPreemption(preemptor.ClusterQueue, target.Reason, target.WorkloadInfo.ClusterQueue)
-		} else {
+			if p.auditSink != nil {
+				victimsMu.Lock()
+				victims = append(victims, newAuditVictim(target))
+				victimsMu.Unlock()
+			}
+		default:
 			log.V(3).Info("Preemption ongoing", "targetWorkload", klog.KObj(target.WorkloadInfo.Obj), "preemptingWorkload", klog.KObj(preemptor.Obj))
 		}
 		successfullyPreempted.Add(1)
 	})
+	if len(victims) > 0 {
+		p.recordAudit(preemptor, victims)
+	}
 	return int(successfullyPreempted.Load()), errCh.ReceiveError()
 }
 
-func (p *Preemptor) patchPreemption(ctx context.Context, w *kueue.Workload, reason, message string) error {
+// recordAudit records an event on the preemptor for each of the victims, and
+// the audit record of the preemptions in the audit sink.
+func (p *Preemptor) recordAudit(preemptor *workload.Info, victims []AuditVictim) {
+	slices.SortFunc(victims, func(a, b AuditVictim) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+	for i := range victims {
+		p.recorder.Event(preemptor.Obj, corev1.EventTypeNormal, "Preempting", auditEventMessage(&victims[i]))
+	}
+	p.auditSink.Record(&AuditRecord{
+		Timestamp: p.clock.Now(),
+		Preemptor: newAuditWorkload(preemptor),
+		Victims:   victims,
+	})
+}
+
+func (p *Preemptor) patchPreemption(ctx context.Context, w *kueue.Workload, preemptor *workload.Info, reason, message string) error {
 	w = w.DeepCopy()
 	return workload.Evict(ctx, p.client, p.recorder, w, kueue.WorkloadEvictedByPreemption, message, "", p.clock, workload.WithCustomPrepare(func() (*kueue.Workload, error) {
 		workload.SetPreemptedCondition(w, reason, message)
 		return w, nil
-	}))
+	}), workload.WithPreemptor(preemptor))
+}
+
+// isShrinkable returns true if the parallelism of the job owning the workload
+// can be reduced instead of evicting the workload.
+func isShrinkable(w *kueue.Workload) bool {
+	return features.Enabled(features.ElasticJobsShrinkOnPreemption) &&
+		w.Annotations[constants.ShrinkableAnnotation] == "true" &&
+		!meta.IsStatusConditionTrue(w.Status.Conditions, kueue.WorkloadEvicted)
+}
+
+// isShrinkRequested returns true if the workload was already asked to shrink.
+func isShrinkRequested(w *kueue.Workload) bool {
+	if !features.Enabled(features.ElasticJobsShrinkOnPreemption) {
+		return false
+	}
+	_, found := w.Annotations[constants.ShrinkRequestedAnnotation]
+	return found
+}
+
+// requestShrink asks the job reconciler to reduce the parallelism of the job
+// owning the workload by setting the ShrinkRequestedAnnotation.
+func (p *Preemptor) requestShrink(ctx context.Context, w *kueue.Workload, message string) error {
+	w = w.DeepCopy()
+	return clientutil.Patch(ctx, p.client, w, func() (client.Object, bool, error) {
+		metav1.SetMetaDataAnnotation(&w.ObjectMeta, constants.ShrinkRequestedAnnotation, message)
+		return w, true, nil
+	})
+}
+
+// candidateIterator yields the candidates of the classical preemption.
+type candidateIterator interface {
+	Next(borrow bool) (*workload.Info, string)
+	Reset()
+	SetFilter(filter func(*workload.Info) bool)
 }
 
 type preemptionAttemptOpts struct {
@@ -250,22 +336,40 @@ func (p *Preemptor) classicalPreemptions(preemptionCtx *preemptionCtx) []*Target
 	}
 
 	for _, attemptOpts := range attemptPossibleOpts {
-		var targets []*Target
-		candidatesGenerator.Reset()
-		for candidate, reason := candidatesGenerator.Next(attemptOpts.borrowing); candidate != nil; candidate, reason = candidatesGenerator.Next(attemptOpts.borrowing) {
-			preemptionCtx.snapshot.RemoveWorkload(candidate)
-			targets = append(targets, &Target{
-				WorkloadInfo: candidate,
-				Reason:       reason,
-			})
-			if workloadFits(preemptionCtx, attemptOpts.borrowing) {
-				targets = fillBackWorkloads(preemptionCtx, targets, attemptOpts.borrowing)
-				restoreSnapshot(preemptionCtx.snapshot, targets)
-				return targets
+		targets := preemptUntilFits(preemptionCtx, candidatesGenerator, attemptOpts.borrowing)
+		if features.Enabled(features.TASDomainAwarePreemption) {
+			if domainTargets := domainAwarePreemptions(preemptionCtx, candidatesGenerator, attemptOpts.borrowing); domainTargets != nil &&
+				(targets == nil || preferredTargets(domainTargets, targets)) {
+				targets = domainTargets
 			}
 		}
-		restoreSnapshot(preemptionCtx.snapshot, targets)
+		if targets != nil {
+			return targets
+		}
+	}
+	return nil
+}
+
+// preemptUntilFits removes the candidates yielded by the generator from the
+// snapshot until the incoming workload fits, and returns the minimal set of
+// them to preempt, or nil if the workload doesn't fit. The snapshot is
+// restored before returning.
+func preemptUntilFits(preemptionCtx *preemptionCtx, candidatesGenerator candidateIterator, allowBorrowing bool) []*Target {
+	var targets []*Target
+	candidatesGenerator.Reset()
+	for candidate, reason := candidatesGenerator.Next(allowBorrowing); candidate != nil; candidate, reason = candidatesGenerator.Next(allowBorrowing) {
+		preemptionCtx.snapshot.RemoveWorkload(candidate)
+		targets = append(targets, &Target{
+			WorkloadInfo: candidate,
+			Reason:       reason,
+		})
+		if workloadFits(preemptionCtx, allowBorrowing) {
+			targets = fillBackWorkloads(preemptionCtx, targets, allowBorrowing)
+			restoreSnapshot(preemptionCtx.snapshot, targets)
+			return targets
+		}
 	}
+	restoreSnapshot(preemptionCtx.snapshot, targets)
 	return nil
 }
 
@@ -2261,7 +2261,7 @@ func TestPreemption(t *testing.T) {
 			}
 			recorder := broadcaster.NewRecorder(scheme, corev1.EventSource{Component: constants.AdmissionName})
 			preemptor := New(cl, workload.Ordering{}, recorder, config.FairSharing{}, false, clocktesting.NewFakeClock(now))
-			preemptor.applyPreemption = func(ctx context.Context, w *kueue.Workload, reason, _ string) error {
+			preemptor.applyPreemption = func(ctx context.Context, w *kueue.Workload, _ *workload.Info, reason, _ string) error {
 				lock.Lock()
 				gotPreempted.Insert(targetKeyReason(workload.Key(w), reason))
 				lock.Unlock()
@@ -4585,7 +4585,7 @@ func TestHierarchicalPreemptions(t *testing.T) {
 			}
 			recorder := broadcaster.NewRecorder(scheme, corev1.EventSource{Component: constants.AdmissionName})
 			preemptor := New(cl, workload.Ordering{}, recorder, config.FairSharing{}, false, clocktesting.NewFakeClock(now))
-			preemptor.applyPreemption = func(ctx context.Context, w *kueue.Workload, reason, _ string) error {
+			preemptor.applyPreemption = func(ctx context.Context, w *kueue.Workload, _ *workload.Info, reason, _ string) error {
 				lock.Lock()
 				gotPreempted.Insert(targetKeyReason(workload.Key(w), reason))
 				lock.Unlock()
@@ -4616,3 +4616,87 @@ func TestHierarchicalPreemptions(t *testing.T) {
 		})
 	}
 }
+
+func TestIssuePreemptionsShrink(t *testing.T) {
+	now := time.Now()
+	shrinkable := utiltesting.MakeWorkload("shrinkable", "ns").
+		Annotation(controllerconstants.ShrinkableAnnotation, "true").
+		ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+		Obj()
+	shrinkRequested := utiltesting.MakeWorkload("shrink-requested", "ns").
+		Annotation(controllerconstants.ShrinkableAnnotation, "true").
+		Annotation(controllerconstants.ShrinkRequestedAnnotation, "Preempted").
+		ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+		Obj()
+	regular := utiltesting.MakeWorkload("regular", "ns").
+		ReserveQuota(utiltesting.MakeAdmission("cq").Obj()).
+		Obj()
+
+	cases := map[string]struct {
+		enableShrinkOnPreemption bool
+		wantPreempted            sets.Set[string]
+		wantShrinkRequested      sets.Set[string]
+	}{
+		"shrinkable workloads are evicted when the feature is disabled": {
+			wantPreempted:       sets.New("ns/shrinkable", "ns/shrink-requested", "ns/regular"),
+			wantShrinkRequested: sets.New("ns/shrink-requested"),
+		},
+		"shrinkable workloads are requested to shrink when the feature is enabled": {
+			enableShrinkOnPreemption: true,
+			wantPreempted:            sets.New("ns/regular"),
+			wantShrinkRequested:      sets.New("ns/shrinkable", "ns/shrink-requested"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.ElasticJobsShrinkOnPreemption, tc.enableShrinkOnPreemption)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			cl := utiltesting.NewClientBuilder().
+				WithObjects(shrinkable.DeepCopy(), shrinkRequested.DeepCopy(), regular.DeepCopy()).
+				Build()
+			recorder := &utiltesting.EventRecorder{}
+			preemptor := New(cl, workload.Ordering{}, recorder, config.FairSharing{}, false, clocktesting.NewFakeClock(now))
+			var lock sync.Mutex
+			gotPreempted := sets.New[string]()
+			preemptor.applyPreemption = func(ctx context.Context, w *kueue.Workload, _ *workload.Info, _, _ string) error {
+				lock.Lock()
+				gotPreempted.Insert(string(workload.Key(w)))
+				lock.Unlock()
+				return nil
+			}
+
+			var targets []*Target
+			for _, name := range []string{"shrinkable", "shrink-requested", "regular"} {
+				var wl kueue.Workload
+				if err := cl.Get(ctx, types.NamespacedName{Name: name, Namespace: "ns"}, &wl); err != nil {
+					t.Fatalf("Could not get Workload: %v", err)
+				}
+				targets = append(targets, &Target{WorkloadInfo: workload.NewInfo(&wl), Reason: kueue.InClusterQueueReason})
+			}
+			preempted, err := preemptor.IssuePreemptions(ctx, workload.NewInfo(utiltesting.MakeWorkload("incoming", "ns").Obj()), targets)
+			if err != nil {
+				t.Fatalf("Failed doing preemption: %v", err)
+			}
+			if preempted != len(targets) {
+				t.Errorf("Unexpected number of preempted targets, want %d, got %d", len(targets), preempted)
+			}
+			if diff := cmp.Diff(tc.wantPreempted, gotPreempted); diff != "" {
+				t.Errorf("Issued preemptions (-want,+got):\n%s", diff)
+			}
+
+			var wls kueue.WorkloadList
+			if err := cl.List(ctx, &wls); err != nil {
+				t.Fatalf("Could not list Workloads: %v", err)
+			}
+			gotShrinkRequested := sets.New[string]()
+			for i := range wls.Items {
+				if _, found := wls.Items[i].Annotations[controllerconstants.ShrinkRequestedAnnotation]; found {
+					gotShrinkRequested.Insert(string(workload.Key(&wls.Items[i])))
+				}
+			}
+			if diff := cmp.Diff(tc.wantShrinkRequested, gotShrinkRequested); diff != "" {
+				t.Errorf("Shrink requested workloads (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
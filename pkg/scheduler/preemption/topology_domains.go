@@ -0,0 +1,132 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preemption
+
+import (
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// domainAwarePreemptions looks for the targets to preempt within a single
+// topology domain, of the level requested by the incoming workload, among the
+// candidates yielded by the generator. The domains are tried in the order of
+// their first candidate, and the preferred targets are returned, or nil if
+// the workload doesn't fit by preempting the candidates of any domain.
+func domainAwarePreemptions(preemptionCtx *preemptionCtx, candidatesGenerator candidateIterator, allowBorrowing bool) []*Target {
+	flavor, level, found := requestedTopologyLevel(preemptionCtx.tasRequests)
+	if !found {
+		return nil
+	}
+	tasFlavor := preemptionCtx.preemptorCQ.TASFlavors[flavor]
+	if tasFlavor == nil {
+		return nil
+	}
+
+	var domains []utiltas.TopologyDomainID
+	candidateDomains := make(map[workload.Reference]sets.Set[utiltas.TopologyDomainID])
+	candidatesGenerator.Reset()
+	for candidate, _ := candidatesGenerator.Next(allowBorrowing); candidate != nil; candidate, _ = candidatesGenerator.Next(allowBorrowing) {
+		wlDomains := workloadTopologyDomains(candidate, tasFlavor, flavor, level)
+		candidateDomains[workload.Key(candidate.Obj)] = wlDomains
+		for _, domain := range sets.List(wlDomains) {
+			if !slices.Contains(domains, domain) {
+				domains = append(domains, domain)
+			}
+		}
+	}
+	if len(domains) < 2 {
+		// Preempting within a single domain doesn't change the targets.
+		return nil
+	}
+
+	var bestTargets []*Target
+	for _, domain := range domains {
+		candidatesGenerator.SetFilter(func(wl *workload.Info) bool {
+			return candidateDomains[workload.Key(wl.Obj)].Has(domain)
+		})
+		if targets := preemptUntilFits(preemptionCtx, candidatesGenerator, allowBorrowing); targets != nil &&
+			(bestTargets == nil || preferredTargets(targets, bestTargets)) {
+			bestTargets = targets
+		}
+	}
+	candidatesGenerator.SetFilter(nil)
+	if bestTargets != nil {
+		preemptionCtx.log.V(3).Info("Found preemption targets within a topology domain", "flavor", flavor, "level", level, "targets", len(bestTargets))
+	}
+	return bestTargets
+}
+
+// requestedTopologyLevel returns the flavor and the topology level required
+// or preferred by the first PodSet of the workload requesting one.
+func requestedTopologyLevel(tasRequests schdcache.WorkloadTASRequests) (kueue.ResourceFlavorReference, string, bool) {
+	for _, flavor := range slices.Sorted(maps.Keys(tasRequests)) {
+		for _, tr := range tasRequests[flavor] {
+			topologyRequest := tr.PodSet.TopologyRequest
+			switch {
+			case topologyRequest == nil:
+			case topologyRequest.Required != nil:
+				return flavor, *topologyRequest.Required, true
+			case topologyRequest.Preferred != nil:
+				return flavor, *topologyRequest.Preferred, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// workloadTopologyDomains returns the topology domains of the level the
+// admitted workload has pods in, for the flavor.
+func workloadTopologyDomains(wl *workload.Info, tasFlavor *schdcache.TASFlavorSnapshot, flavor kueue.ResourceFlavorReference, level string) sets.Set[utiltas.TopologyDomainID] {
+	domains := sets.New[utiltas.TopologyDomainID]()
+	if wl.Obj.Status.Admission == nil {
+		return domains
+	}
+	for _, psa := range wl.Obj.Status.Admission.PodSetAssignments {
+		if slices.Contains(slices.Collect(maps.Values(psa.Flavors)), flavor) {
+			domains = domains.Union(tasFlavor.AssignmentDomains(psa.TopologyAssignment, level))
+		}
+	}
+	return domains
+}
+
+// preferredTargets returns whether the targets a are preferred over the
+// targets b, because their highest priority is lower, or they are fewer.
+func preferredTargets(a, b []*Target) bool {
+	aPriority, bPriority := maxPriority(a), maxPriority(b)
+	if aPriority != bPriority {
+		return aPriority < bPriority
+	}
+	return len(a) < len(b)
+}
+
+func maxPriority(targets []*Target) int32 {
+	var result int32
+	for i, target := range targets {
+		if p := priority.Priority(target.WorkloadInfo.Obj); i == 0 || p > result {
+			result = p
+		}
+	}
+	return result
+}
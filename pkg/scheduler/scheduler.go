@@ -17,16 +17,19 @@ limitations under the License.
 package scheduler
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"maps"
 	"slices"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/labels"
@@ -34,6 +37,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -79,9 +83,25 @@ type Scheduler struct {
 	admissionFairSharing    *config.AdmissionFairSharing
 	clock                   clock.Clock
 
+	// cyclePeriod is the minimum time between the start of two
+	// consecutive scheduling cycles.
+	cyclePeriod time.Duration
+	// maxHeads is the maximum number of heads considered in a
+	// scheduling cycle, 0 means no limit.
+	maxHeads int
+	// maxAdmissionsPerCohort is the maximum number of workloads admitted
+	// per root Cohort in a scheduling cycle, 0 means no limit.
+	maxAdmissionsPerCohort int
+
 	// schedulingCycle identifies the number of scheduling
 	// attempts since the last restart.
 	schedulingCycle int64
+	// lastCycleStart is the start time of the last scheduling cycle.
+	lastCycleStart time.Time
+	// cycleTracker is notified of the scheduling cycles, if set.
+	cycleTracker CycleTracker
+	// decisionSink records the decisions of the admission attempts, if set.
+	decisionSink DecisionSink
 
 	// Stubs.
 	patchAdmission func(ctx context.Context, original, updated *kueue.Workload) error
@@ -91,6 +111,10 @@ type options struct {
 	podsReadyRequeuingTimestamp config.RequeuingTimestamp
 	fairSharing                 config.FairSharing
 	admissionFairSharing        *config.AdmissionFairSharing
+	schedulingCycle             config.SchedulingCycle
+	preemptionAuditSink         preemption.AuditSink
+	cycleTracker                CycleTracker
+	decisionSink                DecisionSink
 	clock                       clock.Clock
 }
 
@@ -124,6 +148,42 @@ func WithAdmissionFairSharing(afs *config.AdmissionFairSharing) Option {
 	}
 }
 
+// WithSchedulingCycle sets the limits of the scheduling cycles.
+func WithSchedulingCycle(sc *config.SchedulingCycle) Option {
+	return func(o *options) {
+		if sc != nil {
+			o.schedulingCycle = *sc
+		}
+	}
+}
+
+// WithPreemptionAuditSink sets the sink of the audit records of the preemptions.
+func WithPreemptionAuditSink(sink preemption.AuditSink) Option {
+	return func(o *options) {
+		o.preemptionAuditSink = sink
+	}
+}
+
+// CycleTracker is notified of the start and of the end of the scheduling cycles.
+type CycleTracker interface {
+	CycleStarted(start time.Time)
+	CycleFinished(end time.Time, success bool)
+}
+
+// WithCycleTracker sets the tracker of the scheduling cycles.
+func WithCycleTracker(tracker CycleTracker) Option {
+	return func(o *options) {
+		o.cycleTracker = tracker
+	}
+}
+
+// WithDecisionSink sets the sink of the decision records of the admission attempts.
+func WithDecisionSink(sink DecisionSink) Option {
+	return func(o *options) {
+		o.decisionSink = sink
+	}
+}
+
 func WithClock(_ testing.TB, c clock.Clock) Option {
 	return func(o *options) {
 		o.clock = c
@@ -149,6 +209,16 @@ func New(queues *qcache.Manager, cache *schdcache.Cache, cl client.Client, recor
 		workloadOrdering:        wo,
 		clock:                   options.clock,
 		admissionFairSharing:    options.admissionFairSharing,
+		maxHeads:                int(ptr.Deref(options.schedulingCycle.MaxHeads, 0)),
+		maxAdmissionsPerCohort:  int(ptr.Deref(options.schedulingCycle.MaxAdmissionsPerCohort, 0)),
+		cycleTracker:            options.cycleTracker,
+		decisionSink:            options.decisionSink,
+	}
+	if options.schedulingCycle.Period != nil {
+		s.cyclePeriod = options.schedulingCycle.Period.Duration
+	}
+	if options.preemptionAuditSink != nil {
+		s.preemptor.SetAuditSink(options.preemptionAuditSink)
 	}
 	s.patchAdmission = s.patchAdmissionStatus
 	return s
@@ -193,6 +263,10 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 	log := ctrl.LoggerFrom(ctx).WithValues("schedulingCycle", s.schedulingCycle)
 	ctx = ctrl.LoggerInto(ctx, log)
 
+	if !s.waitForCyclePeriod(ctx) {
+		return wait.KeepGoing
+	}
+
 	// 1. Get the heads from the queues, including their desired clusterQueue.
 	// This operation blocks while the queues are empty.
 	headWorkloads := s.queues.Heads(ctx)
@@ -201,6 +275,11 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 		return wait.KeepGoing
 	}
 	startTime := s.clock.Now()
+	s.lastCycleStart = startTime
+	if s.cycleTracker != nil {
+		s.cycleTracker.CycleStarted(startTime)
+	}
+	headWorkloads = s.limitHeads(ctx, headWorkloads)
 
 	// 2. Take a snapshot of the cache.
 	var snapshotOpts []schdcache.SnapshotOption
@@ -210,12 +289,18 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 	snapshot, err := s.cache.Snapshot(ctx, snapshotOpts...)
 	if err != nil {
 		log.Error(err, "failed to build snapshot for scheduling")
+		if s.cycleTracker != nil {
+			s.cycleTracker.CycleFinished(s.clock.Now(), false)
+		}
 		return wait.SlowDown
 	}
 	logSnapshotIfVerbose(log, snapshot)
+	phaseStart := s.clock.Now()
+	metrics.ReportSchedulingCyclePhase(metrics.SchedulingCyclePhaseSnapshot, phaseStart.Sub(startTime))
 
 	// 3. Calculate requirements (resource flavors, borrowing) for admitting workloads.
 	entries, inadmissibleEntries := s.nominate(ctx, headWorkloads, snapshot)
+	phaseStart = s.reportCyclePhase(metrics.SchedulingCyclePhaseNominate, phaseStart)
 
 	// 4. Create iterator which returns ordered entries.
 	iterator := makeIterator(ctx, entries, s.workloadOrdering, s.fairSharing.Enable)
@@ -227,6 +312,8 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 	// of other clusterQueues.
 	preemptedWorkloads := make(preemption.PreemptedWorkloads)
 	skippedPreemptions := make(map[kueue.ClusterQueueReference]int)
+	cohortAdmissions := make(map[admissionGroup]int)
+	deferredByCohortLimit := 0
 	for iterator.hasNext() {
 		e := iterator.pop()
 
@@ -253,6 +340,12 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 			log.V(3).Info("Skipping workload as FlavorAssigner assigned NoFit mode")
 			continue
 		}
+		if s.maxAdmissionsPerCohort > 0 && cohortAdmissions[admissionGroupOf(cq)] >= s.maxAdmissionsPerCohort {
+			setSkipped(e, "Workload deferred to the next scheduling cycle, after reaching the maximum admissions per cohort")
+			e.requeueReason = qcache.RequeueReasonDeferred
+			deferredByCohortLimit++
+			continue
+		}
 		log.V(2).Info("Attempting to schedule workload")
 
 		if mode == flavorassigner.Preempt && len(e.preemptionTargets) == 0 {
@@ -287,6 +380,18 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 			}
 			continue
 		}
+		if features.Enabled(features.AdvanceReservations) {
+			if conflicts := cq.ReservationConflicts(e.Obj, usage.Quota, s.clock.Now()); len(conflicts) > 0 {
+				setSkipped(e, fmt.Sprintf("Workload would use the capacity booked by active reservations: %s", strings.Join(conflicts, ", ")))
+				continue
+			}
+		}
+		if features.Enabled(features.ReservedHeadroom) {
+			if conflicts := cq.HeadroomConflicts(e.Obj, usage.Quota, targetsUsage(e.preemptionTargets)); len(conflicts) > 0 {
+				setSkipped(e, fmt.Sprintf("Workload would use the reserved headroom of the ClusterQueue for %s", formatFlavorResources(conflicts)))
+				continue
+			}
+		}
 		preemptedWorkloads.Insert(e.preemptionTargets)
 		cq.AddUsage(usage)
 
@@ -337,12 +442,20 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 		if err := s.admit(ctx, e, cq); err != nil {
 			e.inadmissibleMsg = fmt.Sprintf("Failed to admit workload: %v", err)
 		}
+		if e.status == assumed {
+			cohortAdmissions[admissionGroupOf(cq)]++
+		}
+	}
+	if deferredByCohortLimit > 0 {
+		metrics.ReportSchedulingCycleSkippedHeads(metrics.SkippedHeadsReasonMaxAdmissionsPerCohort, deferredByCohortLimit)
 	}
+	phaseStart = s.reportCyclePhase(metrics.SchedulingCyclePhaseAdmit, phaseStart)
 
 	// 6. Requeue the heads that were not scheduled.
 	result := metrics.AdmissionResultInadmissible
 	for _, e := range entries {
 		logAdmissionAttemptIfVerbose(log, &e)
+		s.recordDecision(&e)
 		// When the workload is evicted by scheduler we skip requeueAndUpdate.
 		// The eviction process will be finalized by the workload controller.
 		if e.status != assumed && e.status != evicted {
@@ -353,17 +466,95 @@ func (s *Scheduler) schedule(ctx context.Context) wait.SpeedSignal {
 	}
 	for _, e := range inadmissibleEntries {
 		logAdmissionAttemptIfVerbose(log, &e)
+		s.recordDecision(&e)
 		s.requeueAndUpdate(ctx, e)
 	}
 
+	s.reportCyclePhase(metrics.SchedulingCyclePhaseRequeue, phaseStart)
+
 	reportSkippedPreemptions(skippedPreemptions)
 	metrics.AdmissionAttempt(result, s.clock.Since(startTime))
+	if s.cycleTracker != nil {
+		s.cycleTracker.CycleFinished(s.clock.Now(), true)
+	}
 	if result != metrics.AdmissionResultSuccess {
 		return wait.SlowDown
 	}
 	return wait.KeepGoing
 }
 
+// recordDecision records the decision of the admission attempt of the entry
+// in the decision sink, if set.
+func (s *Scheduler) recordDecision(e *entry) {
+	if s.decisionSink != nil {
+		s.decisionSink.Record(newDecisionRecord(e, s.schedulingCycle, s.clock.Now()))
+	}
+}
+
+// waitForCyclePeriod blocks until the cycle period has passed since the
+// start of the last scheduling cycle. Returns false if the context is done.
+func (s *Scheduler) waitForCyclePeriod(ctx context.Context) bool {
+	if s.cyclePeriod <= 0 || s.lastCycleStart.IsZero() {
+		return true
+	}
+	remaining := s.cyclePeriod - s.clock.Since(s.lastCycleStart)
+	if remaining <= 0 {
+		return true
+	}
+	select {
+	case <-ctx.Done():
+		return false
+	case <-s.clock.After(remaining):
+		return true
+	}
+}
+
+// limitHeads returns the heads to consider in the scheduling cycle. When
+// there are more heads than maxHeads, the heads with the highest priority,
+// and then the oldest, are kept, and the rest are put back in their queues.
+func (s *Scheduler) limitHeads(ctx context.Context, heads []workload.Info) []workload.Info {
+	if s.maxHeads == 0 || len(heads) <= s.maxHeads {
+		return heads
+	}
+	slices.SortStableFunc(heads, func(a, b workload.Info) int {
+		if c := cmp.Compare(priority.Priority(b.Obj), priority.Priority(a.Obj)); c != 0 {
+			return c
+		}
+		return s.workloadOrdering.GetQueueOrderTimestamp(a.Obj).Compare(s.workloadOrdering.GetQueueOrderTimestamp(b.Obj).Time)
+	})
+	log := ctrl.LoggerFrom(ctx)
+	for i := s.maxHeads; i < len(heads); i++ {
+		w := &heads[i]
+		added := s.queues.RequeueWorkload(ctx, w, qcache.RequeueReasonDeferred)
+		log.V(3).Info("Workload deferred to the next scheduling cycle", "workload", klog.KObj(w.Obj), "clusterQueue", klog.KRef("", string(w.ClusterQueue)), "added", added)
+	}
+	metrics.ReportSchedulingCycleSkippedHeads(metrics.SkippedHeadsReasonMaxHeads, len(heads)-s.maxHeads)
+	return heads[:s.maxHeads]
+}
+
+// reportCyclePhase reports the duration of the phase of the scheduling
+// cycle started at start, and returns the start of the next phase.
+func (s *Scheduler) reportCyclePhase(phase metrics.SchedulingCyclePhase, start time.Time) time.Time {
+	now := s.clock.Now()
+	metrics.ReportSchedulingCyclePhase(phase, now.Sub(start))
+	return now
+}
+
+// admissionGroup identifies the ClusterQueues sharing the
+// maxAdmissionsPerCohort limit: the ones in the same root Cohort, or
+// a single ClusterQueue which doesn't belong to a Cohort.
+type admissionGroup struct {
+	rootCohort   kueue.CohortReference
+	clusterQueue kueue.ClusterQueueReference
+}
+
+func admissionGroupOf(cq *schdcache.ClusterQueueSnapshot) admissionGroup {
+	if cq.HasParent() {
+		return admissionGroup{rootCohort: cq.Parent().Root().GetName()}
+	}
+	return admissionGroup{clusterQueue: cq.Name}
+}
+
 type entryStatus string
 
 const (
@@ -390,6 +581,9 @@ type entry struct {
 	requeueReason        qcache.RequeueReason
 	preemptionTargets    []*preemption.Target
 	clusterQueueSnapshot *schdcache.ClusterQueueSnapshot
+	// reservationHolder is true if the workload is owned by the holder of an
+	// active reservation of its ClusterQueue.
+	reservationHolder bool
 }
 
 func (e *entry) assignmentUsage() workload.Usage {
@@ -417,7 +611,7 @@ func (s *Scheduler) nominate(ctx context.Context, workloads []workload.Info, sna
 			e.inadmissibleMsg = fmt.Sprintf("ClusterQueue %s is inactive", w.ClusterQueue)
 		} else if e.clusterQueueSnapshot == nil {
 			e.inadmissibleMsg = fmt.Sprintf("ClusterQueue %s not found", w.ClusterQueue)
-		} else if err := s.client.Get(ctx, types.NamespacedName{Name: w.Obj.Namespace}, &ns); err != nil {
+		} else if err := s.client.Get(ctx, types.NamespacedName{Name: utilqueue.NamespaceForWorkload(w.Obj)}, &ns); err != nil {
 			e.inadmissibleMsg = fmt.Sprintf("Could not obtain workload namespace: %v", err)
 		} else if !e.clusterQueueSnapshot.NamespaceSelector.Matches(labels.Set(ns.Labels)) {
 			e.inadmissibleMsg = "Workload namespace doesn't match ClusterQueue selector"
@@ -430,6 +624,9 @@ func (s *Scheduler) nominate(ctx context.Context, workloads []workload.Info, sna
 			e.assignment, e.preemptionTargets = s.getAssignments(log, &e.Info, snap)
 			e.inadmissibleMsg = e.assignment.Message()
 			e.LastAssignment = &e.assignment.LastState
+			if features.Enabled(features.AdvanceReservations) {
+				e.reservationHolder = e.clusterQueueSnapshot.HoldsActiveReservation(w.Obj, s.clock.Now())
+			}
 			entries = append(entries, e)
 			continue
 		}
@@ -448,6 +645,26 @@ func fits(cq *schdcache.ClusterQueueSnapshot, usage *workload.Usage, preemptedWo
 	return cq.Fits(*usage)
 }
 
+// targetsUsage returns the usage released by the preemption targets.
+func targetsUsage(targets []*preemption.Target) resources.FlavorResourceQuantities {
+	usage := make(resources.FlavorResourceQuantities)
+	for _, t := range targets {
+		for fr, q := range t.WorkloadInfo.FlavorResourceUsage() {
+			usage[fr] += q
+		}
+	}
+	return usage
+}
+
+// formatFlavorResources formats the flavor resources for the messages of the events and conditions.
+func formatFlavorResources(frs []resources.FlavorResource) string {
+	parts := make([]string, 0, len(frs))
+	for _, fr := range frs {
+		parts = append(parts, fmt.Sprintf("%s in flavor %s", fr.Resource, fr.Flavor))
+	}
+	return strings.Join(parts, ", ")
+}
+
 // resourcesToReserve calculates how much of the available resources in cq/cohort assignment should be reserved.
 func resourcesToReserve(e *entry, cq *schdcache.ClusterQueueSnapshot) workload.Usage {
 	return netUsage(e, quotaResourcesToReserve(e, cq))
@@ -614,6 +831,12 @@ func (s *Scheduler) admit(ctx context.Context, e *entry, cq *schdcache.ClusterQu
 		// sync Admitted, ignore the result since an API update is always done.
 		_ = workload.SyncAdmittedCondition(newWorkload, s.clock.Now())
 	}
+	if features.Enabled(features.WorkloadUnschedulableReasons) {
+		_ = workload.SyncAdmissionCheckUnschedulableReasons(newWorkload)
+	}
+	if features.Enabled(features.AdmissionChecksSummary) {
+		_ = workload.SyncAdmissionChecksSummary(newWorkload)
+	}
 	if err := s.cache.AssumeWorkload(log, newWorkload); err != nil {
 		return err
 	}
@@ -691,6 +914,12 @@ func (e entryOrdering) Less(i, j int) bool {
 		return aHasQuota
 	}
 
+	// Then the workloads of the holders of active reservations, so the
+	// workloads of other users don't take the reserved capacity first.
+	if a.reservationHolder != b.reservationHolder {
+		return a.reservationHolder
+	}
+
 	// 1. Request under nominal quota.
 	aBorrows := a.assignment.Borrows()
 	bBorrows := b.assignment.Borrows()
@@ -771,6 +1000,9 @@ func (s *Scheduler) requeueAndUpdate(ctx context.Context, e entry) {
 
 	added := s.queues.RequeueWorkload(ctx, &e.Info, e.requeueReason)
 	log.V(2).Info("Workload re-queued", "workload", klog.KObj(e.Obj), "clusterQueue", klog.KRef("", string(e.ClusterQueue)), "queue", klog.KRef(e.Obj.Namespace, string(e.Obj.Spec.QueueName)), "requeueReason", e.requeueReason, "added", added, "status", e.status)
+	if features.Enabled(features.StrictFIFOHeadOfLineTimeout) && s.queues.IsParked(e.Obj) {
+		e.inadmissibleMsg += ". The workload is parked, after blocking the head of the ClusterQueue for longer than headOfLineTimeoutSeconds"
+	}
 	if e.status == notNominated || e.status == skipped {
 		wl := e.Obj.DeepCopy()
 		if err := workload.PatchAdmissionStatus(ctx, s.client, wl, s.clock, func() (*kueue.Workload, bool, error) {
@@ -778,6 +1010,13 @@ func (s *Scheduler) requeueAndUpdate(ctx context.Context, e entry) {
 			if workload.PropagateResourceRequests(wl, &e.Info) {
 				updated = true
 			}
+			if features.Enabled(features.WorkloadUnschedulableReasons) {
+				reasons := e.assignment.UnschedulableReasons()
+				if !equality.Semantic.DeepEqual(reasons, wl.Status.UnschedulableReasons) {
+					wl.Status.UnschedulableReasons = reasons
+					updated = true
+				}
+			}
 			return wl, updated, nil
 		}); err != nil {
 			log.Error(err, "Could not update Workload status")
@@ -803,6 +1042,9 @@ func (s *Scheduler) recordQuotaReservationMetrics(newWorkload, originalWorkload
 	s.recorder.Eventf(newWorkload, corev1.EventTypeNormal, "QuotaReserved", "Quota reserved in ClusterQueue %v, wait time since queued was %.0fs", admission.ClusterQueue, waitTime.Seconds())
 
 	metrics.QuotaReservedWorkload(admission.ClusterQueue, newWorkload.Spec.PriorityClassName, waitTime)
+	if sinceCreation, ok := workload.WaitTimeSinceCreation(newWorkload, s.clock); ok {
+		metrics.ReportTimeToQuotaReservation(admission.ClusterQueue, metrics.WorkloadPriorityClassLabel(newWorkload), sinceCreation)
+	}
 	if features.Enabled(features.LocalQueueMetrics) {
 		metrics.LocalQueueQuotaReservedWorkload(metrics.LQRefFromWorkload(newWorkload), newWorkload.Spec.PriorityClassName, waitTime)
 	}
@@ -816,6 +1058,9 @@ func (s *Scheduler) recordWorkloadAdmissionEvents(newWorkload, originalWorkload
 
 	s.recorder.Eventf(newWorkload, corev1.EventTypeNormal, "Admitted", "Admitted by ClusterQueue %v, wait time since reservation was 0s", admission.ClusterQueue)
 	metrics.AdmittedWorkload(admission.ClusterQueue, newWorkload.Spec.PriorityClassName, waitTime)
+	if sinceCreation, ok := workload.WaitTimeSinceCreation(newWorkload, s.clock); ok {
+		metrics.ReportTimeToAdmission(admission.ClusterQueue, metrics.WorkloadPriorityClassLabel(newWorkload), sinceCreation)
+	}
 
 	if features.Enabled(features.LocalQueueMetrics) {
 		metrics.LocalQueueAdmittedWorkload(
@@ -824,6 +1069,9 @@ func (s *Scheduler) recordWorkloadAdmissionEvents(newWorkload, originalWorkload
 			waitTime,
 		)
 	}
+	if features.Enabled(features.NamespaceMetrics) {
+		metrics.NamespaceAdmittedWorkload(newWorkload.Namespace, waitTime)
+	}
 
 	if len(newWorkload.Status.AdmissionChecks) > 0 {
 		metrics.ReportAdmissionChecksWaitTime(admission.ClusterQueue, newWorkload.Spec.PriorityClassName, 0)
@@ -0,0 +1,353 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+const (
+	instrumentationName = "sigs.k8s.io/kueue"
+
+	// WorkloadSpanName is the name of the span covering the whole lifecycle
+	// of a workload, from its creation until it finishes or is deleted.
+	WorkloadSpanName = "Workload"
+	// QueuedSpanName is the name of the spans of a workload waiting for quota.
+	QueuedSpanName = "Queued"
+	// AdmissionChecksSpanName is the name of the spans of a workload with
+	// quota reserved, waiting for its admission checks to be ready.
+	AdmissionChecksSpanName = "AdmissionChecks"
+	// StartingSpanName is the name of the spans of an admitted workload
+	// waiting for its pods to be ready.
+	StartingSpanName = "Starting"
+	// RunningSpanName is the name of the spans of an admitted workload
+	// whose pods are ready.
+	RunningSpanName = "Running"
+)
+
+var propagator = propagation.TraceContext{}
+
+// Tracer emits the spans of the admission lifecycle of the workloads when
+// notified of their updates.
+//
+// All the spans of a workload belong to the trace derived from the UID of the
+// workload, or to the trace of the TraceParentAnnotation when the workload was
+// dispatched by a MultiKueue manager cluster. Each span of the lifecycle is
+// emitted when it ends, as a child of the span of the workload, which is
+// emitted when the workload finishes.
+type Tracer struct {
+	provider trace.TracerProvider
+	tracer   trace.Tracer
+	sampler  sdktrace.Sampler
+}
+
+// New creates a Tracer exporting the spans to the OTLP collector of the
+// configuration.
+func New(ctx context.Context, cfg *tracingapi.TracingConfiguration) (*Tracer, error) {
+	var opts []otlptracegrpc.Option
+	if cfg.Endpoint != nil {
+		opts = append(opts, otlptracegrpc.WithEndpoint(*cfg.Endpoint))
+	}
+	opts = append(opts, otlptracegrpc.WithInsecure())
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.New(ctx, resource.WithAttributes(semconv.ServiceName("kueue")))
+	if err != nil {
+		return nil, err
+	}
+
+	sampler := newSampler(cfg)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithIDGenerator(idGenerator{}),
+	)
+	return newTracer(provider, sampler), nil
+}
+
+// newSampler returns the sampler of the traces of the workloads, which never
+// samples unless the configuration sets a sampling rate.
+func newSampler(cfg *tracingapi.TracingConfiguration) sdktrace.Sampler {
+	if rate := ptr.Deref(cfg.SamplingRatePerMillion, 0); rate > 0 {
+		return sdktrace.TraceIDRatioBased(float64(rate) / 1000000)
+	}
+	return sdktrace.NeverSample()
+}
+
+func newTracer(provider trace.TracerProvider, sampler sdktrace.Sampler) *Tracer {
+	return &Tracer{
+		provider: provider,
+		tracer:   provider.Tracer(instrumentationName),
+		sampler:  sampler,
+	}
+}
+
+// Start implements manager.Runnable. It flushes the pending spans when the
+// manager stops.
+func (t *Tracer) Start(ctx context.Context) error {
+	<-ctx.Done()
+	if provider, ok := t.provider.(interface{ Shutdown(context.Context) error }); ok {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return provider.Shutdown(shutdownCtx)
+	}
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (t *Tracer) NeedLeaderElection() bool {
+	return false
+}
+
+// NotifyWorkloadUpdate implements the WorkloadUpdateWatcher of the workload
+// controller.
+func (t *Tracer) NotifyWorkloadUpdate(oldWl, newWl *kueue.Workload) {
+	if oldWl == nil {
+		// The workload was just created, or observed for the first time
+		// after a restart. Its spans are emitted when its phases end.
+		return
+	}
+	oldPhase, oldOk := currentPhase(oldWl)
+	if !oldOk {
+		return
+	}
+	ctx := t.workloadContext(oldWl)
+	attrs := workloadAttributes(oldWl)
+
+	if newWl == nil {
+		end := time.Now()
+		t.emit(ctx, oldPhase.name, oldPhase.start, end, attrs)
+		t.emitWorkload(oldWl, end, codes.Unset, "deleted")
+		return
+	}
+
+	newPhase, newOk := currentPhase(newWl)
+	if newOk && newPhase == oldPhase {
+		return
+	}
+	end := newPhase.start
+	if !newOk {
+		if c := apimeta.FindStatusCondition(newWl.Status.Conditions, kueue.WorkloadFinished); c != nil {
+			end = c.LastTransitionTime.Time
+		} else {
+			end = time.Now()
+		}
+	}
+	if newOk && newPhase.name == QueuedSpanName {
+		if c := apimeta.FindStatusCondition(newWl.Status.Conditions, kueue.WorkloadEvicted); c != nil && c.Status == metav1.ConditionTrue {
+			attrs = append(attrs, attribute.String("kueue.eviction.reason", c.Reason))
+		}
+	}
+	t.emit(ctx, oldPhase.name, oldPhase.start, end, attrs)
+
+	if !newOk {
+		status := codes.Ok
+		var reason string
+		if c := apimeta.FindStatusCondition(newWl.Status.Conditions, kueue.WorkloadFinished); c != nil {
+			reason = c.Reason
+			if c.Reason == kueue.WorkloadFinishedReasonFailed {
+				status = codes.Error
+			}
+		}
+		t.emitWorkload(newWl, end, status, reason)
+	}
+}
+
+func (t *Tracer) emit(ctx context.Context, name string, start, end time.Time, attrs []attribute.KeyValue) {
+	_, span := t.tracer.Start(ctx, name, trace.WithTimestamp(start), trace.WithAttributes(attrs...))
+	span.End(trace.WithTimestamp(end))
+}
+
+func (t *Tracer) emitWorkload(wl *kueue.Workload, end time.Time, status codes.Code, reason string) {
+	sc, parent := workloadSpanContext(wl, t.sampler)
+	ctx := context.Background()
+	if parent.IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, parent)
+	}
+	ctx = context.WithValue(ctx, spanIDsKey{}, sc)
+	attrs := workloadAttributes(wl)
+	if reason != "" {
+		attrs = append(attrs, attribute.String("kueue.finished.reason", reason))
+	}
+	_, span := t.tracer.Start(ctx, WorkloadSpanName,
+		trace.WithTimestamp(wl.CreationTimestamp.Time),
+		trace.WithAttributes(attrs...),
+	)
+	span.SetStatus(status, reason)
+	span.End(trace.WithTimestamp(end))
+}
+
+// workloadContext returns the context of the spans of the phases of the
+// workload, whose parent is the span of the workload.
+func (t *Tracer) workloadContext(wl *kueue.Workload) context.Context {
+	sc, _ := workloadSpanContext(wl, t.sampler)
+	return trace.ContextWithRemoteSpanContext(context.Background(), sc)
+}
+
+// workloadSpanContext returns the span context of the span of the workload,
+// and the span context of its parent, when the workload was dispatched by a
+// MultiKueue manager cluster.
+func workloadSpanContext(wl *kueue.Workload, sampler sdktrace.Sampler) (trace.SpanContext, trace.SpanContext) {
+	sum := sha256.Sum256([]byte(wl.UID))
+	var spanID trace.SpanID
+	copy(spanID[:], sum[16:24])
+
+	if parent := traceParent(wl); parent.IsValid() {
+		return trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    parent.TraceID(),
+			SpanID:     spanID,
+			TraceFlags: parent.TraceFlags(),
+			Remote:     true,
+		}), parent
+	}
+
+	var traceID trace.TraceID
+	copy(traceID[:], sum[:16])
+	var flags trace.TraceFlags
+	result := sampler.ShouldSample(sdktrace.SamplingParameters{TraceID: traceID, Name: WorkloadSpanName})
+	if result.Decision == sdktrace.RecordAndSample {
+		flags = trace.FlagsSampled
+	}
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: flags,
+		Remote:     true,
+	}), trace.SpanContext{}
+}
+
+// InjectTraceParent sets the TraceParentAnnotation of the workload dispatched
+// to a worker cluster to the span of the original workload, so that the spans
+// of the admission in the worker cluster are children of it.
+func InjectTraceParent(cfg *tracingapi.TracingConfiguration, orig, remote *kueue.Workload) {
+	sc, _ := workloadSpanContext(orig, newSampler(cfg))
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(trace.ContextWithRemoteSpanContext(context.Background(), sc), carrier)
+	if value := carrier.Get("traceparent"); value != "" {
+		if remote.Annotations == nil {
+			remote.Annotations = make(map[string]string, 1)
+		}
+		remote.Annotations[constants.TraceParentAnnotation] = value
+	}
+}
+
+func traceParent(wl *kueue.Workload) trace.SpanContext {
+	value, ok := wl.Annotations[constants.TraceParentAnnotation]
+	if !ok {
+		return trace.SpanContext{}
+	}
+	ctx := propagator.Extract(context.Background(), propagation.MapCarrier{"traceparent": value})
+	return trace.SpanContextFromContext(ctx)
+}
+
+type phase struct {
+	name  string
+	start time.Time
+}
+
+// currentPhase returns the phase of the admission lifecycle the workload is
+// in, and when it started. It returns false for the finished workloads.
+func currentPhase(wl *kueue.Workload) (phase, bool) {
+	if workload.IsFinished(wl) {
+		return phase{}, false
+	}
+	if workload.IsAdmitted(wl) {
+		admitted := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadAdmitted)
+		if c := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadPodsReady); c != nil && c.Status == metav1.ConditionTrue {
+			return phase{name: RunningSpanName, start: c.LastTransitionTime.Time}, true
+		}
+		return phase{name: StartingSpanName, start: admitted.LastTransitionTime.Time}, true
+	}
+	c := apimeta.FindStatusCondition(wl.Status.Conditions, kueue.WorkloadQuotaReserved)
+	if c != nil && c.Status == metav1.ConditionTrue {
+		return phase{name: AdmissionChecksSpanName, start: c.LastTransitionTime.Time}, true
+	}
+	// The workload waits for quota since its creation, or since it lost its
+	// quota reservation.
+	start := wl.CreationTimestamp.Time
+	if c != nil {
+		start = c.LastTransitionTime.Time
+	}
+	return phase{name: QueuedSpanName, start: start}, true
+}
+
+func workloadAttributes(wl *kueue.Workload) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{
+		attribute.String("k8s.namespace.name", wl.Namespace),
+		attribute.String("kueue.workload.name", wl.Name),
+		attribute.String("kueue.local_queue", string(wl.Spec.QueueName)),
+		attribute.Int("kueue.workload.priority", int(priority.Priority(wl))),
+	}
+	if wl.Status.Admission != nil {
+		attrs = append(attrs, attribute.String("kueue.cluster_queue", string(wl.Status.Admission.ClusterQueue)))
+	}
+	return attrs
+}
+
+type spanIDsKey struct{}
+
+// idGenerator generates the IDs of the span of a workload from the span
+// context in the context, and random IDs otherwise.
+type idGenerator struct{}
+
+var _ sdktrace.IDGenerator = idGenerator{}
+
+func (idGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	if sc, ok := ctx.Value(spanIDsKey{}).(trace.SpanContext); ok {
+		return sc.TraceID(), sc.SpanID()
+	}
+	var traceID trace.TraceID
+	_, _ = rand.Read(traceID[:])
+	return traceID, randomSpanID()
+}
+
+func (idGenerator) NewSpanID(ctx context.Context, _ trace.TraceID) trace.SpanID {
+	if sc, ok := ctx.Value(spanIDsKey{}).(trace.SpanContext); ok {
+		return sc.SpanID()
+	}
+	return randomSpanID()
+}
+
+func randomSpanID() trace.SpanID {
+	var spanID trace.SpanID
+	_, _ = rand.Read(spanID[:])
+	return spanID
+}
@@ -0,0 +1,194 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	tracingapi "k8s.io/component-base/tracing/api/v1"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+type recordedSpan struct {
+	Name          string
+	Start         time.Time
+	End           time.Time
+	ChildOfRoot   bool
+	Status        codes.Code
+	EvictedReason string
+}
+
+func newTestTracer() (*Tracer, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	sampler := sdktrace.AlwaysSample()
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+		sdktrace.WithSpanProcessor(recorder),
+		sdktrace.WithIDGenerator(idGenerator{}),
+	)
+	return newTracer(provider, sampler), recorder
+}
+
+func recordedSpans(t *testing.T, recorder *tracetest.SpanRecorder, wl *kueue.Workload) []recordedSpan {
+	t.Helper()
+	root, _ := workloadSpanContext(wl, sdktrace.AlwaysSample())
+	var result []recordedSpan
+	for _, span := range recorder.Ended() {
+		if span.SpanContext().TraceID() != root.TraceID() {
+			t.Errorf("Span %q has trace ID %s, want %s", span.Name(), span.SpanContext().TraceID(), root.TraceID())
+		}
+		rs := recordedSpan{
+			Name:        span.Name(),
+			Start:       span.StartTime(),
+			End:         span.EndTime(),
+			ChildOfRoot: span.Parent().SpanID() == root.SpanID(),
+			Status:      span.Status().Code,
+		}
+		if span.Name() == WorkloadSpanName && span.SpanContext().SpanID() != root.SpanID() {
+			t.Errorf("Workload span has span ID %s, want %s", span.SpanContext().SpanID(), root.SpanID())
+		}
+		for _, attr := range span.Attributes() {
+			if attr.Key == attribute.Key("kueue.eviction.reason") {
+				rs.EvictedReason = attr.Value.AsString()
+			}
+		}
+		result = append(result, rs)
+	}
+	return result
+}
+
+func TestNotifyWorkloadUpdate(t *testing.T) {
+	created := time.Now().Truncate(time.Second)
+	quotaReserved := created.Add(time.Minute)
+	admitted := quotaReserved.Add(time.Second)
+	podsReady := admitted.Add(10 * time.Second)
+	finished := podsReady.Add(time.Hour)
+
+	condition := func(conditionType string, status metav1.ConditionStatus, reason string, at time.Time) metav1.Condition {
+		return metav1.Condition{
+			Type:               conditionType,
+			Status:             status,
+			Reason:             reason,
+			LastTransitionTime: metav1.NewTime(at),
+		}
+	}
+	baseWorkload := utiltesting.MakeWorkload("wl", "ns").
+		UID("wl-uid").
+		Queue("lq").
+		Creation(created)
+	pending := baseWorkload.Clone().Obj()
+	withQuota := baseWorkload.Clone().
+		Condition(condition(kueue.WorkloadQuotaReserved, metav1.ConditionTrue, "QuotaReserved", quotaReserved)).
+		Obj()
+	withAdmission := baseWorkload.Clone().
+		Condition(condition(kueue.WorkloadQuotaReserved, metav1.ConditionTrue, "QuotaReserved", quotaReserved)).
+		Condition(condition(kueue.WorkloadAdmitted, metav1.ConditionTrue, "Admitted", admitted)).
+		Obj()
+	running := baseWorkload.Clone().
+		Condition(condition(kueue.WorkloadQuotaReserved, metav1.ConditionTrue, "QuotaReserved", quotaReserved)).
+		Condition(condition(kueue.WorkloadAdmitted, metav1.ConditionTrue, "Admitted", admitted)).
+		Condition(condition(kueue.WorkloadPodsReady, metav1.ConditionTrue, "PodsReady", podsReady)).
+		Obj()
+	evicted := baseWorkload.Clone().
+		Condition(condition(kueue.WorkloadQuotaReserved, metav1.ConditionFalse, "Pending", finished)).
+		Condition(condition(kueue.WorkloadAdmitted, metav1.ConditionFalse, "NoReservation", finished)).
+		Condition(condition(kueue.WorkloadEvicted, metav1.ConditionTrue, kueue.WorkloadEvictedByPreemption, finished)).
+		Obj()
+	succeeded := baseWorkload.Clone().
+		Condition(condition(kueue.WorkloadQuotaReserved, metav1.ConditionTrue, "QuotaReserved", quotaReserved)).
+		Condition(condition(kueue.WorkloadAdmitted, metav1.ConditionTrue, "Admitted", admitted)).
+		Condition(condition(kueue.WorkloadPodsReady, metav1.ConditionTrue, "PodsReady", podsReady)).
+		Condition(condition(kueue.WorkloadFinished, metav1.ConditionTrue, kueue.WorkloadFinishedReasonSucceeded, finished)).
+		Obj()
+
+	cases := map[string]struct {
+		updates   [][2]*kueue.Workload
+		wantSpans []recordedSpan
+	}{
+		"workload created": {
+			updates: [][2]*kueue.Workload{{nil, pending}},
+		},
+		"workload admitted and finished": {
+			updates: [][2]*kueue.Workload{
+				{pending, withQuota},
+				{withQuota, withAdmission},
+				{withAdmission, withAdmission},
+				{withAdmission, running},
+				{running, succeeded},
+				{succeeded, nil},
+			},
+			wantSpans: []recordedSpan{
+				{Name: QueuedSpanName, Start: created, End: quotaReserved, ChildOfRoot: true},
+				{Name: AdmissionChecksSpanName, Start: quotaReserved, End: admitted, ChildOfRoot: true},
+				{Name: StartingSpanName, Start: admitted, End: podsReady, ChildOfRoot: true},
+				{Name: RunningSpanName, Start: podsReady, End: finished, ChildOfRoot: true},
+				{Name: WorkloadSpanName, Start: created, End: finished, Status: codes.Ok},
+			},
+		},
+		"workload evicted": {
+			updates: [][2]*kueue.Workload{
+				{running, evicted},
+			},
+			wantSpans: []recordedSpan{
+				{Name: RunningSpanName, Start: podsReady, End: finished, ChildOfRoot: true, EvictedReason: kueue.WorkloadEvictedByPreemption},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tracer, recorder := newTestTracer()
+			for _, update := range tc.updates {
+				tracer.NotifyWorkloadUpdate(update[0], update[1])
+			}
+			if diff := cmp.Diff(tc.wantSpans, recordedSpans(t, recorder, pending)); diff != "" {
+				t.Errorf("Unexpected spans (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInjectTraceParent(t *testing.T) {
+	orig := utiltesting.MakeWorkload("wl", "ns").UID("manager-uid").Obj()
+	remote := utiltesting.MakeWorkload("wl", "ns").UID("worker-uid").Obj()
+	InjectTraceParent(&tracingapi.TracingConfiguration{SamplingRatePerMillion: ptr.To[int32](1000000)}, orig, remote)
+
+	if _, ok := remote.Annotations[constants.TraceParentAnnotation]; !ok {
+		t.Fatalf("Missing the %s annotation", constants.TraceParentAnnotation)
+	}
+	origSpan, _ := workloadSpanContext(orig, sdktrace.AlwaysSample())
+	remoteSpan, parent := workloadSpanContext(remote, sdktrace.NeverSample())
+	if remoteSpan.TraceID() != origSpan.TraceID() {
+		t.Errorf("Unexpected trace ID of the remote workload %s, want %s", remoteSpan.TraceID(), origSpan.TraceID())
+	}
+	if parent.SpanID() != origSpan.SpanID() {
+		t.Errorf("Unexpected parent span ID of the remote workload %s, want %s", parent.SpanID(), origSpan.SpanID())
+	}
+	if !remoteSpan.IsSampled() {
+		t.Error("The remote workload span is not sampled as the span of the original workload")
+	}
+}
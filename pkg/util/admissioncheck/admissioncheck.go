@@ -30,6 +30,7 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
 )
 
 var (
@@ -152,6 +153,19 @@ func FilterForController(ctx context.Context, c client.Client, states []kueue.Ad
 	return retActive, nil
 }
 
+// ContinuousEvaluation returns the continuous evaluation configured for the
+// admission check, or nil if the check is evaluated only before the admission.
+func ContinuousEvaluation(ctx context.Context, c client.Client, checkName kueue.AdmissionCheckReference) (*kueue.ContinuousAdmissionCheck, error) {
+	if !features.Enabled(features.ContinuousAdmissionChecks) {
+		return nil, nil
+	}
+	ac := &kueue.AdmissionCheck{}
+	if err := c.Get(ctx, types.NamespacedName{Name: string(checkName)}, ac); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return ac.Spec.Continuous, nil
+}
+
 // FilterProvReqAnnotations returns annotations containing the Provisioning Request annotation prefix.
 func FilterProvReqAnnotations(annotations map[string]string) map[string]string {
 	res := make(map[string]string)
@@ -180,6 +194,44 @@ func NewAdmissionChecks(cq *kueue.ClusterQueue) map[kueue.AdmissionCheckReferenc
 	return checks
 }
 
+// NewAdmissionCheckDependencies returns the AdmissionChecks each AdmissionCheck of
+// .spec.AdmissionChecksStrategy depends on.
+func NewAdmissionCheckDependencies(cq *kueue.ClusterQueue) map[kueue.AdmissionCheckReference][]kueue.AdmissionCheckReference {
+	if cq.Spec.AdmissionChecksStrategy == nil {
+		return nil
+	}
+	var dependencies map[kueue.AdmissionCheckReference][]kueue.AdmissionCheckReference
+	for _, check := range cq.Spec.AdmissionChecksStrategy.AdmissionChecks {
+		if len(check.DependsOn) == 0 {
+			continue
+		}
+		if dependencies == nil {
+			dependencies = make(map[kueue.AdmissionCheckReference][]kueue.AdmissionCheckReference)
+		}
+		dependencies[check.Name] = check.DependsOn
+	}
+	return dependencies
+}
+
+// NewAdmissionCheckPodSets returns the PodSets each AdmissionCheck of
+// .spec.AdmissionChecksStrategy runs for.
+func NewAdmissionCheckPodSets(cq *kueue.ClusterQueue) map[kueue.AdmissionCheckReference]sets.Set[kueue.PodSetReference] {
+	if cq.Spec.AdmissionChecksStrategy == nil {
+		return nil
+	}
+	var podSets map[kueue.AdmissionCheckReference]sets.Set[kueue.PodSetReference]
+	for _, check := range cq.Spec.AdmissionChecksStrategy.AdmissionChecks {
+		if len(check.OnPodSets) == 0 {
+			continue
+		}
+		if podSets == nil {
+			podSets = make(map[kueue.AdmissionCheckReference]sets.Set[kueue.PodSetReference])
+		}
+		podSets[check.Name] = sets.New(check.OnPodSets...)
+	}
+	return podSets
+}
+
 // FindAdmissionCheck - returns a pointer to the check identified by checkName if found in checks.
 func FindAdmissionCheck(checks []kueue.AdmissionCheckState, checkName kueue.AdmissionCheckReference) *kueue.AdmissionCheckState {
 	for i := range checks {
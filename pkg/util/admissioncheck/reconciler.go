@@ -0,0 +1,112 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admissioncheck
+
+import (
+	"context"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+// ConfigReconciler maintains the Active condition of the admission checks of
+// a controller, depending on whether their parameters reference a valid
+// configuration of the helper's configuration type.
+// Without a helper, the admission checks of the controller don't take
+// parameters and are always active.
+type ConfigReconciler[PtrT objAsPtr[T], T any] struct {
+	client         client.Client
+	controllerName string
+	helper         *ConfigHelper[PtrT, T]
+}
+
+var _ reconcile.Reconciler = (*ConfigReconciler[*kueue.MultiKueueConfig, kueue.MultiKueueConfig])(nil)
+
+func NewConfigReconciler[PtrT objAsPtr[T], T any](c client.Client, controllerName string, helper *ConfigHelper[PtrT, T]) *ConfigReconciler[PtrT, T] {
+	return &ConfigReconciler[PtrT, T]{
+		client:         c,
+		controllerName: controllerName,
+		helper:         helper,
+	}
+}
+
+func (r *ConfigReconciler[PtrT, T]) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ac := &kueue.AdmissionCheck{}
+	if err := r.client.Get(ctx, req.NamespacedName, ac); err != nil || ac.Spec.ControllerName != r.controllerName {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	currentCondition := ptr.Deref(apimeta.FindStatusCondition(ac.Status.Conditions, kueue.AdmissionCheckActive), metav1.Condition{})
+	newCondition := metav1.Condition{
+		Type:               kueue.AdmissionCheckActive,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Active",
+		Message:            "The admission check is active",
+		ObservedGeneration: ac.Generation,
+	}
+
+	if r.helper != nil {
+		if _, err := r.helper.ConfigFromRef(ctx, ac.Spec.Parameters); err != nil {
+			newCondition.Status = metav1.ConditionFalse
+			newCondition.Reason = "BadParametersRef"
+			newCondition.Message = err.Error()
+		}
+	}
+
+	if currentCondition.Status != newCondition.Status {
+		apimeta.SetStatusCondition(&ac.Status.Conditions, newCondition)
+		return reconcile.Result{}, client.IgnoreNotFound(r.client.Status().Update(ctx, ac))
+	}
+	return reconcile.Result{}, nil
+}
+
+// AdmissionChecksForConfig returns the admission checks of the controller
+// referencing the configuration.
+func (r *ConfigReconciler[PtrT, T]) AdmissionChecksForConfig(ctx context.Context, obj client.Object) []reconcile.Request {
+	checks := &kueue.AdmissionCheckList{}
+	if err := r.client.List(ctx, checks); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list the admission checks")
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, ac := range checks.Items {
+		if ac.Spec.ControllerName != r.controllerName || ac.Spec.Parameters == nil || ac.Spec.Parameters.Name != obj.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: ac.Name}})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the reconciler, named name, with the Manager.
+func (r *ConfigReconciler[PtrT, T]) SetupWithManager(mgr ctrl.Manager, name string) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&kueue.AdmissionCheck{})
+	if r.helper != nil {
+		b = b.Watches(r.helper.newConfigPtr(), handler.EnqueueRequestsFromMapFunc(r.AdmissionChecksForConfig))
+	}
+	return b.Complete(r)
+}
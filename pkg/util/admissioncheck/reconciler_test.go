@@ -0,0 +1,171 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admissioncheck
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+const testControllerName = "kueue.x-k8s.io/test-controller"
+
+func TestConfigReconciler(t *testing.T) {
+	cases := map[string]struct {
+		admissioncheck *kueue.AdmissionCheck
+		withoutHelper  bool
+		wantConditions []metav1.Condition
+	}{
+		"the admission checks don't take parameters": {
+			admissioncheck: utiltesting.MakeAdmissionCheck("ac").
+				ControllerName(testControllerName).
+				Obj(),
+			withoutHelper: true,
+			wantConditions: []metav1.Condition{{
+				Type:    kueue.AdmissionCheckActive,
+				Status:  metav1.ConditionTrue,
+				Reason:  "Active",
+				Message: "The admission check is active",
+			}},
+		},
+		"the configuration exists": {
+			admissioncheck: utiltesting.MakeAdmissionCheck("ac").
+				ControllerName(testControllerName).
+				Parameters(kueue.GroupVersion.Group, "ProvisioningRequestConfig", "config").
+				Obj(),
+			wantConditions: []metav1.Condition{{
+				Type:    kueue.AdmissionCheckActive,
+				Status:  metav1.ConditionTrue,
+				Reason:  "Active",
+				Message: "The admission check is active",
+			}},
+		},
+		"the configuration is missing": {
+			admissioncheck: utiltesting.MakeAdmissionCheck("ac").
+				ControllerName(testControllerName).
+				Parameters(kueue.GroupVersion.Group, "ProvisioningRequestConfig", "missing").
+				Active(metav1.ConditionTrue).
+				Obj(),
+			wantConditions: []metav1.Condition{{
+				Type:    kueue.AdmissionCheckActive,
+				Status:  metav1.ConditionFalse,
+				Reason:  "BadParametersRef",
+				Message: `provisioningrequestconfigs.kueue.x-k8s.io "missing" not found`,
+			}},
+		},
+		"the parameters reference the wrong kind": {
+			admissioncheck: utiltesting.MakeAdmissionCheck("ac").
+				ControllerName(testControllerName).
+				Parameters(kueue.GroupVersion.Group, "MultiKueueConfig", "config").
+				Obj(),
+			wantConditions: []metav1.Condition{{
+				Type:    kueue.AdmissionCheckActive,
+				Status:  metav1.ConditionFalse,
+				Reason:  "BadParametersRef",
+				Message: `wrong kind "MultiKueueConfig", expecting "ProvisioningRequestConfig": bad parameters reference`,
+			}},
+		},
+		"the admission check of another controller": {
+			admissioncheck: utiltesting.MakeAdmissionCheck("ac").
+				ControllerName("other-controller").
+				Parameters(kueue.GroupVersion.Group, "ProvisioningRequestConfig", "missing").
+				Obj(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := utiltesting.MakeProvisioningRequestConfig("config").ProvisioningClass("className").Obj()
+			client := utiltesting.NewClientBuilder().
+				WithObjects(tc.admissioncheck, config).
+				WithStatusSubresource(tc.admissioncheck).
+				Build()
+			ctx, _ := utiltesting.ContextWithLog(t)
+
+			helper, err := NewConfigHelper[*kueue.ProvisioningRequestConfig](client)
+			if err != nil {
+				t.Fatalf("cannot built the helper: %s", err)
+			}
+			if tc.withoutHelper {
+				helper = nil
+			}
+			reconciler := NewConfigReconciler(client, testControllerName, helper)
+
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: tc.admissioncheck.Name}}
+			if _, err := reconciler.Reconcile(ctx, req); err != nil {
+				t.Fatalf("unexpected reconcile error: %s", err)
+			}
+
+			gotAdmissionCheck := &kueue.AdmissionCheck{}
+			if err := client.Get(ctx, req.NamespacedName, gotAdmissionCheck); err != nil {
+				t.Fatalf("cannot get the admission check: %s", err)
+			}
+			wantConditions := tc.wantConditions
+			if wantConditions == nil {
+				wantConditions = tc.admissioncheck.Status.Conditions
+			}
+			if diff := cmp.Diff(wantConditions, gotAdmissionCheck.Status.Conditions, cmpopts.EquateEmpty(), cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "ObservedGeneration")); diff != "" {
+				t.Errorf("unexpected conditions (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAdmissionChecksForConfig(t *testing.T) {
+	checks := []*kueue.AdmissionCheck{
+		utiltesting.MakeAdmissionCheck("ac1").
+			ControllerName(testControllerName).
+			Parameters(kueue.GroupVersion.Group, "ProvisioningRequestConfig", "config").
+			Obj(),
+		utiltesting.MakeAdmissionCheck("ac2").
+			ControllerName(testControllerName).
+			Parameters(kueue.GroupVersion.Group, "ProvisioningRequestConfig", "other-config").
+			Obj(),
+		utiltesting.MakeAdmissionCheck("ac3").
+			ControllerName("other-controller").
+			Parameters(kueue.GroupVersion.Group, "ProvisioningRequestConfig", "config").
+			Obj(),
+		utiltesting.MakeAdmissionCheck("ac4").
+			ControllerName(testControllerName).
+			Obj(),
+	}
+	builder := utiltesting.NewClientBuilder()
+	for _, ac := range checks {
+		builder = builder.WithObjects(ac)
+	}
+	client := builder.Build()
+	ctx, _ := utiltesting.ContextWithLog(t)
+
+	helper, err := NewConfigHelper[*kueue.ProvisioningRequestConfig](client)
+	if err != nil {
+		t.Fatalf("cannot built the helper: %s", err)
+	}
+	reconciler := NewConfigReconciler(client, testControllerName, helper)
+
+	got := reconciler.AdmissionChecksForConfig(ctx, utiltesting.MakeProvisioningRequestConfig("config").Obj())
+	want := []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "ac1"}}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected requests (-want/+got):\n%s", diff)
+	}
+}
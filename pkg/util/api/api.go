@@ -18,6 +18,7 @@ package api
 
 import (
 	"maps"
+	"unicode/utf8"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -25,6 +26,10 @@ import (
 const (
 	maxEventMsgSize     = 1024
 	maxConditionMsgSize = 32 * 1024
+
+	// MaxUnschedulableReasonMsgSize is the maximum length of the message of
+	// the unschedulable reasons of the Workloads.
+	MaxUnschedulableReasonMsgSize = 1024
 )
 
 // TruncateEventMessage truncates a message if it hits the maxEventMessage.
@@ -36,13 +41,24 @@ func TruncateConditionMessage(message string) string {
 	return truncateMessage(message, maxConditionMsgSize)
 }
 
+// TruncateUnschedulableReasonMessage truncates a message if it hits the
+// MaxUnschedulableReasonMsgSize.
+func TruncateUnschedulableReasonMessage(message string) string {
+	return truncateMessage(message, MaxUnschedulableReasonMsgSize)
+}
+
 // truncateMessage truncates a message if it hits the NoteLengthLimit.
+// The message is cut on a rune boundary, so that it stays valid UTF-8.
 func truncateMessage(message string, limit int) string {
 	if len(message) <= limit {
 		return message
 	}
 	suffix := " ..."
-	return message[:limit-len(suffix)] + suffix
+	end := limit - len(suffix)
+	for end > 0 && !utf8.RuneStart(message[end]) {
+		end--
+	}
+	return message[:end] + suffix
 }
 
 // CloneObjectMetaForCreation creates a copy of the provided ObjectMeta containing
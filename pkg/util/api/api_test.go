@@ -0,0 +1,54 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncateUnschedulableReasonMessage(t *testing.T) {
+	cases := map[string]struct {
+		message string
+		want    string
+	}{
+		"short message": {
+			message: "insufficient quota",
+			want:    "insufficient quota",
+		},
+		"long message": {
+			message: strings.Repeat("a", 1100),
+			want:    strings.Repeat("a", 1020) + " ...",
+		},
+		"long message cut inside a rune": {
+			message: strings.Repeat("a", 1019) + strings.Repeat("é", 100),
+			want:    strings.Repeat("a", 1019) + " ...",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := TruncateUnschedulableReasonMessage(tc.message)
+			if got != tc.want {
+				t.Errorf("Unexpected message, want %q, got %q", tc.want, got)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("The truncated message %q is not valid UTF-8", got)
+			}
+		})
+	}
+}
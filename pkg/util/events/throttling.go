@@ -0,0 +1,161 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+)
+
+type eventKey struct {
+	object    types.UID
+	eventType string
+	reason    string
+	message   string
+}
+
+// throttlingRecorder is an event recorder which drops the events identical to
+// an event already recorded in the current interval, and the events whose reason
+// exhausted its budget in the current interval.
+type throttlingRecorder struct {
+	recorder      record.EventRecorder
+	clock         clock.Clock
+	interval      time.Duration
+	defaultBudget int32
+	reasonBudgets map[string]int32
+	log           logr.Logger
+
+	mu           sync.Mutex
+	windowStart  time.Time
+	reasonCounts map[string]int32
+	recorded     sets.Set[eventKey]
+	dropped      map[string]int32
+}
+
+var _ record.EventRecorder = (*throttlingRecorder)(nil)
+
+// NewThrottlingRecorder returns an event recorder deduplicating and rate limiting
+// the events forwarded to the recorder, as set in the configuration.
+func NewThrottlingRecorder(recorder record.EventRecorder, cfg *config.EventThrottling, clock clock.Clock, log logr.Logger) record.EventRecorder {
+	return &throttlingRecorder{
+		recorder:      recorder,
+		clock:         clock,
+		interval:      ptr.Deref(cfg.Interval, metav1.Duration{Duration: config.DefaultEventThrottlingInterval}).Duration,
+		defaultBudget: ptr.Deref(cfg.DefaultBudget, config.DefaultEventThrottlingBudget),
+		reasonBudgets: cfg.ReasonBudgets,
+		log:           log,
+		windowStart:   clock.Now(),
+		reasonCounts:  make(map[string]int32),
+		recorded:      sets.New[eventKey](),
+		dropped:       make(map[string]int32),
+	}
+}
+
+func (r *throttlingRecorder) Event(object runtime.Object, eventType, reason, message string) {
+	if r.allow(object, eventType, reason, message) {
+		r.recorder.Event(object, eventType, reason, message)
+	}
+}
+
+func (r *throttlingRecorder) Eventf(object runtime.Object, eventType, reason, messageFmt string, args ...any) {
+	if r.allow(object, eventType, reason, fmt.Sprintf(messageFmt, args...)) {
+		r.recorder.Eventf(object, eventType, reason, messageFmt, args...)
+	}
+}
+
+func (r *throttlingRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventType, reason, messageFmt string, args ...any) {
+	if r.allow(object, eventType, reason, fmt.Sprintf(messageFmt, args...)) {
+		r.recorder.AnnotatedEventf(object, annotations, eventType, reason, messageFmt, args...)
+	}
+}
+
+// allow returns whether the event should be recorded, and accounts it in the
+// budget of its reason if so.
+func (r *throttlingRecorder) allow(object runtime.Object, eventType, reason, message string) bool {
+	key := eventKey{eventType: eventType, reason: reason, message: message}
+	if obj, err := apimeta.Accessor(object); err == nil {
+		key.object = obj.GetUID()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if now := r.clock.Now(); now.Sub(r.windowStart) >= r.interval {
+		if len(r.dropped) > 0 {
+			r.log.V(2).Info("Dropped throttled events", "interval", r.interval, "droppedPerReason", r.dropped)
+		}
+		r.windowStart = now
+		clear(r.reasonCounts)
+		clear(r.dropped)
+		r.recorded.Clear()
+	}
+	budget, found := r.reasonBudgets[reason]
+	if !found {
+		budget = r.defaultBudget
+	}
+	if r.recorded.Has(key) || r.reasonCounts[reason] >= budget {
+		r.dropped[reason]++
+		return false
+	}
+	r.reasonCounts[reason]++
+	r.recorded.Insert(key)
+	return true
+}
+
+type throttlingManager struct {
+	manager.Manager
+	cfg *config.EventThrottling
+
+	mu        sync.Mutex
+	recorders map[string]record.EventRecorder
+}
+
+// WithThrottledEvents returns the manager whose event recorders deduplicate and
+// rate limit the events recorded by the controllers, as set in the configuration.
+// The recorders with the same name share their budgets.
+func WithThrottledEvents(mgr manager.Manager, cfg *config.EventThrottling) manager.Manager {
+	return &throttlingManager{
+		Manager:   mgr,
+		cfg:       cfg,
+		recorders: make(map[string]record.EventRecorder),
+	}
+}
+
+func (m *throttlingManager) GetEventRecorderFor(name string) record.EventRecorder {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if recorder, found := m.recorders[name]; found {
+		return recorder
+	}
+	recorder := NewThrottlingRecorder(m.Manager.GetEventRecorderFor(name), m.cfg, clock.RealClock{}, ctrl.Log.WithName("event-throttling").WithValues("recorder", name))
+	m.recorders[name] = recorder
+	return recorder
+}
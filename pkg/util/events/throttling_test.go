@@ -0,0 +1,79 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	config "sigs.k8s.io/kueue/apis/config/v1beta1"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestThrottlingRecorder(t *testing.T) {
+	wlA := utiltesting.MakeWorkload("a", "ns").UID("a-uid").Obj()
+	wlB := utiltesting.MakeWorkload("b", "ns").UID("b-uid").Obj()
+	wlC := utiltesting.MakeWorkload("c", "ns").UID("c-uid").Obj()
+	keyA := types.NamespacedName{Namespace: "ns", Name: "a"}
+	keyB := types.NamespacedName{Namespace: "ns", Name: "b"}
+	keyC := types.NamespacedName{Namespace: "ns", Name: "c"}
+
+	now := time.Now()
+	fakeClock := clocktesting.NewFakeClock(now)
+	recorder := &utiltesting.EventRecorder{}
+	throttled := NewThrottlingRecorder(recorder, &config.EventThrottling{
+		Interval:      &metav1.Duration{Duration: time.Minute},
+		DefaultBudget: ptr.To[int32](2),
+		ReasonBudgets: map[string]int32{"Admitted": 3, "Noisy": 0},
+	}, fakeClock, utiltesting.NewLogger(t))
+
+	// Identical events on the same object are deduplicated.
+	throttled.Eventf(wlA, corev1.EventTypeWarning, "Pending", "couldn't assume workload: %s", "conflict")
+	throttled.Event(wlA, corev1.EventTypeWarning, "Pending", "couldn't assume workload: conflict")
+	// The events only differing by their message or object are recorded, up to the budget of their reason.
+	throttled.Event(wlA, corev1.EventTypeWarning, "Pending", "insufficient quota")
+	throttled.Event(wlB, corev1.EventTypeWarning, "Pending", "couldn't assume workload: conflict")
+	// The reasons with their own budget are accounted separately.
+	throttled.Event(wlA, corev1.EventTypeNormal, "Admitted", "Admitted by ClusterQueue cq")
+	throttled.Event(wlB, corev1.EventTypeNormal, "Admitted", "Admitted by ClusterQueue cq")
+	throttled.Event(wlC, corev1.EventTypeNormal, "Admitted", "Admitted by ClusterQueue cq")
+	throttled.Event(wlC, corev1.EventTypeNormal, "Noisy", "Never recorded")
+	// The budgets and the recorded events are reset after the interval.
+	fakeClock.Step(time.Minute)
+	throttled.Event(wlA, corev1.EventTypeWarning, "Pending", "couldn't assume workload: conflict")
+	throttled.Event(wlC, corev1.EventTypeWarning, "Pending", "couldn't assume workload: conflict")
+
+	want := []utiltesting.EventRecord{
+		{Key: keyA, EventType: corev1.EventTypeWarning, Reason: "Pending", Message: "couldn't assume workload: conflict"},
+		{Key: keyA, EventType: corev1.EventTypeWarning, Reason: "Pending", Message: "insufficient quota"},
+		{Key: keyA, EventType: corev1.EventTypeNormal, Reason: "Admitted", Message: "Admitted by ClusterQueue cq"},
+		{Key: keyB, EventType: corev1.EventTypeNormal, Reason: "Admitted", Message: "Admitted by ClusterQueue cq"},
+		{Key: keyC, EventType: corev1.EventTypeNormal, Reason: "Admitted", Message: "Admitted by ClusterQueue cq"},
+		{Key: keyA, EventType: corev1.EventTypeWarning, Reason: "Pending", Message: "couldn't assume workload: conflict"},
+		{Key: keyC, EventType: corev1.EventTypeWarning, Reason: "Pending", Message: "couldn't assume workload: conflict"},
+	}
+	if diff := cmp.Diff(want, recorder.RecordedEvents); diff != "" {
+		t.Errorf("Unexpected recorded events (-want,+got):\n%s", diff)
+	}
+}
@@ -18,8 +18,11 @@ import (
 	"fmt"
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
 )
 
 // LocalQueueReference is the full reference to LocalQueue formed as <namespace>/< kueue.LocalQueueName >.
@@ -51,7 +54,22 @@ func Key(q *kueue.LocalQueue) LocalQueueReference {
 }
 
 func KeyFromWorkload(w *kueue.Workload) LocalQueueReference {
-	return NewLocalQueueReference(w.Namespace, w.Spec.QueueName)
+	return NewLocalQueueReference(NamespaceForWorkload(w), w.Spec.QueueName)
+}
+
+// NamespaceForWorkload returns the namespace of the LocalQueue of the workload,
+// which is its billing namespace, when set, or the namespace of the workload.
+func NamespaceForWorkload(w *kueue.Workload) string {
+	return NamespaceForObject(w)
+}
+
+// NamespaceForObject returns the namespace of the LocalQueue of the job or the
+// workload, which is its billing namespace, when set, or its own namespace.
+func NamespaceForObject(obj metav1.Object) string {
+	if ns := obj.GetAnnotations()[constants.BillingNamespaceAnnotation]; ns != "" && features.Enabled(features.CrossNamespaceBilling) {
+		return ns
+	}
+	return obj.GetNamespace()
 }
 
 func DefaultQueueKey(namespace string) LocalQueueReference {
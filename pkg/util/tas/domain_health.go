@@ -0,0 +1,81 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tas
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+// DomainHealth determines the unhealthy topology domains of a topology.
+type DomainHealth struct {
+	// levels are the levels of the topology, down to the level of the
+	// domains excluded when one of their nodes is unhealthy.
+	levels    []string
+	taintKeys sets.Set[string]
+}
+
+// NewDomainHealth returns the DomainHealth for the levels of a topology, or
+// nil if the domain health is not configured.
+func NewDomainHealth(levels []string, health *kueue.TopologyDomainHealth) *DomainHealth {
+	if health == nil {
+		return nil
+	}
+	levelIdx := len(levels) - 1
+	if health.Level != nil {
+		if idx := slices.Index(levels, *health.Level); idx >= 0 {
+			levelIdx = idx
+		}
+	}
+	return &DomainHealth{
+		levels:    slices.Clone(levels[:levelIdx+1]),
+		taintKeys: sets.New(health.UnhealthyNodeTaintKeys...),
+	}
+}
+
+// IsNodeHealthy returns whether the node is ready and has none of the taints
+// marking the nodes as unhealthy.
+func (h *DomainHealth) IsNodeHealthy(node *corev1.Node) bool {
+	if !IsNodeStatusConditionTrue(node.Status.Conditions, corev1.NodeReady) {
+		return false
+	}
+	return !slices.ContainsFunc(node.Spec.Taints, func(t corev1.Taint) bool {
+		return h.taintKeys.Has(t.Key)
+	})
+}
+
+// NodeDomain returns the ID of the domain of the node at the level of the
+// domain health.
+func (h *DomainHealth) NodeDomain(node *corev1.Node) TopologyDomainID {
+	return DomainID(LevelValues(h.levels, node.Labels))
+}
+
+// UnhealthyDomains returns the IDs of the domains, at the level of the domain
+// health, with at least one unhealthy node.
+func (h *DomainHealth) UnhealthyDomains(nodes []corev1.Node) sets.Set[TopologyDomainID] {
+	unhealthy := sets.New[TopologyDomainID]()
+	for i := range nodes {
+		if !h.IsNodeHealthy(&nodes[i]) {
+			unhealthy.Insert(h.NodeDomain(&nodes[i]))
+		}
+	}
+	return unhealthy
+}
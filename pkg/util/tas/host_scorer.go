@@ -0,0 +1,66 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tas
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+)
+
+// HostInfo describes a host on which TAS places pods.
+type HostInfo struct {
+	// Name is the hostname of the host.
+	Name string
+	// Labels are the labels of the Node of the host known to TAS, all the
+	// labels of the Node when evaluating the placement, and the topology
+	// labels of the Node when ungating the pods.
+	Labels map[string]string
+}
+
+// HostScorer evaluates the host-local constraints of the pods placed by TAS
+// on a host, such as the NUMA or PCIe affinity of the devices they use, which
+// TAS doesn't see in the capacity of the Nodes. This lets, for example, a
+// single-host multi-GPU gang request the devices behind the same PCIe switch.
+//
+// The scorer is only used when the lowest level of the topology is the
+// hostname.
+type HostScorer interface {
+	// FitPods returns how many pods of the PodSet, out of the count fitting
+	// the free capacity of the host, can be placed on the host with the
+	// host-local constraints satisfied.
+	FitPods(host HostInfo, podSet *kueue.PodSet, count int32) int32
+
+	// PodAnnotations returns the annotations injected into a pod placed on
+	// the host to convey the host-local placement, for example the devices
+	// to use, to the device plugins or the DRA drivers.
+	PodAnnotations(host HostInfo, pod *corev1.Pod) map[string]string
+}
+
+// NoopHostScorer is the default HostScorer, which places no host-local
+// constraints on the pods.
+type NoopHostScorer struct{}
+
+var _ HostScorer = NoopHostScorer{}
+
+func (NoopHostScorer) FitPods(_ HostInfo, _ *kueue.PodSet, count int32) int32 {
+	return count
+}
+
+func (NoopHostScorer) PodAnnotations(HostInfo, *corev1.Pod) map[string]string {
+	return nil
+}
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package jobframework
+package tas
 
 import (
 	"strconv"
@@ -23,6 +23,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/features"
 )
 
 type podSetTopologyRequestBuilder struct {
@@ -59,6 +60,9 @@ func (p *podSetTopologyRequestBuilder) Build() (*kueue.PodSetTopologyRequest, er
 	sliceSizeValue, sliceSizeFound := p.meta.Annotations[kueue.PodSetSliceSizeAnnotation]
 
 	podSetGroupName, podSetGroupNameFound := p.meta.Annotations[kueue.PodSetGroupName]
+	exclusiveTopologyValue, exclusiveTopologyFound := p.meta.Annotations[kueue.PodSetExclusiveTopologyAnnotation]
+	balancedTopologyValue, balancedTopologyFound := p.meta.Annotations[kueue.PodSetBalancedTopologyAnnotation]
+	balancedDomainCountValue, balancedDomainCountFound := p.meta.Annotations[kueue.PodSetBalancedDomainCountAnnotation]
 
 	switch {
 	case requiredFound:
@@ -93,6 +97,17 @@ func (p *podSetTopologyRequestBuilder) Build() (*kueue.PodSetTopologyRequest, er
 	if podSetGroupNameFound && (requiredFound || preferredFound) {
 		psTopologyReq.PodSetGroupName = &podSetGroupName
 	}
+	if features.Enabled(features.TASExclusivePlacement) && exclusiveTopologyFound && (requiredFound || preferredFound || unconstrainedFound) {
+		psTopologyReq.PodSetExclusiveTopology = &exclusiveTopologyValue
+	}
+	if features.Enabled(features.TASBalancedPlacement) && balancedTopologyFound && balancedDomainCountFound && (requiredFound || preferredFound || unconstrainedFound) {
+		balancedDomainCount, err := strconv.ParseInt(balancedDomainCountValue, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		psTopologyReq.PodSetBalancedTopology = &balancedTopologyValue
+		psTopologyReq.PodSetBalancedDomainCount = ptr.To(int32(balancedDomainCount))
+	}
 
 	return &psTopologyReq, nil
 }
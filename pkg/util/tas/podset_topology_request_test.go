@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package jobframework
+package tas
 
 import (
 	"strconv"
@@ -28,6 +28,7 @@ import (
 	jobsetapi "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/features"
 )
 
 func TestPodSetTopologyRequestBuilder(t *testing.T) {
@@ -36,6 +37,8 @@ func TestPodSetTopologyRequestBuilder(t *testing.T) {
 		podIndexLabel      *string
 		subGroupIndexLabel *string
 		subGroupCount      *int32
+		enableExclusive    bool
+		enableBalanced     bool
 		wantReq            *kueue.PodSetTopologyRequest
 		wantErr            error
 	}{
@@ -175,6 +178,68 @@ func TestPodSetTopologyRequestBuilder(t *testing.T) {
 				SubGroupCount:               ptr.To[int32](1),
 			},
 		},
+		"required annotation with exclusive topology annotation": {
+			meta: &metav1.ObjectMeta{
+				Annotations: map[string]string{
+					kueue.PodSetRequiredTopologyAnnotation:  "cloud.com/block",
+					kueue.PodSetExclusiveTopologyAnnotation: "cloud.com/rack",
+				},
+			},
+			enableExclusive: true,
+			wantReq: &kueue.PodSetTopologyRequest{
+				Required:                ptr.To("cloud.com/block"),
+				PodSetExclusiveTopology: ptr.To("cloud.com/rack"),
+			},
+		},
+		"exclusive topology annotation is ignored when the feature gate is disabled": {
+			meta: &metav1.ObjectMeta{
+				Annotations: map[string]string{
+					kueue.PodSetRequiredTopologyAnnotation:  "cloud.com/block",
+					kueue.PodSetExclusiveTopologyAnnotation: "cloud.com/rack",
+				},
+			},
+			wantReq: &kueue.PodSetTopologyRequest{
+				Required: ptr.To("cloud.com/block"),
+			},
+		},
+		"required annotation with balanced topology annotations": {
+			meta: &metav1.ObjectMeta{
+				Annotations: map[string]string{
+					kueue.PodSetRequiredTopologyAnnotation:    "cloud.com/block",
+					kueue.PodSetBalancedTopologyAnnotation:    "cloud.com/rack",
+					kueue.PodSetBalancedDomainCountAnnotation: "4",
+				},
+			},
+			enableBalanced: true,
+			wantReq: &kueue.PodSetTopologyRequest{
+				Required:                  ptr.To("cloud.com/block"),
+				PodSetBalancedTopology:    ptr.To("cloud.com/rack"),
+				PodSetBalancedDomainCount: ptr.To[int32](4),
+			},
+		},
+		"balanced topology annotations are ignored when the feature gate is disabled": {
+			meta: &metav1.ObjectMeta{
+				Annotations: map[string]string{
+					kueue.PodSetRequiredTopologyAnnotation:    "cloud.com/block",
+					kueue.PodSetBalancedTopologyAnnotation:    "cloud.com/rack",
+					kueue.PodSetBalancedDomainCountAnnotation: "4",
+				},
+			},
+			wantReq: &kueue.PodSetTopologyRequest{
+				Required: ptr.To("cloud.com/block"),
+			},
+		},
+		"invalid balanced domain count annotation value": {
+			meta: &metav1.ObjectMeta{
+				Annotations: map[string]string{
+					kueue.PodSetRequiredTopologyAnnotation:    "cloud.com/block",
+					kueue.PodSetBalancedTopologyAnnotation:    "cloud.com/rack",
+					kueue.PodSetBalancedDomainCountAnnotation: "invalid",
+				},
+			},
+			enableBalanced: true,
+			wantErr:        strconv.ErrSyntax,
+		},
 		"invalid unconstrained topology annotation value": {
 			meta: &metav1.ObjectMeta{
 				Annotations: map[string]string{
@@ -195,6 +260,8 @@ func TestPodSetTopologyRequestBuilder(t *testing.T) {
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TASExclusivePlacement, tc.enableExclusive)
+			features.SetFeatureGateDuringTest(t, features.TASBalancedPlacement, tc.enableBalanced)
 			b := NewPodSetTopologyRequest(tc.meta)
 			b.PodIndexLabel(tc.podIndexLabel)
 			b.SubGroup(tc.subGroupIndexLabel, tc.subGroupCount)
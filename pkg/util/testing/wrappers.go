@@ -144,6 +144,12 @@ func (w *WorkloadWrapper) Active(a bool) *WorkloadWrapper {
 	return w
 }
 
+// Held sets whether the workload is held.
+func (w *WorkloadWrapper) Held(h bool) *WorkloadWrapper {
+	w.Spec.Held = ptr.To(h)
+	return w
+}
+
 // SimpleReserveQuota reserves the quota for all the requested resources in one flavor.
 // It assumes one podset with one container.
 func (w *WorkloadWrapper) SimpleReserveQuota(cq, flavor string, now time.Time) *WorkloadWrapper {
@@ -272,6 +278,11 @@ func (w *WorkloadWrapper) NodeSelector(kv map[string]string) *WorkloadWrapper {
 	return w
 }
 
+func (w *WorkloadWrapper) AdmissionChecksSummary(summary string) *WorkloadWrapper {
+	w.Status.AdmissionChecksSummary = summary
+	return w
+}
+
 func (w *WorkloadWrapper) Condition(condition metav1.Condition) *WorkloadWrapper {
 	apimeta.SetStatusCondition(&w.Status.Conditions, condition)
 	return w
@@ -354,6 +365,12 @@ func (w *WorkloadWrapper) UnhealthyNodes(nodeNames ...string) *WorkloadWrapper {
 	return w
 }
 
+// ExcludedFlavor excludes the flavor for the workload until the expiration time.
+func (w *WorkloadWrapper) ExcludedFlavor(name kueue.ResourceFlavorReference, expirationTime time.Time) *WorkloadWrapper {
+	w.Status.ExcludedFlavors = append(w.Status.ExcludedFlavors, kueue.ExcludedFlavor{Name: name, ExpirationTime: metav1.NewTime(expirationTime)})
+	return w
+}
+
 // DeletionTimestamp sets a deletion timestamp for the workload.
 func (w *WorkloadWrapper) DeletionTimestamp(t time.Time) *WorkloadWrapper {
 	w.Workload.DeletionTimestamp = ptr.To(metav1.NewTime(t).Rfc3339Copy())
@@ -699,6 +716,12 @@ func (q *LocalQueueWrapper) Label(k, v string) *LocalQueueWrapper {
 	return q
 }
 
+// UID sets the UID of the LocalQueue.
+func (q *LocalQueueWrapper) UID(uid types.UID) *LocalQueueWrapper {
+	q.LocalQueue.UID = uid
+	return q
+}
+
 // Obj returns the inner LocalQueue.
 func (q *LocalQueueWrapper) Obj() *kueue.LocalQueue {
 	return &q.LocalQueue
@@ -722,12 +745,24 @@ func (q *LocalQueueWrapper) FairSharing(fs *kueue.FairSharing) *LocalQueueWrappe
 	return q
 }
 
+// AdmissionTarget sets the admissionTarget of the LocalQueue.
+func (q *LocalQueueWrapper) AdmissionTarget(d time.Duration) *LocalQueueWrapper {
+	q.Spec.AdmissionTarget = &metav1.Duration{Duration: d}
+	return q
+}
+
 // PendingWorkloads updates the pendingWorkloads in status.
 func (q *LocalQueueWrapper) PendingWorkloads(n int32) *LocalQueueWrapper {
 	q.Status.PendingWorkloads = n
 	return q
 }
 
+// FlavorsReservation sets the flavorsReservation in status.
+func (q *LocalQueueWrapper) FlavorsReservation(flavors ...kueue.LocalQueueFlavorUsage) *LocalQueueWrapper {
+	q.Status.FlavorsReservation = flavors
+	return q
+}
+
 // ReservingWorkloads updates the reservingWorkloads in status.
 func (q *LocalQueueWrapper) ReservingWorkloads(n int32) *LocalQueueWrapper {
 	q.Status.ReservingWorkloads = n
@@ -928,6 +963,38 @@ func (c *ClusterQueueWrapper) QueueingStrategy(strategy kueue.QueueingStrategy)
 	return c
 }
 
+// HeadOfLineTimeoutSeconds sets the headOfLineTimeoutSeconds.
+func (c *ClusterQueueWrapper) HeadOfLineTimeoutSeconds(seconds int32) *ClusterQueueWrapper {
+	c.Spec.HeadOfLineTimeoutSeconds = &seconds
+	return c
+}
+
+// HeadroomPriorityThreshold sets the minimum priority of the workloads which
+// can use the reserved headroom.
+func (c *ClusterQueueWrapper) HeadroomPriorityThreshold(p int32) *ClusterQueueWrapper {
+	c.Spec.HeadroomPriorityThreshold = &p
+	return c
+}
+
+// PhysicalCapacityPolicy sets whether the workloads are admitted beyond the
+// physical capacity of the flavors.
+func (c *ClusterQueueWrapper) PhysicalCapacityPolicy(p kueue.PhysicalCapacityPolicy) *ClusterQueueWrapper {
+	c.Spec.PhysicalCapacityPolicy = &p
+	return c
+}
+
+// EvictionBackoff sets the evictionBackoff of the ClusterQueue.
+func (c *ClusterQueueWrapper) EvictionBackoff(b *kueue.ClusterQueueEvictionBackoff) *ClusterQueueWrapper {
+	c.Spec.EvictionBackoff = b
+	return c
+}
+
+// ReactivationPolicy sets the reactivationPolicy of the ClusterQueue.
+func (c *ClusterQueueWrapper) ReactivationPolicy(p *kueue.ReactivationPolicy) *ClusterQueueWrapper {
+	c.Spec.ReactivationPolicy = p
+	return c
+}
+
 // NamespaceSelector sets the namespace selector.
 func (c *ClusterQueueWrapper) NamespaceSelector(s *metav1.LabelSelector) *ClusterQueueWrapper {
 	c.Spec.NamespaceSelector = s
@@ -952,6 +1019,12 @@ func (c *ClusterQueueWrapper) StopPolicy(p kueue.StopPolicy) *ClusterQueueWrappe
 	return c
 }
 
+// SuccessorClusterQueue sets the successor ClusterQueue.
+func (c *ClusterQueueWrapper) SuccessorClusterQueue(name kueue.ClusterQueueReference) *ClusterQueueWrapper {
+	c.Spec.SuccessorClusterQueue = &name
+	return c
+}
+
 // DeletionTimestamp sets a deletion timestamp for the cluster queue.
 func (c *ClusterQueueWrapper) DeletionTimestamp(t time.Time) *ClusterQueueWrapper {
 	c.ClusterQueue.DeletionTimestamp = ptr.To(metav1.NewTime(t).Rfc3339Copy())
@@ -1003,6 +1076,12 @@ func (c *ClusterQueueWrapper) PendingWorkloads(n int32) *ClusterQueueWrapper {
 	return c
 }
 
+// FlavorsReservation sets the flavorsReservation in status.
+func (c *ClusterQueueWrapper) FlavorsReservation(flavors ...kueue.FlavorUsage) *ClusterQueueWrapper {
+	c.Status.FlavorsReservation = flavors
+	return c
+}
+
 // AdmittedWorkloads sets the admittedWorkloads in status.
 func (c *ClusterQueueWrapper) AdmittedWorkloads(n int32) *ClusterQueueWrapper {
 	c.Status.AdmittedWorkloads = n
@@ -1071,6 +1150,37 @@ func (rq *ResourceQuotaWrapper) LendingLimit(quantity string) *ResourceQuotaWrap
 	return rq
 }
 
+// PeerBorrowingLimit caps the quota which can be borrowed from the peer ClusterQueue.
+func (rq *ResourceQuotaWrapper) PeerBorrowingLimit(cq kueue.ClusterQueueReference, quantity string) *ResourceQuotaWrapper {
+	rq.ResourceQuota.PeerBorrowingLimits = append(rq.ResourceQuota.PeerBorrowingLimits, kueue.PeerBorrowingLimit{
+		ClusterQueue:   cq,
+		BorrowingLimit: resource.MustParse(quantity),
+	})
+	return rq
+}
+
+// ReservedHeadroom sets the quota kept for the workloads above the headroom
+// priority threshold.
+func (rq *ResourceQuotaWrapper) ReservedHeadroom(quantity string) *ResourceQuotaWrapper {
+	rq.ResourceQuota.ReservedHeadroom = ptr.To(resource.MustParse(quantity))
+	return rq
+}
+
+// OvercommitPercent sets the overcommit factor, in percent, of the nominal quota.
+func (rq *ResourceQuotaWrapper) OvercommitPercent(percent int32) *ResourceQuotaWrapper {
+	rq.ResourceQuota.OvercommitPercent = &percent
+	return rq
+}
+
+// MaxRatio sets the maximum quantity of the resource per unit of perResource.
+func (rq *ResourceQuotaWrapper) MaxRatio(perResource corev1.ResourceName, quantity string) *ResourceQuotaWrapper {
+	rq.ResourceQuota.MaxRatio = &kueue.ResourceRatio{
+		PerResource: perResource,
+		Quantity:    resource.MustParse(quantity),
+	}
+	return rq
+}
+
 // Append appends the ResourceQuotaWrapper to its parent
 func (rq *ResourceQuotaWrapper) Append() *FlavorQuotasWrapper {
 	rq.parent.Resources = append(rq.parent.Resources, rq.ResourceQuota)
@@ -1130,6 +1240,24 @@ func (rf *ResourceFlavorWrapper) Toleration(t corev1.Toleration) *ResourceFlavor
 	return rf
 }
 
+// ResourcePartition adds a resource partition to the ResourceFlavor.
+func (rf *ResourceFlavorWrapper) ResourcePartition(name corev1.ResourceName, fraction string) *ResourceFlavorWrapper {
+	rf.Spec.ResourcePartitions = append(rf.Spec.ResourcePartitions, kueue.ResourcePartition{
+		Name:     name,
+		Fraction: resource.MustParse(fraction),
+	})
+	return rf
+}
+
+// PodOverhead sets the pod overhead of a resource of the ResourceFlavor.
+func (rf *ResourceFlavorWrapper) PodOverhead(name corev1.ResourceName, value string) *ResourceFlavorWrapper {
+	if rf.Spec.PodOverhead == nil {
+		rf.Spec.PodOverhead = make(corev1.ResourceList)
+	}
+	rf.Spec.PodOverhead[name] = resource.MustParse(value)
+	return rf
+}
+
 // Creation sets the creation timestamp of the LocalQueue.
 func (rf *ResourceFlavorWrapper) Creation(t time.Time) *ResourceFlavorWrapper {
 	rf.CreationTimestamp = metav1.NewTime(t)
@@ -1159,6 +1287,12 @@ func (t *TopologyWrapper) Levels(levels ...string) *TopologyWrapper {
 	return t
 }
 
+// DomainHealth sets the domain health configuration for a Topology.
+func (t *TopologyWrapper) DomainHealth(health kueue.TopologyDomainHealth) *TopologyWrapper {
+	t.Spec.DomainHealth = &health
+	return t
+}
+
 func (t *TopologyWrapper) Obj() *kueue.Topology {
 	return &t.Topology
 }
@@ -1276,6 +1410,22 @@ func (acs *AdmissionCheckStrategyRuleWrapper) OnFlavors(flavors []kueue.Resource
 	return acs
 }
 
+func (acs *AdmissionCheckStrategyRuleWrapper) OnPodSets(podSets ...kueue.PodSetReference) *AdmissionCheckStrategyRuleWrapper {
+	acs.AdmissionCheckStrategyRule.OnPodSets = podSets
+	return acs
+}
+
+func (acs *AdmissionCheckStrategyRuleWrapper) DependsOn(checks ...kueue.AdmissionCheckReference) *AdmissionCheckStrategyRuleWrapper {
+	acs.AdmissionCheckStrategyRule.DependsOn = checks
+	return acs
+}
+
+func (acs *AdmissionCheckStrategyRuleWrapper) Timeout(timeout time.Duration, policy kueue.AdmissionCheckTimeoutPolicy) *AdmissionCheckStrategyRuleWrapper {
+	acs.AdmissionCheckStrategyRule.Timeout = &metav1.Duration{Duration: timeout}
+	acs.AdmissionCheckStrategyRule.TimeoutPolicy = &policy
+	return acs
+}
+
 func (acs *AdmissionCheckStrategyRuleWrapper) Obj() *kueue.AdmissionCheckStrategyRule {
 	return &acs.AdmissionCheckStrategyRule
 }
@@ -1315,6 +1465,12 @@ func (ac *AdmissionCheckWrapper) Parameters(apigroup, kind, name string) *Admiss
 	return ac
 }
 
+// Continuous sets the continuous evaluation of the AdmissionCheck.
+func (ac *AdmissionCheckWrapper) Continuous(c kueue.ContinuousAdmissionCheck) *AdmissionCheckWrapper {
+	ac.Spec.Continuous = &c
+	return ac
+}
+
 func (ac *AdmissionCheckWrapper) Obj() *kueue.AdmissionCheck {
 	return &ac.AdmissionCheck
 }
@@ -1516,6 +1672,15 @@ func (prc *ProvisioningRequestConfigWrapper) RetryStrategy(retryStrategy *kueue.
 	return prc
 }
 
+func (prc *ProvisioningRequestConfigWrapper) FailurePolicy(policy kueue.ProvisioningRequestFailurePolicy) *ProvisioningRequestConfigWrapper {
+	if prc.Spec.RetryStrategy == nil {
+		prc.Spec.RetryStrategy = &kueue.ProvisioningRequestRetryStrategy{}
+	}
+
+	prc.Spec.RetryStrategy.FailurePolicy = &policy
+	return prc
+}
+
 func (prc *ProvisioningRequestConfigWrapper) PodSetUpdate(update kueue.ProvisioningRequestPodSetUpdates) *ProvisioningRequestConfigWrapper {
 	prc.Spec.PodSetUpdates = &update
 	return prc
@@ -1807,3 +1972,54 @@ func (p *PodSetAssignmentWrapper) DelayedTopologyRequest(state kueue.DelayedTopo
 func (p *PodSetAssignmentWrapper) Assignment(r corev1.ResourceName, f kueue.ResourceFlavorReference, value string) *PodSetAssignmentWrapper {
 	return p.Flavor(r, f).ResourceUsage(r, value)
 }
+
+// ReservationWrapper wraps a Reservation.
+type ReservationWrapper struct{ kueue.Reservation }
+
+// MakeReservation creates a wrapper for a Reservation in the given ClusterQueue.
+func MakeReservation(name string, cq kueue.ClusterQueueReference) *ReservationWrapper {
+	return &ReservationWrapper{kueue.Reservation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: kueue.ReservationSpec{
+			ClusterQueue: cq,
+		},
+	}}
+}
+
+// Obj returns the inner Reservation.
+func (r *ReservationWrapper) Obj() *kueue.Reservation {
+	return &r.Reservation
+}
+
+// Holder sets the namespace and, optionally, the LocalQueue of the holder.
+func (r *ReservationWrapper) Holder(namespace string, lq ...kueue.LocalQueueName) *ReservationWrapper {
+	r.Spec.Holder.Namespace = namespace
+	if len(lq) > 0 {
+		r.Spec.Holder.LocalQueue = ptr.To(lq[0])
+	}
+	return r
+}
+
+// Window sets the reservation window.
+func (r *ReservationWrapper) Window(start, end time.Time) *ReservationWrapper {
+	r.Spec.StartTime = metav1.NewTime(start)
+	r.Spec.EndTime = metav1.NewTime(end)
+	return r
+}
+
+// Resource adds the quantity of the resource reserved in the flavor.
+func (r *ReservationWrapper) Resource(flavor kueue.ResourceFlavorReference, name corev1.ResourceName, quantity string) *ReservationWrapper {
+	for i := range r.Spec.Flavors {
+		if r.Spec.Flavors[i].Name == flavor {
+			r.Spec.Flavors[i].Resources[name] = resource.MustParse(quantity)
+			return r
+		}
+	}
+	r.Spec.Flavors = append(r.Spec.Flavors, kueue.ReservedFlavor{
+		Name:      flavor,
+		Resources: corev1.ResourceList{name: resource.MustParse(quantity)},
+	})
+	return r
+}
@@ -0,0 +1,156 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package argoworkflow
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+// WorkflowWrapper wraps an Argo Workflow.
+type WorkflowWrapper struct {
+	unstructured.Unstructured
+}
+
+// MakeWorkflow creates a wrapper for a suspended Workflow with no templates.
+func MakeWorkflow(name, ns string) *WorkflowWrapper {
+	w := &WorkflowWrapper{}
+	w.SetGroupVersionKind(schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Workflow"})
+	w.SetName(name)
+	w.SetNamespace(ns)
+	w.set(true, "spec", "suspend")
+	return w
+}
+
+// Obj returns the inner Workflow.
+func (w *WorkflowWrapper) Obj() *unstructured.Unstructured {
+	return &w.Unstructured
+}
+
+func (w *WorkflowWrapper) set(value any, fields ...string) {
+	utilruntime.Must(unstructured.SetNestedField(w.Object, value, fields...))
+}
+
+// Queue updates the queue name of the Workflow.
+func (w *WorkflowWrapper) Queue(queue string) *WorkflowWrapper {
+	return w.Label(constants.QueueLabel, queue)
+}
+
+// Label sets a label of the Workflow.
+func (w *WorkflowWrapper) Label(k, v string) *WorkflowWrapper {
+	labels := w.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[k] = v
+	w.SetLabels(labels)
+	return w
+}
+
+// Annotation sets an annotation of the Workflow.
+func (w *WorkflowWrapper) Annotation(k, v string) *WorkflowWrapper {
+	annotations := w.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[k] = v
+	w.SetAnnotations(annotations)
+	return w
+}
+
+// Suspend updates the suspend status of the Workflow.
+func (w *WorkflowWrapper) Suspend(s bool) *WorkflowWrapper {
+	w.set(s, "spec", "suspend")
+	return w
+}
+
+// Parallelism updates the parallelism of the Workflow.
+func (w *WorkflowWrapper) Parallelism(p int64) *WorkflowWrapper {
+	w.set(p, "spec", "parallelism")
+	return w
+}
+
+// NodeSelector adds a node selector to the pods of the Workflow.
+func (w *WorkflowWrapper) NodeSelector(k, v string) *WorkflowWrapper {
+	w.set(v, "spec", "nodeSelector", k)
+	return w
+}
+
+// PodLabel adds a label to the pods of the Workflow.
+func (w *WorkflowWrapper) PodLabel(k, v string) *WorkflowWrapper {
+	w.set(v, "spec", "podMetadata", "labels", k)
+	return w
+}
+
+// WorkflowTemplateRef makes the Workflow reference a WorkflowTemplate.
+func (w *WorkflowWrapper) WorkflowTemplateRef(name string) *WorkflowWrapper {
+	w.set(name, "spec", "workflowTemplateRef", "name")
+	return w
+}
+
+// ContainerTemplate adds a container template requesting the resources.
+func (w *WorkflowWrapper) ContainerTemplate(name string, requests corev1.ResourceList) *WorkflowWrapper {
+	return w.Template(map[string]any{
+		"name":      name,
+		"container": toUnstructured(&corev1.Container{Name: "main", Image: "busybox", Resources: corev1.ResourceRequirements{Requests: requests}}),
+	})
+}
+
+// StepsTemplate adds a steps template running the templates sequentially.
+func (w *WorkflowWrapper) StepsTemplate(name string, templates ...string) *WorkflowWrapper {
+	steps := make([]any, len(templates))
+	for i, t := range templates {
+		steps[i] = []any{map[string]any{"name": t, "template": t}}
+	}
+	return w.Template(map[string]any{"name": name, "steps": steps})
+}
+
+// Template adds a template to the Workflow.
+func (w *WorkflowWrapper) Template(template map[string]any) *WorkflowWrapper {
+	templates, _, _ := unstructured.NestedSlice(w.Object, "spec", "templates")
+	w.set(append(templates, template), "spec", "templates")
+	return w
+}
+
+// Phase updates the phase of the Workflow.
+func (w *WorkflowWrapper) Phase(phase string) *WorkflowWrapper {
+	w.set(phase, "status", "phase")
+	return w
+}
+
+// Message updates the status message of the Workflow.
+func (w *WorkflowWrapper) Message(message string) *WorkflowWrapper {
+	w.set(message, "status", "message")
+	return w
+}
+
+// PodNode adds a node of the Pod type to the status of the Workflow.
+func (w *WorkflowWrapper) PodNode(name, phase string) *WorkflowWrapper {
+	w.set(map[string]any{"type": "Pod", "phase": phase}, "status", "nodes", name)
+	return w
+}
+
+func toUnstructured(obj any) map[string]any {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	utilruntime.Must(err)
+	return u
+}
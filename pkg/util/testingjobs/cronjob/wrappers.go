@@ -0,0 +1,110 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cronjob
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+// CronJobWrapper wraps a CronJob.
+type CronJobWrapper struct {
+	batchv1.CronJob
+}
+
+// MakeCronJob creates a wrapper for an hourly CronJob of Jobs with a single
+// container and parallelism=1.
+func MakeCronJob(name, ns string) *CronJobWrapper {
+	return &CronJobWrapper{batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "0 * * * *",
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Parallelism: ptr.To[int32](1),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							RestartPolicy: corev1.RestartPolicyNever,
+							Containers: []corev1.Container{
+								{
+									Name:  "c",
+									Image: "pause",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}}
+}
+
+// Obj returns the inner CronJob.
+func (c *CronJobWrapper) Obj() *batchv1.CronJob {
+	return &c.CronJob
+}
+
+// Clone clones the CronJobWrapper.
+func (c *CronJobWrapper) Clone() *CronJobWrapper {
+	return &CronJobWrapper{CronJob: *c.DeepCopy()}
+}
+
+// UID updates the uid of the CronJob.
+func (c *CronJobWrapper) UID(uid string) *CronJobWrapper {
+	c.ObjectMeta.UID = types.UID(uid)
+	return c
+}
+
+// Label sets the label of the CronJob.
+func (c *CronJobWrapper) Label(k, v string) *CronJobWrapper {
+	if c.Labels == nil {
+		c.Labels = make(map[string]string)
+	}
+	c.Labels[k] = v
+	return c
+}
+
+// Queue updates the queue name of the CronJob.
+func (c *CronJobWrapper) Queue(q string) *CronJobWrapper {
+	return c.Label(controllerconstants.QueueLabel, q)
+}
+
+// Annotation sets the annotation of the CronJob.
+func (c *CronJobWrapper) Annotation(k, v string) *CronJobWrapper {
+	if c.Annotations == nil {
+		c.Annotations = make(map[string]string)
+	}
+	c.Annotations[k] = v
+	return c
+}
+
+// JobTemplateLabel sets the label of the Job template.
+func (c *CronJobWrapper) JobTemplateLabel(k, v string) *CronJobWrapper {
+	if c.Spec.JobTemplate.Labels == nil {
+		c.Spec.JobTemplate.Labels = make(map[string]string)
+	}
+	c.Spec.JobTemplate.Labels[k] = v
+	return c
+}
@@ -0,0 +1,167 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flink
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+// Wrapper wraps a FlinkDeployment or a FlinkSessionJob.
+type Wrapper struct {
+	unstructured.Unstructured
+}
+
+// MakeFlinkDeployment creates a wrapper for a FlinkDeployment running a
+// suspended job, with a JobManager and TaskManagers of 1 cpu and 2048m of
+// memory.
+func MakeFlinkDeployment(name, ns string) *Wrapper {
+	w := makeWrapper("FlinkDeployment", name, ns)
+	for _, role := range []string{"jobManager", "taskManager"} {
+		w.set(int64(1), "spec", role, "resource", "cpu")
+		w.set("2048m", "spec", role, "resource", "memory")
+	}
+	w.set("local:///opt/flink/examples/streaming/StateMachineExample.jar", "spec", "job", "jarURI")
+	w.set("suspended", "spec", "job", "state")
+	return w
+}
+
+// MakeFlinkSessionJob creates a wrapper for a suspended FlinkSessionJob of
+// the session cluster.
+func MakeFlinkSessionJob(name, ns, deploymentName string) *Wrapper {
+	w := makeWrapper("FlinkSessionJob", name, ns)
+	w.set(deploymentName, "spec", "deploymentName")
+	w.set("https://repo1.maven.org/maven2/org/apache/flink/flink-examples-streaming_2.12/1.16.1/flink-examples-streaming_2.12-1.16.1-TopSpeedWindowing.jar", "spec", "job", "jarURI")
+	w.set("suspended", "spec", "job", "state")
+	return w
+}
+
+func makeWrapper(kind, name, ns string) *Wrapper {
+	w := &Wrapper{}
+	w.SetGroupVersionKind(schema.GroupVersionKind{Group: "flink.apache.org", Version: "v1beta1", Kind: kind})
+	w.SetName(name)
+	w.SetNamespace(ns)
+	return w
+}
+
+// Obj returns the inner object.
+func (w *Wrapper) Obj() *unstructured.Unstructured {
+	return &w.Unstructured
+}
+
+func (w *Wrapper) set(value any, fields ...string) {
+	utilruntime.Must(unstructured.SetNestedField(w.Object, value, fields...))
+}
+
+// Set sets a field of the object.
+func (w *Wrapper) Set(value any, fields ...string) *Wrapper {
+	w.set(value, fields...)
+	return w
+}
+
+// Remove removes a field of the object.
+func (w *Wrapper) Remove(fields ...string) *Wrapper {
+	unstructured.RemoveNestedField(w.Object, fields...)
+	return w
+}
+
+// Queue updates the queue name of the object.
+func (w *Wrapper) Queue(queue string) *Wrapper {
+	w.SetLabels(map[string]string{constants.QueueLabel: queue})
+	return w
+}
+
+// JobState updates the desired state of the job.
+func (w *Wrapper) JobState(state string) *Wrapper {
+	w.set(state, "spec", "job", "state")
+	return w
+}
+
+// Parallelism updates the parallelism of the job.
+func (w *Wrapper) Parallelism(p int64) *Wrapper {
+	w.set(p, "spec", "job", "parallelism")
+	return w
+}
+
+// TaskSlots updates the number of task slots of the TaskManagers.
+func (w *Wrapper) TaskSlots(slots string) *Wrapper {
+	w.set(slots, "spec", "flinkConfiguration", "taskmanager.numberOfTaskSlots")
+	return w
+}
+
+// Resource sets a resource of the JobManager or the TaskManagers.
+func (w *Wrapper) Resource(role, name string, value any) *Wrapper {
+	w.set(value, "spec", role, "resource", name)
+	return w
+}
+
+// Replicas updates the replicas of the JobManager or the TaskManagers.
+func (w *Wrapper) Replicas(role string, replicas int64) *Wrapper {
+	w.set(replicas, "spec", role, "replicas")
+	return w
+}
+
+// PodTemplateLabel sets a label of the common pod template, or of the pod
+// template of the JobManager or the TaskManagers if role is set.
+func (w *Wrapper) PodTemplateLabel(role, k, v string) *Wrapper {
+	w.set(v, append(podTemplatePath(role), "metadata", "labels", k)...)
+	return w
+}
+
+// PodTemplateNodeSelector sets a node selector of the common pod template,
+// or of the pod template of the JobManager or the TaskManagers if role is set.
+func (w *Wrapper) PodTemplateNodeSelector(role, k, v string) *Wrapper {
+	w.set(v, append(podTemplatePath(role), "spec", "nodeSelector", k)...)
+	return w
+}
+
+// PodTemplateContainer adds a container to the common pod template, or to
+// the pod template of the JobManager or the TaskManagers if role is set.
+func (w *Wrapper) PodTemplateContainer(role string, container map[string]any) *Wrapper {
+	path := append(podTemplatePath(role), "spec", "containers")
+	containers, _, _ := unstructured.NestedSlice(w.Object, path...)
+	utilruntime.Must(unstructured.SetNestedSlice(w.Object, append(containers, container), path...))
+	return w
+}
+
+func podTemplatePath(role string) []string {
+	if role == "" {
+		return []string{"spec", "podTemplate"}
+	}
+	return []string{"spec", role, "podTemplate"}
+}
+
+// JobManagerDeploymentStatus updates the status of the JobManager deployment.
+func (w *Wrapper) JobManagerDeploymentStatus(status string) *Wrapper {
+	w.set(status, "status", "jobManagerDeploymentStatus")
+	return w
+}
+
+// JobStatus updates the state of the Flink job.
+func (w *Wrapper) JobStatus(state string) *Wrapper {
+	w.set(state, "status", "jobStatus", "state")
+	return w
+}
+
+// Error updates the error of the status.
+func (w *Wrapper) Error(message string) *Wrapper {
+	w.set(message, "status", "error")
+	return w
+}
@@ -250,6 +250,12 @@ func (j *JobWrapper) Ready(c int32) *JobWrapper {
 	return j
 }
 
+// CreationTimestamp sets the .metadata.creationTimestamp
+func (j *JobWrapper) CreationTimestamp(t time.Time) *JobWrapper {
+	j.ObjectMeta.CreationTimestamp = metav1.NewTime(t).Rfc3339Copy()
+	return j
+}
+
 // Condition adds a condition
 func (j *JobWrapper) Condition(c batchv1.JobCondition) *JobWrapper {
 	j.Status.Conditions = append(j.Status.Conditions, c)
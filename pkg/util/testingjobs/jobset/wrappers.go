@@ -192,6 +192,15 @@ func (j *JobSetWrapper) JobsStatus(statuses ...jobsetapi.ReplicatedJobStatus) *J
 	return j
 }
 
+// SuccessPolicy sets the success policy.
+func (j *JobSetWrapper) SuccessPolicy(operator jobsetapi.Operator, targetReplicatedJobs ...string) *JobSetWrapper {
+	j.Spec.SuccessPolicy = &jobsetapi.SuccessPolicy{
+		Operator:             operator,
+		TargetReplicatedJobs: targetReplicatedJobs,
+	}
+	return j
+}
+
 // Condition adds a condition
 func (j *JobSetWrapper) Condition(c metav1.Condition) *JobSetWrapper {
 	apimeta.SetStatusCondition(&j.Status.Conditions, c)
@@ -0,0 +1,113 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubevirt
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"sigs.k8s.io/kueue/pkg/constants"
+	controllerconstants "sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+// Wrapper wraps a VirtualMachineInstance or a VirtualMachineInstanceReplicaSet.
+type Wrapper struct {
+	unstructured.Unstructured
+	// podMetadataPath is the path of the metadata propagated to the
+	// virt-launcher pods.
+	podMetadataPath []string
+}
+
+func domain() map[string]any {
+	return map[string]any{
+		"cpu":       map[string]any{"cores": int64(1)},
+		"memory":    map[string]any{"guest": "1Gi"},
+		"devices":   map[string]any{},
+		"resources": map[string]any{},
+	}
+}
+
+// MakeVirtualMachineInstance creates a wrapper for a VirtualMachineInstance
+// with a guest of 1 core and 1Gi of memory.
+func MakeVirtualMachineInstance(name, ns string) *Wrapper {
+	w := &Wrapper{podMetadataPath: []string{"metadata"}}
+	w.SetGroupVersionKind(schema.GroupVersionKind{Group: "kubevirt.io", Version: "v1", Kind: "VirtualMachineInstance"})
+	w.SetName(name)
+	w.SetNamespace(ns)
+	w.set(domain(), "spec", "domain")
+	return w
+}
+
+// MakeVirtualMachineInstanceReplicaSet creates a wrapper for a
+// VirtualMachineInstanceReplicaSet of a replica with a guest of 1 core and
+// 1Gi of memory.
+func MakeVirtualMachineInstanceReplicaSet(name, ns string) *Wrapper {
+	w := &Wrapper{podMetadataPath: []string{"spec", "template", "metadata"}}
+	w.SetGroupVersionKind(schema.GroupVersionKind{Group: "kubevirt.io", Version: "v1", Kind: "VirtualMachineInstanceReplicaSet"})
+	w.SetName(name)
+	w.SetNamespace(ns)
+	w.set(int64(1), "spec", "replicas")
+	w.set(map[string]any{"kubevirt.io/vmReplicaSet": name}, "spec", "selector", "matchLabels")
+	w.set(map[string]any{"kubevirt.io/vmReplicaSet": name}, "spec", "template", "metadata", "labels")
+	w.set(domain(), "spec", "template", "spec", "domain")
+	return w
+}
+
+// Obj returns the inner object.
+func (w *Wrapper) Obj() *unstructured.Unstructured {
+	return &w.Unstructured
+}
+
+func (w *Wrapper) set(value any, fields ...string) {
+	utilruntime.Must(unstructured.SetNestedField(w.Object, value, fields...))
+}
+
+// Queue updates the queue name of the object.
+func (w *Wrapper) Queue(queue string) *Wrapper {
+	return w.Label(controllerconstants.QueueLabel, queue)
+}
+
+// Label sets a label of the object.
+func (w *Wrapper) Label(k, v string) *Wrapper {
+	w.set(v, "metadata", "labels", k)
+	return w
+}
+
+// PodLabel sets a label propagated to the virt-launcher pods.
+func (w *Wrapper) PodLabel(k, v string) *Wrapper {
+	w.set(v, append(w.podMetadataPath, "labels", k)...)
+	return w
+}
+
+// PodAnnotation sets an annotation propagated to the virt-launcher pods.
+func (w *Wrapper) PodAnnotation(k, v string) *Wrapper {
+	w.set(v, append(w.podMetadataPath, "annotations", k)...)
+	return w
+}
+
+// PodManagedByKueue sets the label marking the virt-launcher pods as managed
+// by Kueue.
+func (w *Wrapper) PodManagedByKueue() *Wrapper {
+	return w.PodLabel(constants.ManagedByKueueLabelKey, constants.ManagedByKueueLabelValue)
+}
+
+// ReadyReplicas updates the ready replicas of the status.
+func (w *Wrapper) ReadyReplicas(n int64) *Wrapper {
+	w.set(n, "status", "readyReplicas")
+	return w
+}
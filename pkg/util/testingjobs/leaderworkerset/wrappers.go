@@ -20,10 +20,12 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	leaderworkersetv1 "sigs.k8s.io/lws/api/leaderworkerset/v1"
 
@@ -155,6 +157,22 @@ func (w *LeaderWorkerSetWrapper) ReadyReplicas(n int32) *LeaderWorkerSetWrapper
 	return w
 }
 
+// MaxSurge sets the maxSurge of the rolling updates of the LeaderWorkerSet.
+func (w *LeaderWorkerSetWrapper) MaxSurge(maxSurge intstr.IntOrString) *LeaderWorkerSetWrapper {
+	w.Spec.RolloutStrategy.Type = leaderworkersetv1.RollingUpdateStrategyType
+	if w.Spec.RolloutStrategy.RollingUpdateConfiguration == nil {
+		w.Spec.RolloutStrategy.RollingUpdateConfiguration = &leaderworkersetv1.RollingUpdateConfiguration{}
+	}
+	w.Spec.RolloutStrategy.RollingUpdateConfiguration.MaxSurge = maxSurge
+	return w
+}
+
+// Condition sets a condition of the LeaderWorkerSet.
+func (w *LeaderWorkerSetWrapper) Condition(condition metav1.Condition) *LeaderWorkerSetWrapper {
+	apimeta.SetStatusCondition(&w.Status.Conditions, condition)
+	return w
+}
+
 // Size sets the size of the LeaderWorkerSet.
 func (w *LeaderWorkerSetWrapper) Size(n int32) *LeaderWorkerSetWrapper {
 	w.Spec.LeaderWorkerTemplate.Size = ptr.To[int32](n)
@@ -135,6 +135,21 @@ func (j *MPIJobWrapper) Label(key, value string) *MPIJobWrapper {
 	return j
 }
 
+// Annotation sets the annotation key and value
+func (j *MPIJobWrapper) Annotation(key, value string) *MPIJobWrapper {
+	if j.Annotations == nil {
+		j.Annotations = make(map[string]string, 1)
+	}
+	j.Annotations[key] = value
+	return j
+}
+
+// LauncherCreationPolicy sets the launcherCreationPolicy of the job.
+func (j *MPIJobWrapper) LauncherCreationPolicy(p kfmpi.LauncherCreationPolicy) *MPIJobWrapper {
+	j.Spec.LauncherCreationPolicy = p
+	return j
+}
+
 // PriorityClass updates job priorityclass.
 func (j *MPIJobWrapper) PriorityClass(pc string) *MPIJobWrapper {
 	if j.Spec.RunPolicy.SchedulingPolicy == nil {
@@ -144,6 +144,11 @@ func (p *PodWrapper) GroupTotalCount(gtc string) *PodWrapper {
 	return p.Annotation(podconstants.GroupTotalCountAnnotation, gtc)
 }
 
+// GroupMinAvailable updates the pod.GroupMinAvailableAnnotation of the Pod
+func (p *PodWrapper) GroupMinAvailable(minAvailable string) *PodWrapper {
+	return p.Annotation(podconstants.GroupMinAvailableAnnotation, minAvailable)
+}
+
 // GroupIndex updates the pod.GroupIndexLabel of the Pod
 func (p *PodWrapper) GroupIndex(index string) *PodWrapper {
 	return p.Label(kueue.PodGroupPodIndexLabel, index)
@@ -226,6 +226,15 @@ func (j *PyTorchJobWrapper) StatusConditions(c kftraining.JobCondition) *PyTorch
 	return j
 }
 
+// ReplicaStatus sets the status of the replicas of a replica type.
+func (j *PyTorchJobWrapper) ReplicaStatus(replicaType kftraining.ReplicaType, status kftraining.ReplicaStatus) *PyTorchJobWrapper {
+	if j.Status.ReplicaStatuses == nil {
+		j.Status.ReplicaStatuses = make(map[kftraining.ReplicaType]*kftraining.ReplicaStatus)
+	}
+	j.Status.ReplicaStatuses[replicaType] = &status
+	return j
+}
+
 func (j *PyTorchJobWrapper) Image(replicaType kftraining.ReplicaType, image string, args []string) *PyTorchJobWrapper {
 	j.Spec.PyTorchReplicaSpecs[replicaType].Template.Spec.Containers[0].Image = image
 	j.Spec.PyTorchReplicaSpecs[replicaType].Template.Spec.Containers[0].Args = args
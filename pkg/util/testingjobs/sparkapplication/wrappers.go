@@ -0,0 +1,145 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+// SparkApplicationWrapper wraps a SparkApplication.
+type SparkApplicationWrapper struct {
+	unstructured.Unstructured
+}
+
+// MakeSparkApplication creates a wrapper for a suspended Scala
+// SparkApplication with a driver and an executor of 1 core and 1g of memory.
+func MakeSparkApplication(name, ns string) *SparkApplicationWrapper {
+	w := &SparkApplicationWrapper{}
+	w.SetGroupVersionKind(schema.GroupVersionKind{Group: "sparkoperator.k8s.io", Version: "v1beta2", Kind: "SparkApplication"})
+	w.SetName(name)
+	w.SetNamespace(ns)
+	w.set("Scala", "spec", "type")
+	w.set(true, "spec", "suspend")
+	w.set(int64(1), "spec", "driver", "cores")
+	w.set("1g", "spec", "driver", "memory")
+	w.set(int64(1), "spec", "executor", "cores")
+	w.set("1g", "spec", "executor", "memory")
+	w.set(int64(1), "spec", "executor", "instances")
+	return w
+}
+
+// Obj returns the inner SparkApplication.
+func (w *SparkApplicationWrapper) Obj() *unstructured.Unstructured {
+	return &w.Unstructured
+}
+
+func (w *SparkApplicationWrapper) set(value any, fields ...string) {
+	utilruntime.Must(unstructured.SetNestedField(w.Object, value, fields...))
+}
+
+// Queue updates the queue name of the SparkApplication.
+func (w *SparkApplicationWrapper) Queue(queue string) *SparkApplicationWrapper {
+	return w.Label(constants.QueueLabel, queue)
+}
+
+// Label sets a label of the SparkApplication.
+func (w *SparkApplicationWrapper) Label(k, v string) *SparkApplicationWrapper {
+	labels := w.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[k] = v
+	w.SetLabels(labels)
+	return w
+}
+
+// Annotation sets an annotation of the SparkApplication.
+func (w *SparkApplicationWrapper) Annotation(k, v string) *SparkApplicationWrapper {
+	annotations := w.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[k] = v
+	w.SetAnnotations(annotations)
+	return w
+}
+
+// Type updates the type of the SparkApplication.
+func (w *SparkApplicationWrapper) Type(t string) *SparkApplicationWrapper {
+	w.set(t, "spec", "type")
+	return w
+}
+
+// Suspend updates the suspend status of the SparkApplication.
+func (w *SparkApplicationWrapper) Suspend(s bool) *SparkApplicationWrapper {
+	w.set(s, "spec", "suspend")
+	return w
+}
+
+// NodeSelector adds a node selector to the pods of the SparkApplication.
+func (w *SparkApplicationWrapper) NodeSelector(k, v string) *SparkApplicationWrapper {
+	w.set(v, "spec", "nodeSelector", k)
+	return w
+}
+
+// RestartPolicy updates the restart policy of the SparkApplication.
+func (w *SparkApplicationWrapper) RestartPolicy(t string) *SparkApplicationWrapper {
+	w.set(t, "spec", "restartPolicy", "type")
+	return w
+}
+
+// Role sets a field of the driver or the executor spec.
+func (w *SparkApplicationWrapper) Role(role, field string, value any) *SparkApplicationWrapper {
+	w.set(value, "spec", role, field)
+	return w
+}
+
+// RoleNodeSelector adds a node selector to the driver or the executors.
+func (w *SparkApplicationWrapper) RoleNodeSelector(role, k, v string) *SparkApplicationWrapper {
+	w.set(v, "spec", role, "nodeSelector", k)
+	return w
+}
+
+// ExecutorInstances updates the number of executors.
+func (w *SparkApplicationWrapper) ExecutorInstances(n int64) *SparkApplicationWrapper {
+	w.set(n, "spec", "executor", "instances")
+	return w
+}
+
+// DynamicAllocation enables the dynamic allocation of the executors.
+func (w *SparkApplicationWrapper) DynamicAllocation(minExecutors, maxExecutors int64) *SparkApplicationWrapper {
+	w.set(true, "spec", "dynamicAllocation", "enabled")
+	w.set(minExecutors, "spec", "dynamicAllocation", "minExecutors")
+	w.set(maxExecutors, "spec", "dynamicAllocation", "maxExecutors")
+	return w
+}
+
+// State updates the application state of the SparkApplication.
+func (w *SparkApplicationWrapper) State(state string) *SparkApplicationWrapper {
+	w.set(state, "status", "applicationState", "state")
+	return w
+}
+
+// ErrorMessage updates the error message of the application state.
+func (w *SparkApplicationWrapper) ErrorMessage(message string) *SparkApplicationWrapper {
+	w.set(message, "status", "applicationState", "errorMessage")
+	return w
+}
@@ -0,0 +1,87 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package unstructured contains the helpers of the integrations managing
+// the objects of the APIs which Go types are not available to Kueue.
+package unstructured
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AddToSchemeFunc returns a function registering the kind as unstructured
+// objects, for the webhooks to resolve the kind from the scheme.
+func AddToSchemeFunc(gvk schema.GroupVersionKind) func(*runtime.Scheme) error {
+	return func(s *runtime.Scheme) error {
+		s.AddKnownTypeWithName(gvk, &unstructured.Unstructured{})
+		return nil
+	}
+}
+
+// NewObject returns an empty unstructured object of the kind.
+func NewObject(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	return obj
+}
+
+// FromField converts the map at the path of the object into out. It leaves
+// out unchanged if the field isn't found.
+func FromField(obj map[string]any, out any, fields ...string) error {
+	value, found, err := unstructured.NestedMap(obj, fields...)
+	if err != nil || !found {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(value, out)
+}
+
+// SetOrRemoveStringMap sets the string map at the path of the object, or
+// removes the field if the map is empty.
+func SetOrRemoveStringMap(obj map[string]any, value map[string]string, fields ...string) error {
+	if len(value) == 0 {
+		unstructured.RemoveNestedField(obj, fields...)
+		return nil
+	}
+	return unstructured.SetNestedStringMap(obj, value, fields...)
+}
+
+// SetOrRemoveTolerations sets the tolerations at the path of the object, or
+// removes the field if there are none.
+func SetOrRemoveTolerations(obj map[string]any, tolerations []corev1.Toleration, fields ...string) error {
+	if len(tolerations) == 0 {
+		unstructured.RemoveNestedField(obj, fields...)
+		return nil
+	}
+	value := make([]any, len(tolerations))
+	for i := range tolerations {
+		toleration, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&tolerations[i])
+		if err != nil {
+			return err
+		}
+		value[i] = toleration
+	}
+	return unstructured.SetNestedSlice(obj, value, fields...)
+}
+
+// RemoveIfEmpty removes the map at the path of the object if it is empty.
+func RemoveIfEmpty(obj map[string]any, fields ...string) {
+	if value, found, _ := unstructured.NestedMap(obj, fields...); found && len(value) == 0 {
+		unstructured.RemoveNestedField(obj, fields...)
+	}
+}
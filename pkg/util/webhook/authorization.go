@@ -0,0 +1,66 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// ValidatePermission checks, with a SubjectAccessReview, that the user of the
+// admission request in the context is allowed to access the resource described
+// by attrs. The errors are reported for path.
+func ValidatePermission(ctx context.Context, c client.Client, attrs authorizationv1.ResourceAttributes, path *field.Path) field.ErrorList {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return field.ErrorList{field.InternalError(path, err)}
+	}
+	extra := make(map[string]authorizationv1.ExtraValue, len(req.UserInfo.Extra))
+	for k, v := range req.UserInfo.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:               req.UserInfo.Username,
+			UID:                req.UserInfo.UID,
+			Groups:             req.UserInfo.Groups,
+			Extra:              extra,
+			ResourceAttributes: &attrs,
+		},
+	}
+	if err := c.Create(ctx, sar); err != nil {
+		return field.ErrorList{field.InternalError(path, fmt.Errorf("checking the permission to %s: %w", describe(attrs), err))}
+	}
+	if !sar.Status.Allowed {
+		return field.ErrorList{field.Forbidden(path, fmt.Sprintf("user %q cannot %s", req.UserInfo.Username, describe(attrs)))}
+	}
+	return nil
+}
+
+// describe returns a description of the access, like "update workloads/hold in
+// namespace default".
+func describe(attrs authorizationv1.ResourceAttributes) string {
+	resource := attrs.Resource
+	if attrs.Subresource != "" {
+		resource += "/" + attrs.Subresource
+	}
+	return fmt.Sprintf("%s %s in namespace %q", attrs.Verb, resource, attrs.Namespace)
+}
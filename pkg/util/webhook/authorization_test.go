@@ -0,0 +1,129 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestValidatePermission(t *testing.T) {
+	path := field.NewPath("spec", "held")
+	attrs := authorizationv1.ResourceAttributes{
+		Namespace:   "ns",
+		Verb:        "update",
+		Group:       "kueue.x-k8s.io",
+		Resource:    "workloads",
+		Subresource: "hold",
+		Name:        "wl",
+	}
+	testCases := map[string]struct {
+		request   *admission.Request
+		createErr error
+		wantSpec  *authorizationv1.SubjectAccessReviewSpec
+		wantErr   field.ErrorList
+	}{
+		"allowed user": {
+			request: &admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{
+						Username: "admin",
+						UID:      "uid",
+						Groups:   []string{"admins"},
+						Extra:    map[string]authenticationv1.ExtraValue{"scopes": {"all"}},
+					},
+				},
+			},
+			wantSpec: &authorizationv1.SubjectAccessReviewSpec{
+				User:               "admin",
+				UID:                "uid",
+				Groups:             []string{"admins"},
+				Extra:              map[string]authorizationv1.ExtraValue{"scopes": {"all"}},
+				ResourceAttributes: &attrs,
+			},
+		},
+		"forbidden user": {
+			request: &admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "user"},
+				},
+			},
+			wantSpec: &authorizationv1.SubjectAccessReviewSpec{
+				User:               "user",
+				Extra:              map[string]authorizationv1.ExtraValue{},
+				ResourceAttributes: &attrs,
+			},
+			wantErr: field.ErrorList{field.Forbidden(path, `user "user" cannot update workloads/hold in namespace "ns"`)},
+		},
+		"failed review": {
+			request: &admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "admin"},
+				},
+			},
+			createErr: errors.New("unavailable"),
+			wantSpec: &authorizationv1.SubjectAccessReviewSpec{
+				User:               "admin",
+				Extra:              map[string]authorizationv1.ExtraValue{},
+				ResourceAttributes: &attrs,
+			},
+			wantErr: field.ErrorList{field.InternalError(path, errors.New(`checking the permission to update workloads/hold in namespace "ns": unavailable`))},
+		},
+		"no admission request": {
+			wantErr: field.ErrorList{field.InternalError(path, errors.New("admission.Request not found in context"))},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var gotSpec *authorizationv1.SubjectAccessReviewSpec
+			cl := utiltesting.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+					sar := obj.(*authorizationv1.SubjectAccessReview)
+					gotSpec = sar.Spec.DeepCopy()
+					if tc.createErr != nil {
+						return tc.createErr
+					}
+					sar.Status.Allowed = sar.Spec.User == "admin"
+					return nil
+				},
+			}).Build()
+			ctx := t.Context()
+			if tc.request != nil {
+				ctx = admission.NewContextWithRequest(ctx, *tc.request)
+			}
+			gotErr := ValidatePermission(ctx, cl, attrs, path)
+			if diff := cmp.Diff(tc.wantSpec, gotSpec); diff != "" {
+				t.Errorf("Unexpected SubjectAccessReview spec (-want,+got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.IgnoreFields(field.Error{}, "BadValue")); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
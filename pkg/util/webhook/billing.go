@@ -0,0 +1,66 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
+)
+
+var billingNamespacePath = field.NewPath("metadata", "annotations").Key(constants.BillingNamespaceAnnotation)
+
+// ValidateBillingNamespacePermission checks that the user creating the object
+// is allowed to create workloads in the billing namespace of the object, so
+// that the quota of a namespace can only be used by the users who could
+// already use it.
+func ValidateBillingNamespacePermission(ctx context.Context, c client.Client, obj client.Object) field.ErrorList {
+	ns, found := obj.GetAnnotations()[constants.BillingNamespaceAnnotation]
+	if !found || !features.Enabled(features.CrossNamespaceBilling) {
+		return nil
+	}
+	if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+		return field.ErrorList{field.Invalid(billingNamespacePath, ns, strings.Join(errs, ","))}
+	}
+	if ns == obj.GetNamespace() {
+		return nil
+	}
+	return ValidatePermission(ctx, c, authorizationv1.ResourceAttributes{
+		Namespace: ns,
+		Verb:      "create",
+		Group:     kueue.GroupVersion.Group,
+		Resource:  "workloads",
+	}, billingNamespacePath)
+}
+
+// ValidateUpdateForBillingNamespace checks that the billing namespace of the
+// object is not changed.
+func ValidateUpdateForBillingNamespace(oldObj, newObj client.Object) field.ErrorList {
+	if !features.Enabled(features.CrossNamespaceBilling) {
+		return nil
+	}
+	return apivalidation.ValidateImmutableField(newObj.GetAnnotations()[constants.BillingNamespaceAnnotation], oldObj.GetAnnotations()[constants.BillingNamespaceAnnotation], billingNamespacePath)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
+)
+
+// ApplyDefaultSubmitter records the user creating the object in its
+// SubmitterAnnotation, replacing the value set by the user, and keeps the
+// annotation unchanged on the updates of the object, so that the submitter
+// can't be forged.
+func ApplyDefaultSubmitter(ctx context.Context, obj client.Object) error {
+	if !features.Enabled(features.WorkloadSubmitterIdentity) {
+		return nil
+	}
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return nil
+	}
+	var submitter string
+	switch req.Operation {
+	case admissionv1.Create:
+		submitter = req.UserInfo.Username
+	case admissionv1.Update:
+		oldObj := &metav1.PartialObjectMetadata{}
+		if err := json.Unmarshal(req.OldObject.Raw, oldObj); err != nil {
+			return fmt.Errorf("decoding the previous object: %w", err)
+		}
+		submitter = oldObj.Annotations[constants.SubmitterAnnotation]
+	default:
+		return nil
+	}
+	annotations := obj.GetAnnotations()
+	if submitter == "" {
+		delete(annotations, constants.SubmitterAnnotation)
+	} else {
+		if annotations == nil {
+			annotations = make(map[string]string, 1)
+		}
+		annotations[constants.SubmitterAnnotation] = submitter
+	}
+	obj.SetAnnotations(annotations)
+	return nil
+}
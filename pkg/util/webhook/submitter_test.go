@@ -0,0 +1,96 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"sigs.k8s.io/kueue/pkg/controller/constants"
+	"sigs.k8s.io/kueue/pkg/features"
+	utiltestingjob "sigs.k8s.io/kueue/pkg/util/testingjobs/job"
+)
+
+func TestApplyDefaultSubmitter(t *testing.T) {
+	cases := map[string]struct {
+		operation       admissionv1.Operation
+		user            string
+		oldObj          client.Object
+		obj             client.Object
+		wantAnnotations map[string]string
+	}{
+		"the creator of the job is recorded": {
+			operation:       admissionv1.Create,
+			user:            "alice",
+			obj:             utiltestingjob.MakeJob("job", metav1.NamespaceDefault).Obj(),
+			wantAnnotations: map[string]string{constants.SubmitterAnnotation: "alice"},
+		},
+		"the submitter set by the creator of the job is replaced": {
+			operation:       admissionv1.Create,
+			user:            "alice",
+			obj:             utiltestingjob.MakeJob("job", metav1.NamespaceDefault).SetAnnotation(constants.SubmitterAnnotation, "bob").Obj(),
+			wantAnnotations: map[string]string{constants.SubmitterAnnotation: "alice"},
+		},
+		"the submitter is kept on the updates of the job": {
+			operation:       admissionv1.Update,
+			user:            "bob",
+			oldObj:          utiltestingjob.MakeJob("job", metav1.NamespaceDefault).SetAnnotation(constants.SubmitterAnnotation, "alice").Obj(),
+			obj:             utiltestingjob.MakeJob("job", metav1.NamespaceDefault).SetAnnotation(constants.SubmitterAnnotation, "bob").Obj(),
+			wantAnnotations: map[string]string{constants.SubmitterAnnotation: "alice"},
+		},
+		"the submitter isn't added on the updates of the job": {
+			operation: admissionv1.Update,
+			user:      "bob",
+			oldObj:    utiltestingjob.MakeJob("job", metav1.NamespaceDefault).Obj(),
+			obj:       utiltestingjob.MakeJob("job", metav1.NamespaceDefault).SetAnnotation(constants.SubmitterAnnotation, "bob").Obj(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkloadSubmitterIdentity, true)
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: tc.operation,
+					UserInfo:  authenticationv1.UserInfo{Username: tc.user},
+				},
+			}
+			if tc.oldObj != nil {
+				raw, err := json.Marshal(tc.oldObj)
+				if err != nil {
+					t.Fatalf("Failed to encode the previous object: %v", err)
+				}
+				req.OldObject.Raw = raw
+			}
+			ctx := admission.NewContextWithRequest(t.Context(), req)
+			if err := ApplyDefaultSubmitter(ctx, tc.obj); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantAnnotations, tc.obj.GetAnnotations(), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected annotations (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta1"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/util/priority"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+type dispatchedWorkloadsREST struct {
+	cache *schdcache.Cache
+}
+
+var _ rest.Storage = &dispatchedWorkloadsREST{}
+var _ rest.Getter = &dispatchedWorkloadsREST{}
+var _ rest.Scoper = &dispatchedWorkloadsREST{}
+
+func NewDispatchedWorkloadsREST(cache *schdcache.Cache) *dispatchedWorkloadsREST {
+	return &dispatchedWorkloadsREST{
+		cache: cache,
+	}
+}
+
+// New implements rest.Storage interface
+func (m *dispatchedWorkloadsREST) New() runtime.Object {
+	return &visibility.DispatchedWorkloadsSummary{}
+}
+
+// Destroy implements rest.Storage interface
+func (m *dispatchedWorkloadsREST) Destroy() {}
+
+// Get implements rest.Getter interface
+// It lists the workloads dispatched by MultiKueue to the worker cluster
+func (m *dispatchedWorkloadsREST) Get(_ context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	dispatchedWorkloadsInfo := m.cache.DispatchedWorkloads(name)
+	summary := &visibility.DispatchedWorkloadsSummary{
+		Items: make([]visibility.DispatchedWorkload, 0, len(dispatchedWorkloadsInfo)),
+	}
+	for _, wlInfo := range dispatchedWorkloadsInfo {
+		status := visibility.AdmissionStatusQuotaReserved
+		if workload.IsAdmitted(wlInfo.Obj) {
+			status = visibility.AdmissionStatusAdmitted
+		}
+		summary.Items = append(summary.Items, visibility.DispatchedWorkload{
+			ObjectMeta:     newWorkloadObjectMeta(wlInfo.Obj),
+			Priority:       priority.Priority(wlInfo.Obj),
+			LocalQueueName: wlInfo.Obj.Spec.QueueName,
+			ClusterQueue:   wlInfo.ClusterQueue,
+			Status:         status,
+		})
+	}
+	return summary, nil
+}
+
+// NamespaceScoped implements rest.Scoper interface
+func (m *dispatchedWorkloadsREST) NamespaceScoped() bool {
+	return false
+}
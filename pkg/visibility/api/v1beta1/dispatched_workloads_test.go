@@ -0,0 +1,126 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta1"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestDispatchedWorkloads(t *testing.T) {
+	const (
+		nsNameA = "ns-a"
+		nsNameB = "ns-b"
+		cqName  = "cq"
+		lqName  = "lq"
+	)
+
+	admission := utiltesting.MakeAdmission(cqName).
+		PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "default", "1").Obj()).
+		Obj()
+	workloads := []*kueue.Workload{
+		utiltesting.MakeWorkload("admitted", nsNameB).
+			Queue(lqName).
+			Priority(100).
+			Request(corev1.ResourceCPU, "1").
+			ReserveQuota(admission).
+			Admitted(true).
+			ClusterName("worker1").
+			Obj(),
+		utiltesting.MakeWorkload("reserved", nsNameA).
+			Queue(lqName).
+			Request(corev1.ResourceCPU, "1").
+			ReserveQuota(admission).
+			ClusterName("worker1").
+			Obj(),
+		utiltesting.MakeWorkload("other-worker", nsNameA).
+			Queue(lqName).
+			Request(corev1.ResourceCPU, "1").
+			ReserveQuota(admission).
+			Admitted(true).
+			ClusterName("worker2").
+			Obj(),
+		utiltesting.MakeWorkload("nominated", nsNameA).
+			Queue(lqName).
+			Request(corev1.ResourceCPU, "1").
+			ReserveQuota(admission).
+			NominatedClusterNames("worker1", "worker2").
+			Obj(),
+	}
+
+	cases := map[string]struct {
+		clusterName string
+		want        []visibility.DispatchedWorkload
+	}{
+		"workloads dispatched to the worker cluster": {
+			clusterName: "worker1",
+			want: []visibility.DispatchedWorkload{
+				{
+					ObjectMeta:     metav1.ObjectMeta{Name: "reserved", Namespace: nsNameA},
+					LocalQueueName: lqName,
+					ClusterQueue:   cqName,
+					Status:         visibility.AdmissionStatusQuotaReserved,
+				},
+				{
+					ObjectMeta:     metav1.ObjectMeta{Name: "admitted", Namespace: nsNameB},
+					Priority:       100,
+					LocalQueueName: lqName,
+					ClusterQueue:   cqName,
+					Status:         visibility.AdmissionStatusAdmitted,
+				},
+			},
+		},
+		"no workload dispatched to the worker cluster": {
+			clusterName: "worker3",
+			want:        []visibility.DispatchedWorkload{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+			cache := schdcache.New(utiltesting.NewFakeClient())
+			cq := utiltesting.MakeClusterQueue(cqName).
+				ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "10").Obj()).
+				Obj()
+			if err := cache.AddClusterQueue(ctx, cq); err != nil {
+				t.Fatalf("Failed to add the ClusterQueue: %v", err)
+			}
+			for _, wl := range workloads {
+				cache.AddOrUpdateWorkload(log, wl)
+			}
+
+			dispatchedWorkloadsRest := NewDispatchedWorkloadsREST(cache)
+			got, err := dispatchedWorkloadsRest.Get(ctx, tc.clusterName, &metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			summary := got.(*visibility.DispatchedWorkloadsSummary)
+			if diff := cmp.Diff(tc.want, summary.Items, cmpopts.EquateEmpty(), cmpopts.IgnoreFields(metav1.ObjectMeta{}, "CreationTimestamp")); diff != "" {
+				t.Errorf("Unexpected dispatched workloads (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
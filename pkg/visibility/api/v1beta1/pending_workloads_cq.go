@@ -30,6 +30,7 @@ import (
 	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta1"
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	"sigs.k8s.io/kueue/pkg/constants"
+	"sigs.k8s.io/kueue/pkg/workload"
 
 	_ "k8s.io/metrics/pkg/apis/metrics/install"
 )
@@ -65,30 +66,14 @@ func (m *pendingWorkloadsInCqREST) Get(_ context.Context, name string, opts runt
 	if !ok {
 		return nil, fmt.Errorf("invalid options object: %#v", opts)
 	}
-	limit := pendingWorkloadOpts.Limit
-	offset := pendingWorkloadOpts.Offset
-
-	wls := make([]visibility.PendingWorkload, 0, limit)
 	pendingWorkloadsInfo := m.queueMgr.PendingWorkloadsInfo(kueue.ClusterQueueReference(name))
 	if pendingWorkloadsInfo == nil {
 		return nil, errors.NewNotFound(visibility.Resource("clusterqueue"), name)
 	}
 
-	localQueuePositions := make(map[kueue.LocalQueueName]int32, 0)
-
-	for index := 0; index < int(offset+limit) && index < len(pendingWorkloadsInfo); index++ {
-		// Update positions in LocalQueue
-		wlInfo := pendingWorkloadsInfo[index]
-		queueName := wlInfo.Obj.Spec.QueueName
-		positionInLocalQueue := localQueuePositions[queueName]
-		localQueuePositions[queueName]++
-
-		if index >= int(offset) {
-			// Add a workload to results
-			wls = append(wls, *newPendingWorkload(wlInfo, positionInLocalQueue, index))
-		}
-	}
-	return &visibility.PendingWorkloadsSummary{Items: wls}, nil
+	return pendingWorkloadsSummary(pendingWorkloadsInfo, pendingWorkloadOpts, func(*workload.Info) bool {
+		return true
+	})
 }
 
 // NewGetOptions creates a new options object
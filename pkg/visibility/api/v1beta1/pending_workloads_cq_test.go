@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta1"
@@ -36,12 +38,13 @@ import (
 
 func TestPendingWorkloadsInCQ(t *testing.T) {
 	const (
-		nsName   = "foo"
-		cqNameA  = "cqA"
-		lqNameA  = "lqA"
-		lqNameB  = "lqB"
-		lowPrio  = 50
-		highPrio = 100
+		nsName      = "foo"
+		otherNsName = "bar"
+		cqNameA     = "cqA"
+		lqNameA     = "lqA"
+		lqNameB     = "lqB"
+		lowPrio     = 50
+		highPrio    = 100
 	)
 
 	var (
@@ -294,6 +297,127 @@ func TestPendingWorkloadsInCQ(t *testing.T) {
 					}},
 			},
 		},
+		"label selector query parameter set": {
+			clusterQueues: []*kueue.ClusterQueue{
+				utiltesting.MakeClusterQueue(cqNameA).Obj(),
+			},
+			queues: []*kueue.LocalQueue{
+				utiltesting.MakeLocalQueue(lqNameA, nsName).ClusterQueue(cqNameA).Obj(),
+			},
+			workloads: []*kueue.Workload{
+				utiltesting.MakeWorkload("a", nsName).Queue(lqNameA).Priority(highPrio).Creation(now).Label("team", "x").Obj(),
+				utiltesting.MakeWorkload("b", nsName).Queue(lqNameA).Priority(highPrio).Creation(now.Add(time.Second)).Label("team", "y").Obj(),
+				utiltesting.MakeWorkload("c", nsName).Queue(lqNameA).Priority(highPrio).Creation(now.Add(time.Second * 2)).Obj(),
+			},
+			req: &req{
+				queueName: cqNameA,
+				queryParams: &visibility.PendingWorkloadOptions{
+					Limit:         constants.DefaultPendingWorkloadsLimit,
+					LabelSelector: "team=y",
+				},
+			},
+			wantResp: &resp{
+				wantPendingWorkloads: []visibility.PendingWorkload{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              "b",
+							Namespace:         nsName,
+							CreationTimestamp: metav1.NewTime(now.Add(time.Second)),
+						},
+						LocalQueueName:         lqNameA,
+						Priority:               highPrio,
+						PositionInClusterQueue: 1,
+						PositionInLocalQueue:   1,
+					}},
+			},
+		},
+		"invalid label selector query parameter": {
+			clusterQueues: []*kueue.ClusterQueue{
+				utiltesting.MakeClusterQueue(cqNameA).Obj(),
+			},
+			req: &req{
+				queueName: cqNameA,
+				queryParams: &visibility.PendingWorkloadOptions{
+					Limit:         constants.DefaultPendingWorkloadsLimit,
+					LabelSelector: "team in (",
+				},
+			},
+			wantResp: &resp{
+				wantErr: errors.NewBadRequest("invalid labelSelector"),
+			},
+			wantErrMatch: errors.IsBadRequest,
+		},
+		"namespace and min priority query parameters set": {
+			clusterQueues: []*kueue.ClusterQueue{
+				utiltesting.MakeClusterQueue(cqNameA).Obj(),
+			},
+			queues: []*kueue.LocalQueue{
+				utiltesting.MakeLocalQueue(lqNameA, nsName).ClusterQueue(cqNameA).Obj(),
+				utiltesting.MakeLocalQueue(lqNameB, otherNsName).ClusterQueue(cqNameA).Obj(),
+			},
+			workloads: []*kueue.Workload{
+				utiltesting.MakeWorkload("a", otherNsName).Queue(lqNameB).Priority(highPrio).Creation(now).Obj(),
+				utiltesting.MakeWorkload("b", nsName).Queue(lqNameA).Priority(highPrio).Creation(now.Add(time.Second)).Obj(),
+				utiltesting.MakeWorkload("c", nsName).Queue(lqNameA).Priority(highPrio).Creation(now.Add(time.Second * 2)).Obj(),
+				utiltesting.MakeWorkload("d", nsName).Queue(lqNameA).Priority(lowPrio).Creation(now).Obj(),
+			},
+			req: &req{
+				queueName: cqNameA,
+				queryParams: &visibility.PendingWorkloadOptions{
+					Offset:      1,
+					Limit:       constants.DefaultPendingWorkloadsLimit,
+					Namespace:   nsName,
+					MinPriority: ptr.To[int64](highPrio),
+				},
+			},
+			wantResp: &resp{
+				wantPendingWorkloads: []visibility.PendingWorkload{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              "c",
+							Namespace:         nsName,
+							CreationTimestamp: metav1.NewTime(now.Add(time.Second * 2)),
+						},
+						LocalQueueName:         lqNameA,
+						Priority:               highPrio,
+						PositionInClusterQueue: 2,
+						PositionInLocalQueue:   1,
+					}},
+			},
+		},
+		"offset and continue query parameters set": {
+			clusterQueues: []*kueue.ClusterQueue{
+				utiltesting.MakeClusterQueue(cqNameA).Obj(),
+			},
+			req: &req{
+				queueName: cqNameA,
+				queryParams: &visibility.PendingWorkloadOptions{
+					Offset:   1,
+					Limit:    constants.DefaultPendingWorkloadsLimit,
+					Continue: "token",
+				},
+			},
+			wantResp: &resp{
+				wantErr: errors.NewBadRequest("offset cannot be used together with continue"),
+			},
+			wantErrMatch: errors.IsBadRequest,
+		},
+		"invalid continue query parameter": {
+			clusterQueues: []*kueue.ClusterQueue{
+				utiltesting.MakeClusterQueue(cqNameA).Obj(),
+			},
+			req: &req{
+				queueName: cqNameA,
+				queryParams: &visibility.PendingWorkloadOptions{
+					Limit:    constants.DefaultPendingWorkloadsLimit,
+					Continue: "not a token",
+				},
+			},
+			wantResp: &resp{
+				wantErr: errors.NewBadRequest("invalid continue token"),
+			},
+			wantErrMatch: errors.IsBadRequest,
+		},
 		"empty cluster queue": {
 			clusterQueues: []*kueue.ClusterQueue{
 				utiltesting.MakeClusterQueue(cqNameA).Obj(),
@@ -356,3 +480,80 @@ func TestPendingWorkloadsInCQ(t *testing.T) {
 		})
 	}
 }
+
+func TestPendingWorkloadsInCQContinue(t *testing.T) {
+	const (
+		nsName  = "foo"
+		cqName  = "cq"
+		lqName  = "lq"
+		wlCount = 5
+	)
+
+	now := time.Now()
+	manager := qcache.NewManager(utiltesting.NewFakeClient(), nil)
+	ctx, _ := utiltesting.ContextWithLog(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go manager.CleanUpOnContext(ctx)
+	pendingWorkloadsInCqRest := NewPendingWorkloadsInCqREST(manager)
+	if err := manager.AddClusterQueue(ctx, utiltesting.MakeClusterQueue(cqName).Obj()); err != nil {
+		t.Fatalf("Adding cluster queue %s: %v", cqName, err)
+	}
+	if err := manager.AddLocalQueue(ctx, utiltesting.MakeLocalQueue(lqName, nsName).ClusterQueue(cqName).Obj()); err != nil {
+		t.Fatalf("Adding queue %q: %v", lqName, err)
+	}
+	workloads := make([]*kueue.Workload, 0, wlCount)
+	for i := range wlCount {
+		wl := utiltesting.MakeWorkload(fmt.Sprintf("wl-%d", i), nsName).Queue(lqName).Priority(0).Creation(now.Add(time.Duration(i) * time.Second)).Obj()
+		if err := manager.AddOrUpdateWorkload(wl); err != nil {
+			t.Fatalf("Failed to add or update workload %q: %v", wl.Name, err)
+		}
+		workloads = append(workloads, wl)
+	}
+
+	getPage := func(continueToken string) *visibility.PendingWorkloadsSummary {
+		t.Helper()
+		info, err := pendingWorkloadsInCqRest.Get(ctx, cqName, &visibility.PendingWorkloadOptions{
+			Limit:    2,
+			Continue: continueToken,
+		})
+		if err != nil {
+			t.Fatalf("Getting pending workloads: %v", err)
+		}
+		return info.(*visibility.PendingWorkloadsSummary)
+	}
+	names := func(summary *visibility.PendingWorkloadsSummary) []string {
+		result := make([]string, 0, len(summary.Items))
+		for _, wl := range summary.Items {
+			result = append(result, wl.Name)
+		}
+		return result
+	}
+
+	page := getPage("")
+	if diff := cmp.Diff([]string{"wl-0", "wl-1"}, names(page)); diff != "" {
+		t.Errorf("Unexpected first page (-want,+got):\n%s", diff)
+	}
+	if page.Continue == "" {
+		t.Fatal("Expected a continue token for the first page")
+	}
+
+	// The next page starts at the position of the last workload of the
+	// previous page when it is no longer pending.
+	manager.DeleteWorkload(workloads[1])
+	page = getPage(page.Continue)
+	if diff := cmp.Diff([]string{"wl-2", "wl-3"}, names(page)); diff != "" {
+		t.Errorf("Unexpected second page (-want,+got):\n%s", diff)
+	}
+	if page.Continue == "" {
+		t.Fatal("Expected a continue token for the second page")
+	}
+
+	page = getPage(page.Continue)
+	if diff := cmp.Diff([]string{"wl-4"}, names(page)); diff != "" {
+		t.Errorf("Unexpected last page (-want,+got):\n%s", diff)
+	}
+	if page.Continue != "" {
+		t.Errorf("Unexpected continue token for the last page: %q", page.Continue)
+	}
+}
@@ -32,6 +32,7 @@ import (
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
 	"sigs.k8s.io/kueue/pkg/constants"
 	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	"sigs.k8s.io/kueue/pkg/workload"
 
 	_ "k8s.io/metrics/pkg/apis/metrics/install"
 )
@@ -67,8 +68,6 @@ func (m *pendingWorkloadsInLqREST) Get(ctx context.Context, name string, opts ru
 	if !ok {
 		return nil, fmt.Errorf("invalid options object: %#v", opts)
 	}
-	limit := pendingWorkloadOpts.Limit
-	offset := pendingWorkloadOpts.Offset
 
 	namespace := genericapirequest.NamespaceValue(ctx)
 	lqName := kueue.LocalQueueName(name)
@@ -77,23 +76,12 @@ func (m *pendingWorkloadsInLqREST) Get(ctx context.Context, name string, opts ru
 		return nil, errors.NewNotFound(visibility.Resource("localqueue"), name)
 	}
 
-	wls := make([]visibility.PendingWorkload, 0, limit)
-	skippedWls := 0
-	for index, wlInfo := range m.queueMgr.PendingWorkloadsInfo(cqName) {
-		if len(wls) >= int(limit) {
-			break
-		}
-		if wlInfo.Obj.Spec.QueueName == lqName {
-			if skippedWls < int(offset) {
-				skippedWls++
-			} else {
-				// Add a workload to results
-				wls = append(wls, *newPendingWorkload(wlInfo, int32(len(wls)+int(offset)), index))
-			}
-		}
-	}
-
-	return &visibility.PendingWorkloadsSummary{Items: wls}, nil
+	// The namespace filter doesn't apply to the workloads of a LocalQueue.
+	lqOpts := pendingWorkloadOpts.DeepCopy()
+	lqOpts.Namespace = ""
+	return pendingWorkloadsSummary(m.queueMgr.PendingWorkloadsInfo(cqName), lqOpts, func(wlInfo *workload.Info) bool {
+		return wlInfo.Obj.Spec.QueueName == lqName
+	})
 }
 
 // NewGetOptions creates a new options object
@@ -20,13 +20,18 @@ import (
 	"k8s.io/apiserver/pkg/registry/rest"
 
 	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
 )
 
-func NewStorage(mgr *qcache.Manager) map[string]rest.Storage {
+func NewStorage(mgr *qcache.Manager, cache *schdcache.Cache) map[string]rest.Storage {
 	return map[string]rest.Storage{
-		"clusterqueues":                  NewCqREST(),
-		"clusterqueues/pendingworkloads": NewPendingWorkloadsInCqREST(mgr),
-		"localqueues":                    NewLqREST(),
-		"localqueues/pendingworkloads":   NewPendingWorkloadsInLqREST(mgr),
+		"clusterqueues":                          NewCqREST(),
+		"clusterqueues/pendingworkloads":         NewPendingWorkloadsInCqREST(mgr),
+		"localqueues":                            NewLqREST(),
+		"localqueues/pendingworkloads":           NewPendingWorkloadsInLqREST(mgr),
+		"multikueueclusters":                     NewMkcREST(),
+		"multikueueclusters/dispatchedworkloads": NewDispatchedWorkloadsREST(cache),
+		"workloads":                              NewWlREST(),
+		"workloads/explanation":                  NewWorkloadExplanationREST(mgr, cache),
 	}
 }
@@ -17,15 +17,146 @@ limitations under the License.
 package v1beta1
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta1"
+	"sigs.k8s.io/kueue/pkg/util/priority"
 	"sigs.k8s.io/kueue/pkg/workload"
 )
 
+// continueToken identifies where the next page of the pending workloads starts.
+type continueToken struct {
+	// Workload is the key of the last workload of the previous page.
+	Workload workload.Reference `json:"workload"`
+	// Offset is the position of the last workload of the previous page among the
+	// matching workloads, used when that workload is no longer pending.
+	Offset int64 `json:"offset"`
+}
+
+func encodeContinueToken(token continueToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeContinueToken(value string) (*continueToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	token := &continueToken{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+type pendingWorkloadCandidate struct {
+	info         *workload.Info
+	positionInLq int32
+	positionInCq int
+}
+
+// pendingWorkloadsSummary returns the page, specified by the options, of the pending
+// workloads of the ClusterQueue that are in the queue and match the filters of the options.
+func pendingWorkloadsSummary(pendingWorkloadsInfo []*workload.Info, opts *visibility.PendingWorkloadOptions, inQueue func(*workload.Info) bool) (*visibility.PendingWorkloadsSummary, error) {
+	if opts.Continue != "" && opts.Offset > 0 {
+		return nil, errors.NewBadRequest("offset cannot be used together with continue")
+	}
+	selector := labels.Everything()
+	if opts.LabelSelector != "" {
+		var err error
+		selector, err = labels.Parse(opts.LabelSelector)
+		if err != nil {
+			return nil, errors.NewBadRequest(fmt.Sprintf("invalid labelSelector: %v", err))
+		}
+	}
+
+	localQueuePositions := make(map[kueue.LocalQueueName]int32, 0)
+	candidates := make([]pendingWorkloadCandidate, 0)
+	for index, wlInfo := range pendingWorkloadsInfo {
+		if !inQueue(wlInfo) {
+			continue
+		}
+		// Update positions in LocalQueue
+		queueName := wlInfo.Obj.Spec.QueueName
+		positionInLocalQueue := localQueuePositions[queueName]
+		localQueuePositions[queueName]++
+
+		if opts.Namespace != "" && wlInfo.Obj.Namespace != opts.Namespace {
+			continue
+		}
+		if opts.MinPriority != nil && int64(priority.Priority(wlInfo.Obj)) < *opts.MinPriority {
+			continue
+		}
+		if !selector.Matches(labels.Set(wlInfo.Obj.Labels)) {
+			continue
+		}
+		candidates = append(candidates, pendingWorkloadCandidate{
+			info:         wlInfo,
+			positionInLq: positionInLocalQueue,
+			positionInCq: index,
+		})
+	}
+
+	start := opts.Offset
+	if opts.Continue != "" {
+		token, err := decodeContinueToken(opts.Continue)
+		if err != nil {
+			return nil, errors.NewBadRequest(fmt.Sprintf("invalid continue token: %v", err))
+		}
+		start = token.Offset
+		for index, candidate := range candidates {
+			if workload.Key(candidate.info.Obj) == token.Workload {
+				start = int64(index) + 1
+				break
+			}
+		}
+	}
+	start = min(max(start, 0), int64(len(candidates)))
+	end := min(start+max(opts.Limit, 0), int64(len(candidates)))
+
+	wls := make([]visibility.PendingWorkload, 0, end-start)
+	for _, candidate := range candidates[start:end] {
+		// Add a workload to results
+		wls = append(wls, *newPendingWorkload(candidate.info, candidate.positionInLq, candidate.positionInCq))
+	}
+	summary := &visibility.PendingWorkloadsSummary{Items: wls}
+	if end < int64(len(candidates)) && end > start {
+		token, err := encodeContinueToken(continueToken{
+			Workload: workload.Key(candidates[end-1].info.Obj),
+			Offset:   end - 1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		summary.Continue = token
+	}
+	return summary, nil
+}
+
 func newPendingWorkload(wlInfo *workload.Info, positionInLq int32, positionInCq int) *visibility.PendingWorkload {
-	ownerReferences := make([]metav1.OwnerReference, 0, len(wlInfo.Obj.OwnerReferences))
-	for _, ref := range wlInfo.Obj.OwnerReferences {
+	return &visibility.PendingWorkload{
+		ObjectMeta:             newWorkloadObjectMeta(wlInfo.Obj),
+		PositionInClusterQueue: int32(positionInCq),
+		Priority:               *wlInfo.Obj.Spec.Priority,
+		LocalQueueName:         wlInfo.Obj.Spec.QueueName,
+		PositionInLocalQueue:   positionInLq,
+	}
+}
+
+func newWorkloadObjectMeta(wl *kueue.Workload) metav1.ObjectMeta {
+	ownerReferences := make([]metav1.OwnerReference, 0, len(wl.OwnerReferences))
+	for _, ref := range wl.OwnerReferences {
 		ownerReferences = append(ownerReferences, metav1.OwnerReference{
 			APIVersion: ref.APIVersion,
 			Kind:       ref.Kind,
@@ -33,16 +164,10 @@ func newPendingWorkload(wlInfo *workload.Info, positionInLq int32, positionInCq
 			UID:        ref.UID,
 		})
 	}
-	return &visibility.PendingWorkload{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:              wlInfo.Obj.Name,
-			Namespace:         wlInfo.Obj.Namespace,
-			OwnerReferences:   ownerReferences,
-			CreationTimestamp: wlInfo.Obj.CreationTimestamp,
-		},
-		PositionInClusterQueue: int32(positionInCq),
-		Priority:               *wlInfo.Obj.Spec.Priority,
-		LocalQueueName:         wlInfo.Obj.Spec.QueueName,
-		PositionInLocalQueue:   positionInLq,
+	return metav1.ObjectMeta{
+		Name:              wl.Name,
+		Namespace:         wl.Namespace,
+		OwnerReferences:   ownerReferences,
+		CreationTimestamp: wl.CreationTimestamp,
 	}
 }
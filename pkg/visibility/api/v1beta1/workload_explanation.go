@@ -0,0 +1,186 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta1"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	"sigs.k8s.io/kueue/pkg/resources"
+	utilqueue "sigs.k8s.io/kueue/pkg/util/queue"
+	"sigs.k8s.io/kueue/pkg/workload"
+)
+
+// maxWorkloadsAhead is the maximum number of workloads ahead listed in the explanation.
+const maxWorkloadsAhead = 10
+
+type workloadExplanationREST struct {
+	queueMgr *qcache.Manager
+	cache    *schdcache.Cache
+	log      logr.Logger
+}
+
+var _ rest.Storage = &workloadExplanationREST{}
+var _ rest.Getter = &workloadExplanationREST{}
+var _ rest.Scoper = &workloadExplanationREST{}
+
+func NewWorkloadExplanationREST(kueueMgr *qcache.Manager, cache *schdcache.Cache) *workloadExplanationREST {
+	return &workloadExplanationREST{
+		queueMgr: kueueMgr,
+		cache:    cache,
+		log:      ctrl.Log.WithName("workload-explanation"),
+	}
+}
+
+// New implements rest.Storage interface
+func (m *workloadExplanationREST) New() runtime.Object {
+	return &visibility.AdmissionExplanation{}
+}
+
+// Destroy implements rest.Storage interface
+func (m *workloadExplanationREST) Destroy() {}
+
+// Get implements rest.Getter interface
+// It explains why the workload is not admitted yet
+func (m *workloadExplanationREST) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	namespace := genericapirequest.NamespaceValue(ctx)
+	key := workload.NewReference(namespace, name)
+	explanation := &visibility.AdmissionExplanation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+
+	if wlInfo, ok := m.cache.WorkloadWithQuota(key); ok {
+		explanation.Status = visibility.AdmissionStatusQuotaReserved
+		if workload.IsAdmitted(wlInfo.Obj) {
+			explanation.Status = visibility.AdmissionStatusAdmitted
+		}
+		if err := m.explainWorkload(explanation, wlInfo, wlInfo.Obj.Status.Admission.ClusterQueue); err != nil {
+			return nil, err
+		}
+		return explanation, nil
+	}
+
+	wlInfo, ok := m.queueMgr.PendingWorkload(key)
+	if !ok {
+		return nil, apierrors.NewNotFound(visibility.Resource("workload"), name)
+	}
+	explanation.Status = visibility.AdmissionStatusPending
+	cqName, _ := m.queueMgr.ClusterQueueFromLocalQueue(utilqueue.KeyFromWorkload(wlInfo.Obj))
+	if err := m.explainWorkload(explanation, wlInfo, cqName); err != nil {
+		return nil, err
+	}
+	if cqName != "" {
+		explainPosition(explanation, m.queueMgr.PendingWorkloadsInfo(cqName), key)
+	}
+	return explanation, nil
+}
+
+// explainWorkload fills the explanation with the state of the workload and of the
+// quota of its ClusterQueue.
+func (m *workloadExplanationREST) explainWorkload(explanation *visibility.AdmissionExplanation, wlInfo *workload.Info, cqName kueue.ClusterQueueReference) error {
+	explanation.LocalQueueName = wlInfo.Obj.Spec.QueueName
+	explanation.ClusterQueue = cqName
+	if cond := apimeta.FindStatusCondition(wlInfo.Obj.Status.Conditions, kueue.WorkloadQuotaReserved); cond != nil && cond.Status == metav1.ConditionFalse {
+		explanation.Message = cond.Message
+	}
+	for _, check := range wlInfo.Obj.Status.AdmissionChecks {
+		if check.State != kueue.CheckStateReady {
+			explanation.PendingAdmissionChecks = append(explanation.PendingAdmissionChecks, check)
+		}
+	}
+	if cqName == "" {
+		return nil
+	}
+
+	requests := make(resources.Requests)
+	for _, ps := range wlInfo.TotalRequests {
+		requests.Add(ps.Requests)
+	}
+	var rNames []corev1.ResourceName
+	if explanation.Status == visibility.AdmissionStatusPending {
+		rNames = slices.Sorted(maps.Keys(requests))
+	}
+	cohort, quotas, err := m.cache.ClusterQueueQuotas(cqName, rNames)
+	if err != nil {
+		if errors.Is(err, schdcache.ErrCqNotFound) {
+			return nil
+		}
+		return err
+	}
+	explanation.Cohort = cohort
+	for _, quota := range quotas {
+		requested := requests[quota.Resource]
+		explanation.FlavorShortfalls = append(explanation.FlavorShortfalls, visibility.FlavorShortfall{
+			Flavor:       quota.Flavor,
+			Resource:     quota.Resource,
+			Requested:    resources.ResourceQuantity(quota.Resource, requested),
+			NominalQuota: resources.ResourceQuantity(quota.Resource, quota.Nominal),
+			Usage:        resources.ResourceQuantity(quota.Resource, quota.Usage),
+			Available:    resources.ResourceQuantity(quota.Resource, quota.Available),
+			Shortfall:    resources.ResourceQuantity(quota.Resource, max(0, requested-quota.Available)),
+			Borrowing:    quota.Borrowing,
+		})
+	}
+	return nil
+}
+
+// explainPosition fills the explanation with the position of the workload in the
+// ClusterQueue and the pending workloads right ahead of it.
+func explainPosition(explanation *visibility.AdmissionExplanation, pendingWorkloadsInfo []*workload.Info, key workload.Reference) {
+	localQueuePositions := make(map[kueue.LocalQueueName]int32, 0)
+	ahead := make([]visibility.PendingWorkload, 0, maxWorkloadsAhead)
+	for index, wlInfo := range pendingWorkloadsInfo {
+		if workload.Key(wlInfo.Obj) == key {
+			explanation.PositionInClusterQueue = ptr.To(int32(index))
+			explanation.WorkloadsAheadCount = int32(index)
+			explanation.WorkloadsAhead = ahead
+			return
+		}
+		queueName := wlInfo.Obj.Spec.QueueName
+		positionInLocalQueue := localQueuePositions[queueName]
+		localQueuePositions[queueName]++
+		if len(ahead) == maxWorkloadsAhead {
+			ahead = slices.Delete(ahead, 0, 1)
+		}
+		ahead = append(ahead, *newPendingWorkload(wlInfo, positionInLocalQueue, index))
+	}
+}
+
+// NamespaceScoped implements rest.Scoper interface
+func (m *workloadExplanationREST) NamespaceScoped() bool {
+	return true
+}
@@ -0,0 +1,263 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	visibility "sigs.k8s.io/kueue/apis/visibility/v1beta1"
+	qcache "sigs.k8s.io/kueue/pkg/cache/queue"
+	schdcache "sigs.k8s.io/kueue/pkg/cache/scheduler"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestWorkloadExplanation(t *testing.T) {
+	const (
+		nsName   = "foo"
+		cqNameA  = "cqA"
+		cqNameB  = "cqB"
+		lqNameA  = "lqA"
+		cohort   = "team"
+		lowPrio  = 50
+		highPrio = 100
+	)
+
+	now := time.Now().Truncate(time.Second)
+	clusterQueues := []*kueue.ClusterQueue{
+		utiltesting.MakeClusterQueue(cqNameA).
+			Cohort(cohort).
+			ResourceGroup(
+				*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "4").Obj(),
+				*utiltesting.MakeFlavorQuotas("spot").Resource(corev1.ResourceCPU, "2").Obj(),
+			).
+			Obj(),
+		utiltesting.MakeClusterQueue(cqNameB).
+			Cohort(cohort).
+			ResourceGroup(*utiltesting.MakeFlavorQuotas("default").Resource(corev1.ResourceCPU, "2").Obj()).
+			Obj(),
+	}
+	pendingCheck := kueue.AdmissionCheckState{
+		Name:               "check",
+		State:              kueue.CheckStatePending,
+		LastTransitionTime: metav1.NewTime(now),
+		Message:            "Waiting for capacity",
+	}
+	running := utiltesting.MakeWorkload("running", nsName).
+		Queue(lqNameA).
+		Request(corev1.ResourceCPU, "5").
+		ReserveQuota(utiltesting.MakeAdmission(cqNameA).
+			PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "default", "5").Obj()).
+			Obj()).
+		Admitted(true).
+		Obj()
+	checking := utiltesting.MakeWorkload("checking", nsName).
+		Queue(lqNameA).
+		Request(corev1.ResourceCPU, "1").
+		ReserveQuota(utiltesting.MakeAdmission(cqNameA).
+			PodSets(utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "spot", "1").Obj()).
+			Obj()).
+		AdmissionCheck(pendingCheck).
+		Obj()
+	ahead := utiltesting.MakeWorkload("ahead", nsName).
+		Queue(lqNameA).
+		Priority(highPrio).
+		Creation(now).
+		Request(corev1.ResourceCPU, "1").
+		Obj()
+	pending := utiltesting.MakeWorkload("pending", nsName).
+		Queue(lqNameA).
+		Priority(lowPrio).
+		Creation(now).
+		Request(corev1.ResourceCPU, "3").
+		Condition(metav1.Condition{
+			Type:    kueue.WorkloadQuotaReserved,
+			Status:  metav1.ConditionFalse,
+			Reason:  "Pending",
+			Message: "couldn't assign flavors to pod set main: insufficient unused quota for cpu in flavor default",
+		}).
+		Obj()
+
+	cases := map[string]struct {
+		name            string
+		wantExplanation *visibility.AdmissionExplanation
+		wantErrMatch    func(error) bool
+	}{
+		"pending workload": {
+			name: "pending",
+			wantExplanation: &visibility.AdmissionExplanation{
+				ObjectMeta:             metav1.ObjectMeta{Name: "pending", Namespace: nsName},
+				LocalQueueName:         lqNameA,
+				ClusterQueue:           cqNameA,
+				Cohort:                 cohort,
+				Status:                 visibility.AdmissionStatusPending,
+				Message:                "couldn't assign flavors to pod set main: insufficient unused quota for cpu in flavor default",
+				PositionInClusterQueue: ptr.To[int32](1),
+				WorkloadsAheadCount:    1,
+				WorkloadsAhead: []visibility.PendingWorkload{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              "ahead",
+							Namespace:         nsName,
+							CreationTimestamp: metav1.NewTime(now),
+						},
+						LocalQueueName:         lqNameA,
+						Priority:               highPrio,
+						PositionInClusterQueue: 0,
+						PositionInLocalQueue:   0,
+					},
+				},
+				FlavorShortfalls: []visibility.FlavorShortfall{
+					{
+						Flavor:       "default",
+						Resource:     corev1.ResourceCPU,
+						Requested:    resource.MustParse("3"),
+						NominalQuota: resource.MustParse("4"),
+						Usage:        resource.MustParse("5"),
+						Available:    resource.MustParse("1"),
+						Shortfall:    resource.MustParse("2"),
+						Borrowing:    true,
+					},
+					{
+						Flavor:       "spot",
+						Resource:     corev1.ResourceCPU,
+						Requested:    resource.MustParse("3"),
+						NominalQuota: resource.MustParse("2"),
+						Usage:        resource.MustParse("1"),
+						Available:    resource.MustParse("1"),
+						Shortfall:    resource.MustParse("2"),
+					},
+				},
+			},
+		},
+		"workload at the head of the ClusterQueue": {
+			name: "ahead",
+			wantExplanation: &visibility.AdmissionExplanation{
+				ObjectMeta:             metav1.ObjectMeta{Name: "ahead", Namespace: nsName},
+				LocalQueueName:         lqNameA,
+				ClusterQueue:           cqNameA,
+				Cohort:                 cohort,
+				Status:                 visibility.AdmissionStatusPending,
+				PositionInClusterQueue: ptr.To[int32](0),
+				FlavorShortfalls: []visibility.FlavorShortfall{
+					{
+						Flavor:       "default",
+						Resource:     corev1.ResourceCPU,
+						Requested:    resource.MustParse("1"),
+						NominalQuota: resource.MustParse("4"),
+						Usage:        resource.MustParse("5"),
+						Available:    resource.MustParse("1"),
+						Shortfall:    resource.MustParse("0"),
+						Borrowing:    true,
+					},
+					{
+						Flavor:       "spot",
+						Resource:     corev1.ResourceCPU,
+						Requested:    resource.MustParse("1"),
+						NominalQuota: resource.MustParse("2"),
+						Usage:        resource.MustParse("1"),
+						Available:    resource.MustParse("1"),
+						Shortfall:    resource.MustParse("0"),
+					},
+				},
+			},
+		},
+		"workload waiting for admission checks": {
+			name: "checking",
+			wantExplanation: &visibility.AdmissionExplanation{
+				ObjectMeta:             metav1.ObjectMeta{Name: "checking", Namespace: nsName},
+				LocalQueueName:         lqNameA,
+				ClusterQueue:           cqNameA,
+				Cohort:                 cohort,
+				Status:                 visibility.AdmissionStatusQuotaReserved,
+				PendingAdmissionChecks: []kueue.AdmissionCheckState{pendingCheck},
+			},
+		},
+		"admitted workload": {
+			name: "running",
+			wantExplanation: &visibility.AdmissionExplanation{
+				ObjectMeta:     metav1.ObjectMeta{Name: "running", Namespace: nsName},
+				LocalQueueName: lqNameA,
+				ClusterQueue:   cqNameA,
+				Cohort:         cohort,
+				Status:         visibility.AdmissionStatusAdmitted,
+			},
+		},
+		"nonexistent workload": {
+			name:         "missing",
+			wantErrMatch: errors.IsNotFound,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx, log := utiltesting.ContextWithLog(t)
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			cache := schdcache.New(utiltesting.NewFakeClient())
+			manager := qcache.NewManager(utiltesting.NewFakeClient(), nil)
+			go manager.CleanUpOnContext(ctx)
+			workloadExplanationRest := NewWorkloadExplanationREST(manager, cache)
+			for _, rf := range []string{"default", "spot"} {
+				cache.AddOrUpdateResourceFlavor(log, utiltesting.MakeResourceFlavor(rf).Obj())
+			}
+			for _, cq := range clusterQueues {
+				if err := cache.AddClusterQueue(ctx, cq); err != nil {
+					t.Fatalf("Adding cluster queue %s to cache: %v", cq.Name, err)
+				}
+				if err := manager.AddClusterQueue(ctx, cq); err != nil {
+					t.Fatalf("Adding cluster queue %s: %v", cq.Name, err)
+				}
+			}
+			if err := manager.AddLocalQueue(ctx, utiltesting.MakeLocalQueue(lqNameA, nsName).ClusterQueue(cqNameA).Obj()); err != nil {
+				t.Fatalf("Adding queue %q: %v", lqNameA, err)
+			}
+			for _, w := range []*kueue.Workload{running, checking} {
+				cache.AddOrUpdateWorkload(log, w)
+			}
+			for _, w := range []*kueue.Workload{ahead, pending} {
+				if err := manager.AddOrUpdateWorkload(w); err != nil {
+					t.Fatalf("Failed to add or update workload %q: %v", w.Name, err)
+				}
+			}
+
+			got, err := workloadExplanationRest.Get(genericapirequest.WithNamespace(ctx, nsName), tc.name, &metav1.GetOptions{})
+			switch {
+			case tc.wantErrMatch != nil:
+				if !tc.wantErrMatch(err) {
+					t.Errorf("Unexpected error: %v", err)
+				}
+			case err != nil:
+				t.Error(err)
+			default:
+				if diff := cmp.Diff(tc.wantExplanation, got.(*visibility.AdmissionExplanation), cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("Explanation differs: (-want,+got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
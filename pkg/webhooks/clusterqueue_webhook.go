@@ -19,6 +19,7 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -101,6 +102,7 @@ func ValidateClusterQueue(cq *kueue.ClusterQueue) field.ErrorList {
 	config := validationConfig{
 		hasParent:                        cq.Spec.Cohort != "",
 		enforceNominalGreaterThanLending: true,
+		hasHeadroomPriorityThreshold:     cq.Spec.HeadroomPriorityThreshold != nil,
 	}
 	allErrs = append(allErrs, validateResourceGroups(cq.Spec.ResourceGroups, config, path.Child("resourceGroups"), false)...)
 	allErrs = append(allErrs,
@@ -113,6 +115,12 @@ func ValidateClusterQueue(cq *kueue.ClusterQueue) field.ErrorList {
 	allErrs = append(allErrs, validateTotalFlavors(cq.Spec.ResourceGroups, path.Child("resourceGroups"))...)
 	allErrs = append(allErrs, validateTotalCoveredResources(cq.Spec.ResourceGroups, path.Child("resourceGroups"))...)
 	allErrs = append(allErrs, validateFlavorResourceCombinations(cq.Spec.ResourceGroups, path.Child("resourceGroups"))...)
+	if cq.Spec.HeadOfLineTimeoutSeconds != nil && cq.Spec.QueueingStrategy != kueue.StrictFIFO {
+		allErrs = append(allErrs, field.Invalid(path.Child("headOfLineTimeoutSeconds"), *cq.Spec.HeadOfLineTimeoutSeconds, "can only be set when queueingStrategy is StrictFIFO"))
+	}
+	if cq.Spec.SuccessorClusterQueue != nil && *cq.Spec.SuccessorClusterQueue == kueue.ClusterQueueReference(cq.Name) {
+		allErrs = append(allErrs, field.Invalid(path.Child("successorClusterQueue"), *cq.Spec.SuccessorClusterQueue, "must not reference the ClusterQueue itself"))
+	}
 	return allErrs
 }
 
@@ -179,10 +187,76 @@ func validateCQAdmissionChecks(spec *kueue.ClusterQueueSpec, path *field.Path) f
 	if spec.AdmissionChecksStrategy != nil && len(spec.AdmissionChecks) != 0 {
 		allErrs = append(allErrs, field.Invalid(path, spec, "Either AdmissionChecks or AdmissionCheckStrategy can be set, but not both"))
 	}
+	if features.Enabled(features.AdmissionCheckDependencies) && spec.AdmissionChecksStrategy != nil {
+		allErrs = append(allErrs, validateAdmissionCheckDependencies(spec.AdmissionChecksStrategy.AdmissionChecks, path.Child("admissionChecksStrategy", "admissionChecks"))...)
+	}
+	if features.Enabled(features.AdmissionCheckTimeouts) && spec.AdmissionChecksStrategy != nil {
+		for i, rule := range spec.AdmissionChecksStrategy.AdmissionChecks {
+			if rule.Timeout != nil && rule.Timeout.Duration <= 0 {
+				allErrs = append(allErrs, field.Invalid(path.Child("admissionChecksStrategy", "admissionChecks").Index(i).Child("timeout"), rule.Timeout.Duration.String(), "must be greater than 0"))
+			}
+		}
+	}
 
 	return allErrs
 }
 
+func validateAdmissionCheckDependencies(rules []kueue.AdmissionCheckStrategyRule, path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	dependencies := make(map[kueue.AdmissionCheckReference][]kueue.AdmissionCheckReference, len(rules))
+	for _, rule := range rules {
+		dependencies[rule.Name] = rule.DependsOn
+	}
+	for i, rule := range rules {
+		path := path.Index(i).Child("dependsOn")
+		for j, dependency := range rule.DependsOn {
+			switch _, found := dependencies[dependency]; {
+			case dependency == rule.Name:
+				allErrs = append(allErrs, field.Invalid(path.Index(j), dependency, "an admission check cannot depend on itself"))
+			case !found:
+				allErrs = append(allErrs, field.NotFound(path.Index(j), dependency))
+			}
+		}
+	}
+	if len(allErrs) == 0 && hasDependencyCycle(dependencies) {
+		allErrs = append(allErrs, field.Invalid(path, rules, "the dependencies between the admission checks must not contain cycles"))
+	}
+	return allErrs
+}
+
+// hasDependencyCycle returns true if following the dependencies of any
+// admission check leads back to it.
+func hasDependencyCycle(dependencies map[kueue.AdmissionCheckReference][]kueue.AdmissionCheckReference) bool {
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[kueue.AdmissionCheckReference]int, len(dependencies))
+	var visit func(kueue.AdmissionCheckReference) bool
+	visit = func(check kueue.AdmissionCheckReference) bool {
+		switch state[check] {
+		case visiting:
+			return true
+		case visited:
+			return false
+		}
+		state[check] = visiting
+		for _, dependency := range dependencies[check] {
+			if visit(dependency) {
+				return true
+			}
+		}
+		state[check] = visited
+		return false
+	}
+	for check := range dependencies {
+		if visit(check) {
+			return true
+		}
+	}
+	return false
+}
+
 func validateResourceGroups(resourceGroups []kueue.ResourceGroup, config validationConfig, path *field.Path, isCohort bool) field.ErrorList {
 	var allErrs field.ErrorList
 	seenResources := sets.New[corev1.ResourceName]()
@@ -235,6 +309,65 @@ func validateFlavorQuotas(flavorQuotas kueue.FlavorQuotas, coveredResources []co
 			allErrs = append(allErrs, validateLimit(*rq.LendingLimit, config, lendingLimitPath, isCohort)...)
 			allErrs = append(allErrs, validateLendingLimit(*rq.LendingLimit, rq.NominalQuota, config, lendingLimitPath)...)
 		}
+		if features.Enabled(features.PeerBorrowingLimits) && len(rq.PeerBorrowingLimits) > 0 {
+			allErrs = append(allErrs, validatePeerBorrowingLimits(rq.PeerBorrowingLimits, config, path.Child("peerBorrowingLimits"), isCohort)...)
+		}
+		if features.Enabled(features.ReservedHeadroom) && rq.ReservedHeadroom != nil {
+			allErrs = append(allErrs, validateReservedHeadroom(*rq.ReservedHeadroom, rq.NominalQuota, config, path.Child("reservedHeadroom"), isCohort)...)
+		}
+		if features.Enabled(features.FlavorResourceRatios) && rq.MaxRatio != nil {
+			allErrs = append(allErrs, validateMaxRatio(rq.Name, *rq.MaxRatio, coveredResources, path.Child("maxRatio"), isCohort)...)
+		}
+	}
+	return allErrs
+}
+
+// validateMaxRatio enforces that the MaxRatio is relative to another resource
+// covered by the resource group, and that its quantity is non-negative.
+func validateMaxRatio(name corev1.ResourceName, ratio kueue.ResourceRatio, coveredResources []corev1.ResourceName, fldPath *field.Path, isCohort bool) field.ErrorList {
+	var allErrs field.ErrorList
+	if isCohort {
+		return append(allErrs, field.Forbidden(fldPath, "not supported in Cohorts"))
+	}
+	perResourcePath := fldPath.Child("perResource")
+	if ratio.PerResource == name {
+		allErrs = append(allErrs, field.Invalid(perResourcePath, ratio.PerResource, "must be different from the resource name"))
+	} else if !slices.Contains(coveredResources, ratio.PerResource) {
+		allErrs = append(allErrs, field.Invalid(perResourcePath, ratio.PerResource, "must be one of the coveredResources"))
+	}
+	allErrs = append(allErrs, validateResourceQuantity(ratio.Quantity, fldPath.Child("quantity"))...)
+	return allErrs
+}
+
+// validateReservedHeadroom enforces that the ReservedHeadroom is only set in
+// ClusterQueues with a headroomPriorityThreshold, and that it is within the
+// nominal quota.
+func validateReservedHeadroom(headroom, nominal resource.Quantity, config validationConfig, fldPath *field.Path, isCohort bool) field.ErrorList {
+	var allErrs field.ErrorList
+	if isCohort {
+		return append(allErrs, field.Forbidden(fldPath, "not supported in Cohorts"))
+	}
+	if !config.hasHeadroomPriorityThreshold {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "must not be set when headroomPriorityThreshold is not set"))
+	}
+	allErrs = append(allErrs, validateResourceQuantity(headroom, fldPath)...)
+	if headroom.Cmp(nominal) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, headroom.String(), "must be less than or equal to the nominalQuota"))
+	}
+	return allErrs
+}
+
+// validatePeerBorrowingLimits enforces that PeerBorrowingLimits are only set in
+// ClusterQueues which belong to a cohort, and that the limits are non-negative
+func validatePeerBorrowingLimits(limits []kueue.PeerBorrowingLimit, config validationConfig, fldPath *field.Path, isCohort bool) field.ErrorList {
+	var allErrs field.ErrorList
+	if isCohort {
+		return append(allErrs, field.Forbidden(fldPath, "not supported in Cohorts"))
+	}
+	for i, l := range limits {
+		limitPath := fldPath.Index(i).Child("borrowingLimit")
+		allErrs = append(allErrs, validateLimit(l.BorrowingLimit, config, limitPath, isCohort)...)
+		allErrs = append(allErrs, validateResourceQuantity(l.BorrowingLimit, limitPath)...)
 	}
 	return allErrs
 }
@@ -19,6 +19,7 @@ package webhooks
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -42,6 +43,11 @@ func TestValidateClusterQueue(t *testing.T) {
 		clusterQueue        *kueue.ClusterQueue
 		wantErr             field.ErrorList
 		disableLendingLimit bool
+		enablePeerBorrowing bool
+		enableHeadroom      bool
+		enableMaxRatio      bool
+		enableDependencies  bool
+		enableCheckTimeouts bool
 	}{
 		{
 			name: "built-in resources with qualified names",
@@ -58,6 +64,21 @@ func TestValidateClusterQueue(t *testing.T) {
 				field.Invalid(resourceGroupsPath.Index(0).Child("coveredResources").Index(0), "@cpu", ""),
 			},
 		},
+		{
+			name: "successorClusterQueue referencing another ClusterQueue",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				SuccessorClusterQueue("other-cluster-queue").
+				Obj(),
+		},
+		{
+			name: "successorClusterQueue referencing the ClusterQueue itself",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				SuccessorClusterQueue("cluster-queue").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("successorClusterQueue"), "cluster-queue", ""),
+			},
+		},
 		{
 			name: "admissionChecks defined",
 			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
@@ -80,6 +101,61 @@ func TestValidateClusterQueue(t *testing.T) {
 				field.Invalid(specPath, "spec", "Either AdmissionChecks or AdmissionCheckStrategy can be set, but not both"),
 			},
 		},
+		{
+			name:               "admissionCheckStrategy with dependencies",
+			enableDependencies: true,
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				AdmissionCheckStrategy(
+					*testingutil.MakeAdmissionCheckStrategyRule("budget").Obj(),
+					*testingutil.MakeAdmissionCheckStrategyRule("provisioning").DependsOn("budget").Obj(),
+				).Obj(),
+		},
+		{
+			name:               "admissionCheckStrategy with invalid dependencies",
+			enableDependencies: true,
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				AdmissionCheckStrategy(
+					*testingutil.MakeAdmissionCheckStrategyRule("budget").DependsOn("budget").Obj(),
+					*testingutil.MakeAdmissionCheckStrategyRule("provisioning").DependsOn("missing").Obj(),
+				).Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("admissionChecksStrategy", "admissionChecks").Index(0).Child("dependsOn").Index(0), "budget", ""),
+				field.NotFound(specPath.Child("admissionChecksStrategy", "admissionChecks").Index(1).Child("dependsOn").Index(0), "missing"),
+			},
+		},
+		{
+			name:               "admissionCheckStrategy with a dependency cycle",
+			enableDependencies: true,
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				AdmissionCheckStrategy(
+					*testingutil.MakeAdmissionCheckStrategyRule("ac1").DependsOn("ac3").Obj(),
+					*testingutil.MakeAdmissionCheckStrategyRule("ac2").DependsOn("ac1").Obj(),
+					*testingutil.MakeAdmissionCheckStrategyRule("ac3").DependsOn("ac2").Obj(),
+				).Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("admissionChecksStrategy", "admissionChecks"), nil, ""),
+			},
+		},
+		{
+			name:                "admissionCheckStrategy with timeouts",
+			enableCheckTimeouts: true,
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				AdmissionCheckStrategy(
+					*testingutil.MakeAdmissionCheckStrategyRule("ac1").Timeout(10*time.Minute, kueue.AdmissionCheckTimeoutSkip).Obj(),
+					*testingutil.MakeAdmissionCheckStrategyRule("ac2").Timeout(0, kueue.AdmissionCheckTimeoutReject).Obj(),
+				).Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("admissionChecksStrategy", "admissionChecks").Index(1).Child("timeout"), "0s", ""),
+			},
+		},
+		{
+			name: "admissionCheckStrategy with a dependency cycle, feature disabled",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				AdmissionCheckStrategy(
+					*testingutil.MakeAdmissionCheckStrategyRule("ac1").DependsOn("ac2").Obj(),
+					*testingutil.MakeAdmissionCheckStrategyRule("ac2").DependsOn("ac1").Obj(),
+				).Obj(),
+		},
 		{
 			name:         "in cohort",
 			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").Cohort("prod").Obj(),
@@ -180,6 +256,145 @@ func TestValidateClusterQueue(t *testing.T) {
 					*testingutil.MakeFlavorQuotas("x86").Resource("cpu", "1", "", "1").Obj()).
 				Obj(),
 		},
+		{
+			name:                "flavor quota with peerBorrowingLimits",
+			enablePeerBorrowing: true,
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				ResourceGroup(
+					*testingutil.MakeFlavorQuotas("x86").
+						ResourceQuotaWrapper("cpu").NominalQuota("1").PeerBorrowingLimit("interactive", "0").Append().
+						Obj()).
+				Cohort("cohort").
+				Obj(),
+		},
+		{
+			name:                "flavor quota with negative peerBorrowingLimits",
+			enablePeerBorrowing: true,
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				ResourceGroup(
+					*testingutil.MakeFlavorQuotas("x86").
+						ResourceQuotaWrapper("cpu").NominalQuota("1").PeerBorrowingLimit("interactive", "-1").Append().
+						Obj()).
+				Cohort("cohort").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(resourceGroupsPath.Index(0).Child("flavors").Index(0).Child("resources").Index(0).Child("peerBorrowingLimits").Index(0).Child("borrowingLimit"), "-1", ""),
+			},
+		},
+		{
+			name:                "flavor quota with peerBorrowingLimits and empty cohort",
+			enablePeerBorrowing: true,
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				ResourceGroup(
+					*testingutil.MakeFlavorQuotas("x86").
+						ResourceQuotaWrapper("cpu").NominalQuota("1").PeerBorrowingLimit("interactive", "1").Append().
+						Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(resourceGroupsPath.Index(0).Child("flavors").Index(0).Child("resources").Index(0).Child("peerBorrowingLimits").Index(0).Child("borrowingLimit"), "1", "must be nil when cohort is empty"),
+			},
+		},
+		{
+			name: "flavor quota with peerBorrowingLimits and empty cohort, but feature disabled",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				ResourceGroup(
+					*testingutil.MakeFlavorQuotas("x86").
+						ResourceQuotaWrapper("cpu").NominalQuota("1").PeerBorrowingLimit("interactive", "1").Append().
+						Obj()).
+				Obj(),
+		},
+		{
+			name:           "flavor quota with reservedHeadroom",
+			enableHeadroom: true,
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				HeadroomPriorityThreshold(1000).
+				ResourceGroup(
+					*testingutil.MakeFlavorQuotas("x86").
+						ResourceQuotaWrapper("cpu").NominalQuota("10").ReservedHeadroom("2").Append().
+						Obj()).
+				Obj(),
+		},
+		{
+			name:           "flavor quota with reservedHeadroom above nominalQuota",
+			enableHeadroom: true,
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				HeadroomPriorityThreshold(1000).
+				ResourceGroup(
+					*testingutil.MakeFlavorQuotas("x86").
+						ResourceQuotaWrapper("cpu").NominalQuota("1").ReservedHeadroom("2").Append().
+						Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(resourceGroupsPath.Index(0).Child("flavors").Index(0).Child("resources").Index(0).Child("reservedHeadroom"), "2", ""),
+			},
+		},
+		{
+			name:           "flavor quota with reservedHeadroom, without headroomPriorityThreshold",
+			enableHeadroom: true,
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				ResourceGroup(
+					*testingutil.MakeFlavorQuotas("x86").
+						ResourceQuotaWrapper("cpu").NominalQuota("10").ReservedHeadroom("2").Append().
+						Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Forbidden(resourceGroupsPath.Index(0).Child("flavors").Index(0).Child("resources").Index(0).Child("reservedHeadroom"), ""),
+			},
+		},
+		{
+			name:           "flavor quota with maxRatio",
+			enableMaxRatio: true,
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				ResourceGroup(
+					*testingutil.MakeFlavorQuotas("gpu").
+						ResourceQuotaWrapper("cpu").NominalQuota("64").MaxRatio("example.com/gpu", "4").Append().
+						ResourceQuotaWrapper("example.com/gpu").NominalQuota("8").Append().
+						Obj()).
+				Obj(),
+		},
+		{
+			name:           "flavor quota with maxRatio per a resource not covered by the resource group",
+			enableMaxRatio: true,
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				ResourceGroup(
+					*testingutil.MakeFlavorQuotas("gpu").
+						ResourceQuotaWrapper("cpu").NominalQuota("64").MaxRatio("example.com/gpu", "4").Append().
+						Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(resourceGroupsPath.Index(0).Child("flavors").Index(0).Child("resources").Index(0).Child("maxRatio", "perResource"), "example.com/gpu", ""),
+			},
+		},
+		{
+			name:           "flavor quota with maxRatio per the same resource",
+			enableMaxRatio: true,
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				ResourceGroup(
+					*testingutil.MakeFlavorQuotas("gpu").
+						ResourceQuotaWrapper("cpu").NominalQuota("64").MaxRatio("cpu", "4").Append().
+						Obj()).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(resourceGroupsPath.Index(0).Child("flavors").Index(0).Child("resources").Index(0).Child("maxRatio", "perResource"), "cpu", ""),
+			},
+		},
+		{
+			name: "headOfLineTimeoutSeconds with StrictFIFO",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				QueueingStrategy(kueue.StrictFIFO).
+				HeadOfLineTimeoutSeconds(600).
+				Obj(),
+		},
+		{
+			name: "headOfLineTimeoutSeconds with BestEffortFIFO",
+			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
+				QueueingStrategy(kueue.BestEffortFIFO).
+				HeadOfLineTimeoutSeconds(600).
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(specPath.Child("headOfLineTimeoutSeconds"), 600, ""),
+			},
+		},
 		{
 			name: "empty queueing strategy is supported",
 			clusterQueue: testingutil.MakeClusterQueue("cluster-queue").
@@ -333,6 +548,11 @@ func TestValidateClusterQueue(t *testing.T) {
 			if tc.disableLendingLimit {
 				features.SetFeatureGateDuringTest(t, features.LendingLimit, false)
 			}
+			features.SetFeatureGateDuringTest(t, features.PeerBorrowingLimits, tc.enablePeerBorrowing)
+			features.SetFeatureGateDuringTest(t, features.ReservedHeadroom, tc.enableHeadroom)
+			features.SetFeatureGateDuringTest(t, features.FlavorResourceRatios, tc.enableMaxRatio)
+			features.SetFeatureGateDuringTest(t, features.AdmissionCheckDependencies, tc.enableDependencies)
+			features.SetFeatureGateDuringTest(t, features.AdmissionCheckTimeouts, tc.enableCheckTimeouts)
 			gotErr := ValidateClusterQueue(tc.clusterQueue)
 			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.IgnoreFields(field.Error{}, "Detail", "BadValue")); diff != "" {
 				t.Errorf("ValidateResources() mismatch (-want +got):\n%s", diff)
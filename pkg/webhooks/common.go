@@ -37,6 +37,7 @@ func validateResourceName(name corev1.ResourceName, fldPath *field.Path) field.E
 type validationConfig struct {
 	hasParent                        bool
 	enforceNominalGreaterThanLending bool
+	hasHeadroomPriorityThreshold     bool
 }
 
 // validateFairSharing validates the FairSharing config for both ClusterQueues and Cohorts.
@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -93,6 +94,28 @@ func ValidateResourceFlavor(rf *kueue.ResourceFlavor) field.ErrorList {
 
 	allErrs = append(allErrs, validateNodeTaints(rf.Spec.NodeTaints, specPath.Child("nodeTaints"))...)
 	allErrs = append(allErrs, validateTolerations(rf.Spec.Tolerations, specPath.Child("tolerations"))...)
+	allErrs = append(allErrs, validateResourcePartitions(rf.Spec.ResourcePartitions, specPath.Child("resourcePartitions"))...)
+	allErrs = append(allErrs, validatePodOverhead(rf.Spec.PodOverhead, specPath.Child("podOverhead"))...)
+	return allErrs
+}
+
+func validatePodOverhead(overhead corev1.ResourceList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for name, q := range overhead {
+		allErrs = append(allErrs, validateResourceName(name, fldPath.Key(string(name)))...)
+		allErrs = append(allErrs, validateResourceQuantity(q, fldPath.Key(string(name)))...)
+	}
+	return allErrs
+}
+
+func validateResourcePartitions(partitions []kueue.ResourcePartition, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	one := resource.MustParse("1")
+	for i, partition := range partitions {
+		if partition.Fraction.Sign() <= 0 || partition.Fraction.Cmp(one) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("fraction"), partition.Fraction.String(), "must be greater than 0 and not greater than 1"))
+		}
+	}
 	return allErrs
 }
 
@@ -64,6 +64,40 @@ func TestValidateResourceFlavor(t *testing.T) {
 				field.Invalid(field.NewPath("spec", "nodeLabels"), "@abc", ""),
 			},
 		},
+		{
+			name: "valid resource partitions",
+			rf: utiltesting.MakeResourceFlavor("resource-flavor").
+				ResourcePartition("nvidia.com/gpu", "142m").
+				ResourcePartition("example.com/tpu", "1").
+				Obj(),
+		},
+		{
+			name: "invalid resource partition fractions",
+			rf: utiltesting.MakeResourceFlavor("resource-flavor").
+				ResourcePartition("nvidia.com/gpu", "0").
+				ResourcePartition("example.com/tpu", "1500m").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "resourcePartitions").Index(0).Child("fraction"), "0", ""),
+				field.Invalid(field.NewPath("spec", "resourcePartitions").Index(1).Child("fraction"), "1500m", ""),
+			},
+		},
+		{
+			name: "valid pod overhead",
+			rf: utiltesting.MakeResourceFlavor("resource-flavor").
+				PodOverhead(corev1.ResourceCPU, "250m").
+				PodOverhead(corev1.ResourceMemory, "0").
+				Obj(),
+		},
+		{
+			name: "negative pod overhead",
+			rf: utiltesting.MakeResourceFlavor("resource-flavor").
+				PodOverhead(corev1.ResourceMemory, "-1Gi").
+				Obj(),
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "podOverhead").Key("memory"), "-1Gi", ""),
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -18,36 +18,49 @@ package webhooks
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"slices"
 	"strconv"
 
+	admissionv1 "k8s.io/api/admission/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/features"
 	"sigs.k8s.io/kueue/pkg/resources"
+	utilpriority "sigs.k8s.io/kueue/pkg/util/priority"
 	utilslices "sigs.k8s.io/kueue/pkg/util/slices"
+	utiltas "sigs.k8s.io/kueue/pkg/util/tas"
+	utilwebhook "sigs.k8s.io/kueue/pkg/util/webhook"
 	"sigs.k8s.io/kueue/pkg/workload"
 )
 
 type WorkloadWebhook struct {
 	dispatcherName string
+	client         client.Client
 }
 
 func setupWebhookForWorkload(mgr ctrl.Manager, dispatcherName string) error {
 	wh := &WorkloadWebhook{
 		dispatcherName: dispatcherName,
+		client:         mgr.GetClient(),
 	}
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&kueue.Workload{}).
@@ -56,7 +69,7 @@ func setupWebhookForWorkload(mgr ctrl.Manager, dispatcherName string) error {
 		Complete()
 }
 
-// +kubebuilder:webhook:path=/mutate-kueue-x-k8s-io-v1beta1-workload,mutating=true,failurePolicy=fail,sideEffects=None,groups=kueue.x-k8s.io,resources=workloads,verbs=create,versions=v1beta1,name=mworkload.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-kueue-x-k8s-io-v1beta1-workload,mutating=true,failurePolicy=fail,sideEffects=None,groups=kueue.x-k8s.io,resources=workloads,verbs=create;update,versions=v1beta1,name=mworkload.kb.io,admissionReviewVersions=v1
 
 var _ webhook.CustomDefaulter = &WorkloadWebhook{}
 
@@ -66,6 +79,21 @@ func (w *WorkloadWebhook) Default(ctx context.Context, obj runtime.Object) error
 	log := ctrl.LoggerFrom(ctx).WithName("workload-webhook")
 	log.V(5).Info("Applying defaults")
 
+	// The workloads created by Kueue for the jobs get the submitter of their
+	// job, recorded by the webhook of the job. On updates, the webhook is only
+	// called when the priority or the submitter annotation changes, see the
+	// matchConditions of mworkload.kb.io in config/components/webhook.
+	req, err := admission.RequestFromContext(ctx)
+	if err == nil && (req.Operation != admissionv1.Create || metav1.GetControllerOf(wl) == nil) {
+		if err := utilwebhook.ApplyDefaultSubmitter(ctx, wl); err != nil {
+			return err
+		}
+	}
+
+	if err == nil && req.Operation == admissionv1.Update {
+		return recordPriorityChange(req, wl)
+	}
+
 	// drop minCounts if PartialAdmission is not enabled
 	if !features.Enabled(features.PartialAdmission) {
 		for i := range wl.Spec.PodSets {
@@ -73,9 +101,47 @@ func (w *WorkloadWebhook) Default(ctx context.Context, obj runtime.Object) error
 		}
 	}
 
+	// The workloads built outside of Kueue, for example for the external
+	// frameworks, request their topology with the annotations of the PodSets,
+	// like the jobs of the built-in integrations.
+	if features.Enabled(features.TopologyAwareScheduling) && features.Enabled(features.TASExternalFrameworks) && metav1.GetControllerOf(wl) == nil {
+		for i := range wl.Spec.PodSets {
+			ps := &wl.Spec.PodSets[i]
+			if ps.TopologyRequest != nil {
+				continue
+			}
+			topologyRequest, err := utiltas.NewPodSetTopologyRequest(&ps.Template.ObjectMeta).Build()
+			if err != nil {
+				return fmt.Errorf("invalid topology request of the podSet %s: %w", ps.Name, err)
+			}
+			ps.TopologyRequest = topologyRequest
+		}
+	}
+
 	return nil
 }
 
+// recordPriorityChange records the user who changed the priority of the
+// workload, and its previous priority, in the PriorityChangeAnnotation.
+func recordPriorityChange(req admission.Request, wl *kueue.Workload) error {
+	if !features.Enabled(features.WorkloadPriorityChange) {
+		return nil
+	}
+	oldWl := &kueue.Workload{}
+	if err := json.Unmarshal(req.OldObject.Raw, oldWl); err != nil {
+		return fmt.Errorf("decoding the previous workload: %w", err)
+	}
+	if !workload.PriorityChanged(oldWl, wl) {
+		return nil
+	}
+	return workload.SetPriorityChange(wl, &workload.PriorityChange{
+		User:                      req.UserInfo.Username,
+		Time:                      metav1.Now(),
+		PreviousPriorityClassName: oldWl.Spec.PriorityClassName,
+		PreviousPriority:          oldWl.Spec.Priority,
+	})
+}
+
 // +kubebuilder:webhook:path=/validate-kueue-x-k8s-io-v1beta1-workload,mutating=false,failurePolicy=fail,sideEffects=None,groups=kueue.x-k8s.io,resources=workloads;workloads/status,verbs=create;update,versions=v1beta1,name=vworkload.kb.io,admissionReviewVersions=v1
 
 var _ webhook.CustomValidator = &WorkloadWebhook{}
@@ -85,7 +151,12 @@ func (w *WorkloadWebhook) ValidateCreate(ctx context.Context, obj runtime.Object
 	wl := obj.(*kueue.Workload)
 	log := ctrl.LoggerFrom(ctx).WithName("workload-webhook")
 	log.V(5).Info("Validating create")
-	return nil, ValidateWorkload(wl).ToAggregate()
+	allErrs := ValidateWorkload(wl)
+	if ptr.Deref(wl.Spec.Held, false) {
+		allErrs = append(allErrs, w.validateHoldPermission(ctx, wl)...)
+	}
+	allErrs = append(allErrs, utilwebhook.ValidateBillingNamespacePermission(ctx, w.client, wl)...)
+	return nil, allErrs.ToAggregate()
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
@@ -94,7 +165,81 @@ func (w *WorkloadWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj run
 	oldWL := oldObj.(*kueue.Workload)
 	log := ctrl.LoggerFrom(ctx).WithName("workload-webhook")
 	log.V(5).Info("Validating update")
-	return nil, ValidateWorkloadUpdate(newWL, oldWL, w.dispatcherName).ToAggregate()
+	allErrs := ValidateWorkloadUpdate(newWL, oldWL, w.dispatcherName)
+	if ptr.Deref(newWL.Spec.Held, false) != ptr.Deref(oldWL.Spec.Held, false) {
+		allErrs = append(allErrs, w.validateHoldPermission(ctx, newWL)...)
+	}
+	if features.Enabled(features.WorkloadPriorityChange) {
+		allErrs = append(allErrs, w.validatePriorityChange(ctx, newWL, oldWL)...)
+	}
+	allErrs = append(allErrs, utilwebhook.ValidateUpdateForBillingNamespace(oldWL, newWL)...)
+	return nil, allErrs.ToAggregate()
+}
+
+// validateHoldPermission checks that the user of the request is allowed to
+// update the workloads/hold subresource, so that holding and releasing the
+// workloads can be granted separately from editing them.
+func (w *WorkloadWebhook) validateHoldPermission(ctx context.Context, wl *kueue.Workload) field.ErrorList {
+	if !features.Enabled(features.WorkloadHold) {
+		return nil
+	}
+	return w.validateSubresourcePermission(ctx, wl, "hold", field.NewPath("spec", "held"))
+}
+
+// validatePriorityChange checks that the user of the request is allowed to
+// update the workloads/priority subresource when changing the priority of the
+// workload, and that the new priority is the one of its priority class.
+func (w *WorkloadWebhook) validatePriorityChange(ctx context.Context, newWL, oldWL *kueue.Workload) field.ErrorList {
+	specPath := field.NewPath("spec")
+	if !workload.PriorityChanged(oldWL, newWL) {
+		if newWL.Annotations[controllerconsts.PriorityChangeAnnotation] != oldWL.Annotations[controllerconsts.PriorityChangeAnnotation] &&
+			newWL.Annotations[controllerconsts.PriorityChangeAnnotation] != "" {
+			return field.ErrorList{field.Forbidden(field.NewPath("metadata", "annotations").Key(controllerconsts.PriorityChangeAnnotation), "can only be set when changing the priority")}
+		}
+		return nil
+	}
+	allErrs := w.validateSubresourcePermission(ctx, newWL, "priority", specPath.Child("priority"))
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+	var (
+		name     string
+		priority int32
+		err      error
+	)
+	switch newWL.Spec.PriorityClassSource {
+	case constants.WorkloadPriorityClassSource:
+		name, _, priority, err = utilpriority.GetPriorityFromWorkloadPriorityClass(ctx, w.client, newWL.Spec.PriorityClassName)
+	case constants.PodPriorityClassSource:
+		name, _, priority, err = utilpriority.GetPriorityFromPriorityClass(ctx, w.client, newWL.Spec.PriorityClassName)
+	default:
+		return field.ErrorList{field.Invalid(specPath.Child("priorityClassName"), newWL.Spec.PriorityClassName, "the priority can only be changed to the one of a priority class")}
+	}
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return field.ErrorList{field.NotFound(specPath.Child("priorityClassName"), newWL.Spec.PriorityClassName)}
+		}
+		return field.ErrorList{field.InternalError(specPath.Child("priorityClassName"), err)}
+	}
+	if name != newWL.Spec.PriorityClassName || priority != ptr.Deref(newWL.Spec.Priority, constants.DefaultPriority) {
+		return field.ErrorList{field.Invalid(specPath.Child("priority"), ptr.Deref(newWL.Spec.Priority, constants.DefaultPriority), fmt.Sprintf("must be %d, the value of the priority class %q", priority, name))}
+	}
+	return nil
+}
+
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+// validateSubresourcePermission checks that the user of the request is allowed
+// to update the workloads subresource, which is only used for authorization.
+func (w *WorkloadWebhook) validateSubresourcePermission(ctx context.Context, wl *kueue.Workload, subresource string, path *field.Path) field.ErrorList {
+	return utilwebhook.ValidatePermission(ctx, w.client, authorizationv1.ResourceAttributes{
+		Namespace:   wl.Namespace,
+		Verb:        "update",
+		Group:       kueue.GroupVersion.Group,
+		Resource:    "workloads",
+		Subresource: subresource,
+		Name:        wl.Name,
+	}, path)
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
@@ -17,20 +17,33 @@ limitations under the License.
 package webhooks
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	configapi "sigs.k8s.io/kueue/apis/config/v1beta1"
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	"sigs.k8s.io/kueue/pkg/constants"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
 	"sigs.k8s.io/kueue/pkg/features"
 	testingutil "sigs.k8s.io/kueue/pkg/util/testing"
+	"sigs.k8s.io/kueue/pkg/workload"
 )
 
 const (
@@ -38,6 +51,111 @@ const (
 	testWorkloadNamespace = "test-ns"
 )
 
+func TestWorkloadWebhookDefault(t *testing.T) {
+	rackAnnotation := map[string]string{kueue.PodSetRequiredTopologyAnnotation: "rack"}
+	testCases := map[string]struct {
+		enableTASExternalFrameworks bool
+		wl                          *kueue.Workload
+		want                        *kueue.Workload
+	}{
+		"the topology request is built from the annotations": {
+			enableTASExternalFrameworks: true,
+			wl: testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*testingutil.MakePodSet("ps1", 2).Annotations(rackAnnotation).Obj()).
+				Obj(),
+			want: testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*testingutil.MakePodSet("ps1", 2).Annotations(rackAnnotation).RequiredTopologyRequest("rack").Obj()).
+				Obj(),
+		},
+		"the topology request is kept": {
+			enableTASExternalFrameworks: true,
+			wl: testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*testingutil.MakePodSet("ps1", 2).Annotations(rackAnnotation).PreferredTopologyRequest("block").Obj()).
+				Obj(),
+			want: testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*testingutil.MakePodSet("ps1", 2).Annotations(rackAnnotation).PreferredTopologyRequest("block").Obj()).
+				Obj(),
+		},
+		"the workload of a job is not defaulted": {
+			enableTASExternalFrameworks: true,
+			wl: testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "job", "job-uid").
+				PodSets(*testingutil.MakePodSet("ps1", 2).Annotations(rackAnnotation).Obj()).
+				Obj(),
+			want: testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "job", "job-uid").
+				PodSets(*testingutil.MakePodSet("ps1", 2).Annotations(rackAnnotation).Obj()).
+				Obj(),
+		},
+		"the feature gate is disabled": {
+			wl: testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*testingutil.MakePodSet("ps1", 2).Annotations(rackAnnotation).Obj()).
+				Obj(),
+			want: testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				PodSets(*testingutil.MakePodSet("ps1", 2).Annotations(rackAnnotation).Obj()).
+				Obj(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.TopologyAwareScheduling, true)
+			features.SetFeatureGateDuringTest(t, features.TASExternalFrameworks, tc.enableTASExternalFrameworks)
+			ctx, _ := testingutil.ContextWithLog(t)
+			wh := &WorkloadWebhook{}
+			if err := wh.Default(ctx, tc.wl); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.wl); diff != "" {
+				t.Errorf("Unexpected workload (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkloadWebhookDefaultSubmitter(t *testing.T) {
+	testCases := map[string]struct {
+		wl   *kueue.Workload
+		want *kueue.Workload
+	}{
+		"the creator of the workload is recorded": {
+			wl: testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				Annotation(controllerconsts.SubmitterAnnotation, "bob").
+				Obj(),
+			want: testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				Annotation(controllerconsts.SubmitterAnnotation, "alice").
+				Obj(),
+		},
+		"the workload of a job keeps the submitter of the job": {
+			wl: testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "job", "job-uid").
+				Annotation(controllerconsts.SubmitterAnnotation, "bob").
+				Obj(),
+			want: testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+				ControllerReference(batchv1.SchemeGroupVersion.WithKind("Job"), "job", "job-uid").
+				Annotation(controllerconsts.SubmitterAnnotation, "bob").
+				Obj(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkloadSubmitterIdentity, true)
+			ctx := admission.NewContextWithRequest(t.Context(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					UserInfo:  authenticationv1.UserInfo{Username: "alice"},
+				},
+			})
+			wh := &WorkloadWebhook{}
+			if err := wh.Default(ctx, tc.wl); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.wl); diff != "" {
+				t.Errorf("Unexpected workload (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestValidateWorkload(t *testing.T) {
 	specPath := field.NewPath("spec")
 	podSetsPath := specPath.Child("podSets")
@@ -543,3 +661,257 @@ func TestValidateWorkloadUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkloadWebhookHoldPermission(t *testing.T) {
+	baseWorkload := testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace)
+	testCases := map[string]struct {
+		enableWorkloadHold bool
+		oldWorkload        *kueue.Workload
+		newWorkload        *kueue.Workload
+		user               string
+		wantErr            error
+	}{
+		"create a held workload with the hold permission": {
+			enableWorkloadHold: true,
+			newWorkload:        baseWorkload.Clone().Held(true).Obj(),
+			user:               "admin",
+		},
+		"create a held workload without the hold permission": {
+			enableWorkloadHold: true,
+			newWorkload:        baseWorkload.Clone().Held(true).Obj(),
+			user:               "user",
+			wantErr: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "held"), ""),
+			}.ToAggregate(),
+		},
+		"release a workload without the hold permission": {
+			enableWorkloadHold: true,
+			oldWorkload:        baseWorkload.Clone().Held(true).Obj(),
+			newWorkload:        baseWorkload.Clone().Held(false).Obj(),
+			user:               "user",
+			wantErr: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "held"), ""),
+			}.ToAggregate(),
+		},
+		"update a held workload without changing held": {
+			enableWorkloadHold: true,
+			oldWorkload:        baseWorkload.Clone().Held(true).Obj(),
+			newWorkload:        baseWorkload.Clone().Held(true).Label("key", "value").Obj(),
+			user:               "user",
+		},
+		"hold a workload with the feature gate disabled": {
+			oldWorkload: baseWorkload.Clone().Obj(),
+			newWorkload: baseWorkload.Clone().Held(true).Obj(),
+			user:        "user",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkloadHold, tc.enableWorkloadHold)
+			cl := testingutil.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+					sar := obj.(*authorizationv1.SubjectAccessReview)
+					attrs := sar.Spec.ResourceAttributes
+					sar.Status.Allowed = sar.Spec.User == "admin" && attrs.Resource == "workloads" && attrs.Subresource == "hold" && attrs.Verb == "update"
+					return nil
+				},
+			}).Build()
+			wh := &WorkloadWebhook{client: cl}
+			ctx := admission.NewContextWithRequest(t.Context(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: tc.user},
+				},
+			})
+			var err error
+			if tc.oldWorkload == nil {
+				_, err = wh.ValidateCreate(ctx, tc.newWorkload)
+			} else {
+				_, err = wh.ValidateUpdate(ctx, tc.oldWorkload, tc.newWorkload)
+			}
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.IgnoreFields(field.Error{}, "BadValue", "Detail")); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkloadWebhookBillingNamespace(t *testing.T) {
+	baseWorkload := testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace)
+	billingNamespacePath := field.NewPath("metadata", "annotations").Key(controllerconsts.BillingNamespaceAnnotation)
+	testCases := map[string]struct {
+		oldWorkload *kueue.Workload
+		newWorkload *kueue.Workload
+		user        string
+		wantErr     error
+	}{
+		"create a workload billed to a namespace where the user can create workloads": {
+			newWorkload: baseWorkload.Clone().Annotation(controllerconsts.BillingNamespaceAnnotation, "team-a").Obj(),
+			user:        "admin",
+		},
+		"create a workload billed to a namespace where the user can't create workloads": {
+			newWorkload: baseWorkload.Clone().Annotation(controllerconsts.BillingNamespaceAnnotation, "team-a").Obj(),
+			user:        "user",
+			wantErr: field.ErrorList{
+				field.Forbidden(billingNamespacePath, ""),
+			}.ToAggregate(),
+		},
+		"create a workload billed to its own namespace": {
+			newWorkload: baseWorkload.Clone().Annotation(controllerconsts.BillingNamespaceAnnotation, testWorkloadNamespace).Obj(),
+			user:        "user",
+		},
+		"create a workload billed to an invalid namespace": {
+			newWorkload: baseWorkload.Clone().Annotation(controllerconsts.BillingNamespaceAnnotation, "Team_A").Obj(),
+			user:        "admin",
+			wantErr: field.ErrorList{
+				field.Invalid(billingNamespacePath, "Team_A", ""),
+			}.ToAggregate(),
+		},
+		"change the billing namespace": {
+			oldWorkload: baseWorkload.Clone().Annotation(controllerconsts.BillingNamespaceAnnotation, "team-a").Obj(),
+			newWorkload: baseWorkload.Clone().Annotation(controllerconsts.BillingNamespaceAnnotation, "team-b").Obj(),
+			user:        "admin",
+			wantErr: field.ErrorList{
+				field.Invalid(billingNamespacePath, "team-b", ""),
+			}.ToAggregate(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.CrossNamespaceBilling, true)
+			cl := testingutil.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+					sar := obj.(*authorizationv1.SubjectAccessReview)
+					attrs := sar.Spec.ResourceAttributes
+					sar.Status.Allowed = sar.Spec.User == "admin" && attrs.Namespace == "team-a" && attrs.Resource == "workloads" && attrs.Verb == "create"
+					return nil
+				},
+			}).Build()
+			wh := &WorkloadWebhook{client: cl}
+			ctx := admission.NewContextWithRequest(t.Context(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: tc.user},
+				},
+			})
+			var err error
+			if tc.oldWorkload == nil {
+				_, err = wh.ValidateCreate(ctx, tc.newWorkload)
+			} else {
+				_, err = wh.ValidateUpdate(ctx, tc.oldWorkload, tc.newWorkload)
+			}
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.IgnoreFields(field.Error{}, "Detail")); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkloadWebhookPriorityChange(t *testing.T) {
+	baseWorkload := testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+		PriorityClass("low").
+		Priority(100).
+		PriorityClassSource(constants.WorkloadPriorityClassSource)
+	testCases := map[string]struct {
+		newWorkload *kueue.Workload
+		user        string
+		wantErr     error
+	}{
+		"change the priority class with the priority permission": {
+			newWorkload: baseWorkload.Clone().PriorityClass("high").Priority(1000).Obj(),
+			user:        "admin",
+		},
+		"change the priority class without the priority permission": {
+			newWorkload: baseWorkload.Clone().PriorityClass("high").Priority(1000).Obj(),
+			user:        "user",
+			wantErr: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "priority"), ""),
+			}.ToAggregate(),
+		},
+		"change the priority to a value not matching the priority class": {
+			newWorkload: baseWorkload.Clone().PriorityClass("high").Priority(5000).Obj(),
+			user:        "admin",
+			wantErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "priority"), int32(5000), ""),
+			}.ToAggregate(),
+		},
+		"change the priority to a missing priority class": {
+			newWorkload: baseWorkload.Clone().PriorityClass("missing").Priority(1000).Obj(),
+			user:        "admin",
+			wantErr: field.ErrorList{
+				field.NotFound(field.NewPath("spec", "priorityClassName"), "missing"),
+			}.ToAggregate(),
+		},
+		"set the priority change annotation without changing the priority": {
+			newWorkload: baseWorkload.Clone().Annotation(controllerconsts.PriorityChangeAnnotation, `{"user":"admin"}`).Obj(),
+			user:        "admin",
+			wantErr: field.ErrorList{
+				field.Forbidden(field.NewPath("metadata", "annotations").Key(controllerconsts.PriorityChangeAnnotation), ""),
+			}.ToAggregate(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkloadPriorityChange, true)
+			cl := testingutil.NewClientBuilder().
+				WithObjects(
+					testingutil.MakeWorkloadPriorityClass("low").PriorityValue(100).Obj(),
+					testingutil.MakeWorkloadPriorityClass("high").PriorityValue(1000).Obj(),
+				).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+						sar := obj.(*authorizationv1.SubjectAccessReview)
+						sar.Status.Allowed = sar.Spec.User == "admin" && sar.Spec.ResourceAttributes.Subresource == "priority"
+						return nil
+					},
+				}).Build()
+			wh := &WorkloadWebhook{client: cl}
+			ctx := admission.NewContextWithRequest(t.Context(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: tc.user},
+				},
+			})
+			_, err := wh.ValidateUpdate(ctx, baseWorkload.Clone().Obj(), tc.newWorkload)
+			if diff := cmp.Diff(tc.wantErr, err, cmpopts.IgnoreFields(field.Error{}, "Detail")); diff != "" {
+				t.Errorf("Unexpected error (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRecordPriorityChange(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.WorkloadPriorityChange, true)
+	oldWl := testingutil.MakeWorkload(testWorkloadName, testWorkloadNamespace).
+		PriorityClass("low").
+		Priority(100).
+		PriorityClassSource(constants.WorkloadPriorityClassSource).
+		Obj()
+	oldRaw, err := json.Marshal(oldWl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Update,
+			UserInfo:  authenticationv1.UserInfo{Username: "admin"},
+			OldObject: runtime.RawExtension{Raw: oldRaw},
+		},
+	}
+	wl := oldWl.DeepCopy()
+	wl.Spec.PriorityClassName = "high"
+	wl.Spec.Priority = ptr.To[int32](1000)
+	wh := &WorkloadWebhook{}
+	if err := wh.Default(admission.NewContextWithRequest(t.Context(), req), wl); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got, err := workload.GetPriorityChange(wl)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := &workload.PriorityChange{
+		User:                      "admin",
+		PreviousPriorityClassName: "low",
+		PreviousPriority:          ptr.To[int32](100),
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(workload.PriorityChange{}, "Time")); diff != "" {
+		t.Errorf("Unexpected priority change (-want,+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"fmt"
+	"time"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+// AdmissionTarget returns the time within which the workload is expected to
+// reserve quota, from its AdmissionTargetAnnotation or else from the
+// admissionTarget of its LocalQueue, or nil if the workload has no target.
+// The LocalQueue can be nil.
+func AdmissionTarget(wl *kueue.Workload, lq *kueue.LocalQueue) (*time.Duration, error) {
+	if value, found := wl.Annotations[controllerconsts.AdmissionTargetAnnotation]; found {
+		target, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s annotation: %w", controllerconsts.AdmissionTargetAnnotation, err)
+		}
+		if target <= 0 {
+			return nil, fmt.Errorf("invalid %s annotation: the target must be positive", controllerconsts.AdmissionTargetAnnotation)
+		}
+		return &target, nil
+	}
+	if lq != nil && lq.Spec.AdmissionTarget != nil && lq.Spec.AdmissionTarget.Duration > 0 {
+		return &lq.Spec.AdmissionTarget.Duration, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestAdmissionTarget(t *testing.T) {
+	cases := map[string]struct {
+		wl         *kueue.Workload
+		lq         *kueue.LocalQueue
+		wantTarget *time.Duration
+		wantErr    bool
+	}{
+		"no target": {
+			wl: utiltesting.MakeWorkload("wl", "ns").Obj(),
+			lq: utiltesting.MakeLocalQueue("lq", "ns").Obj(),
+		},
+		"target of the LocalQueue": {
+			wl:         utiltesting.MakeWorkload("wl", "ns").Obj(),
+			lq:         utiltesting.MakeLocalQueue("lq", "ns").AdmissionTarget(time.Hour).Obj(),
+			wantTarget: ptr.To(time.Hour),
+		},
+		"annotation overrides the target of the LocalQueue": {
+			wl:         utiltesting.MakeWorkload("wl", "ns").Annotation(controllerconsts.AdmissionTargetAnnotation, "10m").Obj(),
+			lq:         utiltesting.MakeLocalQueue("lq", "ns").AdmissionTarget(time.Hour).Obj(),
+			wantTarget: ptr.To(10 * time.Minute),
+		},
+		"annotation without LocalQueue": {
+			wl:         utiltesting.MakeWorkload("wl", "ns").Annotation(controllerconsts.AdmissionTargetAnnotation, "10m").Obj(),
+			wantTarget: ptr.To(10 * time.Minute),
+		},
+		"invalid annotation": {
+			wl:      utiltesting.MakeWorkload("wl", "ns").Annotation(controllerconsts.AdmissionTargetAnnotation, "soon").Obj(),
+			wantErr: true,
+		},
+		"negative annotation": {
+			wl:      utiltesting.MakeWorkload("wl", "ns").Annotation(controllerconsts.AdmissionTargetAnnotation, "-1m").Obj(),
+			wantErr: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gotTarget, err := AdmissionTarget(tc.wl, tc.lq)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("AdmissionTarget() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.wantTarget, gotTarget); diff != "" {
+				t.Errorf("Unexpected target (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,8 +17,11 @@ limitations under the License.
 package workload
 
 import (
+	"fmt"
+	"slices"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -26,6 +29,7 @@ import (
 
 	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
 	"sigs.k8s.io/kueue/pkg/util/admissioncheck"
+	"sigs.k8s.io/kueue/pkg/util/api"
 )
 
 // SyncAdmittedCondition sync the state of the Admitted condition based on the
@@ -82,6 +86,74 @@ func SyncAdmittedCondition(w *kueue.Workload, now time.Time) bool {
 	return apimeta.SetStatusCondition(&w.Status.Conditions, newCondition)
 }
 
+// SyncAdmissionCheckUnschedulableReasons records the AdmissionChecks which are
+// not ready as the reasons why a workload with quota reserved is not admitted.
+// Return true if any change was done.
+func SyncAdmissionCheckUnschedulableReasons(w *kueue.Workload) bool {
+	if !HasQuotaReservation(w) {
+		return false
+	}
+	var reasons []kueue.UnschedulableReason
+	for _, check := range w.Status.AdmissionChecks {
+		if check.State == kueue.CheckStateReady {
+			continue
+		}
+		message := fmt.Sprintf("admission check %s is %s", check.Name, check.State)
+		if check.Message != "" {
+			message += ": " + check.Message
+		}
+		reasons = append(reasons, kueue.UnschedulableReason{
+			Reason:         kueue.UnschedulableReasonAdmissionCheckPending,
+			AdmissionCheck: check.Name,
+			Message:        api.TruncateUnschedulableReasonMessage(message),
+		})
+	}
+	if equality.Semantic.DeepEqual(reasons, w.Status.UnschedulableReasons) {
+		return false
+	}
+	w.Status.UnschedulableReasons = reasons
+	return true
+}
+
+// AdmissionChecksSummary returns the number of ready AdmissionChecks of the
+// workload out of all its checks, and what the workload waits for. Returns an
+// empty string for a workload without checks.
+func AdmissionChecksSummary(w *kueue.Workload) string {
+	checks := w.Status.AdmissionChecks
+	if len(checks) == 0 {
+		return ""
+	}
+	ready := 0
+	var blocking *kueue.AdmissionCheckState
+	for i := range checks {
+		if checks[i].State == kueue.CheckStateReady {
+			ready++
+		} else if blocking == nil {
+			blocking = &checks[i]
+		}
+	}
+	summary := fmt.Sprintf("%d/%d Ready", ready, len(checks))
+	switch {
+	case IsFinished(w) || IsAdmitted(w):
+	case !HasQuotaReservation(w):
+		summary += ", waiting for quota"
+	case blocking != nil:
+		summary += fmt.Sprintf(", blocked on %s (%s) since %s", blocking.Name, blocking.State, blocking.LastTransitionTime.UTC().Format(time.RFC3339))
+	}
+	return summary
+}
+
+// SyncAdmissionChecksSummary updates the summary of the AdmissionChecks of the
+// workload. Return true if any change was done.
+func SyncAdmissionChecksSummary(w *kueue.Workload) bool {
+	summary := AdmissionChecksSummary(w)
+	if summary == w.Status.AdmissionChecksSummary {
+		return false
+	}
+	w.Status.AdmissionChecksSummary = summary
+	return true
+}
+
 // resetChecksOnEviction sets all AdmissionChecks to Pending
 func resetChecksOnEviction(w *kueue.Workload, now time.Time) {
 	checks := w.Status.AdmissionChecks
@@ -164,6 +236,76 @@ func HasAllChecks(wl *kueue.Workload, mustHaveChecks sets.Set[kueue.AdmissionChe
 	return mustHaveChecks.Len() == 0
 }
 
+// ChecksWithReadyDependencies returns the checks whose dependencies, among the
+// checks, are Ready in the workload. The checks whose dependencies are not Ready
+// yet are left out, so they are added to the workload in later stages.
+func ChecksWithReadyDependencies(wl *kueue.Workload, checks sets.Set[kueue.AdmissionCheckReference], dependencies map[kueue.AdmissionCheckReference][]kueue.AdmissionCheckReference) sets.Set[kueue.AdmissionCheckReference] {
+	if len(dependencies) == 0 {
+		return checks
+	}
+	result := sets.New[kueue.AdmissionCheckReference]()
+	for check := range checks {
+		ready := true
+		for _, dependency := range dependencies[check] {
+			if !checks.Has(dependency) {
+				continue
+			}
+			state := admissioncheck.FindAdmissionCheck(wl.Status.AdmissionChecks, dependency)
+			if state == nil || state.State != kueue.CheckStateReady {
+				ready = false
+				break
+			}
+		}
+		if ready {
+			result.Insert(check)
+		}
+	}
+	return result
+}
+
+// ChecksForPodSets returns the checks that run for the PodSets of the workload.
+// A check limited to some PodSets is left out when the workload has none of them,
+// or, if the check is also limited to some flavors, when none of these PodSets
+// is assigned one of the flavors.
+func ChecksForPodSets(wl *kueue.Workload, checks sets.Set[kueue.AdmissionCheckReference], podSets map[kueue.AdmissionCheckReference]sets.Set[kueue.PodSetReference], flavors map[kueue.AdmissionCheckReference]sets.Set[kueue.ResourceFlavorReference]) sets.Set[kueue.AdmissionCheckReference] {
+	if len(podSets) == 0 {
+		return checks
+	}
+	result := sets.New[kueue.AdmissionCheckReference]()
+	for check := range checks {
+		checkPodSets, found := podSets[check]
+		if !found {
+			result.Insert(check)
+			continue
+		}
+		if !slices.ContainsFunc(wl.Spec.PodSets, func(ps kueue.PodSet) bool { return checkPodSets.Has(ps.Name) }) {
+			continue
+		}
+		if len(flavors[check]) > 0 && !podSetsAssignedFlavors(wl, checkPodSets, flavors[check]) {
+			continue
+		}
+		result.Insert(check)
+	}
+	return result
+}
+
+func podSetsAssignedFlavors(wl *kueue.Workload, podSets sets.Set[kueue.PodSetReference], flavors sets.Set[kueue.ResourceFlavorReference]) bool {
+	if wl.Status.Admission == nil {
+		return false
+	}
+	for _, psa := range wl.Status.Admission.PodSetAssignments {
+		if !podSets.Has(psa.Name) {
+			continue
+		}
+		for _, flavor := range psa.Flavors {
+			if flavors.Has(flavor) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // HasRetryChecks returns true if any of the workloads checks is Retry
 func HasRetryChecks(wl *kueue.Workload) bool {
 	for i := range wl.Status.AdmissionChecks {
@@ -24,6 +24,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	testingclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 
@@ -377,6 +378,172 @@ func TestSyncAdmittedCondition(t *testing.T) {
 	}
 }
 
+func TestSyncAdmissionCheckUnschedulableReasons(t *testing.T) {
+	cases := map[string]struct {
+		quotaReserved bool
+		checkStates   []kueue.AdmissionCheckState
+		reasons       []kueue.UnschedulableReason
+
+		wantReasons []kueue.UnschedulableReason
+		wantChange  bool
+	}{
+		"no reservation": {
+			checkStates: []kueue.AdmissionCheckState{
+				{Name: "check1", State: kueue.CheckStatePending},
+			},
+			reasons: []kueue.UnschedulableReason{
+				{Reason: kueue.UnschedulableReasonInsufficientQuota, Message: "insufficient quota"},
+			},
+			wantReasons: []kueue.UnschedulableReason{
+				{Reason: kueue.UnschedulableReasonInsufficientQuota, Message: "insufficient quota"},
+			},
+		},
+		"reservation, checks not ready": {
+			quotaReserved: true,
+			checkStates: []kueue.AdmissionCheckState{
+				{Name: "check1", State: kueue.CheckStatePending, Message: "waiting for capacity"},
+				{Name: "check2", State: kueue.CheckStateReady},
+			},
+			reasons: []kueue.UnschedulableReason{
+				{Reason: kueue.UnschedulableReasonInsufficientQuota, Message: "insufficient quota"},
+			},
+			wantReasons: []kueue.UnschedulableReason{
+				{
+					Reason:         kueue.UnschedulableReasonAdmissionCheckPending,
+					AdmissionCheck: "check1",
+					Message:        "admission check check1 is Pending: waiting for capacity",
+				},
+			},
+			wantChange: true,
+		},
+		"reservation, checks not ready, no change": {
+			quotaReserved: true,
+			checkStates: []kueue.AdmissionCheckState{
+				{Name: "check1", State: kueue.CheckStatePending},
+			},
+			reasons: []kueue.UnschedulableReason{
+				{
+					Reason:         kueue.UnschedulableReasonAdmissionCheckPending,
+					AdmissionCheck: "check1",
+					Message:        "admission check check1 is Pending",
+				},
+			},
+			wantReasons: []kueue.UnschedulableReason{
+				{
+					Reason:         kueue.UnschedulableReasonAdmissionCheckPending,
+					AdmissionCheck: "check1",
+					Message:        "admission check check1 is Pending",
+				},
+			},
+		},
+		"reservation, all checks ready": {
+			quotaReserved: true,
+			checkStates: []kueue.AdmissionCheckState{
+				{Name: "check1", State: kueue.CheckStateReady},
+			},
+			reasons: []kueue.UnschedulableReason{
+				{
+					Reason:         kueue.UnschedulableReasonAdmissionCheckPending,
+					AdmissionCheck: "check1",
+					Message:        "admission check check1 is Pending",
+				},
+			},
+			wantChange: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wl := utiltesting.MakeWorkload("foo", "bar").
+				AdmissionChecks(tc.checkStates...).
+				Obj()
+			if tc.quotaReserved {
+				wl.Status.Conditions = []metav1.Condition{{
+					Type:   kueue.WorkloadQuotaReserved,
+					Status: metav1.ConditionTrue,
+				}}
+			}
+			wl.Status.UnschedulableReasons = tc.reasons
+
+			gotChange := SyncAdmissionCheckUnschedulableReasons(wl)
+			if gotChange != tc.wantChange {
+				t.Errorf("Unexpected change status, expecting %v", tc.wantChange)
+			}
+			if diff := cmp.Diff(tc.wantReasons, wl.Status.UnschedulableReasons, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Unexpected reasons (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncAdmissionChecksSummary(t *testing.T) {
+	since := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	cases := map[string]struct {
+		conditions  []metav1.Condition
+		checkStates []kueue.AdmissionCheckState
+		summary     string
+
+		wantSummary string
+		wantChange  bool
+	}{
+		"no checks": {},
+		"no reservation": {
+			checkStates: []kueue.AdmissionCheckState{
+				{Name: "check1", State: kueue.CheckStatePending},
+			},
+			wantSummary: "0/1 Ready, waiting for quota",
+			wantChange:  true,
+		},
+		"reservation, checks not ready": {
+			conditions: []metav1.Condition{{Type: kueue.WorkloadQuotaReserved, Status: metav1.ConditionTrue}},
+			checkStates: []kueue.AdmissionCheckState{
+				{Name: "check1", State: kueue.CheckStateReady},
+				{Name: "check2", State: kueue.CheckStateRetry, LastTransitionTime: since},
+				{Name: "check3", State: kueue.CheckStatePending},
+			},
+			wantSummary: "1/3 Ready, blocked on check2 (Retry) since 2025-01-01T00:00:00Z",
+			wantChange:  true,
+		},
+		"reservation, checks not ready, no change": {
+			conditions: []metav1.Condition{{Type: kueue.WorkloadQuotaReserved, Status: metav1.ConditionTrue}},
+			checkStates: []kueue.AdmissionCheckState{
+				{Name: "check1", State: kueue.CheckStatePending, LastTransitionTime: since},
+			},
+			summary:     "0/1 Ready, blocked on check1 (Pending) since 2025-01-01T00:00:00Z",
+			wantSummary: "0/1 Ready, blocked on check1 (Pending) since 2025-01-01T00:00:00Z",
+		},
+		"admitted": {
+			conditions: []metav1.Condition{
+				{Type: kueue.WorkloadQuotaReserved, Status: metav1.ConditionTrue},
+				{Type: kueue.WorkloadAdmitted, Status: metav1.ConditionTrue},
+			},
+			checkStates: []kueue.AdmissionCheckState{
+				{Name: "check1", State: kueue.CheckStateReady},
+				{Name: "check2", State: kueue.CheckStateReady},
+			},
+			summary:     "1/2 Ready, blocked on check2 (Pending) since 2025-01-01T00:00:00Z",
+			wantSummary: "2/2 Ready",
+			wantChange:  true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wl := utiltesting.MakeWorkload("foo", "bar").
+				AdmissionChecks(tc.checkStates...).
+				Obj()
+			wl.Status.Conditions = tc.conditions
+			wl.Status.AdmissionChecksSummary = tc.summary
+
+			gotChange := SyncAdmissionChecksSummary(wl)
+			if gotChange != tc.wantChange {
+				t.Errorf("Unexpected change status, expecting %v", tc.wantChange)
+			}
+			if diff := cmp.Diff(tc.wantSummary, wl.Status.AdmissionChecksSummary); diff != "" {
+				t.Errorf("Unexpected summary (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestSetCheckState(t *testing.T) {
 	now := time.Now()
 	fakeClock := testingclock.NewFakeClock(now)
@@ -552,3 +719,124 @@ func TestSetCheckState(t *testing.T) {
 		})
 	}
 }
+
+func TestChecksWithReadyDependencies(t *testing.T) {
+	dependencies := map[kueue.AdmissionCheckReference][]kueue.AdmissionCheckReference{
+		"provisioning": {"budget"},
+		"multikueue":   {"provisioning"},
+	}
+	cases := map[string]struct {
+		states []kueue.AdmissionCheckState
+		checks sets.Set[kueue.AdmissionCheckReference]
+		want   sets.Set[kueue.AdmissionCheckReference]
+	}{
+		"dependency not in the workload yet": {
+			checks: sets.New[kueue.AdmissionCheckReference]("budget", "provisioning", "multikueue"),
+			want:   sets.New[kueue.AdmissionCheckReference]("budget"),
+		},
+		"dependency pending": {
+			states: []kueue.AdmissionCheckState{
+				{Name: "budget", State: kueue.CheckStatePending},
+			},
+			checks: sets.New[kueue.AdmissionCheckReference]("budget", "provisioning", "multikueue"),
+			want:   sets.New[kueue.AdmissionCheckReference]("budget"),
+		},
+		"dependency ready": {
+			states: []kueue.AdmissionCheckState{
+				{Name: "budget", State: kueue.CheckStateReady},
+			},
+			checks: sets.New[kueue.AdmissionCheckReference]("budget", "provisioning", "multikueue"),
+			want:   sets.New[kueue.AdmissionCheckReference]("budget", "provisioning"),
+		},
+		"all dependencies ready": {
+			states: []kueue.AdmissionCheckState{
+				{Name: "budget", State: kueue.CheckStateReady},
+				{Name: "provisioning", State: kueue.CheckStateReady},
+			},
+			checks: sets.New[kueue.AdmissionCheckReference]("budget", "provisioning", "multikueue"),
+			want:   sets.New[kueue.AdmissionCheckReference]("budget", "provisioning", "multikueue"),
+		},
+		"dependency not applying to the workload": {
+			checks: sets.New[kueue.AdmissionCheckReference]("provisioning"),
+			want:   sets.New[kueue.AdmissionCheckReference]("provisioning"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wl := utiltesting.MakeWorkload("wl", "ns").Obj()
+			wl.Status.AdmissionChecks = tc.states
+			got := ChecksWithReadyDependencies(wl, tc.checks, dependencies)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected checks (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChecksForPodSets(t *testing.T) {
+	podSets := map[kueue.AdmissionCheckReference]sets.Set[kueue.PodSetReference]{
+		"provisioning": sets.New[kueue.PodSetReference]("worker"),
+	}
+	cases := map[string]struct {
+		podSets   []kueue.PodSet
+		flavors   map[kueue.AdmissionCheckReference]sets.Set[kueue.ResourceFlavorReference]
+		admission *kueue.Admission
+		want      sets.Set[kueue.AdmissionCheckReference]
+	}{
+		"workload with the PodSet": {
+			podSets: []kueue.PodSet{
+				*utiltesting.MakePodSet("driver", 1).Obj(),
+				*utiltesting.MakePodSet("worker", 4).Obj(),
+			},
+			want: sets.New[kueue.AdmissionCheckReference]("budget", "provisioning"),
+		},
+		"workload without the PodSet": {
+			podSets: []kueue.PodSet{
+				*utiltesting.MakePodSet("driver", 1).Obj(),
+			},
+			want: sets.New[kueue.AdmissionCheckReference]("budget"),
+		},
+		"PodSet assigned one of the flavors": {
+			podSets: []kueue.PodSet{
+				*utiltesting.MakePodSet("driver", 1).Obj(),
+				*utiltesting.MakePodSet("worker", 4).Obj(),
+			},
+			flavors: map[kueue.AdmissionCheckReference]sets.Set[kueue.ResourceFlavorReference]{
+				"provisioning": sets.New[kueue.ResourceFlavorReference]("gpu"),
+			},
+			admission: utiltesting.MakeAdmission("cq").
+				PodSets(
+					utiltesting.MakePodSetAssignment("driver").Assignment(corev1.ResourceCPU, "cpu", "1").Obj(),
+					utiltesting.MakePodSetAssignment("worker").Assignment(corev1.ResourceCPU, "gpu", "4").Obj(),
+				).
+				Obj(),
+			want: sets.New[kueue.AdmissionCheckReference]("budget", "provisioning"),
+		},
+		"only another PodSet assigned one of the flavors": {
+			podSets: []kueue.PodSet{
+				*utiltesting.MakePodSet("driver", 1).Obj(),
+				*utiltesting.MakePodSet("worker", 4).Obj(),
+			},
+			flavors: map[kueue.AdmissionCheckReference]sets.Set[kueue.ResourceFlavorReference]{
+				"provisioning": sets.New[kueue.ResourceFlavorReference]("gpu"),
+			},
+			admission: utiltesting.MakeAdmission("cq").
+				PodSets(
+					utiltesting.MakePodSetAssignment("driver").Assignment(corev1.ResourceCPU, "gpu", "1").Obj(),
+					utiltesting.MakePodSetAssignment("worker").Assignment(corev1.ResourceCPU, "cpu", "4").Obj(),
+				).
+				Obj(),
+			want: sets.New[kueue.AdmissionCheckReference]("budget"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			wl := utiltesting.MakeWorkload("wl", "ns").PodSets(tc.podSets...).Obj()
+			wl.Status.Admission = tc.admission
+			got := ChecksForPodSets(wl, sets.New[kueue.AdmissionCheckReference]("budget", "provisioning"), podSets, tc.flavors)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Unexpected checks (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
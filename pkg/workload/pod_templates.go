@@ -0,0 +1,189 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+const (
+	// PodTemplateCompactionThreshold is the size, in bytes of JSON, above
+	// which the pod template of a podSet is compacted.
+	PodTemplateCompactionThreshold = 16 * 1024
+
+	podTemplateConfigMapPrefix = "kueue-pod-template-"
+)
+
+// CompactPodSets returns a copy of the podSets in which the pod templates
+// larger than the PodTemplateCompactionThreshold are compacted. The
+// compaction is idempotent, so the podSets of a job and of its compacted
+// workload can be compared once both are compacted.
+func CompactPodSets(podSets []kueue.PodSet) []kueue.PodSet {
+	compacted := make([]kueue.PodSet, len(podSets))
+	for i := range podSets {
+		podSets[i].DeepCopyInto(&compacted[i])
+		if podTemplateSize(&compacted[i].Template) > PodTemplateCompactionThreshold {
+			compactPodTemplate(&compacted[i].Template)
+		}
+	}
+	return compacted
+}
+
+// CompactPodTemplates compacts the pod templates of the workload larger than
+// the PodTemplateCompactionThreshold, records the names of the ConfigMaps
+// storing their full templates in the PodTemplatesAnnotation, and returns
+// these ConfigMaps, which are left to be created in the namespace of the
+// workload.
+func CompactPodTemplates(wl *kueue.Workload) ([]*corev1.ConfigMap, error) {
+	var configMaps []*corev1.ConfigMap
+	templates := make(map[kueue.PodSetReference]string)
+	for i := range wl.Spec.PodSets {
+		ps := &wl.Spec.PodSets[i]
+		data, err := json.Marshal(&ps.Template)
+		if err != nil {
+			return nil, fmt.Errorf("encoding the pod template of the podSet %q: %w", ps.Name, err)
+		}
+		if len(data) <= PodTemplateCompactionThreshold {
+			continue
+		}
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      PodTemplateConfigMapName(data),
+				Namespace: wl.Namespace,
+			},
+			Data: map[string]string{
+				controllerconsts.PodTemplateConfigMapKey: string(data),
+			},
+		}
+		configMaps = append(configMaps, cm)
+		templates[ps.Name] = cm.Name
+		compactPodTemplate(&ps.Template)
+	}
+	if len(templates) == 0 {
+		delete(wl.Annotations, controllerconsts.PodTemplatesAnnotation)
+		return nil, nil
+	}
+	value, err := json.Marshal(templates)
+	if err != nil {
+		return nil, err
+	}
+	metav1.SetMetaDataAnnotation(&wl.ObjectMeta, controllerconsts.PodTemplatesAnnotation, string(value))
+	return configMaps, nil
+}
+
+// GetPodTemplates returns the names of the ConfigMaps storing the full pod
+// templates of the compacted podSets of the workload, or nil if none of its
+// pod templates were compacted.
+func GetPodTemplates(wl *kueue.Workload) (map[kueue.PodSetReference]string, error) {
+	value, found := wl.Annotations[controllerconsts.PodTemplatesAnnotation]
+	if !found {
+		return nil, nil
+	}
+	templates := make(map[kueue.PodSetReference]string)
+	if err := json.Unmarshal([]byte(value), &templates); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// FullPodTemplate returns the full pod template of the podSet of the
+// workload, read from its ConfigMap when the template was compacted.
+func FullPodTemplate(ctx context.Context, c client.Reader, wl *kueue.Workload, ps *kueue.PodSet) (*corev1.PodTemplateSpec, error) {
+	templates, err := GetPodTemplates(wl)
+	if err != nil {
+		return nil, err
+	}
+	name, found := templates[ps.Name]
+	if !found {
+		return &ps.Template, nil
+	}
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: wl.Namespace, Name: name}, cm); err != nil {
+		return nil, fmt.Errorf("getting the pod template of the podSet %q: %w", ps.Name, err)
+	}
+	return PodTemplateFromConfigMap(cm)
+}
+
+// PodTemplateFromConfigMap decodes the full pod template stored in the
+// ConfigMap, after verifying that it matches the name of the ConfigMap,
+// derived from its content.
+func PodTemplateFromConfigMap(cm *corev1.ConfigMap) (*corev1.PodTemplateSpec, error) {
+	value, found := cm.Data[controllerconsts.PodTemplateConfigMapKey]
+	if !found {
+		return nil, fmt.Errorf("the ConfigMap %s/%s has no pod template", cm.Namespace, cm.Name)
+	}
+	if PodTemplateConfigMapName([]byte(value)) != cm.Name {
+		return nil, fmt.Errorf("the pod template of the ConfigMap %s/%s doesn't match its name", cm.Namespace, cm.Name)
+	}
+	template := &corev1.PodTemplateSpec{}
+	if err := json.Unmarshal([]byte(value), template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// PodTemplateConfigMapName returns the name of the ConfigMap storing the
+// encoded pod template, derived from its content so that the identical pod
+// templates of several workloads are stored once.
+func PodTemplateConfigMapName(encodedTemplate []byte) string {
+	sum := sha256.Sum256(encodedTemplate)
+	return podTemplateConfigMapPrefix + hex.EncodeToString(sum[:16])
+}
+
+func podTemplateSize(template *corev1.PodTemplateSpec) int {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return 0
+	}
+	return len(data)
+}
+
+// compactPodTemplate drops the fields of the containers which are not used
+// for the scheduling of the workload, such as their commands, arguments,
+// environment, probes and volume mounts, which make up most of the size of
+// the large pod templates.
+func compactPodTemplate(template *corev1.PodTemplateSpec) {
+	for i := range template.Spec.InitContainers {
+		compactContainer(&template.Spec.InitContainers[i])
+	}
+	for i := range template.Spec.Containers {
+		compactContainer(&template.Spec.Containers[i])
+	}
+}
+
+func compactContainer(c *corev1.Container) {
+	*c = corev1.Container{
+		Name:          c.Name,
+		Image:         c.Image,
+		Ports:         c.Ports,
+		Resources:     c.Resources,
+		ResizePolicy:  c.ResizePolicy,
+		RestartPolicy: c.RestartPolicy,
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+	utiltesting "sigs.k8s.io/kueue/pkg/util/testing"
+)
+
+func TestCompactPodTemplates(t *testing.T) {
+	largeContainer := corev1.Container{
+		Name:    "step",
+		Image:   "image",
+		Command: []string{"sh", "-c"},
+		Args:    []string{strings.Repeat("echo step;", 2*PodTemplateCompactionThreshold/10)},
+		Env:     []corev1.EnvVar{{Name: "PARAM", Value: "value"}},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+		},
+	}
+	wl := utiltesting.MakeWorkload("wl", "ns").
+		PodSets(
+			*utiltesting.MakePodSet("large", 1).
+				Containers(largeContainer).
+				NodeSelector(map[string]string{"zone": "a"}).
+				Obj(),
+			*utiltesting.MakePodSet("small", 1).Request(corev1.ResourceCPU, "1").Obj(),
+		).
+		Obj()
+	original := wl.DeepCopy()
+
+	configMaps, err := CompactPodTemplates(wl)
+	if err != nil {
+		t.Fatalf("CompactPodTemplates() error = %v", err)
+	}
+
+	if len(configMaps) != 1 {
+		t.Fatalf("Unexpected ConfigMaps count, want 1, got %d", len(configMaps))
+	}
+	templates, err := GetPodTemplates(wl)
+	if err != nil {
+		t.Fatalf("GetPodTemplates() error = %v", err)
+	}
+	wantTemplates := map[kueue.PodSetReference]string{"large": configMaps[0].Name}
+	if diff := cmp.Diff(wantTemplates, templates); diff != "" {
+		t.Errorf("Unexpected pod templates (-want,+got):\n%s", diff)
+	}
+	if configMaps[0].Namespace != "ns" {
+		t.Errorf("Unexpected namespace of the ConfigMap %q", configMaps[0].Namespace)
+	}
+	fullTemplate, err := PodTemplateFromConfigMap(configMaps[0])
+	if err != nil {
+		t.Fatalf("PodTemplateFromConfigMap() error = %v", err)
+	}
+	if diff := cmp.Diff(original.Spec.PodSets[0].Template, *fullTemplate); diff != "" {
+		t.Errorf("Unexpected full pod template (-want,+got):\n%s", diff)
+	}
+
+	wantLargeTemplate := original.Spec.PodSets[0].Template.DeepCopy()
+	wantLargeTemplate.Spec.Containers[0] = corev1.Container{
+		Name:      "step",
+		Image:     "image",
+		Resources: largeContainer.Resources,
+	}
+	if diff := cmp.Diff(*wantLargeTemplate, wl.Spec.PodSets[0].Template); diff != "" {
+		t.Errorf("Unexpected compacted pod template (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(original.Spec.PodSets[1], wl.Spec.PodSets[1]); diff != "" {
+		t.Errorf("Unexpected change of the small pod template (-want,+got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(wl.Spec.PodSets, CompactPodSets(original.Spec.PodSets)); diff != "" {
+		t.Errorf("Unexpected compacted podSets (-want,+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(wl.Spec.PodSets, CompactPodSets(wl.Spec.PodSets)); diff != "" {
+		t.Errorf("The compaction is not idempotent (-want,+got):\n%s", diff)
+	}
+
+	other := original.DeepCopy()
+	other.Name = "other"
+	otherConfigMaps, err := CompactPodTemplates(other)
+	if err != nil {
+		t.Fatalf("CompactPodTemplates() error = %v", err)
+	}
+	if len(otherConfigMaps) != 1 || otherConfigMaps[0].Name != configMaps[0].Name {
+		t.Errorf("The identical pod templates are not stored in the same ConfigMap")
+	}
+}
+
+func TestFullPodTemplate(t *testing.T) {
+	largeContainer := corev1.Container{
+		Name:  "step",
+		Image: "image",
+		Args:  []string{strings.Repeat("echo step;", 2*PodTemplateCompactionThreshold/10)},
+	}
+	wl := utiltesting.MakeWorkload("wl", "ns").
+		PodSets(
+			*utiltesting.MakePodSet("large", 1).Containers(largeContainer).Obj(),
+			*utiltesting.MakePodSet("small", 1).Request(corev1.ResourceCPU, "1").Obj(),
+		).
+		Obj()
+	original := wl.DeepCopy()
+	configMaps, err := CompactPodTemplates(wl)
+	if err != nil {
+		t.Fatalf("CompactPodTemplates() error = %v", err)
+	}
+	ctx, _ := utiltesting.ContextWithLog(t)
+
+	t.Run("the full pod template is read from the ConfigMap", func(t *testing.T) {
+		cl := utiltesting.NewFakeClient(configMaps[0])
+		got, err := FullPodTemplate(ctx, cl, wl, &wl.Spec.PodSets[0])
+		if err != nil {
+			t.Fatalf("FullPodTemplate() error = %v", err)
+		}
+		if diff := cmp.Diff(original.Spec.PodSets[0].Template, *got); diff != "" {
+			t.Errorf("Unexpected full pod template (-want,+got):\n%s", diff)
+		}
+	})
+
+	t.Run("the pod template which isn't compacted is returned as is", func(t *testing.T) {
+		cl := utiltesting.NewFakeClient()
+		got, err := FullPodTemplate(ctx, cl, wl, &wl.Spec.PodSets[1])
+		if err != nil {
+			t.Fatalf("FullPodTemplate() error = %v", err)
+		}
+		if diff := cmp.Diff(original.Spec.PodSets[1].Template, *got); diff != "" {
+			t.Errorf("Unexpected pod template (-want,+got):\n%s", diff)
+		}
+	})
+
+	t.Run("the ConfigMap holding another pod template is rejected", func(t *testing.T) {
+		tampered := configMaps[0].DeepCopy()
+		tampered.Data[controllerconsts.PodTemplateConfigMapKey] = "{}"
+		cl := utiltesting.NewFakeClient(tampered)
+		if _, err := FullPodTemplate(ctx, cl, wl, &wl.Spec.PodSets[0]); err == nil {
+			t.Errorf("Expected an error for the ConfigMap which doesn't match its name")
+		}
+	})
+
+	t.Run("the missing ConfigMap is an error", func(t *testing.T) {
+		cl := utiltesting.NewFakeClient()
+		if _, err := FullPodTemplate(ctx, cl, wl, &wl.Spec.PodSets[0]); err == nil {
+			t.Errorf("Expected an error for the missing ConfigMap")
+		}
+	})
+}
@@ -0,0 +1,73 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kueue "sigs.k8s.io/kueue/apis/kueue/v1beta1"
+	controllerconsts "sigs.k8s.io/kueue/pkg/controller/constants"
+)
+
+// PriorityChange is the record of the last change of the priority of a
+// workload, kept in the PriorityChangeAnnotation.
+type PriorityChange struct {
+	// User is the name of the user who changed the priority.
+	User string `json:"user"`
+	// Time is the time of the change.
+	Time metav1.Time `json:"time"`
+	// PreviousPriorityClassName is the priority class of the workload
+	// before the change.
+	PreviousPriorityClassName string `json:"previousPriorityClassName,omitempty"`
+	// PreviousPriority is the priority of the workload before the change.
+	PreviousPriority *int32 `json:"previousPriority,omitempty"`
+}
+
+// PriorityChanged returns true if the priority of the workload differs
+// between the two versions.
+func PriorityChanged(oldWl, newWl *kueue.Workload) bool {
+	return oldWl.Spec.PriorityClassName != newWl.Spec.PriorityClassName ||
+		oldWl.Spec.PriorityClassSource != newWl.Spec.PriorityClassSource ||
+		!ptr.Equal(oldWl.Spec.Priority, newWl.Spec.Priority)
+}
+
+// GetPriorityChange returns the record of the last change of the priority of
+// the workload, or nil if its priority was not changed on the workload.
+func GetPriorityChange(wl *kueue.Workload) (*PriorityChange, error) {
+	value, found := wl.Annotations[controllerconsts.PriorityChangeAnnotation]
+	if !found {
+		return nil, nil
+	}
+	change := &PriorityChange{}
+	if err := json.Unmarshal([]byte(value), change); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// SetPriorityChange records the change of the priority of the workload.
+func SetPriorityChange(wl *kueue.Workload, change *PriorityChange) error {
+	value, err := json.Marshal(change)
+	if err != nil {
+		return err
+	}
+	metav1.SetMetaDataAnnotation(&wl.ObjectMeta, controllerconsts.PriorityChangeAnnotation, string(value))
+	return nil
+}
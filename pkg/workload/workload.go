@@ -61,6 +61,10 @@ const (
 	StatusFinished      = "finished"
 )
 
+// MaxRequeueHistory is the maximum number of evictions kept in the requeue
+// history of a workload.
+const MaxRequeueHistory = 10
+
 var (
 	admissionManagedConditions = []string{
 		kueue.WorkloadQuotaReserved,
@@ -100,6 +104,9 @@ type AssignmentClusterQueueState struct {
 // dra holds DRA-specific configuration for workload.Info construction.
 type dra struct {
 	preprocessedDRAResources map[kueue.PodSetReference]corev1.ResourceList
+	// sharedDRAResources are the resources of the ResourceClaims shared by
+	// the pods of the PodSets, which are accounted once per PodSet.
+	sharedDRAResources map[kueue.PodSetReference]corev1.ResourceList
 }
 
 type InfoOptions struct {
@@ -129,9 +136,15 @@ func WithResourceTransformations(transforms []config.ResourceTransformation) Inf
 // WithPreprocessedDRAResources creates an InfoOption that provides preprocessed DRA resources.
 func WithPreprocessedDRAResources(draResources map[kueue.PodSetReference]corev1.ResourceList) InfoOption {
 	return func(o *InfoOptions) {
-		o.dra = dra{
-			preprocessedDRAResources: draResources,
-		}
+		o.preprocessedDRAResources = draResources
+	}
+}
+
+// WithSharedDRAResources creates an InfoOption that provides the DRA resources
+// of the ResourceClaims shared by the pods of the PodSets.
+func WithSharedDRAResources(draResources map[kueue.PodSetReference]corev1.ResourceList) InfoOption {
+	return func(o *InfoOptions) {
+		o.sharedDRAResources = draResources
 	}
 }
 
@@ -227,6 +240,9 @@ type TopologyDomainRequests struct {
 	SinglePodRequests resources.Requests
 	// Count indicates how many pods are requested in this TopologyDomain.
 	Count int32
+	// ExclusiveLevel is the topology level at which the PodSet requires
+	// exclusive ownership of its domains. Empty if not requested.
+	ExclusiveLevel string
 }
 
 func (t *TopologyDomainRequests) TotalRequests() resources.Requests {
@@ -505,6 +521,14 @@ func totalRequestsFromPodSets(wl *kueue.Workload, info *InfoOptions) []PodSetRes
 			}
 		}
 		setRes.Requests.Mul(int64(count))
+		if features.Enabled(features.DynamicResourceAllocation) && count > 0 {
+			for resName, quantity := range info.sharedDRAResources[ps.Name] {
+				if setRes.Requests == nil {
+					setRes.Requests = make(resources.Requests)
+				}
+				setRes.Requests[resName] += resources.ResourceValue(resName, quantity)
+			}
+		}
 		res = append(res, setRes)
 	}
 
@@ -518,7 +542,7 @@ func totalRequestsFromAdmission(wl *kueue.Workload) []PodSetResources {
 	res := make([]PodSetResources, 0, len(wl.Spec.PodSets))
 	currentCounts := podSetsCountsAfterReclaim(wl)
 	totalCounts := podSetsCounts(wl)
-	for _, psa := range wl.Status.Admission.PodSetAssignments {
+	for i, psa := range wl.Status.Admission.PodSetAssignments {
 		setRes := PodSetResources{
 			Name:     psa.Name,
 			Flavors:  psa.Flavors,
@@ -529,11 +553,16 @@ func totalRequestsFromAdmission(wl *kueue.Workload) []PodSetResources {
 			setRes.TopologyRequest = &TopologyRequest{
 				Levels: psa.TopologyAssignment.Levels,
 			}
+			var exclusiveLevel string
+			if i < len(wl.Spec.PodSets) {
+				exclusiveLevel = ExclusiveTopologyLevel(&wl.Spec.PodSets[i])
+			}
 			for _, domain := range psa.TopologyAssignment.Domains {
 				setRes.TopologyRequest.DomainRequests = append(setRes.TopologyRequest.DomainRequests, TopologyDomainRequests{
 					Values:            domain.Values,
 					SinglePodRequests: setRes.SinglePodRequests(),
 					Count:             domain.Count,
+					ExclusiveLevel:    exclusiveLevel,
 				})
 			}
 		}
@@ -555,6 +584,26 @@ func totalRequestsFromAdmission(wl *kueue.Workload) []PodSetResources {
 	return res
 }
 
+// ExclusiveTopologyLevel returns the topology level at which the PodSet
+// requests exclusive placement, or an empty string if it does not.
+func ExclusiveTopologyLevel(ps *kueue.PodSet) string {
+	if !features.Enabled(features.TASExclusivePlacement) || ps.TopologyRequest == nil {
+		return ""
+	}
+	return ptr.Deref(ps.TopologyRequest.PodSetExclusiveTopology, "")
+}
+
+// BalancedTopology returns the topology level across which the pods of the
+// PodSet are balanced and the number of domains, or an empty string if the
+// PodSet doesn't request balanced placement.
+func BalancedTopology(ps *kueue.PodSet) (string, int32) {
+	if !features.Enabled(features.TASBalancedPlacement) || ps.TopologyRequest == nil ||
+		ps.TopologyRequest.PodSetBalancedTopology == nil || ps.TopologyRequest.PodSetBalancedDomainCount == nil {
+		return "", 0
+	}
+	return *ps.TopologyRequest.PodSetBalancedTopology, *ps.TopologyRequest.PodSetBalancedDomainCount
+}
+
 // UpdateStatus updates the condition of a workload with ssa,
 // fieldManager being set to managerPrefix + "-" + conditionType
 func UpdateStatus(ctx context.Context,
@@ -593,6 +642,9 @@ func UnsetQuotaReservationWithCondition(wl *kueue.Workload, reason, message stri
 	}
 	changed := apimeta.SetStatusCondition(&wl.Status.Conditions, condition)
 	if wl.Status.Admission != nil {
+		if features.Enabled(features.FlavorStickiness) {
+			wl.Status.LastAdmittedFlavors = admittedFlavors(wl.Status.Admission, true)
+		}
 		wl.Status.Admission = nil
 		changed = true
 	}
@@ -604,8 +656,58 @@ func UnsetQuotaReservationWithCondition(wl *kueue.Workload, reason, message stri
 	return changed
 }
 
+// admittedFlavors returns the flavors assigned to the podSets in the admission,
+// and their topology assignments if withTopology is true.
+func admittedFlavors(admission *kueue.Admission, withTopology bool) []kueue.PodSetFlavors {
+	result := make([]kueue.PodSetFlavors, 0, len(admission.PodSetAssignments))
+	for _, psa := range admission.PodSetAssignments {
+		if len(psa.Flavors) == 0 {
+			continue
+		}
+		psf := kueue.PodSetFlavors{
+			Name:    psa.Name,
+			Flavors: maps.Clone(psa.Flavors),
+		}
+		if withTopology {
+			psf.TopologyAssignment = psa.TopologyAssignment.DeepCopy()
+		}
+		result = append(result, psf)
+	}
+	return result
+}
+
+// ExcludeFlavors excludes the flavors for the workload until the expiration
+// time, extending the exclusions already recorded for them.
+func ExcludeFlavors(wl *kueue.Workload, flavors []kueue.ResourceFlavorReference, expirationTime metav1.Time) {
+	for _, flavor := range flavors {
+		idx := slices.IndexFunc(wl.Status.ExcludedFlavors, func(ef kueue.ExcludedFlavor) bool { return ef.Name == flavor })
+		if idx >= 0 {
+			wl.Status.ExcludedFlavors[idx].ExpirationTime = expirationTime
+		} else {
+			wl.Status.ExcludedFlavors = append(wl.Status.ExcludedFlavors, kueue.ExcludedFlavor{Name: flavor, ExpirationTime: expirationTime})
+		}
+	}
+}
+
+// IsFlavorExcluded returns whether the flavor is excluded for the workload.
+func IsFlavorExcluded(wl *kueue.Workload, flavor kueue.ResourceFlavorReference) bool {
+	return slices.ContainsFunc(wl.Status.ExcludedFlavors, func(ef kueue.ExcludedFlavor) bool {
+		return ef.Name == flavor
+	})
+}
+
+// DefaultRequeuingBackoffJitter is the jitter of the requeuing backoff of
+// UpdateRequeueState.
+const DefaultRequeuingBackoffJitter = 0.0001
+
 // UpdateRequeueState calculate requeueAt time and update requeuingCount
 func UpdateRequeueState(wl *kueue.Workload, backoffBaseSeconds int32, backoffMaxSeconds int32, clock clock.Clock) {
+	UpdateRequeueStateWithJitter(wl, backoffBaseSeconds, backoffMaxSeconds, DefaultRequeuingBackoffJitter, clock)
+}
+
+// UpdateRequeueStateWithJitter calculate requeueAt time, with a random jitter of
+// up to jitter times the backoff, and update requeuingCount
+func UpdateRequeueStateWithJitter(wl *kueue.Workload, backoffBaseSeconds int32, backoffMaxSeconds int32, jitter float64, clock clock.Clock) {
 	if wl.Status.RequeueState == nil {
 		wl.Status.RequeueState = &kueue.RequeueState{}
 	}
@@ -619,7 +721,7 @@ func UpdateRequeueState(wl *kueue.Workload, backoffBaseSeconds int32, backoffMax
 	backoff := &wait.Backoff{
 		Duration: time.Duration(backoffBaseSeconds) * time.Second,
 		Factor:   2,
-		Jitter:   0.0001,
+		Jitter:   jitter,
 		Steps:    int(requeuingCount),
 	}
 	var waitDuration time.Duration
@@ -655,6 +757,16 @@ func QueuedWaitTime(wl *kueue.Workload, clock clock.Clock) time.Duration {
 	return clock.Since(queuedTime)
 }
 
+// WaitTimeSinceCreation returns the time since the creation of the workload,
+// and false if the workload was evicted before, as the time doesn't measure
+// the wait for its first admission then.
+func WaitTimeSinceCreation(wl *kueue.Workload, clock clock.Clock) (time.Duration, bool) {
+	if wl.Status.SchedulingStats != nil && len(wl.Status.SchedulingStats.Evictions) > 0 {
+		return 0, false
+	}
+	return clock.Since(wl.CreationTimestamp.Time), true
+}
+
 // workloadsWithPodsReadyToEvictedTime is the amount of time it takes a workload's pods running to getting evicted.
 // This measures runtime of workloads that do not run to completion (ie are evicted).
 func workloadsWithPodsReadyToEvictedTime(wl *kueue.Workload) *time.Duration {
@@ -876,6 +988,12 @@ func admissionStatusPatch(w *kueue.Workload, wlCopy *kueue.Workload) {
 	wlCopy.Status.ClusterName = w.Status.ClusterName
 	wlCopy.Status.NominatedClusterNames = w.Status.NominatedClusterNames
 	wlCopy.Status.UnhealthyNodes = w.Status.UnhealthyNodes
+	wlCopy.Status.UnschedulableReasons = w.Status.UnschedulableReasons
+	wlCopy.Status.AdmissionChecksSummary = w.Status.AdmissionChecksSummary
+	wlCopy.Status.LastAdmittedFlavors = w.Status.LastAdmittedFlavors
+	wlCopy.Status.ExcludedFlavors = w.Status.ExcludedFlavors
+	wlCopy.Status.RequeueHistory = w.Status.RequeueHistory
+	wlCopy.Status.ActualUsage = w.Status.ActualUsage
 }
 
 func admissionChecksStatusPatch(w *kueue.Workload, wlCopy *kueue.Workload, c clock.Clock) {
@@ -1048,6 +1166,12 @@ func IsActive(w *kueue.Workload) bool {
 	return ptr.Deref(w.Spec.Active, true)
 }
 
+// IsHeld returns true if the workload is held, and so is skipped by the
+// scheduler until it is released.
+func IsHeld(w *kueue.Workload) bool {
+	return features.Enabled(features.WorkloadHold) && ptr.Deref(w.Spec.Held, false)
+}
+
 // HasDRA returns true if the workload has DRA resources (ResourceClaims or ResourceClaimTemplates).
 func HasDRA(w *kueue.Workload) bool {
 	return HasResourceClaim(w) || HasResourceClaimTemplates(w)
@@ -1223,6 +1347,7 @@ type EvictOption func(*EvictOptions)
 
 type EvictOptions struct {
 	CustomPrepare func() (*kueue.Workload, error)
+	Preemptor     *Info
 }
 
 func DefaultEvictOptions() *EvictOptions {
@@ -1231,6 +1356,14 @@ func DefaultEvictOptions() *EvictOptions {
 	}
 }
 
+// WithPreemptor sets the workload preempting the evicted workload, recorded
+// in its requeue history.
+func WithPreemptor(preemptor *Info) EvictOption {
+	return func(o *EvictOptions) {
+		o.Preemptor = preemptor
+	}
+}
+
 func WithCustomPrepare(customPrepare func() (*kueue.Workload, error)) EvictOption {
 	return func(o *EvictOptions) {
 		if customPrepare != nil {
@@ -1260,6 +1393,9 @@ func Evict(ctx context.Context, c client.Client, recorder record.EventRecorder,
 		evictionReason = ReasonWithCause(evictionReason, string(underlyingCause))
 	}
 	prepareForEviction(wl, clock.Now(), evictionReason, msg)
+	if features.Enabled(features.WorkloadRequeueHistory) {
+		recordRequeue(wl, clock.Now(), reason, underlyingCause, msg, opts.Preemptor)
+	}
 	reportWorkloadEvictedOnce := workloadEvictionStateInc(wl, reason, underlyingCause)
 	if err := PatchAdmissionStatus(ctx, c, wlOrig, clock, func() (*kueue.Workload, bool, error) {
 		return wl, true, nil
@@ -1288,6 +1424,34 @@ func prepareForEviction(w *kueue.Workload, now time.Time, reason, message string
 	resetUnhealthyNodes(w)
 }
 
+// recordRequeue appends the eviction to the requeue history of the workload,
+// keeping the last MaxRequeueHistory evictions.
+func recordRequeue(w *kueue.Workload, now time.Time, reason string, underlyingCause kueue.EvictionUnderlyingCause, message string, preemptor *Info) {
+	record := kueue.RequeueRecord{
+		Time:            metav1.NewTime(now),
+		Reason:          reason,
+		UnderlyingCause: underlyingCause,
+		Message:         api.TruncateEventMessage(message),
+	}
+	if w.Status.Admission != nil {
+		record.ClusterQueue = w.Status.Admission.ClusterQueue
+		record.Flavors = admittedFlavors(w.Status.Admission, false)
+	}
+	if preemptor != nil {
+		record.Preemptor = &kueue.RequeueRecordPreemptor{
+			Namespace:    preemptor.Obj.Namespace,
+			Name:         preemptor.Obj.Name,
+			UID:          preemptor.Obj.UID,
+			ClusterQueue: preemptor.ClusterQueue,
+		}
+	}
+	history := append(slices.Clone(w.Status.RequeueHistory), record)
+	if len(history) > MaxRequeueHistory {
+		history = history[len(history)-MaxRequeueHistory:]
+	}
+	w.Status.RequeueHistory = history
+}
+
 func resetClusterNomination(w *kueue.Workload) {
 	w.Status.ClusterName = nil
 	w.Status.NominatedClusterNames = nil
@@ -1310,6 +1474,9 @@ func reportEvictedWorkload(recorder record.EventRecorder, wl *kueue.Workload, cq
 			wl.Spec.PriorityClassName,
 		)
 	}
+	if features.Enabled(features.NamespaceMetrics) {
+		metrics.ReportNamespaceEvictedWorkloads(wl.Namespace, reason)
+	}
 	eventReason := ReasonWithCause(kueue.WorkloadEvicted, reason)
 	if reason == kueue.WorkloadDeactivated && underlyingCause != "" {
 		eventReason = ReasonWithCause(eventReason, string(underlyingCause))
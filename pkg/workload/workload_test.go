@@ -19,6 +19,7 @@ package workload
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -1573,3 +1574,140 @@ func TestPatchAdmissionStatus(t *testing.T) {
 		}
 	}
 }
+
+func TestEvictRecordsRequeueHistory(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	fakeClock := testingclock.NewFakeClock(now)
+	admission := utiltesting.MakeAdmission("cq").PodSets(
+		utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).Assignment(corev1.ResourceCPU, "on-demand", "1").Obj(),
+	).Obj()
+	preemptor := NewInfo(utiltesting.MakeWorkload("preemptor", "other").UID("preemptor-uid").Obj())
+	preemptor.ClusterQueue = "other-cq"
+
+	olderRecords := make([]kueue.RequeueRecord, MaxRequeueHistory)
+	for i := range olderRecords {
+		olderRecords[i] = kueue.RequeueRecord{
+			Time:   metav1.NewTime(now.Add(-time.Duration(MaxRequeueHistory-i) * time.Minute)),
+			Reason: fmt.Sprintf("Reason%d", i),
+		}
+	}
+
+	cases := map[string]struct {
+		enableRequeueHistory bool
+		history              []kueue.RequeueRecord
+		reason               string
+		underlyingCause      kueue.EvictionUnderlyingCause
+		options              []EvictOption
+		wantHistory          []kueue.RequeueRecord
+	}{
+		"feature disabled": {
+			reason: kueue.WorkloadEvictedByPodsReadyTimeout,
+		},
+		"preempted": {
+			enableRequeueHistory: true,
+			reason:               kueue.WorkloadEvictedByPreemption,
+			options:              []EvictOption{WithPreemptor(preemptor)},
+			wantHistory: []kueue.RequeueRecord{
+				{
+					Time:         metav1.NewTime(now),
+					Reason:       kueue.WorkloadEvictedByPreemption,
+					Message:      "evicted",
+					ClusterQueue: "cq",
+					Flavors: []kueue.PodSetFlavors{
+						{
+							Name:    kueue.DefaultPodSetName,
+							Flavors: map[corev1.ResourceName]kueue.ResourceFlavorReference{corev1.ResourceCPU: "on-demand"},
+						},
+					},
+					Preemptor: &kueue.RequeueRecordPreemptor{
+						Namespace:    "other",
+						Name:         "preemptor",
+						UID:          "preemptor-uid",
+						ClusterQueue: "other-cq",
+					},
+				},
+			},
+		},
+		"the oldest record is dropped": {
+			enableRequeueHistory: true,
+			history:              olderRecords,
+			reason:               kueue.WorkloadDeactivated,
+			underlyingCause:      kueue.WorkloadRequeuingLimitExceeded,
+			wantHistory: append(slices.Clone(olderRecords[1:]), kueue.RequeueRecord{
+				Time:            metav1.NewTime(now),
+				Reason:          kueue.WorkloadDeactivated,
+				UnderlyingCause: kueue.WorkloadRequeuingLimitExceeded,
+				Message:         "evicted",
+				ClusterQueue:    "cq",
+				Flavors: []kueue.PodSetFlavors{
+					{
+						Name:    kueue.DefaultPodSetName,
+						Flavors: map[corev1.ResourceName]kueue.ResourceFlavorReference{corev1.ResourceCPU: "on-demand"},
+					},
+				},
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.WorkloadRequeueHistory, tc.enableRequeueHistory)
+			ctx, _ := utiltesting.ContextWithLog(t)
+			wl := utiltesting.MakeWorkload("wl", "ns").ReserveQuotaAt(admission, now).Obj()
+			wl.Status.RequeueHistory = tc.history
+			cl := utiltesting.NewFakeClientSSAAsSM(wl)
+
+			if err := Evict(ctx, cl, &utiltesting.EventRecorder{}, wl, tc.reason, "evicted", tc.underlyingCause, fakeClock, tc.options...); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			got := &kueue.Workload{}
+			if err := cl.Get(ctx, client.ObjectKeyFromObject(wl), got); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantHistory, got.Status.RequeueHistory); diff != "" {
+				t.Errorf("Unexpected requeue history (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnsetQuotaReservationRecordsLastAdmittedFlavors(t *testing.T) {
+	topologyAssignment := utiltesting.MakeTopologyAssignment([]string{corev1.LabelHostname}).
+		Domain(kueue.TopologyDomainAssignment{Values: []string{"node-1"}, Count: 1}).
+		Obj()
+	admission := utiltesting.MakeAdmission("cq").PodSets(
+		utiltesting.MakePodSetAssignment(kueue.DefaultPodSetName).
+			Assignment(corev1.ResourceCPU, "tas-flavor", "1").
+			TopologyAssignment(topologyAssignment).
+			Obj(),
+	).Obj()
+
+	cases := map[string]struct {
+		enableFlavorStickiness bool
+		want                   []kueue.PodSetFlavors
+	}{
+		"feature disabled": {},
+		"feature enabled": {
+			enableFlavorStickiness: true,
+			want: []kueue.PodSetFlavors{
+				{
+					Name:               kueue.DefaultPodSetName,
+					Flavors:            map[corev1.ResourceName]kueue.ResourceFlavorReference{corev1.ResourceCPU: "tas-flavor"},
+					TopologyAssignment: topologyAssignment,
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			features.SetFeatureGateDuringTest(t, features.FlavorStickiness, tc.enableFlavorStickiness)
+			now := time.Now()
+			wl := utiltesting.MakeWorkload("wl", "ns").ReserveQuotaAt(admission, now).Obj()
+			if !UnsetQuotaReservationWithCondition(wl, "Pending", "evicted", now) {
+				t.Fatal("Expected the workload to change")
+			}
+			if diff := cmp.Diff(tc.want, wl.Status.LastAdmittedFlavors); diff != "" {
+				t.Errorf("Unexpected last admitted flavors (-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
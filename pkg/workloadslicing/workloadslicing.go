@@ -208,6 +208,7 @@ func EnsureWorkloadSlices(ctx context.Context, clnt client.Client, jobPodSets []
 		// and the old slice is pending deactivation. The system should resolve this
 		// by deactivating the old slice, after which processing will continue under "case #1".
 		oldWorkload := workloads[0]
+		newWorkload := workloads[1]
 
 		// Finish the old workload slice if it lost its quota reservation or if it was
 		// explicitly evicted.
@@ -216,10 +217,24 @@ func EnsureWorkloadSlices(ctx context.Context, clnt client.Client, jobPodSets []
 			if err := Finish(ctx, clnt, &oldWorkload, kueue.WorkloadFinishedReasonOutOfSync, "The workload slice is out of sync with its parent job"); err != nil {
 				return nil, true, err
 			}
+		} else if oldCounts := workload.ExtractPodSetCountsFromWorkload(&oldWorkload); jobPodSetsCounts.HasSamePodSetKeys(oldCounts) &&
+			!workload.HasQuotaReservation(&newWorkload) && !oldCounts.HasFewerReplicasThan(jobPodSetsCounts) {
+			// The scale-up was withdrawn before the new slice was admitted, e.g., by an autoscaler
+			// reverting its decision. The old slice already covers the job, so the new slice is
+			// finished, and the old slice is scaled down in place if needed.
+			if err := Finish(ctx, clnt, &newWorkload, kueue.WorkloadFinishedReasonOutOfSync, "The scale up of the parent job was withdrawn"); err != nil {
+				return nil, true, err
+			}
+			if !jobPodSetsCounts.EqualTo(oldCounts) {
+				workload.ApplyPodSetCounts(&oldWorkload, jobPodSetsCounts)
+				if err := clnt.Update(ctx, &oldWorkload); err != nil {
+					return nil, true, fmt.Errorf("failed to update workload's pod sets counts: %w", err)
+				}
+			}
+			return &oldWorkload, true, nil
 		}
 
 		// We consider the new workload slice only when evaluating against the incoming job (pod sets).
-		newWorkload := workloads[1]
 		newCounts := workload.ExtractPodSetCountsFromWorkload(&newWorkload)
 
 		// Check if new workload and job's pod sets are compatible (have the same keys and keys count)
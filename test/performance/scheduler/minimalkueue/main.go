@@ -128,7 +128,7 @@ func mainWithExitCode() int {
 
 	if *metricsPort > 0 {
 		options.Metrics.BindAddress = fmt.Sprintf(":%d", *metricsPort)
-		metrics.Register()
+		metrics.Register(nil)
 	}
 
 	mgr, err := ctrl.NewManager(kubeConfig, options)